@@ -3,13 +3,17 @@ package main
 import (
 	"flag"
 	"fmt"
+	"net/http"
 
 	"github.com/wenpiner/last-admin-core/api/internal/config"
 	"github.com/wenpiner/last-admin-core/api/internal/handler"
+	"github.com/wenpiner/last-admin-core/api/internal/middleware"
+	"github.com/wenpiner/last-admin-core/api/internal/oidc"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/router"
 )
 
 var configFile = flag.String("f", "etc/core.yaml", "the config file")
@@ -20,11 +24,21 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c, conf.UseEnv())
 
-	server := rest.MustNewServer(c.RestConf)
+	ctx := svc.NewServiceContext(c)
+
+	// API密钥认证需在 JWT 校验之前处理
+	apiKeyRouter := middleware.NewApiKeyRouter(router.NewRouter(), ctx.Trans, ctx.ApiKeyRpc, c.Auth.AccessSecret)
+	server := rest.MustNewServer(c.RestConf, rest.WithRouter(apiKeyRouter))
 	defer server.Stop()
 
-	ctx := svc.NewServiceContext(c)
+	server.Use(ctx.LangMiddleware)
 	handler.RegisterHandlers(server, ctx)
+	// goctl 不支持以点开头的路径，发现文档单独注册
+	server.AddRoute(rest.Route{
+		Method:  http.MethodGet,
+		Path:    oidc.DiscoveryPath,
+		Handler: ctx.Oidc.Discovery,
+	})
 
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
@@ -12,8 +12,9 @@ import (
 type Config struct {
 	rest.RestConf
 	Auth struct {
-		AccessSecret string
-		AccessExpire int64
+		AccessSecret      string
+		AccessExpire      int64
+		ImpersonateExpire int64 `json:",default=1800"` // 模拟登录令牌有效期(秒)
 	}
 	CaptchaConf        captcha.CaptchaConfig // 验证码配置
 	ProjectConf        ProjectConfig         // 项目配置
@@ -22,8 +23,28 @@ type Config struct {
 	RedisConf          config.RedisConfig
 	CasbinConf         casbin.CasbinConf     `json:",optional"`
 	CasbinDatabaseConf config.DatabaseConfig `json:",optional"`
+	OidcConf           OidcConfig            // OAuth2/OIDC 授权服务配置
+	FileConf           FileConfig            // 文件上传下载配置
 }
 
 type ProjectConfig struct {
 	RegisterRoleValue string `json:",default=admin"` // 注册用户默认角色
 }
+
+type OidcConfig struct {
+	Issuer                string `json:",default=http://127.0.0.1:8889"` // 签发者，即本服务对外访问地址
+	AuthorizationEndpoint string `json:",optional"`                      // 前端授权确认页地址
+	SigningKeyFile        string `json:",optional"`                      // RSA私钥文件(PEM)，为空时使用临时密钥
+	AccessTokenExpire     int64  `json:",default=3600"`                  // 访问令牌有效期(秒)
+	RefreshTokenExpire    int64  `json:",default=2592000"`               // 刷新令牌有效期(秒)
+	CodeExpire            int64  `json:",default=300"`                   // 授权码有效期(秒)
+}
+
+type FileConfig struct {
+	MaxSize       int64    `json:",default=10485760"` // 单个文件大小上限(字节)
+	AvatarMaxSize int64    `json:",default=2097152"`  // 头像大小上限(字节)
+	AllowedTypes  []string `json:",optional"`         // 允许上传的文件类型，以 / 结尾时按前缀匹配，为空时不限制
+	SignSecret    string   `json:",optional"`         // 临时下载链接签名密钥，为空时使用 Auth.AccessSecret
+	SignExpire    int64    `json:",default=300"`      // 临时下载链接默认有效期(秒)
+	BaseURL       string   `json:",optional"`         // 文件链接前缀，即本服务对外访问地址
+}
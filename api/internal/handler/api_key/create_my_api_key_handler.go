@@ -0,0 +1,30 @@
+package api_key
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/api_key"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 创建API密钥
+func CreateMyApiKeyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ApiKeyInfo
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := api_key.NewCreateMyApiKeyLogic(r, svcCtx)
+		resp, err := l.CreateMyApiKey(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package api_key
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/api_key"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 删除我的API密钥
+func DeleteMyApiKeyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ID32Request
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := api_key.NewDeleteMyApiKeyLogic(r, svcCtx)
+		resp, err := l.DeleteMyApiKey(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
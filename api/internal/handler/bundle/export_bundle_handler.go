@@ -0,0 +1,39 @@
+package bundle
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/bundle"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 导出配置包(JSON/YAML)
+func ExportBundleHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BundleExportRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := bundle.NewExportBundleLogic(r, svcCtx)
+		data, filename, err := l.ExportBundle(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		contentType := "application/json; charset=utf-8"
+		if req.Format == bundle.BundleFileYAML {
+			contentType = "application/yaml; charset=utf-8"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	}
+}
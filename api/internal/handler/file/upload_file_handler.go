@@ -0,0 +1,23 @@
+package file
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/file"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 上传文件(表单字段 file)
+func UploadFileHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := file.NewUploadFileLogic(r, svcCtx)
+		resp, err := l.UploadFile()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
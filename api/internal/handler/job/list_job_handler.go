@@ -0,0 +1,23 @@
+package job
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/job"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 获取定时任务列表
+func ListJobHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := job.NewListJobLogic(r, svcCtx)
+		resp, err := l.ListJob()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package notification
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/notification"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 获取未读通知数量
+func CountUnreadNotificationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := notification.NewCountUnreadNotificationLogic(r, svcCtx)
+		resp, err := l.CountUnreadNotification()
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
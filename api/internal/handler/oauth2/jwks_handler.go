@@ -0,0 +1,16 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/oauth2"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+)
+
+// 签名公钥
+func JwksHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := oauth2.NewJwksLogic(r, svcCtx)
+		l.Jwks(w)
+	}
+}
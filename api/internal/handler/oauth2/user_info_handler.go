@@ -0,0 +1,16 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/oauth2"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+)
+
+// 用户信息端点
+func UserInfoHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := oauth2.NewUserInfoLogic(r, svcCtx)
+		l.UserInfo(w)
+	}
+}
@@ -0,0 +1,28 @@
+package operation_log
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/operation_log"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 导出操作记录(CSV/JSONL)
+func ExportOperationLogHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OperationLogExportRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 导出内容直接写入响应，仅在开始写入前的错误以 JSON 返回
+		l := operation_log.NewExportOperationLogLogic(r, svcCtx)
+		if err := l.ExportOperationLog(&req, w); err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package permission_report
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/permission_report"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 导出权限持有人(CSV/XLSX)
+func ExportPermissionHoldersHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PermissionHolderExportRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := permission_report.NewExportPermissionHoldersLogic(r, svcCtx)
+		data, filename, err := l.ExportPermissionHolders(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", permission_report.ReportContentType(req.Format))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,31 @@
+package public_dict
+
+import (
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/public_dict"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/httpcache"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 根据编码获取公开字典
+func GetPublicDictByCodeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DictCodeRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := public_dict.NewGetPublicDictByCodeLogic(r, svcCtx)
+		resp, err := l.GetPublicDictByCode(&req)
+		if err != nil {
+			err = svcCtx.Trans.TransError(r.Context(), err)
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpcache.OkJsonWithETag(r.Context(), w, r, svcCtx.TransResponse(r.Context(), resp))
+		}
+	}
+}
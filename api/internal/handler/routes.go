@@ -5,20 +5,32 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	api "github.com/wenpiner/last-admin-core/api/internal/handler/api"
+	api_key "github.com/wenpiner/last-admin-core/api/internal/handler/api_key"
 	auth "github.com/wenpiner/last-admin-core/api/internal/handler/auth"
 	base "github.com/wenpiner/last-admin-core/api/internal/handler/base"
+	bundle "github.com/wenpiner/last-admin-core/api/internal/handler/bundle"
 	captcha "github.com/wenpiner/last-admin-core/api/internal/handler/captcha"
 	configuration "github.com/wenpiner/last-admin-core/api/internal/handler/configuration"
 	department "github.com/wenpiner/last-admin-core/api/internal/handler/department"
 	dict "github.com/wenpiner/last-admin-core/api/internal/handler/dict"
+	file "github.com/wenpiner/last-admin-core/api/internal/handler/file"
+	job "github.com/wenpiner/last-admin-core/api/internal/handler/job"
 	menu "github.com/wenpiner/last-admin-core/api/internal/handler/menu"
+	notification "github.com/wenpiner/last-admin-core/api/internal/handler/notification"
 	oauth "github.com/wenpiner/last-admin-core/api/internal/handler/oauth"
+	oauth2 "github.com/wenpiner/last-admin-core/api/internal/handler/oauth2"
+	operation_log "github.com/wenpiner/last-admin-core/api/internal/handler/operation_log"
+	permission_report "github.com/wenpiner/last-admin-core/api/internal/handler/permission_report"
 	position "github.com/wenpiner/last-admin-core/api/internal/handler/position"
 	public_config "github.com/wenpiner/last-admin-core/api/internal/handler/public_config"
+	public_dict "github.com/wenpiner/last-admin-core/api/internal/handler/public_dict"
+	public_file "github.com/wenpiner/last-admin-core/api/internal/handler/public_file"
 	public_user "github.com/wenpiner/last-admin-core/api/internal/handler/public_user"
 	role "github.com/wenpiner/last-admin-core/api/internal/handler/role"
+	security_event "github.com/wenpiner/last-admin-core/api/internal/handler/security_event"
 	token "github.com/wenpiner/last-admin-core/api/internal/handler/token"
 	user "github.com/wenpiner/last-admin-core/api/internal/handler/user"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
@@ -61,6 +73,68 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 创建API密钥
+					Method:  http.MethodPost,
+					Path:    "/create",
+					Handler: api_key.CreateMyApiKeyHandler(serverCtx),
+				},
+				{
+					// 删除我的API密钥
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: api_key.DeleteMyApiKeyHandler(serverCtx),
+				},
+				{
+					// 获取我的API密钥列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: api_key.ListMyApiKeyHandler(serverCtx),
+				},
+				{
+					// 更新我的API密钥
+					Method:  http.MethodPost,
+					Path:    "/update",
+					Handler: api_key.UpdateMyApiKeyHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/user/apikey"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 删除API密钥
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: api_key.DeleteApiKeyHandler(serverCtx),
+				},
+				{
+					// 获取API密钥列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: api_key.ListApiKeyHandler(serverCtx),
+				},
+				{
+					// 更新API密钥
+					Method:  http.MethodPost,
+					Path:    "/update",
+					Handler: api_key.UpdateApiKeyHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/apikey"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
@@ -87,6 +161,29 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 导出配置包(JSON/YAML)
+					Method:  http.MethodPost,
+					Path:    "/export",
+					Handler: bundle.ExportBundleHandler(serverCtx),
+				},
+				{
+					// 预览或导入配置包(JSON/YAML，表单字段 file)
+					Method:  http.MethodPost,
+					Path:    "/import",
+					Handler: bundle.ImportBundleHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/bundle"),
+		rest.WithTimeout(120000*time.Millisecond),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -143,6 +240,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 为部门分配角色
+					Method:  http.MethodPost,
+					Path:    "/assign/role",
+					Handler: department.AssignDepartmentRoleHandler(serverCtx),
+				},
+				{
+					// 批量删除部门
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: department.BatchDeleteDepartmentHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用部门
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: department.BatchUpdateDepartmentStateHandler(serverCtx),
+				},
 				{
 					// 创建或更新部门
 					Method:  http.MethodPost,
@@ -171,6 +286,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 批量删除字典
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: dict.BatchDeleteDictHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用字典
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: dict.BatchUpdateDictStateHandler(serverCtx),
+				},
+				{
+					// 根据编码获取字典及启用的子项
+					Method:  http.MethodGet,
+					Path:    "/code",
+					Handler: dict.GetDictByCodeHandler(serverCtx),
+				},
+				{
+					// 根据编码批量获取字典及启用的子项
+					Method:  http.MethodGet,
+					Path:    "/codes",
+					Handler: dict.BatchGetDictByCodeHandler(serverCtx),
+				},
 				{
 					// 创建或更新字典
 					Method:  http.MethodPost,
@@ -225,6 +364,86 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/dict"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 下载文件
+					Method:  http.MethodGet,
+					Path:    "/download/:id",
+					Handler: file.DownloadFileHandler(serverCtx),
+				},
+				{
+					// 上传文件(表单字段 file)
+					Method:  http.MethodPost,
+					Path:    "/upload",
+					Handler: file.UploadFileHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/file"),
+		rest.WithTimeout(600000*time.Millisecond),
+		rest.WithMaxBytes(1073741824),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 删除文件
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: file.DeleteFileHandler(serverCtx),
+				},
+				{
+					// 获取文件列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: file.ListFileHandler(serverCtx),
+				},
+				{
+					// 生成临时下载链接
+					Method:  http.MethodPost,
+					Path:    "/url",
+					Handler: file.CreateFileUrlHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/file"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 获取定时任务列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: job.ListJobHandler(serverCtx),
+				},
+				{
+					// 暂停或恢复定时任务
+					Method:  http.MethodPost,
+					Path:    "/pause",
+					Handler: job.PauseJobHandler(serverCtx),
+				},
+				{
+					// 立即执行定时任务
+					Method:  http.MethodPost,
+					Path:    "/trigger",
+					Handler: job.TriggerJobHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/job"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
@@ -241,12 +460,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/all-menus",
 					Handler: menu.GetAllMenusHandler(serverCtx),
 				},
+				{
+					// 为菜单绑定API
+					Method:  http.MethodPost,
+					Path:    "/assign/api",
+					Handler: menu.AssignApiToMenuHandler(serverCtx),
+				},
 				{
 					// 删除菜单
 					Method:  http.MethodPost,
 					Path:    "/delete",
 					Handler: menu.DeleteMenuHandler(serverCtx),
 				},
+				{
+					// 获取菜单绑定的API
+					Method:  http.MethodPost,
+					Path:    "/get/api",
+					Handler: menu.GetMenuApiHandler(serverCtx),
+				},
 				{
 					// 更新菜单
 					Method:  http.MethodPut,
@@ -263,6 +494,86 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 归档通知
+					Method:  http.MethodPost,
+					Path:    "/archive",
+					Handler: notification.ArchiveNotificationHandler(serverCtx),
+				},
+				{
+					// 获取我的通知列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: notification.ListMyNotificationHandler(serverCtx),
+				},
+				{
+					// 标记通知为已读
+					Method:  http.MethodPost,
+					Path:    "/read",
+					Handler: notification.ReadNotificationHandler(serverCtx),
+				},
+				{
+					// 获取未读通知数量
+					Method:  http.MethodPost,
+					Path:    "/unreadCount",
+					Handler: notification.CountUnreadNotificationHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/user/notification"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 发布通知公告
+					Method:  http.MethodPost,
+					Path:    "/create",
+					Handler: notification.CreateNotificationHandler(serverCtx),
+				},
+				{
+					// 删除通知公告
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: notification.DeleteNotificationHandler(serverCtx),
+				},
+				{
+					// 获取通知公告列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: notification.ListNotificationHandler(serverCtx),
+				},
+				{
+					// 更新通知公告
+					Method:  http.MethodPost,
+					Path:    "/update",
+					Handler: notification.UpdateNotificationHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/notification"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 批量删除第三方登录提供商
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: oauth.BatchDeleteOauthProviderHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用第三方登录提供商
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: oauth.BatchUpdateOauthProviderStateHandler(serverCtx),
+				},
 				{
 					// 创建或更新Oauth
 					Method:  http.MethodPost,
@@ -287,10 +598,236 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/oauth"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 签名公钥
+				Method:  http.MethodGet,
+				Path:    "/jwks",
+				Handler: oauth2.JwksHandler(serverCtx),
+			},
+			{
+				// 令牌端点
+				Method:  http.MethodPost,
+				Path:    "/token",
+				Handler: oauth2.TokenHandler(serverCtx),
+			},
+			{
+				// 用户信息端点
+				Method:  http.MethodGet,
+				Path:    "/userinfo",
+				Handler: oauth2.UserInfoHandler(serverCtx),
+			},
+			{
+				// 用户信息端点(POST)
+				Method:  http.MethodPost,
+				Path:    "/userinfo",
+				Handler: oauth2.UserInfoPostHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/oauth2"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 确认或拒绝授权
+					Method:  http.MethodPost,
+					Path:    "/authorize/consent",
+					Handler: oauth2.ConsentHandler(serverCtx),
+				},
+				{
+					// 获取授权请求信息
+					Method:  http.MethodPost,
+					Path:    "/authorize/info",
+					Handler: oauth2.GetAuthorizeInfoHandler(serverCtx),
+				},
+				{
+					// 撤销对应用的授权
+					Method:  http.MethodPost,
+					Path:    "/consent/delete",
+					Handler: oauth2.DeleteMyConsentHandler(serverCtx),
+				},
+				{
+					// 获取我授权的应用
+					Method:  http.MethodPost,
+					Path:    "/consent/list",
+					Handler: oauth2.ListMyConsentHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/oauth2"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 创建客户端应用
+					Method:  http.MethodPost,
+					Path:    "/create",
+					Handler: oauth2.CreateOauthClientHandler(serverCtx),
+				},
+				{
+					// 删除客户端应用
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: oauth2.DeleteOauthClientHandler(serverCtx),
+				},
+				{
+					// 获取客户端应用列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: oauth2.ListOauthClientHandler(serverCtx),
+				},
+				{
+					// 更新客户端应用
+					Method:  http.MethodPost,
+					Path:    "/update",
+					Handler: oauth2.UpdateOauthClientHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/oauth2/client"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 获取操作记录归档列表
+					Method:  http.MethodPost,
+					Path:    "/archive/list",
+					Handler: operation_log.ListOperationLogArchiveHandler(serverCtx),
+				},
+				{
+					// 恢复操作记录归档
+					Method:  http.MethodPost,
+					Path:    "/archive/restore",
+					Handler: operation_log.RestoreOperationLogArchiveHandler(serverCtx),
+				},
+				{
+					// 获取操作记录列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: operation_log.ListOperationLogHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/operationLog"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 导出操作记录(CSV/JSONL)
+					Method:  http.MethodPost,
+					Path:    "/export",
+					Handler: operation_log.ExportOperationLogHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/operationLog"),
+		rest.WithTimeout(600000*time.Millisecond),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 获取持有接口、菜单或配置项权限的角色及用户
+					Method:  http.MethodPost,
+					Path:    "/holder",
+					Handler: permission_report.GetPermissionHoldersHandler(serverCtx),
+				},
+				{
+					// 导出权限持有人(CSV/XLSX)
+					Method:  http.MethodPost,
+					Path:    "/holder/export",
+					Handler: permission_report.ExportPermissionHoldersHandler(serverCtx),
+				},
+				{
+					// 获取角色的全部权限及持有用户
+					Method:  http.MethodPost,
+					Path:    "/role",
+					Handler: permission_report.GetRolePermissionReportHandler(serverCtx),
+				},
+				{
+					// 导出角色权限报表(CSV/XLSX)
+					Method:  http.MethodPost,
+					Path:    "/role/export",
+					Handler: permission_report.ExportRolePermissionReportHandler(serverCtx),
+				},
+				{
+					// 创建当前权限的快照
+					Method:  http.MethodPost,
+					Path:    "/snapshot/create",
+					Handler: permission_report.CreatePermissionSnapshotHandler(serverCtx),
+				},
+				{
+					// 删除权限快照
+					Method:  http.MethodPost,
+					Path:    "/snapshot/delete",
+					Handler: permission_report.DeletePermissionSnapshotHandler(serverCtx),
+				},
+				{
+					// 对比两个权限快照，或快照与当前权限的差异
+					Method:  http.MethodPost,
+					Path:    "/snapshot/diff",
+					Handler: permission_report.DiffPermissionSnapshotHandler(serverCtx),
+				},
+				{
+					// 导出权限快照差异(CSV/XLSX)
+					Method:  http.MethodPost,
+					Path:    "/snapshot/diff/export",
+					Handler: permission_report.ExportPermissionSnapshotDiffHandler(serverCtx),
+				},
+				{
+					// 获取权限快照列表
+					Method:  http.MethodPost,
+					Path:    "/snapshot/list",
+					Handler: permission_report.GetPermissionSnapshotListHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/permissionReport"),
+		rest.WithTimeout(120000*time.Millisecond),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 为岗位分配角色
+					Method:  http.MethodPost,
+					Path:    "/assign/role",
+					Handler: position.AssignPositionRoleHandler(serverCtx),
+				},
+				{
+					// 批量删除岗位
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: position.BatchDeletePositionHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用岗位
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: position.BatchUpdatePositionStateHandler(serverCtx),
+				},
 				{
 					// 创建或更新岗位
 					Method:  http.MethodPost,
@@ -327,6 +864,43 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/public/config"),
 	)
 
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 根据编码获取公开字典
+				Method:  http.MethodGet,
+				Path:    "/code",
+				Handler: public_dict.GetPublicDictByCodeHandler(serverCtx),
+			},
+			{
+				// 根据编码批量获取公开字典
+				Method:  http.MethodGet,
+				Path:    "/codes",
+				Handler: public_dict.BatchGetPublicDictByCodeHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/public/dict"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 获取头像
+				Method:  http.MethodGet,
+				Path:    "/avatar/:id",
+				Handler: public_file.GetAvatarHandler(serverCtx),
+			},
+			{
+				// 通过临时链接下载文件
+				Method:  http.MethodGet,
+				Path:    "/signed/:id",
+				Handler: public_file.SignedDownloadFileHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/file"),
+		rest.WithTimeout(600000*time.Millisecond),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -347,12 +921,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/oauth/login",
 				Handler: public_user.OauthLoginHandler(serverCtx),
 			},
+			{
+				// 修改过期密码(登录时要求修改密码)
+				Method:  http.MethodPost,
+				Path:    "/password/change",
+				Handler: public_user.ChangeExpiredPasswordHandler(serverCtx),
+			},
 			{
 				// 注册用户
 				Method:  http.MethodPost,
 				Path:    "/register",
 				Handler: public_user.RegisterUserHandler(serverCtx),
 			},
+			{
+				// 开始WebAuthn登录
+				Method:  http.MethodPost,
+				Path:    "/webauthn/login/begin",
+				Handler: public_user.WebauthnLoginBeginHandler(serverCtx),
+			},
+			{
+				// WebAuthn登录(Passkey无密码登录)
+				Method:  http.MethodPost,
+				Path:    "/webauthn/login/finish",
+				Handler: public_user.WebauthnLoginFinishHandler(serverCtx),
+			},
 		},
 		rest.WithPrefix("/auth"),
 	)
@@ -361,6 +953,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 检查角色接口授权与菜单的一致性
+					Method:  http.MethodPost,
+					Path:    "/api/consistency",
+					Handler: role.CheckRoleApiConsistencyHandler(serverCtx),
+				},
 				{
 					// 为角色分配API
 					Method:  http.MethodPost,
@@ -379,6 +977,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/assign/menu",
 					Handler: role.AssignMenuToRoleHandler(serverCtx),
 				},
+				{
+					// 批量删除角色
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: role.BatchDeleteRoleHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用角色
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: role.BatchUpdateRoleStateHandler(serverCtx),
+				},
 				{
 					// 创建或更新角色
 					Method:  http.MethodPost,
@@ -421,12 +1031,50 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/list",
 					Handler: role.ListRoleHandler(serverCtx),
 				},
+				{
+					// 解释或模拟接口、配置项的访问决策
+					Method:  http.MethodPost,
+					Path:    "/permission/explain",
+					Handler: role.ExplainPermissionHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/role"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 获取我的登录记录及安全事件
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: security_event.ListMySecurityEventHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/user/securityEvent"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
+				{
+					// 获取安全事件列表
+					Method:  http.MethodPost,
+					Path:    "/list",
+					Handler: security_event.ListSecurityEventHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/securityEvent"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
@@ -437,6 +1085,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/:id",
 					Handler: token.DeleteTokenHandler(serverCtx),
 				},
+				{
+					// 批量删除令牌
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: token.BatchDeleteTokenHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用令牌
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: token.BatchUpdateTokenStateHandler(serverCtx),
+				},
 				{
 					// 拉黑某一个令牌
 					Method:  http.MethodPost,
@@ -471,12 +1131,72 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 上传头像(表单字段 file)
+					Method:  http.MethodPost,
+					Path:    "/avatar",
+					Handler: user.UploadAvatarHandler(serverCtx),
+				},
+				{
+					// 结束模拟登录
+					Method:  http.MethodPost,
+					Path:    "/impersonate/stop",
+					Handler: user.StopImpersonationHandler(serverCtx),
+				},
 				{
 					// 获取用户信息
 					Method:  http.MethodGet,
 					Path:    "/info",
 					Handler: user.GetUserInfoHandler(serverCtx),
 				},
+				{
+					// 修改密码
+					Method:  http.MethodPost,
+					Path:    "/password",
+					Handler: user.ChangePasswordHandler(serverCtx),
+				},
+				{
+					// 修改个人资料
+					Method:  http.MethodPost,
+					Path:    "/profile",
+					Handler: user.UpdateProfileHandler(serverCtx),
+				},
+				{
+					// 获取我的登录会话
+					Method:  http.MethodPost,
+					Path:    "/session/list",
+					Handler: user.ListMySessionHandler(serverCtx),
+				},
+				{
+					// 退出指定会话
+					Method:  http.MethodPost,
+					Path:    "/session/revoke",
+					Handler: user.RevokeMySessionHandler(serverCtx),
+				},
+				{
+					// 退出其他所有会话
+					Method:  http.MethodPost,
+					Path:    "/session/revokeOthers",
+					Handler: user.RevokeOtherSessionsHandler(serverCtx),
+				},
+				{
+					// 禁用TOTP
+					Method:  http.MethodPost,
+					Path:    "/totp/disable",
+					Handler: user.DisableTotpHandler(serverCtx),
+				},
+				{
+					// 创建/重置TOTP
+					Method:  http.MethodPost,
+					Path:    "/totp/enable",
+					Handler: user.EnableTotpHandler(serverCtx),
+				},
+				{
+					// 验证TOTP
+					Method:  http.MethodPost,
+					Path:    "/totp/verify",
+					Handler: user.VerifyTotpHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
@@ -487,6 +1207,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.AuthMiddleware},
 			[]rest.Route{
+				{
+					// 批量删除用户
+					Method:  http.MethodPost,
+					Path:    "/batch/delete",
+					Handler: user.BatchDeleteUserHandler(serverCtx),
+				},
+				{
+					// 批量启用或禁用用户
+					Method:  http.MethodPost,
+					Path:    "/batch/state",
+					Handler: user.BatchUpdateUserStateHandler(serverCtx),
+				},
 				{
 					// 创建或更新用户
 					Method:  http.MethodPost,
@@ -499,6 +1231,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/delete",
 					Handler: user.DeleteUserHandler(serverCtx),
 				},
+				{
+					// 获取用户有效角色及来源
+					Method:  http.MethodPost,
+					Path:    "/effectiveRoles",
+					Handler: user.GetUserEffectiveRolesHandler(serverCtx),
+				},
+				{
+					// 导出用户(CSV/XLSX)
+					Method:  http.MethodPost,
+					Path:    "/export",
+					Handler: user.ExportUserHandler(serverCtx),
+				},
+				{
+					// 模拟用户登录，签发短期令牌
+					Method:  http.MethodPost,
+					Path:    "/impersonate",
+					Handler: user.ImpersonateUserHandler(serverCtx),
+				},
+				{
+					// 导入用户(CSV/XLSX，表单字段 file)
+					Method:  http.MethodPost,
+					Path:    "/import",
+					Handler: user.ImportUserHandler(serverCtx),
+				},
 				{
 					// 获取用户列表
 					Method:  http.MethodPost,
@@ -506,26 +1262,54 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Handler: user.ListUserHandler(serverCtx),
 				},
 				{
-					// 禁用TOTP
+					// 重置用户TOTP(用户丢失设备时由管理员关闭)
 					Method:  http.MethodPost,
-					Path:    "/totp/disable",
-					Handler: user.DisableTotpHandler(serverCtx),
+					Path:    "/totp/reset",
+					Handler: user.ResetUserTotpHandler(serverCtx),
 				},
+			}...,
+		),
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+		rest.WithPrefix("/user"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AuthMiddleware},
+			[]rest.Route{
 				{
-					// 创建/重置TOTP
+					// 删除WebAuthn凭证
 					Method:  http.MethodPost,
-					Path:    "/totp/enable",
-					Handler: user.EnableTotpHandler(serverCtx),
+					Path:    "/delete",
+					Handler: user.DeleteWebauthnCredentialHandler(serverCtx),
 				},
 				{
-					// 验证TOTP
+					// 获取当前用户WebAuthn凭证列表
+					Method:  http.MethodGet,
+					Path:    "/list",
+					Handler: user.ListWebauthnCredentialHandler(serverCtx),
+				},
+				{
+					// 开始注册WebAuthn凭证
 					Method:  http.MethodPost,
-					Path:    "/totp/verify",
-					Handler: user.VerifyTotpHandler(serverCtx),
+					Path:    "/register/begin",
+					Handler: user.WebauthnRegisterBeginHandler(serverCtx),
+				},
+				{
+					// 完成注册WebAuthn凭证
+					Method:  http.MethodPost,
+					Path:    "/register/finish",
+					Handler: user.WebauthnRegisterFinishHandler(serverCtx),
+				},
+				{
+					// 修改WebAuthn凭证名称
+					Method:  http.MethodPost,
+					Path:    "/update",
+					Handler: user.UpdateWebauthnCredentialHandler(serverCtx),
 				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
-		rest.WithPrefix("/user"),
+		rest.WithPrefix("/user/webauthn"),
 	)
 }
@@ -12,7 +12,7 @@ import (
 // 禁用TOTP
 func DisableTotpHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req types.UUIDRequest
+		var req types.DisableTotpRequest
 		if err := httpx.Parse(r, &req); err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 			return
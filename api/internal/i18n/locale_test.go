@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// keyPattern 匹配源码中作为错误或响应消息使用的 i18n 键
+var keyPattern = regexp.MustCompile(`(?:errorx\.New\w*Error\((?:errorx\.\w+,\s*)?|Message:\s*)"([a-zA-Z][\w-]*(?:\.[\w-]+)+)"`)
+
+func loadLocales(t *testing.T) map[string]map[string]string {
+	t.Helper()
+	locales := make(map[string]map[string]string)
+	for _, lang := range Languages() {
+		data, err := fs.ReadFile(LocaleFS, "locale/"+lang+".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var messages map[string]any
+		if err = json.Unmarshal(data, &messages); err != nil {
+			t.Fatalf("%s.json: %v", lang, err)
+		}
+		flat := make(map[string]string)
+		flatten("", messages, flat)
+		locales[lang] = flat
+	}
+	if len(locales) < 2 {
+		t.Fatalf("expected at least zh and en locales, got %v", Languages())
+	}
+	return locales
+}
+
+func flatten(prefix string, messages map[string]any, out map[string]string) {
+	for key, value := range messages {
+		switch v := value.(type) {
+		case map[string]any:
+			flatten(prefix+key+".", v, out)
+		case string:
+			out[prefix+key] = v
+		}
+	}
+}
+
+func TestLocalesHaveSameKeys(t *testing.T) {
+	locales := loadLocales(t)
+	for lang, messages := range locales {
+		for other, otherMessages := range locales {
+			for key := range otherMessages {
+				if _, ok := messages[key]; !ok {
+					t.Errorf("%s.json is missing %q defined in %s.json", lang, key, other)
+				}
+			}
+		}
+		for key, text := range messages {
+			if strings.TrimSpace(text) == "" {
+				t.Errorf("%s.json has an empty message for %q", lang, key)
+			}
+		}
+	}
+}
+
+func TestSourceKeysAreTranslated(t *testing.T) {
+	locales := loadLocales(t)
+	root := filepath.Join("..", "..", "..")
+	for _, dir := range []string{"api", "rpc"} {
+		err := filepath.WalkDir(filepath.Join(root, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && d.Name() == "ent" {
+				return filepath.SkipDir
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, match := range keyPattern.FindAllStringSubmatch(string(data), -1) {
+				for lang, messages := range locales {
+					if _, ok := messages[match[1]]; !ok {
+						t.Errorf("%s: key %q is missing from %s.json", path, match[1], lang)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
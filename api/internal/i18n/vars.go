@@ -2,7 +2,23 @@ package i18n
 
 import (
 	"embed"
+	"io/fs"
+	"path"
+	"strings"
 )
 
 //go:embed locale/*.json
 var LocaleFS embed.FS
+
+// Languages 返回已内置翻译文件的语言
+func Languages() []string {
+	entries, err := fs.ReadDir(LocaleFS, "locale")
+	if err != nil {
+		return nil
+	}
+	langs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		langs = append(langs, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+	return langs
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"net/http"
@@ -32,10 +33,7 @@ func NewListApiLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListApiLogic
 
 func (l *ListApiLogic) ListApi(req *types.ApiListRequest) (resp *types.ApiListResponse, err error) {
 	apiList, err := l.svcCtx.ApiRpc.ListApi(l.ctx, &core.ApiListRequest{
-		Page: &core.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:        pageutils.Request(req.Page),
 		ServiceName: &req.ServiceName,
 		ApiGroup:    &req.ApiGroup,
 		Method:      &req.Method,
@@ -0,0 +1,108 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/apikeyservice"
+)
+
+// ConvertRpcApiKeyInfoToApiApiKeyInfo 将 RPC ApiKeyInfo 转换为 API ApiKeyInfo
+func ConvertRpcApiKeyInfoToApiApiKeyInfo(rpcKey *apikeyservice.ApiKeyInfo) types.ApiKeyInfo {
+	if rpcKey == nil {
+		return types.ApiKeyInfo{}
+	}
+
+	return types.ApiKeyInfo{
+		ID:          rpcKey.Id,
+		CreatedAt:   rpcKey.CreatedAt,
+		UpdatedAt:   rpcKey.UpdatedAt,
+		State:       rpcKey.State,
+		UserId:      rpcKey.UserId,
+		Username:    rpcKey.Username,
+		Name:        pointer.GetString(rpcKey.Name),
+		KeyPrefix:   rpcKey.KeyPrefix,
+		Roles:       rpcKey.Roles,
+		AllowedIps:  rpcKey.AllowedIps,
+		ExpiresAt:   rpcKey.ExpiresAt,
+		LastUsedAt:  rpcKey.LastUsedAt,
+		LastUsedIp:  rpcKey.LastUsedIp,
+		Description: rpcKey.Description,
+	}
+}
+
+// ConvertApiApiKeyInfoToRpcApiKeyInfo 将 API ApiKeyInfo 转换为 RPC ApiKeyInfo，所属用户由调用方指定
+func ConvertApiApiKeyInfoToRpcApiKeyInfo(apiKey *types.ApiKeyInfo, userId *string) *apikeyservice.ApiKeyInfo {
+	return &apikeyservice.ApiKeyInfo{
+		Id:          apiKey.ID,
+		State:       apiKey.State,
+		UserId:      userId,
+		Name:        pointer.ToStringPtr(apiKey.Name),
+		Roles:       apiKey.Roles,
+		AllowedIps:  apiKey.AllowedIps,
+		ExpiresAt:   apiKey.ExpiresAt,
+		Description: apiKey.Description,
+	}
+}
+
+// listApiKeys 获取API密钥列表，userId 不为空时只返回该用户的密钥
+func listApiKeys(ctx context.Context, svcCtx *svc.ServiceContext, req *types.ApiKeyListRequest, userId *string) (*types.ApiKeyListResponse, error) {
+	rpcResp, err := svcCtx.ApiKeyRpc.ListApiKey(ctx, &apikeyservice.ApiKeyListRequest{
+		Page:   pageutils.Request(req.Page),
+		UserId: userId,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.ApiKeyInfo, 0, len(rpcResp.List))
+	for _, item := range rpcResp.List {
+		list = append(list, ConvertRpcApiKeyInfoToApiApiKeyInfo(item))
+	}
+	return &types.ApiKeyListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.ApiKeyListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			List: list,
+		},
+	}, nil
+}
+
+// updateApiKey 更新API密钥，userId 不为空时只能更新该用户的密钥
+func updateApiKey(ctx context.Context, svcCtx *svc.ServiceContext, req *types.ApiKeyInfo, userId *string) (*types.ModifyApiKeyResponse, error) {
+	rpcResp, err := svcCtx.ApiKeyRpc.UpdateApiKey(ctx, ConvertApiApiKeyInfoToRpcApiKeyInfo(req, userId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.ModifyApiKeyResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: ConvertRpcApiKeyInfoToApiApiKeyInfo(rpcResp),
+	}, nil
+}
+
+// deleteApiKey 删除API密钥，userId 不为空时只能删除该用户的密钥
+func deleteApiKey(ctx context.Context, svcCtx *svc.ServiceContext, req *types.ID32Request, userId *string) (*types.BaseResponse, error) {
+	rpcResp, err := svcCtx.ApiKeyRpc.DeleteApiKey(ctx, &apikeyservice.ApiKeyDeleteRequest{
+		Id:     req.ID,
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
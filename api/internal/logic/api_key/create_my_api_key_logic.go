@@ -0,0 +1,50 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateMyApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建API密钥
+func NewCreateMyApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *CreateMyApiKeyLogic {
+	return &CreateMyApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CreateMyApiKeyLogic) CreateMyApiKey(req *types.ApiKeyInfo) (resp *types.CreateApiKeyResponse, err error) {
+	rpcResp, err := l.svcCtx.ApiKeyRpc.CreateApiKey(l.ctx,
+		ConvertApiApiKeyInfoToRpcApiKeyInfo(req, pointer.ToStringPtr(l.ctx.Value("userId").(string))))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.CreateApiKeyResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "apiKey.created",
+		},
+		Data: types.CreateApiKeyInfo{
+			ApiKeyInfo: ConvertRpcApiKeyInfoToApiApiKeyInfo(rpcResp.Info),
+			Key:        rpcResp.Key,
+		},
+	}
+	return
+}
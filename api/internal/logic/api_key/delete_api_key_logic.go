@@ -0,0 +1,33 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除API密钥
+func NewDeleteApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteApiKeyLogic {
+	return &DeleteApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteApiKeyLogic) DeleteApiKey(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	return deleteApiKey(l.ctx, l.svcCtx, req, nil)
+}
@@ -0,0 +1,34 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteMyApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除我的API密钥
+func NewDeleteMyApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteMyApiKeyLogic {
+	return &DeleteMyApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteMyApiKeyLogic) DeleteMyApiKey(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	return deleteApiKey(l.ctx, l.svcCtx, req, pointer.ToStringPtr(l.ctx.Value("userId").(string)))
+}
@@ -0,0 +1,33 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取API密钥列表
+func NewListApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListApiKeyLogic {
+	return &ListApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListApiKeyLogic) ListApiKey(req *types.ApiKeyListRequest) (resp *types.ApiKeyListResponse, err error) {
+	return listApiKeys(l.ctx, l.svcCtx, req, req.UserId)
+}
@@ -0,0 +1,34 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMyApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我的API密钥列表
+func NewListMyApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListMyApiKeyLogic {
+	return &ListMyApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListMyApiKeyLogic) ListMyApiKey(req *types.ApiKeyListRequest) (resp *types.ApiKeyListResponse, err error) {
+	return listApiKeys(l.ctx, l.svcCtx, req, pointer.ToStringPtr(l.ctx.Value("userId").(string)))
+}
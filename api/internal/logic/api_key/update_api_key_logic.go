@@ -0,0 +1,34 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新API密钥
+func NewUpdateApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateApiKeyLogic {
+	return &UpdateApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateApiKeyLogic) UpdateApiKey(req *types.ApiKeyInfo) (resp *types.ModifyApiKeyResponse, err error) {
+	// 管理员可更新任意用户的密钥，所属用户以数据库记录为准
+	return updateApiKey(l.ctx, l.svcCtx, req, nil)
+}
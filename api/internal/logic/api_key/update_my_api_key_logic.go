@@ -0,0 +1,34 @@
+package api_key
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateMyApiKeyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新我的API密钥
+func NewUpdateMyApiKeyLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateMyApiKeyLogic {
+	return &UpdateMyApiKeyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateMyApiKeyLogic) UpdateMyApiKey(req *types.ApiKeyInfo) (resp *types.ModifyApiKeyResponse, err error) {
+	return updateApiKey(l.ctx, l.svcCtx, req, pointer.ToStringPtr(l.ctx.Value("userId").(string)))
+}
@@ -0,0 +1,60 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/bundleservice"
+	"sigs.k8s.io/yaml"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 配置包文件格式
+const (
+	BundleFileJSON = "json"
+	BundleFileYAML = "yaml"
+)
+
+type ExportBundleLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出配置包(JSON/YAML)
+func NewExportBundleLogic(r *http.Request, svcCtx *svc.ServiceContext) *ExportBundleLogic {
+	return &ExportBundleLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ExportBundleLogic) ExportBundle(req *types.BundleExportRequest) (data []byte, filename string, err error) {
+	result, err := l.svcCtx.BundleRpc.ExportBundle(l.ctx, &bundleservice.BundleExportRequest{
+		ExcludeSecrets: req.ExcludeSecrets,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	data = result.Content
+	if req.Format == BundleFileYAML {
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			l.Errorw("convert bundle to yaml failed", logx.Field("detail", err.Error()))
+			return nil, "", errorx.NewInternalError("bundle.exportFailed")
+		}
+	}
+
+	filename = fmt.Sprintf("bundle-%s.%s", time.Now().Format("20060102150405"), req.Format)
+	return data, filename, nil
+}
@@ -0,0 +1,90 @@
+package bundle
+
+import (
+	"context"
+	"io"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/bundleservice"
+	"sigs.k8s.io/yaml"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxBundleFileSize 配置包大小上限，与 gRPC 默认的消息大小上限一致
+const maxBundleFileSize = 4 << 20
+
+type ImportBundleLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 预览或导入配置包(JSON/YAML，表单字段 file)
+func NewImportBundleLogic(r *http.Request, svcCtx *svc.ServiceContext) *ImportBundleLogic {
+	return &ImportBundleLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ImportBundleLogic) ImportBundle(req *types.BundleImportRequest) (resp *types.BundleImportResponse, err error) {
+	file, header, err := l.r.FormFile("file")
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("bundle.invalid")
+	}
+	defer file.Close()
+	if header.Size > maxBundleFileSize {
+		return nil, errorx.NewInvalidArgumentError("bundle.fileTooLarge")
+	}
+
+	raw, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("bundle.invalid")
+	}
+	// YAML 是 JSON 的超集，统一转换为 JSON 后交由 RPC 解析
+	content, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("bundle.invalid")
+	}
+
+	result, err := l.svcCtx.BundleRpc.ImportBundle(l.ctx, &bundleservice.BundleImportRequest{
+		Content:        content,
+		Strategy:       req.Strategy,
+		ExcludeSecrets: req.ExcludeSecrets,
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := types.BundleImportResult{
+		Changes:   make([]types.BundleChange, 0, len(result.Changes)),
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Skipped:   result.Skipped,
+		Unchanged: result.Unchanged,
+		Conflicts: result.Conflicts,
+		DryRun:    result.DryRun,
+	}
+	for _, c := range result.Changes {
+		data.Changes = append(data.Changes, types.BundleChange{
+			Kind:   c.Kind,
+			Key:    c.Key,
+			Action: c.Action,
+			Fields: c.Fields,
+		})
+	}
+
+	return &types.BundleImportResponse{
+		BaseDataInfo: types.BaseDataInfo{Code: 0, Message: "success"},
+		Data:         data,
+	}, nil
+}
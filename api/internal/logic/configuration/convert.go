@@ -3,6 +3,7 @@ package configuration
 import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/configurationservice"
 )
 
@@ -68,10 +69,7 @@ func ConvertApiConfigurationListRequestToRpcConfigurationListRequest(apiReq *typ
 	}
 
 	return &configurationservice.ConfigurationListRequest{
-		Page: &configurationservice.BasePageRequest{
-			PageNumber: apiReq.Page.CurrentPage,
-			PageSize:   apiReq.Page.PageSize,
-		},
+		Page: pageutils.Request(apiReq.Page),
 		Key:   pointer.ToStringPtrIfNotEmpty(apiReq.Key),
 		Name:  pointer.ToStringPtrIfNotEmpty(apiReq.Name),
 		Group: pointer.ToStringPtrIfNotEmpty(apiReq.Group),
@@ -0,0 +1,46 @@
+package department
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignDepartmentRoleLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 为部门分配角色
+func NewAssignDepartmentRoleLogic(r *http.Request, svcCtx *svc.ServiceContext) *AssignDepartmentRoleLogic {
+	return &AssignDepartmentRoleLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *AssignDepartmentRoleLogic) AssignDepartmentRole(req *types.DepartmentRoleRequest) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.DepartmentRpc.AssignDepartmentRole(l.ctx, &core.DepartmentRoleRequest{
+		DepartmentId: req.DepartmentId,
+		RoleIds:      req.RoleIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
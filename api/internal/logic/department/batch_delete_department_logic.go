@@ -0,0 +1,40 @@
+package department
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/departmentservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteDepartmentLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除部门
+func NewBatchDeleteDepartmentLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeleteDepartmentLogic {
+	return &BatchDeleteDepartmentLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeleteDepartmentLogic) BatchDeleteDepartment(req *types.ID32SRequest) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.DepartmentRpc.BatchDeleteDepartment(l.ctx, &departmentservice.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
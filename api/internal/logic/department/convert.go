@@ -17,6 +17,7 @@ func ConvertRpcDepartmentInfoToApiDepartmentInfo(rpcDept *departmentservice.Depa
 		CreatedAt:      rpcDept.CreatedAt,
 		UpdatedAt:      rpcDept.UpdatedAt,
 		DeptName:       pointer.GetString(rpcDept.DeptName),
+		DeptNameI18n:   rpcDept.DeptNameI18N,
 		DeptCode:       pointer.GetString(rpcDept.DeptCode),
 		ParentId:       rpcDept.ParentId,
 		SortOrder:      pointer.GetInt32(rpcDept.SortOrder),
@@ -26,6 +27,8 @@ func ConvertRpcDepartmentInfoToApiDepartmentInfo(rpcDept *departmentservice.Depa
 		LeaderUsername: rpcDept.LaderUsername,
 		LeaderPhone:    rpcDept.LaderPhone,
 		LeaderEmail:    rpcDept.LaderEmail,
+		RoleIds:        rpcDept.RoleIds,
+		InheritRoles:   rpcDept.InheritRoles,
 	}
 }
 
@@ -40,12 +43,14 @@ func ConvertApiDepartmentInfoToRpcDepartmentInfo(apiDept *types.DepartmentInfo)
 		CreatedAt:    apiDept.CreatedAt,
 		UpdatedAt:    apiDept.UpdatedAt,
 		DeptName:     pointer.ToStringPtrIfNotEmpty(apiDept.DeptName),
+		DeptNameI18N: apiDept.DeptNameI18n,
 		DeptCode:     pointer.ToStringPtrIfNotEmpty(apiDept.DeptCode),
 		ParentId:     apiDept.ParentId,
 		SortOrder:    pointer.ToInt32PtrIfNotZero(apiDept.SortOrder),
 		LeaderUserId: pointer.ToStringPtrIfNotEmpty(apiDept.LeaderUserId),
 		State:        pointer.ToBoolPtrIfNotFalse(apiDept.State),
 		Description:  apiDept.Description,
+		InheritRoles: apiDept.InheritRoles,
 	}
 }
 // ConvertRpcDepartmentListResponseToApiDepartmentListResponse 将 RPC DepartmentListResponse 转换为 API DepartmentListResponse
@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/departmentservice"
 
 	"net/http"
@@ -33,10 +34,7 @@ func NewListDepartmentLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListDe
 func (l *ListDepartmentLogic) ListDepartment(req *types.DepartmentListRequest) (resp *types.DepartmentListResponse, err error) {
 	// 构建 RPC 请求
 	rpcReq := &departmentservice.DepartmentListRequest{
-		Page: &departmentservice.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:     pageutils.Request(req.Page),
 		DeptName: pointer.ToStringPtr(req.DeptName),
 		DeptCode: pointer.ToStringPtr(req.DeptCode),
 	}
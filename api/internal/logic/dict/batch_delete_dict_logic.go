@@ -0,0 +1,40 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/dictservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteDictLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除字典
+func NewBatchDeleteDictLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeleteDictLogic {
+	return &BatchDeleteDictLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeleteDictLogic) BatchDeleteDict(req *types.ID32SRequest) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.DictRpc.BatchDeleteDict(l.ctx, &dictservice.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
@@ -0,0 +1,49 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchGetDictByCodeLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 根据编码批量获取字典及启用的子项
+func NewBatchGetDictByCodeLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchGetDictByCodeLogic {
+	return &BatchGetDictByCodeLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchGetDictByCodeLogic) BatchGetDictByCode(req *types.DictCodesRequest) (resp *types.DictDataListResponse, err error) {
+	result, err := l.svcCtx.DictRpc.BatchGetDictByCode(l.ctx, &core.DictCodesRequest{
+		Codes:      SplitDictCodes(req.Codes),
+		PublicOnly: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.DictDataListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: ConvertDictDataList(result.List),
+	}
+	return
+}
@@ -36,6 +36,7 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(req *types.DictItem
 		CreatedAt:   req.CreatedAt,
 		UpdatedAt:   req.UpdatedAt,
 		Label:       &req.Label,
+		LabelI18N:   req.LabelI18n,
 		Value:       &req.Value,
 		Color:       &req.Color,
 		Css:         &req.Css,
@@ -59,6 +60,7 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(req *types.DictItem
 		CreatedAt:   dictItemResult.CreatedAt,
 		UpdatedAt:   dictItemResult.UpdatedAt,
 		Label:       pointer.GetString(dictItemResult.Label),
+		LabelI18n:   dictItemResult.LabelI18N,
 		Value:       pointer.GetString(dictItemResult.Value),
 		Color:       pointer.GetString(dictItemResult.Color),
 		Css:         pointer.GetString(dictItemResult.Css),
@@ -39,6 +39,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(req *types.DictInfo) (resp
 		Code:        &req.Code,
 		Description: &req.Description,
 		State:       req.State,
+		IsPublic:    req.IsPublic,
 	})
 
 	if err != nil {
@@ -58,6 +59,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(req *types.DictInfo) (resp
 			Code:        pointer.GetString(dictResult.Code),
 			Description: pointer.GetString(dictResult.Description),
 			State:       dictResult.State,
+			IsPublic:    dictResult.IsPublic,
 		},
 	}
 
@@ -0,0 +1,53 @@
+package dict
+
+import (
+	"strings"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// SplitDictCodes 拆分逗号分隔的字典编码
+func SplitDictCodes(codes string) []string {
+	var result []string
+	for _, code := range strings.Split(codes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			result = append(result, code)
+		}
+	}
+	return result
+}
+
+// ConvertDictData 将 RPC 字典数据转换为 API 字典数据
+func ConvertDictData(data *core.DictData) types.DictData {
+	items := make([]types.DictItemInfo, 0, len(data.Items))
+	for _, item := range data.Items {
+		items = append(items, types.DictItemInfo{
+			ID:          item.Id,
+			Label:       pointer.GetString(item.Label),
+			LabelI18n:   item.LabelI18N,
+			Value:       pointer.GetString(item.Value),
+			Color:       pointer.GetString(item.Color),
+			Css:         pointer.GetString(item.Css),
+			SortOrder:   pointer.GetInt32(item.SortOrder),
+			Description: pointer.GetString(item.Description),
+			DictID:      pointer.GetUint32(item.DictTypeId),
+		})
+	}
+	return types.DictData{
+		Code:     data.Code,
+		Name:     data.Name,
+		IsPublic: data.IsPublic,
+		Items:    items,
+	}
+}
+
+// ConvertDictDataList 将 RPC 字典数据列表转换为 API 字典数据列表
+func ConvertDictDataList(list []*core.DictData) []types.DictData {
+	result := make([]types.DictData, 0, len(list))
+	for _, data := range list {
+		result = append(result, ConvertDictData(data))
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDictByCodeLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 根据编码获取字典及启用的子项
+func NewGetDictByCodeLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetDictByCodeLogic {
+	return &GetDictByCodeLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetDictByCodeLogic) GetDictByCode(req *types.DictCodeRequest) (resp *types.DictDataResponse, err error) {
+	result, err := l.svcCtx.DictRpc.GetDictByCode(l.ctx, &core.DictCodeRequest{
+		Code:       req.Code,
+		PublicOnly: false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.DictDataResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: ConvertDictData(result),
+	}
+	return
+}
@@ -46,6 +46,7 @@ func (l *GetDictItemLogic) GetDictItem(req *types.ID32Request) (resp *types.Dict
 		CreatedAt:   dictItemResult.CreatedAt,
 		UpdatedAt:   dictItemResult.UpdatedAt,
 		Label:       pointer.GetString(dictItemResult.Label),
+		LabelI18n:   dictItemResult.LabelI18N,
 		Value:       pointer.GetString(dictItemResult.Value),
 		Color:       pointer.GetString(dictItemResult.Color),
 		Css:         pointer.GetString(dictItemResult.Css),
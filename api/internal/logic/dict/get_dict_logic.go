@@ -49,6 +49,7 @@ func (l *GetDictLogic) GetDict(req *types.ID32Request) (resp *types.DictInfoResp
 		Code:        pointer.GetString(dictResult.Code),
 		Description: pointer.GetString(dictResult.Description),
 		State:       dictResult.State,
+		IsPublic:    dictResult.IsPublic,
 	},
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"net/http"
@@ -32,10 +33,7 @@ func NewListDictItemLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListDict
 
 func (l *ListDictItemLogic) ListDictItem(req *types.DictItemListRequest) (resp *types.DictItemListResponse, err error) {
 	dictItemList, err := l.svcCtx.DictRpc.ListDictItem(l.ctx, &core.DictItemListRequest{
-		Page: &core.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:   pageutils.Request(req.Page),
 		DictId: &req.DictId,
 		Label:  &req.Label,
 		Value:  &req.Value,
@@ -69,6 +67,7 @@ func ConvertToDictItemInfo(dictItemList []*core.DictItemInfo) []types.DictItemIn
 			CreatedAt:   item.CreatedAt,
 			UpdatedAt:   item.UpdatedAt,
 			Label:       pointer.GetString(item.Label),
+			LabelI18n:   item.LabelI18N,
 			Value:       pointer.GetString(item.Value),
 			Color:       pointer.GetString(item.Color),
 			Css:         pointer.GetString(item.Css),
@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"net/http"
@@ -32,10 +33,7 @@ func NewListDictLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListDictLogi
 
 func (l *ListDictLogic) ListDict(req *types.DictListRequest) (resp *types.DictListResponse, err error) {
 	dictList, err := l.svcCtx.DictRpc.ListDict(l.ctx, &core.DictListRequest{
-		Page: &core.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:        pageutils.Request(req.Page),
 		Name:        &req.Name,
 		Code:        &req.Code,
 		Description: &req.Description,
@@ -72,6 +70,7 @@ func ConvertToDictInfo(dictList []*core.DictInfo) []types.DictInfo {
 			Code:        pointer.GetString(dict.Code),
 			Description: pointer.GetString(dict.Description),
 			State:       dict.State,
+			IsPublic:    dict.IsPublic,
 		})
 	}
 	return result
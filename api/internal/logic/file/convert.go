@@ -0,0 +1,21 @@
+package file
+
+import (
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+)
+
+// convertFileInfo 转换 RPC 文件信息
+func convertFileInfo(info *fileservice.FileInfo) types.FileInfo {
+	return types.FileInfo{
+		ID:        info.Id,
+		CreatedAt: info.CreatedAt,
+		OwnerId:   info.OwnerId,
+		OwnerName: info.OwnerName,
+		Name:      info.Name,
+		Category:  info.Category,
+		Size:      info.Size,
+		MimeType:  info.MimeType,
+		Checksum:  info.Checksum,
+	}
+}
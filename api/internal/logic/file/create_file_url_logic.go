@@ -0,0 +1,58 @@
+package file
+
+import (
+	"context"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateFileUrlLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 生成临时下载链接
+func NewCreateFileUrlLogic(r *http.Request, svcCtx *svc.ServiceContext) *CreateFileUrlLogic {
+	return &CreateFileUrlLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CreateFileUrlLogic) CreateFileUrl(req *types.FileUrlRequest) (resp *types.FileUrlResponse, err error) {
+	// 确认文件存在后再签发链接
+	info, err := l.svcCtx.FileRpc.GetFile(l.ctx, &fileservice.UUIDRequest{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	expire := req.Expire
+	if expire == 0 {
+		expire = l.svcCtx.Config.FileConf.SignExpire
+	}
+	expiresAt := time.Now().Unix() + expire
+
+	resp = &types.FileUrlResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.FileUrl{
+			Url:       filetransfer.SignedURL(l.svcCtx.Config.FileConf.BaseURL, l.svcCtx.Config.FileConf.SignSecret, *info.Id, expiresAt),
+			ExpiresAt: expiresAt,
+		},
+	}
+	return
+}
@@ -0,0 +1,43 @@
+package file
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteFileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除文件
+func NewDeleteFileLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteFileLogic {
+	return &DeleteFileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteFileLogic) DeleteFile(req *types.FileDeleteRequest) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.FileRpc.DeleteFile(l.ctx, &fileservice.UUIDSRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package file
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadFileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 下载文件
+func NewDownloadFileLogic(r *http.Request, svcCtx *svc.ServiceContext) *DownloadFileLogic {
+	return &DownloadFileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// DownloadFile 以附件形式输出文件内容
+func (l *DownloadFileLogic) DownloadFile(req *types.FileDownloadRequest, w http.ResponseWriter) error {
+	info, err := l.svcCtx.FileRpc.GetFile(l.ctx, &fileservice.UUIDRequest{Id: req.ID})
+	if err != nil {
+		return err
+	}
+
+	return filetransfer.Serve(l.ctx, w, l.svcCtx.FileRpc, info, "attachment")
+}
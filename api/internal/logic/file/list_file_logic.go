@@ -0,0 +1,63 @@
+package file
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取文件列表
+func NewListFileLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListFileLogic {
+	return &ListFileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListFileLogic) ListFile(req *types.FileListRequest) (resp *types.FileListResponse, err error) {
+	rpcResp, err := l.svcCtx.FileRpc.ListFile(l.ctx, &fileservice.FileListRequest{
+		Page:     pageutils.Request(req.Page),
+		OwnerId:  req.OwnerId,
+		Name:     req.Name,
+		Category: req.Category,
+		MimeType: req.MimeType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.FileInfo, 0, len(rpcResp.List))
+	for _, info := range rpcResp.List {
+		list = append(list, convertFileInfo(info))
+	}
+
+	resp = &types.FileListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.FileListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			List: list,
+		},
+	}
+	return
+}
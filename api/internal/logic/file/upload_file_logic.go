@@ -0,0 +1,74 @@
+package file
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UploadFileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 上传文件(表单字段 file)
+func NewUploadFileLogic(r *http.Request, svcCtx *svc.ServiceContext) *UploadFileLogic {
+	return &UploadFileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UploadFileLogic) UploadFile() (resp *types.FileInfoResponse, err error) {
+	conf := l.svcCtx.Config.FileConf
+	f, header, err := l.r.FormFile("file")
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("file.invalid")
+	}
+	defer f.Close()
+	if header.Size > conf.MaxSize {
+		return nil, errorx.NewInvalidArgumentError("file.tooLarge")
+	}
+
+	// 按内容识别类型，不信任客户端声明的类型
+	mimeType, content, err := filetransfer.DetectType(f)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("file.invalid")
+	}
+	if !filetransfer.Allowed(mimeType, conf.AllowedTypes) {
+		return nil, errorx.NewInvalidArgumentError("file.typeNotAllowed")
+	}
+
+	info, err := filetransfer.Upload(l.ctx, l.svcCtx.FileRpc, content, &fileservice.FileInfo{
+		OwnerId:  pointer.ToStringPtr(l.ctx.Value("userId").(string)),
+		Name:     pointer.ToStringPtr(filepath.Base(header.Filename)),
+		Category: pointer.ToStringPtr("attachment"),
+		MimeType: pointer.ToStringPtr(mimeType),
+	}, conf.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.FileInfoResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: convertFileInfo(info),
+	}
+	return
+}
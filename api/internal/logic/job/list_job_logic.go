@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/jobservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListJobLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取定时任务列表
+func NewListJobLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListJobLogic {
+	return &ListJobLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListJobLogic) ListJob() (resp *types.JobListResponse, err error) {
+	rpcResp, err := l.svcCtx.JobRpc.ListJob(l.ctx, &jobservice.EmptyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.JobListResponse{
+		BaseDataInfo: types.BaseDataInfo{Code: 0, Message: "success"},
+		Data: types.JobListInfo{
+			BaseListInfo: types.BaseListInfo{Total: uint64(len(rpcResp.List))},
+			List:         make([]types.JobInfo, 0, len(rpcResp.List)),
+		},
+	}
+	for _, v := range rpcResp.List {
+		resp.Data.List = append(resp.Data.List, types.JobInfo{
+			Name:         v.Name,
+			Spec:         v.Spec,
+			Paused:       v.Paused,
+			LastRunAt:    v.LastRunAt,
+			LastStatus:   v.LastStatus,
+			LastTrigger:  v.LastTrigger,
+			LastDuration: v.LastDuration,
+			LastMessage:  v.LastMessage,
+			NextRunAt:    v.NextRunAt,
+		})
+	}
+	return resp, nil
+}
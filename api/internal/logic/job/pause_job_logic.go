@@ -0,0 +1,45 @@
+package job
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/jobservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PauseJobLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 暂停或恢复定时任务
+func NewPauseJobLogic(r *http.Request, svcCtx *svc.ServiceContext) *PauseJobLogic {
+	return &PauseJobLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *PauseJobLogic) PauseJob(req *types.JobPauseRequest) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.JobRpc.PauseJob(l.ctx, &jobservice.ScheduledJobPauseRequest{
+		Name:   req.Name,
+		Paused: req.Paused,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package job
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/jobservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TriggerJobLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 立即执行定时任务
+func NewTriggerJobLogic(r *http.Request, svcCtx *svc.ServiceContext) *TriggerJobLogic {
+	return &TriggerJobLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *TriggerJobLogic) TriggerJob(req *types.JobNameRequest) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.JobRpc.TriggerJob(l.ctx, &jobservice.StringRequest{
+		Value: req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package menu
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignApiToMenuLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 为菜单绑定API
+func NewAssignApiToMenuLogic(r *http.Request, svcCtx *svc.ServiceContext) *AssignApiToMenuLogic {
+	return &AssignApiToMenuLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *AssignApiToMenuLogic) AssignApiToMenu(req *types.MenuApiRequest) (resp *types.BaseResponse, err error) {
+	_, err = l.svcCtx.MenuRpc.AssignMenuApi(l.ctx, &core.MenuApiRequest{
+		MenuId: req.MenuId,
+		ApiIds: req.ApiIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: "success",
+	}
+	return
+}
@@ -56,6 +56,7 @@ func ConvertToMenuInfo(menus []*core.MenuInfo) []types.MenuInfo {
 		if m.Meta != nil {
 			meta = types.Meta{
 				Title:         pointer.GetString(m.Meta.Title),
+				TitleI18n:     m.MenuNameI18N,
 				Icon:          pointer.GetString(m.Meta.Icon),
 				Order:         m.Sort,
 				HideInMenu:    m.Meta.IsHidden,
@@ -0,0 +1,46 @@
+package menu
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMenuApiLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取菜单绑定的API
+func NewGetMenuApiLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetMenuApiLogic {
+	return &GetMenuApiLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetMenuApiLogic) GetMenuApi(req *types.ID32Request) (resp *types.MenuApiListResponse, err error) {
+	result, err := l.svcCtx.MenuRpc.GetMenuApi(l.ctx, &core.ID32Request{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.MenuApiListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: result.List,
+	}
+	return
+}
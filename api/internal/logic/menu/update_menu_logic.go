@@ -38,6 +38,7 @@ func (l *UpdateMenuLogic) UpdateMenu(req *types.MenuInfo) (resp *types.BaseRespo
 		Id:          req.ID,
 		MenuCode:    &req.Name,
 		MenuName:    menuName,
+		MenuNameI18N: req.Meta.TitleI18n,
 		ParentId:    req.ParentId,
 		MenuPath:    &req.Path,
 		State:       req.State,
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ArchiveNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 归档通知
+func NewArchiveNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *ArchiveNotificationLogic {
+	return &ArchiveNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ArchiveNotificationLogic) ArchiveNotification(req *types.MarkNotificationRequest) (resp *types.BaseResponse, err error) {
+	return markNotification(l.ctx, l.svcCtx, req, "archived")
+}
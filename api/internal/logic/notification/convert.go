@@ -0,0 +1,84 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/notificationservice"
+)
+
+// convertNotificationInfo 转换接口请求为 RPC 通知信息
+func convertNotificationInfo(req *types.NotificationInfo) *notificationservice.NotificationInfo {
+	return &notificationservice.NotificationInfo{
+		Id:            req.ID,
+		State:         req.State,
+		Title:         &req.Title,
+		Content:       req.Content,
+		Category:      req.Category,
+		Level:         req.Level,
+		TargetAll:     req.TargetAll,
+		UserIds:       req.UserIds,
+		RoleIds:       req.RoleIds,
+		DepartmentIds: req.DepartmentIds,
+		PublishAt:     req.PublishAt,
+		ExpireAt:      req.ExpireAt,
+	}
+}
+
+// convertNotificationList 转换通知列表响应
+func convertNotificationList(rpcResp *notificationservice.NotificationListResponse) *types.NotificationListResponse {
+	list := make([]types.NotificationInfo, 0, len(rpcResp.List))
+	for _, n := range rpcResp.List {
+		list = append(list, types.NotificationInfo{
+			ID:            n.Id,
+			CreatedAt:     n.CreatedAt,
+			UpdatedAt:     n.UpdatedAt,
+			State:         n.State,
+			Title:         n.GetTitle(),
+			Content:       n.Content,
+			Category:      n.Category,
+			Level:         n.Level,
+			TargetAll:     n.TargetAll,
+			UserIds:       n.UserIds,
+			RoleIds:       n.RoleIds,
+			DepartmentIds: n.DepartmentIds,
+			PublishAt:     n.PublishAt,
+			ExpireAt:      n.ExpireAt,
+			SenderId:      n.SenderId,
+			Status:        n.Status,
+			ReadAt:        n.ReadAt,
+		})
+	}
+
+	return &types.NotificationListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.NotificationListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			List: list,
+		},
+	}
+}
+
+// markNotification 标记当前用户通知的阅读状态
+func markNotification(ctx context.Context, svcCtx *svc.ServiceContext, req *types.MarkNotificationRequest, status string) (*types.BaseResponse, error) {
+	rpcResp, err := svcCtx.NotificationRpc.MarkNotification(ctx, &notificationservice.MarkNotificationRequest{
+		UserId: ctx.Value("userId").(string),
+		Ids:    req.Ids,
+		All:    req.All,
+		Status: status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
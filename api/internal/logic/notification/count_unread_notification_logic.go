@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/notificationservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CountUnreadNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取未读通知数量
+func NewCountUnreadNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *CountUnreadNotificationLogic {
+	return &CountUnreadNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CountUnreadNotificationLogic) CountUnreadNotification() (resp *types.NotificationCountResponse, err error) {
+	rpcResp, err := l.svcCtx.NotificationRpc.CountUnreadNotification(l.ctx, &notificationservice.UUIDRequest{
+		Id: l.ctx.Value("userId").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.NotificationCountResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.NotificationCountInfo{
+			Count: rpcResp.Count,
+		},
+	}, nil
+}
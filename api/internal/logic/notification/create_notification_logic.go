@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 发布通知公告
+func NewCreateNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *CreateNotificationLogic {
+	return &CreateNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CreateNotificationLogic) CreateNotification(req *types.NotificationInfo) (resp *types.BaseResponse, err error) {
+	// 发送人为当前管理员
+	info := convertNotificationInfo(req)
+	info.SenderId = pointer.ToStringPtr(l.ctx.Value("userId").(string))
+	rpcResp, err := l.svcCtx.NotificationRpc.CreateNotification(l.ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/notificationservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMyNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我的通知列表
+func NewListMyNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListMyNotificationLogic {
+	return &ListMyNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListMyNotificationLogic) ListMyNotification(req *types.MyNotificationListRequest) (resp *types.NotificationListResponse, err error) {
+	rpcResp, err := l.svcCtx.NotificationRpc.ListUserNotification(l.ctx, &notificationservice.UserNotificationListRequest{
+		Page:     pageutils.Request(req.Page),
+		UserId:   l.ctx.Value("userId").(string),
+		Status:   req.Status,
+		Category: req.Category,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertNotificationList(rpcResp), nil
+}
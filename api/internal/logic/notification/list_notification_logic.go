@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/notificationservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取通知公告列表
+func NewListNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListNotificationLogic {
+	return &ListNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListNotificationLogic) ListNotification(req *types.NotificationListRequest) (resp *types.NotificationListResponse, err error) {
+	rpcResp, err := l.svcCtx.NotificationRpc.ListNotification(l.ctx, &notificationservice.NotificationListRequest{
+		Page:     pageutils.Request(req.Page),
+		Title:    req.Title,
+		Category: req.Category,
+		State:    req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertNotificationList(rpcResp), nil
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReadNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 标记通知为已读
+func NewReadNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *ReadNotificationLogic {
+	return &ReadNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ReadNotificationLogic) ReadNotification(req *types.MarkNotificationRequest) (resp *types.BaseResponse, err error) {
+	return markNotification(l.ctx, l.svcCtx, req, "read")
+}
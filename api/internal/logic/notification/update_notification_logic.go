@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateNotificationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新通知公告
+func NewUpdateNotificationLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateNotificationLogic {
+	return &UpdateNotificationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateNotificationLogic) UpdateNotification(req *types.NotificationInfo) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.NotificationRpc.UpdateNotification(l.ctx, convertNotificationInfo(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteOauthProviderLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除第三方登录提供商
+func NewBatchDeleteOauthProviderLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeleteOauthProviderLogic {
+	return &BatchDeleteOauthProviderLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeleteOauthProviderLogic) BatchDeleteOauthProvider(req *types.ID32SRequest) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.OauthRpc.BatchDeleteOauthProvider(l.ctx, &oauthproviderservice.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
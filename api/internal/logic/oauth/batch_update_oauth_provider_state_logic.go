@@ -0,0 +1,43 @@
+package oauth
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateOauthProviderStateLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量启用或禁用第三方登录提供商
+func NewBatchUpdateOauthProviderStateLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchUpdateOauthProviderStateLogic {
+	return &BatchUpdateOauthProviderStateLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchUpdateOauthProviderStateLogic) BatchUpdateOauthProviderState(req *types.BatchStateID32Request) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.OauthRpc.BatchUpdateOauthProviderState(l.ctx, &oauthproviderservice.BatchStateID32Request{
+		Ids:   req.Ids,
+		State: req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
 
 	"net/http"
@@ -33,10 +34,7 @@ func NewListOauthProviderLogic(r *http.Request, svcCtx *svc.ServiceContext) *Lis
 func (l *ListOauthProviderLogic) ListOauthProvider(req *types.OauthProviderListRequest) (resp *types.OauthProviderListResponse, err error) {
 	// 构建 RPC 请求
 	rpcReq := &oauthproviderservice.OauthProviderListRequest{
-		Page: &oauthproviderservice.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page: pageutils.Request(req.Page),
 		ProviderName: pointer.ToStringPtrIfNotEmpty(req.ProviderName),
 		ProviderCode: pointer.ToStringPtrIfNotEmpty(req.ProviderCode),
 		State:        req.State,
@@ -66,8 +64,6 @@ func (l *ListOauthProviderLogic) ListOauthProvider(req *types.OauthProviderListR
 			List: apiList,
 		},
 	},nil
-
-	return resp, nil
 }
 
 // convertRpcOauthProviderInfoToApiOauthProviderInfo 将 RPC OauthProviderInfo 转换为 API OauthProviderInfo
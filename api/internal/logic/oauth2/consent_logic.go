@@ -0,0 +1,70 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConsentLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 确认或拒绝授权
+func NewConsentLogic(r *http.Request, svcCtx *svc.ServiceContext) *ConsentLogic {
+	return &ConsentLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ConsentLogic) Consent(req *types.OauthConsentRequest) (resp *types.OauthConsentResultResponse, err error) {
+	var redirectUri string
+	authorizeReq := ConvertApiOauthAuthorizeRequest(&req.OauthAuthorizeRequest)
+	if req.Approve {
+		userId := l.ctx.Value("userId").(string)
+		// 首次授权或扩大授权范围时保存授权记录，记录为绑定事件
+		result, err := l.svcCtx.Oidc.CheckAuthorize(l.ctx, authorizeReq, userId)
+		if err != nil {
+			return nil, transAuthorizeError(err)
+		}
+		if redirectUri, err = l.svcCtx.Oidc.Approve(l.ctx, authorizeReq, userId, authTime(l.ctx)); err != nil {
+			return nil, transAuthorizeError(err)
+		}
+		if result.ConsentRequired {
+			securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+				Type:     securityevent.TypeOauthBind,
+				UserID:   userId,
+				Success:  true,
+				Provider: fmt.Sprintf("%s:%s", securityevent.ProviderOauth2, result.Client.ID),
+				Reason:   strings.Join(result.Scopes, " "),
+			})
+		}
+	} else if redirectUri, err = l.svcCtx.Oidc.Deny(l.ctx, authorizeReq); err != nil {
+		return nil, transAuthorizeError(err)
+	}
+
+	resp = &types.OauthConsentResultResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OauthRedirectInfo{
+			RedirectUri: redirectUri,
+		},
+	}
+	return
+}
@@ -0,0 +1,99 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/oidc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// ConvertApiOauthAuthorizeRequest 将授权请求转换为授权服务的请求参数
+func ConvertApiOauthAuthorizeRequest(req *types.OauthAuthorizeRequest) *oidc.AuthorizeRequest {
+	return &oidc.AuthorizeRequest{
+		ResponseType:        req.ResponseType,
+		ClientID:            req.ClientId,
+		RedirectURI:         req.RedirectUri,
+		Scope:               pointer.GetString(req.Scope),
+		State:               pointer.GetString(req.State),
+		Nonce:               pointer.GetString(req.Nonce),
+		CodeChallenge:       pointer.GetString(req.CodeChallenge),
+		CodeChallengeMethod: pointer.GetString(req.CodeChallengeMethod),
+	}
+}
+
+// ConvertRpcOauthClientInfoToApiOauthClientInfo 将 RPC OauthClientInfo 转换为 API OauthClientInfo
+func ConvertRpcOauthClientInfoToApiOauthClientInfo(client *oauthclientservice.OauthClientInfo) types.OauthClientInfo {
+	if client == nil {
+		return types.OauthClientInfo{}
+	}
+
+	return types.OauthClientInfo{
+		ID:           client.Id,
+		CreatedAt:    client.CreatedAt,
+		UpdatedAt:    client.UpdatedAt,
+		State:        client.State,
+		ClientId:     client.ClientId,
+		Name:         pointer.GetString(client.Name),
+		RedirectUris: client.RedirectUris,
+		GrantTypes:   client.GrantTypes,
+		Scopes:       client.Scopes,
+		Public:       client.Public,
+		SkipConsent:  client.SkipConsent,
+		Logo:         client.Logo,
+		Description:  client.Description,
+	}
+}
+
+// ConvertApiOauthClientInfoToRpcOauthClientInfo 将 API OauthClientInfo 转换为 RPC OauthClientInfo
+func ConvertApiOauthClientInfoToRpcOauthClientInfo(client *types.OauthClientInfo) *oauthclientservice.OauthClientInfo {
+	return &oauthclientservice.OauthClientInfo{
+		Id:           client.ID,
+		State:        client.State,
+		ClientId:     client.ClientId,
+		Name:         pointer.ToStringPtr(client.Name),
+		RedirectUris: client.RedirectUris,
+		GrantTypes:   client.GrantTypes,
+		Scopes:       client.Scopes,
+		Public:       client.Public,
+		SkipConsent:  client.SkipConsent,
+		Logo:         client.Logo,
+		Description:  client.Description,
+		ResetSecret:  client.ResetSecret,
+	}
+}
+
+// oauthErrorKeys 授权请求错误对应的 i18n 键
+var oauthErrorKeys = map[string]string{
+	oidc.ErrInvalidClient.Code:           "oauth2.invalidClient",
+	oidc.ErrUnauthorizedClient.Code:      "oauth2.unauthorizedClient",
+	oidc.ErrUnsupportedResponseType.Code: "oauth2.unsupportedResponseType",
+	oidc.ErrInvalidScope.Code:            "oauth2.invalidScope",
+}
+
+// transAuthorizeError 将授权服务的协议错误转换为接口错误
+func transAuthorizeError(err error) error {
+	var oauthErr *oidc.Error
+	if !errors.As(err, &oauthErr) {
+		return err
+	}
+	if key, ok := oauthErrorKeys[oauthErr.Code]; ok {
+		return errorx.NewApiBadRequestError(key)
+	}
+	return errorx.NewApiBadRequestError("oauth2.invalidRequest")
+}
+
+// authTime 用户登录时间，取自登录令牌的签发时间
+func authTime(ctx context.Context) time.Time {
+	if iat, ok := ctx.Value("iat").(json.Number); ok {
+		if unix, err := iat.Int64(); err == nil {
+			return time.Unix(unix, 0)
+		}
+	}
+	return time.Now()
+}
@@ -0,0 +1,48 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthClientLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建客户端应用
+func NewCreateOauthClientLogic(r *http.Request, svcCtx *svc.ServiceContext) *CreateOauthClientLogic {
+	return &CreateOauthClientLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CreateOauthClientLogic) CreateOauthClient(req *types.OauthClientInfo) (resp *types.OauthClientSecretResponse, err error) {
+	rpcResp, err := l.svcCtx.OauthClientRpc.CreateOauthClient(l.ctx, ConvertApiOauthClientInfoToRpcOauthClientInfo(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.OauthClientSecretResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "oauthClient.created",
+		},
+		Data: types.OauthClientSecretInfo{
+			OauthClientInfo: ConvertRpcOauthClientInfoToApiOauthClientInfo(rpcResp.Info),
+			ClientSecret:    rpcResp.ClientSecret,
+		},
+	}
+	return
+}
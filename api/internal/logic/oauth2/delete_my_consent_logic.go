@@ -0,0 +1,56 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteMyConsentLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 撤销对应用的授权
+func NewDeleteMyConsentLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteMyConsentLogic {
+	return &DeleteMyConsentLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteMyConsentLogic) DeleteMyConsent(req *types.OauthConsentDeleteRequest) (resp *types.BaseResponse, err error) {
+	// 刷新令牌在刷新时重新校验授权，删除授权后该客户端已签发的刷新令牌即不可用
+	userId := l.ctx.Value("userId").(string)
+	rpcResp, err := l.svcCtx.OauthClientRpc.DeleteOauthConsent(l.ctx, &oauthclientservice.OauthConsentRequest{
+		UserId:   userId,
+		ClientId: req.ClientId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+		Type:     securityevent.TypeOauthUnbind,
+		UserID:   userId,
+		Success:  true,
+		Provider: fmt.Sprintf("%s:%s", securityevent.ProviderOauth2, req.ClientId),
+	})
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
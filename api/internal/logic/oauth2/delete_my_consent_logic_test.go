@@ -0,0 +1,61 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+	"google.golang.org/grpc"
+)
+
+const testUserID = "0192f6c4-7e2a-7000-8000-000000000001"
+
+// fakeOauthClientRpc 记录撤销授权的请求
+type fakeOauthClientRpc struct {
+	oauthclientservice.OauthClientService
+	deleted *oauthclientservice.OauthConsentRequest
+}
+
+func (f *fakeOauthClientRpc) DeleteOauthConsent(_ context.Context, in *oauthclientservice.OauthConsentRequest, _ ...grpc.CallOption) (*oauthclientservice.BaseResponse, error) {
+	f.deleted = in
+	return &oauthclientservice.BaseResponse{}, nil
+}
+
+// fakeSecurityEventRpc 记录写入的安全事件
+type fakeSecurityEventRpc struct {
+	securityeventservice.SecurityEventService
+	events []*securityeventservice.SecurityEventInfo
+}
+
+func (f *fakeSecurityEventRpc) CreateSecurityEvent(_ context.Context, in *securityeventservice.SecurityEventInfo, _ ...grpc.CallOption) (*securityeventservice.BaseResponse, error) {
+	f.events = append(f.events, in)
+	return &securityeventservice.BaseResponse{}, nil
+}
+
+func TestDeleteMyConsentRecordsUnbind(t *testing.T) {
+	clients := &fakeOauthClientRpc{}
+	events := &fakeSecurityEventRpc{}
+	svcCtx := &svc.ServiceContext{OauthClientRpc: clients, SecurityEventRpc: events}
+	r := httptest.NewRequest(http.MethodPost, "/oauth2/consent/delete", nil)
+	r = r.WithContext(context.WithValue(r.Context(), "userId", testUserID))
+
+	if _, err := NewDeleteMyConsentLogic(r, svcCtx).DeleteMyConsent(&types.OauthConsentDeleteRequest{ClientId: "demo"}); err != nil {
+		t.Fatal(err)
+	}
+	if clients.deleted == nil || clients.deleted.UserId != testUserID || clients.deleted.ClientId != "demo" {
+		t.Fatalf("delete request = %+v", clients.deleted)
+	}
+	if len(events.events) != 1 {
+		t.Fatalf("recorded %d events, want 1", len(events.events))
+	}
+	e := events.events[0]
+	if e.GetEventType() != securityevent.TypeOauthUnbind || e.GetUserId() != testUserID || e.GetProvider() != "oauth2:demo" {
+		t.Fatalf("event = %+v", e)
+	}
+}
@@ -0,0 +1,45 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthClientLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除客户端应用
+func NewDeleteOauthClientLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteOauthClientLogic {
+	return &DeleteOauthClientLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteOauthClientLogic) DeleteOauthClient(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.OauthClientRpc.DeleteOauthClient(l.ctx, &oauthclientservice.ID32Request{
+		Id: req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
@@ -0,0 +1,52 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAuthorizeInfoLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取授权请求信息
+func NewGetAuthorizeInfoLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetAuthorizeInfoLogic {
+	return &GetAuthorizeInfoLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetAuthorizeInfoLogic) GetAuthorizeInfo(req *types.OauthAuthorizeRequest) (resp *types.OauthAuthorizeInfoResponse, err error) {
+	result, err := l.svcCtx.Oidc.CheckAuthorize(l.ctx, ConvertApiOauthAuthorizeRequest(req), l.ctx.Value("userId").(string))
+	if err != nil {
+		return nil, transAuthorizeError(err)
+	}
+
+	resp = &types.OauthAuthorizeInfoResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OauthAuthorizeInfo{
+			ClientId:        result.Client.ID,
+			ClientName:      result.Client.Name,
+			ClientLogo:      pointer.ToStringPtrIfNotEmpty(result.Client.Logo),
+			Scopes:          result.Scopes,
+			ConsentRequired: result.ConsentRequired,
+		},
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type JwksLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 签名公钥
+func NewJwksLogic(r *http.Request, svcCtx *svc.ServiceContext) *JwksLogic {
+	return &JwksLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// 输出签名公钥集合
+func (l *JwksLogic) Jwks(w http.ResponseWriter) {
+	l.svcCtx.Oidc.JWKS(w, l.r)
+}
@@ -0,0 +1,62 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMyConsentLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我授权的应用
+func NewListMyConsentLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListMyConsentLogic {
+	return &ListMyConsentLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListMyConsentLogic) ListMyConsent() (resp *types.OauthConsentListResponse, err error) {
+	rpcResp, err := l.svcCtx.OauthClientRpc.ListOauthConsent(l.ctx, &oauthclientservice.UUIDRequest{
+		Id: l.ctx.Value("userId").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.OauthConsentInfo, 0, len(rpcResp.List))
+	for _, consent := range rpcResp.List {
+		list = append(list, types.OauthConsentInfo{
+			ClientId:   consent.ClientId,
+			ClientName: consent.ClientName,
+			ClientLogo: consent.ClientLogo,
+			Scopes:     consent.Scopes,
+			CreatedAt:  consent.CreatedAt,
+			UpdatedAt:  consent.UpdatedAt,
+		})
+	}
+
+	resp = &types.OauthConsentListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OauthConsentListInfo{
+			List: list,
+		},
+	}
+	return
+}
@@ -0,0 +1,61 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOauthClientLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取客户端应用列表
+func NewListOauthClientLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListOauthClientLogic {
+	return &ListOauthClientLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListOauthClientLogic) ListOauthClient(req *types.OauthClientListRequest) (resp *types.OauthClientListResponse, err error) {
+	rpcResp, err := l.svcCtx.OauthClientRpc.ListOauthClient(l.ctx, &oauthclientservice.OauthClientListRequest{
+		Page: pageutils.Request(req.Page),
+		Name:     req.Name,
+		ClientId: req.ClientId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.OauthClientInfo, 0, len(rpcResp.List))
+	for _, client := range rpcResp.List {
+		list = append(list, ConvertRpcOauthClientInfoToApiOauthClientInfo(client))
+	}
+
+	resp = &types.OauthClientListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OauthClientListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			List: list,
+		},
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TokenLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 令牌端点
+func NewTokenLogic(r *http.Request, svcCtx *svc.ServiceContext) *TokenLogic {
+	return &TokenLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// 认证客户端并按授权类型签发令牌，响应遵循 RFC 6749
+func (l *TokenLogic) Token(w http.ResponseWriter) {
+	l.svcCtx.Oidc.Token(w, l.r)
+}
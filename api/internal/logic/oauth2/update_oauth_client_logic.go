@@ -0,0 +1,48 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthClientLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新客户端应用
+func NewUpdateOauthClientLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateOauthClientLogic {
+	return &UpdateOauthClientLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateOauthClientLogic) UpdateOauthClient(req *types.OauthClientInfo) (resp *types.OauthClientSecretResponse, err error) {
+	rpcResp, err := l.svcCtx.OauthClientRpc.UpdateOauthClient(l.ctx, ConvertApiOauthClientInfoToRpcOauthClientInfo(req))
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.OauthClientSecretResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "common.updateSuccess",
+		},
+		Data: types.OauthClientSecretInfo{
+			OauthClientInfo: ConvertRpcOauthClientInfoToApiOauthClientInfo(rpcResp.Info),
+			ClientSecret:    rpcResp.ClientSecret,
+		},
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UserInfoLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 用户信息端点
+func NewUserInfoLogic(r *http.Request, svcCtx *svc.ServiceContext) *UserInfoLogic {
+	return &UserInfoLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// 输出访问令牌对应的用户声明
+func (l *UserInfoLogic) UserInfo(w http.ResponseWriter) {
+	l.svcCtx.Oidc.UserInfo(w, l.r)
+}
@@ -0,0 +1,32 @@
+package oauth2
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UserInfoPostLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 用户信息端点(POST)
+func NewUserInfoPostLogic(r *http.Request, svcCtx *svc.ServiceContext) *UserInfoPostLogic {
+	return &UserInfoPostLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// POST 方式，访问令牌同样通过 Authorization 头传递
+func (l *UserInfoPostLogic) UserInfoPost(w http.ResponseWriter) {
+	l.svcCtx.Oidc.UserInfo(w, l.r)
+}
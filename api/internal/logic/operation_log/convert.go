@@ -0,0 +1,98 @@
+package operation_log
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/operationlogservice"
+)
+
+// operationLogListRequest 将筛选条件转换为 RPC 列表请求
+func operationLogListRequest(filter types.OperationLogFilter) *operationlogservice.OperationLogListRequest {
+	in := &operationlogservice.OperationLogListRequest{
+		UserId:        filter.UserId,
+		Username:      filter.Username,
+		OperationType: filter.OperationType,
+		Module:        filter.Module,
+		BusinessType:  filter.BusinessType,
+		Method:        filter.Method,
+		RequestUrl:    filter.RequestUrl,
+		RequestParams: filter.RequestParams,
+		ResponseData:  filter.ResponseData,
+		IpAddress:     filter.IpAddress,
+		UserAgent:     filter.UserAgent,
+		Location:      filter.Location,
+		IsSuccess:     filter.IsSuccess,
+	}
+	if filter.StartTime != nil || filter.EndTime != nil {
+		in.ExecutionTime = &operationlogservice.TimeRangeQuery{}
+		if filter.StartTime != nil {
+			in.ExecutionTime.StartTime = pointer.ToStringPtr(strconv.FormatInt(*filter.StartTime, 10))
+		}
+		if filter.EndTime != nil {
+			in.ExecutionTime.EndTime = pointer.ToStringPtr(strconv.FormatInt(*filter.EndTime, 10))
+		}
+	}
+	return in
+}
+
+// convertOperationLogInfo 转换操作记录
+func convertOperationLogInfo(log *operationlogservice.OperationLogInfo) types.OperationLogInfo {
+	return types.OperationLogInfo{
+		ID:            log.Id,
+		CreatedAt:     log.CreatedAt,
+		UserId:        log.UserId,
+		Username:      log.Username,
+		OperationType: log.OperationType,
+		Module:        log.Module,
+		BusinessType:  log.BusinessType,
+		Method:        log.Method,
+		RequestUrl:    log.RequestUrl,
+		RequestParams: log.RequestParams,
+		ResponseData:  log.ResponseData,
+		IpAddress:     log.IpAddress,
+		UserAgent:     log.UserAgent,
+		Location:      log.Location,
+		IsSuccess:     log.IsSuccess,
+		ErrorMessage:  log.ErrorMessage,
+		ExecutionTime: log.ExecutionTime,
+		Description:   log.Description,
+	}
+}
+
+// operationLogHeader CSV 导出的表头
+var operationLogHeader = []string{
+	"id", "createdAt", "userId", "username", "operationType", "module", "businessType",
+	"method", "requestUrl", "requestParams", "responseData", "ipAddress", "userAgent",
+	"location", "isSuccess", "errorMessage", "executionTime", "description",
+}
+
+// operationLogRecord 转换为 CSV 导出的一行
+func operationLogRecord(log *operationlogservice.OperationLogInfo) []string {
+	var createdAt string
+	if log.CreatedAt != nil {
+		createdAt = time.UnixMilli(*log.CreatedAt).Format(time.RFC3339)
+	}
+	return []string{
+		strconv.FormatUint(uint64(pointer.GetUint32(log.Id)), 10),
+		createdAt,
+		pointer.GetString(log.UserId),
+		pointer.GetString(log.Username),
+		pointer.GetString(log.OperationType),
+		pointer.GetString(log.Module),
+		pointer.GetString(log.BusinessType),
+		pointer.GetString(log.Method),
+		pointer.GetString(log.RequestUrl),
+		pointer.GetString(log.RequestParams),
+		pointer.GetString(log.ResponseData),
+		pointer.GetString(log.IpAddress),
+		pointer.GetString(log.UserAgent),
+		pointer.GetString(log.Location),
+		strconv.FormatBool(pointer.GetBool(log.IsSuccess)),
+		pointer.GetString(log.ErrorMessage),
+		strconv.FormatInt(int64(pointer.GetInt32(log.ExecutionTime)), 10),
+		pointer.GetString(log.Description),
+	}
+}
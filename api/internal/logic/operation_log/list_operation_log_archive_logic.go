@@ -0,0 +1,71 @@
+package operation_log
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/operationlogservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOperationLogArchiveLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取操作记录归档列表
+func NewListOperationLogArchiveLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListOperationLogArchiveLogic {
+	return &ListOperationLogArchiveLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListOperationLogArchiveLogic) ListOperationLogArchive(req *types.OperationLogArchiveListRequest) (resp *types.OperationLogArchiveListResponse, err error) {
+	rpcResp, err := l.svcCtx.OperationLogRpc.ListOperationLogArchive(l.ctx, &operationlogservice.OperationLogArchiveListRequest{
+		Page:      pageutils.Request(req.Page),
+		Module:    req.Module,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.OperationLogArchiveInfo, 0, len(rpcResp.List))
+	for _, archive := range rpcResp.List {
+		list = append(list, types.OperationLogArchiveInfo{
+			ID:         archive.Id,
+			CreatedAt:  archive.CreatedAt,
+			Module:     archive.Module,
+			StartTime:  archive.StartTime,
+			EndTime:    archive.EndTime,
+			RowCount:   archive.RowCount,
+			Size:       archive.Size,
+			Checksum:   archive.Checksum,
+			RestoredAt: archive.RestoredAt,
+		})
+	}
+
+	return &types.OperationLogArchiveListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OperationLogArchiveListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			List: list,
+		},
+	}, nil
+}
@@ -0,0 +1,58 @@
+package operation_log
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOperationLogLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取操作记录列表
+func NewListOperationLogLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListOperationLogLogic {
+	return &ListOperationLogLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListOperationLogLogic) ListOperationLog(req *types.OperationLogListRequest) (resp *types.OperationLogListResponse, err error) {
+	in := operationLogListRequest(req.OperationLogFilter)
+	in.Page = pageutils.Request(req.Page)
+	rpcResp, err := l.svcCtx.OperationLogRpc.ListOperationLog(l.ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.OperationLogInfo, 0, len(rpcResp.List))
+	for _, log := range rpcResp.List {
+		list = append(list, convertOperationLogInfo(log))
+	}
+
+	return &types.OperationLogListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.OperationLogListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			CursorInfo: pageutils.CursorInfo(rpcResp.Page),
+			List:       list,
+		},
+	}, nil
+}
@@ -0,0 +1,44 @@
+package operation_log
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/operationlogservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreOperationLogArchiveLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 恢复操作记录归档
+func NewRestoreOperationLogArchiveLogic(r *http.Request, svcCtx *svc.ServiceContext) *RestoreOperationLogArchiveLogic {
+	return &RestoreOperationLogArchiveLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *RestoreOperationLogArchiveLogic) RestoreOperationLogArchive(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.OperationLogRpc.RestoreOperationLogArchive(l.ctx, &operationlogservice.ID32Request{
+		Id: req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePermissionSnapshotLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 创建当前权限的快照
+func NewCreatePermissionSnapshotLogic(r *http.Request, svcCtx *svc.ServiceContext) *CreatePermissionSnapshotLogic {
+	return &CreatePermissionSnapshotLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CreatePermissionSnapshotLogic) CreatePermissionSnapshot(req *types.PermissionSnapshotCreateRequest) (resp *types.PermissionSnapshotResponse, err error) {
+	snapshot, err := l.svcCtx.PermissionReportRpc.CreatePermissionSnapshot(l.ctx, &core.PermissionSnapshotInfo{
+		Remark:    req.Remark,
+		CreatorId: pointer.ToStringPtr(l.ctx.Value("userId").(string)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.PermissionSnapshotResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: convertPermissionSnapshot(snapshot),
+	}
+	return
+}
@@ -0,0 +1,43 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePermissionSnapshotLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除权限快照
+func NewDeletePermissionSnapshotLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeletePermissionSnapshotLogic {
+	return &DeletePermissionSnapshotLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeletePermissionSnapshotLogic) DeletePermissionSnapshot(req *types.ID32SRequest) (resp *types.BaseResponse, err error) {
+	result, err := l.svcCtx.PermissionReportRpc.DeletePermissionSnapshot(l.ctx, &core.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: result.Message,
+	}
+	return
+}
@@ -0,0 +1,52 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffPermissionSnapshotLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 对比两个权限快照，或快照与当前权限的差异
+func NewDiffPermissionSnapshotLogic(r *http.Request, svcCtx *svc.ServiceContext) *DiffPermissionSnapshotLogic {
+	return &DiffPermissionSnapshotLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DiffPermissionSnapshotLogic) DiffPermissionSnapshot(req *types.PermissionSnapshotDiffRequest) (resp *types.PermissionSnapshotDiffResponse, err error) {
+	result, err := l.svcCtx.PermissionReportRpc.DiffPermissionSnapshot(l.ctx, &core.PermissionSnapshotDiffRequest{
+		FromId: req.FromId,
+		ToId:   req.ToId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.PermissionSnapshotDiffResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.PermissionSnapshotDiff{
+			Added:   convertPermissionEntries(result.Added),
+			Removed: convertPermissionEntries(result.Removed),
+		},
+	}
+	return
+}
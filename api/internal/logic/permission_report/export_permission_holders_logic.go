@@ -0,0 +1,56 @@
+package permission_report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportPermissionHoldersLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出权限持有人(CSV/XLSX)
+func NewExportPermissionHoldersLogic(r *http.Request, svcCtx *svc.ServiceContext) *ExportPermissionHoldersLogic {
+	return &ExportPermissionHoldersLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ExportPermissionHoldersLogic) ExportPermissionHolders(req *types.PermissionHolderExportRequest) (data []byte, filename string, err error) {
+	result, err := l.svcCtx.PermissionReportRpc.GetPermissionHolders(l.ctx, &core.PermissionHolderRequest{
+		Kind:   req.Kind,
+		Object: req.Object,
+		Action: req.Action,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows := make([][]string, 0, len(result.List))
+	for _, e := range result.List {
+		rows = append(rows, permissionEntryRecord(e))
+	}
+	if data, err = writeReportFile(req.Format, permissionEntryHeader, rows); err != nil {
+		l.Errorw("生成权限持有人报表失败", logx.Field("detail", err.Error()))
+		return nil, "", errorx.NewInternalError("permissionReport.exportFailed")
+	}
+
+	filename = fmt.Sprintf("permission-holders-%s.%s", time.Now().Format("20060102150405"), req.Format)
+	return data, filename, nil
+}
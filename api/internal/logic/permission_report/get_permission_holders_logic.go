@@ -0,0 +1,52 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionHoldersLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取持有接口、菜单或配置项权限的角色及用户
+func NewGetPermissionHoldersLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetPermissionHoldersLogic {
+	return &GetPermissionHoldersLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetPermissionHoldersLogic) GetPermissionHolders(req *types.PermissionHolderRequest) (resp *types.PermissionHolderResponse, err error) {
+	result, err := l.svcCtx.PermissionReportRpc.GetPermissionHolders(l.ctx, &core.PermissionHolderRequest{
+		Kind:   req.Kind,
+		Object: req.Object,
+		Action: req.Action,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.PermissionHolderResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.PermissionHolderListInfo{
+			List: convertPermissionEntries(result.List),
+		},
+	}
+	return
+}
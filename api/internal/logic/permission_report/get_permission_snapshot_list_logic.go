@@ -0,0 +1,59 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionSnapshotListLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取权限快照列表
+func NewGetPermissionSnapshotListLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetPermissionSnapshotListLogic {
+	return &GetPermissionSnapshotListLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetPermissionSnapshotListLogic) GetPermissionSnapshotList(req *types.PageRequest) (resp *types.PermissionSnapshotListResponse, err error) {
+	result, err := l.svcCtx.PermissionReportRpc.GetPermissionSnapshotList(l.ctx, &core.PermissionSnapshotListRequest{
+		Page: pageutils.Request(req.Page),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.PermissionSnapshotInfo, 0, len(result.List))
+	for _, s := range result.List {
+		list = append(list, convertPermissionSnapshot(s))
+	}
+
+	resp = &types.PermissionSnapshotListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.PermissionSnapshotListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: result.Page.Total,
+			},
+			List: list,
+		},
+	}
+	return
+}
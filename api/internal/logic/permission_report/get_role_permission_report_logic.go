@@ -0,0 +1,52 @@
+package permission_report
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRolePermissionReportLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取角色的全部权限及持有用户
+func NewGetRolePermissionReportLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetRolePermissionReportLogic {
+	return &GetRolePermissionReportLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetRolePermissionReportLogic) GetRolePermissionReport(req *types.ID32Request) (resp *types.RolePermissionReportResponse, err error) {
+	report, err := l.svcCtx.PermissionReportRpc.GetRolePermissionReport(l.ctx, &core.ID32Request{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.RolePermissionReportResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.RolePermissionReport{
+			RoleId:    report.RoleId,
+			RoleValue: report.RoleValue,
+			RoleName:  report.RoleName,
+			State:     report.State,
+			Entries:   convertPermissionEntries(report.Entries),
+		},
+	}
+	return
+}
@@ -0,0 +1,99 @@
+package permission_report
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/xuri/excelize/v2"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// 报表导出文件格式
+const (
+	ReportFileCSV  = "csv"
+	ReportFileXLSX = "xlsx"
+)
+
+// 差异导出中的变更类型
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+)
+
+var permissionEntryHeader = []string{"类型", "角色", "资源", "操作", "名称", "说明"}
+
+// ReportContentType 获取导出文件的内容类型
+func ReportContentType(format string) string {
+	if format == ReportFileXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+func permissionEntryRecord(e *core.PermissionEntry) []string {
+	return []string{e.Kind, e.RoleValue, e.Object, e.Action, e.Label, e.Detail}
+}
+
+// writeReportFile 生成 CSV 或 XLSX 文件，首行为表头
+func writeReportFile(format string, header []string, rows [][]string) ([]byte, error) {
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, header)
+	records = append(records, rows...)
+
+	var buf bytes.Buffer
+	switch format {
+	case ReportFileCSV:
+		// 写入 BOM，便于 Excel 正确识别 UTF-8
+		buf.WriteString("\xEF\xBB\xBF")
+		w := csv.NewWriter(&buf)
+		if err := w.WriteAll(records); err != nil {
+			return nil, err
+		}
+	case ReportFileXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+		sheet := f.GetSheetName(0)
+		for i, record := range records {
+			cellName, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			if err = f.SetSheetRow(sheet, cellName, &record); err != nil {
+				return nil, err
+			}
+		}
+		if err := f.Write(&buf); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorx.NewInvalidArgumentError("common.validationFailed")
+	}
+	return buf.Bytes(), nil
+}
+
+func convertPermissionEntries(entries []*core.PermissionEntry) []types.PermissionEntry {
+	list := make([]types.PermissionEntry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, types.PermissionEntry{
+			Kind:      e.Kind,
+			RoleValue: e.RoleValue,
+			Object:    e.Object,
+			Action:    e.Action,
+			Label:     e.Label,
+			Detail:    e.Detail,
+		})
+	}
+	return list
+}
+
+func convertPermissionSnapshot(s *core.PermissionSnapshotInfo) types.PermissionSnapshotInfo {
+	return types.PermissionSnapshotInfo{
+		ID:         s.Id,
+		CreatedAt:  s.CreatedAt,
+		Remark:     s.Remark,
+		CreatorId:  s.CreatorId,
+		EntryCount: s.EntryCount,
+	}
+}
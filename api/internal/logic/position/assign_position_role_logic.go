@@ -0,0 +1,46 @@
+package position
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignPositionRoleLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 为岗位分配角色
+func NewAssignPositionRoleLogic(r *http.Request, svcCtx *svc.ServiceContext) *AssignPositionRoleLogic {
+	return &AssignPositionRoleLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *AssignPositionRoleLogic) AssignPositionRole(req *types.PositionRoleRequest) (resp *types.BaseResponse, err error) {
+	rpcResp, err := l.svcCtx.PositionRpc.AssignPositionRole(l.ctx, &core.PositionRoleRequest{
+		PositionId: req.PositionId,
+		RoleIds:    req.RoleIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
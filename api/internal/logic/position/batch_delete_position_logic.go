@@ -0,0 +1,40 @@
+package position
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/positionservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeletePositionLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除岗位
+func NewBatchDeletePositionLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeletePositionLogic {
+	return &BatchDeletePositionLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeletePositionLogic) BatchDeletePosition(req *types.ID32SRequest) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.PositionRpc.BatchDeletePosition(l.ctx, &positionservice.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
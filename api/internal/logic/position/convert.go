@@ -17,10 +17,12 @@ func ConvertRpcPositionInfoToApiPositionInfo(rpcPos *positionservice.PositionInf
 		CreatedAt:    rpcPos.CreatedAt,
 		UpdatedAt:    rpcPos.UpdatedAt,
 		PositionName: pointer.GetString(rpcPos.PositionName),
+		PositionNameI18n: rpcPos.PositionNameI18N,
 		PositionCode: pointer.GetString(rpcPos.PositionCode),
 		SortOrder:    pointer.GetInt32(rpcPos.SortOrder),
 		State:        rpcPos.State,
 		Description:  rpcPos.Description,
+		RoleIds:      rpcPos.RoleIds,
 	}
 }
 
@@ -35,6 +37,7 @@ func ConvertApiPositionInfoToRpcPositionInfo(apiPos *types.PositionInfo) *positi
 		CreatedAt:    apiPos.CreatedAt,
 		UpdatedAt:    apiPos.UpdatedAt,
 		PositionName: pointer.ToStringPtrIfNotEmpty(apiPos.PositionName),
+		PositionNameI18N: apiPos.PositionNameI18n,
 		PositionCode: pointer.ToStringPtrIfNotEmpty(apiPos.PositionCode),
 		SortOrder:    pointer.ToInt32PtrIfNotZero(apiPos.SortOrder),
 		State:        apiPos.State,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"net/http"
@@ -31,10 +32,7 @@ func NewListPositionLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListPosi
 
 func (l *ListPositionLogic) ListPosition(req *types.PositionListRequest) (resp *types.PositionListResponse, err error) {
 	positionList, err := l.svcCtx.PositionRpc.ListPosition(l.ctx, &core.PositionListRequest{
-		Page: &core.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:         pageutils.Request(req.Page),
 		PositionName: &req.PositionName,
 		PositionCode: &req.PositionCode,
 	})
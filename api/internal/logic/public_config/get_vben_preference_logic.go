@@ -37,14 +37,15 @@ func (l *GetVbenPreferenceLogic) GetVbenPreference() (resp *types.VbenPreference
 		Value: enums.ConfigurationVBenPreference,
 	})
 	if err != nil {
-		return nil, errorx.NewApiError(errorx.CodeInternalError, err.Error())
+		return nil, err
 	}
 
 	// 将 result.Value 转 map[string]interface{}
 	var data map[string]interface{}
 	err = json.Unmarshal([]byte(result.Value), &data)
 	if err != nil {
-		return nil, errorx.NewApiError(errorx.CodeInternalError, err.Error())
+		l.Errorw("解析VBen Preference配置失败", logx.Field("detail", err.Error()))
+		return nil, errorx.NewApiError(errorx.CodeInternalError, "common.configuration.invalidValue")
 	}
 
 	resp = &types.VbenPreference{
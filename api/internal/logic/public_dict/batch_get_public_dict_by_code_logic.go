@@ -0,0 +1,50 @@
+package public_dict
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/dict"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchGetPublicDictByCodeLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 根据编码批量获取公开字典
+func NewBatchGetPublicDictByCodeLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchGetPublicDictByCodeLogic {
+	return &BatchGetPublicDictByCodeLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchGetPublicDictByCodeLogic) BatchGetPublicDictByCode(req *types.DictCodesRequest) (resp *types.DictDataListResponse, err error) {
+	result, err := l.svcCtx.DictRpc.BatchGetDictByCode(l.ctx, &core.DictCodesRequest{
+		Codes:      dict.SplitDictCodes(req.Codes),
+		PublicOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.DictDataListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: dict.ConvertDictDataList(result.List),
+	}
+	return
+}
@@ -0,0 +1,50 @@
+package public_dict
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/logic/dict"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPublicDictByCodeLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 根据编码获取公开字典
+func NewGetPublicDictByCodeLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetPublicDictByCodeLogic {
+	return &GetPublicDictByCodeLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetPublicDictByCodeLogic) GetPublicDictByCode(req *types.DictCodeRequest) (resp *types.DictDataResponse, err error) {
+	result, err := l.svcCtx.DictRpc.GetDictByCode(l.ctx, &core.DictCodeRequest{
+		Code:       req.Code,
+		PublicOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.DictDataResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: dict.ConvertDictData(result),
+	}
+	return
+}
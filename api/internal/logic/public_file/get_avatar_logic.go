@@ -0,0 +1,49 @@
+package public_file
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAvatarLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取头像
+func NewGetAvatarLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetAvatarLogic {
+	return &GetAvatarLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// GetAvatar 公开输出头像，其他分类的文件按不存在处理
+func (l *GetAvatarLogic) GetAvatar(req *types.FileDownloadRequest, w http.ResponseWriter) error {
+	info, err := l.svcCtx.FileRpc.GetFile(l.ctx, &fileservice.UUIDRequest{Id: req.ID})
+	if err != nil {
+		return err
+	}
+	if pointer.GetString(info.Category) != "avatar" {
+		return errorx.NewApiNotFoundError(last_i18n.TargetNotExist)
+	}
+
+	// 每次上传头像都会生成新的文件ID，内容不会变化
+	w.Header().Set("Cache-Control", "public, max-age=604800, immutable")
+	return filetransfer.Serve(l.ctx, w, l.svcCtx.FileRpc, info, "inline")
+}
@@ -0,0 +1,47 @@
+package public_file
+
+import (
+	"context"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SignedDownloadFileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 通过临时链接下载文件
+func NewSignedDownloadFileLogic(r *http.Request, svcCtx *svc.ServiceContext) *SignedDownloadFileLogic {
+	return &SignedDownloadFileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// SignedDownloadFile 校验临时链接签名后输出文件内容
+func (l *SignedDownloadFileLogic) SignedDownloadFile(req *types.FileSignedDownloadRequest, w http.ResponseWriter) error {
+	if !filetransfer.Verify(l.svcCtx.Config.FileConf.SignSecret, req.ID, req.Expires, req.Signature, time.Now()) {
+		return errorx.NewApiForbiddenError("file.invalidSignature")
+	}
+
+	info, err := l.svcCtx.FileRpc.GetFile(l.ctx, &fileservice.UUIDRequest{Id: req.ID})
+	if err != nil {
+		return err
+	}
+
+	return filetransfer.Serve(l.ctx, w, l.svcCtx.FileRpc, info, "attachment")
+}
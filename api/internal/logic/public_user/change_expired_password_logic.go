@@ -0,0 +1,67 @@
+package public_user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ChangeExpiredPasswordLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改过期密码(登录时要求修改密码)
+func NewChangeExpiredPasswordLogic(r *http.Request, svcCtx *svc.ServiceContext) *ChangeExpiredPasswordLogic {
+	return &ChangeExpiredPasswordLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ChangeExpiredPasswordLogic) ChangeExpiredPassword(req *types.ChangeExpiredPasswordRequest) (resp *types.BaseResponse, err error) {
+	// 凭据在登录校验通过后签发，持有凭据即视为已验证身份
+	userID, err := GetPasswordChangeTicket(l.ctx, req.Ticket, l.svcCtx.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := l.svcCtx.UserRpc.ChangePassword(l.ctx, &userservice.ChangePasswordRequest{
+		UserId:      userID,
+		NewPassword: req.NewPassword,
+	})
+	event := securityevent.Event{
+		Type:     securityevent.TypePasswordChange,
+		UserID:   userID,
+		Success:  err == nil,
+		Provider: securityevent.ProviderPassword,
+	}
+	if err != nil {
+		event.Reason = securityevent.Reason(err)
+	}
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, event)
+	if err != nil {
+		return nil, err
+	}
+
+	// 修改成功后作废凭据
+	if err = DeletePasswordChangeTicket(l.ctx, req.Ticket, l.svcCtx.Redis); err != nil {
+		l.Errorw("作废修改密码凭据失败", logx.Field("detail", err.Error()))
+	}
+
+	return &types.BaseResponse{
+		Code:    0,
+		Message: result.Message,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package public_user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-common/utils/captcha"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"google.golang.org/grpc"
+)
+
+// fakeUserRpc 仅实现测试用到的方法，调用其他方法会因内嵌接口为空而 panic
+type fakeUserRpc struct {
+	userservice.UserService
+	user    *userservice.UserInfo
+	err     error
+	totpErr error
+}
+
+func (f *fakeUserRpc) GetUserByUsername(context.Context, *userservice.StringRequest, ...grpc.CallOption) (*userservice.UserInfo, error) {
+	return f.user, f.err
+}
+
+func (f *fakeUserRpc) VerifyTotpCode(context.Context, *userservice.VerifyTotpCodeRequest, ...grpc.CallOption) (*userservice.VerifyTotpCodeResponse, error) {
+	if f.totpErr != nil {
+		return nil, f.totpErr
+	}
+	return &userservice.VerifyTotpCodeResponse{IsValid: false}, nil
+}
+
+func (f *fakeUserRpc) FinishWebauthnLogin(context.Context, *userservice.WebauthnFinishLoginRequest, ...grpc.CallOption) (*userservice.UserInfo, error) {
+	return f.user, f.err
+}
+
+// fakeSecurityEventRpc 记录写入的安全事件
+type fakeSecurityEventRpc struct {
+	securityeventservice.SecurityEventService
+	events []*securityeventservice.SecurityEventInfo
+}
+
+func (f *fakeSecurityEventRpc) CreateSecurityEvent(_ context.Context, in *securityeventservice.SecurityEventInfo, _ ...grpc.CallOption) (*securityeventservice.BaseResponse, error) {
+	f.events = append(f.events, in)
+	return &securityeventservice.BaseResponse{}, nil
+}
+
+func newTestContext(t *testing.T, users *fakeUserRpc) (*svc.ServiceContext, *fakeSecurityEventRpc) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	conf := captcha.DefaultConfig()
+	conf.Store = captcha.StoreConfig{Type: captcha.StoreTypeMemory, Expire: time.Minute}
+	captchaService, err := captcha.NewService(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := &fakeSecurityEventRpc{}
+	return &svc.ServiceContext{
+		CaptchaService:   captchaService,
+		UserRpc:          users,
+		SecurityEventRpc: events,
+		Redis:            rds,
+	}, events
+}
@@ -2,14 +2,15 @@ package public_user
 
 import (
 	"context"
-	"strings"
+	"encoding/json"
 	"time"
 
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/wenpiner/last-admin-common/utils/encrypt"
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 	"google.golang.org/grpc/status"
@@ -51,6 +52,7 @@ func (l *LoginByPasswordLogic) LoginByPassword(req *types.LoginRequest) (resp *t
 	if err != nil {
 		if e,ok := status.FromError(err); ok {
 			if e.Message() == last_i18n.TargetNotExist {
+				l.recordEvent(securityevent.TypeLogin, req.Username, "", securityevent.ProviderPassword, last_i18n.TargetNotExist)
 				return nil, errorx.NewApiError(errorx.CodeInvalidCredentials, "login.passwordError")
 			}
 		}
@@ -59,53 +61,76 @@ func (l *LoginByPasswordLogic) LoginByPassword(req *types.LoginRequest) (resp *t
 
 	// 校验密码
 	if encrypt.BcryptCheck(req.Password, *user.PasswordHash) == false {
+		l.recordEvent(securityevent.TypeLogin, req.Username, *user.Id, securityevent.ProviderPassword, "login.passwordError")
 		return nil, errorx.NewApiError(errorx.CodeInvalidCredentials, "login.passwordError")
 	}
 
 	// 验证用户状态
 	if pointer.GetBool(user.State) == false {
+		l.recordEvent(securityevent.TypeLogin, req.Username, *user.Id, securityevent.ProviderPassword, "user.disabled")
 		return nil, errorx.NewApiError(errorx.CodeAccountDisabled, "user.disabled")
 	}
 
-	if user.TotpInfo != nil {
-		if pointer.GetBool(user.TotpInfo.State) == true {
-			if req.TotpCode == nil || *req.TotpCode == "" {
-				return nil, errorx.NewApiError(errorx.CodeTOTPRequired, "totp.notProvided")
-			}else{
-				// 验证TOTP
-				verifyReq := &userservice.VerifyTotpCodeRequest{
-					UserId:   *user.Id,
-					TotpCode: *req.TotpCode,
-				}
-				verifyResp, err := l.svcCtx.UserRpc.VerifyTotpCode(l.ctx, verifyReq)
-				if err != nil {
-					return nil, err
-				}
-				if verifyResp.IsValid == false {
-					return nil, errorx.NewApiError(errorx.CodeTOTPVerifyFailed, "totp.verifyFailed")
-				}
-			}
+	// 多因素认证，TOTP 与 WebAuthn 任选其一
+	totpEnabled := user.TotpInfo != nil && pointer.GetBool(user.TotpInfo.State)
+	webauthnEnabled := pointer.GetUint32(user.WebauthnCount) > 0
+	switch {
+	case webauthnEnabled && req.WebauthnSessionId != nil && len(req.WebauthnCredential) > 0:
+		// 验证WebAuthn断言，会话必须属于当前用户
+		credential, err := json.Marshal(req.WebauthnCredential)
+		if err != nil {
+			return nil, errorx.NewApiInvalidParamsError("webauthn.verifyFailed")
+		}
+		_, err = l.svcCtx.UserRpc.FinishWebauthnLogin(l.ctx, &userservice.WebauthnFinishLoginRequest{
+			SessionId:  *req.WebauthnSessionId,
+			Credential: string(credential),
+			UserId:     user.Id,
+		})
+		if err != nil {
+			l.recordEvent(securityevent.TypeMfaChallenge, req.Username, *user.Id, securityevent.ProviderWebauthn, securityevent.Reason(err))
+			return nil, err
+		}
+		l.recordEvent(securityevent.TypeMfaChallenge, req.Username, *user.Id, securityevent.ProviderWebauthn, "")
+	case totpEnabled && req.TotpCode != nil && *req.TotpCode != "":
+		// 验证TOTP
+		verifyReq := &userservice.VerifyTotpCodeRequest{
+			UserId:   *user.Id,
+			TotpCode: *req.TotpCode,
+		}
+		verifyResp, err := l.svcCtx.UserRpc.VerifyTotpCode(l.ctx, verifyReq)
+		if err != nil {
+			l.recordEvent(securityevent.TypeMfaChallenge, req.Username, *user.Id, securityevent.ProviderTotp, securityevent.Reason(err))
+			return nil, err
 		}
+		if verifyResp.IsValid == false {
+			l.recordEvent(securityevent.TypeMfaChallenge, req.Username, *user.Id, securityevent.ProviderTotp, "totp.verifyFailed")
+			return nil, errorx.NewApiError(errorx.CodeTOTPVerifyFailed, "totp.verifyFailed")
+		}
+		l.recordEvent(securityevent.TypeMfaChallenge, req.Username, *user.Id, securityevent.ProviderTotp, "")
+	case totpEnabled && webauthnEnabled:
+		return nil, errorx.NewApiError(errorx.CodeTOTPRequired, "mfa.notProvided")
+	case totpEnabled:
+		return nil, errorx.NewApiError(errorx.CodeTOTPRequired, "totp.notProvided")
+	case webauthnEnabled:
+		return nil, errorx.NewApiError(errorx.CodeTOTPRequired, "webauthn.notProvided")
 	}
 
-	// 生成Token
-	accessToken, err := generateToken(user, l.svcCtx.Config.Auth.AccessExpire, l.svcCtx.Config.Auth.AccessSecret)
-	if err != nil {
-		return nil, err
+	// 需要修改密码时不签发令牌，返回一次性修改密码凭据
+	if PasswordChangeRequired(user) {
+		if resp, err = passwordChangeResponse(l.ctx, *user.Id, l.svcCtx.Redis); err != nil {
+			return nil, err
+		}
+		// 未签发令牌，不视为登录成功
+		l.recordEvent(securityevent.TypeLogin, req.Username, *user.Id, securityevent.ProviderPassword, "password.changeRequired")
+		return resp, nil
 	}
 
-	_, err = l.svcCtx.TokenRpc.CreateToken(l.ctx, &tokenservice.CreateTokenRequest{
-		TokenValue: accessToken,
-		TokenType:  "access_token",
-		UserId:     user.Id,
-		ExpiresAt:  time.Now().Add(time.Second * time.Duration(l.svcCtx.Config.Auth.AccessExpire)).Unix(),
-		DeviceInfo: pointer.ToStringPtr(l.r.UserAgent()),
-		IpAddress:  pointer.ToStringPtr(lastHttp.GetIP(l.r)),
-		UserAgent:  pointer.ToStringPtr(l.r.UserAgent()),
-	})
+	// 生成Token
+	accessToken, err := issueAccessToken(l.ctx, l.r, l.svcCtx, user)
 	if err != nil {
 		return nil, err
 	}
+	l.recordEvent(securityevent.TypeLogin, req.Username, *user.Id, securityevent.ProviderPassword, "")
 
 	resp = &types.LoginResponse{
 		BaseDataInfo: types.BaseDataInfo{
@@ -119,23 +144,36 @@ func (l *LoginByPasswordLogic) LoginByPassword(req *types.LoginRequest) (resp *t
 	return
 }
 
-func generateToken(user *userservice.UserInfo, expire int64, secret string) (accessToken string, err error) {
-	// 生成Token
-	claims := make(jwt.MapClaims)
-	iat := time.Now().Unix()
-	claims["iat"] = iat
-	claims["exp"] = iat + expire
-	claims["userId"] = *user.Id
-	claims["deptId"] = *user.DepartmentId
-	claims["roleId"] = strings.Join(user.RoleValues, ",")
-	claims["providerId"] = 0
-	if user.ProviderId != nil {
-		claims["providerId"] = *user.ProviderId
+// recordEvent 记录登录过程中的安全事件，reason 为空表示成功
+func (l *LoginByPasswordLogic) recordEvent(eventType, username, userID, provider, reason string) {
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+		Type:     eventType,
+		UserID:   userID,
+		Username: username,
+		Success:  reason == "",
+		Provider: provider,
+		Reason:   reason,
+	})
+}
+
+// issueAccessToken 签发访问令牌并记录
+func issueAccessToken(ctx context.Context, r *http.Request, svcCtx *svc.ServiceContext, user *userservice.UserInfo) (string, error) {
+	accessToken, err := jwtutils.GenerateToken(user, svcCtx.Config.Auth.AccessExpire, svcCtx.Config.Auth.AccessSecret, nil)
+	if err != nil {
+		return "", err
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	accessToken, err = token.SignedString([]byte(secret))
+
+	_, err = svcCtx.TokenRpc.CreateToken(ctx, &tokenservice.CreateTokenRequest{
+		TokenValue: accessToken,
+		TokenType:  "access_token",
+		UserId:     user.Id,
+		ExpiresAt:  time.Now().Add(time.Second * time.Duration(svcCtx.Config.Auth.AccessExpire)).Unix(),
+		DeviceInfo: pointer.ToStringPtr(r.UserAgent()),
+		IpAddress:  pointer.ToStringPtr(lastHttp.GetIP(r)),
+		UserAgent:  pointer.ToStringPtr(r.UserAgent()),
+	})
 	if err != nil {
-		err = errorx.NewInternalError("token.generateTokenFailed")
+		return "", err
 	}
-	return
+	return accessToken, nil
 }
@@ -0,0 +1,64 @@
+package public_user
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginByPasswordRecordsFailedEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		totpErr  error
+		event    string
+		provider string
+		reason   string
+	}{
+		{"wrongPassword", "wrong", nil, securityevent.TypeLogin, securityevent.ProviderPassword, "login.passwordError"},
+		{"totpInvalid", "secret", nil, securityevent.TypeMfaChallenge, securityevent.ProviderTotp, "totp.verifyFailed"},
+		{"totpRpcError", "secret", status.Error(codes.Unavailable, "totp.unavailable"), securityevent.TypeMfaChallenge, securityevent.ProviderTotp, "totp.unavailable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &userservice.UserInfo{
+				Id:           pointer.ToStringPtr("0192f6c4-7e2a-7000-8000-000000000001"),
+				Username:     pointer.ToStringPtr("alice"),
+				PasswordHash: pointer.ToStringPtr(encrypt.BcryptEncrypt("secret")),
+				State:        pointer.ToBoolPtr(true),
+				TotpInfo:     &userservice.TotpInfo{State: pointer.ToBoolPtr(true)},
+			}
+			svcCtx, events := newTestContext(t, &fakeUserRpc{user: user, totpErr: tt.totpErr})
+			c, err := svcCtx.CaptchaService.GenerateDigit()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest("POST", "/auth/login", nil)
+			resp, err := NewLoginByPasswordLogic(r, svcCtx).LoginByPassword(&types.LoginRequest{
+				Username: "alice",
+				Password: tt.password,
+				Captcha:  types.CaptchaInfo{ID: c.ID, Value: c.Answer},
+				TotpCode: pointer.ToStringPtr("123456"),
+			})
+			if err == nil {
+				t.Fatalf("expected login to fail, got %+v", resp)
+			}
+			if n := len(events.events); n != 1 {
+				t.Fatalf("expected one event, got %d", n)
+			}
+			e := events.events[0]
+			if pointer.GetString(e.EventType) != tt.event || pointer.GetBool(e.Success) ||
+				pointer.GetString(e.Provider) != tt.provider || pointer.GetString(e.Reason) != tt.reason {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+		})
+	}
+}
@@ -2,12 +2,15 @@ package public_user
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	lastHttp "github.com/wenpiner/last-admin-common/utils/http"
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
 
@@ -39,11 +42,43 @@ func (l *OauthCallbackLogic) OauthCallback() (resp *types.CallbackResponse, err
 		Code:  l.r.FormValue("code"),
 	})
 	if err != nil {
+		securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+			Type:     securityevent.TypeLogin,
+			Provider: securityevent.ProviderOauth,
+			Reason:   securityevent.Reason(err),
+		})
 		return nil, err
 	}
 
+	provider := fmt.Sprintf("%s:%d", securityevent.ProviderOauth, pointer.GetUint32(result.ProviderId))
+
+	// 与密码登录一致，需要修改密码时不签发令牌，返回一次性修改密码凭据
+	if PasswordChangeRequired(result) {
+		ticket, err := CreatePasswordChangeTicket(l.ctx, pointer.GetString(result.Id), l.svcCtx.Redis)
+		if err != nil {
+			return nil, err
+		}
+		securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+			Type:     securityevent.TypeLogin,
+			UserID:   pointer.GetString(result.Id),
+			Username: pointer.GetString(result.Username),
+			Provider: provider,
+			Reason:   "password.changeRequired",
+		})
+		return &types.CallbackResponse{
+			BaseDataInfo: types.BaseDataInfo{
+				Code:    CodePasswordChangeRequired,
+				Message: "password.changeRequired",
+			},
+			Data: types.CallbackInfo{
+				UserID:               pointer.GetString(result.Id),
+				PasswordChangeTicket: ticket,
+			},
+		}, nil
+	}
+
 	// 生成Token
-	accessToken, err := generateToken(result, l.svcCtx.Config.Auth.AccessExpire, l.svcCtx.Config.Auth.AccessSecret)
+	accessToken, err := jwtutils.GenerateToken(result, l.svcCtx.Config.Auth.AccessExpire, l.svcCtx.Config.Auth.AccessSecret, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +98,13 @@ func (l *OauthCallbackLogic) OauthCallback() (resp *types.CallbackResponse, err
 	if err != nil {
 		return nil, err
 	}
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+		Type:     securityevent.TypeLogin,
+		UserID:   pointer.GetString(result.Id),
+		Username: pointer.GetString(result.Username),
+		Success:  true,
+		Provider: provider,
+	})
 
 	resp = &types.CallbackResponse{
 		BaseDataInfo: types.BaseDataInfo{
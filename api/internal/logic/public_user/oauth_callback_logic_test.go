@@ -0,0 +1,60 @@
+package public_user
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
+	"google.golang.org/grpc"
+)
+
+// fakeOauthRpc 返回固定的第三方登录用户
+type fakeOauthRpc struct {
+	oauthproviderservice.OauthProviderService
+	user *oauthproviderservice.UserInfo
+}
+
+func (f *fakeOauthRpc) OauthCallback(context.Context, *oauthproviderservice.OauthCallbackRequest, ...grpc.CallOption) (*oauthproviderservice.UserInfo, error) {
+	return f.user, nil
+}
+
+func TestOauthCallbackRequiresPasswordChange(t *testing.T) {
+	tests := []struct {
+		name string
+		user *oauthproviderservice.UserInfo
+	}{
+		{"mustChange", &oauthproviderservice.UserInfo{MustChangePassword: pointer.ToBoolPtr(true)}},
+		{"expired", &oauthproviderservice.UserInfo{PasswordExpired: pointer.ToBoolPtr(true)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.user.Id = pointer.ToStringPtr("0192f6c4-7e2a-7000-8000-000000000001")
+			tt.user.Username = pointer.ToStringPtr("alice")
+			tt.user.ProviderId = pointer.ToUint32Ptr(1)
+			svcCtx, events := newTestContext(t, &fakeUserRpc{})
+			svcCtx.OauthRpc = &fakeOauthRpc{user: tt.user}
+
+			// TokenRpc 为空，签发令牌会 panic
+			r := httptest.NewRequest("GET", "/oauth/callback?state=s&code=c", nil)
+			resp, err := NewOauthCallbackLogic(r, svcCtx).OauthCallback()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Code != CodePasswordChangeRequired || resp.Data.Token != "" || resp.Data.PasswordChangeTicket == "" {
+				t.Fatalf("unexpected response: %+v", resp)
+			}
+			userID, err := GetPasswordChangeTicket(r.Context(), resp.Data.PasswordChangeTicket, svcCtx.Redis)
+			if err != nil || userID != *tt.user.Id {
+				t.Fatalf("ticket maps to %q, %v", userID, err)
+			}
+			if n := len(events.events); n != 1 {
+				t.Fatalf("expected one login event, got %d", n)
+			}
+			if e := events.events[0]; pointer.GetBool(e.Success) || pointer.GetString(e.Reason) != "password.changeRequired" {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package public_user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+const (
+	passwordChangePrefix = "password:change:"
+
+	// CodePasswordChangeRequired 需要修改密码后才能登录
+	CodePasswordChangeRequired = 30011
+)
+
+// CreatePasswordChangeTicket 生成一次性修改密码凭据，凭据有效期内可直接设置新密码
+func CreatePasswordChangeTicket(ctx context.Context, userID string, redis *redis.Client) (string, error) {
+	id, _ := uuid.NewV7()
+	err := redis.SetEx(ctx, passwordChangePrefix+id.String(), userID, 10*time.Minute).Err()
+	if err != nil {
+		return "", errorx.NewInternalError("password.changeFailed")
+	}
+	return id.String(), nil
+}
+
+// GetPasswordChangeTicket 获取修改密码凭据对应的用户ID
+func GetPasswordChangeTicket(ctx context.Context, ticket string, rds *redis.Client) (string, error) {
+	userID, err := rds.Get(ctx, passwordChangePrefix+ticket).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", errorx.NewInvalidArgumentError("password.ticketExpired")
+	}
+	if err != nil {
+		return "", errorx.NewInternalError("password.changeFailed")
+	}
+	return userID, nil
+}
+
+// DeletePasswordChangeTicket 作废修改密码凭据
+func DeletePasswordChangeTicket(ctx context.Context, ticket string, rds *redis.Client) error {
+	return rds.Del(ctx, passwordChangePrefix+ticket).Err()
+}
+
+// PasswordChangeRequired 判断用户是否需要先修改密码才能登录，所有登录方式签发令牌前都需检查
+func PasswordChangeRequired(user *userservice.UserInfo) bool {
+	return pointer.GetBool(user.MustChangePassword) || pointer.GetBool(user.PasswordExpired)
+}
+
+// passwordChangeResponse 需要修改密码时不签发令牌，返回一次性修改密码凭据
+func passwordChangeResponse(ctx context.Context, userID string, rds *redis.Client) (*types.LoginResponse, error) {
+	ticket, err := CreatePasswordChangeTicket(ctx, userID, rds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LoginResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    CodePasswordChangeRequired,
+			Message: "password.changeRequired",
+		},
+		Data: types.LoginInfo{
+			PasswordChangeTicket: ticket,
+		},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package public_user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"google.golang.org/grpc/status"
+
+	"net/http"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WebauthnLoginBeginLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 开始WebAuthn登录
+func NewWebauthnLoginBeginLogic(r *http.Request, svcCtx *svc.ServiceContext) *WebauthnLoginBeginLogic {
+	return &WebauthnLoginBeginLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *WebauthnLoginBeginLogic) WebauthnLoginBegin(req *types.WebauthnLoginBeginRequest) (resp *types.WebauthnBeginResponse, err error) {
+	rpcReq := &userservice.WebauthnBeginLoginRequest{}
+
+	// 指定用户名时作为第二因素，只允许该用户的凭证
+	if req.Username != "" {
+		user, err := l.svcCtx.UserRpc.GetUserByUsername(l.ctx, &userservice.StringRequest{Value: req.Username})
+		if err != nil {
+			if e, ok := status.FromError(err); ok && e.Message() == last_i18n.TargetNotExist {
+				return nil, errorx.NewApiInvalidParamsError("webauthn.notRegistered")
+			}
+			return nil, err
+		}
+		rpcReq.UserId = user.Id
+	}
+
+	rpcResp, err := l.svcCtx.UserRpc.BeginWebauthnLogin(l.ctx, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var options map[string]interface{}
+	if err = json.Unmarshal([]byte(rpcResp.Options), &options); err != nil {
+		return nil, errorx.NewApiInternalError("webauthn.beginFailed")
+	}
+
+	resp = &types.WebauthnBeginResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.WebauthnBeginInfo{
+			SessionId: rpcResp.SessionId,
+			Options:   options,
+		},
+	}
+	return
+}
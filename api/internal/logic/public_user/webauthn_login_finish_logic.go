@@ -0,0 +1,99 @@
+package public_user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WebauthnLoginFinishLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// WebAuthn登录(Passkey无密码登录)
+func NewWebauthnLoginFinishLogic(r *http.Request, svcCtx *svc.ServiceContext) *WebauthnLoginFinishLogic {
+	return &WebauthnLoginFinishLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *WebauthnLoginFinishLogic) WebauthnLoginFinish(req *types.WebauthnLoginFinishRequest) (resp *types.LoginResponse, err error) {
+	credential, err := json.Marshal(req.Credential)
+	if err != nil {
+		return nil, errorx.NewApiInvalidParamsError("webauthn.verifyFailed")
+	}
+
+	// 无用户名登录，由凭证中的 userHandle 定位用户
+	user, err := l.svcCtx.UserRpc.FinishWebauthnLogin(l.ctx, &userservice.WebauthnFinishLoginRequest{
+		SessionId:  req.SessionId,
+		Credential: string(credential),
+	})
+	if err != nil {
+		l.recordLogin(nil, securityevent.Reason(err))
+		return nil, err
+	}
+
+	// 验证用户状态
+	if pointer.GetBool(user.State) == false {
+		l.recordLogin(user, "user.disabled")
+		return nil, errorx.NewApiError(errorx.CodeAccountDisabled, "user.disabled")
+	}
+
+	// 与密码登录一致，需要修改密码时不签发令牌
+	if PasswordChangeRequired(user) {
+		if resp, err = passwordChangeResponse(l.ctx, pointer.GetString(user.Id), l.svcCtx.Redis); err != nil {
+			return nil, err
+		}
+		l.recordLogin(user, "password.changeRequired")
+		return resp, nil
+	}
+
+	// 生成Token
+	accessToken, err := issueAccessToken(l.ctx, l.r, l.svcCtx, user)
+	if err != nil {
+		return nil, err
+	}
+	l.recordLogin(user, "")
+
+	resp = &types.LoginResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "login.success",
+		},
+		Data: types.LoginInfo{
+			AccessToken: accessToken,
+		},
+	}
+	return
+}
+
+// recordLogin 记录无密码登录事件，凭证校验失败时无法确定用户
+func (l *WebauthnLoginFinishLogic) recordLogin(user *userservice.UserInfo, reason string) {
+	event := securityevent.Event{
+		Type:     securityevent.TypeLogin,
+		Success:  reason == "",
+		Provider: securityevent.ProviderWebauthn,
+		Reason:   reason,
+	}
+	if user != nil {
+		event.UserID = pointer.GetString(user.Id)
+		event.Username = pointer.GetString(user.Username)
+	}
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, event)
+}
@@ -0,0 +1,47 @@
+package public_user
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+)
+
+func TestWebauthnLoginFinishRequiresPasswordChange(t *testing.T) {
+	tests := []struct {
+		name string
+		user *userservice.UserInfo
+	}{
+		{"mustChange", &userservice.UserInfo{MustChangePassword: pointer.ToBoolPtr(true)}},
+		{"expired", &userservice.UserInfo{PasswordExpired: pointer.ToBoolPtr(true)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.user.Id = pointer.ToStringPtr("0192f6c4-7e2a-7000-8000-000000000001")
+			tt.user.Username = pointer.ToStringPtr("alice")
+			tt.user.State = pointer.ToBoolPtr(true)
+			svcCtx, events := newTestContext(t, &fakeUserRpc{user: tt.user})
+
+			r := httptest.NewRequest("POST", "/auth/webauthn/login/finish", nil)
+			resp, err := NewWebauthnLoginFinishLogic(r, svcCtx).WebauthnLoginFinish(&types.WebauthnLoginFinishRequest{SessionId: "s"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Code != CodePasswordChangeRequired || resp.Data.AccessToken != "" || resp.Data.PasswordChangeTicket == "" {
+				t.Fatalf("unexpected response: %+v", resp)
+			}
+			userID, err := GetPasswordChangeTicket(r.Context(), resp.Data.PasswordChangeTicket, svcCtx.Redis)
+			if err != nil || userID != *tt.user.Id {
+				t.Fatalf("ticket maps to %q, %v", userID, err)
+			}
+			if n := len(events.events); n != 1 {
+				t.Fatalf("expected one login event, got %d", n)
+			}
+			if e := events.events[0]; pointer.GetBool(e.Success) || pointer.GetString(e.Reason) != "password.changeRequired" {
+				t.Fatalf("unexpected event: %+v", e)
+			}
+		})
+	}
+}
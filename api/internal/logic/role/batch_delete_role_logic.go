@@ -0,0 +1,40 @@
+package role
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/roleservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteRoleLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除角色
+func NewBatchDeleteRoleLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeleteRoleLogic {
+	return &BatchDeleteRoleLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeleteRoleLogic) BatchDeleteRole(req *types.ID32SRequest) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.RoleRpc.BatchDeleteRole(l.ctx, &roleservice.ID32SRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
@@ -0,0 +1,43 @@
+package role
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/roleservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateRoleStateLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量启用或禁用角色
+func NewBatchUpdateRoleStateLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchUpdateRoleStateLogic {
+	return &BatchUpdateRoleStateLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchUpdateRoleStateLogic) BatchUpdateRoleState(req *types.BatchStateID32Request) (resp *types.BatchResponse, err error) {
+	result, err := l.svcCtx.RoleRpc.BatchUpdateRoleState(l.ctx, &roleservice.BatchStateID32Request{
+		Ids:   req.Ids,
+		State: req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
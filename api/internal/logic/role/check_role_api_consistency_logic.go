@@ -0,0 +1,66 @@
+package role
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckRoleApiConsistencyLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 检查角色接口授权与菜单的一致性
+func NewCheckRoleApiConsistencyLogic(r *http.Request, svcCtx *svc.ServiceContext) *CheckRoleApiConsistencyLogic {
+	return &CheckRoleApiConsistencyLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *CheckRoleApiConsistencyLogic) CheckRoleApiConsistency(req *types.RoleApiConsistencyRequest) (resp *types.RoleApiConsistencyResponse, err error) {
+	result, err := l.svcCtx.RoleRpc.CheckRoleApiConsistency(l.ctx, &core.RoleApiConsistencyRequest{RoleId: req.RoleId})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]types.RoleApiConsistency, 0, len(result.List))
+	for _, item := range result.List {
+		mismatches := make([]types.RoleApiMismatch, 0, len(item.Mismatches))
+		for _, m := range item.Mismatches {
+			mismatches = append(mismatches, types.RoleApiMismatch{
+				Type:    m.Type,
+				Path:    m.Path,
+				Method:  m.Method,
+				ApiId:   m.ApiId,
+				MenuIds: m.MenuIds,
+			})
+		}
+		data = append(data, types.RoleApiConsistency{
+			RoleId:     item.RoleId,
+			RoleValue:  item.RoleValue,
+			RoleName:   item.RoleName,
+			Mismatches: mismatches,
+		})
+	}
+
+	resp = &types.RoleApiConsistencyResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: data,
+	}
+	return
+}
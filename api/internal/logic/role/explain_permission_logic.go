@@ -0,0 +1,87 @@
+package role
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExplainPermissionLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 解释或模拟接口、配置项的访问决策
+func NewExplainPermissionLogic(r *http.Request, svcCtx *svc.ServiceContext) *ExplainPermissionLogic {
+	return &ExplainPermissionLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ExplainPermissionLogic) ExplainPermission(req *types.PermissionExplainRequest) (resp *types.PermissionExplainResponse, err error) {
+	result, err := l.svcCtx.RoleRpc.ExplainPermission(l.ctx, &core.PermissionExplainRequest{
+		UserId:           req.UserId,
+		RoleValues:       req.RoleValues,
+		AddRoleValues:    req.AddRoleValues,
+		RemoveRoleValues: req.RemoveRoleValues,
+		Domain:           req.Domain,
+		Object:           req.Object,
+		Action:           req.Action,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]types.PermissionRoleDecision, 0, len(result.Roles))
+	for _, r := range result.Roles {
+		sources := make([]types.RoleSource, 0, len(r.Sources))
+		for _, s := range r.Sources {
+			sources = append(sources, types.RoleSource{Type: s.Type, Id: s.Id, Name: s.Name})
+		}
+		roles = append(roles, types.PermissionRoleDecision{
+			RoleValue:       r.RoleValue,
+			Allowed:         r.Allowed,
+			MatchedPolicies: r.MatchedPolicies,
+			Sources:         sources,
+			Simulated:       r.Simulated,
+		})
+	}
+
+	suggestions := make([]types.PermissionSuggestion, 0, len(result.Suggestions))
+	for _, s := range result.Suggestions {
+		suggestions = append(suggestions, types.PermissionSuggestion{
+			Type:      s.Type,
+			RoleValue: s.RoleValue,
+			ApiId:     s.ApiId,
+			MenuId:    s.MenuId,
+			MenuName:  s.MenuName,
+		})
+	}
+
+	resp = &types.PermissionExplainResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.PermissionExplainInfo{
+			Allowed:     result.Allowed,
+			GrantedBy:   result.GrantedBy,
+			Simulated:   result.Simulated,
+			Public:      result.Public,
+			Roles:       roles,
+			Suggestions: suggestions,
+		},
+	}
+	return
+}
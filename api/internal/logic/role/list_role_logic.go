@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/roleservice"
 
 	"net/http"
@@ -33,10 +34,7 @@ func NewListRoleLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListRoleLogi
 func (l *ListRoleLogic) ListRole(req *types.RoleListRequest) (resp *types.RoleListResponse, err error) {
 	// 构建 RPC 请求
 	rpcReq := &roleservice.RoleListRequest{
-		Page: &roleservice.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:     pageutils.Request(req.Page),
 		RoleName: pointer.ToStringPtrIfNotEmpty(req.RoleName),
 		RoleCode: pointer.ToStringPtrIfNotEmpty(req.RoleCode),
 	}
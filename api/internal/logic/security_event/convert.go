@@ -0,0 +1,40 @@
+package security_event
+
+import (
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+)
+
+// convertSecurityEventList 转换安全事件列表响应
+func convertSecurityEventList(rpcResp *securityeventservice.SecurityEventListResponse) *types.SecurityEventListResponse {
+	list := make([]types.SecurityEventInfo, 0, len(rpcResp.List))
+	for _, event := range rpcResp.List {
+		list = append(list, types.SecurityEventInfo{
+			ID:        event.Id,
+			CreatedAt: event.CreatedAt,
+			UserId:    event.UserId,
+			Username:  event.Username,
+			EventType: event.EventType,
+			Success:   event.Success,
+			Provider:  event.Provider,
+			Reason:    event.Reason,
+			IpAddress: event.IpAddress,
+			UserAgent: event.UserAgent,
+		})
+	}
+
+	return &types.SecurityEventListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.SecurityEventListInfo{
+			BaseListInfo: types.BaseListInfo{
+				Total: rpcResp.Page.Total,
+			},
+			CursorInfo: pageutils.CursorInfo(rpcResp.Page),
+			List:       list,
+		},
+	}
+}
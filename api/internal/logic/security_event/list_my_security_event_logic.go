@@ -0,0 +1,49 @@
+package security_event
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMySecurityEventLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我的登录记录及安全事件
+func NewListMySecurityEventLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListMySecurityEventLogic {
+	return &ListMySecurityEventLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListMySecurityEventLogic) ListMySecurityEvent(req *types.MySecurityEventListRequest) (resp *types.SecurityEventListResponse, err error) {
+	// 只能查询自己的记录
+	rpcResp, err := l.svcCtx.SecurityEventRpc.ListSecurityEvent(l.ctx, &securityeventservice.SecurityEventListRequest{
+		Page:      pageutils.Request(req.Page),
+		UserId:    pointer.ToStringPtr(l.ctx.Value("userId").(string)),
+		EventType: req.EventType,
+		Success:   req.Success,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSecurityEventList(rpcResp), nil
+}
@@ -0,0 +1,49 @@
+package security_event
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSecurityEventLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取安全事件列表
+func NewListSecurityEventLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListSecurityEventLogic {
+	return &ListSecurityEventLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListSecurityEventLogic) ListSecurityEvent(req *types.SecurityEventListRequest) (resp *types.SecurityEventListResponse, err error) {
+	rpcResp, err := l.svcCtx.SecurityEventRpc.ListSecurityEvent(l.ctx, &securityeventservice.SecurityEventListRequest{
+		Page:      pageutils.Request(req.Page),
+		UserId:    req.UserId,
+		Username:  req.Username,
+		EventType: req.EventType,
+		Success:   req.Success,
+		IpAddress: req.IpAddress,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSecurityEventList(rpcResp), nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
 	"k8s.io/utils/pointer"
 
@@ -36,10 +37,18 @@ func (l *BlockTokenLogic) BlockToken(req *types.BlockTokenRequest) (resp *types.
 		Id:         &req.ID,	
 		State:      pointer.Bool(false),
 	}
-	_, err = l.svcCtx.TokenRpc.UpdateToken(l.ctx, rpcReq)
+	token, err := l.svcCtx.TokenRpc.UpdateToken(l.ctx, rpcReq)
 	if err != nil {
 		return nil, err
 	}
+	if token.UserId != nil {
+		securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+			Type:    securityevent.TypeTokenRevoke,
+			UserID:  *token.UserId,
+			Success: true,
+			Reason:  "blockedBy:" + l.ctx.Value("userId").(string),
+		})
+	}
 
 	resp = &types.BaseResponse{
 		Code:    0,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
 
 	"net/http"
@@ -32,10 +33,7 @@ func NewListTokenLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListTokenLo
 func (l *ListTokenLogic) ListToken(req *types.TokenListRequest) (resp *types.TokenListResponse, err error) {
 	// 构建 RPC 请求
 	rpcReq := &tokenservice.TokenListRequest{
-		Page: &tokenservice.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:       pageutils.Request(req.Page),
 		UserId:     req.UserId,
 		TokenType:  req.TokenType,
 		IpAddress:  req.IpAddress,
@@ -83,6 +81,7 @@ func (l *ListTokenLogic) ListToken(req *types.TokenListRequest) (resp *types.Tok
 			BaseListInfo: types.BaseListInfo{
 				Total: rpcResp.Page.Total,
 			},
+			CursorInfo: pageutils.CursorInfo(rpcResp.Page),
 		},
 	}
 	return resp, nil
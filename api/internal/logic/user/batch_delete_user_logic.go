@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"slices"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteUserLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量删除用户
+func NewBatchDeleteUserLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchDeleteUserLogic {
+	return &BatchDeleteUserLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchDeleteUserLogic) BatchDeleteUser(req *types.UUIDSRequest) (resp *types.BatchResponse, err error) {
+	// 不能删除当前登录用户
+	if slices.Contains(req.Ids, l.ctx.Value("userId").(string)) {
+		return nil, errorx.NewInvalidArgumentError("user.batchIncludesSelf")
+	}
+
+	// 被删除用户的令牌由核心服务在同一操作中撤销
+	result, err := l.svcCtx.UserRpc.BatchDeleteUser(l.ctx, &userservice.UUIDSRequest{Ids: req.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"slices"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateUserStateLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 批量启用或禁用用户
+func NewBatchUpdateUserStateLogic(r *http.Request, svcCtx *svc.ServiceContext) *BatchUpdateUserStateLogic {
+	return &BatchUpdateUserStateLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *BatchUpdateUserStateLogic) BatchUpdateUserState(req *types.BatchStateUUIDRequest) (resp *types.BatchResponse, err error) {
+	// 不能禁用当前登录用户
+	if !req.State && slices.Contains(req.Ids, l.ctx.Value("userId").(string)) {
+		return nil, errorx.NewInvalidArgumentError("user.batchIncludesSelf")
+	}
+
+	// 被禁用用户的令牌由核心服务在同一操作中撤销
+	result, err := l.svcCtx.UserRpc.BatchUpdateUserState(l.ctx, &userservice.BatchStateUUIDRequest{
+		Ids:   req.Ids,
+		State: req.State,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batchutils.Response(l.ctx, l.svcCtx.Trans, result), nil
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ChangePasswordLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改密码
+func NewChangePasswordLogic(r *http.Request, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ChangePasswordLogic) ChangePassword(req *types.ChangePasswordRequest) (resp *types.BaseResponse, err error) {
+	userID := l.ctx.Value("userId").(string)
+	response, err := l.svcCtx.UserRpc.ChangePassword(l.ctx, &userservice.ChangePasswordRequest{
+		UserId:      userID,
+		OldPassword: &req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypePasswordChange, securityevent.ProviderPassword, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按需退出其他设备上的会话
+	if pointer.GetBool(req.RevokeOtherSessions) {
+		if _, err = revokeOtherSessions(l.ctx, l.r, l.svcCtx, revokeReasonPasswordChange); err != nil {
+			return nil, err
+		}
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: response.Message,
+	}
+
+	return
+}
@@ -13,26 +13,31 @@ func ConvertRpcUserInfoToApiUserInfo(rpcUser *userservice.UserInfo) *types.UserI
 	}
 
 	userInfo := &types.UserInfo{
-		Avatar:         pointer.GetString(rpcUser.Avatar),
-		RealName:       pointer.GetString(rpcUser.FullName),
-		Roles:          rpcUser.RoleValues,
-		UserId:         pointer.GetString(rpcUser.Id),
-		Username:       pointer.GetString(rpcUser.Username),
-		Desc:           pointer.GetString(rpcUser.UserDescription),
-		HomePath:       pointer.GetString(rpcUser.HomePath),
-		Email:          pointer.GetString(rpcUser.Email),
-		RoleNames:      rpcUser.RoleNames,
-		DepartmentName: pointer.GetString(rpcUser.DepartmentName),
-		Mobile:         pointer.GetString(rpcUser.Mobile),
-		DepartmentId:   pointer.GetUint32(rpcUser.DepartmentId),
-		PositionNames:  rpcUser.PositionNames,
-		PositionIds:    rpcUser.PositionIds,
-		State:          pointer.GetBool(rpcUser.State),
-		CreatedAt:      pointer.GetInt64(rpcUser.CreatedAt),
-		UpdatedAt:      pointer.GetInt64(rpcUser.UpdatedAt),
-		LastLoginAt:    pointer.GetInt64(rpcUser.LastLoginAt),
-		LastLoginIp:    pointer.GetString(rpcUser.LastLoginIp),
-		RoleIds:        rpcUser.RoleIds,
+		Avatar:             pointer.GetString(rpcUser.Avatar),
+		RealName:           pointer.GetString(rpcUser.FullName),
+		Roles:              rpcUser.RoleValues,
+		UserId:             pointer.GetString(rpcUser.Id),
+		Username:           pointer.GetString(rpcUser.Username),
+		Desc:               pointer.GetString(rpcUser.UserDescription),
+		HomePath:           pointer.GetString(rpcUser.HomePath),
+		Email:              pointer.GetString(rpcUser.Email),
+		RoleNames:          rpcUser.RoleNames,
+		DepartmentName:     pointer.GetString(rpcUser.DepartmentName),
+		Mobile:             pointer.GetString(rpcUser.Mobile),
+		DepartmentId:       pointer.GetUint32(rpcUser.DepartmentId),
+		PositionNames:      rpcUser.PositionNames,
+		PositionIds:        rpcUser.PositionIds,
+		State:              pointer.GetBool(rpcUser.State),
+		CreatedAt:          pointer.GetInt64(rpcUser.CreatedAt),
+		UpdatedAt:          pointer.GetInt64(rpcUser.UpdatedAt),
+		LastLoginAt:        pointer.GetInt64(rpcUser.LastLoginAt),
+		LastLoginIp:        pointer.GetString(rpcUser.LastLoginIp),
+		RoleIds:            rpcUser.RoleIds,
+		WebauthnCount:      pointer.GetUint32(rpcUser.WebauthnCount),
+		MustChangePassword: rpcUser.MustChangePassword,
+		PasswordChangedAt:  pointer.GetInt64(rpcUser.PasswordChangedAt),
+		PasswordExpired:    pointer.GetBool(rpcUser.PasswordExpired),
+		Language:           rpcUser.Language,
 	}
 	if rpcUser.TotpInfo != nil {
 		userInfo.TotpInfo = &types.TotpInfo{
@@ -58,27 +63,29 @@ func ConvertApiUserInfoToRpcUserInfo(apiUser *types.UserInfo) *userservice.UserI
 	}
 
 	return &userservice.UserInfo{
-		Id:              pointer.ToStringPtrIfNotEmpty(apiUser.UserId),
-		CreatedAt:       pointer.ToInt64PtrIfNotNil(apiUser.CreatedAt),
-		UpdatedAt:       pointer.ToInt64PtrIfNotNil(apiUser.UpdatedAt),
-		Username:        pointer.ToStringPtrIfNotEmpty(apiUser.Username),
-		Email:           pointer.ToStringPtrIfNotEmpty(apiUser.Email),
-		FullName:        pointer.ToStringPtrIfNotEmpty(apiUser.RealName),
-		Mobile:          pointer.ToStringPtrIfNotEmpty(apiUser.Mobile),
-		Avatar:          pointer.ToStringPtrIfNotEmpty(apiUser.Avatar),
-		UserDescription: pointer.ToStringPtrIfNotEmpty(apiUser.Desc),
-		LastLoginAt:     pointer.ToInt64PtrIfNotNil(apiUser.LastLoginAt),
-		LastLoginIp:     pointer.ToStringPtrIfNotEmpty(apiUser.LastLoginIp),
-		State:           toBoolPtrIfNotFalse(apiUser.State),
-		RoleIds:         apiUser.RoleIds,
-		RoleValues:      apiUser.Roles,
-		DepartmentId:    pointer.ToUint32PtrIfNotZero(apiUser.DepartmentId),
-		PositionIds:     apiUser.PositionIds,
-		HomePath:        pointer.ToStringPtrIfNotEmpty(apiUser.HomePath),
-		RoleNames:       apiUser.RoleNames,
-		DepartmentName:  pointer.ToStringPtrIfNotEmpty(apiUser.DepartmentName),
-		PositionNames:   apiUser.PositionNames,
-		PasswordHash:    apiUser.Password,
+		Id:                 pointer.ToStringPtrIfNotEmpty(apiUser.UserId),
+		CreatedAt:          pointer.ToInt64PtrIfNotNil(apiUser.CreatedAt),
+		UpdatedAt:          pointer.ToInt64PtrIfNotNil(apiUser.UpdatedAt),
+		Username:           pointer.ToStringPtrIfNotEmpty(apiUser.Username),
+		Email:              pointer.ToStringPtrIfNotEmpty(apiUser.Email),
+		FullName:           pointer.ToStringPtrIfNotEmpty(apiUser.RealName),
+		Mobile:             pointer.ToStringPtrIfNotEmpty(apiUser.Mobile),
+		Avatar:             pointer.ToStringPtrIfNotEmpty(apiUser.Avatar),
+		UserDescription:    pointer.ToStringPtrIfNotEmpty(apiUser.Desc),
+		LastLoginAt:        pointer.ToInt64PtrIfNotNil(apiUser.LastLoginAt),
+		LastLoginIp:        pointer.ToStringPtrIfNotEmpty(apiUser.LastLoginIp),
+		State:              toBoolPtrIfNotFalse(apiUser.State),
+		RoleIds:            apiUser.RoleIds,
+		RoleValues:         apiUser.Roles,
+		DepartmentId:       pointer.ToUint32PtrIfNotZero(apiUser.DepartmentId),
+		PositionIds:        apiUser.PositionIds,
+		HomePath:           pointer.ToStringPtrIfNotEmpty(apiUser.HomePath),
+		RoleNames:          apiUser.RoleNames,
+		DepartmentName:     pointer.ToStringPtrIfNotEmpty(apiUser.DepartmentName),
+		PositionNames:      apiUser.PositionNames,
+		PasswordHash:       apiUser.Password,
+		MustChangePassword: apiUser.MustChangePassword,
+		Language:           apiUser.Language,
 	}
 }
 
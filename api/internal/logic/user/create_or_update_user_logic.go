@@ -38,6 +38,12 @@ func (l *CreateOrUpdateUserLogic) CreateOrUpdateUser(req *types.UserInfo) (resp
 	if req.UserId != "" {
 		// 更新用户
 		rpcResp, err = l.svcCtx.UserRpc.UpdateUser(l.ctx, rpcReq)
+		if err == nil && req.Language != nil {
+			clearUserLanguageCache(l.ctx, l.svcCtx, l.Logger, req.UserId)
+		}
+		if err == nil {
+			clearUserRolesCache(l.ctx, l.svcCtx, l.Logger, req.UserId)
+		}
 	} else {
 		// 创建用户
 		rpcResp, err = l.svcCtx.UserRpc.CreateUser(l.ctx, rpcReq)
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWebauthnCredentialLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除WebAuthn凭证
+func NewDeleteWebauthnCredentialLogic(r *http.Request, svcCtx *svc.ServiceContext) *DeleteWebauthnCredentialLogic {
+	return &DeleteWebauthnCredentialLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *DeleteWebauthnCredentialLogic) DeleteWebauthnCredential(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	userID := l.ctx.Value("userId").(string)
+	rpcResp, err := l.svcCtx.UserRpc.DeleteWebauthnCredential(l.ctx, &userservice.WebauthnDeleteCredentialRequest{
+		UserId: userID,
+		Id:     req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypeMfaDisable, securityevent.ProviderWebauthn, nil)
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: rpcResp.Message,
+	}
+	return
+}
@@ -3,12 +3,15 @@ package user
 import (
 	"context"
 
+	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 
 	"net/http"
 
+	"github.com/zeromicro/go-zero/core/errorx"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -29,14 +32,37 @@ func NewDisableTotpLogic(r *http.Request, svcCtx *svc.ServiceContext) *DisableTo
 	}
 }
 
-func (l *DisableTotpLogic) DisableTotp(req *types.UUIDRequest) (resp *types.BaseResponse, err error) {
+func (l *DisableTotpLogic) DisableTotp(req *types.DisableTotpRequest) (resp *types.BaseResponse, err error) {
+	userID := l.ctx.Value("userId").(string)
+
+	// 关闭前需验证当前TOTP验证码
+	verifyResp, err := l.svcCtx.UserRpc.VerifyTotpCode(l.ctx, &userservice.VerifyTotpCodeRequest{
+		UserId:   userID,
+		TotpCode: req.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if verifyResp.IsValid == false {
+		recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypeMfaChallenge, securityevent.ProviderTotp,
+			errorx.NewApiError(errorx.CodeTOTPVerifyFailed, "totp.verifyFailed"))
+		return nil, errorx.NewApiError(errorx.CodeTOTPVerifyFailed, "totp.verifyFailed")
+	}
+
 	response, err := l.svcCtx.UserRpc.DisableTotp(l.ctx, &userservice.DisableTotpRequest{
-		UserId: req.ID,
+		UserId: userID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypeMfaDisable, securityevent.ProviderTotp, nil)
 
+	// 按需退出其他设备上的会话
+	if pointer.GetBool(req.RevokeOtherSessions) {
+		if _, err = revokeOtherSessions(l.ctx, l.r, l.svcCtx, revokeReasonMfaDisable); err != nil {
+			return nil, err
+		}
+	}
 
 	resp = &types.BaseResponse{
 		Code:    0,
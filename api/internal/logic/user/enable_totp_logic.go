@@ -31,7 +31,7 @@ func NewEnableTotpLogic(r *http.Request, svcCtx *svc.ServiceContext) *EnableTotp
 
 func (l *EnableTotpLogic) EnableTotp(req *types.EnableTotpRequest) (resp *types.TotpSetupResponse, err error) {
 	response, err := l.svcCtx.UserRpc.EnableTotp(l.ctx, &userservice.EnableTotpRequest{
-		UserId: req.UserId,
+		UserId: l.ctx.Value("userId").(string),
 		Domain: req.Domain,
 		Issuer: req.Issuer,
 	})
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportUserLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导出用户(CSV/XLSX)
+func NewExportUserLogic(r *http.Request, svcCtx *svc.ServiceContext) *ExportUserLogic {
+	return &ExportUserLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+// ExportUser 返回导出文件内容及文件名
+func (l *ExportUserLogic) ExportUser(req *types.UserExportRequest) (data []byte, filename string, err error) {
+	result, err := l.svcCtx.UserRpc.ExportUser(l.ctx, &userservice.UserListRequest{
+		Username: pointer.ToStringPtrIfNotEmpty(req.Username),
+		Mobile:   pointer.ToStringPtrIfNotEmpty(req.Mobile),
+		Email:    pointer.ToStringPtrIfNotEmpty(req.Email),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err = WriteUserFile(req.Format, result.Rows)
+	if err != nil {
+		l.Errorw("生成用户导出文件失败", logx.Field("detail", err.Error()))
+		return nil, "", errorx.NewInternalError("user.exportFailed")
+	}
+
+	filename = fmt.Sprintf("users-%s.%s", time.Now().Format("20060102150405"), req.Format)
+	return data, filename, nil
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserEffectiveRolesLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取用户有效角色及来源
+func NewGetUserEffectiveRolesLogic(r *http.Request, svcCtx *svc.ServiceContext) *GetUserEffectiveRolesLogic {
+	return &GetUserEffectiveRolesLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *GetUserEffectiveRolesLogic) GetUserEffectiveRoles(req *types.UUIDRequest) (resp *types.EffectiveRoleListResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.GetEffectiveRoles(l.ctx, &userservice.UUIDRequest{Id: req.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.EffectiveRoleInfo, 0, len(rpcResp.List))
+	for _, r := range rpcResp.List {
+		info := types.EffectiveRoleInfo{
+			RoleId:    r.RoleId,
+			RoleValue: r.RoleValue,
+			RoleName:  r.RoleName,
+			Sources:   make([]types.RoleSource, 0, len(r.Sources)),
+		}
+		for _, source := range r.Sources {
+			info.Sources = append(info.Sources, types.RoleSource{
+				Type: source.Type,
+				Id:   source.Id,
+				Name: source.Name,
+			})
+		}
+		list = append(list, info)
+	}
+
+	resp = &types.EffectiveRoleListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.EffectiveRoleListInfo{List: list},
+	}
+	return
+}
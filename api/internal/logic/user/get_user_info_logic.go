@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 
 	"net/http"
@@ -44,7 +45,7 @@ func (l *GetUserInfoLogic) GetUserInfo() (resp *types.UserInfoResponse, err erro
 		Data: types.UserInfo{
 			Avatar:         pointer.GetString(u.Avatar),
 			RealName:       pointer.GetString(u.FullName),
-			Roles:          u.RoleValues,
+			Roles:          u.EffectiveRoleValues,
 			UserId:         pointer.GetString(u.Id),
 			Username:       pointer.GetString(u.Username),
 			Desc:           pointer.GetString(u.UserDescription),
@@ -62,6 +63,8 @@ func (l *GetUserInfoLogic) GetUserInfo() (resp *types.UserInfoResponse, err erro
 			LastLoginAt:    pointer.GetInt64(u.LastLoginAt),
 			LastLoginIp:    pointer.GetString(u.LastLoginIp),
 			RoleIds:        u.RoleIds,
+			WebauthnCount:  pointer.GetUint32(u.WebauthnCount),
+			ImpersonatorId: jwtutils.GetImpersonatorId(l.ctx),
 		},
 	}
 
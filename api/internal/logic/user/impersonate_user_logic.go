@@ -0,0 +1,94 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	lastHttp "github.com/wenpiner/last-admin-common/utils/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// impersonationTokenType 模拟登录令牌类型
+const impersonationTokenType = "impersonation_token"
+
+type ImpersonateUserLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 模拟用户登录，签发短期令牌
+func NewImpersonateUserLogic(r *http.Request, svcCtx *svc.ServiceContext) *ImpersonateUserLogic {
+	return &ImpersonateUserLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ImpersonateUserLogic) ImpersonateUser(req *types.ImpersonateRequest) (resp *types.LoginResponse, err error) {
+	// 不允许在模拟会话中再次发起模拟
+	if jwtutils.GetImpersonatorId(l.ctx) != "" {
+		return nil, errorx.NewApiForbiddenError("impersonation.forbidden")
+	}
+
+	impersonatorId := l.ctx.Value("userId").(string)
+	user, err := l.svcCtx.UserRpc.StartImpersonation(l.ctx, &userservice.ImpersonationRequest{
+		ImpersonatorId: impersonatorId,
+		UserId:         req.UserId,
+		Reason:         req.Reason,
+		IpAddress:      pointer.ToStringPtr(lastHttp.GetIP(l.r)),
+		UserAgent:      pointer.ToStringPtr(l.r.UserAgent()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expire := l.svcCtx.Config.Auth.ImpersonateExpire
+	accessToken, err := jwtutils.GenerateToken(user, expire, l.svcCtx.Config.Auth.AccessSecret, map[string]any{
+		jwtutils.ImpersonatorIdKey: impersonatorId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, _ := json.Marshal(map[string]string{jwtutils.ImpersonatorIdKey: impersonatorId})
+	_, err = l.svcCtx.TokenRpc.CreateToken(l.ctx, &tokenservice.CreateTokenRequest{
+		TokenValue: accessToken,
+		TokenType:  impersonationTokenType,
+		UserId:     user.Id,
+		ExpiresAt:  time.Now().Add(time.Second * time.Duration(expire)).Unix(),
+		DeviceInfo: pointer.ToStringPtr(l.r.UserAgent()),
+		IpAddress:  pointer.ToStringPtr(lastHttp.GetIP(l.r)),
+		UserAgent:  pointer.ToStringPtr(l.r.UserAgent()),
+		Metadata:   pointer.ToStringPtr(string(metadata)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.LoginResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "impersonation.started",
+		},
+		Data: types.LoginInfo{
+			AccessToken: accessToken,
+		},
+	}
+	return
+}
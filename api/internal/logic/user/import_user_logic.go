@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxImportFileSize 导入文件大小上限
+const maxImportFileSize = 10 << 20
+
+type ImportUserLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 导入用户(CSV/XLSX，表单字段 file)
+func NewImportUserLogic(r *http.Request, svcCtx *svc.ServiceContext) *ImportUserLogic {
+	return &ImportUserLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ImportUserLogic) ImportUser(req *types.UserImportRequest) (resp *types.UserImportResponse, err error) {
+	file, header, err := l.r.FormFile("file")
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+	}
+	defer file.Close()
+	if header.Size > maxImportFileSize {
+		return nil, errorx.NewInvalidArgumentError("user.importFileTooLarge")
+	}
+
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(header.Filename), "."))
+	rows, err := ParseUserFile(format, file)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := l.svcCtx.UserRpc.ImportUser(l.ctx, &userservice.UserImportRequest{
+		Rows:      rows,
+		DryRun:    req.DryRun,
+		BatchSize: pointer.ToUint32PtrIfNotZero(req.BatchSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := types.UserImportResult{
+		Total:   result.Total,
+		Success: result.Success,
+		Failed:  result.Failed,
+		DryRun:  result.DryRun,
+		Results: make([]types.UserImportRowResult, 0, len(result.Results)),
+	}
+	for _, row := range result.Results {
+		item := types.UserImportRowResult{
+			RowNumber:       row.RowNumber,
+			Username:        row.Username,
+			Success:         row.Success,
+			Errors:          make([]types.UserImportRowError, 0, len(row.Errors)),
+			InitialPassword: pointer.GetString(row.InitialPassword),
+		}
+		for _, e := range row.Errors {
+			item.Errors = append(item.Errors, types.UserImportRowError{
+				Field:   e.Field,
+				Message: l.svcCtx.Trans.Trans(l.ctx, e.Message),
+				Value:   pointer.GetString(e.Value),
+			})
+		}
+		data.Results = append(data.Results, item)
+	}
+
+	resp = &types.UserImportResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: data,
+	}
+	return resp, nil
+}
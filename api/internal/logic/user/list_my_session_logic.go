@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxSessions 会话列表最多返回的数量
+const maxSessions = 100
+
+type ListMySessionLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取我的登录会话
+func NewListMySessionLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListMySessionLogic {
+	return &ListMySessionLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListMySessionLogic) ListMySession() (resp *types.SessionListResponse, err error) {
+	// 会话数量有限，不分页，最多返回最近的 maxSessions 条
+	rpcResp, err := l.svcCtx.TokenRpc.ListToken(l.ctx, &tokenservice.TokenListRequest{
+		Page: &tokenservice.BasePageRequest{
+			PageNumber: 1,
+			PageSize:   maxSessions,
+		},
+		UserId:    pointer.ToStringPtr(l.ctx.Value("userId").(string)),
+		TokenType: pointer.ToStringPtr(sessionTokenType),
+		Active:    pointer.ToBoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current := jwtutils.GetToken(l.r.Header.Get("Authorization"))
+	list := make([]types.SessionInfo, 0, len(rpcResp.List))
+	for _, token := range rpcResp.List {
+		// 不向前端返回令牌值
+		list = append(list, types.SessionInfo{
+			Id:         pointer.GetUint32(token.Id),
+			CreatedAt:  pointer.GetInt64(token.CreatedAt),
+			LastUsedAt: token.LastUsedAt,
+			ExpiresAt:  pointer.GetInt64(token.ExpiresAt),
+			DeviceInfo: token.DeviceInfo,
+			IpAddress:  token.IpAddress,
+			UserAgent:  token.UserAgent,
+			Current:    pointer.GetString(token.TokenValue) == current,
+		})
+	}
+
+	resp = &types.SessionListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.SessionListInfo{
+			List: list,
+		},
+	}
+	return
+}
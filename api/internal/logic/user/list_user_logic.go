@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 
 	"net/http"
@@ -33,13 +34,11 @@ func NewListUserLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListUserLogi
 func (l *ListUserLogic) ListUser(req *types.UserListRequest) (resp *types.UserListResponse, err error) {
 	// 构建 RPC 请求
 	rpcReq := &userservice.UserListRequest{
-		Page: &userservice.BasePageRequest{
-			PageNumber: req.Page.CurrentPage,
-			PageSize:   req.Page.PageSize,
-		},
+		Page:     pageutils.Request(req.Page),
 		Username: pointer.ToStringPtrIfNotEmpty(req.Username),
 		Mobile:   pointer.ToStringPtrIfNotEmpty(req.Mobile),
 		Email:    pointer.ToStringPtrIfNotEmpty(req.Email),
+		RoleIds:  req.RoleIds,
 	}
 
 	// 调用 RPC 服务获取用户列表
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWebauthnCredentialLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取当前用户WebAuthn凭证列表
+func NewListWebauthnCredentialLogic(r *http.Request, svcCtx *svc.ServiceContext) *ListWebauthnCredentialLogic {
+	return &ListWebauthnCredentialLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ListWebauthnCredentialLogic) ListWebauthnCredential() (resp *types.WebauthnCredentialListResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.ListWebauthnCredential(l.ctx, &userservice.UUIDRequest{
+		Id: l.ctx.Value("userId").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.WebauthnCredentialInfo, 0, len(rpcResp.List))
+	for _, credential := range rpcResp.List {
+		list = append(list, types.WebauthnCredentialInfo{
+			Id:             pointer.GetUint32(credential.Id),
+			CreatedAt:      pointer.GetInt64(credential.CreatedAt),
+			FriendlyName:   pointer.GetString(credential.FriendlyName),
+			SignCount:      pointer.GetUint32(credential.SignCount),
+			Aaguid:         pointer.GetString(credential.Aaguid),
+			Transports:     credential.Transports,
+			Attachment:     pointer.GetString(credential.Attachment),
+			BackupEligible: pointer.GetBool(credential.BackupEligible),
+			BackupState:    pointer.GetBool(credential.BackupState),
+			CloneWarning:   pointer.GetBool(credential.CloneWarning),
+			LastUsedAt:     pointer.GetInt64(credential.LastUsedAt),
+			State:          pointer.GetBool(credential.State),
+		})
+	}
+
+	resp = &types.WebauthnCredentialListResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: list,
+	}
+	return
+}
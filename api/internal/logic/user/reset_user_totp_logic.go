@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetUserTotpLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 重置用户TOTP(用户丢失设备时由管理员关闭)
+func NewResetUserTotpLogic(r *http.Request, svcCtx *svc.ServiceContext) *ResetUserTotpLogic {
+	return &ResetUserTotpLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *ResetUserTotpLogic) ResetUserTotp(req *types.UUIDRequest) (resp *types.BaseResponse, err error) {
+	response, err := l.svcCtx.UserRpc.DisableTotp(l.ctx, &userservice.DisableTotpRequest{
+		UserId: req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 记录在被重置的用户名下，原因中注明操作的管理员
+	securityevent.Record(l.ctx, l.r, l.svcCtx.SecurityEventRpc, securityevent.Event{
+		Type:     securityevent.TypeMfaDisable,
+		UserID:   req.ID,
+		Success:  true,
+		Provider: securityevent.ProviderTotp,
+		Reason:   "resetBy:" + l.ctx.Value("userId").(string),
+	})
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: response.Message,
+	}
+
+	return
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeMySessionLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 退出指定会话
+func NewRevokeMySessionLogic(r *http.Request, svcCtx *svc.ServiceContext) *RevokeMySessionLogic {
+	return &RevokeMySessionLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *RevokeMySessionLogic) RevokeMySession(req *types.ID32Request) (resp *types.BaseResponse, err error) {
+	userID := l.ctx.Value("userId").(string)
+	_, err = l.svcCtx.TokenRpc.RevokeUserTokens(l.ctx, &tokenservice.RevokeUserTokensRequest{
+		UserId:     userID,
+		Id:         &req.ID,
+		TokenTypes: []string{sessionTokenType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordRevoke(l.ctx, l.r, l.svcCtx, userID, revokeReasonSignOut)
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: "session.revoked",
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeOtherSessionsLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 退出其他所有会话
+func NewRevokeOtherSessionsLogic(r *http.Request, svcCtx *svc.ServiceContext) *RevokeOtherSessionsLogic {
+	return &RevokeOtherSessionsLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *RevokeOtherSessionsLogic) RevokeOtherSessions() (resp *types.RevokeSessionsResponse, err error) {
+	revokedCount, err := revokeOtherSessions(l.ctx, l.r, l.svcCtx, revokeReasonSignOutOthers)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.RevokeSessionsResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "session.othersRevoked",
+		},
+		Data: types.RevokeSessionsInfo{
+			RevokedCount: revokedCount,
+		},
+	}
+	return
+}
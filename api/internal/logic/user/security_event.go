@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+)
+
+// 令牌撤销事件的触发原因
+const (
+	revokeReasonSignOut        = "signOut"
+	revokeReasonSignOutOthers  = "signOutOthers"
+	revokeReasonPasswordChange = "passwordChange"
+	revokeReasonMfaDisable     = "mfaDisable"
+)
+
+// recordEvent 记录指定用户的安全事件，err 不为空时记为失败
+func recordEvent(ctx context.Context, r *http.Request, svcCtx *svc.ServiceContext, userID, eventType, provider string, err error) {
+	event := securityevent.Event{
+		Type:     eventType,
+		UserID:   userID,
+		Success:  err == nil,
+		Provider: provider,
+	}
+	if err != nil {
+		event.Reason = securityevent.Reason(err)
+	}
+	securityevent.Record(ctx, r, svcCtx.SecurityEventRpc, event)
+}
+
+// recordRevoke 记录令牌撤销事件
+func recordRevoke(ctx context.Context, r *http.Request, svcCtx *svc.ServiceContext, userID, reason string) {
+	securityevent.Record(ctx, r, svcCtx.SecurityEventRpc, securityevent.Event{
+		Type:    securityevent.TypeTokenRevoke,
+		UserID:  userID,
+		Success: true,
+		Reason:  reason,
+	})
+}
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"google.golang.org/grpc"
+)
+
+// fakeUserRpc 记录自助接口传给用户服务的请求
+type fakeUserRpc struct {
+	userservice.UserService
+	verified string
+	disabled string
+	password *userservice.ChangePasswordRequest
+	profile  *userservice.UserInfo
+}
+
+func (f *fakeUserRpc) VerifyTotpCode(_ context.Context, in *userservice.VerifyTotpCodeRequest, _ ...grpc.CallOption) (*userservice.VerifyTotpCodeResponse, error) {
+	f.verified = in.UserId
+	return &userservice.VerifyTotpCodeResponse{IsValid: true}, nil
+}
+
+func (f *fakeUserRpc) DisableTotp(_ context.Context, in *userservice.DisableTotpRequest, _ ...grpc.CallOption) (*userservice.BaseResponse, error) {
+	f.disabled = in.UserId
+	return &userservice.BaseResponse{}, nil
+}
+
+func (f *fakeUserRpc) ChangePassword(_ context.Context, in *userservice.ChangePasswordRequest, _ ...grpc.CallOption) (*userservice.BaseResponse, error) {
+	f.password = in
+	return &userservice.BaseResponse{}, nil
+}
+
+func (f *fakeUserRpc) UpdateUser(_ context.Context, in *userservice.UserInfo, _ ...grpc.CallOption) (*userservice.UserInfo, error) {
+	f.profile = in
+	return in, nil
+}
+
+func newSelfServiceContext() (*svc.ServiceContext, *fakeUserRpc) {
+	users := &fakeUserRpc{}
+	return &svc.ServiceContext{UserRpc: users, SecurityEventRpc: &fakeSecurityEventRpc{}}, users
+}
+
+func TestSelfServiceUsesJwtUser(t *testing.T) {
+	svcCtx, users := newSelfServiceContext()
+
+	if _, err := NewDisableTotpLogic(newSessionRequest(), svcCtx).DisableTotp(&types.DisableTotpRequest{Code: "123456"}); err != nil {
+		t.Fatal(err)
+	}
+	if users.verified != testUserID || users.disabled != testUserID {
+		t.Fatalf("totp verified for %q and disabled for %q, want %q", users.verified, users.disabled, testUserID)
+	}
+
+	_, err := NewChangePasswordLogic(newSessionRequest(), svcCtx).ChangePassword(&types.ChangePasswordRequest{
+		OldPassword: "old",
+		NewPassword: "new",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 自助修改密码必须校验当前密码
+	if in := users.password; in.UserId != testUserID || pointer.GetString(in.OldPassword) != "old" || in.NewPassword != "new" {
+		t.Fatalf("unexpected change password request: %+v", in)
+	}
+
+	_, err = NewUpdateProfileLogic(newSessionRequest(), svcCtx).UpdateProfile(&types.UpdateProfileRequest{
+		RealName: pointer.ToStringPtr("Alice"),
+		HomePath: pointer.ToStringPtr("/dashboard/workspace"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 只提交个人资料字段，不修改角色、部门及状态
+	in := users.profile
+	if pointer.GetString(in.Id) != testUserID || pointer.GetString(in.FullName) != "Alice" ||
+		pointer.GetString(in.HomePath) != "/dashboard/workspace" ||
+		in.RoleIds != nil || in.DepartmentId != nil || in.State != nil || in.PasswordHash != nil {
+		t.Fatalf("unexpected profile update: %+v", in)
+	}
+}
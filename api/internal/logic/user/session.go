@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+)
+
+// sessionTokenType 登录会话对应的令牌类型
+const sessionTokenType = "access_token"
+
+// revokeOtherSessions 退出当前用户除本次请求所用会话外的其他登录会话，reason 为触发原因
+func revokeOtherSessions(ctx context.Context, r *http.Request, svcCtx *svc.ServiceContext, reason string) (int64, error) {
+	userID := ctx.Value("userId").(string)
+	resp, err := svcCtx.TokenRpc.RevokeUserTokens(ctx, &tokenservice.RevokeUserTokensRequest{
+		UserId:           userID,
+		ExceptTokenValue: pointer.ToStringPtr(jwtutils.GetToken(r.Header.Get("Authorization"))),
+		TokenTypes:       []string{sessionTokenType},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if resp.RevokedCount > 0 {
+		recordRevoke(ctx, r, svcCtx, userID, reason)
+	}
+	return resp.RevokedCount, nil
+}
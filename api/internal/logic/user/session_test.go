@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"google.golang.org/grpc"
+)
+
+const testUserID = "0192f6c4-7e2a-7000-8000-000000000001"
+
+// fakeTokenRpc 返回固定的会话列表，并记录收到的请求
+type fakeTokenRpc struct {
+	tokenservice.TokenService
+	sessions []*tokenservice.TokenInfo
+	list     *tokenservice.TokenListRequest
+	revoke   *tokenservice.RevokeUserTokensRequest
+}
+
+func (f *fakeTokenRpc) ListToken(_ context.Context, in *tokenservice.TokenListRequest, _ ...grpc.CallOption) (*tokenservice.TokenListResponse, error) {
+	f.list = in
+	return &tokenservice.TokenListResponse{List: f.sessions}, nil
+}
+
+func (f *fakeTokenRpc) RevokeUserTokens(_ context.Context, in *tokenservice.RevokeUserTokensRequest, _ ...grpc.CallOption) (*tokenservice.RevokeUserTokensResponse, error) {
+	f.revoke = in
+	return &tokenservice.RevokeUserTokensResponse{RevokedCount: int64(len(f.sessions) - 1)}, nil
+}
+
+// fakeSecurityEventRpc 记录写入的安全事件
+type fakeSecurityEventRpc struct {
+	securityeventservice.SecurityEventService
+	events []*securityeventservice.SecurityEventInfo
+}
+
+func (f *fakeSecurityEventRpc) CreateSecurityEvent(_ context.Context, in *securityeventservice.SecurityEventInfo, _ ...grpc.CallOption) (*securityeventservice.BaseResponse, error) {
+	f.events = append(f.events, in)
+	return &securityeventservice.BaseResponse{}, nil
+}
+
+// newSessionRequest 以 testUserID 登录且使用 current 令牌的请求
+func newSessionRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/user/session/list", nil)
+	r.Header.Set("Authorization", "Bearer current")
+	return r.WithContext(context.WithValue(r.Context(), "userId", testUserID))
+}
+
+func newSessionContext() (*svc.ServiceContext, *fakeTokenRpc, *fakeSecurityEventRpc) {
+	tokens := &fakeTokenRpc{sessions: []*tokenservice.TokenInfo{
+		{Id: pointer.ToUint32Ptr(1), TokenValue: pointer.ToStringPtr("current")},
+		{Id: pointer.ToUint32Ptr(2), TokenValue: pointer.ToStringPtr("other")},
+	}}
+	events := &fakeSecurityEventRpc{}
+	return &svc.ServiceContext{TokenRpc: tokens, SecurityEventRpc: events}, tokens, events
+}
+
+func TestListMySession(t *testing.T) {
+	svcCtx, tokens, _ := newSessionContext()
+	resp, err := NewListMySessionLogic(newSessionRequest(), svcCtx).ListMySession()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 仅查询当前用户有效的登录会话
+	in := tokens.list
+	if pointer.GetString(in.UserId) != testUserID || pointer.GetString(in.TokenType) != sessionTokenType || !pointer.GetBool(in.Active) {
+		t.Fatalf("unexpected list request: %+v", in)
+	}
+	if len(resp.Data.List) != 2 || !resp.Data.List[0].Current || resp.Data.List[1].Current {
+		t.Fatalf("only the session of this request is current: %+v", resp.Data.List)
+	}
+}
+
+func TestRevokeOtherSessionsKeepsCurrent(t *testing.T) {
+	svcCtx, tokens, events := newSessionContext()
+	resp, err := NewRevokeOtherSessionsLogic(newSessionRequest(), svcCtx).RevokeOtherSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.RevokedCount != 1 {
+		t.Fatalf("revoked %d, want 1", resp.Data.RevokedCount)
+	}
+
+	in := tokens.revoke
+	if in.UserId != testUserID || pointer.GetString(in.ExceptTokenValue) != "current" ||
+		len(in.TokenTypes) != 1 || in.TokenTypes[0] != sessionTokenType {
+		t.Fatalf("unexpected revoke request: %+v", in)
+	}
+	if len(events.events) != 1 || pointer.GetString(events.events[0].EventType) != securityevent.TypeTokenRevoke ||
+		pointer.GetString(events.events[0].Reason) != revokeReasonSignOutOthers {
+		t.Fatalf("unexpected events: %+v", events.events)
+	}
+}
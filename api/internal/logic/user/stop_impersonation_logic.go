@@ -0,0 +1,73 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	lastHttp "github.com/wenpiner/last-admin-common/utils/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StopImpersonationLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 结束模拟登录
+func NewStopImpersonationLogic(r *http.Request, svcCtx *svc.ServiceContext) *StopImpersonationLogic {
+	return &StopImpersonationLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *StopImpersonationLogic) StopImpersonation() (resp *types.BaseResponse, err error) {
+	impersonatorId := jwtutils.GetImpersonatorId(l.ctx)
+	if impersonatorId == "" {
+		return nil, errorx.NewApiBadRequestError("impersonation.notActive")
+	}
+
+	// 作废当前模拟令牌
+	accessToken := jwtutils.GetToken(l.r.Header.Get("Authorization"))
+	token, err := l.svcCtx.TokenRpc.GetTokenByValue(l.ctx, &tokenservice.StringRequest{Value: accessToken})
+	if err != nil {
+		return nil, err
+	}
+	_, err = l.svcCtx.TokenRpc.UpdateToken(l.ctx, &tokenservice.TokenInfo{
+		Id:    token.Id,
+		State: pointer.ToBoolPtr(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = l.svcCtx.UserRpc.StopImpersonation(l.ctx, &userservice.ImpersonationRequest{
+		ImpersonatorId: impersonatorId,
+		UserId:         l.ctx.Value("userId").(string),
+		IpAddress:      pointer.ToStringPtr(lastHttp.GetIP(l.r)),
+		UserAgent:      pointer.ToStringPtr(l.r.UserAgent()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: "impersonation.stopped",
+	}
+	return
+}
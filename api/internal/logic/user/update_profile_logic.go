@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/middleware"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改个人资料
+func NewUpdateProfileLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateProfileLogic) UpdateProfile(req *types.UpdateProfileRequest) (resp *types.UserInfoResponse, err error) {
+	userId := l.ctx.Value("userId").(string)
+	// 仅允许修改个人资料字段，角色、部门、状态等由管理员维护
+	u, err := l.svcCtx.UserRpc.UpdateUser(l.ctx, &userservice.UserInfo{
+		Id:              pointer.ToStringPtr(userId),
+		FullName:        req.RealName,
+		Avatar:          req.Avatar,
+		Email:           req.Email,
+		Mobile:          req.Mobile,
+		HomePath:        req.HomePath,
+		UserDescription: req.Desc,
+		Language:        req.Language,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Language != nil {
+		clearUserLanguageCache(l.ctx, l.svcCtx, l.Logger, userId)
+	}
+
+	resp = &types.UserInfoResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: *ConvertRpcUserInfoToApiUserInfo(u),
+	}
+
+	return
+}
+
+// clearUserLanguageCache 删除用户偏好语言缓存，下次请求重新读取
+func clearUserLanguageCache(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userId string) {
+	if err := svcCtx.Redis.Del(ctx, middleware.UserLanguageCacheKey(userId)).Err(); err != nil {
+		logger.Errorw("删除用户偏好语言缓存失败", logx.Field("detail", err.Error()))
+	}
+}
+
+// clearUserRolesCache 删除用户有效角色缓存，角色、岗位或部门变更后立即生效
+func clearUserRolesCache(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userId string) {
+	if err := svcCtx.Redis.Del(ctx, middleware.UserRolesCacheKey(userId)).Err(); err != nil {
+		logger.Errorw("删除用户有效角色缓存失败", logx.Field("detail", err.Error()))
+	}
+}
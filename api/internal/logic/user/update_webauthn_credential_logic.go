@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateWebauthnCredentialLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 修改WebAuthn凭证名称
+func NewUpdateWebauthnCredentialLogic(r *http.Request, svcCtx *svc.ServiceContext) *UpdateWebauthnCredentialLogic {
+	return &UpdateWebauthnCredentialLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UpdateWebauthnCredentialLogic) UpdateWebauthnCredential(req *types.WebauthnUpdateCredentialRequest) (resp *types.BaseResponse, err error) {
+	_, err = l.svcCtx.UserRpc.UpdateWebauthnCredential(l.ctx, &userservice.WebauthnUpdateCredentialRequest{
+		UserId:       l.ctx.Value("userId").(string),
+		Id:           req.Id,
+		FriendlyName: &req.FriendlyName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: "success",
+	}
+	return
+}
@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/filetransfer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UploadAvatarLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 上传头像(表单字段 file)
+func NewUploadAvatarLogic(r *http.Request, svcCtx *svc.ServiceContext) *UploadAvatarLogic {
+	return &UploadAvatarLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *UploadAvatarLogic) UploadAvatar() (resp *types.UserInfoResponse, err error) {
+	userId := l.ctx.Value("userId").(string)
+	conf := l.svcCtx.Config.FileConf
+
+	f, header, err := l.r.FormFile("file")
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("file.invalid")
+	}
+	defer f.Close()
+	if header.Size > conf.AvatarMaxSize {
+		return nil, errorx.NewInvalidArgumentError("file.tooLarge")
+	}
+
+	mimeType, content, err := filetransfer.DetectType(f)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError("file.invalid")
+	}
+	if !strings.HasPrefix(mimeType, "image/") {
+		return nil, errorx.NewInvalidArgumentError("file.typeNotAllowed")
+	}
+
+	old, err := l.svcCtx.UserRpc.GetUser(l.ctx, &userservice.UUIDRequest{Id: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := filetransfer.Upload(l.ctx, l.svcCtx.FileRpc, content, &fileservice.FileInfo{
+		OwnerId:  pointer.ToStringPtr(userId),
+		Name:     pointer.ToStringPtr(filepath.Base(header.Filename)),
+		Category: pointer.ToStringPtr("avatar"),
+		MimeType: pointer.ToStringPtr(mimeType),
+	}, conf.AvatarMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := l.svcCtx.UserRpc.UpdateUser(l.ctx, &userservice.UserInfo{
+		Id:     pointer.ToStringPtr(userId),
+		Avatar: pointer.ToStringPtr(filetransfer.AvatarURL(conf.BaseURL, *info.Id)),
+	})
+	if err != nil {
+		l.deleteAvatar(*info.Id)
+		return nil, err
+	}
+	// 替换成功后删除本人上传的旧头像，删除失败只残留文件
+	if id, ok := filetransfer.AvatarID(pointer.GetString(old.Avatar)); ok {
+		prev, err := l.svcCtx.FileRpc.GetFile(l.ctx, &fileservice.UUIDRequest{Id: id})
+		if err == nil && pointer.GetString(prev.OwnerId) == userId && pointer.GetString(prev.Category) == "avatar" {
+			l.deleteAvatar(id)
+		}
+	}
+
+	resp = &types.UserInfoResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: *ConvertRpcUserInfoToApiUserInfo(u),
+	}
+	return
+}
+
+// deleteAvatar 删除头像文件，失败只记录日志
+func (l *UploadAvatarLogic) deleteAvatar(id string) {
+	if _, err := l.svcCtx.FileRpc.DeleteFile(l.ctx, &fileservice.UUIDSRequest{Ids: []string{id}}); err != nil {
+		l.Errorw("删除头像文件失败", logx.Field("id", id), logx.Field("detail", err.Error()))
+	}
+}
@@ -0,0 +1,200 @@
+package user
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/xuri/excelize/v2"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// 用户导入导出文件格式
+const (
+	UserFileCSV  = "csv"
+	UserFileXLSX = "xlsx"
+)
+
+// 导入导出的列，表头同时识别中文与英文名称
+const (
+	colUsername = iota
+	colFullName
+	colEmail
+	colMobile
+	colDepartmentCode
+	colPositionCodes
+	colRoleCodes
+	colCount
+)
+
+var userFileHeader = []string{"用户名", "姓名", "邮箱", "手机号", "部门编码", "岗位编码", "角色编码"}
+
+var userFileHeaderAlias = map[string]int{
+	"username":       colUsername,
+	"fullname":       colFullName,
+	"email":          colEmail,
+	"mobile":         colMobile,
+	"departmentcode": colDepartmentCode,
+	"positioncodes":  colPositionCodes,
+	"rolecodes":      colRoleCodes,
+}
+
+// ParseUserFile 解析导入文件，首行为表头，返回带行号的导入行
+func ParseUserFile(format string, r io.Reader) ([]*userservice.UserImportRow, error) {
+	var (
+		records [][]string
+		lines   []int // 每条记录在文件中的行号
+	)
+	switch format {
+	case UserFileCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+			}
+			line, _ := reader.FieldPos(0)
+			records = append(records, record)
+			lines = append(lines, line)
+		}
+	case UserFileXLSX:
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+		}
+		defer f.Close()
+		if records, err = f.GetRows(f.GetSheetName(0)); err != nil {
+			return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+		}
+		for i := range records {
+			lines = append(lines, i+1)
+		}
+	default:
+		return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+	}
+
+	if len(records) == 0 {
+		return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+	}
+
+	// 根据表头定位列
+	columns := make([]int, colCount)
+	for i := range columns {
+		columns[i] = -1
+	}
+	for i, name := range records[0] {
+		if col, ok := headerColumn(name); ok {
+			columns[col] = i
+		}
+	}
+	if columns[colUsername] < 0 || columns[colDepartmentCode] < 0 {
+		return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+	}
+
+	var rows []*userservice.UserImportRow
+	for i, record := range records[1:] {
+		cell := func(col int) string {
+			if columns[col] < 0 || columns[col] >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[columns[col]])
+		}
+
+		// 跳过空行
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		rows = append(rows, &userservice.UserImportRow{
+			RowNumber:      uint32(lines[i+1]),
+			Username:       cell(colUsername),
+			FullName:       pointer.ToStringPtrIfNotEmpty(cell(colFullName)),
+			Email:          pointer.ToStringPtrIfNotEmpty(cell(colEmail)),
+			Mobile:         pointer.ToStringPtrIfNotEmpty(cell(colMobile)),
+			DepartmentCode: cell(colDepartmentCode),
+			PositionCodes:  splitCodes(cell(colPositionCodes)),
+			RoleCodes:      splitCodes(cell(colRoleCodes)),
+		})
+	}
+	return rows, nil
+}
+
+// WriteUserFile 按导入格式生成导出文件，导出文件可直接用于导入
+func WriteUserFile(format string, rows []*userservice.UserImportRow) ([]byte, error) {
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, userFileHeader)
+	for _, row := range rows {
+		records = append(records, []string{
+			row.Username,
+			pointer.GetString(row.FullName),
+			pointer.GetString(row.Email),
+			pointer.GetString(row.Mobile),
+			row.DepartmentCode,
+			strings.Join(row.PositionCodes, ","),
+			strings.Join(row.RoleCodes, ","),
+		})
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case UserFileCSV:
+		// 写入 BOM，便于 Excel 正确识别 UTF-8
+		buf.WriteString("\xEF\xBB\xBF")
+		w := csv.NewWriter(&buf)
+		if err := w.WriteAll(records); err != nil {
+			return nil, err
+		}
+	case UserFileXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+		sheet := f.GetSheetName(0)
+		for i, record := range records {
+			cellName, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			if err = f.SetSheetRow(sheet, cellName, &record); err != nil {
+				return nil, err
+			}
+		}
+		if err := f.Write(&buf); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorx.NewInvalidArgumentError("user.importFileInvalid")
+	}
+	return buf.Bytes(), nil
+}
+
+func headerColumn(name string) (int, bool) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "\xEF\xBB\xBF")
+	for i, header := range userFileHeader {
+		if name == header {
+			return i, true
+		}
+	}
+	col, ok := userFileHeaderAlias[strings.ToLower(strings.ReplaceAll(name, "_", ""))]
+	return col, ok
+}
+
+// splitCodes 拆分多个编码，支持中英文逗号、分号及竖线分隔
+func splitCodes(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == '|' || r == '，' || r == '；'
+	})
+	codes := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if code := strings.TrimSpace(field); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+)
+
+func TestUserFileRoundTrip(t *testing.T) {
+	rows := []*userservice.UserImportRow{
+		{
+			Username:       "alice",
+			FullName:       pointer.ToStringPtrIfNotEmpty("Alice"),
+			Email:          pointer.ToStringPtrIfNotEmpty("alice@example.com"),
+			DepartmentCode: "dev",
+			PositionCodes:  []string{"p1", "p2"},
+			RoleCodes:      []string{"admin"},
+		},
+		{
+			Username:       "bob",
+			Mobile:         pointer.ToStringPtrIfNotEmpty("13800000000"),
+			DepartmentCode: "ops",
+		},
+	}
+
+	for _, format := range []string{UserFileCSV, UserFileXLSX} {
+		data, err := WriteUserFile(format, rows)
+		if err != nil {
+			t.Fatalf("%s: write: %v", format, err)
+		}
+		parsed, err := ParseUserFile(format, bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: parse: %v", format, err)
+		}
+		if len(parsed) != len(rows) {
+			t.Fatalf("%s: got %d rows, want %d", format, len(parsed), len(rows))
+		}
+		for i, row := range parsed {
+			want := rows[i]
+			if row.RowNumber != uint32(i+2) || row.Username != want.Username || row.DepartmentCode != want.DepartmentCode ||
+				pointer.GetString(row.FullName) != pointer.GetString(want.FullName) ||
+				pointer.GetString(row.Email) != pointer.GetString(want.Email) ||
+				pointer.GetString(row.Mobile) != pointer.GetString(want.Mobile) ||
+				strings.Join(row.PositionCodes, ",") != strings.Join(want.PositionCodes, ",") ||
+				strings.Join(row.RoleCodes, ",") != strings.Join(want.RoleCodes, ",") {
+				t.Errorf("%s: row %d = %+v, want %+v", format, i, row, want)
+			}
+		}
+	}
+}
+
+func TestParseUserFileHeader(t *testing.T) {
+	csv := "department_code,username,role_codes\n\ndev,alice,a；b\n"
+	rows, err := ParseUserFile(UserFileCSV, strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || rows[0].Username != "alice" || rows[0].RowNumber != 3 || len(rows[0].RoleCodes) != 2 {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+
+	if _, err = ParseUserFile(UserFileCSV, strings.NewReader("姓名\nAlice\n")); err == nil {
+		t.Fatal("expected error for missing username column")
+	}
+	if _, err = ParseUserFile("txt", strings.NewReader("")); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
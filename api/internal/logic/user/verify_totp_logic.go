@@ -5,6 +5,7 @@ import (
 
 	"github.com/wenpiner/last-admin-core/api/internal/svc"
 	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 
 	"net/http"
@@ -30,10 +31,13 @@ func NewVerifyTotpLogic(r *http.Request, svcCtx *svc.ServiceContext) *VerifyTotp
 }
 
 func (l *VerifyTotpLogic) VerifyTotp(req *types.VerifyTotpRequest) (resp *types.BaseResponse, err error) {
+	userID := l.ctx.Value("userId").(string)
 	response, err := l.svcCtx.UserRpc.VerifyTotpSetup(l.ctx, &userservice.VerifyTotpSetupRequest{
-		UserId:   req.UserId,
+		UserId:   userID,
 		TotpCode: req.Code,
 	})
+	// 验证通过后TOTP才正式启用
+	recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypeMfaEnable, securityevent.ProviderTotp, err)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WebauthnRegisterBeginLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 开始注册WebAuthn凭证
+func NewWebauthnRegisterBeginLogic(r *http.Request, svcCtx *svc.ServiceContext) *WebauthnRegisterBeginLogic {
+	return &WebauthnRegisterBeginLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *WebauthnRegisterBeginLogic) WebauthnRegisterBegin() (resp *types.WebauthnBeginResponse, err error) {
+	rpcResp, err := l.svcCtx.UserRpc.BeginWebauthnRegistration(l.ctx, &userservice.WebauthnBeginRegistrationRequest{
+		UserId: l.ctx.Value("userId").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var options map[string]interface{}
+	if err = json.Unmarshal([]byte(rpcResp.Options), &options); err != nil {
+		return nil, errorx.NewApiInternalError("webauthn.beginFailed")
+	}
+
+	resp = &types.WebauthnBeginResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: "success",
+		},
+		Data: types.WebauthnBeginInfo{
+			SessionId: rpcResp.SessionId,
+			Options:   options,
+		},
+	}
+	return
+}
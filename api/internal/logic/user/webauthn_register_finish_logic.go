@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/svc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WebauthnRegisterFinishLogic struct {
+	logx.Logger
+	r      *http.Request
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 完成注册WebAuthn凭证
+func NewWebauthnRegisterFinishLogic(r *http.Request, svcCtx *svc.ServiceContext) *WebauthnRegisterFinishLogic {
+	return &WebauthnRegisterFinishLogic{
+		Logger: logx.WithContext(r.Context()),
+		r:      r,
+		svcCtx: svcCtx,
+		ctx:    r.Context(),
+	}
+}
+
+func (l *WebauthnRegisterFinishLogic) WebauthnRegisterFinish(req *types.WebauthnRegisterFinishRequest) (resp *types.BaseResponse, err error) {
+	credential, err := json.Marshal(req.Credential)
+	if err != nil {
+		return nil, errorx.NewApiInvalidParamsError("webauthn.verifyFailed")
+	}
+
+	userID := l.ctx.Value("userId").(string)
+	_, err = l.svcCtx.UserRpc.FinishWebauthnRegistration(l.ctx, &userservice.WebauthnFinishRegistrationRequest{
+		UserId:       userID,
+		SessionId:    req.SessionId,
+		Credential:   string(credential),
+		FriendlyName: pointer.ToStringPtrIfNotEmpty(req.FriendlyName),
+	})
+	recordEvent(l.ctx, l.r, l.svcCtx, userID, securityevent.TypeMfaEnable, securityevent.ProviderWebauthn, err)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BaseResponse{
+		Code:    0,
+		Message: "webauthn.registerSuccess",
+	}
+	return
+}
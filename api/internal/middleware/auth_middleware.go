@@ -1,7 +1,9 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/redis/go-redis/v9"
@@ -9,21 +11,28 @@ import (
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
 	last_redis "github.com/wenpiner/last-admin-common/last-redis"
 	last_casbin "github.com/wenpiner/last-admin-common/plugins/casbin"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 	"github.com/zeromicro/go-zero/core/errorx"
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 type AuthMiddleware struct {
-	trans *last_i18n.Translator
-	cbn   *casbin.Enforcer
-	rds   *redis.Client
+	trans    *last_i18n.Translator
+	cbn      *casbin.Enforcer
+	rds      *redis.Client
+	tokenRpc tokenservice.TokenService
+	userRpc  userservice.UserService
 }
 
-func NewAuthMiddleware(trans *last_i18n.Translator, cbn *casbin.Enforcer, rds *redis.Client) *AuthMiddleware {
+func NewAuthMiddleware(trans *last_i18n.Translator, cbn *casbin.Enforcer, rds *redis.Client, tokenRpc tokenservice.TokenService, userRpc userservice.UserService) *AuthMiddleware {
 	return &AuthMiddleware{
-		trans: trans,
-		cbn:   cbn,
-		rds:   rds,
+		trans:    trans,
+		cbn:      cbn,
+		rds:      rds,
+		tokenRpc: tokenRpc,
+		userRpc:  userRpc,
 	}
 }
 
@@ -43,11 +52,40 @@ func (m *AuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 		token := r.Header.Get("Authorization")
 		if token != "" {
 			token = token[7:]
-			_, err := m.rds.SIsMember(r.Context(), key, token).Result()
-			if err != nil {
+			blocked, err := m.rds.SIsMember(r.Context(), key, token).Result()
+			if err != nil || blocked {
 				httpx.Error(w, errorx.NewApiForbiddenError(m.trans.Trans(r.Context(), "common.forbidden")))
 				return
 			}
+			// API密钥会话的内部令牌没有会话记录，使用情况在认证时记录于密钥
+			if !jwtutils.IsApiKeySession(r.Context()) {
+				m.touchSession(r, token)
+			}
+		}
+
+		// API密钥会话的角色为密钥授权范围，其余会话按当前有效角色鉴权，组织变更无需重新登录
+		if !jwtutils.IsApiKeySession(r.Context()) {
+			roles = m.effectiveRoles(r.Context(), roles)
+			r = r.WithContext(context.WithValue(r.Context(), "roleId", strings.Join(roles, ",")))
+		}
+
+		// 模拟登录及API密钥会话禁止敏感操作，结束模拟不校验被模拟用户的权限
+		route := act + " " + obj
+		if jwtutils.IsApiKeySession(r.Context()) {
+			if _, ok := delegatedBlockedRoutes[route]; ok {
+				httpx.Error(w, errorx.NewApiForbiddenError(m.trans.Trans(r.Context(), "apiKey.forbidden")))
+				return
+			}
+		}
+		if jwtutils.GetImpersonatorId(r.Context()) != "" {
+			if _, ok := delegatedBlockedRoutes[route]; ok {
+				httpx.Error(w, errorx.NewApiForbiddenError(m.trans.Trans(r.Context(), "impersonation.forbidden")))
+				return
+			}
+			if route == impersonationStopRoute {
+				next(w, r)
+				return
+			}
 		}
 
 		if last_casbin.Check(m.cbn, "api", roles, obj, act) {
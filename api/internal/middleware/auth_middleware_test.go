@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/api/internal/i18n"
+	"github.com/wenpiner/last-admin-core/api/internal/utils/jwtutils"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/rest/enums"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTokenRpc 记录更新会话最后使用时间的调用
+type fakeTokenRpc struct {
+	tokenservice.TokenService
+	touched chan string
+}
+
+func (f *fakeTokenRpc) UpdateTokenLastUsed(_ context.Context, in *tokenservice.UpdateTokenLastUsedRequest, _ ...grpc.CallOption) (*tokenservice.BaseResponse, error) {
+	f.touched <- in.TokenValue
+	return &tokenservice.BaseResponse{}, nil
+}
+
+func TestApiKeySessionIsNotTouched(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+	tokens := &fakeTokenRpc{touched: make(chan string, 1)}
+	m := NewAuthMiddleware(last_i18n.NewTranslator(last_i18n.Config{}, i18n.LocaleFS), nil, rds, tokens, nil)
+
+	serve := func(token string, claim string, value any) {
+		ctx := context.WithValue(context.Background(), string(enums.LangKey), "zh")
+		ctx = context.WithValue(ctx, "roleId", "ops")
+		ctx = context.WithValue(ctx, claim, value)
+		// 敏感操作对两种会话均被拒绝，无需 Casbin 鉴权
+		r := httptest.NewRequest(http.MethodPost, "/user/password", nil).WithContext(ctx)
+		r.Header.Set("Authorization", "Bearer "+token)
+		m.Handle(func(http.ResponseWriter, *http.Request) { t.Fatal("request must be blocked") })(httptest.NewRecorder(), r)
+	}
+
+	serve("api-key-token", jwtutils.ApiKeyIdKey, uint32(1))
+	if keys := mr.Keys(); len(keys) != 0 {
+		t.Fatalf("api key sessions must not be touched, got keys %v", keys)
+	}
+
+	serve("impersonation-token", jwtutils.ImpersonatorIdKey, "admin")
+	if token := <-tokens.touched; token != "impersonation-token" {
+		t.Fatalf("touched %q", token)
+	}
+}
+
+// fakeUserRpc 模拟已删除的用户
+type fakeUserRpc struct {
+	userservice.UserService
+}
+
+func (fakeUserRpc) GetEffectiveRoles(context.Context, *userservice.UUIDRequest, ...grpc.CallOption) (*userservice.EffectiveRoleResponse, error) {
+	return nil, status.Error(codes.NotFound, "common.targetNotExist")
+}
+
+func TestEffectiveRolesFailClosed(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+	m := NewAuthMiddleware(nil, nil, rds, nil, fakeUserRpc{})
+
+	ctx := context.WithValue(context.Background(), "userId", "deleted-user")
+	if roles := m.effectiveRoles(ctx, []string{"admin"}); len(roles) != 0 {
+		t.Fatalf("token roles must not be used when the user cannot be resolved, got %v", roles)
+	}
+}
@@ -0,0 +1,8 @@
+package middleware
+
+import "net/http"
+
+//go:generate go run ../../../scripts/sensitiveroutes -dir ../../desc -out delegated_routes_gen.go
+
+// impersonationStopRoute 结束模拟登录，不受被模拟用户的接口权限限制
+const impersonationStopRoute = http.MethodPost + " /user/impersonate/stop"
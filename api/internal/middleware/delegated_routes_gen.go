@@ -0,0 +1,41 @@
+// Code generated by scripts/sensitiveroutes. DO NOT EDIT.
+
+package middleware
+
+// delegatedBlockedRoutes 模拟登录及API密钥会话禁止访问的敏感操作，由 .api 文件中 @doc 标记 sensitive: "true" 的路由生成
+var delegatedBlockedRoutes = map[string]struct{}{
+	"POST /apikey/delete":                  {},
+	"POST /apikey/update":                  {},
+	"POST /bundle/import":                  {},
+	"POST /department/assign/role":         {},
+	"POST /menu/assign/api":                {},
+	"POST /oauth2/authorize/consent":       {},
+	"POST /oauth2/client/create":           {},
+	"POST /oauth2/client/update":           {},
+	"POST /oauth2/consent/delete":          {},
+	"POST /position/assign/role":           {},
+	"POST /role/assign/api":                {},
+	"POST /role/assign/configurationGroup": {},
+	"POST /role/assign/menu":               {},
+	"POST /role/createOrUpdate":            {},
+	"POST /user/apikey/create":             {},
+	"POST /user/apikey/delete":             {},
+	"POST /user/apikey/update":             {},
+	"POST /user/batch/delete":              {},
+	"POST /user/batch/state":               {},
+	"POST /user/createOrUpdate":            {},
+	"POST /user/delete":                    {},
+	"POST /user/impersonate":               {},
+	"POST /user/import":                    {},
+	"POST /user/password":                  {},
+	"POST /user/session/revoke":            {},
+	"POST /user/session/revokeOthers":      {},
+	"POST /user/totp/disable":              {},
+	"POST /user/totp/enable":               {},
+	"POST /user/totp/reset":                {},
+	"POST /user/totp/verify":               {},
+	"POST /user/webauthn/delete":           {},
+	"POST /user/webauthn/register/begin":   {},
+	"POST /user/webauthn/register/finish":  {},
+	"POST /user/webauthn/update":           {},
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestDelegatedRoutesUpToDate(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "routes.go")
+	cmd := exec.Command("go", "run", "../../../scripts/sensitiveroutes", "-dir", "../../desc", "-out", out)
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generate: %v\n%s", err, b)
+	}
+	want, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("delegated_routes_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("delegated_routes_gen.go is stale, run go generate ./api/internal/middleware")
+	}
+}
+
+func TestDelegatedRoutesBlockPrivilegeChanges(t *testing.T) {
+	for _, route := range []string{
+		"POST /user/password",
+		"POST /role/assign/api",
+		"POST /position/assign/role",
+		"POST /department/assign/role",
+		"POST /menu/assign/api",
+		"POST /bundle/import",
+		"POST /user/batch/state",
+		"POST /user/batch/delete",
+	} {
+		if _, ok := delegatedBlockedRoutes[route]; !ok {
+			t.Errorf("%s must be blocked for delegated sessions", route)
+		}
+	}
+	if _, ok := delegatedBlockedRoutes[impersonationStopRoute]; ok {
+		t.Error("impersonated sessions must be able to stop impersonation")
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// userRolesCacheTTL 用户有效角色缓存有效期，岗位、部门等组织变更最迟在此时间后生效
+const userRolesCacheTTL = time.Minute
+
+// UserRolesCacheKey 用户有效角色缓存键，修改用户角色、岗位或部门后需删除
+func UserRolesCacheKey(userId string) string {
+	return "user:roles:" + userId
+}
+
+// effectiveRoles 获取登录用户当前的有效角色，优先读取缓存
+// 获取失败时不沿用令牌中的角色，已删除或禁用的用户及核心服务不可用时均按无角色处理
+func (m *AuthMiddleware) effectiveRoles(ctx context.Context, tokenRoles []string) []string {
+	userId, ok := ctx.Value("userId").(string)
+	if !ok || userId == "" {
+		return tokenRoles
+	}
+
+	key := UserRolesCacheKey(userId)
+	value, err := m.rds.Get(ctx, key).Result()
+	if err == nil {
+		if value == "" {
+			return nil
+		}
+		return strings.Split(value, ",")
+	}
+	if !errors.Is(err, redis.Nil) {
+		logx.WithContext(ctx).Errorw("读取用户有效角色缓存失败", logx.Field("detail", err.Error()))
+	}
+
+	resp, err := m.userRpc.GetEffectiveRoles(ctx, &userservice.UUIDRequest{Id: userId})
+	if err != nil {
+		logx.WithContext(ctx).Errorw("获取用户有效角色失败", logx.Field("detail", err.Error()))
+		return nil
+	}
+	if err = m.rds.Set(ctx, key, strings.Join(resp.RoleValues, ","), userRolesCacheTTL).Err(); err != nil {
+		logx.WithContext(ctx).Errorw("写入用户有效角色缓存失败", logx.Field("detail", err.Error()))
+	}
+	return resp.RoleValues
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-common/ctx/langctx"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/api/internal/i18n"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/enums"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// defaultLang 无法协商出支持的语言时使用的语言
+	defaultLang = "zh"
+	// userLanguageCacheTTL 用户偏好语言缓存有效期
+	userLanguageCacheTTL = 10 * time.Minute
+)
+
+// UserLanguageCacheKey 用户偏好语言缓存键，修改用户偏好语言后需删除
+func UserLanguageCacheKey(userId string) string {
+	return "user:language:" + userId
+}
+
+type LangMiddleware struct {
+	rds       *redis.Client
+	userRpc   userservice.UserService
+	supported []language.Tag
+	matcher   language.Matcher
+}
+
+func NewLangMiddleware(rds *redis.Client, userRpc userservice.UserService) *LangMiddleware {
+	var supported []language.Tag
+	for _, lang := range i18n.Languages() {
+		if tag, err := language.Parse(lang); err == nil {
+			supported = append(supported, tag)
+		}
+	}
+	return &LangMiddleware{
+		rds:       rds,
+		userRpc:   userRpc,
+		supported: supported,
+		matcher:   language.NewMatcher(supported),
+	}
+}
+
+// Handle 协商请求语言写入上下文，供翻译及 RPC 调用使用
+// 优先使用登录用户的偏好语言，其次为 Accept-Language，均不支持时使用默认语言
+func (m *LangMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lang := m.match(m.userLanguage(r.Context()))
+		if lang == "" {
+			lang = m.match(r.Header.Get("Accept-Language"))
+		}
+		if lang == "" {
+			lang = defaultLang
+		}
+		next(w, r.WithContext(langctx.WithLangToContext(r.Context(), lang)))
+	}
+}
+
+// match 将语言偏好匹配为已支持的语言，无法匹配时返回空
+func (m *LangMiddleware) match(accept string) string {
+	if accept == "" || len(m.supported) == 0 {
+		return ""
+	}
+	tags, _, err := language.ParseAcceptLanguage(accept)
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+	_, index, confidence := m.matcher.Match(tags...)
+	if confidence == language.No {
+		return ""
+	}
+	return m.supported[index].String()
+}
+
+// userLanguage 获取登录用户的偏好语言，优先读取缓存，未设置时缓存空值
+func (m *LangMiddleware) userLanguage(ctx context.Context) string {
+	userId, ok := ctx.Value("userId").(string)
+	if !ok || userId == "" {
+		return ""
+	}
+
+	key := UserLanguageCacheKey(userId)
+	lang, err := m.rds.Get(ctx, key).Result()
+	if err == nil {
+		return lang
+	}
+	if !errors.Is(err, redis.Nil) {
+		logx.WithContext(ctx).Errorw("读取用户偏好语言缓存失败", logx.Field("detail", err.Error()))
+	}
+
+	user, err := m.userRpc.GetUser(ctx, &userservice.UUIDRequest{Id: userId})
+	if err != nil {
+		logx.WithContext(ctx).Errorw("获取用户偏好语言失败", logx.Field("detail", err.Error()))
+		return ""
+	}
+	lang = pointer.GetString(user.Language)
+	if err = m.rds.Set(ctx, key, lang, userLanguageCacheTTL).Err(); err != nil {
+		logx.WithContext(ctx).Errorw("写入用户偏好语言缓存失败", logx.Field("detail", err.Error()))
+	}
+	return lang
+}
+
+// LangClientInterceptor 将上下文中的语言通过 metadata 传递给 RPC 服务
+func LangClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if lang, ok := langctx.GetLangFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, string(enums.LangKey), lang)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	lastHttp "github.com/wenpiner/last-admin-common/utils/http"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// sessionTouchInterval 同一会话最后使用时间的最小更新间隔
+const sessionTouchInterval = time.Minute
+
+// touchSession 异步更新会话的最后使用时间及IP，按间隔节流以减少数据库写入
+func (m *AuthMiddleware) touchSession(r *http.Request, token string) {
+	sum := sha256.Sum256([]byte(token))
+	key := "session:touch:" + hex.EncodeToString(sum[:])
+	ok, err := m.rds.SetNX(r.Context(), key, 1, sessionTouchInterval).Result()
+	if err != nil || !ok {
+		return
+	}
+
+	ctx := context.WithoutCancel(r.Context())
+	ip := lastHttp.GetIP(r)
+	threading.GoSafe(func() {
+		_, err := m.tokenRpc.UpdateTokenLastUsed(ctx, &tokenservice.UpdateTokenLastUsedRequest{
+			TokenValue: token,
+			IpAddress:  pointer.ToStringPtr(ip),
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorw("更新会话最后使用时间失败", logx.Field("detail", err.Error()))
+		}
+	})
+}
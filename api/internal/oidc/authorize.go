@@ -0,0 +1,188 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// codeChallengeMethodS256 仅支持 S256 方式的 PKCE
+const codeChallengeMethodS256 = "S256"
+
+// AuthorizeRequest 授权请求参数
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// AuthorizeResult 授权请求校验结果，用于展示授权确认页
+type AuthorizeResult struct {
+	Client          *Client
+	Scopes          []string
+	ConsentRequired bool
+}
+
+// CheckAuthorize 校验授权请求，并判断用户是否需要确认授权
+func (p *Provider) CheckAuthorize(ctx context.Context, req *AuthorizeRequest, userID string) (*AuthorizeResult, error) {
+	client, err := p.clients.GetClient(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrInvalidClient
+		}
+		return nil, err
+	}
+	if req.RedirectURI == "" || !slices.Contains(client.RedirectURIs, req.RedirectURI) {
+		return nil, ErrInvalidRequest.WithDescription("redirect_uri is not registered")
+	}
+	if req.ResponseType != "code" {
+		return nil, ErrUnsupportedResponseType
+	}
+	if !client.allowsGrant(GrantTypeAuthorizationCode) {
+		return nil, ErrUnauthorizedClient
+	}
+
+	// 公共客户端必须使用 PKCE
+	if req.CodeChallenge != "" || req.CodeChallengeMethod != "" {
+		if req.CodeChallengeMethod != codeChallengeMethodS256 || len(req.CodeChallenge) < 43 {
+			return nil, ErrInvalidRequest.WithDescription("code_challenge_method must be S256")
+		}
+	} else if client.Public {
+		return nil, ErrInvalidRequest.WithDescription("code_challenge is required")
+	}
+
+	scopes, err := resolveScopes(req.Scope, client.allowedScopes())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AuthorizeResult{Client: client, Scopes: scopes}
+	if client.SkipConsent {
+		return result, nil
+	}
+	granted, err := p.consents.GetConsent(ctx, userID, client.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			result.ConsentRequired = true
+			break
+		}
+	}
+	return result, nil
+}
+
+// Approve 用户同意授权，签发授权码并返回回调地址
+func (p *Provider) Approve(ctx context.Context, req *AuthorizeRequest, userID string, authTime time.Time) (string, error) {
+	result, err := p.CheckAuthorize(ctx, req, userID)
+	if err != nil {
+		return "", err
+	}
+	if result.ConsentRequired {
+		if err = p.consents.SaveConsent(ctx, userID, result.Client.ID, result.Scopes); err != nil {
+			return "", err
+		}
+	}
+
+	code, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	err = p.grants.SaveCode(ctx, code, &Grant{
+		ClientID:            result.Client.ID,
+		UserID:              userID,
+		Scopes:              result.Scopes,
+		RedirectURI:         req.RedirectURI,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		AuthTime:            authTime.Unix(),
+	}, p.conf.CodeTTL)
+	if err != nil {
+		return "", err
+	}
+
+	return redirectURL(req.RedirectURI, url.Values{"code": {code}, "state": {req.State}, "iss": {p.conf.Issuer}}), nil
+}
+
+// Deny 用户拒绝授权，返回携带 access_denied 的回调地址
+func (p *Provider) Deny(ctx context.Context, req *AuthorizeRequest) (string, error) {
+	client, err := p.clients.GetClient(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", ErrInvalidClient
+		}
+		return "", err
+	}
+	// 未注册的回调地址不可跳转，避免开放重定向
+	if !slices.Contains(client.RedirectURIs, req.RedirectURI) {
+		return "", ErrInvalidRequest.WithDescription("redirect_uri is not registered")
+	}
+	return redirectURL(req.RedirectURI, url.Values{"error": {ErrAccessDenied.Code}, "state": {req.State}, "iss": {p.conf.Issuer}}), nil
+}
+
+// resolveScopes 解析请求的权限范围，未指定时使用客户端允许的全部范围
+func resolveScopes(scope string, allowed []string) ([]string, error) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return slices.Clone(allowed), nil
+	}
+	scopes := make([]string, 0, len(requested))
+	for _, s := range requested {
+		if !slices.Contains(allowed, s) {
+			return nil, ErrInvalidScope.WithDescription(s + " is not allowed")
+		}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes, nil
+}
+
+// redirectURL 在回调地址上追加查询参数，忽略空值
+func redirectURL(uri string, params url.Values) string {
+	u, _ := url.Parse(uri)
+	query := u.Query()
+	for key, values := range params {
+		if len(values) > 0 && values[0] != "" {
+			query.Set(key, values[0])
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// randomToken 生成随机的授权码或刷新令牌
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// verifyCodeChallenge 校验 PKCE code_verifier
+func verifyCodeChallenge(grant *Grant, verifier string) bool {
+	if grant.CodeChallenge == "" {
+		return verifier == ""
+	}
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(challenge), []byte(grant.CodeChallenge)) == 1
+}
@@ -0,0 +1,74 @@
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Error OAuth2 协议错误
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	status      int
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Description
+}
+
+// WithDescription 返回带描述的错误副本
+func (e *Error) WithDescription(description string) *Error {
+	return &Error{Code: e.Code, Description: description, status: e.status}
+}
+
+// Is 按错误码比较
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+var (
+	ErrInvalidRequest          = &Error{Code: "invalid_request", status: http.StatusBadRequest}
+	ErrInvalidClient           = &Error{Code: "invalid_client", status: http.StatusUnauthorized}
+	ErrInvalidGrant            = &Error{Code: "invalid_grant", status: http.StatusBadRequest}
+	ErrUnauthorizedClient      = &Error{Code: "unauthorized_client", status: http.StatusBadRequest}
+	ErrUnsupportedGrantType    = &Error{Code: "unsupported_grant_type", status: http.StatusBadRequest}
+	ErrUnsupportedResponseType = &Error{Code: "unsupported_response_type", status: http.StatusBadRequest}
+	ErrInvalidScope            = &Error{Code: "invalid_scope", status: http.StatusBadRequest}
+	ErrAccessDenied            = &Error{Code: "access_denied", status: http.StatusForbidden}
+	ErrInvalidToken            = &Error{Code: "invalid_token", status: http.StatusUnauthorized}
+	ErrServerError             = &Error{Code: "server_error", status: http.StatusInternalServerError}
+)
+
+// writeError 按 RFC 6749 输出错误响应
+func writeError(w http.ResponseWriter, err error) {
+	var oauthErr *Error
+	if !errors.As(err, &oauthErr) {
+		oauthErr = ErrServerError
+	}
+	switch oauthErr.Code {
+	case ErrInvalidClient.Code:
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth2"`)
+	case ErrInvalidToken.Code:
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	}
+	noStore(w)
+	writeJSON(w, oauthErr.status, oauthErr)
+}
+
+// noStore 禁止缓存包含令牌或错误的响应
+func noStore(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+}
+
+// writeJSON 输出 JSON 响应
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,123 @@
+package oidc
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Discovery OpenID Connect 发现文档
+func (p *Provider) Discovery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.conf.Issuer,
+		"authorization_endpoint":                p.conf.AuthorizationEndpoint,
+		"token_endpoint":                        p.conf.Issuer + TokenPath,
+		"userinfo_endpoint":                     p.conf.Issuer + UserInfoPath,
+		"jwks_uri":                              p.conf.Issuer + JWKSPath,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{jwt.SigningMethodRS256.Alg()},
+		"scopes_supported":                      defaultScopes,
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{codeChallengeMethodS256},
+		"claims_supported": []string{
+			"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "azp",
+			"preferred_username", "name", "picture", "email", "roles",
+		},
+		"authorization_response_iss_parameter_supported": true,
+	})
+}
+
+// JWKS 签名公钥集合
+func (p *Provider) JWKS(w http.ResponseWriter, r *http.Request) {
+	key := &p.conf.SigningKey.PublicKey
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": jwt.SigningMethodRS256.Alg(),
+			"kid": p.keyID,
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   encodeExponent(key.E),
+		}},
+	})
+}
+
+// UserInfo 用户信息端点，按访问令牌的权限范围返回用户声明
+func (p *Provider) UserInfo(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) < 7 || !strings.EqualFold(authorization[:7], "Bearer ") {
+		writeError(w, ErrInvalidToken)
+		return
+	}
+	claims, err := p.parseAccessToken(strings.TrimSpace(authorization[7:]))
+	if err != nil {
+		writeError(w, ErrInvalidToken)
+		return
+	}
+	scopes := strings.Fields(claims.Scope)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		writeError(w, ErrInvalidToken.WithDescription("openid scope is required"))
+		return
+	}
+
+	user, err := p.users.GetUser(r.Context(), claims.Subject)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			writeError(w, ErrInvalidToken)
+			return
+		}
+		logx.WithContext(r.Context()).Errorw("获取OAuth用户信息失败", logx.Field("detail", err.Error()))
+		writeError(w, err)
+		return
+	}
+	noStore(w)
+	writeJSON(w, http.StatusOK, p.userClaims(user, scopes))
+}
+
+// parseAccessToken 校验并解析本服务签发的访问令牌
+func (p *Provider) parseAccessToken(tokenString string) (*accessTokenClaims, error) {
+	claims := &accessTokenClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		if token.Header["typ"] != accessTokenType {
+			return nil, errors.New("not an access token")
+		}
+		return &p.conf.SigningKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	if !claims.VerifyIssuer(p.conf.Issuer, true) {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// userClaims 按权限范围构建用户声明，角色编码始终返回
+func (p *Provider) userClaims(user *User, scopes []string) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub":   user.ID,
+		"roles": user.Roles,
+	}
+	if slices.Contains(scopes, ScopeProfile) {
+		claims["preferred_username"] = user.Username
+		if user.Name != "" {
+			claims["name"] = user.Name
+		}
+		if user.Avatar != "" {
+			claims["picture"] = user.Avatar
+		}
+	}
+	if slices.Contains(scopes, ScopeEmail) && user.Email != "" {
+		claims["email"] = user.Email
+	}
+	return claims
+}
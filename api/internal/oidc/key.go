@@ -0,0 +1,42 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// LoadSigningKey 加载 PEM 格式的 RSA 私钥，未配置时生成临时密钥
+func LoadSigningKey(file string) (*rsa.PrivateKey, error) {
+	if file == "" {
+		// 临时密钥在重启后失效，已签发的令牌将无法校验
+		logx.Alert("OIDC signing key file is not configured, using a temporary key")
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("oidc: invalid PEM signing key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("oidc: signing key is not an RSA key")
+	}
+	return rsaKey, nil
+}
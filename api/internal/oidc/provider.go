@@ -0,0 +1,163 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+)
+
+const (
+	// DiscoveryPath OpenID Connect 发现文档地址
+	DiscoveryPath = "/.well-known/openid-configuration"
+	// JWKSPath 签名公钥地址
+	JWKSPath = "/oauth2/jwks"
+	// TokenPath 令牌端点地址
+	TokenPath = "/oauth2/token"
+	// UserInfoPath 用户信息端点地址
+	UserInfoPath = "/oauth2/userinfo"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// defaultScopes 客户端未配置权限范围时允许的标准范围
+var defaultScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+// ErrNotFound 存储中不存在对应记录，或记录已失效
+var ErrNotFound = errors.New("oidc: not found")
+
+// Client 已注册的客户端应用
+type Client struct {
+	ID           string
+	Name         string
+	Logo         string
+	RedirectURIs []string
+	GrantTypes   []string
+	Scopes       []string
+	Public       bool
+	SkipConsent  bool
+}
+
+// allowsGrant 客户端是否允许使用指定授权类型
+func (c *Client) allowsGrant(grantType string) bool {
+	return slices.Contains(c.GrantTypes, grantType)
+}
+
+// allowedScopes 客户端允许申请的权限范围
+func (c *Client) allowedScopes() []string {
+	if len(c.Scopes) == 0 {
+		return defaultScopes
+	}
+	return c.Scopes
+}
+
+// User 资源所有者
+type User struct {
+	ID       string
+	Username string
+	Name     string
+	Email    string
+	Avatar   string
+	Roles    []string
+}
+
+// Grant 授权码或刷新令牌对应的授权信息
+type Grant struct {
+	ClientID            string   `json:"clientId"`
+	UserID              string   `json:"userId"`
+	Scopes              []string `json:"scopes"`
+	RedirectURI         string   `json:"redirectUri,omitempty"`
+	Nonce               string   `json:"nonce,omitempty"`
+	CodeChallenge       string   `json:"codeChallenge,omitempty"`
+	CodeChallengeMethod string   `json:"codeChallengeMethod,omitempty"`
+	AuthTime            int64    `json:"authTime"`
+}
+
+// ClientStore 客户端存储，客户端不存在、已禁用或密钥错误时返回 ErrNotFound
+type ClientStore interface {
+	GetClient(ctx context.Context, clientID string) (*Client, error)
+	AuthenticateClient(ctx context.Context, clientID, secret string) (*Client, error)
+}
+
+// UserStore 用户存储，用户不存在或已禁用时返回 ErrNotFound
+type UserStore interface {
+	GetUser(ctx context.Context, userID string) (*User, error)
+}
+
+// ConsentStore 用户授权记录存储，未授权时返回空列表
+type ConsentStore interface {
+	GetConsent(ctx context.Context, userID, clientID string) ([]string, error)
+	SaveConsent(ctx context.Context, userID, clientID string, scopes []string) error
+}
+
+// GrantStore 授权码及刷新令牌存储，取出即失效，不存在时返回 ErrNotFound
+type GrantStore interface {
+	SaveCode(ctx context.Context, code string, grant *Grant, ttl time.Duration) error
+	TakeCode(ctx context.Context, code string) (*Grant, error)
+	SaveRefreshToken(ctx context.Context, token string, grant *Grant, ttl time.Duration) error
+	TakeRefreshToken(ctx context.Context, token string) (*Grant, error)
+}
+
+// Config 授权服务配置
+type Config struct {
+	Issuer                string          // 签发者，同时作为各端点的基础地址
+	AuthorizationEndpoint string          // 授权确认页地址，由前端实现
+	SigningKey            *rsa.PrivateKey // 令牌签名私钥
+	AccessTokenTTL        time.Duration
+	RefreshTokenTTL       time.Duration
+	CodeTTL               time.Duration
+}
+
+// Provider OAuth2/OpenID Connect 授权服务
+type Provider struct {
+	conf     Config
+	keyID    string
+	clients  ClientStore
+	users    UserStore
+	consents ConsentStore
+	grants   GrantStore
+}
+
+// NewProvider 创建授权服务
+func NewProvider(conf Config, clients ClientStore, users UserStore, consents ConsentStore, grants GrantStore) *Provider {
+	return &Provider{
+		conf:     conf,
+		keyID:    thumbprint(&conf.SigningKey.PublicKey),
+		clients:  clients,
+		users:    users,
+		consents: consents,
+		grants:   grants,
+	}
+}
+
+// Issuer 签发者
+func (p *Provider) Issuer() string {
+	return p.conf.Issuer
+}
+
+// thumbprint 按 RFC 7638 计算公钥指纹，作为密钥ID
+func thumbprint(key *rsa.PublicKey) string {
+	jwk := fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, encodeExponent(key.E), base64.RawURLEncoding.EncodeToString(key.N.Bytes()))
+	sum := sha256.Sum256([]byte(jwk))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// encodeExponent 将公钥指数编码为 base64url
+func encodeExponent(e int) string {
+	return base64.RawURLEncoding.EncodeToString(big.NewInt(int64(e)).Bytes())
+}
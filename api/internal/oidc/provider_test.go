@@ -0,0 +1,471 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// memoryStore 测试用的内存存储
+type memoryStore struct {
+	mu       sync.Mutex
+	clients  map[string]*Client
+	secrets  map[string]string
+	users    map[string]*User
+	consents map[string][]string
+	grants   map[string]*Grant
+}
+
+func (s *memoryStore) GetClient(_ context.Context, clientID string) (*Client, error) {
+	if client, ok := s.clients[clientID]; ok {
+		return client, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryStore) AuthenticateClient(ctx context.Context, clientID, secret string) (*Client, error) {
+	if s.secrets[clientID] == "" || s.secrets[clientID] != secret {
+		return nil, ErrNotFound
+	}
+	return s.GetClient(ctx, clientID)
+}
+
+func (s *memoryStore) GetUser(_ context.Context, userID string) (*User, error) {
+	if user, ok := s.users[userID]; ok {
+		return user, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryStore) GetConsent(_ context.Context, userID, clientID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.consents[userID+"/"+clientID], nil
+}
+
+func (s *memoryStore) SaveConsent(_ context.Context, userID, clientID string, scopes []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.consents[userID+"/"+clientID] = scopes
+	return nil
+}
+
+func (s *memoryStore) SaveCode(_ context.Context, code string, grant *Grant, _ time.Duration) error {
+	return s.save("code:"+code, grant)
+}
+
+func (s *memoryStore) TakeCode(_ context.Context, code string) (*Grant, error) {
+	return s.take("code:" + code)
+}
+
+func (s *memoryStore) SaveRefreshToken(_ context.Context, token string, grant *Grant, _ time.Duration) error {
+	return s.save("refresh:"+token, grant)
+}
+
+func (s *memoryStore) TakeRefreshToken(_ context.Context, token string) (*Grant, error) {
+	return s.take("refresh:" + token)
+}
+
+func (s *memoryStore) save(key string, grant *Grant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *grant
+	s.grants[key] = &copied
+	return nil
+}
+
+func (s *memoryStore) take(key string) (*Grant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	grant, ok := s.grants[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	delete(s.grants, key)
+	return grant, nil
+}
+
+const (
+	testUserID       = "0b7c3c4e-3c0a-4a55-9d7e-6d1f4a1f1a01"
+	testRedirectURI  = "https://app.example.com/callback"
+	testClientSecret = "web-secret"
+	testServiceScope = "reports.read"
+)
+
+// newTestServer 启动挂载各端点的测试服务
+func newTestServer(t *testing.T) (*Provider, *httptest.Server) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryStore{
+		clients: map[string]*Client{
+			"web": {
+				ID:           "web",
+				RedirectURIs: []string{testRedirectURI},
+				GrantTypes:   []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken},
+			},
+			"spa": {
+				ID:           "spa",
+				RedirectURIs: []string{testRedirectURI},
+				GrantTypes:   []string{GrantTypeAuthorizationCode},
+				Public:       true,
+			},
+			"service": {
+				ID:         "service",
+				GrantTypes: []string{GrantTypeClientCredentials},
+				Scopes:     []string{ScopeOpenID, testServiceScope},
+			},
+		},
+		secrets: map[string]string{"web": testClientSecret, "service": "service-secret"},
+		users: map[string]*User{
+			testUserID: {ID: testUserID, Username: "alice", Name: "Alice", Email: "alice@example.com", Roles: []string{"admin", "auditor"}},
+		},
+		consents: map[string][]string{},
+		grants:   map[string]*Grant{},
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	p := NewProvider(Config{
+		Issuer:                server.URL,
+		AuthorizationEndpoint: server.URL + "/#/oauth2/authorize",
+		SigningKey:            key,
+		AccessTokenTTL:        time.Hour,
+		RefreshTokenTTL:       24 * time.Hour,
+		CodeTTL:               time.Minute,
+	}, store, store, store, store)
+	mux.HandleFunc(DiscoveryPath, p.Discovery)
+	mux.HandleFunc(JWKSPath, p.JWKS)
+	mux.HandleFunc(TokenPath, p.Token)
+	mux.HandleFunc(UserInfoPath, p.UserInfo)
+	return p, server
+}
+
+// postToken 请求令牌端点
+func postToken(t *testing.T, server *httptest.Server, form url.Values, basicAuth ...string) (int, map[string]any) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodPost, server.URL+TokenPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if len(basicAuth) == 2 {
+		req.SetBasicAuth(basicAuth[0], basicAuth[1])
+	}
+	return doJSON(t, req)
+}
+
+func doJSON(t *testing.T, req *http.Request) (int, map[string]any) {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body := map[string]any{}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, body
+}
+
+func getJSON(t *testing.T, rawURL, bearer string) (int, map[string]any) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, rawURL, nil)
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	return doJSON(t, req)
+}
+
+// approve 模拟用户在授权确认页同意授权，返回授权码
+func approve(t *testing.T, p *Provider, req *AuthorizeRequest) string {
+	t.Helper()
+	redirect, err := p.Approve(context.Background(), req, testUserID, time.Now())
+	if err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	u, _ := url.Parse(redirect)
+	if got := u.Query().Get("state"); got != req.State {
+		t.Fatalf("state = %q, want %q", got, req.State)
+	}
+	return u.Query().Get("code")
+}
+
+// verifyIDToken 使用 JWKS 端点公布的公钥校验 ID 令牌
+func verifyIDToken(t *testing.T, server *httptest.Server, idToken string) jwt.MapClaims {
+	t.Helper()
+	_, discovery := getJSON(t, server.URL+DiscoveryPath, "")
+	_, jwks := getJSON(t, discovery["jwks_uri"].(string), "")
+	jwk := jwks["keys"].([]any)[0].(map[string]any)
+	n, _ := base64.RawURLEncoding.DecodeString(jwk["n"].(string))
+	e, _ := base64.RawURLEncoding.DecodeString(jwk["e"].(string))
+	publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		if token.Header["kid"] != jwk["kid"] {
+			return nil, errors.New("unknown kid")
+		}
+		return publicKey, nil
+	}, jwt.WithValidMethods([]string{"RS256"}))
+	if err != nil {
+		t.Fatalf("verify id_token: %v", err)
+	}
+	if claims["iss"] != discovery["issuer"] {
+		t.Fatalf("iss = %v, want %v", claims["iss"], discovery["issuer"])
+	}
+	return claims
+}
+
+func pkcePair() (verifier, challenge string) {
+	verifier = strings.Repeat("v", 20) + "-pkce-verifier-for-tests-0123456789"
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestDiscovery(t *testing.T) {
+	_, server := newTestServer(t)
+	status, body := getJSON(t, server.URL+DiscoveryPath, "")
+	if status != http.StatusOK {
+		t.Fatalf("status = %d", status)
+	}
+	if body["issuer"] != server.URL || body["token_endpoint"] != server.URL+TokenPath ||
+		body["userinfo_endpoint"] != server.URL+UserInfoPath || body["jwks_uri"] != server.URL+JWKSPath {
+		t.Fatalf("unexpected discovery document: %v", body)
+	}
+}
+
+func TestAuthorizationCodeFlowWithPKCE(t *testing.T) {
+	p, server := newTestServer(t)
+	verifier, challenge := pkcePair()
+	req := &AuthorizeRequest{
+		ResponseType:        "code",
+		ClientID:            "spa",
+		RedirectURI:         testRedirectURI,
+		Scope:               "openid profile email",
+		State:               "xyz",
+		Nonce:               "n-0S6_WzA2Mj",
+		CodeChallenge:       challenge,
+		CodeChallengeMethod: "S256",
+	}
+
+	result, err := p.CheckAuthorize(context.Background(), req, testUserID)
+	if err != nil || !result.ConsentRequired {
+		t.Fatalf("first authorization should require consent: %v %v", result, err)
+	}
+	code := approve(t, p, req)
+
+	status, body := postToken(t, server, url.Values{
+		"grant_type":    {GrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {testRedirectURI},
+		"client_id":     {"spa"},
+		"code_verifier": {verifier},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("token status = %d, body = %v", status, body)
+	}
+	if _, ok := body["refresh_token"]; ok {
+		t.Fatal("client without refresh_token grant must not receive a refresh token")
+	}
+
+	claims := verifyIDToken(t, server, body["id_token"].(string))
+	if claims["sub"] != testUserID || claims["aud"] != "spa" || claims["nonce"] != req.Nonce || claims["email"] != "alice@example.com" {
+		t.Fatalf("unexpected id_token claims: %v", claims)
+	}
+	if roles, _ := json.Marshal(claims["roles"]); string(roles) != `["admin","auditor"]` {
+		t.Fatalf("roles = %s", roles)
+	}
+
+	status, userInfo := getJSON(t, server.URL+UserInfoPath, body["access_token"].(string))
+	if status != http.StatusOK || userInfo["preferred_username"] != "alice" || userInfo["sub"] != testUserID {
+		t.Fatalf("userinfo status = %d, body = %v", status, userInfo)
+	}
+
+	// ID 令牌不能作为访问令牌使用
+	if status, _ = getJSON(t, server.URL+UserInfoPath, body["id_token"].(string)); status != http.StatusUnauthorized {
+		t.Fatalf("id_token accepted by userinfo, status = %d", status)
+	}
+
+	// 授权码只能使用一次
+	status, body = postToken(t, server, url.Values{
+		"grant_type":    {GrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {testRedirectURI},
+		"client_id":     {"spa"},
+		"code_verifier": {verifier},
+	})
+	if status != http.StatusBadRequest || body["error"] != "invalid_grant" {
+		t.Fatalf("reused code: status = %d, body = %v", status, body)
+	}
+
+	// 已授权的范围无需再次确认
+	if result, err = p.CheckAuthorize(context.Background(), req, testUserID); err != nil || result.ConsentRequired {
+		t.Fatalf("second authorization should not require consent: %v %v", result, err)
+	}
+}
+
+func TestPKCEValidation(t *testing.T) {
+	p, server := newTestServer(t)
+	_, challenge := pkcePair()
+
+	// 公共客户端必须使用 PKCE
+	_, err := p.CheckAuthorize(context.Background(), &AuthorizeRequest{
+		ResponseType: "code", ClientID: "spa", RedirectURI: testRedirectURI, Scope: "openid",
+	}, testUserID)
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("err = %v, want invalid_request", err)
+	}
+
+	code := approve(t, p, &AuthorizeRequest{
+		ResponseType: "code", ClientID: "spa", RedirectURI: testRedirectURI, Scope: "openid",
+		CodeChallenge: challenge, CodeChallengeMethod: "S256",
+	})
+	status, body := postToken(t, server, url.Values{
+		"grant_type":    {GrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {testRedirectURI},
+		"client_id":     {"spa"},
+		"code_verifier": {strings.Repeat("x", 43)},
+	})
+	if status != http.StatusBadRequest || body["error"] != "invalid_grant" {
+		t.Fatalf("wrong verifier: status = %d, body = %v", status, body)
+	}
+}
+
+func TestAuthorizeRejectsInvalidRequests(t *testing.T) {
+	p, _ := newTestServer(t)
+	cases := []struct {
+		name string
+		req  AuthorizeRequest
+		want error
+	}{
+		{"unknown client", AuthorizeRequest{ResponseType: "code", ClientID: "none", RedirectURI: testRedirectURI}, ErrInvalidClient},
+		{"unregistered redirect", AuthorizeRequest{ResponseType: "code", ClientID: "web", RedirectURI: "https://evil.example.com/cb"}, ErrInvalidRequest},
+		{"unsupported response type", AuthorizeRequest{ResponseType: "token", ClientID: "web", RedirectURI: testRedirectURI}, ErrUnsupportedResponseType},
+		{"scope not allowed", AuthorizeRequest{ResponseType: "code", ClientID: "web", RedirectURI: testRedirectURI, Scope: "openid admin"}, ErrInvalidScope},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := p.CheckAuthorize(context.Background(), &c.req, testUserID); !errors.Is(err, c.want) {
+				t.Fatalf("err = %v, want %v", err, c.want)
+			}
+		})
+	}
+
+	redirect, err := p.Deny(context.Background(), &AuthorizeRequest{ClientID: "web", RedirectURI: testRedirectURI, State: "s"})
+	if err != nil || !strings.Contains(redirect, "error=access_denied") || !strings.Contains(redirect, "state=s") {
+		t.Fatalf("deny redirect = %q, err = %v", redirect, err)
+	}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	p, server := newTestServer(t)
+	code := approve(t, p, &AuthorizeRequest{
+		ResponseType: "code", ClientID: "web", RedirectURI: testRedirectURI, Scope: "openid profile", State: "s",
+	})
+
+	// 机密客户端未提供密钥时拒绝
+	status, body := postToken(t, server, url.Values{
+		"grant_type": {GrantTypeAuthorizationCode}, "code": {code}, "redirect_uri": {testRedirectURI}, "client_id": {"web"},
+	})
+	if status != http.StatusUnauthorized || body["error"] != "invalid_client" {
+		t.Fatalf("missing secret: status = %d, body = %v", status, body)
+	}
+
+	code = approve(t, p, &AuthorizeRequest{
+		ResponseType: "code", ClientID: "web", RedirectURI: testRedirectURI, Scope: "openid profile", State: "s",
+	})
+	status, body = postToken(t, server, url.Values{
+		"grant_type": {GrantTypeAuthorizationCode}, "code": {code}, "redirect_uri": {testRedirectURI},
+	}, "web", testClientSecret)
+	if status != http.StatusOK {
+		t.Fatalf("token status = %d, body = %v", status, body)
+	}
+	refreshToken := body["refresh_token"].(string)
+
+	status, body = postToken(t, server, url.Values{
+		"grant_type": {GrantTypeRefreshToken}, "refresh_token": {refreshToken}, "scope": {"openid"},
+		"client_id": {"web"}, "client_secret": {testClientSecret},
+	})
+	if status != http.StatusOK || body["scope"] != "openid" || body["refresh_token"] == refreshToken {
+		t.Fatalf("refresh status = %d, body = %v", status, body)
+	}
+	if claims := verifyIDToken(t, server, body["id_token"].(string)); claims["preferred_username"] != nil {
+		t.Fatalf("narrowed scope still returns profile claims: %v", claims)
+	}
+
+	// 刷新令牌轮换后旧令牌失效
+	status, body = postToken(t, server, url.Values{
+		"grant_type": {GrantTypeRefreshToken}, "refresh_token": {refreshToken},
+	}, "web", testClientSecret)
+	if status != http.StatusBadRequest || body["error"] != "invalid_grant" {
+		t.Fatalf("reused refresh token: status = %d, body = %v", status, body)
+	}
+}
+
+func TestRefreshAfterConsentRevoked(t *testing.T) {
+	p, server := newTestServer(t)
+	code := approve(t, p, &AuthorizeRequest{
+		ResponseType: "code", ClientID: "web", RedirectURI: testRedirectURI, Scope: "openid profile", State: "s",
+	})
+	status, body := postToken(t, server, url.Values{
+		"grant_type": {GrantTypeAuthorizationCode}, "code": {code}, "redirect_uri": {testRedirectURI},
+	}, "web", testClientSecret)
+	if status != http.StatusOK {
+		t.Fatalf("token status = %d, body = %v", status, body)
+	}
+
+	// 用户撤销授权后刷新令牌不可再用
+	store := p.consents.(*memoryStore)
+	store.mu.Lock()
+	delete(store.consents, testUserID+"/web")
+	store.mu.Unlock()
+	status, body = postToken(t, server, url.Values{
+		"grant_type": {GrantTypeRefreshToken}, "refresh_token": {body["refresh_token"].(string)},
+	}, "web", testClientSecret)
+	if status != http.StatusBadRequest || body["error"] != "invalid_grant" {
+		t.Fatalf("refresh after revoke: status = %d, body = %v", status, body)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	_, server := newTestServer(t)
+
+	status, body := postToken(t, server, url.Values{"grant_type": {GrantTypeClientCredentials}}, "service", "wrong")
+	if status != http.StatusUnauthorized || body["error"] != "invalid_client" {
+		t.Fatalf("wrong secret: status = %d, body = %v", status, body)
+	}
+
+	status, body = postToken(t, server, url.Values{"grant_type": {GrantTypeClientCredentials}}, "service", "service-secret")
+	if status != http.StatusOK || body["scope"] != testServiceScope {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+	if body["id_token"] != nil || body["refresh_token"] != nil {
+		t.Fatalf("client credentials must only issue an access token: %v", body)
+	}
+	if status, _ = getJSON(t, server.URL+UserInfoPath, body["access_token"].(string)); status != http.StatusUnauthorized {
+		t.Fatalf("client token accepted by userinfo, status = %d", status)
+	}
+
+	status, body = postToken(t, server, url.Values{"grant_type": {GrantTypeAuthorizationCode}}, "service", "service-secret")
+	if status != http.StatusBadRequest || body["error"] != "unauthorized_client" {
+		t.Fatalf("status = %d, body = %v", status, body)
+	}
+}
@@ -0,0 +1,76 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	codeKeyPrefix         = "oauth2:code:"
+	refreshTokenKeyPrefix = "oauth2:refresh:"
+)
+
+// RedisGrantStore 基于Redis的授权码及刷新令牌存储，键中仅保存令牌哈希
+type RedisGrantStore struct {
+	rds *redis.Client
+}
+
+// NewRedisGrantStore 创建基于Redis的授权存储
+func NewRedisGrantStore(rds *redis.Client) *RedisGrantStore {
+	return &RedisGrantStore{rds: rds}
+}
+
+// SaveCode 保存授权码
+func (s *RedisGrantStore) SaveCode(ctx context.Context, code string, grant *Grant, ttl time.Duration) error {
+	return s.save(ctx, codeKeyPrefix+hashToken(code), grant, ttl)
+}
+
+// TakeCode 取出授权码，授权码只能使用一次
+func (s *RedisGrantStore) TakeCode(ctx context.Context, code string) (*Grant, error) {
+	return s.take(ctx, codeKeyPrefix+hashToken(code))
+}
+
+// SaveRefreshToken 保存刷新令牌
+func (s *RedisGrantStore) SaveRefreshToken(ctx context.Context, token string, grant *Grant, ttl time.Duration) error {
+	return s.save(ctx, refreshTokenKeyPrefix+hashToken(token), grant, ttl)
+}
+
+// TakeRefreshToken 取出刷新令牌，刷新后旧令牌即失效
+func (s *RedisGrantStore) TakeRefreshToken(ctx context.Context, token string) (*Grant, error) {
+	return s.take(ctx, refreshTokenKeyPrefix+hashToken(token))
+}
+
+func (s *RedisGrantStore) save(ctx context.Context, key string, grant *Grant, ttl time.Duration) error {
+	data, err := json.Marshal(grant)
+	if err != nil {
+		return err
+	}
+	return s.rds.Set(ctx, key, data, ttl).Err()
+}
+
+func (s *RedisGrantStore) take(ctx context.Context, key string) (*Grant, error) {
+	data, err := s.rds.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	grant := &Grant{}
+	if err = json.Unmarshal(data, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// hashToken 计算令牌哈希
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,112 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RpcStore 基于核心RPC服务的客户端、用户及授权记录存储
+type RpcStore struct {
+	clientRpc oauthclientservice.OauthClientService
+	userRpc   userservice.UserService
+}
+
+// NewRpcStore 创建基于核心RPC服务的存储
+func NewRpcStore(clientRpc oauthclientservice.OauthClientService, userRpc userservice.UserService) *RpcStore {
+	return &RpcStore{clientRpc: clientRpc, userRpc: userRpc}
+}
+
+// GetClient 获取启用的客户端
+func (s *RpcStore) GetClient(ctx context.Context, clientID string) (*Client, error) {
+	info, err := s.clientRpc.AuthenticateOauthClient(ctx, &oauthclientservice.OauthClientAuthRequest{ClientId: clientID})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return convertClient(info), nil
+}
+
+// AuthenticateClient 校验客户端密钥
+func (s *RpcStore) AuthenticateClient(ctx context.Context, clientID, secret string) (*Client, error) {
+	info, err := s.clientRpc.AuthenticateOauthClient(ctx, &oauthclientservice.OauthClientAuthRequest{
+		ClientId:     clientID,
+		ClientSecret: pointer.ToStringPtr(secret),
+	})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	return convertClient(info), nil
+}
+
+// GetUser 获取启用的用户及其有效角色编码
+func (s *RpcStore) GetUser(ctx context.Context, userID string) (*User, error) {
+	info, err := s.userRpc.GetUser(ctx, &userservice.UUIDRequest{Id: userID})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	if !pointer.GetBool(info.State) {
+		return nil, ErrNotFound
+	}
+	roles := info.EffectiveRoleValues
+	if roles == nil {
+		roles = []string{}
+	}
+	return &User{
+		ID:       pointer.GetString(info.Id),
+		Username: pointer.GetString(info.Username),
+		Name:     pointer.GetString(info.FullName),
+		Email:    pointer.GetString(info.Email),
+		Avatar:   pointer.GetString(info.Avatar),
+		Roles:    roles,
+	}, nil
+}
+
+// GetConsent 获取用户已授权的权限范围
+func (s *RpcStore) GetConsent(ctx context.Context, userID, clientID string) ([]string, error) {
+	consent, err := s.clientRpc.GetOauthConsent(ctx, &oauthclientservice.OauthConsentRequest{UserId: userID, ClientId: clientID})
+	if err != nil {
+		if err = rpcError(err); err == ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return consent.Scopes, nil
+}
+
+// SaveConsent 保存用户授权的权限范围
+func (s *RpcStore) SaveConsent(ctx context.Context, userID, clientID string, scopes []string) error {
+	_, err := s.clientRpc.SaveOauthConsent(ctx, &oauthclientservice.OauthConsentInfo{
+		UserId:   userID,
+		ClientId: clientID,
+		Scopes:   scopes,
+	})
+	return err
+}
+
+// rpcError 将RPC的不存在及认证失败错误转换为 ErrNotFound
+func rpcError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound, codes.Unauthenticated:
+		return ErrNotFound
+	default:
+		return err
+	}
+}
+
+// convertClient 转换RPC返回的客户端信息
+func convertClient(info *oauthclientservice.OauthClientInfo) *Client {
+	return &Client{
+		ID:           pointer.GetString(info.ClientId),
+		Name:         pointer.GetString(info.Name),
+		Logo:         pointer.GetString(info.Logo),
+		RedirectURIs: info.RedirectUris,
+		GrantTypes:   info.GrantTypes,
+		Scopes:       info.Scopes,
+		Public:       pointer.GetBool(info.Public),
+		SkipConsent:  pointer.GetBool(info.SkipConsent),
+	}
+}
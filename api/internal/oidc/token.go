@@ -0,0 +1,282 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// accessTokenType 访问令牌的 JWT 类型 (RFC 9068)，用于区分 ID 令牌
+const accessTokenType = "at+jwt"
+
+// TokenResponse 令牌端点响应
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// accessTokenClaims 访问令牌声明
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	ClientID string   `json:"client_id"`
+	Scope    string   `json:"scope,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+// Token 令牌端点，支持授权码、刷新令牌及客户端凭证模式
+func (p *Provider) Token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, ErrInvalidRequest.WithDescription("method must be POST"))
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, ErrInvalidRequest)
+		return
+	}
+
+	resp, err := p.exchange(r.Context(), r)
+	if err != nil {
+		var oauthErr *Error
+		if !errors.As(err, &oauthErr) {
+			logx.WithContext(r.Context()).Errorw("OAuth令牌签发失败", logx.Field("detail", err.Error()))
+		}
+		writeError(w, err)
+		return
+	}
+	noStore(w)
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// exchange 认证客户端并按授权类型签发令牌
+func (p *Provider) exchange(ctx context.Context, r *http.Request) (*TokenResponse, error) {
+	client, err := p.authenticateClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	grantType := r.PostForm.Get("grant_type")
+	switch grantType {
+	case GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials:
+	default:
+		return nil, ErrUnsupportedGrantType
+	}
+	if !client.allowsGrant(grantType) {
+		return nil, ErrUnauthorizedClient
+	}
+
+	switch grantType {
+	case GrantTypeAuthorizationCode:
+		grant, err := p.grants.TakeCode(ctx, r.PostForm.Get("code"))
+		if err != nil {
+			return nil, grantError(err)
+		}
+		if grant.ClientID != client.ID || grant.RedirectURI != r.PostForm.Get("redirect_uri") {
+			return nil, ErrInvalidGrant
+		}
+		if !verifyCodeChallenge(grant, r.PostForm.Get("code_verifier")) {
+			return nil, ErrInvalidGrant.WithDescription("code_verifier mismatch")
+		}
+		return p.issueTokens(ctx, client, grant)
+	case GrantTypeRefreshToken:
+		grant, err := p.grants.TakeRefreshToken(ctx, r.PostForm.Get("refresh_token"))
+		if err != nil {
+			return nil, grantError(err)
+		}
+		if grant.ClientID != client.ID {
+			return nil, ErrInvalidGrant
+		}
+		// 用户可能已撤销授权或客户端的权限范围已调整，刷新时按当前授权重新确定权限范围
+		if grant.Scopes, err = p.grantedScopes(ctx, client, grant); err != nil {
+			return nil, err
+		}
+		// 刷新时只能缩小权限范围
+		if scope := r.PostForm.Get("scope"); scope != "" {
+			if grant.Scopes, err = resolveScopes(scope, grant.Scopes); err != nil {
+				return nil, err
+			}
+		}
+		return p.issueTokens(ctx, client, grant)
+	default:
+		scopes, err := resolveScopes(r.PostForm.Get("scope"), client.allowedScopes())
+		if err != nil {
+			return nil, err
+		}
+		// 客户端凭证模式没有终端用户，不签发 ID 令牌
+		scopes = slices.DeleteFunc(scopes, func(s string) bool { return s == ScopeOpenID })
+		return p.issueClientToken(client, scopes)
+	}
+}
+
+// grantedScopes 返回刷新令牌中仍被客户端允许且用户仍授权的权限范围，全部失效时刷新令牌不可用
+func (p *Provider) grantedScopes(ctx context.Context, client *Client, grant *Grant) ([]string, error) {
+	var consented []string
+	if !client.SkipConsent {
+		var err error
+		if consented, err = p.consents.GetConsent(ctx, grant.UserID, client.ID); err != nil {
+			return nil, err
+		}
+	}
+	allowed := client.allowedScopes()
+	scopes := slices.DeleteFunc(slices.Clone(grant.Scopes), func(s string) bool {
+		return !slices.Contains(allowed, s) || (!client.SkipConsent && !slices.Contains(consented, s))
+	})
+	if len(scopes) == 0 {
+		return nil, ErrInvalidGrant.WithDescription("consent has been revoked")
+	}
+	return scopes, nil
+}
+
+// authenticateClient 通过 Basic 认证或表单参数认证客户端，公共客户端只需提供客户端ID
+func (p *Provider) authenticateClient(ctx context.Context, r *http.Request) (*Client, error) {
+	clientID, secret, ok := r.BasicAuth()
+	if ok {
+		// Basic 认证中的凭证需先进行表单解码 (RFC 6749 2.3.1)
+		var err error
+		if clientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, ErrInvalidClient
+		}
+		if secret, err = url.QueryUnescape(secret); err != nil {
+			return nil, ErrInvalidClient
+		}
+	} else {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+	if clientID == "" {
+		return nil, ErrInvalidClient
+	}
+
+	var (
+		client *Client
+		err    error
+	)
+	if secret != "" {
+		client, err = p.clients.AuthenticateClient(ctx, clientID, secret)
+	} else {
+		client, err = p.clients.GetClient(ctx, clientID)
+		if err == nil && !client.Public {
+			return nil, ErrInvalidClient
+		}
+	}
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrInvalidClient
+		}
+		return nil, err
+	}
+	return client, nil
+}
+
+// issueTokens 为用户签发访问令牌，按需签发 ID 令牌及刷新令牌
+func (p *Provider) issueTokens(ctx context.Context, client *Client, grant *Grant) (*TokenResponse, error) {
+	// 每次签发都重新读取用户，确保角色为最新且用户仍可用
+	user, err := p.users.GetUser(ctx, grant.UserID)
+	if err != nil {
+		return nil, grantError(err)
+	}
+
+	now := time.Now()
+	scope := strings.Join(grant.Scopes, " ")
+	accessToken, err := p.sign(accessTokenType, &accessTokenClaims{
+		RegisteredClaims: p.registeredClaims(user.ID, client.ID, now),
+		ClientID:         client.ID,
+		Scope:            scope,
+		Roles:            user.Roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(p.conf.AccessTokenTTL.Seconds()),
+		Scope:       scope,
+	}
+
+	if slices.Contains(grant.Scopes, ScopeOpenID) {
+		claims := p.userClaims(user, grant.Scopes)
+		claims["iss"] = p.conf.Issuer
+		claims["aud"] = client.ID
+		claims["iat"] = now.Unix()
+		claims["exp"] = now.Add(p.conf.AccessTokenTTL).Unix()
+		claims["azp"] = client.ID
+		claims["auth_time"] = grant.AuthTime
+		if grant.Nonce != "" {
+			claims["nonce"] = grant.Nonce
+		}
+		if resp.IDToken, err = p.sign("JWT", claims); err != nil {
+			return nil, err
+		}
+	}
+
+	if client.allowsGrant(GrantTypeRefreshToken) {
+		if resp.RefreshToken, err = randomToken(); err != nil {
+			return nil, err
+		}
+		refreshGrant := *grant
+		refreshGrant.CodeChallenge, refreshGrant.CodeChallengeMethod, refreshGrant.Nonce = "", "", ""
+		if err = p.grants.SaveRefreshToken(ctx, resp.RefreshToken, &refreshGrant, p.conf.RefreshTokenTTL); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// issueClientToken 为客户端自身签发访问令牌
+func (p *Provider) issueClientToken(client *Client, scopes []string) (*TokenResponse, error) {
+	scope := strings.Join(scopes, " ")
+	accessToken, err := p.sign(accessTokenType, &accessTokenClaims{
+		RegisteredClaims: p.registeredClaims(client.ID, client.ID, time.Now()),
+		ClientID:         client.ID,
+		Scope:            scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(p.conf.AccessTokenTTL.Seconds()),
+		Scope:       scope,
+	}, nil
+}
+
+// registeredClaims 构建访问令牌的标准声明
+func (p *Provider) registeredClaims(subject, audience string, now time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    p.conf.Issuer,
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(p.conf.AccessTokenTTL)),
+		ID:        uuid.NewString(),
+	}
+}
+
+// sign 使用 RS256 签名令牌
+func (p *Provider) sign(typ string, claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.keyID
+	token.Header["typ"] = typ
+	return token.SignedString(p.conf.SigningKey)
+}
+
+// grantError 将存储中不存在的记录转换为 invalid_grant
+func grantError(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return ErrInvalidGrant
+	}
+	return err
+}
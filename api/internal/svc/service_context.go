@@ -1,28 +1,42 @@
 package svc
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/go-playground/locales/en"
 	"github.com/go-playground/locales/zh"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-common/ctx/langctx"
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
 	"github.com/wenpiner/last-admin-common/utils/captcha"
 	"github.com/wenpiner/last-admin-common/validator"
 	"github.com/wenpiner/last-admin-core/api/internal/config"
 	"github.com/wenpiner/last-admin-core/api/internal/i18n"
 	"github.com/wenpiner/last-admin-core/api/internal/middleware"
+	"github.com/wenpiner/last-admin-core/api/internal/oidc"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/client/apikeyservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/apiservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/bundleservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/configurationservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/departmentservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/dictservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/initservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/jobservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/menuservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/notificationservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/oauthclientservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/oauthproviderservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/operationlogservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/permissionreportservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/positionservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/roleservice"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/tokenservice"
 	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -34,6 +48,7 @@ import (
 type ServiceContext struct {
 	Config         config.Config
 	AuthMiddleware rest.Middleware
+	LangMiddleware rest.Middleware
 
 	CaptchaService *captcha.Service
 
@@ -50,6 +65,17 @@ type ServiceContext struct {
 	DictRpc        dictservice.DictService
 	PositionRpc    positionservice.PositionService
 	ConfigurationRpc configurationservice.ConfigurationService
+	ApiKeyRpc        apikeyservice.ApiKeyService
+	OauthClientRpc   oauthclientservice.OauthClientService
+	SecurityEventRpc securityeventservice.SecurityEventService
+	NotificationRpc  notificationservice.NotificationService
+	JobRpc           jobservice.JobService
+	OperationLogRpc  operationlogservice.OperationLogService
+	FileRpc          fileservice.FileService
+	PermissionReportRpc permissionreportservice.PermissionReportService
+	BundleRpc           bundleservice.BundleService
+
+	Oidc *oidc.Provider
 
 	validator *validator.Validator
 
@@ -85,17 +111,39 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		PoolSize: c.RedisConf.PoolSize,
 	})
 
+	// 临时下载链接未单独配置密钥时使用令牌密钥
+	if c.FileConf.SignSecret == "" {
+		c.FileConf.SignSecret = c.Auth.AccessSecret
+	}
+
 	// 初始化Casbin
 	casbin := c.CasbinConf.MustNewCasbinWithRedisWatcher(c.CasbinDatabaseConf.DBType, c.CasbinDatabaseConf.GetDSN(), c.RedisConf)
 
 	// 初始化用户服务
-	coreRpc := zrpc.NewClientIfEnable(c.CoreRpc)
-	return &ServiceContext{
+	coreRpc := zrpc.MustNewClient(c.CoreRpc, zrpc.WithUnaryClientInterceptor(middleware.LangClientInterceptor))
+	userRpc := userservice.NewUserService(coreRpc)
+	oauthClientRpc := oauthclientservice.NewOauthClientService(coreRpc)
+
+	// 初始化OAuth2/OIDC授权服务
+	signingKey, err := oidc.LoadSigningKey(c.OidcConf.SigningKeyFile)
+	logx.Must(err)
+	oidcStore := oidc.NewRpcStore(oauthClientRpc, userRpc)
+	oidcProvider := oidc.NewProvider(oidc.Config{
+		Issuer:                strings.TrimSuffix(c.OidcConf.Issuer, "/"),
+		AuthorizationEndpoint: c.OidcConf.AuthorizationEndpoint,
+		SigningKey:            signingKey,
+		AccessTokenTTL:        time.Duration(c.OidcConf.AccessTokenExpire) * time.Second,
+		RefreshTokenTTL:       time.Duration(c.OidcConf.RefreshTokenExpire) * time.Second,
+		CodeTTL:               time.Duration(c.OidcConf.CodeExpire) * time.Second,
+	}, oidcStore, oidcStore, oidcStore, oidc.NewRedisGrantStore(redisClient))
+
+	svcCtx := &ServiceContext{
 		Config:         c,
-		AuthMiddleware: middleware.NewAuthMiddleware(trans, casbin, redisClient).Handle,
+		AuthMiddleware: middleware.NewAuthMiddleware(trans, casbin, redisClient, tokenservice.NewTokenService(coreRpc), userRpc).Handle,
+		LangMiddleware: middleware.NewLangMiddleware(redisClient, userRpc).Handle,
 		CaptchaService: captchaService,
 		Trans:          trans,
-		UserRpc:        userservice.NewUserService(coreRpc),
+		UserRpc:        userRpc,
 		TokenRpc:       tokenservice.NewTokenService(coreRpc),
 		OauthRpc:       oauthproviderservice.NewOauthProviderService(coreRpc),
 		InitRpc:        initservice.NewInitService(coreRpc),
@@ -106,7 +154,39 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		DictRpc:        dictservice.NewDictService(coreRpc),
 		PositionRpc:    positionservice.NewPositionService(coreRpc),
 		ConfigurationRpc: configurationservice.NewConfigurationService(coreRpc),
+		ApiKeyRpc:        apikeyservice.NewApiKeyService(coreRpc),
+		OauthClientRpc:   oauthClientRpc,
+		SecurityEventRpc: securityeventservice.NewSecurityEventService(coreRpc),
+		NotificationRpc:  notificationservice.NewNotificationService(coreRpc),
+		JobRpc:           jobservice.NewJobService(coreRpc),
+		OperationLogRpc:  operationlogservice.NewOperationLogService(coreRpc),
+		FileRpc:          fileservice.NewFileService(coreRpc),
+		PermissionReportRpc: permissionreportservice.NewPermissionReportService(coreRpc),
+		BundleRpc:           bundleservice.NewBundleService(coreRpc),
+		Oidc:             oidcProvider,
 		Redis:          redisClient,
 		Casbin:         casbin,
 	}
+
+	// 成功响应中的消息同样按请求语言翻译
+	httpx.SetOkHandler(svcCtx.TransResponse)
+	return svcCtx
+}
+
+// TransResponse 翻译响应中作为 i18n 键的 Message
+func (s *ServiceContext) TransResponse(ctx context.Context, v any) any {
+	resp, ok := v.(types.MessageTranslator)
+	if !ok {
+		return v
+	}
+	if _, ok = langctx.GetLangFromContext(ctx); !ok {
+		ctx = langctx.WithLangToContext(ctx, "zh")
+	}
+	resp.TranslateMessage(func(key string) string {
+		if key == "" {
+			return key
+		}
+		return s.Trans.Trans(ctx, key)
+	})
+	return resp
 }
@@ -0,0 +1,18 @@
+package types
+
+// MessageTranslator 响应中的 Message 为 i18n 键时，在输出前翻译
+type MessageTranslator interface {
+	TranslateMessage(trans func(key string) string)
+}
+
+func (b *BaseDataInfo) TranslateMessage(trans func(key string) string) {
+	b.Message = trans(b.Message)
+}
+
+func (b *BaseResponse) TranslateMessage(trans func(key string) string) {
+	b.Message = trans(b.Message)
+}
+
+func (c *CleanExpiredTokensResponse) TranslateMessage(trans func(key string) string) {
+	c.Message = trans(c.Message)
+}
@@ -26,6 +26,39 @@ type ApiInfo struct {
 	ApiGroup    string `json:"apiGroup"`             // API分组 / API group
 }
 
+type ApiKeyInfo struct {
+	ID          *uint32  `json:"id,optional"`                                       // 密钥ID / Key ID
+	CreatedAt   *int64   `json:"createdAt,optional"`                                // 创建时间 / Creation time
+	UpdatedAt   *int64   `json:"updatedAt,optional"`                                // 更新时间 / Update time
+	State       *bool    `json:"state,optional"`                                    // 状态 / State
+	UserId      *string  `json:"userId,optional"`                                   // 所属用户ID / Owner user ID
+	Username    *string  `json:"username,optional"`                                 // 所属用户名 / Owner username
+	Name        string   `json:"name" validate:"required,max=100"`                  // 名称 / Name
+	KeyPrefix   *string  `json:"keyPrefix,optional"`                                // 密钥前缀 / Key prefix
+	Roles       []string `json:"roles" validate:"required,min=1"`                   // 授权的角色编码，须为所属用户角色的子集 / Granted role codes
+	AllowedIps  []string `json:"allowedIps,optional"`                               // 允许访问的IP或CIDR，为空不限制 / Allowed IPs or CIDRs
+	ExpiresAt   *int64   `json:"expiresAt,optional"`                                // 过期时间，为空永不过期 / Expiration time
+	LastUsedAt  *int64   `json:"lastUsedAt,optional"`                               // 最后使用时间 / Last used time
+	LastUsedIp  *string  `json:"lastUsedIp,optional"`                               // 最后使用IP / Last used IP
+	Description *string  `json:"description,optional" validate:"omitempty,max=255"` // 描述 / Description
+}
+
+type ApiKeyListInfo struct {
+	BaseListInfo
+	List []ApiKeyInfo `json:"list"` // 密钥列表 / Key list
+}
+
+type ApiKeyListRequest struct {
+	PageRequest
+	UserId *string `json:"userId,optional"` // 所属用户ID / Owner user ID
+	Name   *string `json:"name,optional"`   // 名称 / Name
+}
+
+type ApiKeyListResponse struct {
+	BaseDataInfo
+	Data ApiKeyListInfo `json:"data"` // 密钥列表 / Key list
+}
+
 type ApiListInfo struct {
 	BaseListInfo
 	List []ApiInfo `json:"list"` // API列表 / API list
@@ -79,14 +112,76 @@ type BaseUUIDInfo struct {
 	UpdatedAt *int64 `json:"updatedAt"`
 }
 
+type BatchItemResult struct {
+	ID      string `json:"id"`               // ID / ID
+	Success bool   `json:"success"`          // 是否成功 / Whether it succeeded
+	Message string `json:"message,optional"` // 失败原因 / Failure reason
+}
+
+type BatchResponse struct {
+	BaseDataInfo
+	Data BatchResult `json:"data"` // 批量操作结果 / Batch result
+}
+
+type BatchResult struct {
+	Total   uint32            `json:"total"`   // 总数 / Total
+	Success uint32            `json:"success"` // 成功数 / Succeeded
+	Failed  uint32            `json:"failed"`  // 失败数 / Failed
+	Results []BatchItemResult `json:"results"` // 逐项结果 / Per-item results
+}
+
+type BatchStateID32Request struct {
+	Ids   []uint32 `json:"ids" validate:"required,min=1,max=500,dive,gt=0"` // ID列表 / IDs
+	State bool     `json:"state"`                                           // 状态 / State
+}
+
+type BatchStateUUIDRequest struct {
+	Ids   []string `json:"ids" validate:"required,min=1,max=500,dive,uuid"` // ID列表 / IDs
+	State bool     `json:"state"`                                           // 状态 / State
+}
+
 type BlockTokenRequest struct {
 	ID uint32 `json:"id"` // 令牌ID / Token ID
 }
 
+type BundleChange struct {
+	Kind   string   `json:"kind"`            // 类型：api、menu、role、configuration 或 policy / Change kind
+	Key    string   `json:"key"`             // 自然键 / Natural key
+	Action string   `json:"action"`          // 操作：create、update、delete、skip、conflict 或 unchanged / Change action
+	Fields []string `json:"fields,optional"` // 发生变化的字段 / Changed fields
+}
+
+type BundleExportRequest struct {
+	Format         string `json:"format,default=json" validate:"oneof=json yaml"` // 文件格式 / File format (json, yaml)
+	ExcludeSecrets bool   `json:"excludeSecrets,optional"`                        // 排除疑似密钥的配置项 / Exclude secret-like configurations
+}
+
+type BundleImportRequest struct {
+	Strategy       string `form:"strategy,default=fail" validate:"oneof=skip overwrite fail"` // 冲突策略：skip 跳过，overwrite 覆盖，fail 存在冲突时中止 / Conflict strategy
+	ExcludeSecrets bool   `form:"excludeSecrets,optional"`                                    // 跳过疑似密钥的配置项 / Skip secret-like configurations
+	DryRun         bool   `form:"dryRun,optional"`                                            // 仅预览变更 / Preview only
+}
+
+type BundleImportResponse struct {
+	BaseDataInfo
+	Data BundleImportResult `json:"data"` // 导入结果 / Import result
+}
+
+type BundleImportResult struct {
+	Changes   []BundleChange `json:"changes"`   // 变更明细 / Changes
+	Created   int64          `json:"created"`   // 新增数量，不含策略 / Created items, excluding policies
+	Updated   int64          `json:"updated"`   // 更新数量 / Updated items
+	Skipped   int64          `json:"skipped"`   // 跳过数量 / Skipped items
+	Unchanged int64          `json:"unchanged"` // 未变化数量 / Unchanged items
+	Conflicts int64          `json:"conflicts"` // 冲突数量 / Conflicting items
+	DryRun    bool           `json:"dryRun"`    // 是否仅预览 / Whether dry run
+}
+
 type CallbackInfo struct {
-	UserID    string `json:"userId"`      // 用户ID / User ID
-	Token     string `json:"accessToken"` // 访问令牌 / Access token
-	ExpiresAt int64  `json:"expiresAt"`   // 过期时间 / Expiration time
+	UserID               string `json:"userId"`                        // 用户ID / User ID
+	Token                string `json:"accessToken"`                   // 访问令牌 / Access token
+	ExpiresAt            int64  `json:"expiresAt"`                     // 过期时间 / Expiration time
+	PasswordChangeTicket string `json:"passwordChangeTicket,optional"` // 修改密码凭据，需修改密码时返回 / Password change ticket, returned when password change is required
 }
 
 type CallbackResponse struct {
@@ -99,6 +194,17 @@ type CaptchaInfo struct {
 	Value string `json:"value" validate:"required"` // 验证码值 / Captcha value
 }
 
+type ChangeExpiredPasswordRequest struct {
+	Ticket      string `json:"ticket" validate:"required"`             // 修改密码凭据 / Password change ticket
+	NewPassword string `json:"newPassword" validate:"required,max=64"` // 新密码 / New password
+}
+
+type ChangePasswordRequest struct {
+	OldPassword         string `json:"oldPassword" validate:"required,max=64"` // 当前密码 / Current password
+	NewPassword         string `json:"newPassword" validate:"required,max=64"` // 新密码 / New password
+	RevokeOtherSessions *bool  `json:"revokeOtherSessions,optional"`           // 是否同时退出其他设备 / Sign out other sessions
+}
+
 type CleanExpiredTokensRequest struct {
 	TokenType  *string `json:"tokenType,optional"`  // 令牌类型 / Token type (access_token, refresh_token, reset_password, email_verify, api_token, sso_token)
 	BeforeTime *int64  `json:"beforeTime,optional"` // 清理指定时间之前的token，如果不指定则清理所有过期token / Clean up tokens before the specified time, if not specified, clean up all expired tokens
@@ -134,6 +240,22 @@ type ConfigurationListResponse struct {
 	Data ConfigurationListInfo `json:"data"` // 配置列表 / Configuration list
 }
 
+type CreateApiKeyInfo struct {
+	ApiKeyInfo
+	Key string `json:"key"` // 明文密钥，仅返回一次 / Plain key, returned only once
+}
+
+type CreateApiKeyResponse struct {
+	BaseDataInfo
+	Data CreateApiKeyInfo `json:"data"` // 密钥信息 / Key information
+}
+
+type CursorInfo struct {
+	NextCursor string `json:"nextCursor,optional"` // 下一页游标 / Next page cursor
+	HasNext    bool   `json:"hasNext,optional"`    // 是否还有下一页 / Whether there is a next page
+	Skipped    uint64 `json:"skipped,optional"`    // 游标之前的记录数 / Records before the cursor
+}
+
 type DeleteConfigurationRequest struct {
 	Key string `json:"key"` // 配置键 / Configuration key
 }
@@ -142,20 +264,27 @@ type DeleteMenuRequest struct {
 	ID uint32 `json:"id"` // 菜单ID / Menu ID
 }
 
+type DeleteNotificationRequest struct {
+	Ids []uint32 `json:"ids" validate:"required,min=1"` // 通知ID / Notification IDs
+}
+
 type DepartmentInfo struct {
-	ID             *uint32 `json:"id,optional"`             // 部门ID / Department ID
-	CreatedAt      *int64  `json:"createdAt,optional"`      // 创建时间 / Creation time
-	UpdatedAt      *int64  `json:"updatedAt,optional"`      // 更新时间 / Update time
-	DeptName       string  `json:"deptName"`                // 部门名称 / Department name
-	DeptCode       string  `json:"deptCode"`                // 部门编码 / Department code
-	ParentId       *uint32 `json:"parentId"`                // 父部门ID / Parent department ID
-	SortOrder      int32   `json:"sortOrder"`               // 排序 / Sort
-	LeaderUserId   string  `json:"leaderUserId"`            // 部门负责人用户ID / Leader user ID
-	State          bool    `json:"state"`                   // 状态 / State
-	Description    *string `json:"description,optional"`    // 部门描述 / Department description
-	LeaderUsername *string `json:"leaderUsername,optional"` // 部门负责人用户名 / Leader username
-	LeaderPhone    *string `json:"leaderPhone,optional"`    // 部门负责人手机号 / Leader phone
-	LeaderEmail    *string `json:"leaderEmail,optional"`    // 部门负责人邮箱 / Leader email
+	ID             *uint32           `json:"id,optional"`             // 部门ID / Department ID
+	CreatedAt      *int64            `json:"createdAt,optional"`      // 创建时间 / Creation time
+	UpdatedAt      *int64            `json:"updatedAt,optional"`      // 更新时间 / Update time
+	DeptName       string            `json:"deptName"`                // 部门名称 / Department name
+	DeptNameI18n   map[string]string `json:"deptNameI18n,optional"`   // 部门名称多语言，键为语言标签 / Department name translations keyed by locale
+	DeptCode       string            `json:"deptCode"`                // 部门编码 / Department code
+	ParentId       *uint32           `json:"parentId"`                // 父部门ID / Parent department ID
+	SortOrder      int32             `json:"sortOrder"`               // 排序 / Sort
+	LeaderUserId   string            `json:"leaderUserId"`            // 部门负责人用户ID / Leader user ID
+	State          bool              `json:"state"`                   // 状态 / State
+	Description    *string           `json:"description,optional"`    // 部门描述 / Department description
+	LeaderUsername *string           `json:"leaderUsername,optional"` // 部门负责人用户名 / Leader username
+	LeaderPhone    *string           `json:"leaderPhone,optional"`    // 部门负责人手机号 / Leader phone
+	LeaderEmail    *string           `json:"leaderEmail,optional"`    // 部门负责人邮箱 / Leader email
+	RoleIds        []uint32          `json:"roleIds,optional"`        // 部门授予的角色ID / Role IDs granted by the department
+	InheritRoles   *bool             `json:"inheritRoles,optional"`   // 下级部门是否继承本部门角色 / Whether sub-departments inherit roles
 }
 
 type DepartmentListInfo struct {
@@ -176,6 +305,36 @@ type DepartmentListResponse struct {
 	Data DepartmentListInfo `json:"data"` // 部门列表 / Department list
 }
 
+type DepartmentRoleRequest struct {
+	DepartmentId uint32   `json:"departmentId"` // 部门ID / Department ID
+	RoleIds      []uint32 `json:"roleIds"`      // 角色ID / Role ID
+}
+
+type DictCodeRequest struct {
+	Code string `form:"code" validate:"required,max=100"` // 字典编码 / Dictionary code
+}
+
+type DictCodesRequest struct {
+	Codes string `form:"codes" validate:"required"` // 字典编码，多个以逗号分隔 / Dictionary codes separated by commas
+}
+
+type DictData struct {
+	Code     string         `json:"code"`     // 字典编码 / Dictionary code
+	Name     string         `json:"name"`     // 字典名称 / Dictionary name
+	IsPublic bool           `json:"isPublic"` // 是否公开 / Whether public
+	Items    []DictItemInfo `json:"items"`    // 启用的字典子项，按排序升序 / Enabled items sorted by sort order
+}
+
+type DictDataListResponse struct {
+	BaseDataInfo
+	Data []DictData `json:"data"` // 字典数据列表 / Dictionary data list
+}
+
+type DictDataResponse struct {
+	BaseDataInfo
+	Data DictData `json:"data"` // 字典数据 / Dictionary data
+}
+
 type DictInfo struct {
 	ID          *uint32 `json:"id,optional"`          // 字典ID / Dictionary ID
 	CreatedAt   *int64  `json:"createdAt,optional"`   // 创建时间 / Creation time
@@ -184,6 +343,7 @@ type DictInfo struct {
 	Code        string  `json:"code"`                 // 字典编码 / Dictionary code
 	Description string  `json:"description,optional"` // 描述 / Description
 	State       *bool   `json:"state,optional"`       // 状态 / State
+	IsPublic    *bool   `json:"isPublic,optional"`    // 是否公开，公开字典允许匿名读取 / Whether anonymous users can read the dictionary
 }
 
 type DictInfoResponse struct {
@@ -192,17 +352,18 @@ type DictInfoResponse struct {
 }
 
 type DictItemInfo struct {
-	ID          *uint32 `json:"id,optional"`          // 字典子项ID / Dictionary item ID
-	CreatedAt   *int64  `json:"createdAt,optional"`   // 创建时间 / Creation time
-	UpdatedAt   *int64  `json:"updatedAt,optional"`   // 更新时间 / Update time
-	Label       string  `json:"label"`                // 字典子项标签 / Dictionary item label
-	Value       string  `json:"value"`                // 字典子项值 / Dictionary item value
-	Color       string  `json:"color,optional"`       // 字典子项颜色 / Dictionary item color
-	Css         string  `json:"css,optional"`         // 字典子项CSS / Dictionary item CSS
-	SortOrder   int32   `json:"sortOrder"`            // 排序 / Sort order
-	Description string  `json:"description,optional"` // 描述 / Description
-	State       *bool   `json:"state,optional"`       // 状态 / State
-	DictID      uint32  `json:"dictId"`               // 字典类型ID / Dictionary type ID
+	ID          *uint32           `json:"id,optional"`          // 字典子项ID / Dictionary item ID
+	CreatedAt   *int64            `json:"createdAt,optional"`   // 创建时间 / Creation time
+	UpdatedAt   *int64            `json:"updatedAt,optional"`   // 更新时间 / Update time
+	Label       string            `json:"label"`                // 字典子项标签 / Dictionary item label
+	LabelI18n   map[string]string `json:"labelI18n,optional"`   // 标签多语言，键为语言标签 / Label translations keyed by locale
+	Value       string            `json:"value"`                // 字典子项值 / Dictionary item value
+	Color       string            `json:"color,optional"`       // 字典子项颜色 / Dictionary item color
+	Css         string            `json:"css,optional"`         // 字典子项CSS / Dictionary item CSS
+	SortOrder   int32             `json:"sortOrder"`            // 排序 / Sort order
+	Description string            `json:"description,optional"` // 描述 / Description
+	State       *bool             `json:"state,optional"`       // 状态 / State
+	DictID      uint32            `json:"dictId"`               // 字典类型ID / Dictionary type ID
 }
 
 type DictItemListInfo struct {
@@ -244,12 +405,102 @@ type DictListResponse struct {
 	Data DictListInfo `json:"data"` // 字典列表 / Dictionary list
 }
 
+type DisableTotpRequest struct {
+	Code                string `json:"code" validate:"required,len=6"` // 当前验证码 / Current verification code
+	RevokeOtherSessions *bool  `json:"revokeOtherSessions,optional"`   // 是否同时退出其他设备 / Sign out other sessions
+}
+
+type EffectiveRoleInfo struct {
+	RoleId    uint32       `json:"roleId"`    // 角色ID / Role ID
+	RoleValue string       `json:"roleValue"` // 角色值 / Role value
+	RoleName  string       `json:"roleName"`  // 角色名称 / Role name
+	Sources   []RoleSource `json:"sources"`   // 角色来源 / Role sources
+}
+
+type EffectiveRoleListInfo struct {
+	List []EffectiveRoleInfo `json:"list"` // 有效角色列表 / Effective role list
+}
+
+type EffectiveRoleListResponse struct {
+	BaseDataInfo
+	Data EffectiveRoleListInfo `json:"data"` // 有效角色列表 / Effective role list
+}
+
 type EnableTotpRequest struct {
-	UserId string `json:"userId"` // 用户ID / User ID
 	Issuer string `json:"issuer"` // 发行者名称 / Issuer name
 	Domain string `json:"domain"` // 域名 / Domain
 }
 
+type FileDeleteRequest struct {
+	Ids []string `json:"ids" validate:"required,min=1,dive,uuid"` // 文件ID / File IDs
+}
+
+type FileDownloadRequest struct {
+	ID string `path:"id"` // 文件ID / File ID
+}
+
+type FileInfo struct {
+	ID        *string `json:"id,optional"`        // 文件ID / File ID
+	CreatedAt *int64  `json:"createdAt,optional"` // 上传时间 / Upload time
+	OwnerId   *string `json:"ownerId,optional"`   // 上传用户ID / Owner user ID
+	OwnerName *string `json:"ownerName,optional"` // 上传用户名 / Owner username
+	Name      *string `json:"name,optional"`      // 原始文件名 / Original file name
+	Category  *string `json:"category,optional"`  // 文件分类 / File category (attachment, avatar)
+	Size      *int64  `json:"size,optional"`      // 文件大小(字节) / File size (bytes)
+	MimeType  *string `json:"mimeType,optional"`  // 文件类型 / MIME type
+	Checksum  *string `json:"checksum,optional"`  // SHA-256 / SHA-256 checksum
+}
+
+type FileInfoResponse struct {
+	BaseDataInfo
+	Data FileInfo `json:"data"` // 文件信息 / File information
+}
+
+type FileListInfo struct {
+	BaseListInfo
+	List []FileInfo `json:"list"` // 文件列表 / File list
+}
+
+type FileListRequest struct {
+	PageRequest
+	OwnerId  *string `json:"ownerId,optional" validate:"omitempty,uuid"` // 上传用户ID / Owner user ID
+	Name     *string `json:"name,optional"`                              // 文件名 / File name
+	Category *string `json:"category,optional"`                          // 文件分类 / File category
+	MimeType *string `json:"mimeType,optional"`                          // 文件类型，支持前缀如 image/ / MIME type or prefix
+}
+
+type FileListResponse struct {
+	BaseDataInfo
+	Data FileListInfo `json:"data"` // 文件列表 / File list
+}
+
+type FileSignedDownloadRequest struct {
+	ID        string `path:"id"`        // 文件ID / File ID
+	Expires   int64  `form:"expires"`   // 过期时间(秒级时间戳) / Expiry (unix seconds)
+	Signature string `form:"signature"` // 签名 / Signature
+}
+
+type FileUrl struct {
+	Url       string `json:"url"`       // 下载链接 / Download URL
+	ExpiresAt int64  `json:"expiresAt"` // 过期时间(秒级时间戳) / Expiry (unix seconds)
+}
+
+type FileUrlRequest struct {
+	ID     string `json:"id" validate:"required,uuid"`                           // 文件ID / File ID
+	Expire int64  `json:"expire,optional" validate:"omitempty,min=1,max=604800"` // 有效期(秒)，为空时使用默认值 / Lifetime in seconds
+}
+
+type FileUrlResponse struct {
+	BaseDataInfo
+	Data FileUrl `json:"data"` // 下载链接 / Download URL
+}
+
+type FilterCondition struct {
+	Field  string   `json:"field" validate:"required,max=64"`                                                            // 字段名 / Field name
+	Op     string   `json:"op" validate:"oneof=eq neq gt gte lt lte in notIn between contains hasPrefix isNull notNull"` // 操作符 / Operator
+	Values []string `json:"values,optional" validate:"omitempty,max=100"`                                                // 比较值 / Values
+}
+
 type GenerateCaptchaInfo struct {
 	ID          string `json:"id"`          // 验证码ID
 	Base64Blob  string `json:"base64Blob"`  // Base64编码的验证码图片/音频
@@ -265,15 +516,58 @@ type ID32Request struct {
 	ID uint32 `json:"id" validate:"required,number,gt=0"`
 }
 
+type ID32SRequest struct {
+	Ids []uint32 `json:"ids" validate:"required,min=1,max=500,dive,gt=0"` // ID列表 / IDs
+}
+
+type ImpersonateRequest struct {
+	UserId string  `json:"userId" validate:"required,uuid"`              // 被模拟的用户ID / User ID to impersonate
+	Reason *string `json:"reason,optional" validate:"omitempty,max=255"` // 模拟原因 / Reason
+}
+
+type JobInfo struct {
+	Name         string  `json:"name"`                  // 任务名称 / Job name (token_cleanup, log_retention, totp_cleanup)
+	Spec         string  `json:"spec"`                  // cron 表达式，为空表示未启用定时执行 / Cron expression, empty means not scheduled
+	Paused       bool    `json:"paused"`                // 是否暂停 / Whether paused
+	LastRunAt    *int64  `json:"lastRunAt,optional"`    // 最后执行时间 / Last run time
+	LastStatus   *string `json:"lastStatus,optional"`   // 最后执行状态 / Last run status (running, success, failed)
+	LastTrigger  *string `json:"lastTrigger,optional"`  // 最后触发方式 / Last trigger (schedule, manual)
+	LastDuration *int64  `json:"lastDuration,optional"` // 最后执行耗时(毫秒) / Last run duration (milliseconds)
+	LastMessage  *string `json:"lastMessage,optional"`  // 最后执行结果 / Last run result or error message
+	NextRunAt    *int64  `json:"nextRunAt,optional"`    // 下次执行时间 / Next run time
+}
+
+type JobListInfo struct {
+	BaseListInfo
+	List []JobInfo `json:"list"` // 任务列表 / Job list
+}
+
+type JobListResponse struct {
+	BaseDataInfo
+	Data JobListInfo `json:"data"` // 任务列表 / Job list
+}
+
+type JobNameRequest struct {
+	Name string `json:"name" validate:"required"` // 任务名称 / Job name
+}
+
+type JobPauseRequest struct {
+	Name   string `json:"name" validate:"required"` // 任务名称 / Job name
+	Paused bool   `json:"paused"`                   // 是否暂停 / Whether to pause
+}
+
 type LoginInfo struct {
-	AccessToken string `json:"accessToken"` // 访问令牌 / Access token
+	AccessToken          string `json:"accessToken"`                   // 访问令牌 / Access token
+	PasswordChangeTicket string `json:"passwordChangeTicket,optional"` // 修改密码凭据，需修改密码时返回 / Password change ticket, returned when password change is required
 }
 
 type LoginRequest struct {
-	Captcha  CaptchaInfo `json:"captcha"`                                   // 验证码 / Captcha
-	Password string      `json:"password" validate:"required,min=6,max=16"` // 密码 / Password
-	Username string      `json:"username" validate:"required,min=4,max=16"` // 用户名 / Username
-	TotpCode *string     `json:"totpCode,optional"`                         // TOTP认证 / TOTP authentication
+	Captcha            CaptchaInfo            `json:"captcha"`                                   // 验证码 / Captcha
+	Password           string                 `json:"password" validate:"required,max=64"`       // 密码 / Password
+	Username           string                 `json:"username" validate:"required,min=4,max=16"` // 用户名 / Username
+	TotpCode           *string                `json:"totpCode,optional"`                         // TOTP认证 / TOTP authentication
+	WebauthnSessionId  *string                `json:"webauthnSessionId,optional"`                // WebAuthn会话ID / WebAuthn session ID
+	WebauthnCredential map[string]interface{} `json:"webauthnCredential,optional"`               // WebAuthn断言 / WebAuthn assertion
 }
 
 type LoginResponse struct {
@@ -281,6 +575,21 @@ type LoginResponse struct {
 	Data LoginInfo `json:"data"` // 登录信息 / Login information
 }
 
+type MarkNotificationRequest struct {
+	Ids []uint32 `json:"ids,optional"` // 通知ID / Notification IDs
+	All bool     `json:"all,optional"` // 是否标记全部 / Whether to mark all
+}
+
+type MenuApiListResponse struct {
+	BaseDataInfo
+	Data []uint32 `json:"data"` // 菜单绑定的API ID / API IDs bound to the menu
+}
+
+type MenuApiRequest struct {
+	MenuId uint32   `json:"menuId"` // 菜单ID / Menu ID
+	ApiIds []uint32 `json:"apiIds"` // API ID / API ID
+}
+
 type MenuInfo struct {
 	Path        string     `json:"path"`                 // 菜单路径 / Menu path
 	Name        string     `json:"name"`                 // 菜单名称 / Menu name
@@ -304,15 +613,21 @@ type MenuListResponse struct {
 }
 
 type Meta struct {
-	Title         string  `json:"title,optional"`         // 菜单标题 / Menu title
-	Icon          string  `json:"icon,optional"`          // 菜单图标 / Menu icon
-	Order         *int32  `json:"order,optional"`         // 菜单排序 / Menu order
-	HideInMenu    *bool   `json:"hideInMenu,optional"`    // 是否在菜单中隐藏 / Whether to hide in menu
-	AffixTab      *bool   `json:"affixTab,optional"`      // 是否固定在标签栏 / Whether to fix in tabbar
-	AffixTabOrder *int32  `json:"affixTabOrder,optional"` // 标签栏固定顺序 / Tabbar fix order
-	Link          *string `json:"link,optional"`          // 外链地址 / Link address
-	IframeSrc     *string `json:"iframeSrc,optional"`     // 内嵌iframe地址 / Embedded iframe address
-	KeepAlive     *bool   `json:"keepAlive,optional"`     // 是否缓存 / Whether to cache
+	Title         string            `json:"title,optional"`         // 菜单标题 / Menu title
+	TitleI18n     map[string]string `json:"titleI18n,optional"`     // 菜单标题多语言，键为语言标签 / Menu title translations keyed by locale
+	Icon          string            `json:"icon,optional"`          // 菜单图标 / Menu icon
+	Order         *int32            `json:"order,optional"`         // 菜单排序 / Menu order
+	HideInMenu    *bool             `json:"hideInMenu,optional"`    // 是否在菜单中隐藏 / Whether to hide in menu
+	AffixTab      *bool             `json:"affixTab,optional"`      // 是否固定在标签栏 / Whether to fix in tabbar
+	AffixTabOrder *int32            `json:"affixTabOrder,optional"` // 标签栏固定顺序 / Tabbar fix order
+	Link          *string           `json:"link,optional"`          // 外链地址 / Link address
+	IframeSrc     *string           `json:"iframeSrc,optional"`     // 内嵌iframe地址 / Embedded iframe address
+	KeepAlive     *bool             `json:"keepAlive,optional"`     // 是否缓存 / Whether to cache
+}
+
+type ModifyApiKeyResponse struct {
+	BaseDataInfo
+	Data ApiKeyInfo `json:"data"` // 密钥信息 / Key information
 }
 
 type ModifyConfigurationResponse struct {
@@ -340,6 +655,165 @@ type ModifyUserResponse struct {
 	Data UserInfo `json:"data"` // 用户信息 / User information
 }
 
+type MyNotificationListRequest struct {
+	PageRequest
+	Status   *string `json:"status,optional" validate:"omitempty,oneof=unread read archived"` // 阅读状态，为空时返回未归档的通知 / Status
+	Category *string `json:"category,optional"`                                               // 分类 / Category
+}
+
+type MySecurityEventListRequest struct {
+	PageRequest
+	EventType *string `json:"eventType,optional"` // 事件类型 / Event type
+	Success   *bool   `json:"success,optional"`   // 是否成功 / Whether successful
+	StartTime *int64  `json:"startTime,optional"` // 开始时间 / Start time
+	EndTime   *int64  `json:"endTime,optional"`   // 结束时间 / End time
+}
+
+type NotificationCountInfo struct {
+	Count int64 `json:"count"` // 数量 / Count
+}
+
+type NotificationCountResponse struct {
+	BaseDataInfo
+	Data NotificationCountInfo `json:"data"` // 未读数量 / Unread count
+}
+
+type NotificationInfo struct {
+	ID            *uint32  `json:"id,optional"`                                                               // 通知ID / Notification ID
+	CreatedAt     *int64   `json:"createdAt,optional"`                                                        // 创建时间 / Creation time
+	UpdatedAt     *int64   `json:"updatedAt,optional"`                                                        // 更新时间 / Update time
+	State         *bool    `json:"state,optional"`                                                            // 状态 / State
+	Title         string   `json:"title" validate:"required,max=200"`                                         // 标题 / Title
+	Content       *string  `json:"content,optional"`                                                          // 内容 / Content
+	Category      *string  `json:"category,optional" validate:"omitempty,oneof=announcement notice security"` // 分类 / Category
+	Level         *string  `json:"level,optional" validate:"omitempty,oneof=info warning critical"`           // 级别 / Level
+	TargetAll     *bool    `json:"targetAll,optional"`                                                        // 是否发送给所有用户 / Whether targeted at all users
+	UserIds       []string `json:"userIds,optional"`                                                          // 接收用户ID / Target user IDs
+	RoleIds       []uint32 `json:"roleIds,optional"`                                                          // 接收角色ID / Target role IDs
+	DepartmentIds []uint32 `json:"departmentIds,optional"`                                                    // 接收部门ID / Target department IDs
+	PublishAt     *int64   `json:"publishAt,optional"`                                                        // 发布时间，为空立即发布 / Publish time
+	ExpireAt      *int64   `json:"expireAt,optional"`                                                         // 过期时间，为空永不过期 / Expiration time
+	SenderId      *string  `json:"senderId,optional"`                                                         // 发送人ID / Sender ID
+	Status        *string  `json:"status,optional"`                                                           // 阅读状态 / Status (unread, read, archived)
+	ReadAt        *int64   `json:"readAt,optional"`                                                           // 阅读时间 / Read time
+}
+
+type NotificationListInfo struct {
+	BaseListInfo
+	List []NotificationInfo `json:"list"` // 通知列表 / Notification list
+}
+
+type NotificationListRequest struct {
+	PageRequest
+	Title    *string `json:"title,optional"`    // 标题 / Title
+	Category *string `json:"category,optional"` // 分类 / Category
+	State    *bool   `json:"state,optional"`    // 状态 / State
+}
+
+type NotificationListResponse struct {
+	BaseDataInfo
+	Data NotificationListInfo `json:"data"` // 通知列表 / Notification list
+}
+
+type OauthAuthorizeInfo struct {
+	ClientId        string   `json:"clientId"`            // 客户端ID / Client ID
+	ClientName      string   `json:"clientName"`          // 应用名称 / Application name
+	ClientLogo      *string  `json:"clientLogo,optional"` // 应用图标 / Application logo
+	Scopes          []string `json:"scopes"`              // 申请的权限范围 / Requested scopes
+	ConsentRequired bool     `json:"consentRequired"`     // 是否需要用户确认 / Whether consent is required
+}
+
+type OauthAuthorizeInfoResponse struct {
+	BaseDataInfo
+	Data OauthAuthorizeInfo `json:"data"` // 授权信息 / Authorization information
+}
+
+type OauthAuthorizeRequest struct {
+	ResponseType        string  `json:"responseType" validate:"required"` // 响应类型，固定为 code / Response type
+	ClientId            string  `json:"clientId" validate:"required"`     // 客户端ID / Client ID
+	RedirectUri         string  `json:"redirectUri" validate:"required"`  // 回调地址 / Redirect URI
+	Scope               *string `json:"scope,optional"`                   // 权限范围，空格分隔 / Space separated scopes
+	State               *string `json:"state,optional"`                   // 客户端状态值 / Client state
+	Nonce               *string `json:"nonce,optional"`                   // ID令牌随机值 / ID token nonce
+	CodeChallenge       *string `json:"codeChallenge,optional"`           // PKCE挑战值 / PKCE code challenge
+	CodeChallengeMethod *string `json:"codeChallengeMethod,optional"`     // PKCE挑战方式，仅支持 S256 / PKCE method
+}
+
+type OauthClientInfo struct {
+	ID           *uint32  `json:"id,optional"`                                       // 应用ID / Application ID
+	CreatedAt    *int64   `json:"createdAt,optional"`                                // 创建时间 / Creation time
+	UpdatedAt    *int64   `json:"updatedAt,optional"`                                // 更新时间 / Update time
+	State        *bool    `json:"state,optional"`                                    // 状态 / State
+	ClientId     *string  `json:"clientId,optional" validate:"omitempty,max=64"`     // 客户端ID，为空时自动生成 / Client ID, generated if empty
+	Name         string   `json:"name" validate:"required,max=100"`                  // 应用名称 / Application name
+	RedirectUris []string `json:"redirectUris,optional"`                             // 回调地址 / Redirect URIs
+	GrantTypes   []string `json:"grantTypes" validate:"required,min=1"`              // 授权类型 / Grant types
+	Scopes       []string `json:"scopes,optional"`                                   // 允许的权限范围，为空时允许标准范围 / Allowed scopes
+	Public       *bool    `json:"public,optional"`                                   // 是否公共客户端，创建后不可修改 / Public client, immutable
+	SkipConsent  *bool    `json:"skipConsent,optional"`                              // 是否跳过授权确认 / Skip consent
+	Logo         *string  `json:"logo,optional" validate:"omitempty,max=500"`        // 应用图标 / Logo
+	Description  *string  `json:"description,optional" validate:"omitempty,max=255"` // 描述 / Description
+	ResetSecret  *bool    `json:"resetSecret,optional"`                              // 更新时是否重置密钥 / Reset secret on update
+}
+
+type OauthClientListInfo struct {
+	BaseListInfo
+	List []OauthClientInfo `json:"list"` // 应用列表 / Application list
+}
+
+type OauthClientListRequest struct {
+	PageRequest
+	Name     *string `json:"name,optional"`     // 应用名称 / Application name
+	ClientId *string `json:"clientId,optional"` // 客户端ID / Client ID
+}
+
+type OauthClientListResponse struct {
+	BaseDataInfo
+	Data OauthClientListInfo `json:"data"` // 应用列表 / Application list
+}
+
+type OauthClientSecretInfo struct {
+	OauthClientInfo
+	ClientSecret *string `json:"clientSecret,optional"` // 明文密钥，仅在创建或重置时返回 / Plain secret, returned only on create or reset
+}
+
+type OauthClientSecretResponse struct {
+	BaseDataInfo
+	Data OauthClientSecretInfo `json:"data"` // 应用信息 / Application information
+}
+
+type OauthConsentDeleteRequest struct {
+	ClientId string `json:"clientId" validate:"required"` // 客户端ID / Client ID
+}
+
+type OauthConsentInfo struct {
+	ClientId   string   `json:"clientId"`            // 客户端ID / Client ID
+	ClientName *string  `json:"clientName,optional"` // 应用名称 / Application name
+	ClientLogo *string  `json:"clientLogo,optional"` // 应用图标 / Application logo
+	Scopes     []string `json:"scopes"`              // 已授权的权限范围 / Granted scopes
+	CreatedAt  *int64   `json:"createdAt,optional"`  // 首次授权时间 / First granted time
+	UpdatedAt  *int64   `json:"updatedAt,optional"`  // 最近授权时间 / Last granted time
+}
+
+type OauthConsentListInfo struct {
+	List []OauthConsentInfo `json:"list"` // 授权列表 / Consent list
+}
+
+type OauthConsentListResponse struct {
+	BaseDataInfo
+	Data OauthConsentListInfo `json:"data"` // 授权列表 / Consent list
+}
+
+type OauthConsentRequest struct {
+	OauthAuthorizeRequest
+	Approve bool `json:"approve"` // 是否同意授权 / Whether the user approves
+}
+
+type OauthConsentResultResponse struct {
+	BaseDataInfo
+	Data OauthRedirectInfo `json:"data"` // 回调信息 / Redirect information
+}
+
 type OauthLoginRequest struct {
 	Provider string `json:"provider" validate:"required"`     // Oauth 提供商 / Oauth provider
 	State    string `json:"state" validate:"required,len=32"` // 状态 / State
@@ -385,29 +859,244 @@ type OauthProviderListResponse struct {
 	Data OauthProviderInfoList `json:"data"` // 提供商列表 / Provider list
 }
 
+type OauthRedirectInfo struct {
+	RedirectUri string `json:"redirectUri"` // 携带授权码或错误的回调地址 / Redirect URI with code or error
+}
+
 type OauthRedirectResponse struct {
 	BaseDataInfo
 	Data string `json:"data"` // 重定向地址 / Redirect url
 }
 
+type OperationLogArchiveInfo struct {
+	ID         *uint32 `json:"id,optional"`         // 归档ID / Archive ID
+	CreatedAt  *int64  `json:"createdAt,optional"`  // 归档时间 / Archived time
+	Module     *string `json:"module,optional"`     // 操作模块 / Module
+	StartTime  *int64  `json:"startTime,optional"`  // 记录最早时间 / Earliest record time
+	EndTime    *int64  `json:"endTime,optional"`    // 记录最晚时间 / Latest record time
+	RowCount   *int64  `json:"rowCount,optional"`   // 记录数 / Number of records
+	Size       *int64  `json:"size,optional"`       // 文件大小(字节) / File size (bytes)
+	Checksum   *string `json:"checksum,optional"`   // SHA-256 / SHA-256 checksum
+	RestoredAt *int64  `json:"restoredAt,optional"` // 恢复时间 / Restored time
+}
+
+type OperationLogArchiveListInfo struct {
+	BaseListInfo
+	List []OperationLogArchiveInfo `json:"list"` // 归档列表 / Archive list
+}
+
+type OperationLogArchiveListRequest struct {
+	PageRequest
+	Module    *string `json:"module,optional"`    // 操作模块 / Module
+	StartTime *int64  `json:"startTime,optional"` // 开始时间 / Start time
+	EndTime   *int64  `json:"endTime,optional"`   // 结束时间 / End time
+}
+
+type OperationLogArchiveListResponse struct {
+	BaseDataInfo
+	Data OperationLogArchiveListInfo `json:"data"` // 归档列表 / Archive list
+}
+
+type OperationLogExportRequest struct {
+	OperationLogFilter
+	Format string `json:"format,default=csv" validate:"oneof=csv jsonl"` // 文件格式 / File format (csv, jsonl)
+}
+
+type OperationLogFilter struct {
+	UserId        *string `json:"userId,optional"`        // 操作用户ID / User ID
+	Username      *string `json:"username,optional"`      // 操作用户名 / Username
+	OperationType *string `json:"operationType,optional"` // 操作类型 / Operation type
+	Module        *string `json:"module,optional"`        // 操作模块 / Module
+	BusinessType  *string `json:"businessType,optional"`  // 业务类型 / Business type
+	Method        *string `json:"method,optional"`        // 请求方法 / Request method
+	RequestUrl    *string `json:"requestUrl,optional"`    // 请求URL / Request URL
+	RequestParams *string `json:"requestParams,optional"` // 请求参数 / Request parameters
+	ResponseData  *string `json:"responseData,optional"`  // 响应数据 / Response data
+	IpAddress     *string `json:"ipAddress,optional"`     // IP地址 / IP address
+	UserAgent     *string `json:"userAgent,optional"`     // 用户代理 / User agent
+	Location      *string `json:"location,optional"`      // 操作地点 / Location
+	IsSuccess     *bool   `json:"isSuccess,optional"`     // 是否成功 / Whether successful
+	StartTime     *int64  `json:"startTime,optional"`     // 开始时间 / Start time
+	EndTime       *int64  `json:"endTime,optional"`       // 结束时间 / End time
+}
+
+type OperationLogInfo struct {
+	ID            *uint32 `json:"id,optional"`            // 记录ID / Log ID
+	CreatedAt     *int64  `json:"createdAt,optional"`     // 操作时间 / Operation time
+	UserId        *string `json:"userId,optional"`        // 操作用户ID / User ID
+	Username      *string `json:"username,optional"`      // 操作用户名 / Username
+	OperationType *string `json:"operationType,optional"` // 操作类型 / Operation type
+	Module        *string `json:"module,optional"`        // 操作模块 / Module
+	BusinessType  *string `json:"businessType,optional"`  // 业务类型 / Business type
+	Method        *string `json:"method,optional"`        // 请求方法 / Request method
+	RequestUrl    *string `json:"requestUrl,optional"`    // 请求URL / Request URL
+	RequestParams *string `json:"requestParams,optional"` // 请求参数 / Request parameters
+	ResponseData  *string `json:"responseData,optional"`  // 响应数据 / Response data
+	IpAddress     *string `json:"ipAddress,optional"`     // IP地址 / IP address
+	UserAgent     *string `json:"userAgent,optional"`     // 用户代理 / User agent
+	Location      *string `json:"location,optional"`      // 操作地点 / Location
+	IsSuccess     *bool   `json:"isSuccess,optional"`     // 是否成功 / Whether successful
+	ErrorMessage  *string `json:"errorMessage,optional"`  // 错误信息 / Error message
+	ExecutionTime *int32  `json:"executionTime,optional"` // 执行时间(毫秒) / Execution time (milliseconds)
+	Description   *string `json:"description,optional"`   // 操作描述 / Description
+}
+
+type OperationLogListInfo struct {
+	BaseListInfo
+	CursorInfo
+	List []OperationLogInfo `json:"list"` // 操作记录列表 / Log list
+}
+
+type OperationLogListRequest struct {
+	PageRequest
+	OperationLogFilter
+}
+
+type OperationLogListResponse struct {
+	BaseDataInfo
+	Data OperationLogListInfo `json:"data"` // 操作记录列表 / Log list
+}
+
 type Page struct {
-	PageSize    uint32 `json:"pageSize"`
-	CurrentPage uint32 `json:"currentPage"`
+	PageSize    uint32            `json:"pageSize"`
+	CurrentPage uint32            `json:"currentPage"`
+	Filters     []FilterCondition `json:"filters,optional" validate:"omitempty,max=20,dive"` // 筛选条件 / Filter conditions
+	Sorts       []SortOption      `json:"sorts,optional" validate:"omitempty,max=5,dive"`    // 排序条件 / Sort options
+	Cursor      *string           `json:"cursor,optional" validate:"omitempty,max=512"`      // 游标分页，首页传空字符串，之后传上一页的 nextCursor / Cursor pagination, empty for the first page
+	WithSkipped bool              `json:"withSkipped,optional"`                              // 游标分页时统计游标之前的记录数 / Count records before the cursor
 }
 
 type PageRequest struct {
 	Page Page `json:"page"`
 }
 
+type PermissionEntry struct {
+	Kind      string `json:"kind"`      // 类型：menu 菜单，button 按钮，api 接口，configuration 配置项，role 角色，user 用户 / Entry kind
+	RoleValue string `json:"roleValue"` // 角色值 / Role value
+	Object    string `json:"object"`    // 菜单编码、接口路径、配置分组或用户名 / Menu code, API path, configuration group or username
+	Action    string `json:"action"`    // 接口请求方法或配置操作 / HTTP method or configuration operation
+	Label     string `json:"label"`     // 菜单、接口、角色名称或用户姓名 / Name
+	Detail    string `json:"detail"`    // 授权来源、命中的策略或角色来源 / Grant source, matched policy or role sources
+}
+
+type PermissionExplainInfo struct {
+	Allowed     bool                     `json:"allowed"`            // 是否允许 / Whether allowed
+	GrantedBy   *string                  `json:"grantedBy,optional"` // 授予访问权限的角色 / Role granting access
+	Simulated   bool                     `json:"simulated"`          // 是否包含模拟的角色变更 / Whether simulated
+	Public      bool                     `json:"public"`             // 是否为公开资源 / Whether the resource is public
+	Roles       []PermissionRoleDecision `json:"roles"`              // 各角色的评估结果 / Per role decisions
+	Suggestions []PermissionSuggestion   `json:"suggestions"`        // 授权建议 / Suggestions
+}
+
+type PermissionExplainRequest struct {
+	UserId           *string  `json:"userId,optional" validate:"omitempty,uuid"` // 用户ID，提供时以其有效角色为基础 / User ID
+	RoleValues       []string `json:"roleValues,optional"`                       // 角色值，未提供用户时使用 / Role values when no user is given
+	AddRoleValues    []string `json:"addRoleValues,optional"`                    // 模拟追加的角色值 / Role values to add in simulation
+	RemoveRoleValues []string `json:"removeRoleValues,optional"`                 // 模拟移除的角色值 / Role values to remove in simulation
+	Domain           string   `json:"domain" validate:"oneof=api configuration"` // 资源类型 / Resource type (api, configuration)
+	Object           string   `json:"object" validate:"required"`                // API路径或配置分组 / API path or configuration group
+	Action           string   `json:"action" validate:"required"`                // 请求方法或配置操作 / HTTP method or configuration operation
+}
+
+type PermissionExplainResponse struct {
+	BaseDataInfo
+	Data PermissionExplainInfo `json:"data"` // 评估结果 / Explanation
+}
+
+type PermissionHolderExportRequest struct {
+	PermissionHolderRequest
+	Format string `json:"format,default=xlsx" validate:"oneof=csv xlsx"` // 文件格式 / File format (csv, xlsx)
+}
+
+type PermissionHolderListInfo struct {
+	List []PermissionEntry `json:"list"` // 持有权限的角色及用户 / Roles and users holding the permission
+}
+
+type PermissionHolderRequest struct {
+	Kind   string `json:"kind" validate:"oneof=api menu configuration"` // 权限类型 / Permission kind
+	Object string `json:"object" validate:"required"`                   // 接口路径、菜单编码或配置分组 / API path, menu code or configuration group
+	Action string `json:"action,optional"`                              // 接口请求方法或配置操作，菜单为空 / HTTP method or configuration operation
+}
+
+type PermissionHolderResponse struct {
+	BaseDataInfo
+	Data PermissionHolderListInfo `json:"data"` // 权限持有人 / Permission holders
+}
+
+type PermissionRoleDecision struct {
+	RoleValue       string       `json:"roleValue"`       // 角色值 / Role value
+	Allowed         bool         `json:"allowed"`         // 是否允许 / Whether allowed
+	MatchedPolicies []string     `json:"matchedPolicies"` // 命中的策略 / Matched policies
+	Sources         []RoleSource `json:"sources"`         // 角色来源 / Role sources
+	Simulated       bool         `json:"simulated"`       // 是否为模拟追加的角色 / Whether added by simulation
+}
+
+type PermissionSnapshotCreateRequest struct {
+	Remark *string `json:"remark,optional" validate:"omitempty,max=255"` // 备注，如审查周期 / Remark, e.g. review period
+}
+
+type PermissionSnapshotDiff struct {
+	Added   []PermissionEntry `json:"added"`   // 新增的授权 / Added entries
+	Removed []PermissionEntry `json:"removed"` // 移除的授权 / Removed entries
+}
+
+type PermissionSnapshotDiffExportRequest struct {
+	PermissionSnapshotDiffRequest
+	Format string `json:"format,default=xlsx" validate:"oneof=csv xlsx"` // 文件格式 / File format (csv, xlsx)
+}
+
+type PermissionSnapshotDiffRequest struct {
+	FromId uint32  `json:"fromId" validate:"required,gt=0"`         // 基准快照ID / Base snapshot ID
+	ToId   *uint32 `json:"toId,optional" validate:"omitempty,gt=0"` // 对比快照ID，为空时与当前权限对比 / Target snapshot ID, current permissions when empty
+}
+
+type PermissionSnapshotDiffResponse struct {
+	BaseDataInfo
+	Data PermissionSnapshotDiff `json:"data"` // 权限差异 / Permission diff
+}
+
+type PermissionSnapshotInfo struct {
+	ID         *uint32 `json:"id,optional"`         // 快照ID / Snapshot ID
+	CreatedAt  *int64  `json:"createdAt,optional"`  // 创建时间 / Created time
+	Remark     *string `json:"remark,optional"`     // 备注 / Remark
+	CreatorId  *string `json:"creatorId,optional"`  // 创建人ID / Creator user ID
+	EntryCount *int64  `json:"entryCount,optional"` // 授权记录数 / Number of entries
+}
+
+type PermissionSnapshotListInfo struct {
+	BaseListInfo
+	List []PermissionSnapshotInfo `json:"list"` // 权限快照列表 / Permission snapshot list
+}
+
+type PermissionSnapshotListResponse struct {
+	BaseDataInfo
+	Data PermissionSnapshotListInfo `json:"data"` // 权限快照列表 / Permission snapshot list
+}
+
+type PermissionSnapshotResponse struct {
+	BaseDataInfo
+	Data PermissionSnapshotInfo `json:"data"` // 权限快照 / Permission snapshot
+}
+
+type PermissionSuggestion struct {
+	Type      string  `json:"type"`               // 建议类型 / Suggestion type
+	RoleValue *string `json:"roleValue,optional"` // 角色值 / Role value
+	ApiId     *uint32 `json:"apiId,optional"`     // API ID / API ID
+	MenuId    *uint32 `json:"menuId,optional"`    // 菜单ID / Menu ID
+	MenuName  *string `json:"menuName,optional"`  // 菜单名称 / Menu name
+}
+
 type PositionInfo struct {
-	ID           *uint32 `json:"id,optional"`          // 岗位ID / Position ID
-	CreatedAt    *int64  `json:"createdAt,optional"`   // 创建时间 / Creation time
-	UpdatedAt    *int64  `json:"updatedAt,optional"`   // 更新时间 / Update time
-	PositionName string  `json:"positionName"`         // 岗位名称 / Position name
-	PositionCode string  `json:"positionCode"`         // 岗位编码 / Position code
-	SortOrder    int32   `json:"sortOrder"`            // 排序 / Sort
-	State        *bool   `json:"state,optional"`       // 状态 / State
-	Description  *string `json:"description,optional"` // 岗位描述 / Position description
+	ID               *uint32           `json:"id,optional"`               // 岗位ID / Position ID
+	CreatedAt        *int64            `json:"createdAt,optional"`        // 创建时间 / Creation time
+	UpdatedAt        *int64            `json:"updatedAt,optional"`        // 更新时间 / Update time
+	PositionName     string            `json:"positionName"`              // 岗位名称 / Position name
+	PositionNameI18n map[string]string `json:"positionNameI18n,optional"` // 岗位名称多语言，键为语言标签 / Position name translations keyed by locale
+	PositionCode     string            `json:"positionCode"`              // 岗位编码 / Position code
+	SortOrder        int32             `json:"sortOrder"`                 // 排序 / Sort
+	State            *bool             `json:"state,optional"`            // 状态 / State
+	Description      *string           `json:"description,optional"`      // 岗位描述 / Position description
+	RoleIds          []uint32          `json:"roleIds,optional"`          // 岗位授予的角色ID / Role IDs granted by the position
 }
 
 type PositionListInfo struct {
@@ -427,17 +1116,55 @@ type PositionListResponse struct {
 	Data []PositionListInfo `json:"data"` // 岗位列表 / Position list
 }
 
+type PositionRoleRequest struct {
+	PositionId uint32   `json:"positionId"` // 岗位ID / Position ID
+	RoleIds    []uint32 `json:"roleIds"`    // 角色ID / Role ID
+}
+
 type RegisterRequest struct {
 	CaptchaInfo
-	Password string `json:"password" validate:"required,min=6,max=16"` // 密码 / Password
+	Password string `json:"password" validate:"required,max=64"`       // 密码 / Password，规则由密码策略校验
 	Username string `json:"username" validate:"required,min=4,max=16"` // 用户名 / Username
 }
 
+type RevokeSessionsInfo struct {
+	RevokedCount int64 `json:"revokedCount"` // 已退出的会话数量 / Number of revoked sessions
+}
+
+type RevokeSessionsResponse struct {
+	BaseDataInfo
+	Data RevokeSessionsInfo `json:"data"` // 退出结果 / Revocation result
+}
+
+type RoleApiConsistency struct {
+	RoleId     uint32            `json:"roleId"`     // 角色ID / Role ID
+	RoleValue  string            `json:"roleValue"`  // 角色值 / Role value
+	RoleName   string            `json:"roleName"`   // 角色名称 / Role name
+	Mismatches []RoleApiMismatch `json:"mismatches"` // 不一致项 / Mismatches
+}
+
+type RoleApiConsistencyRequest struct {
+	RoleId *uint32 `json:"roleId,optional"` // 角色ID，为空时检查全部角色 / Role ID, all roles when empty
+}
+
+type RoleApiConsistencyResponse struct {
+	BaseDataInfo
+	Data []RoleApiConsistency `json:"data"` // 存在不一致项的角色 / Roles with mismatches
+}
+
 type RoleApiListResponse struct {
 	BaseDataInfo
 	Data []string `json:"data"` // API列表 / API list
 }
 
+type RoleApiMismatch struct {
+	Type    string   `json:"type"`             // 类型：missingApi 菜单依赖的API未授权，missingMenu API所属菜单未授权，unknownApi API不存在 / Mismatch type
+	Path    string   `json:"path"`             // API路径 / API path
+	Method  string   `json:"method"`           // 请求方法 / HTTP method
+	ApiId   *uint32  `json:"apiId,optional"`   // API ID / API ID
+	MenuIds []uint32 `json:"menuIds,optional"` // 相关菜单ID / Related menu IDs
+}
+
 type RoleApiRequest struct {
 	RoleId uint32   `json:"roleId"` // 角色ID / Role ID
 	ApiIds []uint32 `json:"apiIds"` // API ID / API ID
@@ -485,6 +1212,61 @@ type RoleMenuRequest struct {
 	MenuIds []uint32 `json:"menuIds"` // 菜单ID / Menu ID
 }
 
+type RolePermissionReport struct {
+	RoleId    uint32            `json:"roleId"`    // 角色ID / Role ID
+	RoleValue string            `json:"roleValue"` // 角色值 / Role value
+	RoleName  string            `json:"roleName"`  // 角色名称 / Role name
+	State     bool              `json:"state"`     // 角色状态 / Role state
+	Entries   []PermissionEntry `json:"entries"`   // 权限及持有用户 / Permissions and holders
+}
+
+type RolePermissionReportExportRequest struct {
+	ID     uint32 `json:"id" validate:"required,number,gt=0"`            // 角色ID / Role ID
+	Format string `json:"format,default=xlsx" validate:"oneof=csv xlsx"` // 文件格式 / File format (csv, xlsx)
+}
+
+type RolePermissionReportResponse struct {
+	BaseDataInfo
+	Data RolePermissionReport `json:"data"` // 角色权限报表 / Role permission report
+}
+
+type RoleSource struct {
+	Type string  `json:"type"`          // 来源类型：direct 直接分配，position 岗位，department 所在部门，inherited 上级部门继承 / Source type
+	Id   *uint32 `json:"id,optional"`   // 来源岗位或部门ID / Source position or department ID
+	Name *string `json:"name,optional"` // 来源岗位或部门名称 / Source position or department name
+}
+
+type SecurityEventInfo struct {
+	ID        *uint32 `json:"id,optional"`        // 事件ID / Event ID
+	CreatedAt *int64  `json:"createdAt,optional"` // 发生时间 / Occurred time
+	UserId    *string `json:"userId,optional"`    // 用户ID / User ID
+	Username  *string `json:"username,optional"`  // 用户名 / Username
+	EventType *string `json:"eventType,optional"` // 事件类型 / Event type (LOGIN, MFA_CHALLENGE, PASSWORD_CHANGE, MFA_ENABLE, MFA_DISABLE, TOKEN_REVOKE, OAUTH_BIND, OAUTH_UNBIND)
+	Success   *bool   `json:"success,optional"`   // 是否成功 / Whether successful
+	Provider  *string `json:"provider,optional"`  // 认证方式或第三方提供商 / Authentication method or provider
+	Reason    *string `json:"reason,optional"`    // 原因 / Reason
+	IpAddress *string `json:"ipAddress,optional"` // IP地址 / IP address
+	UserAgent *string `json:"userAgent,optional"` // 用户代理 / User agent
+}
+
+type SecurityEventListInfo struct {
+	BaseListInfo
+	CursorInfo
+	List []SecurityEventInfo `json:"list"` // 事件列表 / Event list
+}
+
+type SecurityEventListRequest struct {
+	MySecurityEventListRequest
+	UserId    *string `json:"userId,optional"`    // 用户ID / User ID
+	Username  *string `json:"username,optional"`  // 用户名 / Username
+	IpAddress *string `json:"ipAddress,optional"` // IP地址 / IP address
+}
+
+type SecurityEventListResponse struct {
+	BaseDataInfo
+	Data SecurityEventListInfo `json:"data"` // 事件列表 / Event list
+}
+
 type SendEmailCaptchaReq struct {
 	Email string `json:"email" validate:"required,email"` // 邮箱
 }
@@ -493,6 +1275,31 @@ type SendSmsCaptchaReq struct {
 	Phone string `json:"phone" validate:"required,numeric"` // 手机号
 }
 
+type SessionInfo struct {
+	Id         uint32  `json:"id"`                  // 会话ID / Session ID
+	CreatedAt  int64   `json:"createdAt"`           // 登录时间 / Sign-in time
+	LastUsedAt *int64  `json:"lastUsedAt,optional"` // 最后活跃时间 / Last active time
+	ExpiresAt  int64   `json:"expiresAt"`           // 过期时间 / Expiration time
+	DeviceInfo *string `json:"deviceInfo,optional"` // 设备信息 / Device information
+	IpAddress  *string `json:"ipAddress,optional"`  // IP地址 / IP address
+	UserAgent  *string `json:"userAgent,optional"`  // 用户代理 / User agent
+	Current    bool    `json:"current"`             // 是否当前会话 / Whether this is the current session
+}
+
+type SessionListInfo struct {
+	List []SessionInfo `json:"list"` // 会话列表 / Session list
+}
+
+type SessionListResponse struct {
+	BaseDataInfo
+	Data SessionListInfo `json:"data"` // 会话列表 / Session list
+}
+
+type SortOption struct {
+	Field string `json:"field" validate:"required,max=64"` // 字段名 / Field name
+	Desc  bool   `json:"desc,optional"`                    // 是否降序 / Descending
+}
+
 type StringIDRequest struct {
 	ID string `json:"id" validate:"required"`
 }
@@ -519,6 +1326,7 @@ type TokenInfo struct {
 
 type TokenInfoList struct {
 	BaseListInfo
+	CursorInfo
 	List []TokenInfo `json:"list"` // 令牌列表 / Token list
 }
 
@@ -572,29 +1380,88 @@ type UUIDRequest struct {
 	ID string `json:"id" validate:"required"`
 }
 
+type UUIDSRequest struct {
+	Ids []string `json:"ids" validate:"required,min=1,max=500,dive,uuid"` // ID列表 / IDs
+}
+
+type UpdateProfileRequest struct {
+	RealName *string `json:"realName,optional" validate:"omitempty,max=100"`     // 用户全名 / User full name
+	Avatar   *string `json:"avatar,optional" validate:"omitempty,max=255"`       // 头像URL / Avatar URL
+	Email    *string `json:"email,optional" validate:"omitempty,email,max=100"`  // 邮箱 / Email
+	Mobile   *string `json:"mobile,optional" validate:"omitempty,max=20"`        // 手机号 / Mobile
+	HomePath *string `json:"homePath,optional" validate:"omitempty,max=255"`     // 首页地址 / Home page address
+	Desc     *string `json:"desc,optional"`                                      // 用户描述 / User description
+	Language *string `json:"language,optional" validate:"omitempty,oneof=zh en"` // 偏好语言，为空时按请求头协商 / Preferred language
+}
+
+type UserExportRequest struct {
+	Username string `json:"username,optional"`                             // 用户名 / Username
+	Email    string `json:"email,optional"`                                // 邮箱 / Email
+	Mobile   string `json:"mobile,optional"`                               // 手机号 / Mobile
+	Format   string `json:"format,default=xlsx" validate:"oneof=csv xlsx"` // 文件格式 / File format (csv, xlsx)
+}
+
+type UserImportRequest struct {
+	DryRun    bool   `form:"dryRun,optional"`                                  // 仅校验不写入 / Validate only
+	BatchSize uint32 `form:"batchSize,optional" validate:"omitempty,max=1000"` // 每批事务写入行数 / Rows per transaction batch
+}
+
+type UserImportResponse struct {
+	BaseDataInfo
+	Data UserImportResult `json:"data"` // 导入结果 / Import result
+}
+
+type UserImportResult struct {
+	Total   uint32                `json:"total"`   // 总行数 / Total rows
+	Success uint32                `json:"success"` // 成功行数 / Succeeded rows
+	Failed  uint32                `json:"failed"`  // 失败行数 / Failed rows
+	DryRun  bool                  `json:"dryRun"`  // 是否仅校验 / Whether dry run
+	Results []UserImportRowResult `json:"results"` // 逐行结果 / Per-row results
+}
+
+type UserImportRowError struct {
+	Field   string `json:"field"`          // 出错的列 / Column
+	Message string `json:"message"`        // 错误信息 / Error message
+	Value   string `json:"value,optional"` // 出错的值 / Value
+}
+
+type UserImportRowResult struct {
+	RowNumber       uint32               `json:"rowNumber"`                // 行号 / Row number
+	Username        string               `json:"username"`                 // 用户名 / Username
+	Success         bool                 `json:"success"`                  // 是否成功 / Whether succeeded
+	Errors          []UserImportRowError `json:"errors"`                   // 错误列表 / Errors
+	InitialPassword string               `json:"initialPassword,optional"` // 初始密码，首次登录须修改 / Initial password, must be changed at first login
+}
+
 type UserInfo struct {
-	Avatar         string    `json:"avatar,optional"`         // 头像URL / Avatar URL
-	RealName       string    `json:"realName,optional"`       // 用户全名 / User full name
-	Roles          []string  `json:"roles,optional"`          // 用户角色 / User roles
-	UserId         string    `json:"userId,optional"`         // 用户ID / User ID
-	Username       string    `json:"username,optional"`       // 用户名 / Username
-	Desc           string    `json:"desc,optional"`           // 用户描述 / User description
-	HomePath       string    `json:"homePath,optional"`       // 首页地址 / Home page address
-	Email          string    `json:"email,optional"`          // 邮箱 / Email
-	RoleNames      []string  `json:"roleNames,optional"`      // 用户角色名称 / User role names
-	DepartmentName string    `json:"departmentName,optional"` // 用户部门名称 / User department name
-	Mobile         string    `json:"mobile,optional"`         // 手机号 / Mobile
-	DepartmentId   uint32    `json:"departmentId,optional"`   // 用户部门ID / User department ID
-	PositionNames  []string  `json:"positionNames,optional"`  // 用户职位名称 / User position names
-	PositionIds    []uint32  `json:"positionIds,optional"`    // 用户职位ID / User position ID
-	State          bool      `json:"state,optional"`          // 用户状态 / User state
-	CreatedAt      int64     `json:"createdAt,optional"`      // 创建时间 / Create time
-	UpdatedAt      int64     `json:"updatedAt,optional"`      // 更新时间 / Update time
-	LastLoginAt    int64     `json:"lastLoginAt,optional"`    // 最后登录时间 / Last login time
-	LastLoginIp    string    `json:"lastLoginIp,optional"`    // 最后登录IP / Last login IP
-	RoleIds        []uint32  `json:"roleIds,optional"`        // 用户角色ID / User role ID
-	Password       *string   `json:"password,optional"`       // 密码 / Password
-	TotpInfo       *TotpInfo `json:"totpInfo,optional"`       // TOTP信息 / TOTP information
+	Avatar             string    `json:"avatar,optional"`                                    // 头像URL / Avatar URL
+	RealName           string    `json:"realName,optional"`                                  // 用户全名 / User full name
+	Roles              []string  `json:"roles,optional"`                                     // 用户角色 / User roles
+	UserId             string    `json:"userId,optional"`                                    // 用户ID / User ID
+	Username           string    `json:"username,optional"`                                  // 用户名 / Username
+	Desc               string    `json:"desc,optional"`                                      // 用户描述 / User description
+	HomePath           string    `json:"homePath,optional"`                                  // 首页地址 / Home page address
+	Email              string    `json:"email,optional"`                                     // 邮箱 / Email
+	RoleNames          []string  `json:"roleNames,optional"`                                 // 用户角色名称 / User role names
+	DepartmentName     string    `json:"departmentName,optional"`                            // 用户部门名称 / User department name
+	Mobile             string    `json:"mobile,optional"`                                    // 手机号 / Mobile
+	DepartmentId       uint32    `json:"departmentId,optional"`                              // 用户部门ID / User department ID
+	PositionNames      []string  `json:"positionNames,optional"`                             // 用户职位名称 / User position names
+	PositionIds        []uint32  `json:"positionIds,optional"`                               // 用户职位ID / User position ID
+	State              bool      `json:"state,optional"`                                     // 用户状态 / User state
+	CreatedAt          int64     `json:"createdAt,optional"`                                 // 创建时间 / Create time
+	UpdatedAt          int64     `json:"updatedAt,optional"`                                 // 更新时间 / Update time
+	LastLoginAt        int64     `json:"lastLoginAt,optional"`                               // 最后登录时间 / Last login time
+	LastLoginIp        string    `json:"lastLoginIp,optional"`                               // 最后登录IP / Last login IP
+	RoleIds            []uint32  `json:"roleIds,optional"`                                   // 用户角色ID / User role ID
+	Password           *string   `json:"password,optional"`                                  // 密码 / Password
+	TotpInfo           *TotpInfo `json:"totpInfo,optional"`                                  // TOTP信息 / TOTP information
+	WebauthnCount      uint32    `json:"webauthnCount,optional"`                             // 已启用的WebAuthn凭证数量 / Enabled WebAuthn credential count
+	MustChangePassword *bool     `json:"mustChangePassword,optional"`                        // 下次登录须修改密码 / Must change password at next login
+	PasswordChangedAt  int64     `json:"passwordChangedAt,optional"`                         // 密码修改时间 / Password changed time
+	PasswordExpired    bool      `json:"passwordExpired,optional"`                           // 密码是否已过期 / Whether password expired
+	Language           *string   `json:"language,optional" validate:"omitempty,oneof=zh en"` // 偏好语言，为空时按请求头协商 / Preferred language
+	ImpersonatorId     string    `json:"impersonatorId,optional"`                            // 模拟登录的管理员ID，非模拟登录时为空 / Impersonator ID
 }
 
 type UserInfoResponse struct {
@@ -609,12 +1476,13 @@ type UserListInfo struct {
 
 type UserListRequest struct {
 	PageRequest
-	Username     string `json:"username,optional"`     // 用户名 / Username
-	Email        string `json:"email,optional"`        // 邮箱 / Email
-	Mobile       string `json:"mobile,optional"`       // 手机号 / Mobile
-	State        bool   `json:"state,optional"`        // 用户状态 / User state
-	DepartmentId uint32 `json:"departmentId,optional"` // 用户部门ID / User department ID
-	UserID       string `json:"userId,optional"`       // 用户ID / User ID
+	Username     string   `json:"username,optional"`     // 用户名 / Username
+	Email        string   `json:"email,optional"`        // 邮箱 / Email
+	Mobile       string   `json:"mobile,optional"`       // 手机号 / Mobile
+	State        bool     `json:"state,optional"`        // 用户状态 / User state
+	DepartmentId uint32   `json:"departmentId,optional"` // 用户部门ID / User department ID
+	UserID       string   `json:"userId,optional"`       // 用户ID / User ID
+	RoleIds      []uint32 `json:"roleIds,optional"`      // 角色ID，拥有任一角色即匹配 / Role IDs, matches users having any of them
 }
 
 type UserListResponse struct {
@@ -628,6 +1496,55 @@ type VbenPreference struct {
 }
 
 type VerifyTotpRequest struct {
-	UserId string `json:"userId"` // 用户ID / User ID
-	Code   string `json:"code"`   // 验证码 / Verification code
+	Code string `json:"code" validate:"required,len=6"` // 验证码 / Verification code
+}
+
+type WebauthnBeginInfo struct {
+	SessionId string                 `json:"sessionId"` // 会话ID / Session ID
+	Options   map[string]interface{} `json:"options"`   // 凭证选项 / Credential options
+}
+
+type WebauthnBeginResponse struct {
+	BaseDataInfo
+	Data WebauthnBeginInfo `json:"data"` // WebAuthn仪式信息 / WebAuthn ceremony information
+}
+
+type WebauthnCredentialInfo struct {
+	Id             uint32   `json:"id"`             // 凭证ID / Credential ID
+	CreatedAt      int64    `json:"createdAt"`      // 创建时间 / Creation time
+	FriendlyName   string   `json:"friendlyName"`   // 凭证名称 / Friendly name
+	SignCount      uint32   `json:"signCount"`      // 签名计数器 / Signature counter
+	Aaguid         string   `json:"aaguid"`         // 认证器型号标识 / Authenticator AAGUID
+	Transports     []string `json:"transports"`     // 传输方式 / Transports
+	Attachment     string   `json:"attachment"`     // 认证器连接方式 / Authenticator attachment
+	BackupEligible bool     `json:"backupEligible"` // 是否可备份 / Whether backup eligible
+	BackupState    bool     `json:"backupState"`    // 是否已备份 / Whether backed up
+	CloneWarning   bool     `json:"cloneWarning"`   // 克隆告警 / Clone warning
+	LastUsedAt     int64    `json:"lastUsedAt"`     // 最后使用时间 / Last used time
+	State          bool     `json:"state"`          // 状态 / State
+}
+
+type WebauthnCredentialListResponse struct {
+	BaseDataInfo
+	Data []WebauthnCredentialInfo `json:"data"` // 凭证列表 / Credential list
+}
+
+type WebauthnLoginBeginRequest struct {
+	Username string `json:"username,optional"` // 用户名，为空时使用Passkey登录 / Username, empty for passkey login
+}
+
+type WebauthnLoginFinishRequest struct {
+	SessionId  string                 `json:"sessionId" validate:"required"`  // 会话ID / Session ID
+	Credential map[string]interface{} `json:"credential" validate:"required"` // WebAuthn断言 / WebAuthn assertion
+}
+
+type WebauthnRegisterFinishRequest struct {
+	SessionId    string                 `json:"sessionId" validate:"required"`                      // 会话ID / Session ID
+	Credential   map[string]interface{} `json:"credential" validate:"required"`                     // 浏览器返回的凭证 / Credential from browser
+	FriendlyName string                 `json:"friendlyName,optional" validate:"omitempty,max=100"` // 凭证名称 / Friendly name
+}
+
+type WebauthnUpdateCredentialRequest struct {
+	Id           uint32 `json:"id" validate:"required,gt=0"`              // 凭证ID / Credential ID
+	FriendlyName string `json:"friendlyName" validate:"required,max=100"` // 凭证名称 / Friendly name
 }
@@ -0,0 +1,37 @@
+package batchutils
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// Response 转换 RPC 批量操作结果，并翻译逐项失败原因
+func Response(ctx context.Context, trans *last_i18n.Translator, result *core.BatchResponse) *types.BatchResponse {
+	data := types.BatchResult{
+		Total:   result.Total,
+		Success: result.Success,
+		Failed:  result.Failed,
+		Results: make([]types.BatchItemResult, 0, len(result.Results)),
+	}
+	for _, item := range result.Results {
+		r := types.BatchItemResult{
+			ID:      item.Id,
+			Success: item.Success,
+		}
+		if item.Message != nil {
+			r.Message = trans.Trans(ctx, *item.Message)
+		}
+		data.Results = append(data.Results, r)
+	}
+
+	return &types.BatchResponse{
+		BaseDataInfo: types.BaseDataInfo{
+			Code:    0,
+			Message: trans.Trans(ctx, "common.batchSuccess"),
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,163 @@
+package filetransfer
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/fileservice"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// AvatarPath 头像访问路径前缀
+	AvatarPath = "/file/avatar/"
+
+	// sniffLen 识别文件类型读取的字节数
+	sniffLen = 512
+	// chunkSize 上传时每条消息携带的字节数
+	chunkSize = 64 << 10
+)
+
+// DetectType 根据文件内容识别类型，返回的 Reader 仍包含已读取的内容
+func DetectType(r io.Reader) (string, io.Reader, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		mimeType = "application/octet-stream"
+	}
+	return mimeType, io.MultiReader(bytes.NewReader(head), r), nil
+}
+
+// Allowed 判断文件类型是否允许上传，以 / 结尾的规则按前缀匹配，规则为空时不限制
+func Allowed(mimeType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, v := range allowed {
+		if v == mimeType || (strings.HasSuffix(v, "/") && strings.HasPrefix(mimeType, v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Upload 将 r 的内容分块上传到文件服务，超过 maxSize 时由文件服务拒绝
+func Upload(ctx context.Context, rpc fileservice.FileService, r io.Reader, info *fileservice.FileInfo, maxSize int64) (*fileservice.FileInfo, error) {
+	stream, err := rpc.UploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &fileservice.FileUploadRequest{Info: info, MaxSize: maxSize}
+	for {
+		buf := make([]byte, chunkSize)
+		n, err := io.ReadFull(r, buf)
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			_ = stream.CloseSend()
+			return nil, err
+		}
+		req.Chunk = buf[:n]
+		if n > 0 || req.Info != nil {
+			// 服务端提前结束时返回 io.EOF，具体错误由 CloseAndRecv 获取
+			if err := stream.Send(req); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, err
+			}
+		}
+		if eof {
+			break
+		}
+		req = &fileservice.FileUploadRequest{}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// Serve 将文件内容写入响应，disposition 为 attachment 或 inline。
+// 收到第一块内容后再写入响应头，之前的错误返回给调用方，之后的错误只记录日志
+func Serve(ctx context.Context, w http.ResponseWriter, rpc fileservice.FileService, info *fileservice.FileInfo, disposition string) error {
+	stream, err := rpc.DownloadFile(ctx, &fileservice.UUIDRequest{Id: pointer.GetString(info.Id)})
+	if err != nil {
+		return err
+	}
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	w.Header().Set("Content-Type", pointer.GetString(info.MimeType))
+	w.Header().Set("Content-Length", strconv.FormatInt(pointer.GetInt64(info.Size), 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": pointer.GetString(info.Name)}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	for err == nil {
+		if _, err = w.Write(chunk.Data); err != nil {
+			break
+		}
+		chunk, err = stream.Recv()
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		logx.WithContext(ctx).Errorw("文件下载中断", logx.Field("id", pointer.GetString(info.Id)), logx.Field("detail", err.Error()))
+	}
+	return nil
+}
+
+// Sign 生成临时下载链接签名
+func Sign(secret, id string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + "." + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 校验临时下载链接的签名及有效期
+func Verify(secret, id string, expires int64, signature string, now time.Time) bool {
+	if now.Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, id, expires)), []byte(signature))
+}
+
+// SignedURL 生成临时下载链接
+func SignedURL(baseURL, secret, id string, expires int64) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", Sign(secret, id, expires))
+	return strings.TrimSuffix(baseURL, "/") + "/file/signed/" + id + "?" + query.Encode()
+}
+
+// AvatarURL 生成头像访问链接
+func AvatarURL(baseURL, id string) string {
+	return strings.TrimSuffix(baseURL, "/") + AvatarPath + id
+}
+
+// AvatarID 从头像链接中解析文件ID，非本服务上传的头像返回 false
+func AvatarID(avatarURL string) (string, bool) {
+	i := strings.LastIndex(avatarURL, AvatarPath)
+	if i < 0 {
+		return "", false
+	}
+	id := avatarURL[i+len(AvatarPath):]
+	return id, id != ""
+}
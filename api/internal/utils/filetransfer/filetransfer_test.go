@@ -0,0 +1,100 @@
+package filetransfer
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectType(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("x", 1000)
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "png", content: png, want: "image/png"},
+		{name: "text", content: "hello", want: "text/plain"},
+		{name: "pdf", content: "%PDF-1.7", want: "application/pdf"},
+		{name: "empty", content: "", want: "text/plain"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, r, err := DetectType(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("DetectType() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DetectType() = %q, want %q", got, tt.want)
+			}
+			// 识别类型后内容必须完整
+			data, _ := io.ReadAll(r)
+			if string(data) != tt.content {
+				t.Errorf("DetectType() content length = %d, want %d", len(data), len(tt.content))
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	allowed := []string{"image/", "application/pdf"}
+	tests := []struct {
+		mimeType string
+		want     bool
+	}{
+		{"image/png", true},
+		{"application/pdf", true},
+		{"application/pdfx", false},
+		{"text/plain", false},
+	}
+	for _, tt := range tests {
+		if got := Allowed(tt.mimeType, allowed); got != tt.want {
+			t.Errorf("Allowed(%q) = %v, want %v", tt.mimeType, got, tt.want)
+		}
+	}
+	if !Allowed("text/plain", nil) {
+		t.Error("Allowed() with empty rules should allow all types")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	expires := now.Add(time.Minute).Unix()
+	sig := Sign("secret", "file-1", expires)
+
+	if !Verify("secret", "file-1", expires, sig, now) {
+		t.Error("Verify() valid signature = false")
+	}
+	if Verify("secret", "file-2", expires, sig, now) {
+		t.Error("Verify() signature for another file = true")
+	}
+	if Verify("other", "file-1", expires, sig, now) {
+		t.Error("Verify() signature with another secret = true")
+	}
+	if Verify("secret", "file-1", expires+1, sig, now) {
+		t.Error("Verify() tampered expiry = true")
+	}
+	if Verify("secret", "file-1", expires, sig, now.Add(2*time.Minute)) {
+		t.Error("Verify() expired link = true")
+	}
+}
+
+func TestAvatarID(t *testing.T) {
+	tests := []struct {
+		url    string
+		want   string
+		wantOk bool
+	}{
+		{"/file/avatar/abc", "abc", true},
+		{"https://admin.example.com/file/avatar/abc", "abc", true},
+		{"https://cdn.example.com/a.png", "", false},
+		{"/file/avatar/", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := AvatarID(tt.url)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("AvatarID(%q) = %q, %v, want %q, %v", tt.url, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
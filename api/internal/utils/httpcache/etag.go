@@ -0,0 +1,51 @@
+package httpcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// OkJsonWithETag 按响应内容生成 ETag，客户端 If-None-Match 命中时返回 304
+func OkJsonWithETag(ctx context.Context, w http.ResponseWriter, r *http.Request, resp any) {
+	body, err := json.Marshal(resp)
+	if err != nil {
+		httpx.OkJsonCtx(ctx, w, resp)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	// 允许缓存但每次使用前需重新验证，内容随请求语言变化
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Add("Vary", "Accept-Language")
+
+	if Match(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// Match 判断 If-None-Match 是否包含指定 ETag，忽略弱校验前缀
+func Match(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
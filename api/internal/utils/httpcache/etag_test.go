@@ -0,0 +1,34 @@
+package httpcache
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOkJsonWithETag(t *testing.T) {
+	resp := map[string]string{"code": "gender"}
+
+	w := httptest.NewRecorder()
+	OkJsonWithETag(context.Background(), w, httptest.NewRequest(http.MethodGet, "/", nil), resp)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.Len() == 0 {
+		t.Fatalf("unexpected first response: %d %q", w.Code, etag)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("If-None-Match", `"other", W/`+etag)
+	w = httptest.NewRecorder()
+	OkJsonWithETag(context.Background(), w, r, resp)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Fatalf("expected 304, got %d", w.Code)
+	}
+
+	r.Header.Set("If-None-Match", `"other"`)
+	w = httptest.NewRecorder()
+	OkJsonWithETag(context.Background(), w, r, resp)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+}
@@ -0,0 +1,62 @@
+package jwtutils
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+const (
+	// ImpersonatorIdKey 模拟登录令牌中记录发起模拟的管理员ID的声明
+	ImpersonatorIdKey = "impersonatorId"
+	// ApiKeyIdKey API密钥换取的内部令牌中记录密钥ID的声明
+	ApiKeyIdKey = "apiKeyId"
+)
+
+// GenerateToken 为用户签发访问令牌，extra 中的声明会附加到令牌中
+// 角色优先使用有效角色，未提供时使用直接分配的角色
+func GenerateToken(user *userservice.UserInfo, expire int64, secret string, extra map[string]any) (string, error) {
+	claims := make(jwt.MapClaims)
+	iat := time.Now().Unix()
+	claims["iat"] = iat
+	claims["exp"] = iat + expire
+	claims["userId"] = pointer.GetString(user.Id)
+	claims["deptId"] = pointer.GetUint32(user.DepartmentId)
+	roles := user.EffectiveRoleValues
+	if len(roles) == 0 {
+		roles = user.RoleValues
+	}
+	claims["roleId"] = strings.Join(roles, ",")
+	claims["providerId"] = pointer.GetUint32(user.ProviderId)
+	for key, value := range extra {
+		claims[key] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	accessToken, err := token.SignedString([]byte(secret))
+	if err != nil {
+		return "", errorx.NewInternalError("token.generateTokenFailed")
+	}
+	return accessToken, nil
+}
+
+// GetImpersonatorId 获取模拟登录的管理员ID，非模拟登录时返回空
+func GetImpersonatorId(ctx context.Context) string {
+	impersonatorId, _ := ctx.Value(ImpersonatorIdKey).(string)
+	return impersonatorId
+}
+
+// IsApiKeySession 判断当前请求是否通过API密钥认证
+func IsApiKeySession(ctx context.Context) bool {
+	return ctx.Value(ApiKeyIdKey) != nil
+}
+
+// GetToken 获取请求头中的 Bearer 令牌
+func GetToken(authorization string) string {
+	return strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+}
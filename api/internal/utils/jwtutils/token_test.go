@@ -0,0 +1,66 @@
+package jwtutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/userservice"
+)
+
+func TestGenerateToken(t *testing.T) {
+	user := &userservice.UserInfo{
+		Id:         pointer.ToStringPtr("u1"),
+		RoleValues: []string{"admin", "ops"},
+	}
+	token, err := GenerateToken(user, 60, "secret", map[string]any{ImpersonatorIdKey: "u0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return []byte("secret"), nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if claims["userId"] != "u1" || claims["roleId"] != "admin,ops" || claims[ImpersonatorIdKey] != "u0" {
+		t.Fatalf("unexpected claims: %v", claims)
+	}
+}
+
+func TestGenerateTokenEffectiveRoles(t *testing.T) {
+	user := &userservice.UserInfo{
+		Id:                  pointer.ToStringPtr("u1"),
+		RoleValues:          []string{"ops"},
+		EffectiveRoleValues: []string{"ops", "auditor"},
+	}
+	token, err := GenerateToken(user, 60, "secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return []byte("secret"), nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if claims["roleId"] != "ops,auditor" {
+		t.Fatalf("unexpected roles: %v", claims["roleId"])
+	}
+}
+
+func TestGetImpersonatorId(t *testing.T) {
+	if id := GetImpersonatorId(context.Background()); id != "" {
+		t.Fatalf("expected empty impersonator, got %q", id)
+	}
+	ctx := context.WithValue(context.Background(), ImpersonatorIdKey, "u0")
+	if id := GetImpersonatorId(ctx); id != "u0" {
+		t.Fatalf("got %q, want u0", id)
+	}
+	if token := GetToken("Bearer abc"); token != "abc" {
+		t.Fatalf("got %q, want abc", token)
+	}
+}
@@ -0,0 +1,39 @@
+package pageutils
+
+import (
+	"github.com/wenpiner/last-admin-core/api/internal/types"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// Request 将接口分页参数转换为 RPC 分页请求，附带通用筛选与排序条件
+func Request(page types.Page) *core.BasePageRequest {
+	in := &core.BasePageRequest{
+		PageNumber:  page.CurrentPage,
+		PageSize:    page.PageSize,
+		Cursor:      page.Cursor,
+		WithSkipped: page.WithSkipped,
+	}
+	for _, f := range page.Filters {
+		in.Filters = append(in.Filters, &core.FilterCondition{
+			Field:  f.Field,
+			Op:     f.Op,
+			Values: f.Values,
+		})
+	}
+	for _, s := range page.Sorts {
+		in.Sorts = append(in.Sorts, &core.SortOption{
+			Field: s.Field,
+			Desc:  s.Desc,
+		})
+	}
+	return in
+}
+
+// CursorInfo 提取 RPC 分页结果中的游标分页信息
+func CursorInfo(page *core.BasePageResp) types.CursorInfo {
+	return types.CursorInfo{
+		NextCursor: page.GetNextCursor(),
+		HasNext:    page.GetHasNext(),
+		Skipped:    page.GetSkipped(),
+	}
+}
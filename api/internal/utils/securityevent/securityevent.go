@@ -0,0 +1,68 @@
+package securityevent
+
+import (
+	"context"
+	"net/http"
+
+	lastHttp "github.com/wenpiner/last-admin-common/utils/http"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/securityeventservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+// 安全事件类型
+const (
+	TypeLogin          = "LOGIN"
+	TypeMfaChallenge   = "MFA_CHALLENGE"
+	TypePasswordChange = "PASSWORD_CHANGE"
+	TypeMfaEnable      = "MFA_ENABLE"
+	TypeMfaDisable     = "MFA_DISABLE"
+	TypeTokenRevoke    = "TOKEN_REVOKE"
+	TypeOauthBind      = "OAUTH_BIND"
+	TypeOauthUnbind    = "OAUTH_UNBIND"
+)
+
+// 认证方式
+const (
+	ProviderPassword = "password"
+	ProviderTotp     = "totp"
+	ProviderWebauthn = "webauthn"
+	ProviderOauth    = "oauth"
+	ProviderOauth2   = "oauth2"
+)
+
+// Event 待记录的安全事件，UserID 为空时按 Username 记录
+type Event struct {
+	Type     string
+	UserID   string
+	Username string
+	Success  bool
+	Provider string
+	Reason   string
+}
+
+// Record 记录安全事件，IP及用户代理取自请求。记录失败只输出日志，不影响业务流程
+func Record(ctx context.Context, r *http.Request, rpc securityeventservice.SecurityEventService, event Event) {
+	_, err := rpc.CreateSecurityEvent(ctx, &securityeventservice.SecurityEventInfo{
+		UserId:    pointer.ToStringPtrIfNotEmpty(event.UserID),
+		Username:  pointer.ToStringPtrIfNotEmpty(event.Username),
+		EventType: pointer.ToStringPtr(event.Type),
+		Success:   pointer.ToBoolPtr(event.Success),
+		Provider:  pointer.ToStringPtrIfNotEmpty(event.Provider),
+		Reason:    pointer.ToStringPtrIfNotEmpty(event.Reason),
+		IpAddress: pointer.ToStringPtrIfNotEmpty(lastHttp.GetIP(r)),
+		UserAgent: pointer.ToStringPtrIfNotEmpty(r.UserAgent()),
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorw("记录安全事件失败", logx.Field("type", event.Type), logx.Field("detail", err.Error()))
+	}
+}
+
+// Reason 提取错误信息作为失败原因，RPC错误取其消息，通常为国际化键
+func Reason(err error) string {
+	if s, ok := status.FromError(err); ok {
+		return s.Message()
+	}
+	return err.Error()
+}
@@ -0,0 +1,142 @@
+package authz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/wenpiner/last-admin-common/config"
+	"github.com/wenpiner/last-admin-common/ctx/rolectx"
+	"github.com/wenpiner/last-admin-common/ctx/userctx"
+	last_casbin "github.com/wenpiner/last-admin-common/plugins/casbin"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/client/authzservice"
+
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// defaultCacheExpire 默认本地缓存有效期，用户角色、岗位及部门变更最迟在此时间后生效
+	defaultCacheExpire = 30 * time.Second
+	// defaultCacheLimit 默认本地缓存最大条目数
+	defaultCacheLimit = 10000
+	// apiKeyIdKey API密钥会话令牌中记录密钥ID的声明，此类会话按令牌中的授权角色鉴权
+	apiKeyIdKey = "apiKeyId"
+)
+
+type (
+	// Checker 通过核心服务的 AuthzService 鉴权，结果缓存在本地，策略变更时失效
+	Checker struct {
+		client     authzservice.AuthzService
+		cache      *collection.Cache
+		generation atomic.Uint64
+	}
+
+	// Option 鉴权客户端选项
+	Option func(*options)
+
+	options struct {
+		expire time.Duration
+		limit  int
+	}
+)
+
+// WithCacheExpire 设置本地缓存有效期
+func WithCacheExpire(expire time.Duration) Option {
+	return func(o *options) {
+		o.expire = expire
+	}
+}
+
+// WithCacheLimit 设置本地缓存最大条目数
+func WithCacheLimit(limit int) Option {
+	return func(o *options) {
+		o.limit = limit
+	}
+}
+
+// NewChecker 创建鉴权客户端
+func NewChecker(client authzservice.AuthzService, opts ...Option) *Checker {
+	o := options{expire: defaultCacheExpire, limit: defaultCacheLimit}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cache, err := collection.NewCache(o.expire, collection.WithLimit(o.limit), collection.WithName("authz"))
+	logx.Must(err)
+	return &Checker{client: client, cache: cache}
+}
+
+// MustWatchPolicy 订阅核心服务的 Casbin 策略变更通知，收到通知后清空本地缓存
+func (c *Checker) MustWatchPolicy(conf config.RedisConfig) persist.Watcher {
+	return last_casbin.CasbinConf{}.MustNewRedisWatcher(conf, func(string) {
+		c.Invalidate()
+	})
+}
+
+// Invalidate 使本地缓存的全部鉴权结果失效
+func (c *Checker) Invalidate() {
+	c.generation.Add(1)
+}
+
+// Client 获取 AuthzService 客户端，用于批量检查等未缓存的调用
+func (c *Checker) Client() authzservice.AuthzService {
+	return c.client
+}
+
+// Check 检查主体是否有权访问资源，优先使用本地缓存
+func (c *Checker) Check(ctx context.Context, subject *authzservice.AuthzSubject, domain, object, action string) (bool, error) {
+	key := strings.Join([]string{strconv.FormatUint(c.generation.Load(), 10), subjectKey(subject), domain, action, object}, "|")
+	allowed, err := c.cache.Take(key, func() (any, error) {
+		resp, err := c.client.Check(ctx, &authzservice.AuthzCheckRequest{
+			Subject:  subject,
+			Resource: &authzservice.AuthzResource{Domain: domain, Object: object, Action: action},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Allowed, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return allowed.(bool), nil
+}
+
+// SubjectFromContext 从上下文中获取鉴权主体
+// 登录用户按其当前有效角色鉴权，API密钥会话及无用户的调用使用令牌中的角色
+func SubjectFromContext(ctx context.Context) (*authzservice.AuthzSubject, bool) {
+	roles, hasRoles := rolectx.GetRoleFromContext(ctx)
+	if !isApiKeySession(ctx) {
+		if userId, ok := userctx.GetUserIDFromContext(ctx); ok && userId != "" {
+			return &authzservice.AuthzSubject{UserId: pointer.ToStringPtr(userId)}, true
+		}
+	}
+	if !hasRoles {
+		return nil, false
+	}
+	return &authzservice.AuthzSubject{RoleValues: roles}, true
+}
+
+func isApiKeySession(ctx context.Context) bool {
+	if ctx.Value(apiKeyIdKey) != nil {
+		return true
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(apiKeyIdKey)) > 0
+}
+
+func subjectKey(subject *authzservice.AuthzSubject) string {
+	if userId := pointer.GetString(subject.UserId); userId != "" {
+		return "u:" + userId
+	}
+	roles := append([]string(nil), subject.RoleValues...)
+	sort.Strings(roles)
+	return "r:" + strings.Join(roles, ",")
+}
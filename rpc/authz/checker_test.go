@@ -0,0 +1,60 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-core/rpc/client/authzservice"
+	"google.golang.org/grpc"
+)
+
+type fakeAuthzService struct {
+	authzservice.AuthzService
+	calls int
+}
+
+func (f *fakeAuthzService) Check(_ context.Context, in *authzservice.AuthzCheckRequest, _ ...grpc.CallOption) (*authzservice.AuthzCheckResponse, error) {
+	f.calls++
+	return &authzservice.AuthzCheckResponse{Allowed: in.Resource.Action == "GET"}, nil
+}
+
+func TestCheckerCache(t *testing.T) {
+	client := &fakeAuthzService{}
+	checker := NewChecker(client)
+	ctx := context.Background()
+
+	for _, roles := range [][]string{{"a", "b"}, {"b", "a"}} {
+		allowed, err := checker.Check(ctx, &authzservice.AuthzSubject{RoleValues: roles}, ApiDomain, "/order/list", "GET")
+		if err != nil || !allowed {
+			t.Fatalf("got %v %v", allowed, err)
+		}
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected cached result, got %d calls", client.calls)
+	}
+
+	checker.Invalidate()
+	if _, err := checker.Check(ctx, &authzservice.AuthzSubject{RoleValues: []string{"a", "b"}}, ApiDomain, "/order/list", "GET"); err != nil {
+		t.Fatal(err)
+	}
+	if client.calls != 2 {
+		t.Fatalf("expected invalidated cache, got %d calls", client.calls)
+	}
+}
+
+func TestSubjectFromContext(t *testing.T) {
+	ctx := context.WithValue(context.WithValue(context.Background(), "userId", "u1"), "roleId", "admin")
+	subject, ok := SubjectFromContext(ctx)
+	if !ok || subject.GetUserId() != "u1" {
+		t.Fatalf("expected user subject, got %v", subject)
+	}
+
+	subject, ok = SubjectFromContext(context.WithValue(ctx, apiKeyIdKey, "k1"))
+	if !ok || subject.UserId != nil || len(subject.RoleValues) != 1 || subject.RoleValues[0] != "admin" {
+		t.Fatalf("expected scoped roles for api key session, got %v", subject)
+	}
+
+	if _, ok = SubjectFromContext(context.Background()); ok {
+		t.Fatal("expected no subject")
+	}
+}
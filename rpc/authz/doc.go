@@ -0,0 +1,7 @@
+// Package authz 为基于本项目构建的下游服务提供鉴权中间件及拦截器，通过核心服务的 AuthzService 按同一套角色及策略鉴权
+//
+// REST 中间件需在 go-zero JWT 认证之后使用，鉴权主体取自已验证的令牌声明。
+// gRPC 拦截器必须声明调用方的认证方式：WithJwtSecret 校验元数据中的访问令牌并以令牌声明作为鉴权主体；
+// WithTrustedGateway 直接信任元数据中的 userId、roleId，仅可在调用全部经过已认证的可信网关且服务端口不对外暴露时使用，
+// 否则任何调用方都可伪造元数据冒充其他用户。两者均未配置时创建拦截器会直接失败。
+package authz
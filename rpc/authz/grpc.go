@@ -0,0 +1,164 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/wenpiner/last-admin-core/rpc/client/authzservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RpcMethod gRPC 方法鉴权使用的请求方法，方法以 /package.Service/Method 为路径、GRPC 为请求方法注册为API后即可在角色中授权
+	RpcMethod = "GRPC"
+	// authorizationKey 调用方传递访问令牌的元数据键
+	authorizationKey = "authorization"
+)
+
+type (
+	// GrpcOption gRPC 鉴权拦截器选项
+	GrpcOption func(*grpcOptions)
+
+	grpcOptions struct {
+		secret         string
+		trustedGateway bool
+		skip           map[string]struct{}
+	}
+)
+
+// WithJwtSecret 使用访问令牌认证调用方，令牌由元数据 authorization 以 Bearer 方式传递，secret 与签发令牌的 API 服务一致
+func WithJwtSecret(secret string) GrpcOption {
+	return func(o *grpcOptions) {
+		o.secret = secret
+	}
+}
+
+// WithTrustedGateway 信任元数据中的 userId、roleId，仅可在调用全部经过已认证的可信网关时使用
+func WithTrustedGateway() GrpcOption {
+	return func(o *grpcOptions) {
+		o.trustedGateway = true
+	}
+}
+
+// WithSkipMethods 设置无需鉴权的完整方法名
+func WithSkipMethods(methods ...string) GrpcOption {
+	return func(o *grpcOptions) {
+		for _, m := range methods {
+			o.skip[m] = struct{}{}
+		}
+	}
+}
+
+// UnaryServerInterceptor 创建 gRPC 一元调用鉴权拦截器，按完整方法名在指定域下鉴权
+// 必须通过 WithJwtSecret 或 WithTrustedGateway 声明调用方的认证方式，否则直接失败
+func UnaryServerInterceptor(checker *Checker, domain string, opts ...GrpcOption) grpc.UnaryServerInterceptor {
+	o := newGrpcOptions(opts)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := o.skip[info.FullMethod]; !ok {
+			var err error
+			if ctx, err = o.authorize(ctx, checker, domain, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor 创建 gRPC 流式调用鉴权拦截器，规则与 UnaryServerInterceptor 一致
+func StreamServerInterceptor(checker *Checker, domain string, opts ...GrpcOption) grpc.StreamServerInterceptor {
+	o := newGrpcOptions(opts)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := o.skip[info.FullMethod]; !ok {
+			ctx, err := o.authorize(ss.Context(), checker, domain, info.FullMethod)
+			if err != nil {
+				return err
+			}
+			ss = &authorizedStream{ServerStream: ss, ctx: ctx}
+		}
+		return handler(srv, ss)
+	}
+}
+
+func newGrpcOptions(opts []GrpcOption) *grpcOptions {
+	o := &grpcOptions{skip: make(map[string]struct{})}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.secret == "" && !o.trustedGateway {
+		panic("authz: gRPC 拦截器未配置调用方认证，请使用 WithJwtSecret 或 WithTrustedGateway")
+	}
+	return o
+}
+
+// authorize 认证调用方并鉴权，返回携带鉴权主体的上下文
+func (o *grpcOptions) authorize(ctx context.Context, checker *Checker, domain, method string) (context.Context, error) {
+	ctx, subject, ok := o.subject(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "common.forbidden")
+	}
+	allowed, err := checker.Check(ctx, subject, domain, method, RpcMethod)
+	if err != nil {
+		logx.WithContext(ctx).Errorw("调用鉴权服务失败", logx.Field("detail", err.Error()))
+		return nil, status.Error(codes.Unavailable, "common.forbidden")
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "common.api-forbidden")
+	}
+	return ctx, nil
+}
+
+// subject 获取鉴权主体，配置了密钥时只认可令牌声明，元数据中的 userId、roleId 被忽略
+func (o *grpcOptions) subject(ctx context.Context) (context.Context, *authzservice.AuthzSubject, bool) {
+	if o.secret == "" {
+		subject, ok := SubjectFromContext(ctx)
+		return ctx, subject, ok
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return ctx, nil, false
+	}
+	claims := make(jwt.MapClaims)
+	token := strings.TrimSpace(strings.TrimPrefix(values[0], "Bearer "))
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(o.secret), nil
+	}); err != nil {
+		logx.WithContext(ctx).Infow("访问令牌校验失败", logx.Field("detail", err.Error()))
+		return ctx, nil, false
+	}
+
+	// 声明写入上下文，业务代码通过 userctx、rolectx 读取时优先于元数据
+	userId, _ := claims["userId"].(string)
+	roleId, _ := claims["roleId"].(string)
+	ctx = context.WithValue(ctx, "userId", userId)
+	ctx = context.WithValue(ctx, "roleId", roleId)
+	if apiKeyId, ok := claims[apiKeyIdKey]; ok {
+		ctx = context.WithValue(ctx, apiKeyIdKey, apiKeyId)
+	} else if userId != "" {
+		return ctx, &authzservice.AuthzSubject{UserId: &userId}, true
+	}
+	if roleId == "" {
+		return ctx, nil, false
+	}
+	return ctx, &authzservice.AuthzSubject{RoleValues: strings.Split(roleId, ",")}, true
+}
+
+// authorizedStream 使流式处理器获取到携带鉴权主体的上下文
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,105 @@
+package authz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/wenpiner/last-admin-core/rpc/client/authzservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "secret"
+
+type subjectAuthzService struct {
+	authzservice.AuthzService
+	subject *authzservice.AuthzSubject
+}
+
+func (f *subjectAuthzService) Check(_ context.Context, in *authzservice.AuthzCheckRequest, _ ...grpc.CallOption) (*authzservice.AuthzCheckResponse, error) {
+	f.subject = in.Subject
+	return &authzservice.AuthzCheckResponse{Allowed: in.Subject.GetUserId() == "u1" || len(in.Subject.RoleValues) > 0}, nil
+}
+
+func signToken(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func callUnary(interceptor grpc.UnaryServerInterceptor, md metadata.MD) (context.Context, error) {
+	var got context.Context
+	_, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/order.Order/List"},
+		func(ctx context.Context, _ any) (any, error) {
+			got = ctx
+			return nil, nil
+		})
+	return got, err
+}
+
+func TestInterceptorRequiresUpstreamAuth(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected interceptor without upstream auth to fail")
+		}
+	}()
+	UnaryServerInterceptor(NewChecker(&subjectAuthzService{}), ApiDomain)
+}
+
+func TestInterceptorJwtSecret(t *testing.T) {
+	client := &subjectAuthzService{}
+	interceptor := UnaryServerInterceptor(NewChecker(client), ApiDomain, WithJwtSecret(testSecret))
+
+	// 未携带令牌时元数据中的主体不被信任
+	if _, err := callUnary(interceptor, metadata.Pairs("userId", "u1")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated for forged metadata, got %v", err)
+	}
+	forged := signToken(t, "other", jwt.MapClaims{"userId": "u1"})
+	if _, err := callUnary(interceptor, metadata.Pairs(authorizationKey, "Bearer "+forged)); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated for wrong signature, got %v", err)
+	}
+
+	token := signToken(t, testSecret, jwt.MapClaims{"userId": "u1", "roleId": "admin"})
+	ctx, err := callUnary(interceptor, metadata.Pairs(authorizationKey, "Bearer "+token, "userId", "u2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.subject.GetUserId() != "u1" || ctx.Value("userId") != "u1" {
+		t.Fatalf("expected subject from token claims, got %v", client.subject)
+	}
+
+	token = signToken(t, testSecret, jwt.MapClaims{"userId": "u1", "roleId": "reader", apiKeyIdKey: float64(1)})
+	if _, err = callUnary(interceptor, metadata.Pairs(authorizationKey, "Bearer "+token)); err != nil {
+		t.Fatal(err)
+	}
+	if client.subject.UserId != nil || len(client.subject.RoleValues) != 1 || client.subject.RoleValues[0] != "reader" {
+		t.Fatalf("expected scoped roles for api key session, got %v", client.subject)
+	}
+}
+
+func TestInterceptorTrustedGateway(t *testing.T) {
+	client := &subjectAuthzService{}
+	interceptor := UnaryServerInterceptor(NewChecker(client), ApiDomain, WithTrustedGateway(), WithSkipMethods("/order.Order/Ping"))
+
+	if _, err := callUnary(interceptor, metadata.Pairs("userId", "u1")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := callUnary(interceptor, metadata.MD{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected unauthenticated without subject, got %v", err)
+	}
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/order.Order/Ping"},
+		func(context.Context, any) (any, error) { return nil, nil })
+	if err != nil {
+		t.Fatalf("expected skipped method to pass, got %v", err)
+	}
+}
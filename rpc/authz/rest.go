@@ -0,0 +1,38 @@
+package authz
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ApiDomain 接口鉴权所在的域，与核心服务的接口策略一致
+const ApiDomain = "api"
+
+// NewRestMiddleware 创建 go-zero REST 鉴权中间件，按请求路径及方法在指定域下鉴权，需在 JWT 认证之后使用
+func NewRestMiddleware(checker *Checker, domain string) rest.Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			subject, ok := SubjectFromContext(r.Context())
+			if !ok {
+				httpx.ErrorCtx(r.Context(), w, errorx.NewApiForbiddenError("common.forbidden"))
+				return
+			}
+
+			allowed, err := checker.Check(r.Context(), subject, domain, r.URL.Path, r.Method)
+			if err != nil {
+				logx.WithContext(r.Context()).Errorw("调用鉴权服务失败", logx.Field("detail", err.Error()))
+				httpx.ErrorCtx(r.Context(), w, err)
+				return
+			}
+			if !allowed {
+				httpx.ErrorCtx(r.Context(), w, errorx.NewApiForbiddenError("common.api-forbidden"))
+				return
+			}
+			next(w, r)
+		}
+	}
+}
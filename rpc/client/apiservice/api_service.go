@@ -15,21 +15,52 @@ import (
 
 type (
 	ApiInfo                            = core.ApiInfo
+	ApiKeyAuthRequest                  = core.ApiKeyAuthRequest
+	ApiKeyAuthResponse                 = core.ApiKeyAuthResponse
+	ApiKeyDeleteRequest                = core.ApiKeyDeleteRequest
+	ApiKeyInfo                         = core.ApiKeyInfo
+	ApiKeyListRequest                  = core.ApiKeyListRequest
+	ApiKeyListResponse                 = core.ApiKeyListResponse
 	ApiListRequest                     = core.ApiListRequest
 	ApiListResponse                    = core.ApiListResponse
+	AuthzBatchCheckRequest             = core.AuthzBatchCheckRequest
+	AuthzBatchCheckResponse            = core.AuthzBatchCheckResponse
+	AuthzCheckRequest                  = core.AuthzCheckRequest
+	AuthzCheckResponse                 = core.AuthzCheckResponse
+	AuthzListActionsRequest            = core.AuthzListActionsRequest
+	AuthzListActionsResponse           = core.AuthzListActionsResponse
+	AuthzPermissionCodeResponse        = core.AuthzPermissionCodeResponse
+	AuthzResource                      = core.AuthzResource
+	AuthzSubject                       = core.AuthzSubject
 	BackupCodesResponse                = core.BackupCodesResponse
 	BasePageRequest                    = core.BasePageRequest
 	BasePageResp                       = core.BasePageResp
 	BaseResponse                       = core.BaseResponse
+	BatchItemResult                    = core.BatchItemResult
+	BatchResponse                      = core.BatchResponse
+	BatchStateID32Request              = core.BatchStateID32Request
+	BatchStateUUIDRequest              = core.BatchStateUUIDRequest
+	BundleChange                       = core.BundleChange
+	BundleData                         = core.BundleData
+	BundleExportRequest                = core.BundleExportRequest
+	BundleImportRequest                = core.BundleImportRequest
+	BundleImportResponse               = core.BundleImportResponse
+	ChangePasswordRequest              = core.ChangePasswordRequest
 	CleanExpiredTokensRequest          = core.CleanExpiredTokensRequest
 	CleanExpiredTokensResponse         = core.CleanExpiredTokensResponse
 	ConfigurationInfo                  = core.ConfigurationInfo
 	ConfigurationListRequest           = core.ConfigurationListRequest
 	ConfigurationListResponse          = core.ConfigurationListResponse
+	CreateApiKeyResponse               = core.CreateApiKeyResponse
 	CreateTokenRequest                 = core.CreateTokenRequest
 	DepartmentInfo                     = core.DepartmentInfo
 	DepartmentListRequest              = core.DepartmentListRequest
 	DepartmentListResponse             = core.DepartmentListResponse
+	DepartmentRoleRequest              = core.DepartmentRoleRequest
+	DictCodeRequest                    = core.DictCodeRequest
+	DictCodesRequest                   = core.DictCodesRequest
+	DictData                           = core.DictData
+	DictDataListResponse               = core.DictDataListResponse
 	DictInfo                           = core.DictInfo
 	DictItemInfo                       = core.DictItemInfo
 	DictItemListRequest                = core.DictItemListRequest
@@ -37,26 +68,75 @@ type (
 	DictListRequest                    = core.DictListRequest
 	DictListResponse                   = core.DictListResponse
 	DisableTotpRequest                 = core.DisableTotpRequest
+	EffectiveRoleInfo                  = core.EffectiveRoleInfo
+	EffectiveRoleResponse              = core.EffectiveRoleResponse
 	EmptyRequest                       = core.EmptyRequest
 	EnableTotpRequest                  = core.EnableTotpRequest
+	FileChunk                          = core.FileChunk
+	FileInfo                           = core.FileInfo
+	FileListRequest                    = core.FileListRequest
+	FileListResponse                   = core.FileListResponse
+	FileUploadRequest                  = core.FileUploadRequest
+	FilterCondition                    = core.FilterCondition
 	ID32Request                        = core.ID32Request
 	ID32SRequest                       = core.ID32SRequest
 	IDRequest                          = core.IDRequest
 	IDSRequest                         = core.IDSRequest
+	ImpersonationRequest               = core.ImpersonationRequest
+	MarkNotificationRequest            = core.MarkNotificationRequest
+	MenuApiListResponse                = core.MenuApiListResponse
+	MenuApiRequest                     = core.MenuApiRequest
 	MenuInfo                           = core.MenuInfo
 	MenuListRequest                    = core.MenuListRequest
 	MenuListResponse                   = core.MenuListResponse
 	MenuMeta                           = core.MenuMeta
+	NotificationCountResponse          = core.NotificationCountResponse
+	NotificationInfo                   = core.NotificationInfo
+	NotificationListRequest            = core.NotificationListRequest
+	NotificationListResponse           = core.NotificationListResponse
 	OauthCallbackRequest               = core.OauthCallbackRequest
+	OauthClientAuthRequest             = core.OauthClientAuthRequest
+	OauthClientInfo                    = core.OauthClientInfo
+	OauthClientListRequest             = core.OauthClientListRequest
+	OauthClientListResponse            = core.OauthClientListResponse
+	OauthClientSecretResponse          = core.OauthClientSecretResponse
+	OauthConsentInfo                   = core.OauthConsentInfo
+	OauthConsentListResponse           = core.OauthConsentListResponse
+	OauthConsentRequest                = core.OauthConsentRequest
 	OauthLoginRequest                  = core.OauthLoginRequest
 	OauthProviderInfo                  = core.OauthProviderInfo
 	OauthProviderListRequest           = core.OauthProviderListRequest
 	OauthProviderListResponse          = core.OauthProviderListResponse
 	OauthRedirectResponse              = core.OauthRedirectResponse
+	OperationLogArchiveInfo            = core.OperationLogArchiveInfo
+	OperationLogArchiveListRequest     = core.OperationLogArchiveListRequest
+	OperationLogArchiveListResponse    = core.OperationLogArchiveListResponse
+	OperationLogInfo                   = core.OperationLogInfo
+	OperationLogListRequest            = core.OperationLogListRequest
+	OperationLogListResponse           = core.OperationLogListResponse
+	PermissionEntry                    = core.PermissionEntry
+	PermissionExplainRequest           = core.PermissionExplainRequest
+	PermissionExplainResponse          = core.PermissionExplainResponse
+	PermissionHolderRequest            = core.PermissionHolderRequest
+	PermissionHolderResponse           = core.PermissionHolderResponse
+	PermissionRoleDecision             = core.PermissionRoleDecision
+	PermissionSnapshotDiffRequest      = core.PermissionSnapshotDiffRequest
+	PermissionSnapshotDiffResponse     = core.PermissionSnapshotDiffResponse
+	PermissionSnapshotInfo             = core.PermissionSnapshotInfo
+	PermissionSnapshotListRequest      = core.PermissionSnapshotListRequest
+	PermissionSnapshotListResponse     = core.PermissionSnapshotListResponse
+	PermissionSuggestion               = core.PermissionSuggestion
 	PositionInfo                       = core.PositionInfo
 	PositionListRequest                = core.PositionListRequest
 	PositionListResponse               = core.PositionListResponse
+	PositionRoleRequest                = core.PositionRoleRequest
+	RevokeUserTokensRequest            = core.RevokeUserTokensRequest
+	RevokeUserTokensResponse           = core.RevokeUserTokensResponse
+	RoleApiConsistency                 = core.RoleApiConsistency
+	RoleApiConsistencyRequest          = core.RoleApiConsistencyRequest
+	RoleApiConsistencyResponse         = core.RoleApiConsistencyResponse
 	RoleApiListResponse                = core.RoleApiListResponse
+	RoleApiMismatch                    = core.RoleApiMismatch
 	RoleApiRequest                     = core.RoleApiRequest
 	RoleConfigurationGroupListResponse = core.RoleConfigurationGroupListResponse
 	RoleConfigurationGroupRequest      = core.RoleConfigurationGroupRequest
@@ -65,8 +145,18 @@ type (
 	RoleListResponse                   = core.RoleListResponse
 	RoleMenuListResponse               = core.RoleMenuListResponse
 	RoleMenuRequest                    = core.RoleMenuRequest
+	RolePermissionReport               = core.RolePermissionReport
+	RoleSource                         = core.RoleSource
+	ScheduledJobInfo                   = core.ScheduledJobInfo
+	ScheduledJobListResponse           = core.ScheduledJobListResponse
+	ScheduledJobPauseRequest           = core.ScheduledJobPauseRequest
+	SecurityEventInfo                  = core.SecurityEventInfo
+	SecurityEventListRequest           = core.SecurityEventListRequest
+	SecurityEventListResponse          = core.SecurityEventListResponse
+	SortOption                         = core.SortOption
 	StringListResponse                 = core.StringListResponse
 	StringRequest                      = core.StringRequest
+	TimeRangeQuery                     = core.TimeRangeQuery
 	TokenInfo                          = core.TokenInfo
 	TokenListRequest                   = core.TokenListRequest
 	TokenListResponse                  = core.TokenListResponse
@@ -78,14 +168,30 @@ type (
 	UUIDSRequest                       = core.UUIDSRequest
 	UpdateTokenLastUsedRequest         = core.UpdateTokenLastUsedRequest
 	UseBackupCodeRequest               = core.UseBackupCodeRequest
+	UserExportResponse                 = core.UserExportResponse
+	UserImportRequest                  = core.UserImportRequest
+	UserImportResponse                 = core.UserImportResponse
+	UserImportRow                      = core.UserImportRow
+	UserImportRowError                 = core.UserImportRowError
+	UserImportRowResult                = core.UserImportRowResult
 	UserInfo                           = core.UserInfo
 	UserListRequest                    = core.UserListRequest
 	UserListResponse                   = core.UserListResponse
+	UserNotificationListRequest        = core.UserNotificationListRequest
 	ValidateConfigurationRequest       = core.ValidateConfigurationRequest
 	ValidateConfigurationResponse      = core.ValidateConfigurationResponse
 	VerifyTotpCodeRequest              = core.VerifyTotpCodeRequest
 	VerifyTotpCodeResponse             = core.VerifyTotpCodeResponse
 	VerifyTotpSetupRequest             = core.VerifyTotpSetupRequest
+	WebauthnBeginLoginRequest          = core.WebauthnBeginLoginRequest
+	WebauthnBeginRegistrationRequest   = core.WebauthnBeginRegistrationRequest
+	WebauthnBeginResponse              = core.WebauthnBeginResponse
+	WebauthnCredentialInfo             = core.WebauthnCredentialInfo
+	WebauthnCredentialListResponse     = core.WebauthnCredentialListResponse
+	WebauthnDeleteCredentialRequest    = core.WebauthnDeleteCredentialRequest
+	WebauthnFinishLoginRequest         = core.WebauthnFinishLoginRequest
+	WebauthnFinishRegistrationRequest  = core.WebauthnFinishRegistrationRequest
+	WebauthnUpdateCredentialRequest    = core.WebauthnUpdateCredentialRequest
 
 	ApiService interface {
 		// 创建或更新API
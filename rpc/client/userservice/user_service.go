@@ -15,21 +15,52 @@ import (
 
 type (
 	ApiInfo                            = core.ApiInfo
+	ApiKeyAuthRequest                  = core.ApiKeyAuthRequest
+	ApiKeyAuthResponse                 = core.ApiKeyAuthResponse
+	ApiKeyDeleteRequest                = core.ApiKeyDeleteRequest
+	ApiKeyInfo                         = core.ApiKeyInfo
+	ApiKeyListRequest                  = core.ApiKeyListRequest
+	ApiKeyListResponse                 = core.ApiKeyListResponse
 	ApiListRequest                     = core.ApiListRequest
 	ApiListResponse                    = core.ApiListResponse
+	AuthzBatchCheckRequest             = core.AuthzBatchCheckRequest
+	AuthzBatchCheckResponse            = core.AuthzBatchCheckResponse
+	AuthzCheckRequest                  = core.AuthzCheckRequest
+	AuthzCheckResponse                 = core.AuthzCheckResponse
+	AuthzListActionsRequest            = core.AuthzListActionsRequest
+	AuthzListActionsResponse           = core.AuthzListActionsResponse
+	AuthzPermissionCodeResponse        = core.AuthzPermissionCodeResponse
+	AuthzResource                      = core.AuthzResource
+	AuthzSubject                       = core.AuthzSubject
 	BackupCodesResponse                = core.BackupCodesResponse
 	BasePageRequest                    = core.BasePageRequest
 	BasePageResp                       = core.BasePageResp
 	BaseResponse                       = core.BaseResponse
+	BatchItemResult                    = core.BatchItemResult
+	BatchResponse                      = core.BatchResponse
+	BatchStateID32Request              = core.BatchStateID32Request
+	BatchStateUUIDRequest              = core.BatchStateUUIDRequest
+	BundleChange                       = core.BundleChange
+	BundleData                         = core.BundleData
+	BundleExportRequest                = core.BundleExportRequest
+	BundleImportRequest                = core.BundleImportRequest
+	BundleImportResponse               = core.BundleImportResponse
+	ChangePasswordRequest              = core.ChangePasswordRequest
 	CleanExpiredTokensRequest          = core.CleanExpiredTokensRequest
 	CleanExpiredTokensResponse         = core.CleanExpiredTokensResponse
 	ConfigurationInfo                  = core.ConfigurationInfo
 	ConfigurationListRequest           = core.ConfigurationListRequest
 	ConfigurationListResponse          = core.ConfigurationListResponse
+	CreateApiKeyResponse               = core.CreateApiKeyResponse
 	CreateTokenRequest                 = core.CreateTokenRequest
 	DepartmentInfo                     = core.DepartmentInfo
 	DepartmentListRequest              = core.DepartmentListRequest
 	DepartmentListResponse             = core.DepartmentListResponse
+	DepartmentRoleRequest              = core.DepartmentRoleRequest
+	DictCodeRequest                    = core.DictCodeRequest
+	DictCodesRequest                   = core.DictCodesRequest
+	DictData                           = core.DictData
+	DictDataListResponse               = core.DictDataListResponse
 	DictInfo                           = core.DictInfo
 	DictItemInfo                       = core.DictItemInfo
 	DictItemListRequest                = core.DictItemListRequest
@@ -37,26 +68,75 @@ type (
 	DictListRequest                    = core.DictListRequest
 	DictListResponse                   = core.DictListResponse
 	DisableTotpRequest                 = core.DisableTotpRequest
+	EffectiveRoleInfo                  = core.EffectiveRoleInfo
+	EffectiveRoleResponse              = core.EffectiveRoleResponse
 	EmptyRequest                       = core.EmptyRequest
 	EnableTotpRequest                  = core.EnableTotpRequest
+	FileChunk                          = core.FileChunk
+	FileInfo                           = core.FileInfo
+	FileListRequest                    = core.FileListRequest
+	FileListResponse                   = core.FileListResponse
+	FileUploadRequest                  = core.FileUploadRequest
+	FilterCondition                    = core.FilterCondition
 	ID32Request                        = core.ID32Request
 	ID32SRequest                       = core.ID32SRequest
 	IDRequest                          = core.IDRequest
 	IDSRequest                         = core.IDSRequest
+	ImpersonationRequest               = core.ImpersonationRequest
+	MarkNotificationRequest            = core.MarkNotificationRequest
+	MenuApiListResponse                = core.MenuApiListResponse
+	MenuApiRequest                     = core.MenuApiRequest
 	MenuInfo                           = core.MenuInfo
 	MenuListRequest                    = core.MenuListRequest
 	MenuListResponse                   = core.MenuListResponse
 	MenuMeta                           = core.MenuMeta
+	NotificationCountResponse          = core.NotificationCountResponse
+	NotificationInfo                   = core.NotificationInfo
+	NotificationListRequest            = core.NotificationListRequest
+	NotificationListResponse           = core.NotificationListResponse
 	OauthCallbackRequest               = core.OauthCallbackRequest
+	OauthClientAuthRequest             = core.OauthClientAuthRequest
+	OauthClientInfo                    = core.OauthClientInfo
+	OauthClientListRequest             = core.OauthClientListRequest
+	OauthClientListResponse            = core.OauthClientListResponse
+	OauthClientSecretResponse          = core.OauthClientSecretResponse
+	OauthConsentInfo                   = core.OauthConsentInfo
+	OauthConsentListResponse           = core.OauthConsentListResponse
+	OauthConsentRequest                = core.OauthConsentRequest
 	OauthLoginRequest                  = core.OauthLoginRequest
 	OauthProviderInfo                  = core.OauthProviderInfo
 	OauthProviderListRequest           = core.OauthProviderListRequest
 	OauthProviderListResponse          = core.OauthProviderListResponse
 	OauthRedirectResponse              = core.OauthRedirectResponse
+	OperationLogArchiveInfo            = core.OperationLogArchiveInfo
+	OperationLogArchiveListRequest     = core.OperationLogArchiveListRequest
+	OperationLogArchiveListResponse    = core.OperationLogArchiveListResponse
+	OperationLogInfo                   = core.OperationLogInfo
+	OperationLogListRequest            = core.OperationLogListRequest
+	OperationLogListResponse           = core.OperationLogListResponse
+	PermissionEntry                    = core.PermissionEntry
+	PermissionExplainRequest           = core.PermissionExplainRequest
+	PermissionExplainResponse          = core.PermissionExplainResponse
+	PermissionHolderRequest            = core.PermissionHolderRequest
+	PermissionHolderResponse           = core.PermissionHolderResponse
+	PermissionRoleDecision             = core.PermissionRoleDecision
+	PermissionSnapshotDiffRequest      = core.PermissionSnapshotDiffRequest
+	PermissionSnapshotDiffResponse     = core.PermissionSnapshotDiffResponse
+	PermissionSnapshotInfo             = core.PermissionSnapshotInfo
+	PermissionSnapshotListRequest      = core.PermissionSnapshotListRequest
+	PermissionSnapshotListResponse     = core.PermissionSnapshotListResponse
+	PermissionSuggestion               = core.PermissionSuggestion
 	PositionInfo                       = core.PositionInfo
 	PositionListRequest                = core.PositionListRequest
 	PositionListResponse               = core.PositionListResponse
+	PositionRoleRequest                = core.PositionRoleRequest
+	RevokeUserTokensRequest            = core.RevokeUserTokensRequest
+	RevokeUserTokensResponse           = core.RevokeUserTokensResponse
+	RoleApiConsistency                 = core.RoleApiConsistency
+	RoleApiConsistencyRequest          = core.RoleApiConsistencyRequest
+	RoleApiConsistencyResponse         = core.RoleApiConsistencyResponse
 	RoleApiListResponse                = core.RoleApiListResponse
+	RoleApiMismatch                    = core.RoleApiMismatch
 	RoleApiRequest                     = core.RoleApiRequest
 	RoleConfigurationGroupListResponse = core.RoleConfigurationGroupListResponse
 	RoleConfigurationGroupRequest      = core.RoleConfigurationGroupRequest
@@ -65,8 +145,18 @@ type (
 	RoleListResponse                   = core.RoleListResponse
 	RoleMenuListResponse               = core.RoleMenuListResponse
 	RoleMenuRequest                    = core.RoleMenuRequest
+	RolePermissionReport               = core.RolePermissionReport
+	RoleSource                         = core.RoleSource
+	ScheduledJobInfo                   = core.ScheduledJobInfo
+	ScheduledJobListResponse           = core.ScheduledJobListResponse
+	ScheduledJobPauseRequest           = core.ScheduledJobPauseRequest
+	SecurityEventInfo                  = core.SecurityEventInfo
+	SecurityEventListRequest           = core.SecurityEventListRequest
+	SecurityEventListResponse          = core.SecurityEventListResponse
+	SortOption                         = core.SortOption
 	StringListResponse                 = core.StringListResponse
 	StringRequest                      = core.StringRequest
+	TimeRangeQuery                     = core.TimeRangeQuery
 	TokenInfo                          = core.TokenInfo
 	TokenListRequest                   = core.TokenListRequest
 	TokenListResponse                  = core.TokenListResponse
@@ -78,14 +168,30 @@ type (
 	UUIDSRequest                       = core.UUIDSRequest
 	UpdateTokenLastUsedRequest         = core.UpdateTokenLastUsedRequest
 	UseBackupCodeRequest               = core.UseBackupCodeRequest
+	UserExportResponse                 = core.UserExportResponse
+	UserImportRequest                  = core.UserImportRequest
+	UserImportResponse                 = core.UserImportResponse
+	UserImportRow                      = core.UserImportRow
+	UserImportRowError                 = core.UserImportRowError
+	UserImportRowResult                = core.UserImportRowResult
 	UserInfo                           = core.UserInfo
 	UserListRequest                    = core.UserListRequest
 	UserListResponse                   = core.UserListResponse
+	UserNotificationListRequest        = core.UserNotificationListRequest
 	ValidateConfigurationRequest       = core.ValidateConfigurationRequest
 	ValidateConfigurationResponse      = core.ValidateConfigurationResponse
 	VerifyTotpCodeRequest              = core.VerifyTotpCodeRequest
 	VerifyTotpCodeResponse             = core.VerifyTotpCodeResponse
 	VerifyTotpSetupRequest             = core.VerifyTotpSetupRequest
+	WebauthnBeginLoginRequest          = core.WebauthnBeginLoginRequest
+	WebauthnBeginRegistrationRequest   = core.WebauthnBeginRegistrationRequest
+	WebauthnBeginResponse              = core.WebauthnBeginResponse
+	WebauthnCredentialInfo             = core.WebauthnCredentialInfo
+	WebauthnCredentialListResponse     = core.WebauthnCredentialListResponse
+	WebauthnDeleteCredentialRequest    = core.WebauthnDeleteCredentialRequest
+	WebauthnFinishLoginRequest         = core.WebauthnFinishLoginRequest
+	WebauthnFinishRegistrationRequest  = core.WebauthnFinishRegistrationRequest
+	WebauthnUpdateCredentialRequest    = core.WebauthnUpdateCredentialRequest
 
 	UserService interface {
 		// 创建用户
@@ -94,12 +200,18 @@ type (
 		UpdateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*UserInfo, error)
 		// 删除用户
 		DeleteUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+		// 批量删除用户，系统保留用户跳过
+		BatchDeleteUser(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+		// 批量启用或禁用用户，系统保留用户跳过
+		BatchUpdateUserState(ctx context.Context, in *BatchStateUUIDRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 		// 获取用户
 		GetUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*UserInfo, error)
 		// 获取用户列表
 		ListUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
 		// 获取用户 - 用户用户名
 		GetUserByUsername(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*UserInfo, error)
+		// 获取用户有效角色及来源
+		GetEffectiveRoles(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*EffectiveRoleResponse, error)
 		// TOTP相关接口
 		EnableTotp(ctx context.Context, in *EnableTotpRequest, opts ...grpc.CallOption) (*TotpSetupResponse, error)
 		// 验证并确认TOTP设置
@@ -114,6 +226,30 @@ type (
 		RegenerateBackupCodes(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*BackupCodesResponse, error)
 		// 使用备用恢复码
 		UseBackupCode(ctx context.Context, in *UseBackupCodeRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+		// WebAuthn相关接口
+		BeginWebauthnRegistration(ctx context.Context, in *WebauthnBeginRegistrationRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error)
+		// 完成注册WebAuthn凭证
+		FinishWebauthnRegistration(ctx context.Context, in *WebauthnFinishRegistrationRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error)
+		// 开始WebAuthn登录
+		BeginWebauthnLogin(ctx context.Context, in *WebauthnBeginLoginRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error)
+		// 完成WebAuthn登录
+		FinishWebauthnLogin(ctx context.Context, in *WebauthnFinishLoginRequest, opts ...grpc.CallOption) (*UserInfo, error)
+		// 获取用户WebAuthn凭证列表
+		ListWebauthnCredential(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*WebauthnCredentialListResponse, error)
+		// 修改WebAuthn凭证
+		UpdateWebauthnCredential(ctx context.Context, in *WebauthnUpdateCredentialRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error)
+		// 删除WebAuthn凭证
+		DeleteWebauthnCredential(ctx context.Context, in *WebauthnDeleteCredentialRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+		// 修改密码
+		ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+		// 批量导入用户
+		ImportUser(ctx context.Context, in *UserImportRequest, opts ...grpc.CallOption) (*UserImportResponse, error)
+		// 导出用户，筛选条件与 ListUser 一致，忽略分页
+		ExportUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserExportResponse, error)
+		// 开始模拟登录，校验目标用户并记录审计日志
+		StartImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*UserInfo, error)
+		// 结束模拟登录，记录审计日志
+		StopImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 	}
 
 	defaultUserService struct {
@@ -145,6 +281,18 @@ func (m *defaultUserService) DeleteUser(ctx context.Context, in *UUIDRequest, op
 	return client.DeleteUser(ctx, in, opts...)
 }
 
+// 批量删除用户，系统保留用户跳过
+func (m *defaultUserService) BatchDeleteUser(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.BatchDeleteUser(ctx, in, opts...)
+}
+
+// 批量启用或禁用用户，系统保留用户跳过
+func (m *defaultUserService) BatchUpdateUserState(ctx context.Context, in *BatchStateUUIDRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.BatchUpdateUserState(ctx, in, opts...)
+}
+
 // 获取用户
 func (m *defaultUserService) GetUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*UserInfo, error) {
 	client := core.NewUserServiceClient(m.cli.Conn())
@@ -163,6 +311,12 @@ func (m *defaultUserService) GetUserByUsername(ctx context.Context, in *StringRe
 	return client.GetUserByUsername(ctx, in, opts...)
 }
 
+// 获取用户有效角色及来源
+func (m *defaultUserService) GetEffectiveRoles(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*EffectiveRoleResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.GetEffectiveRoles(ctx, in, opts...)
+}
+
 // TOTP相关接口
 func (m *defaultUserService) EnableTotp(ctx context.Context, in *EnableTotpRequest, opts ...grpc.CallOption) (*TotpSetupResponse, error) {
 	client := core.NewUserServiceClient(m.cli.Conn())
@@ -204,3 +358,75 @@ func (m *defaultUserService) UseBackupCode(ctx context.Context, in *UseBackupCod
 	client := core.NewUserServiceClient(m.cli.Conn())
 	return client.UseBackupCode(ctx, in, opts...)
 }
+
+// WebAuthn相关接口
+func (m *defaultUserService) BeginWebauthnRegistration(ctx context.Context, in *WebauthnBeginRegistrationRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.BeginWebauthnRegistration(ctx, in, opts...)
+}
+
+// 完成注册WebAuthn凭证
+func (m *defaultUserService) FinishWebauthnRegistration(ctx context.Context, in *WebauthnFinishRegistrationRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.FinishWebauthnRegistration(ctx, in, opts...)
+}
+
+// 开始WebAuthn登录
+func (m *defaultUserService) BeginWebauthnLogin(ctx context.Context, in *WebauthnBeginLoginRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.BeginWebauthnLogin(ctx, in, opts...)
+}
+
+// 完成WebAuthn登录
+func (m *defaultUserService) FinishWebauthnLogin(ctx context.Context, in *WebauthnFinishLoginRequest, opts ...grpc.CallOption) (*UserInfo, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.FinishWebauthnLogin(ctx, in, opts...)
+}
+
+// 获取用户WebAuthn凭证列表
+func (m *defaultUserService) ListWebauthnCredential(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*WebauthnCredentialListResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.ListWebauthnCredential(ctx, in, opts...)
+}
+
+// 修改WebAuthn凭证
+func (m *defaultUserService) UpdateWebauthnCredential(ctx context.Context, in *WebauthnUpdateCredentialRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.UpdateWebauthnCredential(ctx, in, opts...)
+}
+
+// 删除WebAuthn凭证
+func (m *defaultUserService) DeleteWebauthnCredential(ctx context.Context, in *WebauthnDeleteCredentialRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.DeleteWebauthnCredential(ctx, in, opts...)
+}
+
+// 修改密码
+func (m *defaultUserService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.ChangePassword(ctx, in, opts...)
+}
+
+// 批量导入用户
+func (m *defaultUserService) ImportUser(ctx context.Context, in *UserImportRequest, opts ...grpc.CallOption) (*UserImportResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.ImportUser(ctx, in, opts...)
+}
+
+// 导出用户，筛选条件与 ListUser 一致，忽略分页
+func (m *defaultUserService) ExportUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserExportResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.ExportUser(ctx, in, opts...)
+}
+
+// 开始模拟登录，校验目标用户并记录审计日志
+func (m *defaultUserService) StartImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*UserInfo, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.StartImpersonation(ctx, in, opts...)
+}
+
+// 结束模拟登录，记录审计日志
+func (m *defaultUserService) StopImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	client := core.NewUserServiceClient(m.cli.Conn())
+	return client.StopImpersonation(ctx, in, opts...)
+}
@@ -5,15 +5,26 @@ import (
 	"fmt"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/config"
+	jobservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/jobservice"
+	apikeyserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/apikeyservice"
 	apiserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/apiservice"
+	authzserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/authzservice"
+	bundleserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/bundleservice"
 	configurationserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/configurationservice"
 	departmentserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/departmentservice"
 	dictserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/dictservice"
+	fileserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/fileservice"
 	initserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/initservice"
+	jobserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/jobservice"
 	menuserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/menuservice"
+	notificationserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/notificationservice"
+	oauthclientserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/oauthclientservice"
 	oauthproviderserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/oauthproviderservice"
+	operationlogserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/operationlogservice"
+	permissionreportserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/permissionreportservice"
 	positionserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/positionservice"
 	roleserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/roleservice"
+	securityeventserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/securityeventservice"
 	tokenserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/tokenservice"
 	userserviceServer "github.com/wenpiner/last-admin-core/rpc/internal/server/userservice"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -49,6 +60,16 @@ func main() {
 		core.RegisterInitServiceServer(grpcServer, initserviceServer.NewInitServiceServer(ctx))
 		core.RegisterTokenServiceServer(grpcServer, tokenserviceServer.NewTokenServiceServer(ctx))
 		core.RegisterConfigurationServiceServer(grpcServer, configurationserviceServer.NewConfigurationServiceServer(ctx))
+		core.RegisterApiKeyServiceServer(grpcServer, apikeyserviceServer.NewApiKeyServiceServer(ctx))
+		core.RegisterOauthClientServiceServer(grpcServer, oauthclientserviceServer.NewOauthClientServiceServer(ctx))
+		core.RegisterSecurityEventServiceServer(grpcServer, securityeventserviceServer.NewSecurityEventServiceServer(ctx))
+		core.RegisterNotificationServiceServer(grpcServer, notificationserviceServer.NewNotificationServiceServer(ctx))
+		core.RegisterJobServiceServer(grpcServer, jobserviceServer.NewJobServiceServer(ctx))
+		core.RegisterOperationLogServiceServer(grpcServer, operationlogserviceServer.NewOperationLogServiceServer(ctx))
+		core.RegisterFileServiceServer(grpcServer, fileserviceServer.NewFileServiceServer(ctx))
+		core.RegisterAuthzServiceServer(grpcServer, authzserviceServer.NewAuthzServiceServer(ctx))
+		core.RegisterPermissionReportServiceServer(grpcServer, permissionreportserviceServer.NewPermissionReportServiceServer(ctx))
+		core.RegisterBundleServiceServer(grpcServer, bundleserviceServer.NewBundleServiceServer(ctx))
 
 		if c.Mode == service.DevMode || c.Mode == service.TestMode {
 			reflection.Register(grpcServer)
@@ -56,6 +77,11 @@ func main() {
 	})
 	defer s.Stop()
 
+	// 启动内置定时任务
+	jobservicelogic.RegisterJobs(ctx)
+	ctx.Scheduler.Start()
+	defer ctx.Scheduler.Stop()
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
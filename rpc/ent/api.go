@@ -34,10 +34,42 @@ type API struct {
 	// 服务名称 / Service name
 	ServiceName string `json:"service_name,omitempty"`
 	// API分组 / API group
-	APIGroup     string `json:"api_group,omitempty"`
+	APIGroup string `json:"api_group,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIQuery when eager-loading is set.
+	Edges        APIEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// APIEdges holds the relations/edges for other nodes in the graph.
+type APIEdges struct {
+	// Menus holds the value of the menus edge.
+	Menus []*Menu `json:"menus,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MenusOrErr returns the Menus value or an error if the edge
+// was not loaded in eager-loading.
+func (e APIEdges) MenusOrErr() ([]*Menu, error) {
+	if e.loadedTypes[0] {
+		return e.Menus, nil
+	}
+	return nil, &NotLoadedError{edge: "menus"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e APIEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*API) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -141,6 +173,16 @@ func (_m *API) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryMenus queries the "menus" edge of the API entity.
+func (_m *API) QueryMenus() *MenuQuery {
+	return NewAPIClient(_m.config).QueryMenus(_m)
+}
+
+// QueryRoles queries the "roles" edge of the API entity.
+func (_m *API) QueryRoles() *RoleQuery {
+	return NewAPIClient(_m.config).QueryRoles(_m)
+}
+
 // Update returns a builder for updating this API.
 // Note that you need to call API.Unwrap() before calling this method if this API
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -31,6 +32,8 @@ type Department struct {
 	Sort int32 `json:"sort,omitempty"`
 	// 部门名称 / Department name
 	DeptName string `json:"dept_name,omitempty"`
+	// 部门名称多语言，键为语言标签 / Department name translations keyed by locale
+	DeptNameI18n map[string]string `json:"dept_name_i18n,omitempty"`
 	// 部门编码 / Department code
 	DeptCode string `json:"dept_code,omitempty"`
 	// 父部门ID / Parent department ID
@@ -39,10 +42,13 @@ type Department struct {
 	LeaderUserID *uuid.UUID `json:"leader_user_id,omitempty"`
 	// 部门描述 / Department description
 	Description *string `json:"description,omitempty"`
+	// 下级部门是否继承本部门角色 / Whether sub-departments inherit roles of this department
+	InheritRoles bool `json:"inherit_roles,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DepartmentQuery when eager-loading is set.
-	Edges        DepartmentEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges                    DepartmentEdges `json:"edges"`
+	notification_departments *uint32
+	selectValues             sql.SelectValues
 }
 
 // DepartmentEdges holds the relations/edges for other nodes in the graph.
@@ -53,11 +59,13 @@ type DepartmentEdges struct {
 	Children []*Department `json:"children,omitempty"`
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// Leader holds the value of the leader edge.
 	Leader *User `json:"leader,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -89,12 +97,21 @@ func (e DepartmentEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e DepartmentEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[3] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // LeaderOrErr returns the Leader value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e DepartmentEdges) LeaderOrErr() (*User, error) {
 	if e.Leader != nil {
 		return e.Leader, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "leader"}
@@ -107,7 +124,9 @@ func (*Department) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case department.FieldLeaderUserID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case department.FieldState:
+		case department.FieldDeptNameI18n:
+			values[i] = new([]byte)
+		case department.FieldState, department.FieldInheritRoles:
 			values[i] = new(sql.NullBool)
 		case department.FieldID, department.FieldSort, department.FieldParentID:
 			values[i] = new(sql.NullInt64)
@@ -115,6 +134,8 @@ func (*Department) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case department.FieldCreatedAt, department.FieldUpdatedAt, department.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
+		case department.ForeignKeys[0]: // notification_departments
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -173,6 +194,14 @@ func (_m *Department) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DeptName = value.String
 			}
+		case department.FieldDeptNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dept_name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DeptNameI18n); err != nil {
+					return fmt.Errorf("unmarshal field dept_name_i18n: %w", err)
+				}
+			}
 		case department.FieldDeptCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field dept_code", values[i])
@@ -200,6 +229,19 @@ func (_m *Department) assignValues(columns []string, values []any) error {
 				_m.Description = new(string)
 				*_m.Description = value.String
 			}
+		case department.FieldInheritRoles:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field inherit_roles", values[i])
+			} else if value.Valid {
+				_m.InheritRoles = value.Bool
+			}
+		case department.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field notification_departments", value)
+			} else if value.Valid {
+				_m.notification_departments = new(uint32)
+				*_m.notification_departments = uint32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +270,11 @@ func (_m *Department) QueryUsers() *UserQuery {
 	return NewDepartmentClient(_m.config).QueryUsers(_m)
 }
 
+// QueryRoles queries the "roles" edge of the Department entity.
+func (_m *Department) QueryRoles() *RoleQuery {
+	return NewDepartmentClient(_m.config).QueryRoles(_m)
+}
+
 // QueryLeader queries the "leader" edge of the Department entity.
 func (_m *Department) QueryLeader() *UserQuery {
 	return NewDepartmentClient(_m.config).QueryLeader(_m)
@@ -276,6 +323,9 @@ func (_m *Department) String() string {
 	builder.WriteString("dept_name=")
 	builder.WriteString(_m.DeptName)
 	builder.WriteString(", ")
+	builder.WriteString("dept_name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeptNameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("dept_code=")
 	builder.WriteString(_m.DeptCode)
 	builder.WriteString(", ")
@@ -293,6 +343,9 @@ func (_m *Department) String() string {
 		builder.WriteString("description=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("inherit_roles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InheritRoles))
 	builder.WriteByte(')')
 	return builder.String()
 }
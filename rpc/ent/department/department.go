@@ -26,6 +26,8 @@ const (
 	FieldSort = "sort"
 	// FieldDeptName holds the string denoting the dept_name field in the database.
 	FieldDeptName = "dept_name"
+	// FieldDeptNameI18n holds the string denoting the dept_name_i18n field in the database.
+	FieldDeptNameI18n = "dept_name_i18n"
 	// FieldDeptCode holds the string denoting the dept_code field in the database.
 	FieldDeptCode = "dept_code"
 	// FieldParentID holds the string denoting the parent_id field in the database.
@@ -34,12 +36,16 @@ const (
 	FieldLeaderUserID = "leader_user_id"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldInheritRoles holds the string denoting the inherit_roles field in the database.
+	FieldInheritRoles = "inherit_roles"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
 	EdgeChildren = "children"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
 	// EdgeLeader holds the string denoting the leader edge name in mutations.
 	EdgeLeader = "leader"
 	// Table holds the table name of the department in the database.
@@ -59,6 +65,11 @@ const (
 	UsersInverseTable = "sys_users"
 	// UsersColumn is the table column denoting the users relation/edge.
 	UsersColumn = "department_id"
+	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
+	RolesTable = "role_departments"
+	// RolesInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RolesInverseTable = "sys_roles"
 	// LeaderTable is the table that holds the leader relation/edge.
 	LeaderTable = "sys_departments"
 	// LeaderInverseTable is the table name for the User entity.
@@ -77,12 +88,26 @@ var Columns = []string{
 	FieldState,
 	FieldSort,
 	FieldDeptName,
+	FieldDeptNameI18n,
 	FieldDeptCode,
 	FieldParentID,
 	FieldLeaderUserID,
 	FieldDescription,
+	FieldInheritRoles,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sys_departments"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"notification_departments",
+}
+
+var (
+	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
+	// primary key for the roles relation (M2M).
+	RolesPrimaryKey = []string{"role_id", "department_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -90,6 +115,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -108,6 +138,8 @@ var (
 	DeptNameValidator func(string) error
 	// DeptCodeValidator is a validator for the "dept_code" field. It is called by the builders before save.
 	DeptCodeValidator func(string) error
+	// DefaultInheritRoles holds the default value on creation for the "inherit_roles" field.
+	DefaultInheritRoles bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -170,6 +202,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByInheritRoles orders the results by the inherit_roles field.
+func ByInheritRoles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInheritRoles, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -205,6 +242,20 @@ func ByUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLeaderField orders the results by leader field.
 func ByLeaderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -232,6 +283,13 @@ func newUsersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, UsersTable, UsersColumn),
 	)
 }
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
+	)
+}
 func newLeaderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -106,6 +106,11 @@ func Description(v string) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldDescription, v))
 }
 
+// InheritRoles applies equality check predicate on the "inherit_roles" field. It's identical to InheritRolesEQ.
+func InheritRoles(v bool) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldInheritRoles, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldCreatedAt, v))
@@ -361,6 +366,16 @@ func DeptNameContainsFold(v string) predicate.Department {
 	return predicate.Department(sql.FieldContainsFold(FieldDeptName, v))
 }
 
+// DeptNameI18nIsNil applies the IsNil predicate on the "dept_name_i18n" field.
+func DeptNameI18nIsNil() predicate.Department {
+	return predicate.Department(sql.FieldIsNull(FieldDeptNameI18n))
+}
+
+// DeptNameI18nNotNil applies the NotNil predicate on the "dept_name_i18n" field.
+func DeptNameI18nNotNil() predicate.Department {
+	return predicate.Department(sql.FieldNotNull(FieldDeptNameI18n))
+}
+
 // DeptCodeEQ applies the EQ predicate on the "dept_code" field.
 func DeptCodeEQ(v string) predicate.Department {
 	return predicate.Department(sql.FieldEQ(FieldDeptCode, v))
@@ -561,6 +576,16 @@ func DescriptionContainsFold(v string) predicate.Department {
 	return predicate.Department(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// InheritRolesEQ applies the EQ predicate on the "inherit_roles" field.
+func InheritRolesEQ(v bool) predicate.Department {
+	return predicate.Department(sql.FieldEQ(FieldInheritRoles, v))
+}
+
+// InheritRolesNEQ applies the NEQ predicate on the "inherit_roles" field.
+func InheritRolesNEQ(v bool) predicate.Department {
+	return predicate.Department(sql.FieldNEQ(FieldInheritRoles, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Department {
 	return predicate.Department(func(s *sql.Selector) {
@@ -630,6 +655,29 @@ func HasUsersWith(preds ...predicate.User) predicate.Department {
 	})
 }
 
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.Department {
+	return predicate.Department(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLeader applies the HasEdge predicate on the "leader" edge.
 func HasLeader() predicate.Department {
 	return predicate.Department(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 )
 
@@ -98,6 +99,12 @@ func (_c *DepartmentCreate) SetDeptName(v string) *DepartmentCreate {
 	return _c
 }
 
+// SetDeptNameI18n sets the "dept_name_i18n" field.
+func (_c *DepartmentCreate) SetDeptNameI18n(v map[string]string) *DepartmentCreate {
+	_c.mutation.SetDeptNameI18n(v)
+	return _c
+}
+
 // SetDeptCode sets the "dept_code" field.
 func (_c *DepartmentCreate) SetDeptCode(v string) *DepartmentCreate {
 	_c.mutation.SetDeptCode(v)
@@ -146,6 +153,20 @@ func (_c *DepartmentCreate) SetNillableDescription(v *string) *DepartmentCreate
 	return _c
 }
 
+// SetInheritRoles sets the "inherit_roles" field.
+func (_c *DepartmentCreate) SetInheritRoles(v bool) *DepartmentCreate {
+	_c.mutation.SetInheritRoles(v)
+	return _c
+}
+
+// SetNillableInheritRoles sets the "inherit_roles" field if the given value is not nil.
+func (_c *DepartmentCreate) SetNillableInheritRoles(v *bool) *DepartmentCreate {
+	if v != nil {
+		_c.SetInheritRoles(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DepartmentCreate) SetID(v uint32) *DepartmentCreate {
 	_c.mutation.SetID(v)
@@ -187,6 +208,21 @@ func (_c *DepartmentCreate) AddUsers(v ...*User) *DepartmentCreate {
 	return _c.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_c *DepartmentCreate) AddRoleIDs(ids ...uint32) *DepartmentCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_c *DepartmentCreate) AddRoles(v ...*Role) *DepartmentCreate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
 // SetLeaderID sets the "leader" edge to the User entity by ID.
 func (_c *DepartmentCreate) SetLeaderID(id uuid.UUID) *DepartmentCreate {
 	_c.mutation.SetLeaderID(id)
@@ -257,6 +293,10 @@ func (_c *DepartmentCreate) defaults() {
 		v := department.DefaultSort
 		_c.mutation.SetSort(v)
 	}
+	if _, ok := _c.mutation.InheritRoles(); !ok {
+		v := department.DefaultInheritRoles
+		_c.mutation.SetInheritRoles(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -286,6 +326,9 @@ func (_c *DepartmentCreate) check() error {
 			return &ValidationError{Name: "dept_code", err: fmt.Errorf(`ent: validator failed for field "Department.dept_code": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.InheritRoles(); !ok {
+		return &ValidationError{Name: "inherit_roles", err: errors.New(`ent: missing required field "Department.inherit_roles"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := department.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Department.id": %w`, err)}
@@ -347,6 +390,10 @@ func (_c *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
 		_spec.SetField(department.FieldDeptName, field.TypeString, value)
 		_node.DeptName = value
 	}
+	if value, ok := _c.mutation.DeptNameI18n(); ok {
+		_spec.SetField(department.FieldDeptNameI18n, field.TypeJSON, value)
+		_node.DeptNameI18n = value
+	}
 	if value, ok := _c.mutation.DeptCode(); ok {
 		_spec.SetField(department.FieldDeptCode, field.TypeString, value)
 		_node.DeptCode = value
@@ -355,6 +402,10 @@ func (_c *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
 		_spec.SetField(department.FieldDescription, field.TypeString, value)
 		_node.Description = &value
 	}
+	if value, ok := _c.mutation.InheritRoles(); ok {
+		_spec.SetField(department.FieldInheritRoles, field.TypeBool, value)
+		_node.InheritRoles = value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -404,6 +455,22 @@ func (_c *DepartmentCreate) createSpec() (*Department, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LeaderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 )
 
@@ -111,6 +112,18 @@ func (_u *DepartmentUpdate) SetNillableDeptName(v *string) *DepartmentUpdate {
 	return _u
 }
 
+// SetDeptNameI18n sets the "dept_name_i18n" field.
+func (_u *DepartmentUpdate) SetDeptNameI18n(v map[string]string) *DepartmentUpdate {
+	_u.mutation.SetDeptNameI18n(v)
+	return _u
+}
+
+// ClearDeptNameI18n clears the value of the "dept_name_i18n" field.
+func (_u *DepartmentUpdate) ClearDeptNameI18n() *DepartmentUpdate {
+	_u.mutation.ClearDeptNameI18n()
+	return _u
+}
+
 // SetDeptCode sets the "dept_code" field.
 func (_u *DepartmentUpdate) SetDeptCode(v string) *DepartmentUpdate {
 	_u.mutation.SetDeptCode(v)
@@ -185,6 +198,20 @@ func (_u *DepartmentUpdate) ClearDescription() *DepartmentUpdate {
 	return _u
 }
 
+// SetInheritRoles sets the "inherit_roles" field.
+func (_u *DepartmentUpdate) SetInheritRoles(v bool) *DepartmentUpdate {
+	_u.mutation.SetInheritRoles(v)
+	return _u
+}
+
+// SetNillableInheritRoles sets the "inherit_roles" field if the given value is not nil.
+func (_u *DepartmentUpdate) SetNillableInheritRoles(v *bool) *DepartmentUpdate {
+	if v != nil {
+		_u.SetInheritRoles(*v)
+	}
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Department entity.
 func (_u *DepartmentUpdate) SetParent(v *Department) *DepartmentUpdate {
 	return _u.SetParentID(v.ID)
@@ -220,6 +247,21 @@ func (_u *DepartmentUpdate) AddUsers(v ...*User) *DepartmentUpdate {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *DepartmentUpdate) AddRoleIDs(ids ...uint32) *DepartmentUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *DepartmentUpdate) AddRoles(v ...*Role) *DepartmentUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // SetLeaderID sets the "leader" edge to the User entity by ID.
 func (_u *DepartmentUpdate) SetLeaderID(id uuid.UUID) *DepartmentUpdate {
 	_u.mutation.SetLeaderID(id)
@@ -292,6 +334,27 @@ func (_u *DepartmentUpdate) RemoveUsers(v ...*User) *DepartmentUpdate {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *DepartmentUpdate) ClearRoles() *DepartmentUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *DepartmentUpdate) RemoveRoleIDs(ids ...uint32) *DepartmentUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *DepartmentUpdate) RemoveRoles(v ...*Role) *DepartmentUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // ClearLeader clears the "leader" edge to the User entity.
 func (_u *DepartmentUpdate) ClearLeader() *DepartmentUpdate {
 	_u.mutation.ClearLeader()
@@ -385,6 +448,12 @@ func (_u *DepartmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.DeptName(); ok {
 		_spec.SetField(department.FieldDeptName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeptNameI18n(); ok {
+		_spec.SetField(department.FieldDeptNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.DeptNameI18nCleared() {
+		_spec.ClearField(department.FieldDeptNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.DeptCode(); ok {
 		_spec.SetField(department.FieldDeptCode, field.TypeString, value)
 	}
@@ -394,6 +463,9 @@ func (_u *DepartmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(department.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.InheritRoles(); ok {
+		_spec.SetField(department.FieldInheritRoles, field.TypeBool, value)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -513,6 +585,51 @@ func (_u *DepartmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.LeaderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -643,6 +760,18 @@ func (_u *DepartmentUpdateOne) SetNillableDeptName(v *string) *DepartmentUpdateO
 	return _u
 }
 
+// SetDeptNameI18n sets the "dept_name_i18n" field.
+func (_u *DepartmentUpdateOne) SetDeptNameI18n(v map[string]string) *DepartmentUpdateOne {
+	_u.mutation.SetDeptNameI18n(v)
+	return _u
+}
+
+// ClearDeptNameI18n clears the value of the "dept_name_i18n" field.
+func (_u *DepartmentUpdateOne) ClearDeptNameI18n() *DepartmentUpdateOne {
+	_u.mutation.ClearDeptNameI18n()
+	return _u
+}
+
 // SetDeptCode sets the "dept_code" field.
 func (_u *DepartmentUpdateOne) SetDeptCode(v string) *DepartmentUpdateOne {
 	_u.mutation.SetDeptCode(v)
@@ -717,6 +846,20 @@ func (_u *DepartmentUpdateOne) ClearDescription() *DepartmentUpdateOne {
 	return _u
 }
 
+// SetInheritRoles sets the "inherit_roles" field.
+func (_u *DepartmentUpdateOne) SetInheritRoles(v bool) *DepartmentUpdateOne {
+	_u.mutation.SetInheritRoles(v)
+	return _u
+}
+
+// SetNillableInheritRoles sets the "inherit_roles" field if the given value is not nil.
+func (_u *DepartmentUpdateOne) SetNillableInheritRoles(v *bool) *DepartmentUpdateOne {
+	if v != nil {
+		_u.SetInheritRoles(*v)
+	}
+	return _u
+}
+
 // SetParent sets the "parent" edge to the Department entity.
 func (_u *DepartmentUpdateOne) SetParent(v *Department) *DepartmentUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -752,6 +895,21 @@ func (_u *DepartmentUpdateOne) AddUsers(v ...*User) *DepartmentUpdateOne {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *DepartmentUpdateOne) AddRoleIDs(ids ...uint32) *DepartmentUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *DepartmentUpdateOne) AddRoles(v ...*Role) *DepartmentUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // SetLeaderID sets the "leader" edge to the User entity by ID.
 func (_u *DepartmentUpdateOne) SetLeaderID(id uuid.UUID) *DepartmentUpdateOne {
 	_u.mutation.SetLeaderID(id)
@@ -824,6 +982,27 @@ func (_u *DepartmentUpdateOne) RemoveUsers(v ...*User) *DepartmentUpdateOne {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *DepartmentUpdateOne) ClearRoles() *DepartmentUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *DepartmentUpdateOne) RemoveRoleIDs(ids ...uint32) *DepartmentUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *DepartmentUpdateOne) RemoveRoles(v ...*Role) *DepartmentUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // ClearLeader clears the "leader" edge to the User entity.
 func (_u *DepartmentUpdateOne) ClearLeader() *DepartmentUpdateOne {
 	_u.mutation.ClearLeader()
@@ -947,6 +1126,12 @@ func (_u *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department,
 	if value, ok := _u.mutation.DeptName(); ok {
 		_spec.SetField(department.FieldDeptName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DeptNameI18n(); ok {
+		_spec.SetField(department.FieldDeptNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.DeptNameI18nCleared() {
+		_spec.ClearField(department.FieldDeptNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.DeptCode(); ok {
 		_spec.SetField(department.FieldDeptCode, field.TypeString, value)
 	}
@@ -956,6 +1141,9 @@ func (_u *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department,
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(department.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.InheritRoles(); ok {
+		_spec.SetField(department.FieldInheritRoles, field.TypeBool, value)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1075,6 +1263,51 @@ func (_u *DepartmentUpdateOne) sqlSave(ctx context.Context) (_node *Department,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   department.RolesTable,
+			Columns: department.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.LeaderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,8 @@ type DictItem struct {
 	State bool `json:"state,omitempty"`
 	// 字典项标签 / Dictionary item label
 	ItemLabel string `json:"item_label,omitempty"`
+	// 字典项标签多语言，键为语言标签 / Dictionary item label translations keyed by locale
+	ItemLabelI18n map[string]string `json:"item_label_i18n,omitempty"`
 	// 字典项值 / Dictionary item value
 	ItemValue string `json:"item_value,omitempty"`
 	// 字典项颜色 / Dictionary item color
@@ -71,6 +74,8 @@ func (*DictItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case dictitem.FieldItemLabelI18n:
+			values[i] = new([]byte)
 		case dictitem.FieldState:
 			values[i] = new(sql.NullBool)
 		case dictitem.FieldID, dictitem.FieldSortOrder, dictitem.FieldDictTypeID:
@@ -131,6 +136,14 @@ func (_m *DictItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ItemLabel = value.String
 			}
+		case dictitem.FieldItemLabelI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field item_label_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ItemLabelI18n); err != nil {
+					return fmt.Errorf("unmarshal field item_label_i18n: %w", err)
+				}
+			}
 		case dictitem.FieldItemValue:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field item_value", values[i])
@@ -228,6 +241,9 @@ func (_m *DictItem) String() string {
 	builder.WriteString("item_label=")
 	builder.WriteString(_m.ItemLabel)
 	builder.WriteString(", ")
+	builder.WriteString("item_label_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemLabelI18n))
+	builder.WriteString(", ")
 	builder.WriteString("item_value=")
 	builder.WriteString(_m.ItemValue)
 	builder.WriteString(", ")
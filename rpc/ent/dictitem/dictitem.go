@@ -24,6 +24,8 @@ const (
 	FieldState = "state"
 	// FieldItemLabel holds the string denoting the item_label field in the database.
 	FieldItemLabel = "item_label"
+	// FieldItemLabelI18n holds the string denoting the item_label_i18n field in the database.
+	FieldItemLabelI18n = "item_label_i18n"
 	// FieldItemValue holds the string denoting the item_value field in the database.
 	FieldItemValue = "item_value"
 	// FieldItemColor holds the string denoting the item_color field in the database.
@@ -57,6 +59,7 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldState,
 	FieldItemLabel,
+	FieldItemLabelI18n,
 	FieldItemValue,
 	FieldItemColor,
 	FieldItemCSS,
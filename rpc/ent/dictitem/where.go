@@ -325,6 +325,16 @@ func ItemLabelContainsFold(v string) predicate.DictItem {
 	return predicate.DictItem(sql.FieldContainsFold(FieldItemLabel, v))
 }
 
+// ItemLabelI18nIsNil applies the IsNil predicate on the "item_label_i18n" field.
+func ItemLabelI18nIsNil() predicate.DictItem {
+	return predicate.DictItem(sql.FieldIsNull(FieldItemLabelI18n))
+}
+
+// ItemLabelI18nNotNil applies the NotNil predicate on the "item_label_i18n" field.
+func ItemLabelI18nNotNil() predicate.DictItem {
+	return predicate.DictItem(sql.FieldNotNull(FieldItemLabelI18n))
+}
+
 // ItemValueEQ applies the EQ predicate on the "item_value" field.
 func ItemValueEQ(v string) predicate.DictItem {
 	return predicate.DictItem(sql.FieldEQ(FieldItemValue, v))
@@ -83,6 +83,12 @@ func (_c *DictItemCreate) SetItemLabel(v string) *DictItemCreate {
 	return _c
 }
 
+// SetItemLabelI18n sets the "item_label_i18n" field.
+func (_c *DictItemCreate) SetItemLabelI18n(v map[string]string) *DictItemCreate {
+	_c.mutation.SetItemLabelI18n(v)
+	return _c
+}
+
 // SetItemValue sets the "item_value" field.
 func (_c *DictItemCreate) SetItemValue(v string) *DictItemCreate {
 	_c.mutation.SetItemValue(v)
@@ -315,6 +321,10 @@ func (_c *DictItemCreate) createSpec() (*DictItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(dictitem.FieldItemLabel, field.TypeString, value)
 		_node.ItemLabel = value
 	}
+	if value, ok := _c.mutation.ItemLabelI18n(); ok {
+		_spec.SetField(dictitem.FieldItemLabelI18n, field.TypeJSON, value)
+		_node.ItemLabelI18n = value
+	}
 	if value, ok := _c.mutation.ItemValue(); ok {
 		_spec.SetField(dictitem.FieldItemValue, field.TypeString, value)
 		_node.ItemValue = value
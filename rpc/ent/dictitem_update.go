@@ -89,6 +89,18 @@ func (_u *DictItemUpdate) SetNillableItemLabel(v *string) *DictItemUpdate {
 	return _u
 }
 
+// SetItemLabelI18n sets the "item_label_i18n" field.
+func (_u *DictItemUpdate) SetItemLabelI18n(v map[string]string) *DictItemUpdate {
+	_u.mutation.SetItemLabelI18n(v)
+	return _u
+}
+
+// ClearItemLabelI18n clears the value of the "item_label_i18n" field.
+func (_u *DictItemUpdate) ClearItemLabelI18n() *DictItemUpdate {
+	_u.mutation.ClearItemLabelI18n()
+	return _u
+}
+
 // SetItemValue sets the "item_value" field.
 func (_u *DictItemUpdate) SetItemValue(v string) *DictItemUpdate {
 	_u.mutation.SetItemValue(v)
@@ -308,6 +320,12 @@ func (_u *DictItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ItemLabel(); ok {
 		_spec.SetField(dictitem.FieldItemLabel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ItemLabelI18n(); ok {
+		_spec.SetField(dictitem.FieldItemLabelI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.ItemLabelI18nCleared() {
+		_spec.ClearField(dictitem.FieldItemLabelI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ItemValue(); ok {
 		_spec.SetField(dictitem.FieldItemValue, field.TypeString, value)
 	}
@@ -444,6 +462,18 @@ func (_u *DictItemUpdateOne) SetNillableItemLabel(v *string) *DictItemUpdateOne
 	return _u
 }
 
+// SetItemLabelI18n sets the "item_label_i18n" field.
+func (_u *DictItemUpdateOne) SetItemLabelI18n(v map[string]string) *DictItemUpdateOne {
+	_u.mutation.SetItemLabelI18n(v)
+	return _u
+}
+
+// ClearItemLabelI18n clears the value of the "item_label_i18n" field.
+func (_u *DictItemUpdateOne) ClearItemLabelI18n() *DictItemUpdateOne {
+	_u.mutation.ClearItemLabelI18n()
+	return _u
+}
+
 // SetItemValue sets the "item_value" field.
 func (_u *DictItemUpdateOne) SetItemValue(v string) *DictItemUpdateOne {
 	_u.mutation.SetItemValue(v)
@@ -693,6 +723,12 @@ func (_u *DictItemUpdateOne) sqlSave(ctx context.Context) (_node *DictItem, err
 	if value, ok := _u.mutation.ItemLabel(); ok {
 		_spec.SetField(dictitem.FieldItemLabel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ItemLabelI18n(); ok {
+		_spec.SetField(dictitem.FieldItemLabelI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.ItemLabelI18nCleared() {
+		_spec.ClearField(dictitem.FieldItemLabelI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ItemValue(); ok {
 		_spec.SetField(dictitem.FieldItemValue, field.TypeString, value)
 	}
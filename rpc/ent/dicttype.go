@@ -31,6 +31,8 @@ type DictType struct {
 	DictTypeName string `json:"dict_type_name,omitempty"`
 	// 字典类型描述 / Dictionary type description
 	Description string `json:"description,omitempty"`
+	// 是否公开，公开字典允许匿名读取 / Whether anonymous users can read the dictionary
+	IsPublic bool `json:"is_public,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DictTypeQuery when eager-loading is set.
 	Edges        DictTypeEdges `json:"edges"`
@@ -60,7 +62,7 @@ func (*DictType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dicttype.FieldState:
+		case dicttype.FieldState, dicttype.FieldIsPublic:
 			values[i] = new(sql.NullBool)
 		case dicttype.FieldID:
 			values[i] = new(sql.NullInt64)
@@ -132,6 +134,12 @@ func (_m *DictType) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case dicttype.FieldIsPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_public", values[i])
+			} else if value.Valid {
+				_m.IsPublic = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -195,6 +203,9 @@ func (_m *DictType) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("is_public=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,8 @@ const (
 	FieldDictTypeName = "dict_type_name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldIsPublic holds the string denoting the is_public field in the database.
+	FieldIsPublic = "is_public"
 	// EdgeDictItems holds the string denoting the dict_items edge name in mutations.
 	EdgeDictItems = "dict_items"
 	// Table holds the table name of the dicttype in the database.
@@ -51,6 +53,7 @@ var Columns = []string{
 	FieldDictTypeCode,
 	FieldDictTypeName,
 	FieldDescription,
+	FieldIsPublic,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +83,8 @@ var (
 	DefaultDescription string
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
+	// DefaultIsPublic holds the default value on creation for the "is_public" field.
+	DefaultIsPublic bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -127,6 +132,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByIsPublic orders the results by the is_public field.
+func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
+}
+
 // ByDictItemsCount orders the results by dict_items count.
 func ByDictItemsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -90,6 +90,11 @@ func Description(v string) predicate.DictType {
 	return predicate.DictType(sql.FieldEQ(FieldDescription, v))
 }
 
+// IsPublic applies equality check predicate on the "is_public" field. It's identical to IsPublicEQ.
+func IsPublic(v bool) predicate.DictType {
+	return predicate.DictType(sql.FieldEQ(FieldIsPublic, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DictType {
 	return predicate.DictType(sql.FieldEQ(FieldCreatedAt, v))
@@ -435,6 +440,16 @@ func DescriptionContainsFold(v string) predicate.DictType {
 	return predicate.DictType(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// IsPublicEQ applies the EQ predicate on the "is_public" field.
+func IsPublicEQ(v bool) predicate.DictType {
+	return predicate.DictType(sql.FieldEQ(FieldIsPublic, v))
+}
+
+// IsPublicNEQ applies the NEQ predicate on the "is_public" field.
+func IsPublicNEQ(v bool) predicate.DictType {
+	return predicate.DictType(sql.FieldNEQ(FieldIsPublic, v))
+}
+
 // HasDictItems applies the HasEdge predicate on the "dict_items" edge.
 func HasDictItems() predicate.DictType {
 	return predicate.DictType(func(s *sql.Selector) {
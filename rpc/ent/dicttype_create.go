@@ -103,6 +103,20 @@ func (_c *DictTypeCreate) SetNillableDescription(v *string) *DictTypeCreate {
 	return _c
 }
 
+// SetIsPublic sets the "is_public" field.
+func (_c *DictTypeCreate) SetIsPublic(v bool) *DictTypeCreate {
+	_c.mutation.SetIsPublic(v)
+	return _c
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_c *DictTypeCreate) SetNillableIsPublic(v *bool) *DictTypeCreate {
+	if v != nil {
+		_c.SetIsPublic(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DictTypeCreate) SetID(v uint32) *DictTypeCreate {
 	_c.mutation.SetID(v)
@@ -175,6 +189,10 @@ func (_c *DictTypeCreate) defaults() {
 		v := dicttype.DefaultDescription
 		_c.mutation.SetDescription(v)
 	}
+	if _, ok := _c.mutation.IsPublic(); !ok {
+		v := dicttype.DefaultIsPublic
+		_c.mutation.SetIsPublic(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -209,6 +227,9 @@ func (_c *DictTypeCreate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "DictType.description": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.IsPublic(); !ok {
+		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "DictType.is_public"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := dicttype.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DictType.id": %w`, err)}
@@ -274,6 +295,10 @@ func (_c *DictTypeCreate) createSpec() (*DictType, *sqlgraph.CreateSpec) {
 		_spec.SetField(dicttype.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.IsPublic(); ok {
+		_spec.SetField(dicttype.FieldIsPublic, field.TypeBool, value)
+		_node.IsPublic = value
+	}
 	if nodes := _c.mutation.DictItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
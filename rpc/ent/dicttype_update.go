@@ -117,6 +117,20 @@ func (_u *DictTypeUpdate) SetNillableDescription(v *string) *DictTypeUpdate {
 	return _u
 }
 
+// SetIsPublic sets the "is_public" field.
+func (_u *DictTypeUpdate) SetIsPublic(v bool) *DictTypeUpdate {
+	_u.mutation.SetIsPublic(v)
+	return _u
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_u *DictTypeUpdate) SetNillableIsPublic(v *bool) *DictTypeUpdate {
+	if v != nil {
+		_u.SetIsPublic(*v)
+	}
+	return _u
+}
+
 // AddDictItemIDs adds the "dict_items" edge to the DictItem entity by IDs.
 func (_u *DictTypeUpdate) AddDictItemIDs(ids ...uint32) *DictTypeUpdate {
 	_u.mutation.AddDictItemIDs(ids...)
@@ -250,6 +264,9 @@ func (_u *DictTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(dicttype.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.IsPublic(); ok {
+		_spec.SetField(dicttype.FieldIsPublic, field.TypeBool, value)
+	}
 	if _u.mutation.DictItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -403,6 +420,20 @@ func (_u *DictTypeUpdateOne) SetNillableDescription(v *string) *DictTypeUpdateOn
 	return _u
 }
 
+// SetIsPublic sets the "is_public" field.
+func (_u *DictTypeUpdateOne) SetIsPublic(v bool) *DictTypeUpdateOne {
+	_u.mutation.SetIsPublic(v)
+	return _u
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_u *DictTypeUpdateOne) SetNillableIsPublic(v *bool) *DictTypeUpdateOne {
+	if v != nil {
+		_u.SetIsPublic(*v)
+	}
+	return _u
+}
+
 // AddDictItemIDs adds the "dict_items" edge to the DictItem entity by IDs.
 func (_u *DictTypeUpdateOne) AddDictItemIDs(ids ...uint32) *DictTypeUpdateOne {
 	_u.mutation.AddDictItemIDs(ids...)
@@ -566,6 +597,9 @@ func (_u *DictTypeUpdateOne) sqlSave(ctx context.Context) (_node *DictType, err
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(dicttype.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.IsPublic(); ok {
+		_spec.SetField(dicttype.FieldIsPublic, field.TypeBool, value)
+	}
 	if _u.mutation.DictItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
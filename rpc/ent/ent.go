@@ -13,18 +13,30 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
 	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dictitem"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/oauthprovider"
 	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/token"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userpasswordhistory"
 	"github.com/wenpiner/last-admin-core/rpc/ent/usertotp"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -85,19 +97,31 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			api.Table:           api.ValidColumn,
-			configuration.Table: configuration.ValidColumn,
-			department.Table:    department.ValidColumn,
-			dictitem.Table:      dictitem.ValidColumn,
-			dicttype.Table:      dicttype.ValidColumn,
-			menu.Table:          menu.ValidColumn,
-			oauthprovider.Table: oauthprovider.ValidColumn,
-			operationlog.Table:  operationlog.ValidColumn,
-			position.Table:      position.ValidColumn,
-			role.Table:          role.ValidColumn,
-			token.Table:         token.ValidColumn,
-			user.Table:          user.ValidColumn,
-			usertotp.Table:      usertotp.ValidColumn,
+			api.Table:                   api.ValidColumn,
+			apikey.Table:                apikey.ValidColumn,
+			configuration.Table:         configuration.ValidColumn,
+			department.Table:            department.ValidColumn,
+			dictitem.Table:              dictitem.ValidColumn,
+			dicttype.Table:              dicttype.ValidColumn,
+			file.Table:                  file.ValidColumn,
+			menu.Table:                  menu.ValidColumn,
+			notification.Table:          notification.ValidColumn,
+			notificationrecipient.Table: notificationrecipient.ValidColumn,
+			oauthclient.Table:           oauthclient.ValidColumn,
+			oauthconsent.Table:          oauthconsent.ValidColumn,
+			oauthprovider.Table:         oauthprovider.ValidColumn,
+			operationlog.Table:          operationlog.ValidColumn,
+			operationlogarchive.Table:   operationlogarchive.ValidColumn,
+			permissionsnapshot.Table:    permissionsnapshot.ValidColumn,
+			position.Table:              position.ValidColumn,
+			role.Table:                  role.ValidColumn,
+			scheduledjob.Table:          scheduledjob.ValidColumn,
+			securityevent.Table:         securityevent.ValidColumn,
+			token.Table:                 token.ValidColumn,
+			user.Table:                  user.ValidColumn,
+			userpasswordhistory.Table:   userpasswordhistory.ValidColumn,
+			usertotp.Table:              usertotp.ValidColumn,
+			userwebauthn.Table:          userwebauthn.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -29,6 +30,8 @@ type Menu struct {
 	MenuCode string `json:"menu_code,omitempty"`
 	// 菜单名称 / Menu name
 	MenuName string `json:"menu_name,omitempty"`
+	// 菜单名称多语言，键为语言标签 / Menu name translations keyed by locale
+	MenuNameI18n map[string]string `json:"menu_name_i18n,omitempty"`
 	// 父菜单ID / Parent menu ID
 	ParentID *uint32 `json:"parent_id,omitempty"`
 	// 菜单路径 / Menu path
@@ -77,9 +80,11 @@ type MenuEdges struct {
 	Children []*Menu `json:"children,omitempty"`
 	// Roles holds the value of the roles edge.
 	Roles []*Role `json:"roles,omitempty"`
+	// Apis holds the value of the apis edge.
+	Apis []*API `json:"apis,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -111,11 +116,22 @@ func (e MenuEdges) RolesOrErr() ([]*Role, error) {
 	return nil, &NotLoadedError{edge: "roles"}
 }
 
+// ApisOrErr returns the Apis value or an error if the edge
+// was not loaded in eager-loading.
+func (e MenuEdges) ApisOrErr() ([]*API, error) {
+	if e.loadedTypes[3] {
+		return e.Apis, nil
+	}
+	return nil, &NotLoadedError{edge: "apis"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Menu) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case menu.FieldMenuNameI18n:
+			values[i] = new([]byte)
 		case menu.FieldState, menu.FieldIsHidden, menu.FieldIsBreadcrumb, menu.FieldIsCache, menu.FieldIsTab, menu.FieldIsAffix:
 			values[i] = new(sql.NullBool)
 		case menu.FieldID, menu.FieldSort, menu.FieldParentID, menu.FieldMenuLevel:
@@ -181,6 +197,14 @@ func (_m *Menu) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MenuName = value.String
 			}
+		case menu.FieldMenuNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field menu_name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MenuNameI18n); err != nil {
+					return fmt.Errorf("unmarshal field menu_name_i18n: %w", err)
+				}
+			}
 		case menu.FieldParentID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
@@ -326,6 +350,11 @@ func (_m *Menu) QueryRoles() *RoleQuery {
 	return NewMenuClient(_m.config).QueryRoles(_m)
 }
 
+// QueryApis queries the "apis" edge of the Menu entity.
+func (_m *Menu) QueryApis() *APIQuery {
+	return NewMenuClient(_m.config).QueryApis(_m)
+}
+
 // Update returns a builder for updating this Menu.
 // Note that you need to call Menu.Unwrap() before calling this method if this Menu
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -367,6 +396,9 @@ func (_m *Menu) String() string {
 	builder.WriteString("menu_name=")
 	builder.WriteString(_m.MenuName)
 	builder.WriteString(", ")
+	builder.WriteString("menu_name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MenuNameI18n))
+	builder.WriteString(", ")
 	if v := _m.ParentID; v != nil {
 		builder.WriteString("parent_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
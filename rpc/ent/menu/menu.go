@@ -26,6 +26,8 @@ const (
 	FieldMenuCode = "menu_code"
 	// FieldMenuName holds the string denoting the menu_name field in the database.
 	FieldMenuName = "menu_name"
+	// FieldMenuNameI18n holds the string denoting the menu_name_i18n field in the database.
+	FieldMenuNameI18n = "menu_name_i18n"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
 	// FieldMenuPath holds the string denoting the menu_path field in the database.
@@ -66,6 +68,8 @@ const (
 	EdgeChildren = "children"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
 	EdgeRoles = "roles"
+	// EdgeApis holds the string denoting the apis edge name in mutations.
+	EdgeApis = "apis"
 	// Table holds the table name of the menu in the database.
 	Table = "sys_menus"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -81,6 +85,11 @@ const (
 	// RolesInverseTable is the table name for the Role entity.
 	// It exists in this package in order to avoid circular dependency with the "role" package.
 	RolesInverseTable = "sys_roles"
+	// ApisTable is the table that holds the apis relation/edge. The primary key declared below.
+	ApisTable = "menu_apis"
+	// ApisInverseTable is the table name for the API entity.
+	// It exists in this package in order to avoid circular dependency with the "api" package.
+	ApisInverseTable = "sys_apis"
 )
 
 // Columns holds all SQL columns for menu fields.
@@ -92,6 +101,7 @@ var Columns = []string{
 	FieldSort,
 	FieldMenuCode,
 	FieldMenuName,
+	FieldMenuNameI18n,
 	FieldParentID,
 	FieldMenuPath,
 	FieldComponent,
@@ -115,6 +125,9 @@ var (
 	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
 	// primary key for the roles relation (M2M).
 	RolesPrimaryKey = []string{"role_id", "menu_id"}
+	// ApisPrimaryKey and ApisColumn2 are the table columns denoting the
+	// primary key for the apis relation (M2M).
+	ApisPrimaryKey = []string{"menu_id", "api_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -323,6 +336,20 @@ func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByApisCount orders the results by apis count.
+func ByApisCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newApisStep(), opts...)
+	}
+}
+
+// ByApis orders the results by apis terms.
+func ByApis(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApisStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -344,3 +371,10 @@ func newRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
 	)
 }
+func newApisStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApisInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ApisTable, ApisPrimaryKey...),
+	)
+}
@@ -440,6 +440,16 @@ func MenuNameContainsFold(v string) predicate.Menu {
 	return predicate.Menu(sql.FieldContainsFold(FieldMenuName, v))
 }
 
+// MenuNameI18nIsNil applies the IsNil predicate on the "menu_name_i18n" field.
+func MenuNameI18nIsNil() predicate.Menu {
+	return predicate.Menu(sql.FieldIsNull(FieldMenuNameI18n))
+}
+
+// MenuNameI18nNotNil applies the NotNil predicate on the "menu_name_i18n" field.
+func MenuNameI18nNotNil() predicate.Menu {
+	return predicate.Menu(sql.FieldNotNull(FieldMenuNameI18n))
+}
+
 // ParentIDEQ applies the EQ predicate on the "parent_id" field.
 func ParentIDEQ(v uint32) predicate.Menu {
 	return predicate.Menu(sql.FieldEQ(FieldParentID, v))
@@ -1419,6 +1429,29 @@ func HasRolesWith(preds ...predicate.Role) predicate.Menu {
 	})
 }
 
+// HasApis applies the HasEdge predicate on the "apis" edge.
+func HasApis() predicate.Menu {
+	return predicate.Menu(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ApisTable, ApisPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApisWith applies the HasEdge predicate on the "apis" edge with a given conditions (other predicates).
+func HasApisWith(preds ...predicate.API) predicate.Menu {
+	return predicate.Menu(func(s *sql.Selector) {
+		step := newApisStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Menu) predicate.Menu {
 	return predicate.Menu(sql.AndPredicates(predicates...))
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/api"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 )
@@ -89,6 +90,12 @@ func (_c *MenuCreate) SetMenuName(v string) *MenuCreate {
 	return _c
 }
 
+// SetMenuNameI18n sets the "menu_name_i18n" field.
+func (_c *MenuCreate) SetMenuNameI18n(v map[string]string) *MenuCreate {
+	_c.mutation.SetMenuNameI18n(v)
+	return _c
+}
+
 // SetParentID sets the "parent_id" field.
 func (_c *MenuCreate) SetParentID(v uint32) *MenuCreate {
 	_c.mutation.SetParentID(v)
@@ -360,6 +367,21 @@ func (_c *MenuCreate) AddRoles(v ...*Role) *MenuCreate {
 	return _c.AddRoleIDs(ids...)
 }
 
+// AddAPIIDs adds the "apis" edge to the API entity by IDs.
+func (_c *MenuCreate) AddAPIIDs(ids ...uint32) *MenuCreate {
+	_c.mutation.AddAPIIDs(ids...)
+	return _c
+}
+
+// AddApis adds the "apis" edges to the API entity.
+func (_c *MenuCreate) AddApis(v ...*API) *MenuCreate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIIDs(ids...)
+}
+
 // Mutation returns the MenuMutation object of the builder.
 func (_c *MenuCreate) Mutation() *MenuMutation {
 	return _c.mutation
@@ -556,6 +578,10 @@ func (_c *MenuCreate) createSpec() (*Menu, *sqlgraph.CreateSpec) {
 		_spec.SetField(menu.FieldMenuName, field.TypeString, value)
 		_node.MenuName = value
 	}
+	if value, ok := _c.mutation.MenuNameI18n(); ok {
+		_spec.SetField(menu.FieldMenuNameI18n, field.TypeJSON, value)
+		_node.MenuNameI18n = value
+	}
 	if value, ok := _c.mutation.MenuPath(); ok {
 		_spec.SetField(menu.FieldMenuPath, field.TypeString, value)
 		_node.MenuPath = &value
@@ -669,6 +695,22 @@ func (_c *MenuCreate) createSpec() (*Menu, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ApisIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/api"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
@@ -104,6 +105,18 @@ func (_u *MenuUpdate) SetNillableMenuName(v *string) *MenuUpdate {
 	return _u
 }
 
+// SetMenuNameI18n sets the "menu_name_i18n" field.
+func (_u *MenuUpdate) SetMenuNameI18n(v map[string]string) *MenuUpdate {
+	_u.mutation.SetMenuNameI18n(v)
+	return _u
+}
+
+// ClearMenuNameI18n clears the value of the "menu_name_i18n" field.
+func (_u *MenuUpdate) ClearMenuNameI18n() *MenuUpdate {
+	_u.mutation.ClearMenuNameI18n()
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *MenuUpdate) SetParentID(v uint32) *MenuUpdate {
 	_u.mutation.SetParentID(v)
@@ -474,6 +487,21 @@ func (_u *MenuUpdate) AddRoles(v ...*Role) *MenuUpdate {
 	return _u.AddRoleIDs(ids...)
 }
 
+// AddAPIIDs adds the "apis" edge to the API entity by IDs.
+func (_u *MenuUpdate) AddAPIIDs(ids ...uint32) *MenuUpdate {
+	_u.mutation.AddAPIIDs(ids...)
+	return _u
+}
+
+// AddApis adds the "apis" edges to the API entity.
+func (_u *MenuUpdate) AddApis(v ...*API) *MenuUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIIDs(ids...)
+}
+
 // Mutation returns the MenuMutation object of the builder.
 func (_u *MenuUpdate) Mutation() *MenuMutation {
 	return _u.mutation
@@ -527,6 +555,27 @@ func (_u *MenuUpdate) RemoveRoles(v ...*Role) *MenuUpdate {
 	return _u.RemoveRoleIDs(ids...)
 }
 
+// ClearApis clears all "apis" edges to the API entity.
+func (_u *MenuUpdate) ClearApis() *MenuUpdate {
+	_u.mutation.ClearApis()
+	return _u
+}
+
+// RemoveAPIIDs removes the "apis" edge to API entities by IDs.
+func (_u *MenuUpdate) RemoveAPIIDs(ids ...uint32) *MenuUpdate {
+	_u.mutation.RemoveAPIIDs(ids...)
+	return _u
+}
+
+// RemoveApis removes "apis" edges to API entities.
+func (_u *MenuUpdate) RemoveApis(v ...*API) *MenuUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MenuUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -656,6 +705,12 @@ func (_u *MenuUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.MenuName(); ok {
 		_spec.SetField(menu.FieldMenuName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MenuNameI18n(); ok {
+		_spec.SetField(menu.FieldMenuNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.MenuNameI18nCleared() {
+		_spec.ClearField(menu.FieldMenuNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.MenuPath(); ok {
 		_spec.SetField(menu.FieldMenuPath, field.TypeString, value)
 	}
@@ -868,6 +923,51 @@ func (_u *MenuUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ApisCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedApisIDs(); len(nodes) > 0 && !_u.mutation.ApisCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApisIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{menu.Label}
@@ -963,6 +1063,18 @@ func (_u *MenuUpdateOne) SetNillableMenuName(v *string) *MenuUpdateOne {
 	return _u
 }
 
+// SetMenuNameI18n sets the "menu_name_i18n" field.
+func (_u *MenuUpdateOne) SetMenuNameI18n(v map[string]string) *MenuUpdateOne {
+	_u.mutation.SetMenuNameI18n(v)
+	return _u
+}
+
+// ClearMenuNameI18n clears the value of the "menu_name_i18n" field.
+func (_u *MenuUpdateOne) ClearMenuNameI18n() *MenuUpdateOne {
+	_u.mutation.ClearMenuNameI18n()
+	return _u
+}
+
 // SetParentID sets the "parent_id" field.
 func (_u *MenuUpdateOne) SetParentID(v uint32) *MenuUpdateOne {
 	_u.mutation.SetParentID(v)
@@ -1333,6 +1445,21 @@ func (_u *MenuUpdateOne) AddRoles(v ...*Role) *MenuUpdateOne {
 	return _u.AddRoleIDs(ids...)
 }
 
+// AddAPIIDs adds the "apis" edge to the API entity by IDs.
+func (_u *MenuUpdateOne) AddAPIIDs(ids ...uint32) *MenuUpdateOne {
+	_u.mutation.AddAPIIDs(ids...)
+	return _u
+}
+
+// AddApis adds the "apis" edges to the API entity.
+func (_u *MenuUpdateOne) AddApis(v ...*API) *MenuUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIIDs(ids...)
+}
+
 // Mutation returns the MenuMutation object of the builder.
 func (_u *MenuUpdateOne) Mutation() *MenuMutation {
 	return _u.mutation
@@ -1386,6 +1513,27 @@ func (_u *MenuUpdateOne) RemoveRoles(v ...*Role) *MenuUpdateOne {
 	return _u.RemoveRoleIDs(ids...)
 }
 
+// ClearApis clears all "apis" edges to the API entity.
+func (_u *MenuUpdateOne) ClearApis() *MenuUpdateOne {
+	_u.mutation.ClearApis()
+	return _u
+}
+
+// RemoveAPIIDs removes the "apis" edge to API entities by IDs.
+func (_u *MenuUpdateOne) RemoveAPIIDs(ids ...uint32) *MenuUpdateOne {
+	_u.mutation.RemoveAPIIDs(ids...)
+	return _u
+}
+
+// RemoveApis removes "apis" edges to API entities.
+func (_u *MenuUpdateOne) RemoveApis(v ...*API) *MenuUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIIDs(ids...)
+}
+
 // Where appends a list predicates to the MenuUpdate builder.
 func (_u *MenuUpdateOne) Where(ps ...predicate.Menu) *MenuUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1545,6 +1693,12 @@ func (_u *MenuUpdateOne) sqlSave(ctx context.Context) (_node *Menu, err error) {
 	if value, ok := _u.mutation.MenuName(); ok {
 		_spec.SetField(menu.FieldMenuName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MenuNameI18n(); ok {
+		_spec.SetField(menu.FieldMenuNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.MenuNameI18nCleared() {
+		_spec.ClearField(menu.FieldMenuNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.MenuPath(); ok {
 		_spec.SetField(menu.FieldMenuPath, field.TypeString, value)
 	}
@@ -1757,6 +1911,51 @@ func (_u *MenuUpdateOne) sqlSave(ctx context.Context) (_node *Menu, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ApisCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedApisIDs(); len(nodes) > 0 && !_u.mutation.ApisCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApisIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   menu.ApisTable,
+			Columns: menu.ApisPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(api.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Menu{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -36,6 +36,50 @@ var (
 			},
 		},
 	}
+	// SysAPIKeysColumns holds the columns for the "sys_api_keys" table.
+	SysAPIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "名称 / Name"},
+		{Name: "key_prefix", Type: field.TypeString, Size: 20, Comment: "密钥前缀，用于展示识别 / Key prefix for display"},
+		{Name: "key_hash", Type: field.TypeString, Size: 64, Comment: "密钥哈希(SHA-256) / Key hash (SHA-256)"},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true, Comment: "授权的角色编码，为所属用户角色的子集 / Granted role codes, a subset of the owner's roles"},
+		{Name: "allowed_ips", Type: field.TypeJSON, Nullable: true, Comment: "允许访问的IP或CIDR，为空不限制 / Allowed IPs or CIDRs, empty means unrestricted"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空永不过期 / Expiration time, never expires if empty"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最后使用时间 / Last used time"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "最后使用IP / Last used IP"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "描述 / Description"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "所属用户ID / Owner user ID"},
+	}
+	// SysAPIKeysTable holds the schema information for the "sys_api_keys" table.
+	SysAPIKeysTable = &schema.Table{
+		Name:       "sys_api_keys",
+		Comment:    "API密钥表 / API key table",
+		Columns:    SysAPIKeysColumns,
+		PrimaryKey: []*schema.Column{SysAPIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_api_keys_sys_users_api_keys",
+				Columns:    []*schema.Column{SysAPIKeysColumns[13]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sys_api_keys_key_hash_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SysAPIKeysColumns[6]},
+			},
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysAPIKeysColumns[13]},
+			},
+		},
+	}
 	// SysConfigurationColumns holds the columns for the "sys_configuration" table.
 	SysConfigurationColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -69,10 +113,13 @@ var (
 		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
 		{Name: "sort", Type: field.TypeInt32, Comment: "排序 / Sort", Default: 0},
 		{Name: "dept_name", Type: field.TypeString, Size: 100, Comment: "部门名称 / Department name"},
+		{Name: "dept_name_i18n", Type: field.TypeJSON, Nullable: true, Comment: "部门名称多语言，键为语言标签 / Department name translations keyed by locale"},
 		{Name: "dept_code", Type: field.TypeString, Size: 50, Comment: "部门编码 / Department code"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "部门描述 / Department description"},
+		{Name: "inherit_roles", Type: field.TypeBool, Comment: "下级部门是否继承本部门角色 / Whether sub-departments inherit roles of this department", Default: false},
 		{Name: "parent_id", Type: field.TypeUint32, Nullable: true, Comment: "父部门ID / Parent department ID"},
 		{Name: "leader_user_id", Type: field.TypeUUID, Nullable: true, Comment: "部门负责人用户ID / Leader user ID"},
+		{Name: "notification_departments", Type: field.TypeUint32, Nullable: true},
 	}
 	// SysDepartmentsTable holds the schema information for the "sys_departments" table.
 	SysDepartmentsTable = &schema.Table{
@@ -83,27 +130,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_departments_sys_departments_children",
-				Columns:    []*schema.Column{SysDepartmentsColumns[9]},
+				Columns:    []*schema.Column{SysDepartmentsColumns[11]},
 				RefColumns: []*schema.Column{SysDepartmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "sys_departments_sys_users_leader",
-				Columns:    []*schema.Column{SysDepartmentsColumns[10]},
+				Columns:    []*schema.Column{SysDepartmentsColumns[12]},
 				RefColumns: []*schema.Column{SysUsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "sys_departments_sys_notifications_departments",
+				Columns:    []*schema.Column{SysDepartmentsColumns[13]},
+				RefColumns: []*schema.Column{SysNotificationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "sys_departments_code_unique",
 				Unique:  true,
-				Columns: []*schema.Column{SysDepartmentsColumns[7]},
+				Columns: []*schema.Column{SysDepartmentsColumns[8]},
 			},
 			{
 				Name:    "department_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysDepartmentsColumns[9]},
+				Columns: []*schema.Column{SysDepartmentsColumns[11]},
 			},
 		},
 	}
@@ -115,6 +168,7 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间 / Deleted time"},
 		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
 		{Name: "item_label", Type: field.TypeString, Size: 100, Comment: "字典项标签 / Dictionary item label"},
+		{Name: "item_label_i18n", Type: field.TypeJSON, Nullable: true, Comment: "字典项标签多语言，键为语言标签 / Dictionary item label translations keyed by locale"},
 		{Name: "item_value", Type: field.TypeString, Size: 100, Comment: "字典项值 / Dictionary item value"},
 		{Name: "item_color", Type: field.TypeString, Nullable: true, Size: 20, Comment: "字典项颜色 / Dictionary item color"},
 		{Name: "item_css", Type: field.TypeString, Nullable: true, Size: 100, Comment: "字典项CSS / Dictionary item CSS"},
@@ -131,7 +185,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_dict_items_sys_dict_types_dict_type",
-				Columns:    []*schema.Column{SysDictItemsColumns[11]},
+				Columns:    []*schema.Column{SysDictItemsColumns[12]},
 				RefColumns: []*schema.Column{SysDictTypesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -140,12 +194,12 @@ var (
 			{
 				Name:    "sys_dict_items_type_value_unique",
 				Unique:  true,
-				Columns: []*schema.Column{SysDictItemsColumns[11], SysDictItemsColumns[6]},
+				Columns: []*schema.Column{SysDictItemsColumns[12], SysDictItemsColumns[7]},
 			},
 			{
 				Name:    "dictitem_dict_type_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysDictItemsColumns[11]},
+				Columns: []*schema.Column{SysDictItemsColumns[12]},
 			},
 		},
 	}
@@ -159,6 +213,7 @@ var (
 		{Name: "dict_type_code", Type: field.TypeString, Size: 100, Comment: "字典类型编码 / Dictionary type code"},
 		{Name: "dict_type_name", Type: field.TypeString, Size: 100, Comment: "字典类型名称 / Dictionary type name"},
 		{Name: "description", Type: field.TypeString, Size: 2147483647, Comment: "字典类型描述 / Dictionary type description", Default: ""},
+		{Name: "is_public", Type: field.TypeBool, Comment: "是否公开，公开字典允许匿名读取 / Whether anonymous users can read the dictionary", Default: false},
 	}
 	// SysDictTypesTable holds the schema information for the "sys_dict_types" table.
 	SysDictTypesTable = &schema.Table{
@@ -174,6 +229,46 @@ var (
 			},
 		},
 	}
+	// SysFilesColumns holds the columns for the "sys_files" table.
+	SysFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Comment: "文件ID / File ID"},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "原始文件名 / Original file name"},
+		{Name: "category", Type: field.TypeString, Size: 50, Comment: "文件分类 / File category (attachment, avatar)", Default: "attachment"},
+		{Name: "size", Type: field.TypeInt64, Comment: "文件大小(字节) / File size (bytes)", Default: 0},
+		{Name: "mime_type", Type: field.TypeString, Size: 127, Comment: "文件类型 / MIME type"},
+		{Name: "checksum", Type: field.TypeString, Size: 64, Comment: "文件 SHA-256 / File SHA-256"},
+		{Name: "storage_key", Type: field.TypeString, Unique: true, Size: 255, Comment: "存储键 / Storage key"},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true, Comment: "上传用户ID / Owner user ID"},
+	}
+	// SysFilesTable holds the schema information for the "sys_files" table.
+	SysFilesTable = &schema.Table{
+		Name:       "sys_files",
+		Comment:    "文件表 / File table",
+		Columns:    SysFilesColumns,
+		PrimaryKey: []*schema.Column{SysFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_files_sys_users_files",
+				Columns:    []*schema.Column{SysFilesColumns[9]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "file_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysFilesColumns[9]},
+			},
+			{
+				Name:    "file_category",
+				Unique:  false,
+				Columns: []*schema.Column{SysFilesColumns[4]},
+			},
+		},
+	}
 	// SysMenusColumns holds the columns for the "sys_menus" table.
 	SysMenusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
@@ -183,6 +278,7 @@ var (
 		{Name: "sort", Type: field.TypeInt32, Comment: "排序 / Sort", Default: 0},
 		{Name: "menu_code", Type: field.TypeString, Size: 100, Comment: "菜单编码 / Menu code"},
 		{Name: "menu_name", Type: field.TypeString, Size: 100, Comment: "菜单名称 / Menu name"},
+		{Name: "menu_name_i18n", Type: field.TypeJSON, Nullable: true, Comment: "菜单名称多语言，键为语言标签 / Menu name translations keyed by locale"},
 		{Name: "menu_path", Type: field.TypeString, Nullable: true, Size: 255, Comment: "菜单路径 / Menu path"},
 		{Name: "component", Type: field.TypeString, Nullable: true, Size: 100, Comment: "前端组件 / Frontend component"},
 		{Name: "redirect", Type: field.TypeString, Nullable: true, Size: 255, Comment: "重定向地址 / Redirect path"},
@@ -210,7 +306,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sys_menus_sys_menus_children",
-				Columns:    []*schema.Column{SysMenusColumns[23]},
+				Columns:    []*schema.Column{SysMenusColumns[24]},
 				RefColumns: []*schema.Column{SysMenusColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -223,6 +319,145 @@ var (
 			},
 		},
 	}
+	// SysNotificationsColumns holds the columns for the "sys_notifications" table.
+	SysNotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
+		{Name: "title", Type: field.TypeString, Size: 200, Comment: "标题 / Title"},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "内容 / Content"},
+		{Name: "category", Type: field.TypeString, Size: 20, Comment: "分类 / Category (announcement, notice, security)", Default: "announcement"},
+		{Name: "level", Type: field.TypeString, Size: 20, Comment: "级别 / Level (info, warning, critical)", Default: "info"},
+		{Name: "target_all", Type: field.TypeBool, Comment: "是否发送给所有用户 / Whether targeted at all users", Default: false},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true, Comment: "发布时间，为空立即发布 / Publish time, published immediately if empty"},
+		{Name: "expire_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空永不过期 / Expiration time, never expires if empty"},
+		{Name: "sender_id", Type: field.TypeUUID, Nullable: true, Comment: "发送人ID，系统通知为空 / Sender ID, empty for system notifications"},
+	}
+	// SysNotificationsTable holds the schema information for the "sys_notifications" table.
+	SysNotificationsTable = &schema.Table{
+		Name:       "sys_notifications",
+		Comment:    "通知公告表 / Notification table",
+		Columns:    SysNotificationsColumns,
+		PrimaryKey: []*schema.Column{SysNotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysNotificationsColumns[9]},
+			},
+			{
+				Name:    "notification_category",
+				Unique:  false,
+				Columns: []*schema.Column{SysNotificationsColumns[6]},
+			},
+		},
+	}
+	// SysNotificationRecipientsColumns holds the columns for the "sys_notification_recipients" table.
+	SysNotificationRecipientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "status", Type: field.TypeString, Size: 20, Comment: "阅读状态 / Status (unread, read, archived)", Default: "unread"},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true, Comment: "阅读时间 / Read time"},
+		{Name: "notification_id", Type: field.TypeUint32, Comment: "通知ID / Notification ID"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "用户ID / User ID"},
+	}
+	// SysNotificationRecipientsTable holds the schema information for the "sys_notification_recipients" table.
+	SysNotificationRecipientsTable = &schema.Table{
+		Name:       "sys_notification_recipients",
+		Comment:    "通知接收状态表，未读且未操作过的通知不产生记录 / Notification recipient status table",
+		Columns:    SysNotificationRecipientsColumns,
+		PrimaryKey: []*schema.Column{SysNotificationRecipientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_notification_recipients_sys_notifications_recipients",
+				Columns:    []*schema.Column{SysNotificationRecipientsColumns[5]},
+				RefColumns: []*schema.Column{SysNotificationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "sys_notification_recipients_sys_users_notification_recipients",
+				Columns:    []*schema.Column{SysNotificationRecipientsColumns[6]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationrecipient_notification_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysNotificationRecipientsColumns[5], SysNotificationRecipientsColumns[6]},
+			},
+			{
+				Name:    "notificationrecipient_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysNotificationRecipientsColumns[6], SysNotificationRecipientsColumns[3]},
+			},
+		},
+	}
+	// SysOauthClientsColumns holds the columns for the "sys_oauth_clients" table.
+	SysOauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
+		{Name: "client_id", Type: field.TypeString, Size: 64, Comment: "客户端ID / Client ID"},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true, Size: 255, Comment: "客户端密钥哈希，公共客户端为空 / Client secret hash, empty for public clients"},
+		{Name: "name", Type: field.TypeString, Size: 100, Comment: "应用名称 / Application name"},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true, Comment: "回调地址 / Redirect URIs"},
+		{Name: "grant_types", Type: field.TypeJSON, Nullable: true, Comment: "授权类型 / Grant types"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "允许的权限范围 / Allowed scopes"},
+		{Name: "public", Type: field.TypeBool, Comment: "是否公共客户端，公共客户端必须使用PKCE / Public client, PKCE required", Default: false},
+		{Name: "skip_consent", Type: field.TypeBool, Comment: "是否跳过授权确认，仅用于受信任的内部应用 / Skip consent for trusted internal apps", Default: false},
+		{Name: "logo", Type: field.TypeString, Nullable: true, Size: 500, Comment: "应用图标 / Application logo"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "描述 / Description"},
+	}
+	// SysOauthClientsTable holds the schema information for the "sys_oauth_clients" table.
+	SysOauthClientsTable = &schema.Table{
+		Name:       "sys_oauth_clients",
+		Comment:    "OAuth客户端应用表 / OAuth client application table",
+		Columns:    SysOauthClientsColumns,
+		PrimaryKey: []*schema.Column{SysOauthClientsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sys_oauth_clients_client_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthClientsColumns[4]},
+			},
+		},
+	}
+	// SysOauthConsentsColumns holds the columns for the "sys_oauth_consents" table.
+	SysOauthConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "client_id", Type: field.TypeString, Size: 64, Comment: "客户端ID / Client ID"},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true, Comment: "已授权的权限范围 / Granted scopes"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "用户ID / User ID"},
+	}
+	// SysOauthConsentsTable holds the schema information for the "sys_oauth_consents" table.
+	SysOauthConsentsTable = &schema.Table{
+		Name:       "sys_oauth_consents",
+		Comment:    "OAuth授权记录表 / OAuth consent table",
+		Columns:    SysOauthConsentsColumns,
+		PrimaryKey: []*schema.Column{SysOauthConsentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_oauth_consents_sys_users_oauth_consents",
+				Columns:    []*schema.Column{SysOauthConsentsColumns[5]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sys_oauth_consents_user_client_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SysOauthConsentsColumns[5], SysOauthConsentsColumns[3]},
+			},
+		},
+	}
 	// SysOauthProvidersColumns holds the columns for the "sys_oauth_providers" table.
 	SysOauthProvidersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
@@ -314,6 +549,60 @@ var (
 			},
 		},
 	}
+	// SysOperationLogArchivesColumns holds the columns for the "sys_operation_log_archives" table.
+	SysOperationLogArchivesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "module", Type: field.TypeString, Size: 50, Comment: "操作模块 / Operation module"},
+		{Name: "start_time", Type: field.TypeTime, Comment: "归档记录的最早创建时间 / Earliest created time of archived rows"},
+		{Name: "end_time", Type: field.TypeTime, Comment: "归档记录的最晚创建时间 / Latest created time of archived rows"},
+		{Name: "start_id", Type: field.TypeUint32, Comment: "归档记录的最小ID / Smallest archived row ID"},
+		{Name: "end_id", Type: field.TypeUint32, Comment: "归档记录的最大ID / Largest archived row ID"},
+		{Name: "row_count", Type: field.TypeInt64, Comment: "归档记录数 / Number of archived rows", Default: 0},
+		{Name: "size", Type: field.TypeInt64, Comment: "归档文件大小(字节) / Archive file size (bytes)", Default: 0},
+		{Name: "checksum", Type: field.TypeString, Size: 64, Comment: "归档文件 SHA-256 / Archive file SHA-256"},
+		{Name: "storage_key", Type: field.TypeString, Unique: true, Size: 255, Comment: "存储键 / Storage key"},
+		{Name: "restored_at", Type: field.TypeTime, Nullable: true, Comment: "恢复时间，下次执行保留策略时恢复的记录将再次移除 / Restored time, restored rows are removed again on the next retention run"},
+	}
+	// SysOperationLogArchivesTable holds the schema information for the "sys_operation_log_archives" table.
+	SysOperationLogArchivesTable = &schema.Table{
+		Name:       "sys_operation_log_archives",
+		Comment:    "操作记录归档表 / Operation log archive table",
+		Columns:    SysOperationLogArchivesColumns,
+		PrimaryKey: []*schema.Column{SysOperationLogArchivesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "operationlogarchive_module_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{SysOperationLogArchivesColumns[3], SysOperationLogArchivesColumns[4]},
+			},
+		},
+	}
+	// SysPermissionSnapshotsColumns holds the columns for the "sys_permission_snapshots" table.
+	SysPermissionSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Size: 255, Comment: "备注，如审查周期 / Remark, e.g. review period"},
+		{Name: "creator_id", Type: field.TypeUUID, Nullable: true, Comment: "创建人ID / Creator user ID"},
+		{Name: "entry_count", Type: field.TypeInt64, Comment: "授权记录数 / Number of entries", Default: 0},
+		{Name: "entries", Type: field.TypeJSON, Nullable: true, Comment: "全部角色的授权及持有用户 / Permissions and holders of all roles"},
+	}
+	// SysPermissionSnapshotsTable holds the schema information for the "sys_permission_snapshots" table.
+	SysPermissionSnapshotsTable = &schema.Table{
+		Name:       "sys_permission_snapshots",
+		Comment:    "权限快照表 / Permission snapshot table",
+		Columns:    SysPermissionSnapshotsColumns,
+		PrimaryKey: []*schema.Column{SysPermissionSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "permissionsnapshot_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysPermissionSnapshotsColumns[1]},
+			},
+		},
+	}
 	// SysPositionsColumns holds the columns for the "sys_positions" table.
 	SysPositionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
@@ -323,6 +612,7 @@ var (
 		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
 		{Name: "sort", Type: field.TypeInt32, Comment: "排序 / Sort", Default: 0},
 		{Name: "position_name", Type: field.TypeString, Size: 100, Comment: "职位名称 / Position name"},
+		{Name: "position_name_i18n", Type: field.TypeJSON, Nullable: true, Comment: "职位名称多语言，键为语言标签 / Position name translations keyed by locale"},
 		{Name: "position_code", Type: field.TypeString, Size: 50, Comment: "职位编码 / Position code"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "职位描述 / Position description"},
 	}
@@ -336,7 +626,7 @@ var (
 			{
 				Name:    "sys_positions_code_unique",
 				Unique:  true,
-				Columns: []*schema.Column{SysPositionsColumns[7]},
+				Columns: []*schema.Column{SysPositionsColumns[8]},
 			},
 		},
 	}
@@ -350,12 +640,21 @@ var (
 		{Name: "role_name", Type: field.TypeString, Size: 50},
 		{Name: "role_code", Type: field.TypeString, Size: 50},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "notification_roles", Type: field.TypeUint32, Nullable: true},
 	}
 	// SysRolesTable holds the schema information for the "sys_roles" table.
 	SysRolesTable = &schema.Table{
 		Name:       "sys_roles",
 		Columns:    SysRolesColumns,
 		PrimaryKey: []*schema.Column{SysRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_roles_sys_notifications_roles",
+				Columns:    []*schema.Column{SysRolesColumns[8]},
+				RefColumns: []*schema.Column{SysNotificationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "role_role_code",
@@ -364,6 +663,72 @@ var (
 			},
 		},
 	}
+	// SysScheduledJobsColumns holds the columns for the "sys_scheduled_jobs" table.
+	SysScheduledJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50, Comment: "任务名称 / Job name"},
+		{Name: "paused", Type: field.TypeBool, Comment: "是否暂停定时执行 / Whether scheduled runs are paused", Default: false},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true, Comment: "最后执行时间 / Last run time"},
+		{Name: "last_status", Type: field.TypeString, Nullable: true, Size: 20, Comment: "最后执行状态 / Last run status (running, success, failed)"},
+		{Name: "last_trigger", Type: field.TypeString, Nullable: true, Size: 20, Comment: "最后触发方式 / Last trigger (schedule, manual)"},
+		{Name: "last_duration", Type: field.TypeInt64, Comment: "最后执行耗时(毫秒) / Last run duration (milliseconds)", Default: 0},
+		{Name: "last_message", Type: field.TypeString, Nullable: true, Size: 1000, Comment: "最后执行结果或错误信息 / Last run result or error message"},
+	}
+	// SysScheduledJobsTable holds the schema information for the "sys_scheduled_jobs" table.
+	SysScheduledJobsTable = &schema.Table{
+		Name:       "sys_scheduled_jobs",
+		Comment:    "定时任务表 / Scheduled job table",
+		Columns:    SysScheduledJobsColumns,
+		PrimaryKey: []*schema.Column{SysScheduledJobsColumns[0]},
+	}
+	// SysSecurityEventsColumns holds the columns for the "sys_security_events" table.
+	SysSecurityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "username", Type: field.TypeString, Nullable: true, Size: 50, Comment: "用户名，登录失败时为尝试的用户名，用户删除后仍保留 / Username"},
+		{Name: "event_type", Type: field.TypeString, Size: 32, Comment: "事件类型 / Event type (LOGIN, MFA_CHALLENGE, PASSWORD_CHANGE, MFA_ENABLE, MFA_DISABLE, TOKEN_REVOKE, OAUTH_BIND, OAUTH_UNBIND)"},
+		{Name: "success", Type: field.TypeBool, Comment: "是否成功 / Whether successful", Default: true},
+		{Name: "provider", Type: field.TypeString, Nullable: true, Size: 50, Comment: "认证方式或第三方提供商 / Authentication method or provider"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 255, Comment: "原因，失败时为错误信息 / Reason"},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45, Comment: "IP地址 / IP address"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 500, Comment: "用户代理 / User agent"},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true, Comment: "用户ID，登录失败且用户不存在或用户已删除时为空 / User ID"},
+	}
+	// SysSecurityEventsTable holds the schema information for the "sys_security_events" table.
+	SysSecurityEventsTable = &schema.Table{
+		Name:       "sys_security_events",
+		Comment:    "安全事件表 / Security event table",
+		Columns:    SysSecurityEventsColumns,
+		PrimaryKey: []*schema.Column{SysSecurityEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_security_events_sys_users_security_events",
+				Columns:    []*schema.Column{SysSecurityEventsColumns[10]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysSecurityEventsColumns[10], SysSecurityEventsColumns[1]},
+			},
+			{
+				Name:    "securityevent_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{SysSecurityEventsColumns[4]},
+			},
+			{
+				Name:    "securityevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysSecurityEventsColumns[1]},
+			},
+		},
+	}
 	// SysTokensColumns holds the columns for the "sys_tokens" table.
 	SysTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
@@ -445,6 +810,10 @@ var (
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "最后登录时间 / Last login time"},
 		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Size: 45, Comment: "最后登录IP / Last login IP"},
 		{Name: "home_path", Type: field.TypeString, Nullable: true, Size: 255, Comment: "首页路径 / Home path"},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true, Comment: "密码修改时间 / Password changed time"},
+		{Name: "must_change_password", Type: field.TypeBool, Comment: "是否必须修改密码 / Whether password change is required", Default: false},
+		{Name: "language", Type: field.TypeString, Nullable: true, Size: 20, Comment: "偏好语言 / Preferred language"},
+		{Name: "notification_users", Type: field.TypeUint32, Nullable: true},
 		{Name: "department_id", Type: field.TypeUint32, Nullable: true, Comment: "部门ID / Department ID"},
 	}
 	// SysUsersTable holds the schema information for the "sys_users" table.
@@ -454,9 +823,15 @@ var (
 		Columns:    SysUsersColumns,
 		PrimaryKey: []*schema.Column{SysUsersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_users_sys_notifications_users",
+				Columns:    []*schema.Column{SysUsersColumns[17]},
+				RefColumns: []*schema.Column{SysNotificationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "sys_users_sys_departments_department",
-				Columns:    []*schema.Column{SysUsersColumns[14]},
+				Columns:    []*schema.Column{SysUsersColumns[18]},
 				RefColumns: []*schema.Column{SysDepartmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -470,7 +845,37 @@ var (
 			{
 				Name:    "user_department_id",
 				Unique:  false,
-				Columns: []*schema.Column{SysUsersColumns[14]},
+				Columns: []*schema.Column{SysUsersColumns[18]},
+			},
+		},
+	}
+	// SysUserPasswordHistoryColumns holds the columns for the "sys_user_password_history" table.
+	SysUserPasswordHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "password_hash", Type: field.TypeString, Size: 255, Comment: "密码哈希 / Password hash"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "用户ID / User ID"},
+	}
+	// SysUserPasswordHistoryTable holds the schema information for the "sys_user_password_history" table.
+	SysUserPasswordHistoryTable = &schema.Table{
+		Name:       "sys_user_password_history",
+		Comment:    "用户密码历史表 / User password history table",
+		Columns:    SysUserPasswordHistoryColumns,
+		PrimaryKey: []*schema.Column{SysUserPasswordHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_user_password_history_sys_users_password_histories",
+				Columns:    []*schema.Column{SysUserPasswordHistoryColumns[4]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userpasswordhistory_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserPasswordHistoryColumns[4]},
 			},
 		},
 	}
@@ -526,6 +931,79 @@ var (
 			},
 		},
 	}
+	// SysUserWebauthnColumns holds the columns for the "sys_user_webauthn" table.
+	SysUserWebauthnColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间 / Creation time"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间 / Update time"},
+		{Name: "state", Type: field.TypeBool, Nullable: true, Comment: "状态 / State", Default: true},
+		{Name: "credential_id", Type: field.TypeString, Size: 1024, Comment: "凭证ID(Base64URL) / Credential ID (Base64URL)"},
+		{Name: "public_key", Type: field.TypeBytes, Comment: "凭证公钥(COSE) / Credential public key (COSE)"},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true, Size: 50, Comment: "证明格式 / Attestation format"},
+		{Name: "aaguid", Type: field.TypeString, Nullable: true, Size: 64, Comment: "认证器型号标识 / Authenticator AAGUID"},
+		{Name: "sign_count", Type: field.TypeUint32, Comment: "签名计数器 / Signature counter", Default: 0},
+		{Name: "clone_warning", Type: field.TypeBool, Comment: "克隆告警 / Clone warning", Default: false},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true, Comment: "传输方式 / Transports"},
+		{Name: "attachment", Type: field.TypeString, Nullable: true, Size: 50, Comment: "认证器连接方式 / Authenticator attachment"},
+		{Name: "user_verified", Type: field.TypeBool, Comment: "是否经过用户验证 / Whether user verified", Default: false},
+		{Name: "backup_eligible", Type: field.TypeBool, Comment: "是否可备份 / Whether backup eligible", Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Comment: "是否已备份 / Whether backed up", Default: false},
+		{Name: "friendly_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "凭证名称 / Friendly name"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最后使用时间 / Last used time"},
+		{Name: "user_id", Type: field.TypeUUID, Comment: "用户ID / User ID"},
+	}
+	// SysUserWebauthnTable holds the schema information for the "sys_user_webauthn" table.
+	SysUserWebauthnTable = &schema.Table{
+		Name:       "sys_user_webauthn",
+		Comment:    "用户WebAuthn凭证表 / User WebAuthn credential table",
+		Columns:    SysUserWebauthnColumns,
+		PrimaryKey: []*schema.Column{SysUserWebauthnColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sys_user_webauthn_sys_users_webauthn_credentials",
+				Columns:    []*schema.Column{SysUserWebauthnColumns[17]},
+				RefColumns: []*schema.Column{SysUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sys_user_webauthn_credential_id_unique",
+				Unique:  true,
+				Columns: []*schema.Column{SysUserWebauthnColumns[4]},
+			},
+			{
+				Name:    "userwebauthn_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserWebauthnColumns[17]},
+			},
+		},
+	}
+	// MenuApisColumns holds the columns for the "menu_apis" table.
+	MenuApisColumns = []*schema.Column{
+		{Name: "menu_id", Type: field.TypeUint32},
+		{Name: "api_id", Type: field.TypeUint32},
+	}
+	// MenuApisTable holds the schema information for the "menu_apis" table.
+	MenuApisTable = &schema.Table{
+		Name:       "menu_apis",
+		Columns:    MenuApisColumns,
+		PrimaryKey: []*schema.Column{MenuApisColumns[0], MenuApisColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "menu_apis_menu_id",
+				Columns:    []*schema.Column{MenuApisColumns[0]},
+				RefColumns: []*schema.Column{SysMenusColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "menu_apis_api_id",
+				Columns:    []*schema.Column{MenuApisColumns[1]},
+				RefColumns: []*schema.Column{SysApisColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// PositionUsersColumns holds the columns for the "position_users" table.
 	PositionUsersColumns = []*schema.Column{
 		{Name: "position_id", Type: field.TypeUint32},
@@ -601,24 +1079,115 @@ var (
 			},
 		},
 	}
+	// RolePositionsColumns holds the columns for the "role_positions" table.
+	RolePositionsColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeUint32},
+		{Name: "position_id", Type: field.TypeUint32},
+	}
+	// RolePositionsTable holds the schema information for the "role_positions" table.
+	RolePositionsTable = &schema.Table{
+		Name:       "role_positions",
+		Columns:    RolePositionsColumns,
+		PrimaryKey: []*schema.Column{RolePositionsColumns[0], RolePositionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_positions_role_id",
+				Columns:    []*schema.Column{RolePositionsColumns[0]},
+				RefColumns: []*schema.Column{SysRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_positions_position_id",
+				Columns:    []*schema.Column{RolePositionsColumns[1]},
+				RefColumns: []*schema.Column{SysPositionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RoleDepartmentsColumns holds the columns for the "role_departments" table.
+	RoleDepartmentsColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeUint32},
+		{Name: "department_id", Type: field.TypeUint32},
+	}
+	// RoleDepartmentsTable holds the schema information for the "role_departments" table.
+	RoleDepartmentsTable = &schema.Table{
+		Name:       "role_departments",
+		Columns:    RoleDepartmentsColumns,
+		PrimaryKey: []*schema.Column{RoleDepartmentsColumns[0], RoleDepartmentsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_departments_role_id",
+				Columns:    []*schema.Column{RoleDepartmentsColumns[0]},
+				RefColumns: []*schema.Column{SysRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_departments_department_id",
+				Columns:    []*schema.Column{RoleDepartmentsColumns[1]},
+				RefColumns: []*schema.Column{SysDepartmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RoleApisColumns holds the columns for the "role_apis" table.
+	RoleApisColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeUint32},
+		{Name: "api_id", Type: field.TypeUint32},
+	}
+	// RoleApisTable holds the schema information for the "role_apis" table.
+	RoleApisTable = &schema.Table{
+		Name:       "role_apis",
+		Columns:    RoleApisColumns,
+		PrimaryKey: []*schema.Column{RoleApisColumns[0], RoleApisColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_apis_role_id",
+				Columns:    []*schema.Column{RoleApisColumns[0]},
+				RefColumns: []*schema.Column{SysRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_apis_api_id",
+				Columns:    []*schema.Column{RoleApisColumns[1]},
+				RefColumns: []*schema.Column{SysApisColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		SysApisTable,
+		SysAPIKeysTable,
 		SysConfigurationTable,
 		SysDepartmentsTable,
 		SysDictItemsTable,
 		SysDictTypesTable,
+		SysFilesTable,
 		SysMenusTable,
+		SysNotificationsTable,
+		SysNotificationRecipientsTable,
+		SysOauthClientsTable,
+		SysOauthConsentsTable,
 		SysOauthProvidersTable,
 		SysOperationLogsTable,
+		SysOperationLogArchivesTable,
+		SysPermissionSnapshotsTable,
 		SysPositionsTable,
 		SysRolesTable,
+		SysScheduledJobsTable,
+		SysSecurityEventsTable,
 		SysTokensTable,
 		SysUsersTable,
+		SysUserPasswordHistoryTable,
 		SysUserTotpTable,
+		SysUserWebauthnTable,
+		MenuApisTable,
 		PositionUsersTable,
 		RoleMenusTable,
 		RoleUsersTable,
+		RolePositionsTable,
+		RoleDepartmentsTable,
+		RoleApisTable,
 	}
 )
 
@@ -626,11 +1195,16 @@ func init() {
 	SysApisTable.Annotation = &entsql.Annotation{
 		Table: "sys_apis",
 	}
+	SysAPIKeysTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysAPIKeysTable.Annotation = &entsql.Annotation{
+		Table: "sys_api_keys",
+	}
 	SysConfigurationTable.Annotation = &entsql.Annotation{
 		Table: "sys_configuration",
 	}
 	SysDepartmentsTable.ForeignKeys[0].RefTable = SysDepartmentsTable
 	SysDepartmentsTable.ForeignKeys[1].RefTable = SysUsersTable
+	SysDepartmentsTable.ForeignKeys[2].RefTable = SysNotificationsTable
 	SysDepartmentsTable.Annotation = &entsql.Annotation{
 		Table: "sys_departments",
 	}
@@ -641,10 +1215,29 @@ func init() {
 	SysDictTypesTable.Annotation = &entsql.Annotation{
 		Table: "sys_dict_types",
 	}
+	SysFilesTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysFilesTable.Annotation = &entsql.Annotation{
+		Table: "sys_files",
+	}
 	SysMenusTable.ForeignKeys[0].RefTable = SysMenusTable
 	SysMenusTable.Annotation = &entsql.Annotation{
 		Table: "sys_menus",
 	}
+	SysNotificationsTable.Annotation = &entsql.Annotation{
+		Table: "sys_notifications",
+	}
+	SysNotificationRecipientsTable.ForeignKeys[0].RefTable = SysNotificationsTable
+	SysNotificationRecipientsTable.ForeignKeys[1].RefTable = SysUsersTable
+	SysNotificationRecipientsTable.Annotation = &entsql.Annotation{
+		Table: "sys_notification_recipients",
+	}
+	SysOauthClientsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_clients",
+	}
+	SysOauthConsentsTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysOauthConsentsTable.Annotation = &entsql.Annotation{
+		Table: "sys_oauth_consents",
+	}
 	SysOauthProvidersTable.Annotation = &entsql.Annotation{
 		Table: "sys_oauth_providers",
 	}
@@ -652,29 +1245,60 @@ func init() {
 	SysOperationLogsTable.Annotation = &entsql.Annotation{
 		Table: "sys_operation_logs",
 	}
+	SysOperationLogArchivesTable.Annotation = &entsql.Annotation{
+		Table: "sys_operation_log_archives",
+	}
+	SysPermissionSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "sys_permission_snapshots",
+	}
 	SysPositionsTable.Annotation = &entsql.Annotation{
 		Table: "sys_positions",
 	}
+	SysRolesTable.ForeignKeys[0].RefTable = SysNotificationsTable
 	SysRolesTable.Annotation = &entsql.Annotation{
 		Table: "sys_roles",
 	}
+	SysScheduledJobsTable.Annotation = &entsql.Annotation{
+		Table: "sys_scheduled_jobs",
+	}
+	SysSecurityEventsTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysSecurityEventsTable.Annotation = &entsql.Annotation{
+		Table: "sys_security_events",
+	}
 	SysTokensTable.ForeignKeys[0].RefTable = SysUsersTable
 	SysTokensTable.ForeignKeys[1].RefTable = SysOauthProvidersTable
 	SysTokensTable.Annotation = &entsql.Annotation{
 		Table: "sys_tokens",
 	}
-	SysUsersTable.ForeignKeys[0].RefTable = SysDepartmentsTable
+	SysUsersTable.ForeignKeys[0].RefTable = SysNotificationsTable
+	SysUsersTable.ForeignKeys[1].RefTable = SysDepartmentsTable
 	SysUsersTable.Annotation = &entsql.Annotation{
 		Table: "sys_users",
 	}
+	SysUserPasswordHistoryTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysUserPasswordHistoryTable.Annotation = &entsql.Annotation{
+		Table: "sys_user_password_history",
+	}
 	SysUserTotpTable.ForeignKeys[0].RefTable = SysUsersTable
 	SysUserTotpTable.Annotation = &entsql.Annotation{
 		Table: "sys_user_totp",
 	}
+	SysUserWebauthnTable.ForeignKeys[0].RefTable = SysUsersTable
+	SysUserWebauthnTable.Annotation = &entsql.Annotation{
+		Table: "sys_user_webauthn",
+	}
+	MenuApisTable.ForeignKeys[0].RefTable = SysMenusTable
+	MenuApisTable.ForeignKeys[1].RefTable = SysApisTable
 	PositionUsersTable.ForeignKeys[0].RefTable = SysPositionsTable
 	PositionUsersTable.ForeignKeys[1].RefTable = SysUsersTable
 	RoleMenusTable.ForeignKeys[0].RefTable = SysRolesTable
 	RoleMenusTable.ForeignKeys[1].RefTable = SysMenusTable
 	RoleUsersTable.ForeignKeys[0].RefTable = SysRolesTable
 	RoleUsersTable.ForeignKeys[1].RefTable = SysUsersTable
+	RolePositionsTable.ForeignKeys[0].RefTable = SysRolesTable
+	RolePositionsTable.ForeignKeys[1].RefTable = SysPositionsTable
+	RoleDepartmentsTable.ForeignKeys[0].RefTable = SysRolesTable
+	RoleDepartmentsTable.ForeignKeys[1].RefTable = SysDepartmentsTable
+	RoleApisTable.ForeignKeys[0].RefTable = SysRolesTable
+	RoleApisTable.ForeignKeys[1].RefTable = SysApisTable
 }
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+)
+
+// 通知接收状态表，未读且未操作过的通知不产生记录 / Notification recipient status table
+type NotificationRecipient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 通知ID / Notification ID
+	NotificationID uint32 `json:"notification_id,omitempty"`
+	// 用户ID / User ID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// 阅读状态 / Status (unread, read, archived)
+	Status string `json:"status,omitempty"`
+	// 阅读时间 / Read time
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationRecipientQuery when eager-loading is set.
+	Edges        NotificationRecipientEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationRecipientEdges holds the relations/edges for other nodes in the graph.
+type NotificationRecipientEdges struct {
+	// Notification holds the value of the notification edge.
+	Notification *Notification `json:"notification,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NotificationOrErr returns the Notification value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationRecipientEdges) NotificationOrErr() (*Notification, error) {
+	if e.Notification != nil {
+		return e.Notification, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: notification.Label}
+	}
+	return nil, &NotLoadedError{edge: "notification"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationRecipientEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationRecipient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationrecipient.FieldID, notificationrecipient.FieldNotificationID:
+			values[i] = new(sql.NullInt64)
+		case notificationrecipient.FieldStatus:
+			values[i] = new(sql.NullString)
+		case notificationrecipient.FieldCreatedAt, notificationrecipient.FieldUpdatedAt, notificationrecipient.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		case notificationrecipient.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationRecipient fields.
+func (_m *NotificationRecipient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationrecipient.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case notificationrecipient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case notificationrecipient.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case notificationrecipient.FieldNotificationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_id", values[i])
+			} else if value.Valid {
+				_m.NotificationID = uint32(value.Int64)
+			}
+		case notificationrecipient.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case notificationrecipient.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case notificationrecipient.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				_m.ReadAt = new(time.Time)
+				*_m.ReadAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationRecipient.
+// This includes values selected through modifiers, order, etc.
+func (_m *NotificationRecipient) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryNotification queries the "notification" edge of the NotificationRecipient entity.
+func (_m *NotificationRecipient) QueryNotification() *NotificationQuery {
+	return NewNotificationRecipientClient(_m.config).QueryNotification(_m)
+}
+
+// QueryUser queries the "user" edge of the NotificationRecipient entity.
+func (_m *NotificationRecipient) QueryUser() *UserQuery {
+	return NewNotificationRecipientClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this NotificationRecipient.
+// Note that you need to call NotificationRecipient.Unwrap() before calling this method if this NotificationRecipient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NotificationRecipient) Update() *NotificationRecipientUpdateOne {
+	return NewNotificationRecipientClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NotificationRecipient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NotificationRecipient) Unwrap() *NotificationRecipient {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationRecipient is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NotificationRecipient) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationRecipient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("notification_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotificationID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationRecipients is a parsable slice of NotificationRecipient.
+type NotificationRecipients []*NotificationRecipient
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+)
+
+// NotificationRecipientUpdate is the builder for updating NotificationRecipient entities.
+type NotificationRecipientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationRecipientMutation
+}
+
+// Where appends a list predicates to the NotificationRecipientUpdate builder.
+func (_u *NotificationRecipientUpdate) Where(ps ...predicate.NotificationRecipient) *NotificationRecipientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationRecipientUpdate) SetUpdatedAt(v time.Time) *NotificationRecipientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNotificationID sets the "notification_id" field.
+func (_u *NotificationRecipientUpdate) SetNotificationID(v uint32) *NotificationRecipientUpdate {
+	_u.mutation.SetNotificationID(v)
+	return _u
+}
+
+// SetNillableNotificationID sets the "notification_id" field if the given value is not nil.
+func (_u *NotificationRecipientUpdate) SetNillableNotificationID(v *uint32) *NotificationRecipientUpdate {
+	if v != nil {
+		_u.SetNotificationID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationRecipientUpdate) SetUserID(v uuid.UUID) *NotificationRecipientUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationRecipientUpdate) SetNillableUserID(v *uuid.UUID) *NotificationRecipientUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationRecipientUpdate) SetStatus(v string) *NotificationRecipientUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationRecipientUpdate) SetNillableStatus(v *string) *NotificationRecipientUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationRecipientUpdate) SetReadAt(v time.Time) *NotificationRecipientUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationRecipientUpdate) SetNillableReadAt(v *time.Time) *NotificationRecipientUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationRecipientUpdate) ClearReadAt() *NotificationRecipientUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetNotification sets the "notification" edge to the Notification entity.
+func (_u *NotificationRecipientUpdate) SetNotification(v *Notification) *NotificationRecipientUpdate {
+	return _u.SetNotificationID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationRecipientUpdate) SetUser(v *User) *NotificationRecipientUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationRecipientMutation object of the builder.
+func (_u *NotificationRecipientUpdate) Mutation() *NotificationRecipientMutation {
+	return _u.mutation
+}
+
+// ClearNotification clears the "notification" edge to the Notification entity.
+func (_u *NotificationRecipientUpdate) ClearNotification() *NotificationRecipientUpdate {
+	_u.mutation.ClearNotification()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationRecipientUpdate) ClearUser() *NotificationRecipientUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationRecipientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationRecipientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationRecipientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationRecipientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationRecipientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationrecipient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationRecipientUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationrecipient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationRecipient.status": %w`, err)}
+		}
+	}
+	if _u.mutation.NotificationCleared() && len(_u.mutation.NotificationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationRecipient.notification"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationRecipient.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationRecipientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationrecipient.Table, notificationrecipient.Columns, sqlgraph.NewFieldSpec(notificationrecipient.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrecipient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationrecipient.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notificationrecipient.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notificationrecipient.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.NotificationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.NotificationTable,
+			Columns: []string{notificationrecipient.NotificationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.NotificationTable,
+			Columns: []string{notificationrecipient.NotificationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.UserTable,
+			Columns: []string{notificationrecipient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.UserTable,
+			Columns: []string{notificationrecipient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrecipient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationRecipientUpdateOne is the builder for updating a single NotificationRecipient entity.
+type NotificationRecipientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationRecipientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotificationRecipientUpdateOne) SetUpdatedAt(v time.Time) *NotificationRecipientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNotificationID sets the "notification_id" field.
+func (_u *NotificationRecipientUpdateOne) SetNotificationID(v uint32) *NotificationRecipientUpdateOne {
+	_u.mutation.SetNotificationID(v)
+	return _u
+}
+
+// SetNillableNotificationID sets the "notification_id" field if the given value is not nil.
+func (_u *NotificationRecipientUpdateOne) SetNillableNotificationID(v *uint32) *NotificationRecipientUpdateOne {
+	if v != nil {
+		_u.SetNotificationID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationRecipientUpdateOne) SetUserID(v uuid.UUID) *NotificationRecipientUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationRecipientUpdateOne) SetNillableUserID(v *uuid.UUID) *NotificationRecipientUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *NotificationRecipientUpdateOne) SetStatus(v string) *NotificationRecipientUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *NotificationRecipientUpdateOne) SetNillableStatus(v *string) *NotificationRecipientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationRecipientUpdateOne) SetReadAt(v time.Time) *NotificationRecipientUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationRecipientUpdateOne) SetNillableReadAt(v *time.Time) *NotificationRecipientUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationRecipientUpdateOne) ClearReadAt() *NotificationRecipientUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetNotification sets the "notification" edge to the Notification entity.
+func (_u *NotificationRecipientUpdateOne) SetNotification(v *Notification) *NotificationRecipientUpdateOne {
+	return _u.SetNotificationID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *NotificationRecipientUpdateOne) SetUser(v *User) *NotificationRecipientUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationRecipientMutation object of the builder.
+func (_u *NotificationRecipientUpdateOne) Mutation() *NotificationRecipientMutation {
+	return _u.mutation
+}
+
+// ClearNotification clears the "notification" edge to the Notification entity.
+func (_u *NotificationRecipientUpdateOne) ClearNotification() *NotificationRecipientUpdateOne {
+	_u.mutation.ClearNotification()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *NotificationRecipientUpdateOne) ClearUser() *NotificationRecipientUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the NotificationRecipientUpdate builder.
+func (_u *NotificationRecipientUpdateOne) Where(ps ...predicate.NotificationRecipient) *NotificationRecipientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationRecipientUpdateOne) Select(field string, fields ...string) *NotificationRecipientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NotificationRecipient entity.
+func (_u *NotificationRecipientUpdateOne) Save(ctx context.Context) (*NotificationRecipient, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationRecipientUpdateOne) SaveX(ctx context.Context) *NotificationRecipient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationRecipientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationRecipientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationRecipientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notificationrecipient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationRecipientUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := notificationrecipient.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationRecipient.status": %w`, err)}
+		}
+	}
+	if _u.mutation.NotificationCleared() && len(_u.mutation.NotificationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationRecipient.notification"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationRecipient.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationRecipientUpdateOne) sqlSave(ctx context.Context) (_node *NotificationRecipient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationrecipient.Table, notificationrecipient.Columns, sqlgraph.NewFieldSpec(notificationrecipient.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationRecipient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationrecipient.FieldID)
+		for _, f := range fields {
+			if !notificationrecipient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationrecipient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrecipient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(notificationrecipient.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notificationrecipient.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notificationrecipient.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.NotificationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.NotificationTable,
+			Columns: []string{notificationrecipient.NotificationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.NotificationTable,
+			Columns: []string{notificationrecipient.NotificationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.UserTable,
+			Columns: []string{notificationrecipient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationrecipient.UserTable,
+			Columns: []string{notificationrecipient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationRecipient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrecipient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
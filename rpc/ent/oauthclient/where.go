@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthclient
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldState, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecretHash applies equality check predicate on the "client_secret_hash" field. It's identical to ClientSecretHashEQ.
+func ClientSecretHash(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldName, v))
+}
+
+// Public applies equality check predicate on the "public" field. It's identical to PublicEQ.
+func Public(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldPublic, v))
+}
+
+// SkipConsent applies equality check predicate on the "skip_consent" field. It's identical to SkipConsentEQ.
+func SkipConsent(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldSkipConsent, v))
+}
+
+// Logo applies equality check predicate on the "logo" field. It's identical to LogoEQ.
+func Logo(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldLogo, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldState))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretHashEQ applies the EQ predicate on the "client_secret_hash" field.
+func ClientSecretHashEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashNEQ applies the NEQ predicate on the "client_secret_hash" field.
+func ClientSecretHashNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIn applies the In predicate on the "client_secret_hash" field.
+func ClientSecretHashIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashNotIn applies the NotIn predicate on the "client_secret_hash" field.
+func ClientSecretHashNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashGT applies the GT predicate on the "client_secret_hash" field.
+func ClientSecretHashGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashGTE applies the GTE predicate on the "client_secret_hash" field.
+func ClientSecretHashGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLT applies the LT predicate on the "client_secret_hash" field.
+func ClientSecretHashLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLTE applies the LTE predicate on the "client_secret_hash" field.
+func ClientSecretHashLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContains applies the Contains predicate on the "client_secret_hash" field.
+func ClientSecretHashContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasPrefix applies the HasPrefix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasSuffix applies the HasSuffix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIsNil applies the IsNil predicate on the "client_secret_hash" field.
+func ClientSecretHashIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashNotNil applies the NotNil predicate on the "client_secret_hash" field.
+func ClientSecretHashNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashEqualFold applies the EqualFold predicate on the "client_secret_hash" field.
+func ClientSecretHashEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContainsFold applies the ContainsFold predicate on the "client_secret_hash" field.
+func ClientSecretHashContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldClientSecretHash, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldName, v))
+}
+
+// RedirectUrisIsNil applies the IsNil predicate on the "redirect_uris" field.
+func RedirectUrisIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldRedirectUris))
+}
+
+// RedirectUrisNotNil applies the NotNil predicate on the "redirect_uris" field.
+func RedirectUrisNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldRedirectUris))
+}
+
+// GrantTypesIsNil applies the IsNil predicate on the "grant_types" field.
+func GrantTypesIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldGrantTypes))
+}
+
+// GrantTypesNotNil applies the NotNil predicate on the "grant_types" field.
+func GrantTypesNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldGrantTypes))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldScopes))
+}
+
+// PublicEQ applies the EQ predicate on the "public" field.
+func PublicEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldPublic, v))
+}
+
+// PublicNEQ applies the NEQ predicate on the "public" field.
+func PublicNEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldPublic, v))
+}
+
+// SkipConsentEQ applies the EQ predicate on the "skip_consent" field.
+func SkipConsentEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldSkipConsent, v))
+}
+
+// SkipConsentNEQ applies the NEQ predicate on the "skip_consent" field.
+func SkipConsentNEQ(v bool) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldSkipConsent, v))
+}
+
+// LogoEQ applies the EQ predicate on the "logo" field.
+func LogoEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldLogo, v))
+}
+
+// LogoNEQ applies the NEQ predicate on the "logo" field.
+func LogoNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldLogo, v))
+}
+
+// LogoIn applies the In predicate on the "logo" field.
+func LogoIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldLogo, vs...))
+}
+
+// LogoNotIn applies the NotIn predicate on the "logo" field.
+func LogoNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldLogo, vs...))
+}
+
+// LogoGT applies the GT predicate on the "logo" field.
+func LogoGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldLogo, v))
+}
+
+// LogoGTE applies the GTE predicate on the "logo" field.
+func LogoGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldLogo, v))
+}
+
+// LogoLT applies the LT predicate on the "logo" field.
+func LogoLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldLogo, v))
+}
+
+// LogoLTE applies the LTE predicate on the "logo" field.
+func LogoLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldLogo, v))
+}
+
+// LogoContains applies the Contains predicate on the "logo" field.
+func LogoContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldLogo, v))
+}
+
+// LogoHasPrefix applies the HasPrefix predicate on the "logo" field.
+func LogoHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldLogo, v))
+}
+
+// LogoHasSuffix applies the HasSuffix predicate on the "logo" field.
+func LogoHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldLogo, v))
+}
+
+// LogoIsNil applies the IsNil predicate on the "logo" field.
+func LogoIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldLogo))
+}
+
+// LogoNotNil applies the NotNil predicate on the "logo" field.
+func LogoNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldLogo))
+}
+
+// LogoEqualFold applies the EqualFold predicate on the "logo" field.
+func LogoEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldLogo, v))
+}
+
+// LogoContainsFold applies the ContainsFold predicate on the "logo" field.
+func LogoContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldLogo, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.OauthClient {
+	return predicate.OauthClient(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OauthClient) predicate.OauthClient {
+	return predicate.OauthClient(sql.NotPredicates(p))
+}
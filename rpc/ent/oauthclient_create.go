@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+)
+
+// OauthClientCreate is the builder for creating a OauthClient entity.
+type OauthClientCreate struct {
+	config
+	mutation *OauthClientMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OauthClientCreate) SetCreatedAt(v time.Time) *OauthClientCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableCreatedAt(v *time.Time) *OauthClientCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OauthClientCreate) SetUpdatedAt(v time.Time) *OauthClientCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableUpdatedAt(v *time.Time) *OauthClientCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *OauthClientCreate) SetState(v bool) *OauthClientCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableState(v *bool) *OauthClientCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OauthClientCreate) SetClientID(v string) *OauthClientCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_c *OauthClientCreate) SetClientSecretHash(v string) *OauthClientCreate {
+	_c.mutation.SetClientSecretHash(v)
+	return _c
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableClientSecretHash(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetClientSecretHash(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *OauthClientCreate) SetName(v string) *OauthClientCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *OauthClientCreate) SetRedirectUris(v []string) *OauthClientCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_c *OauthClientCreate) SetGrantTypes(v []string) *OauthClientCreate {
+	_c.mutation.SetGrantTypes(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OauthClientCreate) SetScopes(v []string) *OauthClientCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetPublic sets the "public" field.
+func (_c *OauthClientCreate) SetPublic(v bool) *OauthClientCreate {
+	_c.mutation.SetPublic(v)
+	return _c
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillablePublic(v *bool) *OauthClientCreate {
+	if v != nil {
+		_c.SetPublic(*v)
+	}
+	return _c
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_c *OauthClientCreate) SetSkipConsent(v bool) *OauthClientCreate {
+	_c.mutation.SetSkipConsent(v)
+	return _c
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableSkipConsent(v *bool) *OauthClientCreate {
+	if v != nil {
+		_c.SetSkipConsent(*v)
+	}
+	return _c
+}
+
+// SetLogo sets the "logo" field.
+func (_c *OauthClientCreate) SetLogo(v string) *OauthClientCreate {
+	_c.mutation.SetLogo(v)
+	return _c
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableLogo(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetLogo(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *OauthClientCreate) SetDescription(v string) *OauthClientCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *OauthClientCreate) SetNillableDescription(v *string) *OauthClientCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OauthClientCreate) SetID(v uint32) *OauthClientCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_c *OauthClientCreate) Mutation() *OauthClientMutation {
+	return _c.mutation
+}
+
+// Save creates the OauthClient in the database.
+func (_c *OauthClientCreate) Save(ctx context.Context) (*OauthClient, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OauthClientCreate) SaveX(ctx context.Context) *OauthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthClientCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthClientCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OauthClientCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthclient.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauthclient.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := oauthclient.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		v := oauthclient.DefaultPublic
+		_c.mutation.SetPublic(v)
+	}
+	if _, ok := _c.mutation.SkipConsent(); !ok {
+		v := oauthclient.DefaultSkipConsent
+		_c.mutation.SetSkipConsent(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OauthClientCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OauthClient.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OauthClient.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OauthClient.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OauthClient.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "OauthClient.public"`)}
+	}
+	if _, ok := _c.mutation.SkipConsent(); !ok {
+		return &ValidationError{Name: "skip_consent", err: errors.New(`ent: missing required field "OauthClient.skip_consent"`)}
+	}
+	if v, ok := _c.mutation.Logo(); ok {
+		if err := oauthclient.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "OauthClient.logo": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := oauthclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "OauthClient.description": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oauthclient.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OauthClient.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OauthClientCreate) sqlSave(ctx context.Context) (*OauthClient, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OauthClientCreate) createSpec() (*OauthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthClient{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(oauthclient.FieldState, field.TypeBool, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+		_node.GrantTypes = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Public(); ok {
+		_spec.SetField(oauthclient.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := _c.mutation.SkipConsent(); ok {
+		_spec.SetField(oauthclient.FieldSkipConsent, field.TypeBool, value)
+		_node.SkipConsent = value
+	}
+	if value, ok := _c.mutation.Logo(); ok {
+		_spec.SetField(oauthclient.FieldLogo, field.TypeString, value)
+		_node.Logo = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	return _node, _spec
+}
+
+// OauthClientCreateBulk is the builder for creating many OauthClient entities in bulk.
+type OauthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OauthClientCreate
+}
+
+// Save creates the OauthClient entities in the database.
+func (_c *OauthClientCreateBulk) Save(ctx context.Context) ([]*OauthClient, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OauthClient, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OauthClientCreateBulk) SaveX(ctx context.Context) []*OauthClient {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OauthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OauthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,753 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// OauthClientUpdate is the builder for updating OauthClient entities.
+type OauthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (_u *OauthClientUpdate) Where(ps ...predicate.OauthClient) *OauthClientUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthClientUpdate) SetUpdatedAt(v time.Time) *OauthClientUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *OauthClientUpdate) SetState(v bool) *OauthClientUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableState(v *bool) *OauthClientUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *OauthClientUpdate) ClearState() *OauthClientUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *OauthClientUpdate) SetClientSecretHash(v string) *OauthClientUpdate {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableClientSecretHash(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *OauthClientUpdate) ClearClientSecretHash() *OauthClientUpdate {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthClientUpdate) SetName(v string) *OauthClientUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableName(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OauthClientUpdate) SetRedirectUris(v []string) *OauthClientUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OauthClientUpdate) AppendRedirectUris(v []string) *OauthClientUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OauthClientUpdate) ClearRedirectUris() *OauthClientUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OauthClientUpdate) SetGrantTypes(v []string) *OauthClientUpdate {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OauthClientUpdate) AppendGrantTypes(v []string) *OauthClientUpdate {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (_u *OauthClientUpdate) ClearGrantTypes() *OauthClientUpdate {
+	_u.mutation.ClearGrantTypes()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthClientUpdate) SetScopes(v []string) *OauthClientUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthClientUpdate) AppendScopes(v []string) *OauthClientUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthClientUpdate) ClearScopes() *OauthClientUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *OauthClientUpdate) SetPublic(v bool) *OauthClientUpdate {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillablePublic(v *bool) *OauthClientUpdate {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_u *OauthClientUpdate) SetSkipConsent(v bool) *OauthClientUpdate {
+	_u.mutation.SetSkipConsent(v)
+	return _u
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableSkipConsent(v *bool) *OauthClientUpdate {
+	if v != nil {
+		_u.SetSkipConsent(*v)
+	}
+	return _u
+}
+
+// SetLogo sets the "logo" field.
+func (_u *OauthClientUpdate) SetLogo(v string) *OauthClientUpdate {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableLogo(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetLogo(*v)
+	}
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *OauthClientUpdate) ClearLogo() *OauthClientUpdate {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OauthClientUpdate) SetDescription(v string) *OauthClientUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OauthClientUpdate) SetNillableDescription(v *string) *OauthClientUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OauthClientUpdate) ClearDescription() *OauthClientUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_u *OauthClientUpdate) Mutation() *OauthClientMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OauthClientUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OauthClientUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthClientUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthClientUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthClientUpdate) check() error {
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Logo(); ok {
+		if err := oauthclient.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "OauthClient.logo": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauthclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "OauthClient.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OauthClientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(oauthclient.FieldState, field.TypeBool, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(oauthclient.FieldState, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if _u.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauthclient.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(oauthclient.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SkipConsent(); ok {
+		_spec.SetField(oauthclient.FieldSkipConsent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(oauthclient.FieldLogo, field.TypeString, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(oauthclient.FieldLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OauthClientUpdateOne is the builder for updating a single OauthClient entity.
+type OauthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OauthClientUpdateOne) SetUpdatedAt(v time.Time) *OauthClientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *OauthClientUpdateOne) SetState(v bool) *OauthClientUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableState(v *bool) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *OauthClientUpdateOne) ClearState() *OauthClientUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *OauthClientUpdateOne) SetClientSecretHash(v string) *OauthClientUpdateOne {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableClientSecretHash(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *OauthClientUpdateOne) ClearClientSecretHash() *OauthClientUpdateOne {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OauthClientUpdateOne) SetName(v string) *OauthClientUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableName(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) SetRedirectUris(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) AppendRedirectUris(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *OauthClientUpdateOne) ClearRedirectUris() *OauthClientUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (_u *OauthClientUpdateOne) SetGrantTypes(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetGrantTypes(v)
+	return _u
+}
+
+// AppendGrantTypes appends value to the "grant_types" field.
+func (_u *OauthClientUpdateOne) AppendGrantTypes(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendGrantTypes(v)
+	return _u
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (_u *OauthClientUpdateOne) ClearGrantTypes() *OauthClientUpdateOne {
+	_u.mutation.ClearGrantTypes()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OauthClientUpdateOne) SetScopes(v []string) *OauthClientUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OauthClientUpdateOne) AppendScopes(v []string) *OauthClientUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *OauthClientUpdateOne) ClearScopes() *OauthClientUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *OauthClientUpdateOne) SetPublic(v bool) *OauthClientUpdateOne {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillablePublic(v *bool) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetSkipConsent sets the "skip_consent" field.
+func (_u *OauthClientUpdateOne) SetSkipConsent(v bool) *OauthClientUpdateOne {
+	_u.mutation.SetSkipConsent(v)
+	return _u
+}
+
+// SetNillableSkipConsent sets the "skip_consent" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableSkipConsent(v *bool) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetSkipConsent(*v)
+	}
+	return _u
+}
+
+// SetLogo sets the "logo" field.
+func (_u *OauthClientUpdateOne) SetLogo(v string) *OauthClientUpdateOne {
+	_u.mutation.SetLogo(v)
+	return _u
+}
+
+// SetNillableLogo sets the "logo" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableLogo(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetLogo(*v)
+	}
+	return _u
+}
+
+// ClearLogo clears the value of the "logo" field.
+func (_u *OauthClientUpdateOne) ClearLogo() *OauthClientUpdateOne {
+	_u.mutation.ClearLogo()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OauthClientUpdateOne) SetDescription(v string) *OauthClientUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OauthClientUpdateOne) SetNillableDescription(v *string) *OauthClientUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OauthClientUpdateOne) ClearDescription() *OauthClientUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (_u *OauthClientUpdateOne) Mutation() *OauthClientMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (_u *OauthClientUpdateOne) Where(ps ...predicate.OauthClient) *OauthClientUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OauthClientUpdateOne) Select(field string, fields ...string) *OauthClientUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OauthClient entity.
+func (_u *OauthClientUpdateOne) Save(ctx context.Context) (*OauthClient, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OauthClientUpdateOne) SaveX(ctx context.Context) *OauthClient {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OauthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OauthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OauthClientUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OauthClientUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientSecretHash(); ok {
+		if err := oauthclient.ClientSecretHashValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret_hash", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_secret_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Logo(); ok {
+		if err := oauthclient.LogoValidator(v); err != nil {
+			return &ValidationError{Name: "logo", err: fmt.Errorf(`ent: validator failed for field "OauthClient.logo": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := oauthclient.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "OauthClient.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OauthClientUpdateOne) sqlSave(ctx context.Context) (_node *OauthClient, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(oauthclient.FieldState, field.TypeBool, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(oauthclient.FieldState, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(oauthclient.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(oauthclient.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if _u.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauthclient.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthclient.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(oauthclient.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(oauthclient.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SkipConsent(); ok {
+		_spec.SetField(oauthclient.FieldSkipConsent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Logo(); ok {
+		_spec.SetField(oauthclient.FieldLogo, field.TypeString, value)
+	}
+	if _u.mutation.LogoCleared() {
+		_spec.ClearField(oauthclient.FieldLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oauthclient.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oauthclient.FieldDescription, field.TypeString)
+	}
+	_node = &OauthClient{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
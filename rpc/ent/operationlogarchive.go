@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+)
+
+// 操作记录归档表 / Operation log archive table
+type OperationLogArchive struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 操作模块 / Operation module
+	Module string `json:"module,omitempty"`
+	// 归档记录的最早创建时间 / Earliest created time of archived rows
+	StartTime time.Time `json:"start_time,omitempty"`
+	// 归档记录的最晚创建时间 / Latest created time of archived rows
+	EndTime time.Time `json:"end_time,omitempty"`
+	// 归档记录的最小ID / Smallest archived row ID
+	StartID uint32 `json:"start_id,omitempty"`
+	// 归档记录的最大ID / Largest archived row ID
+	EndID uint32 `json:"end_id,omitempty"`
+	// 归档记录数 / Number of archived rows
+	RowCount int64 `json:"row_count,omitempty"`
+	// 归档文件大小(字节) / Archive file size (bytes)
+	Size int64 `json:"size,omitempty"`
+	// 归档文件 SHA-256 / Archive file SHA-256
+	Checksum string `json:"checksum,omitempty"`
+	// 存储键 / Storage key
+	StorageKey string `json:"storage_key,omitempty"`
+	// 恢复时间，下次执行保留策略时恢复的记录将再次移除 / Restored time, restored rows are removed again on the next retention run
+	RestoredAt   *time.Time `json:"restored_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OperationLogArchive) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case operationlogarchive.FieldID, operationlogarchive.FieldStartID, operationlogarchive.FieldEndID, operationlogarchive.FieldRowCount, operationlogarchive.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case operationlogarchive.FieldModule, operationlogarchive.FieldChecksum, operationlogarchive.FieldStorageKey:
+			values[i] = new(sql.NullString)
+		case operationlogarchive.FieldCreatedAt, operationlogarchive.FieldUpdatedAt, operationlogarchive.FieldStartTime, operationlogarchive.FieldEndTime, operationlogarchive.FieldRestoredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OperationLogArchive fields.
+func (_m *OperationLogArchive) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case operationlogarchive.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case operationlogarchive.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case operationlogarchive.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case operationlogarchive.FieldModule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module", values[i])
+			} else if value.Valid {
+				_m.Module = value.String
+			}
+		case operationlogarchive.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case operationlogarchive.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case operationlogarchive.FieldStartID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_id", values[i])
+			} else if value.Valid {
+				_m.StartID = uint32(value.Int64)
+			}
+		case operationlogarchive.FieldEndID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_id", values[i])
+			} else if value.Valid {
+				_m.EndID = uint32(value.Int64)
+			}
+		case operationlogarchive.FieldRowCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field row_count", values[i])
+			} else if value.Valid {
+				_m.RowCount = value.Int64
+			}
+		case operationlogarchive.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case operationlogarchive.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				_m.Checksum = value.String
+			}
+		case operationlogarchive.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				_m.StorageKey = value.String
+			}
+		case operationlogarchive.FieldRestoredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_at", values[i])
+			} else if value.Valid {
+				_m.RestoredAt = new(time.Time)
+				*_m.RestoredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OperationLogArchive.
+// This includes values selected through modifiers, order, etc.
+func (_m *OperationLogArchive) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OperationLogArchive.
+// Note that you need to call OperationLogArchive.Unwrap() before calling this method if this OperationLogArchive
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OperationLogArchive) Update() *OperationLogArchiveUpdateOne {
+	return NewOperationLogArchiveClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OperationLogArchive entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OperationLogArchive) Unwrap() *OperationLogArchive {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OperationLogArchive is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OperationLogArchive) String() string {
+	var builder strings.Builder
+	builder.WriteString("OperationLogArchive(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("module=")
+	builder.WriteString(_m.Module)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("start_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartID))
+	builder.WriteString(", ")
+	builder.WriteString("end_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndID))
+	builder.WriteString(", ")
+	builder.WriteString("row_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RowCount))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(_m.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(_m.StorageKey)
+	builder.WriteString(", ")
+	if v := _m.RestoredAt; v != nil {
+		builder.WriteString("restored_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OperationLogArchives is a parsable slice of OperationLogArchive.
+type OperationLogArchives []*OperationLogArchive
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package operationlogarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the operationlogarchive type in the database.
+	Label = "operation_log_archive"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldModule holds the string denoting the module field in the database.
+	FieldModule = "module"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldStartID holds the string denoting the start_id field in the database.
+	FieldStartID = "start_id"
+	// FieldEndID holds the string denoting the end_id field in the database.
+	FieldEndID = "end_id"
+	// FieldRowCount holds the string denoting the row_count field in the database.
+	FieldRowCount = "row_count"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldStorageKey holds the string denoting the storage_key field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldRestoredAt holds the string denoting the restored_at field in the database.
+	FieldRestoredAt = "restored_at"
+	// Table holds the table name of the operationlogarchive in the database.
+	Table = "sys_operation_log_archives"
+)
+
+// Columns holds all SQL columns for operationlogarchive fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldModule,
+	FieldStartTime,
+	FieldEndTime,
+	FieldStartID,
+	FieldEndID,
+	FieldRowCount,
+	FieldSize,
+	FieldChecksum,
+	FieldStorageKey,
+	FieldRestoredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ModuleValidator is a validator for the "module" field. It is called by the builders before save.
+	ModuleValidator func(string) error
+	// DefaultRowCount holds the default value on creation for the "row_count" field.
+	DefaultRowCount int64
+	// DefaultSize holds the default value on creation for the "size" field.
+	DefaultSize int64
+	// ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	ChecksumValidator func(string) error
+	// StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	StorageKeyValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the OperationLogArchive queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByModule orders the results by the module field.
+func ByModule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModule, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByStartID orders the results by the start_id field.
+func ByStartID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartID, opts...).ToFunc()
+}
+
+// ByEndID orders the results by the end_id field.
+func ByEndID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndID, opts...).ToFunc()
+}
+
+// ByRowCount orders the results by the row_count field.
+func ByRowCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRowCount, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storage_key field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
+
+// ByRestoredAt orders the results by the restored_at field.
+func ByRestoredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestoredAt, opts...).ToFunc()
+}
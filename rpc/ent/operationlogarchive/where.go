@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package operationlogarchive
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Module applies equality check predicate on the "module" field. It's identical to ModuleEQ.
+func Module(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldModule, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldEndTime, v))
+}
+
+// StartID applies equality check predicate on the "start_id" field. It's identical to StartIDEQ.
+func StartID(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStartID, v))
+}
+
+// EndID applies equality check predicate on the "end_id" field. It's identical to EndIDEQ.
+func EndID(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldEndID, v))
+}
+
+// RowCount applies equality check predicate on the "row_count" field. It's identical to RowCountEQ.
+func RowCount(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldRowCount, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldSize, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldChecksum, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// RestoredAt applies equality check predicate on the "restored_at" field. It's identical to RestoredAtEQ.
+func RestoredAt(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldRestoredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ModuleEQ applies the EQ predicate on the "module" field.
+func ModuleEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldModule, v))
+}
+
+// ModuleNEQ applies the NEQ predicate on the "module" field.
+func ModuleNEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldModule, v))
+}
+
+// ModuleIn applies the In predicate on the "module" field.
+func ModuleIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldModule, vs...))
+}
+
+// ModuleNotIn applies the NotIn predicate on the "module" field.
+func ModuleNotIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldModule, vs...))
+}
+
+// ModuleGT applies the GT predicate on the "module" field.
+func ModuleGT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldModule, v))
+}
+
+// ModuleGTE applies the GTE predicate on the "module" field.
+func ModuleGTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldModule, v))
+}
+
+// ModuleLT applies the LT predicate on the "module" field.
+func ModuleLT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldModule, v))
+}
+
+// ModuleLTE applies the LTE predicate on the "module" field.
+func ModuleLTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldModule, v))
+}
+
+// ModuleContains applies the Contains predicate on the "module" field.
+func ModuleContains(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContains(FieldModule, v))
+}
+
+// ModuleHasPrefix applies the HasPrefix predicate on the "module" field.
+func ModuleHasPrefix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasPrefix(FieldModule, v))
+}
+
+// ModuleHasSuffix applies the HasSuffix predicate on the "module" field.
+func ModuleHasSuffix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasSuffix(FieldModule, v))
+}
+
+// ModuleEqualFold applies the EqualFold predicate on the "module" field.
+func ModuleEqualFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEqualFold(FieldModule, v))
+}
+
+// ModuleContainsFold applies the ContainsFold predicate on the "module" field.
+func ModuleContainsFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContainsFold(FieldModule, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldEndTime, v))
+}
+
+// StartIDEQ applies the EQ predicate on the "start_id" field.
+func StartIDEQ(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStartID, v))
+}
+
+// StartIDNEQ applies the NEQ predicate on the "start_id" field.
+func StartIDNEQ(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldStartID, v))
+}
+
+// StartIDIn applies the In predicate on the "start_id" field.
+func StartIDIn(vs ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldStartID, vs...))
+}
+
+// StartIDNotIn applies the NotIn predicate on the "start_id" field.
+func StartIDNotIn(vs ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldStartID, vs...))
+}
+
+// StartIDGT applies the GT predicate on the "start_id" field.
+func StartIDGT(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldStartID, v))
+}
+
+// StartIDGTE applies the GTE predicate on the "start_id" field.
+func StartIDGTE(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldStartID, v))
+}
+
+// StartIDLT applies the LT predicate on the "start_id" field.
+func StartIDLT(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldStartID, v))
+}
+
+// StartIDLTE applies the LTE predicate on the "start_id" field.
+func StartIDLTE(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldStartID, v))
+}
+
+// EndIDEQ applies the EQ predicate on the "end_id" field.
+func EndIDEQ(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldEndID, v))
+}
+
+// EndIDNEQ applies the NEQ predicate on the "end_id" field.
+func EndIDNEQ(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldEndID, v))
+}
+
+// EndIDIn applies the In predicate on the "end_id" field.
+func EndIDIn(vs ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldEndID, vs...))
+}
+
+// EndIDNotIn applies the NotIn predicate on the "end_id" field.
+func EndIDNotIn(vs ...uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldEndID, vs...))
+}
+
+// EndIDGT applies the GT predicate on the "end_id" field.
+func EndIDGT(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldEndID, v))
+}
+
+// EndIDGTE applies the GTE predicate on the "end_id" field.
+func EndIDGTE(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldEndID, v))
+}
+
+// EndIDLT applies the LT predicate on the "end_id" field.
+func EndIDLT(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldEndID, v))
+}
+
+// EndIDLTE applies the LTE predicate on the "end_id" field.
+func EndIDLTE(v uint32) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldEndID, v))
+}
+
+// RowCountEQ applies the EQ predicate on the "row_count" field.
+func RowCountEQ(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldRowCount, v))
+}
+
+// RowCountNEQ applies the NEQ predicate on the "row_count" field.
+func RowCountNEQ(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldRowCount, v))
+}
+
+// RowCountIn applies the In predicate on the "row_count" field.
+func RowCountIn(vs ...int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldRowCount, vs...))
+}
+
+// RowCountNotIn applies the NotIn predicate on the "row_count" field.
+func RowCountNotIn(vs ...int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldRowCount, vs...))
+}
+
+// RowCountGT applies the GT predicate on the "row_count" field.
+func RowCountGT(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldRowCount, v))
+}
+
+// RowCountGTE applies the GTE predicate on the "row_count" field.
+func RowCountGTE(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldRowCount, v))
+}
+
+// RowCountLT applies the LT predicate on the "row_count" field.
+func RowCountLT(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldRowCount, v))
+}
+
+// RowCountLTE applies the LTE predicate on the "row_count" field.
+func RowCountLTE(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldRowCount, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldSize, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// RestoredAtEQ applies the EQ predicate on the "restored_at" field.
+func RestoredAtEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldEQ(FieldRestoredAt, v))
+}
+
+// RestoredAtNEQ applies the NEQ predicate on the "restored_at" field.
+func RestoredAtNEQ(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNEQ(FieldRestoredAt, v))
+}
+
+// RestoredAtIn applies the In predicate on the "restored_at" field.
+func RestoredAtIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIn(FieldRestoredAt, vs...))
+}
+
+// RestoredAtNotIn applies the NotIn predicate on the "restored_at" field.
+func RestoredAtNotIn(vs ...time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotIn(FieldRestoredAt, vs...))
+}
+
+// RestoredAtGT applies the GT predicate on the "restored_at" field.
+func RestoredAtGT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGT(FieldRestoredAt, v))
+}
+
+// RestoredAtGTE applies the GTE predicate on the "restored_at" field.
+func RestoredAtGTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldGTE(FieldRestoredAt, v))
+}
+
+// RestoredAtLT applies the LT predicate on the "restored_at" field.
+func RestoredAtLT(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLT(FieldRestoredAt, v))
+}
+
+// RestoredAtLTE applies the LTE predicate on the "restored_at" field.
+func RestoredAtLTE(v time.Time) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldLTE(FieldRestoredAt, v))
+}
+
+// RestoredAtIsNil applies the IsNil predicate on the "restored_at" field.
+func RestoredAtIsNil() predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldIsNull(FieldRestoredAt))
+}
+
+// RestoredAtNotNil applies the NotNil predicate on the "restored_at" field.
+func RestoredAtNotNil() predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.FieldNotNull(FieldRestoredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OperationLogArchive) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OperationLogArchive) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OperationLogArchive) predicate.OperationLogArchive {
+	return predicate.OperationLogArchive(sql.NotPredicates(p))
+}
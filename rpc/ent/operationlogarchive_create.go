@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+)
+
+// OperationLogArchiveCreate is the builder for creating a OperationLogArchive entity.
+type OperationLogArchiveCreate struct {
+	config
+	mutation *OperationLogArchiveMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OperationLogArchiveCreate) SetCreatedAt(v time.Time) *OperationLogArchiveCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OperationLogArchiveCreate) SetNillableCreatedAt(v *time.Time) *OperationLogArchiveCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OperationLogArchiveCreate) SetUpdatedAt(v time.Time) *OperationLogArchiveCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OperationLogArchiveCreate) SetNillableUpdatedAt(v *time.Time) *OperationLogArchiveCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetModule sets the "module" field.
+func (_c *OperationLogArchiveCreate) SetModule(v string) *OperationLogArchiveCreate {
+	_c.mutation.SetModule(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *OperationLogArchiveCreate) SetStartTime(v time.Time) *OperationLogArchiveCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *OperationLogArchiveCreate) SetEndTime(v time.Time) *OperationLogArchiveCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetStartID sets the "start_id" field.
+func (_c *OperationLogArchiveCreate) SetStartID(v uint32) *OperationLogArchiveCreate {
+	_c.mutation.SetStartID(v)
+	return _c
+}
+
+// SetEndID sets the "end_id" field.
+func (_c *OperationLogArchiveCreate) SetEndID(v uint32) *OperationLogArchiveCreate {
+	_c.mutation.SetEndID(v)
+	return _c
+}
+
+// SetRowCount sets the "row_count" field.
+func (_c *OperationLogArchiveCreate) SetRowCount(v int64) *OperationLogArchiveCreate {
+	_c.mutation.SetRowCount(v)
+	return _c
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_c *OperationLogArchiveCreate) SetNillableRowCount(v *int64) *OperationLogArchiveCreate {
+	if v != nil {
+		_c.SetRowCount(*v)
+	}
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *OperationLogArchiveCreate) SetSize(v int64) *OperationLogArchiveCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *OperationLogArchiveCreate) SetNillableSize(v *int64) *OperationLogArchiveCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetChecksum sets the "checksum" field.
+func (_c *OperationLogArchiveCreate) SetChecksum(v string) *OperationLogArchiveCreate {
+	_c.mutation.SetChecksum(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *OperationLogArchiveCreate) SetStorageKey(v string) *OperationLogArchiveCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_c *OperationLogArchiveCreate) SetRestoredAt(v time.Time) *OperationLogArchiveCreate {
+	_c.mutation.SetRestoredAt(v)
+	return _c
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_c *OperationLogArchiveCreate) SetNillableRestoredAt(v *time.Time) *OperationLogArchiveCreate {
+	if v != nil {
+		_c.SetRestoredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OperationLogArchiveCreate) SetID(v uint32) *OperationLogArchiveCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OperationLogArchiveMutation object of the builder.
+func (_c *OperationLogArchiveCreate) Mutation() *OperationLogArchiveMutation {
+	return _c.mutation
+}
+
+// Save creates the OperationLogArchive in the database.
+func (_c *OperationLogArchiveCreate) Save(ctx context.Context) (*OperationLogArchive, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OperationLogArchiveCreate) SaveX(ctx context.Context) *OperationLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OperationLogArchiveCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OperationLogArchiveCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OperationLogArchiveCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := operationlogarchive.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := operationlogarchive.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RowCount(); !ok {
+		v := operationlogarchive.DefaultRowCount
+		_c.mutation.SetRowCount(v)
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		v := operationlogarchive.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OperationLogArchiveCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OperationLogArchive.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OperationLogArchive.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Module(); !ok {
+		return &ValidationError{Name: "module", err: errors.New(`ent: missing required field "OperationLogArchive.module"`)}
+	}
+	if v, ok := _c.mutation.Module(); ok {
+		if err := operationlogarchive.ModuleValidator(v); err != nil {
+			return &ValidationError{Name: "module", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.module": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "OperationLogArchive.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "OperationLogArchive.end_time"`)}
+	}
+	if _, ok := _c.mutation.StartID(); !ok {
+		return &ValidationError{Name: "start_id", err: errors.New(`ent: missing required field "OperationLogArchive.start_id"`)}
+	}
+	if _, ok := _c.mutation.EndID(); !ok {
+		return &ValidationError{Name: "end_id", err: errors.New(`ent: missing required field "OperationLogArchive.end_id"`)}
+	}
+	if _, ok := _c.mutation.RowCount(); !ok {
+		return &ValidationError{Name: "row_count", err: errors.New(`ent: missing required field "OperationLogArchive.row_count"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "OperationLogArchive.size"`)}
+	}
+	if _, ok := _c.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "OperationLogArchive.checksum"`)}
+	}
+	if v, ok := _c.mutation.Checksum(); ok {
+		if err := operationlogarchive.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.checksum": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "OperationLogArchive.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := operationlogarchive.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := operationlogarchive.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OperationLogArchiveCreate) sqlSave(ctx context.Context) (*OperationLogArchive, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OperationLogArchiveCreate) createSpec() (*OperationLogArchive, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OperationLogArchive{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(operationlogarchive.Table, sqlgraph.NewFieldSpec(operationlogarchive.FieldID, field.TypeUint32))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(operationlogarchive.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(operationlogarchive.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Module(); ok {
+		_spec.SetField(operationlogarchive.FieldModule, field.TypeString, value)
+		_node.Module = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(operationlogarchive.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(operationlogarchive.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.StartID(); ok {
+		_spec.SetField(operationlogarchive.FieldStartID, field.TypeUint32, value)
+		_node.StartID = value
+	}
+	if value, ok := _c.mutation.EndID(); ok {
+		_spec.SetField(operationlogarchive.FieldEndID, field.TypeUint32, value)
+		_node.EndID = value
+	}
+	if value, ok := _c.mutation.RowCount(); ok {
+		_spec.SetField(operationlogarchive.FieldRowCount, field.TypeInt64, value)
+		_node.RowCount = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(operationlogarchive.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Checksum(); ok {
+		_spec.SetField(operationlogarchive.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(operationlogarchive.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.RestoredAt(); ok {
+		_spec.SetField(operationlogarchive.FieldRestoredAt, field.TypeTime, value)
+		_node.RestoredAt = &value
+	}
+	return _node, _spec
+}
+
+// OperationLogArchiveCreateBulk is the builder for creating many OperationLogArchive entities in bulk.
+type OperationLogArchiveCreateBulk struct {
+	config
+	err      error
+	builders []*OperationLogArchiveCreate
+}
+
+// Save creates the OperationLogArchive entities in the database.
+func (_c *OperationLogArchiveCreateBulk) Save(ctx context.Context) ([]*OperationLogArchive, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OperationLogArchive, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OperationLogArchiveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OperationLogArchiveCreateBulk) SaveX(ctx context.Context) []*OperationLogArchive {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OperationLogArchiveCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OperationLogArchiveCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
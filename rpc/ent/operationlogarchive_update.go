@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// OperationLogArchiveUpdate is the builder for updating OperationLogArchive entities.
+type OperationLogArchiveUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OperationLogArchiveMutation
+}
+
+// Where appends a list predicates to the OperationLogArchiveUpdate builder.
+func (_u *OperationLogArchiveUpdate) Where(ps ...predicate.OperationLogArchive) *OperationLogArchiveUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OperationLogArchiveUpdate) SetUpdatedAt(v time.Time) *OperationLogArchiveUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetModule sets the "module" field.
+func (_u *OperationLogArchiveUpdate) SetModule(v string) *OperationLogArchiveUpdate {
+	_u.mutation.SetModule(v)
+	return _u
+}
+
+// SetNillableModule sets the "module" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableModule(v *string) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetModule(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *OperationLogArchiveUpdate) SetStartTime(v time.Time) *OperationLogArchiveUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableStartTime(v *time.Time) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *OperationLogArchiveUpdate) SetEndTime(v time.Time) *OperationLogArchiveUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableEndTime(v *time.Time) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetStartID sets the "start_id" field.
+func (_u *OperationLogArchiveUpdate) SetStartID(v uint32) *OperationLogArchiveUpdate {
+	_u.mutation.ResetStartID()
+	_u.mutation.SetStartID(v)
+	return _u
+}
+
+// SetNillableStartID sets the "start_id" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableStartID(v *uint32) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetStartID(*v)
+	}
+	return _u
+}
+
+// AddStartID adds value to the "start_id" field.
+func (_u *OperationLogArchiveUpdate) AddStartID(v int32) *OperationLogArchiveUpdate {
+	_u.mutation.AddStartID(v)
+	return _u
+}
+
+// SetEndID sets the "end_id" field.
+func (_u *OperationLogArchiveUpdate) SetEndID(v uint32) *OperationLogArchiveUpdate {
+	_u.mutation.ResetEndID()
+	_u.mutation.SetEndID(v)
+	return _u
+}
+
+// SetNillableEndID sets the "end_id" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableEndID(v *uint32) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetEndID(*v)
+	}
+	return _u
+}
+
+// AddEndID adds value to the "end_id" field.
+func (_u *OperationLogArchiveUpdate) AddEndID(v int32) *OperationLogArchiveUpdate {
+	_u.mutation.AddEndID(v)
+	return _u
+}
+
+// SetRowCount sets the "row_count" field.
+func (_u *OperationLogArchiveUpdate) SetRowCount(v int64) *OperationLogArchiveUpdate {
+	_u.mutation.ResetRowCount()
+	_u.mutation.SetRowCount(v)
+	return _u
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableRowCount(v *int64) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetRowCount(*v)
+	}
+	return _u
+}
+
+// AddRowCount adds value to the "row_count" field.
+func (_u *OperationLogArchiveUpdate) AddRowCount(v int64) *OperationLogArchiveUpdate {
+	_u.mutation.AddRowCount(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *OperationLogArchiveUpdate) SetSize(v int64) *OperationLogArchiveUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableSize(v *int64) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *OperationLogArchiveUpdate) AddSize(v int64) *OperationLogArchiveUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *OperationLogArchiveUpdate) SetChecksum(v string) *OperationLogArchiveUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableChecksum(v *string) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *OperationLogArchiveUpdate) SetStorageKey(v string) *OperationLogArchiveUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableStorageKey(v *string) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_u *OperationLogArchiveUpdate) SetRestoredAt(v time.Time) *OperationLogArchiveUpdate {
+	_u.mutation.SetRestoredAt(v)
+	return _u
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdate) SetNillableRestoredAt(v *time.Time) *OperationLogArchiveUpdate {
+	if v != nil {
+		_u.SetRestoredAt(*v)
+	}
+	return _u
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (_u *OperationLogArchiveUpdate) ClearRestoredAt() *OperationLogArchiveUpdate {
+	_u.mutation.ClearRestoredAt()
+	return _u
+}
+
+// Mutation returns the OperationLogArchiveMutation object of the builder.
+func (_u *OperationLogArchiveUpdate) Mutation() *OperationLogArchiveMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OperationLogArchiveUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationLogArchiveUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OperationLogArchiveUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationLogArchiveUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationLogArchiveUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := operationlogarchive.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationLogArchiveUpdate) check() error {
+	if v, ok := _u.mutation.Module(); ok {
+		if err := operationlogarchive.ModuleValidator(v); err != nil {
+			return &ValidationError{Name: "module", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.module": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := operationlogarchive.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := operationlogarchive.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OperationLogArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operationlogarchive.Table, operationlogarchive.Columns, sqlgraph.NewFieldSpec(operationlogarchive.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(operationlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Module(); ok {
+		_spec.SetField(operationlogarchive.FieldModule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(operationlogarchive.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(operationlogarchive.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartID(); ok {
+		_spec.SetField(operationlogarchive.FieldStartID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedStartID(); ok {
+		_spec.AddField(operationlogarchive.FieldStartID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.EndID(); ok {
+		_spec.SetField(operationlogarchive.FieldEndID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedEndID(); ok {
+		_spec.AddField(operationlogarchive.FieldEndID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RowCount(); ok {
+		_spec.SetField(operationlogarchive.FieldRowCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRowCount(); ok {
+		_spec.AddField(operationlogarchive.FieldRowCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(operationlogarchive.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(operationlogarchive.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(operationlogarchive.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(operationlogarchive.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestoredAt(); ok {
+		_spec.SetField(operationlogarchive.FieldRestoredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RestoredAtCleared() {
+		_spec.ClearField(operationlogarchive.FieldRestoredAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operationlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OperationLogArchiveUpdateOne is the builder for updating a single OperationLogArchive entity.
+type OperationLogArchiveUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OperationLogArchiveMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OperationLogArchiveUpdateOne) SetUpdatedAt(v time.Time) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetModule sets the "module" field.
+func (_u *OperationLogArchiveUpdateOne) SetModule(v string) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetModule(v)
+	return _u
+}
+
+// SetNillableModule sets the "module" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableModule(v *string) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetModule(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *OperationLogArchiveUpdateOne) SetStartTime(v time.Time) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableStartTime(v *time.Time) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *OperationLogArchiveUpdateOne) SetEndTime(v time.Time) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableEndTime(v *time.Time) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetStartID sets the "start_id" field.
+func (_u *OperationLogArchiveUpdateOne) SetStartID(v uint32) *OperationLogArchiveUpdateOne {
+	_u.mutation.ResetStartID()
+	_u.mutation.SetStartID(v)
+	return _u
+}
+
+// SetNillableStartID sets the "start_id" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableStartID(v *uint32) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetStartID(*v)
+	}
+	return _u
+}
+
+// AddStartID adds value to the "start_id" field.
+func (_u *OperationLogArchiveUpdateOne) AddStartID(v int32) *OperationLogArchiveUpdateOne {
+	_u.mutation.AddStartID(v)
+	return _u
+}
+
+// SetEndID sets the "end_id" field.
+func (_u *OperationLogArchiveUpdateOne) SetEndID(v uint32) *OperationLogArchiveUpdateOne {
+	_u.mutation.ResetEndID()
+	_u.mutation.SetEndID(v)
+	return _u
+}
+
+// SetNillableEndID sets the "end_id" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableEndID(v *uint32) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetEndID(*v)
+	}
+	return _u
+}
+
+// AddEndID adds value to the "end_id" field.
+func (_u *OperationLogArchiveUpdateOne) AddEndID(v int32) *OperationLogArchiveUpdateOne {
+	_u.mutation.AddEndID(v)
+	return _u
+}
+
+// SetRowCount sets the "row_count" field.
+func (_u *OperationLogArchiveUpdateOne) SetRowCount(v int64) *OperationLogArchiveUpdateOne {
+	_u.mutation.ResetRowCount()
+	_u.mutation.SetRowCount(v)
+	return _u
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableRowCount(v *int64) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetRowCount(*v)
+	}
+	return _u
+}
+
+// AddRowCount adds value to the "row_count" field.
+func (_u *OperationLogArchiveUpdateOne) AddRowCount(v int64) *OperationLogArchiveUpdateOne {
+	_u.mutation.AddRowCount(v)
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *OperationLogArchiveUpdateOne) SetSize(v int64) *OperationLogArchiveUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableSize(v *int64) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *OperationLogArchiveUpdateOne) AddSize(v int64) *OperationLogArchiveUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *OperationLogArchiveUpdateOne) SetChecksum(v string) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableChecksum(v *string) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *OperationLogArchiveUpdateOne) SetStorageKey(v string) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableStorageKey(v *string) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// SetRestoredAt sets the "restored_at" field.
+func (_u *OperationLogArchiveUpdateOne) SetRestoredAt(v time.Time) *OperationLogArchiveUpdateOne {
+	_u.mutation.SetRestoredAt(v)
+	return _u
+}
+
+// SetNillableRestoredAt sets the "restored_at" field if the given value is not nil.
+func (_u *OperationLogArchiveUpdateOne) SetNillableRestoredAt(v *time.Time) *OperationLogArchiveUpdateOne {
+	if v != nil {
+		_u.SetRestoredAt(*v)
+	}
+	return _u
+}
+
+// ClearRestoredAt clears the value of the "restored_at" field.
+func (_u *OperationLogArchiveUpdateOne) ClearRestoredAt() *OperationLogArchiveUpdateOne {
+	_u.mutation.ClearRestoredAt()
+	return _u
+}
+
+// Mutation returns the OperationLogArchiveMutation object of the builder.
+func (_u *OperationLogArchiveUpdateOne) Mutation() *OperationLogArchiveMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OperationLogArchiveUpdate builder.
+func (_u *OperationLogArchiveUpdateOne) Where(ps ...predicate.OperationLogArchive) *OperationLogArchiveUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OperationLogArchiveUpdateOne) Select(field string, fields ...string) *OperationLogArchiveUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OperationLogArchive entity.
+func (_u *OperationLogArchiveUpdateOne) Save(ctx context.Context) (*OperationLogArchive, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationLogArchiveUpdateOne) SaveX(ctx context.Context) *OperationLogArchive {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OperationLogArchiveUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationLogArchiveUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationLogArchiveUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := operationlogarchive.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationLogArchiveUpdateOne) check() error {
+	if v, ok := _u.mutation.Module(); ok {
+		if err := operationlogarchive.ModuleValidator(v); err != nil {
+			return &ValidationError{Name: "module", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.module": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := operationlogarchive.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := operationlogarchive.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "OperationLogArchive.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OperationLogArchiveUpdateOne) sqlSave(ctx context.Context) (_node *OperationLogArchive, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operationlogarchive.Table, operationlogarchive.Columns, sqlgraph.NewFieldSpec(operationlogarchive.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OperationLogArchive.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operationlogarchive.FieldID)
+		for _, f := range fields {
+			if !operationlogarchive.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != operationlogarchive.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(operationlogarchive.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Module(); ok {
+		_spec.SetField(operationlogarchive.FieldModule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(operationlogarchive.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(operationlogarchive.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartID(); ok {
+		_spec.SetField(operationlogarchive.FieldStartID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedStartID(); ok {
+		_spec.AddField(operationlogarchive.FieldStartID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.EndID(); ok {
+		_spec.SetField(operationlogarchive.FieldEndID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedEndID(); ok {
+		_spec.AddField(operationlogarchive.FieldEndID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.RowCount(); ok {
+		_spec.SetField(operationlogarchive.FieldRowCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRowCount(); ok {
+		_spec.AddField(operationlogarchive.FieldRowCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(operationlogarchive.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(operationlogarchive.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(operationlogarchive.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(operationlogarchive.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RestoredAt(); ok {
+		_spec.SetField(operationlogarchive.FieldRestoredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RestoredAtCleared() {
+		_spec.ClearField(operationlogarchive.FieldRestoredAt, field.TypeTime)
+	}
+	_node = &OperationLogArchive{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operationlogarchive.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
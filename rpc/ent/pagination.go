@@ -4,21 +4,38 @@ package ent
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
 	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dictitem"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/oauthprovider"
 	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/token"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userpasswordhistory"
 	"github.com/wenpiner/last-admin-core/rpc/ent/usertotp"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
 )
 
 // Pagination constants
@@ -98,6 +115,104 @@ func CalculatePages(total uint64, pageSize uint32) uint32 {
 	return uint32((total + uint64(pageSize) - 1) / uint64(pageSize))
 }
 
+// CursorRequest contains keyset pagination parameters
+type CursorRequest struct {
+	// Cursor is the NextCursor of the previous page, empty for the first page
+	Cursor string
+	Size   uint32
+	// Field is the sort key column (camelCase accepted), empty to sort by ID only
+	Field string
+	Desc  bool
+	// Skipped requests counting the records before the cursor
+	Skipped bool
+}
+
+// CursorPageDetails contains keyset pagination metadata
+type CursorPageDetails struct {
+	Size       uint32 `json:"size"`
+	NextCursor string `json:"nextCursor"`
+	HasNext    bool   `json:"hasNext"`
+	Skipped    uint64 `json:"skipped"`
+}
+
+// cursorToken is the decoded form of an opaque cursor
+type cursorToken struct {
+	Field string `json:"f"`
+	Desc  bool   `json:"d"`
+	Value string `json:"v"`
+	ID    string `json:"i"`
+}
+
+// cursorField resolves the sort key column of a cursor request
+func cursorField(fields map[string]queryKind, name, idField string) (string, error) {
+	if name == "" {
+		return idField, nil
+	}
+	column := queryColumn(name)
+	if _, ok := fields[column]; !ok {
+		return "", fmt.Errorf("%w: field %q cannot be used for cursor pagination", ErrInvalidQuery, name)
+	}
+	return column, nil
+}
+
+// formatCursorValue formats a sort key value, times keep nanosecond precision
+func formatCursorValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
+
+// encodeCursor builds the opaque cursor pointing after the given row
+func encodeCursor(field string, desc bool, value, id any) (string, error) {
+	b, err := json.Marshal(cursorToken{Field: field, Desc: desc, Value: formatCursorValue(value), ID: formatCursorValue(id)})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor parses a cursor, which must have been issued for the same sort key and direction.
+// Times are converted to loc, the zone of the database connection, since some drivers compare them as text.
+func decodeCursor(fields map[string]queryKind, cursor, field, idField string, desc bool, loc *time.Location) (value, id any, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	var token cursorToken
+	if err := json.Unmarshal(b, &token); err != nil || token.Field != field || token.Desc != desc {
+		return nil, nil, fmt.Errorf("%w: cursor does not match the sort order", ErrInvalidQuery)
+	}
+	if value, err = parseQueryValue(fields[field], token.Value); err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	if t, ok := value.(time.Time); ok {
+		value = t.In(loc)
+	}
+	if id, err = parseQueryValue(fields[idField], token.ID); err != nil {
+		return nil, nil, fmt.Errorf("%w: malformed cursor", ErrInvalidQuery)
+	}
+	return value, id, nil
+}
+
+// cursorPredicate selects the rows after the cursor, or the rows up to it when before is set
+func cursorPredicate(field, idField string, value, id any, desc, before bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		op := sql.GT
+		if desc {
+			op = sql.LT
+		}
+		p := op(s.C(idField), id)
+		if field != idField {
+			p = sql.Or(op(s.C(field), value), sql.And(sql.EQ(s.C(field), value), p))
+		}
+		if before {
+			p = sql.Not(p)
+		}
+		s.Where(p)
+	}
+}
+
 // APIPager provides pagination functionality for API
 type APIPager struct {
 	Order  api.OrderOption
@@ -267,69 +382,173 @@ func (_m *APIQuery) PageWithCount(
 	return ret, nil
 }
 
-// ConfigurationPager provides pagination functionality for Configuration
-type ConfigurationPager struct {
-	Order  configuration.OrderOption
-	Filter func(*ConfigurationQuery) (*ConfigurationQuery, error)
+// apiCursorFields are the sort keys of API usable for cursor pagination
+var apiCursorFields = map[string]queryKind{
+	api.FieldID:          queryKindUint,
+	api.FieldCreatedAt:   queryKindTime,
+	api.FieldMethod:      queryKindString,
+	api.FieldPath:        queryKindString,
+	api.FieldServiceName: queryKindString,
+	api.FieldAPIGroup:    queryKindString,
 }
 
-// ConfigurationPaginateOption enables pagination customization.
-type ConfigurationPaginateOption func(*ConfigurationPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *API) cursorValue(field string) any {
+	switch field {
+	case api.FieldCreatedAt:
+		return _m.CreatedAt
+	case api.FieldMethod:
+		return _m.Method
+	case api.FieldPath:
+		return _m.Path
+	case api.FieldServiceName:
+		return _m.ServiceName
+	case api.FieldAPIGroup:
+		return _m.APIGroup
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultConfigurationOrder is the default ordering of Configuration.
-var DefaultConfigurationOrder = Desc(configuration.FieldID)
+// APICursorList is API cursor page result.
+type APICursorList struct {
+	List        []*API             `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewConfigurationPager creates a new pager with the given options
-func NewConfigurationPager(opts ...ConfigurationPaginateOption) (*ConfigurationPager, error) {
-	pager := &ConfigurationPager{}
+// CursorPage performs keyset pagination for API, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *APIQuery) CursorPage(ctx context.Context, req *CursorRequest) (*APICursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(apiCursorFields, req.Field, api.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &APICursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(apiCursorFields, req.Cursor, field, api.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.API(cursorPredicate(field, api.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count api: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.API(cursorPredicate(field, api.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != api.FieldID {
+		_m = _m.Order(sql.OrderByField(api.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// ApiKeyPager provides pagination functionality for ApiKey
+type ApiKeyPager struct {
+	Order  apikey.OrderOption
+	Filter func(*ApiKeyQuery) (*ApiKeyQuery, error)
+}
+
+// ApiKeyPaginateOption enables pagination customization.
+type ApiKeyPaginateOption func(*ApiKeyPager)
+
+// DefaultApiKeyOrder is the default ordering of ApiKey.
+var DefaultApiKeyOrder = Desc(apikey.FieldID)
+
+// NewApiKeyPager creates a new pager with the given options
+func NewApiKeyPager(opts ...ApiKeyPaginateOption) (*ApiKeyPager, error) {
+	pager := &ApiKeyPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultConfigurationOrder
+		pager.Order = DefaultApiKeyOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Configuration pagination
-func WithConfigurationOrder(order configuration.OrderOption) ConfigurationPaginateOption {
-	return func(p *ConfigurationPager) {
+// WithOrder sets the order option for ApiKey pagination
+func WithApiKeyOrder(order apikey.OrderOption) ApiKeyPaginateOption {
+	return func(p *ApiKeyPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Configuration pagination
-func WithConfigurationFilter(filter func(*ConfigurationQuery) (*ConfigurationQuery, error)) ConfigurationPaginateOption {
-	return func(p *ConfigurationPager) {
+// WithFilter sets the filter function for ApiKey pagination
+func WithApiKeyFilter(filter func(*ApiKeyQuery) (*ApiKeyQuery, error)) ApiKeyPaginateOption {
+	return func(p *ApiKeyPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *ConfigurationPager) ApplyFilter(query *ConfigurationQuery) (*ConfigurationQuery, error) {
+func (p *ApiKeyPager) ApplyFilter(query *ApiKeyQuery) (*ApiKeyQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// ConfigurationPageList is Configuration PageList result.
-type ConfigurationPageList struct {
-	List        []*Configuration `json:"list"`
-	PageDetails *PageDetails     `json:"pageDetails"`
+// ApiKeyPageList is ApiKey PageList result.
+type ApiKeyPageList struct {
+	List        []*ApiKey    `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for Configuration
-func (_m *ConfigurationQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...ConfigurationPaginateOption,
-) (*ConfigurationPageList, error) {
+// Page performs paginated query for ApiKey
+func (_m *ApiKeyQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...ApiKeyPaginateOption,
+) (*ApiKeyPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewConfigurationPager(opts...)
+	pager, err := NewApiKeyPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +558,7 @@ func (_m *ConfigurationQuery) Page(
 		return nil, err
 	}
 
-	ret := &ConfigurationPageList{}
+	ret := &ApiKeyPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -350,7 +569,7 @@ func (_m *ConfigurationQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count configuration: %w", err)
+		return nil, fmt.Errorf("failed to count apikey: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -358,7 +577,7 @@ func (_m *ConfigurationQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Configuration{}
+		ret.List = []*ApiKey{}
 		return ret, nil
 	}
 
@@ -366,7 +585,7 @@ func (_m *ConfigurationQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultConfigurationOrder)
+		_m = _m.Order(DefaultApiKeyOrder)
 	}
 
 	// Apply pagination
@@ -375,24 +594,24 @@ func (_m *ConfigurationQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query configuration: %w", err)
+		return nil, fmt.Errorf("failed to query apikey: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Configuration
-func (_m *ConfigurationQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...ConfigurationPaginateOption,
-) (*ConfigurationPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for ApiKey
+func (_m *ApiKeyQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...ApiKeyPaginateOption,
+) (*ApiKeyPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewConfigurationPager(opts...)
+	pager, err := NewApiKeyPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -402,7 +621,7 @@ func (_m *ConfigurationQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &ConfigurationPageList{}
+	ret := &ApiKeyPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -412,7 +631,7 @@ func (_m *ConfigurationQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Configuration{}
+		ret.List = []*ApiKey{}
 		return ret, nil
 	}
 
@@ -420,7 +639,7 @@ func (_m *ConfigurationQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultConfigurationOrder)
+		_m = _m.Order(DefaultApiKeyOrder)
 	}
 
 	// Apply pagination
@@ -429,76 +648,171 @@ func (_m *ConfigurationQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query configuration: %w", err)
+		return nil, fmt.Errorf("failed to query apikey: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// DepartmentPager provides pagination functionality for Department
-type DepartmentPager struct {
-	Order  department.OrderOption
-	Filter func(*DepartmentQuery) (*DepartmentQuery, error)
+// apikeyCursorFields are the sort keys of ApiKey usable for cursor pagination
+var apikeyCursorFields = map[string]queryKind{
+	apikey.FieldID:        queryKindUint,
+	apikey.FieldCreatedAt: queryKindTime,
+	apikey.FieldName:      queryKindString,
 }
 
-// DepartmentPaginateOption enables pagination customization.
-type DepartmentPaginateOption func(*DepartmentPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *ApiKey) cursorValue(field string) any {
+	switch field {
+	case apikey.FieldCreatedAt:
+		return _m.CreatedAt
+	case apikey.FieldName:
+		return _m.Name
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultDepartmentOrder is the default ordering of Department.
-var DefaultDepartmentOrder = Desc(department.FieldID)
+// ApiKeyCursorList is ApiKey cursor page result.
+type ApiKeyCursorList struct {
+	List        []*ApiKey          `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewDepartmentPager creates a new pager with the given options
-func NewDepartmentPager(opts ...DepartmentPaginateOption) (*DepartmentPager, error) {
-	pager := &DepartmentPager{}
+// CursorPage performs keyset pagination for ApiKey, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *ApiKeyQuery) CursorPage(ctx context.Context, req *CursorRequest) (*ApiKeyCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(apikeyCursorFields, req.Field, apikey.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ApiKeyCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(apikeyCursorFields, req.Cursor, field, apikey.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.ApiKey(cursorPredicate(field, apikey.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count apikey: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.ApiKey(cursorPredicate(field, apikey.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != apikey.FieldID {
+		_m = _m.Order(sql.OrderByField(apikey.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query apikey: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// ConfigurationPager provides pagination functionality for Configuration
+type ConfigurationPager struct {
+	Order  configuration.OrderOption
+	Filter func(*ConfigurationQuery) (*ConfigurationQuery, error)
+}
+
+// ConfigurationPaginateOption enables pagination customization.
+type ConfigurationPaginateOption func(*ConfigurationPager)
+
+// DefaultConfigurationOrder is the default ordering of Configuration.
+var DefaultConfigurationOrder = Desc(configuration.FieldID)
+
+// NewConfigurationPager creates a new pager with the given options
+func NewConfigurationPager(opts ...ConfigurationPaginateOption) (*ConfigurationPager, error) {
+	pager := &ConfigurationPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultDepartmentOrder
+		pager.Order = DefaultConfigurationOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Department pagination
-func WithDepartmentOrder(order department.OrderOption) DepartmentPaginateOption {
-	return func(p *DepartmentPager) {
+// WithOrder sets the order option for Configuration pagination
+func WithConfigurationOrder(order configuration.OrderOption) ConfigurationPaginateOption {
+	return func(p *ConfigurationPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Department pagination
-func WithDepartmentFilter(filter func(*DepartmentQuery) (*DepartmentQuery, error)) DepartmentPaginateOption {
-	return func(p *DepartmentPager) {
+// WithFilter sets the filter function for Configuration pagination
+func WithConfigurationFilter(filter func(*ConfigurationQuery) (*ConfigurationQuery, error)) ConfigurationPaginateOption {
+	return func(p *ConfigurationPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *DepartmentPager) ApplyFilter(query *DepartmentQuery) (*DepartmentQuery, error) {
+func (p *ConfigurationPager) ApplyFilter(query *ConfigurationQuery) (*ConfigurationQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// DepartmentPageList is Department PageList result.
-type DepartmentPageList struct {
-	List        []*Department `json:"list"`
-	PageDetails *PageDetails  `json:"pageDetails"`
+// ConfigurationPageList is Configuration PageList result.
+type ConfigurationPageList struct {
+	List        []*Configuration `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
 }
 
-// Page performs paginated query for Department
-func (_m *DepartmentQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DepartmentPaginateOption,
-) (*DepartmentPageList, error) {
+// Page performs paginated query for Configuration
+func (_m *ConfigurationQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...ConfigurationPaginateOption,
+) (*ConfigurationPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDepartmentPager(opts...)
+	pager, err := NewConfigurationPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -508,7 +822,7 @@ func (_m *DepartmentQuery) Page(
 		return nil, err
 	}
 
-	ret := &DepartmentPageList{}
+	ret := &ConfigurationPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -519,7 +833,7 @@ func (_m *DepartmentQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count department: %w", err)
+		return nil, fmt.Errorf("failed to count configuration: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -527,7 +841,7 @@ func (_m *DepartmentQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Department{}
+		ret.List = []*Configuration{}
 		return ret, nil
 	}
 
@@ -535,7 +849,7 @@ func (_m *DepartmentQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDepartmentOrder)
+		_m = _m.Order(DefaultConfigurationOrder)
 	}
 
 	// Apply pagination
@@ -544,24 +858,24 @@ func (_m *DepartmentQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query department: %w", err)
+		return nil, fmt.Errorf("failed to query configuration: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Department
-func (_m *DepartmentQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DepartmentPaginateOption,
-) (*DepartmentPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for Configuration
+func (_m *ConfigurationQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...ConfigurationPaginateOption,
+) (*ConfigurationPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDepartmentPager(opts...)
+	pager, err := NewConfigurationPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -571,7 +885,7 @@ func (_m *DepartmentQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &DepartmentPageList{}
+	ret := &ConfigurationPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -581,7 +895,7 @@ func (_m *DepartmentQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Department{}
+		ret.List = []*Configuration{}
 		return ret, nil
 	}
 
@@ -589,7 +903,7 @@ func (_m *DepartmentQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDepartmentOrder)
+		_m = _m.Order(DefaultConfigurationOrder)
 	}
 
 	// Apply pagination
@@ -598,76 +912,3390 @@ func (_m *DepartmentQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query department: %w", err)
+		return nil, fmt.Errorf("failed to query configuration: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// DictItemPager provides pagination functionality for DictItem
-type DictItemPager struct {
-	Order  dictitem.OrderOption
-	Filter func(*DictItemQuery) (*DictItemQuery, error)
+// configurationCursorFields are the sort keys of Configuration usable for cursor pagination
+var configurationCursorFields = map[string]queryKind{
+	configuration.FieldID:    queryKindInt,
+	configuration.FieldName:  queryKindString,
+	configuration.FieldGroup: queryKindString,
+	configuration.FieldKey:   queryKindString,
 }
 
-// DictItemPaginateOption enables pagination customization.
-type DictItemPaginateOption func(*DictItemPager)
-
-// DefaultDictItemOrder is the default ordering of DictItem.
-var DefaultDictItemOrder = Desc(dictitem.FieldID)
-
-// NewDictItemPager creates a new pager with the given options
-func NewDictItemPager(opts ...DictItemPaginateOption) (*DictItemPager, error) {
-	pager := &DictItemPager{}
-	for _, opt := range opts {
-		opt(pager)
-	}
-	if pager.Order == nil {
-		pager.Order = DefaultDictItemOrder
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Configuration) cursorValue(field string) any {
+	switch field {
+	case configuration.FieldName:
+		return _m.Name
+	case configuration.FieldGroup:
+		return _m.Group
+	case configuration.FieldKey:
+		return _m.Key
+	default:
+		return _m.ID
 	}
-	return pager, nil
 }
 
-// WithOrder sets the order option for DictItem pagination
-func WithDictItemOrder(order dictitem.OrderOption) DictItemPaginateOption {
-	return func(p *DictItemPager) {
-		p.Order = order
-	}
+// ConfigurationCursorList is Configuration cursor page result.
+type ConfigurationCursorList struct {
+	List        []*Configuration   `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
 }
 
-// WithFilter sets the filter function for DictItem pagination
-func WithDictItemFilter(filter func(*DictItemQuery) (*DictItemQuery, error)) DictItemPaginateOption {
-	return func(p *DictItemPager) {
-		p.Filter = filter
+// CursorPage performs keyset pagination for Configuration, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *ConfigurationQuery) CursorPage(ctx context.Context, req *CursorRequest) (*ConfigurationCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
 	}
-}
 
-// ApplyFilter applies the filter to the query if set
-func (p *DictItemPager) ApplyFilter(query *DictItemQuery) (*DictItemQuery, error) {
-	if p.Filter != nil {
+	field, err := cursorField(configurationCursorFields, req.Field, configuration.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ConfigurationCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(configurationCursorFields, req.Cursor, field, configuration.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Configuration(cursorPredicate(field, configuration.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count configuration: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Configuration(cursorPredicate(field, configuration.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != configuration.FieldID {
+		_m = _m.Order(sql.OrderByField(configuration.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query configuration: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// DepartmentPager provides pagination functionality for Department
+type DepartmentPager struct {
+	Order  department.OrderOption
+	Filter func(*DepartmentQuery) (*DepartmentQuery, error)
+}
+
+// DepartmentPaginateOption enables pagination customization.
+type DepartmentPaginateOption func(*DepartmentPager)
+
+// DefaultDepartmentOrder is the default ordering of Department.
+var DefaultDepartmentOrder = Desc(department.FieldID)
+
+// NewDepartmentPager creates a new pager with the given options
+func NewDepartmentPager(opts ...DepartmentPaginateOption) (*DepartmentPager, error) {
+	pager := &DepartmentPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultDepartmentOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for Department pagination
+func WithDepartmentOrder(order department.OrderOption) DepartmentPaginateOption {
+	return func(p *DepartmentPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for Department pagination
+func WithDepartmentFilter(filter func(*DepartmentQuery) (*DepartmentQuery, error)) DepartmentPaginateOption {
+	return func(p *DepartmentPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *DepartmentPager) ApplyFilter(query *DepartmentQuery) (*DepartmentQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// DepartmentPageList is Department PageList result.
+type DepartmentPageList struct {
+	List        []*Department `json:"list"`
+	PageDetails *PageDetails  `json:"pageDetails"`
+}
+
+// Page performs paginated query for Department
+func (_m *DepartmentQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DepartmentPaginateOption,
+) (*DepartmentPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDepartmentPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DepartmentPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count department: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*Department{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDepartmentOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query department: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for Department
+func (_m *DepartmentQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DepartmentPaginateOption,
+) (*DepartmentPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDepartmentPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DepartmentPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*Department{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDepartmentOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query department: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// departmentCursorFields are the sort keys of Department usable for cursor pagination
+var departmentCursorFields = map[string]queryKind{
+	department.FieldID:        queryKindUint,
+	department.FieldCreatedAt: queryKindTime,
+	department.FieldUpdatedAt: queryKindTime,
+	department.FieldSort:      queryKindInt,
+	department.FieldDeptName:  queryKindString,
+	department.FieldDeptCode:  queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Department) cursorValue(field string) any {
+	switch field {
+	case department.FieldCreatedAt:
+		return _m.CreatedAt
+	case department.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case department.FieldSort:
+		return _m.Sort
+	case department.FieldDeptName:
+		return _m.DeptName
+	case department.FieldDeptCode:
+		return _m.DeptCode
+	default:
+		return _m.ID
+	}
+}
+
+// DepartmentCursorList is Department cursor page result.
+type DepartmentCursorList struct {
+	List        []*Department      `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for Department, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *DepartmentQuery) CursorPage(ctx context.Context, req *CursorRequest) (*DepartmentCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(departmentCursorFields, req.Field, department.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &DepartmentCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(departmentCursorFields, req.Cursor, field, department.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Department(cursorPredicate(field, department.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count department: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Department(cursorPredicate(field, department.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != department.FieldID {
+		_m = _m.Order(sql.OrderByField(department.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query department: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// DictItemPager provides pagination functionality for DictItem
+type DictItemPager struct {
+	Order  dictitem.OrderOption
+	Filter func(*DictItemQuery) (*DictItemQuery, error)
+}
+
+// DictItemPaginateOption enables pagination customization.
+type DictItemPaginateOption func(*DictItemPager)
+
+// DefaultDictItemOrder is the default ordering of DictItem.
+var DefaultDictItemOrder = Desc(dictitem.FieldID)
+
+// NewDictItemPager creates a new pager with the given options
+func NewDictItemPager(opts ...DictItemPaginateOption) (*DictItemPager, error) {
+	pager := &DictItemPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultDictItemOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for DictItem pagination
+func WithDictItemOrder(order dictitem.OrderOption) DictItemPaginateOption {
+	return func(p *DictItemPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for DictItem pagination
+func WithDictItemFilter(filter func(*DictItemQuery) (*DictItemQuery, error)) DictItemPaginateOption {
+	return func(p *DictItemPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *DictItemPager) ApplyFilter(query *DictItemQuery) (*DictItemQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// DictItemPageList is DictItem PageList result.
+type DictItemPageList struct {
+	List        []*DictItem  `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
+}
+
+// Page performs paginated query for DictItem
+func (_m *DictItemQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DictItemPaginateOption,
+) (*DictItemPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDictItemPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictItemPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dictitem: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*DictItem{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictItemOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dictitem: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for DictItem
+func (_m *DictItemQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DictItemPaginateOption,
+) (*DictItemPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDictItemPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictItemPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*DictItem{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictItemOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dictitem: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// dictitemCursorFields are the sort keys of DictItem usable for cursor pagination
+var dictitemCursorFields = map[string]queryKind{
+	dictitem.FieldID:        queryKindUint,
+	dictitem.FieldCreatedAt: queryKindTime,
+	dictitem.FieldUpdatedAt: queryKindTime,
+	dictitem.FieldItemLabel: queryKindString,
+	dictitem.FieldItemValue: queryKindString,
+	dictitem.FieldSortOrder: queryKindInt,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *DictItem) cursorValue(field string) any {
+	switch field {
+	case dictitem.FieldCreatedAt:
+		return _m.CreatedAt
+	case dictitem.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case dictitem.FieldItemLabel:
+		return _m.ItemLabel
+	case dictitem.FieldItemValue:
+		return _m.ItemValue
+	case dictitem.FieldSortOrder:
+		return _m.SortOrder
+	default:
+		return _m.ID
+	}
+}
+
+// DictItemCursorList is DictItem cursor page result.
+type DictItemCursorList struct {
+	List        []*DictItem        `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for DictItem, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *DictItemQuery) CursorPage(ctx context.Context, req *CursorRequest) (*DictItemCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(dictitemCursorFields, req.Field, dictitem.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &DictItemCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(dictitemCursorFields, req.Cursor, field, dictitem.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.DictItem(cursorPredicate(field, dictitem.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count dictitem: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.DictItem(cursorPredicate(field, dictitem.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != dictitem.FieldID {
+		_m = _m.Order(sql.OrderByField(dictitem.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dictitem: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// DictTypePager provides pagination functionality for DictType
+type DictTypePager struct {
+	Order  dicttype.OrderOption
+	Filter func(*DictTypeQuery) (*DictTypeQuery, error)
+}
+
+// DictTypePaginateOption enables pagination customization.
+type DictTypePaginateOption func(*DictTypePager)
+
+// DefaultDictTypeOrder is the default ordering of DictType.
+var DefaultDictTypeOrder = Desc(dicttype.FieldID)
+
+// NewDictTypePager creates a new pager with the given options
+func NewDictTypePager(opts ...DictTypePaginateOption) (*DictTypePager, error) {
+	pager := &DictTypePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultDictTypeOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for DictType pagination
+func WithDictTypeOrder(order dicttype.OrderOption) DictTypePaginateOption {
+	return func(p *DictTypePager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for DictType pagination
+func WithDictTypeFilter(filter func(*DictTypeQuery) (*DictTypeQuery, error)) DictTypePaginateOption {
+	return func(p *DictTypePager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *DictTypePager) ApplyFilter(query *DictTypeQuery) (*DictTypeQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// DictTypePageList is DictType PageList result.
+type DictTypePageList struct {
+	List        []*DictType  `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
+}
+
+// Page performs paginated query for DictType
+func (_m *DictTypeQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DictTypePaginateOption,
+) (*DictTypePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDictTypePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictTypePageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dicttype: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*DictType{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictTypeOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dicttype: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for DictType
+func (_m *DictTypeQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DictTypePaginateOption,
+) (*DictTypePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewDictTypePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &DictTypePageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*DictType{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultDictTypeOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dicttype: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// dicttypeCursorFields are the sort keys of DictType usable for cursor pagination
+var dicttypeCursorFields = map[string]queryKind{
+	dicttype.FieldID:           queryKindUint,
+	dicttype.FieldCreatedAt:    queryKindTime,
+	dicttype.FieldUpdatedAt:    queryKindTime,
+	dicttype.FieldDictTypeCode: queryKindString,
+	dicttype.FieldDictTypeName: queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *DictType) cursorValue(field string) any {
+	switch field {
+	case dicttype.FieldCreatedAt:
+		return _m.CreatedAt
+	case dicttype.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case dicttype.FieldDictTypeCode:
+		return _m.DictTypeCode
+	case dicttype.FieldDictTypeName:
+		return _m.DictTypeName
+	default:
+		return _m.ID
+	}
+}
+
+// DictTypeCursorList is DictType cursor page result.
+type DictTypeCursorList struct {
+	List        []*DictType        `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for DictType, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *DictTypeQuery) CursorPage(ctx context.Context, req *CursorRequest) (*DictTypeCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(dicttypeCursorFields, req.Field, dicttype.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &DictTypeCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(dicttypeCursorFields, req.Cursor, field, dicttype.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.DictType(cursorPredicate(field, dicttype.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count dicttype: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.DictType(cursorPredicate(field, dicttype.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != dicttype.FieldID {
+		_m = _m.Order(sql.OrderByField(dicttype.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dicttype: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// FilePager provides pagination functionality for File
+type FilePager struct {
+	Order  file.OrderOption
+	Filter func(*FileQuery) (*FileQuery, error)
+}
+
+// FilePaginateOption enables pagination customization.
+type FilePaginateOption func(*FilePager)
+
+// DefaultFileOrder is the default ordering of File.
+var DefaultFileOrder = Desc(file.FieldID)
+
+// NewFilePager creates a new pager with the given options
+func NewFilePager(opts ...FilePaginateOption) (*FilePager, error) {
+	pager := &FilePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultFileOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for File pagination
+func WithFileOrder(order file.OrderOption) FilePaginateOption {
+	return func(p *FilePager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for File pagination
+func WithFileFilter(filter func(*FileQuery) (*FileQuery, error)) FilePaginateOption {
+	return func(p *FilePager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *FilePager) ApplyFilter(query *FileQuery) (*FileQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// FilePageList is File PageList result.
+type FilePageList struct {
+	List        []*File      `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
+}
+
+// Page performs paginated query for File
+func (_m *FileQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...FilePaginateOption,
+) (*FilePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewFilePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &FilePageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count file: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*File{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultFileOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for File
+func (_m *FileQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...FilePaginateOption,
+) (*FilePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewFilePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &FilePageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*File{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultFileOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// fileCursorFields are the sort keys of File usable for cursor pagination
+var fileCursorFields = map[string]queryKind{
+	file.FieldID:        queryKindUUID,
+	file.FieldCreatedAt: queryKindTime,
+	file.FieldName:      queryKindString,
+	file.FieldSize:      queryKindInt,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *File) cursorValue(field string) any {
+	switch field {
+	case file.FieldCreatedAt:
+		return _m.CreatedAt
+	case file.FieldName:
+		return _m.Name
+	case file.FieldSize:
+		return _m.Size
+	default:
+		return _m.ID
+	}
+}
+
+// FileCursorList is File cursor page result.
+type FileCursorList struct {
+	List        []*File            `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for File, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *FileQuery) CursorPage(ctx context.Context, req *CursorRequest) (*FileCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(fileCursorFields, req.Field, file.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &FileCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(fileCursorFields, req.Cursor, field, file.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.File(cursorPredicate(field, file.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count file: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.File(cursorPredicate(field, file.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != file.FieldID {
+		_m = _m.Order(sql.OrderByField(file.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query file: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// MenuPager provides pagination functionality for Menu
+type MenuPager struct {
+	Order  menu.OrderOption
+	Filter func(*MenuQuery) (*MenuQuery, error)
+}
+
+// MenuPaginateOption enables pagination customization.
+type MenuPaginateOption func(*MenuPager)
+
+// DefaultMenuOrder is the default ordering of Menu.
+var DefaultMenuOrder = Desc(menu.FieldID)
+
+// NewMenuPager creates a new pager with the given options
+func NewMenuPager(opts ...MenuPaginateOption) (*MenuPager, error) {
+	pager := &MenuPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultMenuOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for Menu pagination
+func WithMenuOrder(order menu.OrderOption) MenuPaginateOption {
+	return func(p *MenuPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for Menu pagination
+func WithMenuFilter(filter func(*MenuQuery) (*MenuQuery, error)) MenuPaginateOption {
+	return func(p *MenuPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *MenuPager) ApplyFilter(query *MenuQuery) (*MenuQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// MenuPageList is Menu PageList result.
+type MenuPageList struct {
+	List        []*Menu      `json:"list"`
+	PageDetails *PageDetails `json:"pageDetails"`
+}
+
+// Page performs paginated query for Menu
+func (_m *MenuQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...MenuPaginateOption,
+) (*MenuPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewMenuPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &MenuPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count menu: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*Menu{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultMenuOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for Menu
+func (_m *MenuQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...MenuPaginateOption,
+) (*MenuPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewMenuPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &MenuPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*Menu{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultMenuOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// menuCursorFields are the sort keys of Menu usable for cursor pagination
+var menuCursorFields = map[string]queryKind{
+	menu.FieldID:        queryKindUint,
+	menu.FieldCreatedAt: queryKindTime,
+	menu.FieldSort:      queryKindInt,
+	menu.FieldMenuCode:  queryKindString,
+	menu.FieldMenuName:  queryKindString,
+	menu.FieldMenuLevel: queryKindUint,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Menu) cursorValue(field string) any {
+	switch field {
+	case menu.FieldCreatedAt:
+		return _m.CreatedAt
+	case menu.FieldSort:
+		return _m.Sort
+	case menu.FieldMenuCode:
+		return _m.MenuCode
+	case menu.FieldMenuName:
+		return _m.MenuName
+	case menu.FieldMenuLevel:
+		return _m.MenuLevel
+	default:
+		return _m.ID
+	}
+}
+
+// MenuCursorList is Menu cursor page result.
+type MenuCursorList struct {
+	List        []*Menu            `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for Menu, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *MenuQuery) CursorPage(ctx context.Context, req *CursorRequest) (*MenuCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(menuCursorFields, req.Field, menu.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &MenuCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(menuCursorFields, req.Cursor, field, menu.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Menu(cursorPredicate(field, menu.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count menu: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Menu(cursorPredicate(field, menu.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != menu.FieldID {
+		_m = _m.Order(sql.OrderByField(menu.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query menu: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// NotificationPager provides pagination functionality for Notification
+type NotificationPager struct {
+	Order  notification.OrderOption
+	Filter func(*NotificationQuery) (*NotificationQuery, error)
+}
+
+// NotificationPaginateOption enables pagination customization.
+type NotificationPaginateOption func(*NotificationPager)
+
+// DefaultNotificationOrder is the default ordering of Notification.
+var DefaultNotificationOrder = Desc(notification.FieldID)
+
+// NewNotificationPager creates a new pager with the given options
+func NewNotificationPager(opts ...NotificationPaginateOption) (*NotificationPager, error) {
+	pager := &NotificationPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultNotificationOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for Notification pagination
+func WithNotificationOrder(order notification.OrderOption) NotificationPaginateOption {
+	return func(p *NotificationPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for Notification pagination
+func WithNotificationFilter(filter func(*NotificationQuery) (*NotificationQuery, error)) NotificationPaginateOption {
+	return func(p *NotificationPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *NotificationPager) ApplyFilter(query *NotificationQuery) (*NotificationQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// NotificationPageList is Notification PageList result.
+type NotificationPageList struct {
+	List        []*Notification `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+// Page performs paginated query for Notification
+func (_m *NotificationQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...NotificationPaginateOption,
+) (*NotificationPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewNotificationPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notification: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*Notification{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultNotificationOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for Notification
+func (_m *NotificationQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...NotificationPaginateOption,
+) (*NotificationPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewNotificationPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*Notification{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultNotificationOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// notificationCursorFields are the sort keys of Notification usable for cursor pagination
+var notificationCursorFields = map[string]queryKind{
+	notification.FieldID:        queryKindUint,
+	notification.FieldCreatedAt: queryKindTime,
+	notification.FieldTitle:     queryKindString,
+	notification.FieldLevel:     queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Notification) cursorValue(field string) any {
+	switch field {
+	case notification.FieldCreatedAt:
+		return _m.CreatedAt
+	case notification.FieldTitle:
+		return _m.Title
+	case notification.FieldLevel:
+		return _m.Level
+	default:
+		return _m.ID
+	}
+}
+
+// NotificationCursorList is Notification cursor page result.
+type NotificationCursorList struct {
+	List        []*Notification    `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for Notification, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *NotificationQuery) CursorPage(ctx context.Context, req *CursorRequest) (*NotificationCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(notificationCursorFields, req.Field, notification.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(notificationCursorFields, req.Cursor, field, notification.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Notification(cursorPredicate(field, notification.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count notification: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Notification(cursorPredicate(field, notification.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != notification.FieldID {
+		_m = _m.Order(sql.OrderByField(notification.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// NotificationRecipientPager provides pagination functionality for NotificationRecipient
+type NotificationRecipientPager struct {
+	Order  notificationrecipient.OrderOption
+	Filter func(*NotificationRecipientQuery) (*NotificationRecipientQuery, error)
+}
+
+// NotificationRecipientPaginateOption enables pagination customization.
+type NotificationRecipientPaginateOption func(*NotificationRecipientPager)
+
+// DefaultNotificationRecipientOrder is the default ordering of NotificationRecipient.
+var DefaultNotificationRecipientOrder = Desc(notificationrecipient.FieldID)
+
+// NewNotificationRecipientPager creates a new pager with the given options
+func NewNotificationRecipientPager(opts ...NotificationRecipientPaginateOption) (*NotificationRecipientPager, error) {
+	pager := &NotificationRecipientPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultNotificationRecipientOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for NotificationRecipient pagination
+func WithNotificationRecipientOrder(order notificationrecipient.OrderOption) NotificationRecipientPaginateOption {
+	return func(p *NotificationRecipientPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for NotificationRecipient pagination
+func WithNotificationRecipientFilter(filter func(*NotificationRecipientQuery) (*NotificationRecipientQuery, error)) NotificationRecipientPaginateOption {
+	return func(p *NotificationRecipientPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *NotificationRecipientPager) ApplyFilter(query *NotificationRecipientQuery) (*NotificationRecipientQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// NotificationRecipientPageList is NotificationRecipient PageList result.
+type NotificationRecipientPageList struct {
+	List        []*NotificationRecipient `json:"list"`
+	PageDetails *PageDetails             `json:"pageDetails"`
+}
+
+// Page performs paginated query for NotificationRecipient
+func (_m *NotificationRecipientQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...NotificationRecipientPaginateOption,
+) (*NotificationRecipientPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewNotificationRecipientPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationRecipientPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notificationrecipient: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*NotificationRecipient{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultNotificationRecipientOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notificationrecipient: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for NotificationRecipient
+func (_m *NotificationRecipientQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...NotificationRecipientPaginateOption,
+) (*NotificationRecipientPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewNotificationRecipientPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationRecipientPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*NotificationRecipient{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultNotificationRecipientOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notificationrecipient: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// notificationrecipientCursorFields are the sort keys of NotificationRecipient usable for cursor pagination
+var notificationrecipientCursorFields = map[string]queryKind{
+	notificationrecipient.FieldID: queryKindUint,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *NotificationRecipient) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
+
+// NotificationRecipientCursorList is NotificationRecipient cursor page result.
+type NotificationRecipientCursorList struct {
+	List        []*NotificationRecipient `json:"list"`
+	PageDetails *CursorPageDetails       `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for NotificationRecipient, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *NotificationRecipientQuery) CursorPage(ctx context.Context, req *CursorRequest) (*NotificationRecipientCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(notificationrecipientCursorFields, req.Field, notificationrecipient.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &NotificationRecipientCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(notificationrecipientCursorFields, req.Cursor, field, notificationrecipient.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.NotificationRecipient(cursorPredicate(field, notificationrecipient.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count notificationrecipient: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.NotificationRecipient(cursorPredicate(field, notificationrecipient.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != notificationrecipient.FieldID {
+		_m = _m.Order(sql.OrderByField(notificationrecipient.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notificationrecipient: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// OauthClientPager provides pagination functionality for OauthClient
+type OauthClientPager struct {
+	Order  oauthclient.OrderOption
+	Filter func(*OauthClientQuery) (*OauthClientQuery, error)
+}
+
+// OauthClientPaginateOption enables pagination customization.
+type OauthClientPaginateOption func(*OauthClientPager)
+
+// DefaultOauthClientOrder is the default ordering of OauthClient.
+var DefaultOauthClientOrder = Desc(oauthclient.FieldID)
+
+// NewOauthClientPager creates a new pager with the given options
+func NewOauthClientPager(opts ...OauthClientPaginateOption) (*OauthClientPager, error) {
+	pager := &OauthClientPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthClientOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for OauthClient pagination
+func WithOauthClientOrder(order oauthclient.OrderOption) OauthClientPaginateOption {
+	return func(p *OauthClientPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for OauthClient pagination
+func WithOauthClientFilter(filter func(*OauthClientQuery) (*OauthClientQuery, error)) OauthClientPaginateOption {
+	return func(p *OauthClientPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *OauthClientPager) ApplyFilter(query *OauthClientQuery) (*OauthClientQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthClientPageList is OauthClient PageList result.
+type OauthClientPageList struct {
+	List        []*OauthClient `json:"list"`
+	PageDetails *PageDetails   `json:"pageDetails"`
+}
+
+// Page performs paginated query for OauthClient
+func (_m *OauthClientQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OauthClientPaginateOption,
+) (*OauthClientPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthClientPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthClientPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count oauthclient: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*OauthClient{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthClientOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthclient: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for OauthClient
+func (_m *OauthClientQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OauthClientPaginateOption,
+) (*OauthClientPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthClientPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthClientPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*OauthClient{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthClientOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthclient: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// oauthclientCursorFields are the sort keys of OauthClient usable for cursor pagination
+var oauthclientCursorFields = map[string]queryKind{
+	oauthclient.FieldID:        queryKindUint,
+	oauthclient.FieldCreatedAt: queryKindTime,
+	oauthclient.FieldClientID:  queryKindString,
+	oauthclient.FieldName:      queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *OauthClient) cursorValue(field string) any {
+	switch field {
+	case oauthclient.FieldCreatedAt:
+		return _m.CreatedAt
+	case oauthclient.FieldClientID:
+		return _m.ClientID
+	case oauthclient.FieldName:
+		return _m.Name
+	default:
+		return _m.ID
+	}
+}
+
+// OauthClientCursorList is OauthClient cursor page result.
+type OauthClientCursorList struct {
+	List        []*OauthClient     `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for OauthClient, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *OauthClientQuery) CursorPage(ctx context.Context, req *CursorRequest) (*OauthClientCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(oauthclientCursorFields, req.Field, oauthclient.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OauthClientCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(oauthclientCursorFields, req.Cursor, field, oauthclient.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.OauthClient(cursorPredicate(field, oauthclient.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count oauthclient: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.OauthClient(cursorPredicate(field, oauthclient.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != oauthclient.FieldID {
+		_m = _m.Order(sql.OrderByField(oauthclient.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthclient: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// OauthConsentPager provides pagination functionality for OauthConsent
+type OauthConsentPager struct {
+	Order  oauthconsent.OrderOption
+	Filter func(*OauthConsentQuery) (*OauthConsentQuery, error)
+}
+
+// OauthConsentPaginateOption enables pagination customization.
+type OauthConsentPaginateOption func(*OauthConsentPager)
+
+// DefaultOauthConsentOrder is the default ordering of OauthConsent.
+var DefaultOauthConsentOrder = Desc(oauthconsent.FieldID)
+
+// NewOauthConsentPager creates a new pager with the given options
+func NewOauthConsentPager(opts ...OauthConsentPaginateOption) (*OauthConsentPager, error) {
+	pager := &OauthConsentPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthConsentOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for OauthConsent pagination
+func WithOauthConsentOrder(order oauthconsent.OrderOption) OauthConsentPaginateOption {
+	return func(p *OauthConsentPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for OauthConsent pagination
+func WithOauthConsentFilter(filter func(*OauthConsentQuery) (*OauthConsentQuery, error)) OauthConsentPaginateOption {
+	return func(p *OauthConsentPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *OauthConsentPager) ApplyFilter(query *OauthConsentQuery) (*OauthConsentQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthConsentPageList is OauthConsent PageList result.
+type OauthConsentPageList struct {
+	List        []*OauthConsent `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+// Page performs paginated query for OauthConsent
+func (_m *OauthConsentQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OauthConsentPaginateOption,
+) (*OauthConsentPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthConsentPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthConsentPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count oauthconsent: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*OauthConsent{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthConsentOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthconsent: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for OauthConsent
+func (_m *OauthConsentQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OauthConsentPaginateOption,
+) (*OauthConsentPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthConsentPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthConsentPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*OauthConsent{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthConsentOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthconsent: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// oauthconsentCursorFields are the sort keys of OauthConsent usable for cursor pagination
+var oauthconsentCursorFields = map[string]queryKind{
+	oauthconsent.FieldID: queryKindUint,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *OauthConsent) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
+
+// OauthConsentCursorList is OauthConsent cursor page result.
+type OauthConsentCursorList struct {
+	List        []*OauthConsent    `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for OauthConsent, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *OauthConsentQuery) CursorPage(ctx context.Context, req *CursorRequest) (*OauthConsentCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(oauthconsentCursorFields, req.Field, oauthconsent.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OauthConsentCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(oauthconsentCursorFields, req.Cursor, field, oauthconsent.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.OauthConsent(cursorPredicate(field, oauthconsent.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count oauthconsent: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.OauthConsent(cursorPredicate(field, oauthconsent.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != oauthconsent.FieldID {
+		_m = _m.Order(sql.OrderByField(oauthconsent.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthconsent: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// OauthProviderPager provides pagination functionality for OauthProvider
+type OauthProviderPager struct {
+	Order  oauthprovider.OrderOption
+	Filter func(*OauthProviderQuery) (*OauthProviderQuery, error)
+}
+
+// OauthProviderPaginateOption enables pagination customization.
+type OauthProviderPaginateOption func(*OauthProviderPager)
+
+// DefaultOauthProviderOrder is the default ordering of OauthProvider.
+var DefaultOauthProviderOrder = Desc(oauthprovider.FieldID)
+
+// NewOauthProviderPager creates a new pager with the given options
+func NewOauthProviderPager(opts ...OauthProviderPaginateOption) (*OauthProviderPager, error) {
+	pager := &OauthProviderPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOauthProviderOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for OauthProvider pagination
+func WithOauthProviderOrder(order oauthprovider.OrderOption) OauthProviderPaginateOption {
+	return func(p *OauthProviderPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for OauthProvider pagination
+func WithOauthProviderFilter(filter func(*OauthProviderQuery) (*OauthProviderQuery, error)) OauthProviderPaginateOption {
+	return func(p *OauthProviderPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *OauthProviderPager) ApplyFilter(query *OauthProviderQuery) (*OauthProviderQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OauthProviderPageList is OauthProvider PageList result.
+type OauthProviderPageList struct {
+	List        []*OauthProvider `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
+}
+
+// Page performs paginated query for OauthProvider
+func (_m *OauthProviderQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OauthProviderPaginateOption,
+) (*OauthProviderPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthProviderPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthProviderPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count oauthprovider: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*OauthProvider{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthProviderOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthprovider: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for OauthProvider
+func (_m *OauthProviderQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OauthProviderPaginateOption,
+) (*OauthProviderPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOauthProviderPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OauthProviderPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*OauthProvider{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOauthProviderOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthprovider: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// oauthproviderCursorFields are the sort keys of OauthProvider usable for cursor pagination
+var oauthproviderCursorFields = map[string]queryKind{
+	oauthprovider.FieldID:           queryKindUint,
+	oauthprovider.FieldCreatedAt:    queryKindTime,
+	oauthprovider.FieldProviderName: queryKindString,
+	oauthprovider.FieldProviderCode: queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *OauthProvider) cursorValue(field string) any {
+	switch field {
+	case oauthprovider.FieldCreatedAt:
+		return _m.CreatedAt
+	case oauthprovider.FieldProviderName:
+		return _m.ProviderName
+	case oauthprovider.FieldProviderCode:
+		return _m.ProviderCode
+	default:
+		return _m.ID
+	}
+}
+
+// OauthProviderCursorList is OauthProvider cursor page result.
+type OauthProviderCursorList struct {
+	List        []*OauthProvider   `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for OauthProvider, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *OauthProviderQuery) CursorPage(ctx context.Context, req *CursorRequest) (*OauthProviderCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(oauthproviderCursorFields, req.Field, oauthprovider.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OauthProviderCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(oauthproviderCursorFields, req.Cursor, field, oauthprovider.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.OauthProvider(cursorPredicate(field, oauthprovider.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count oauthprovider: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.OauthProvider(cursorPredicate(field, oauthprovider.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != oauthprovider.FieldID {
+		_m = _m.Order(sql.OrderByField(oauthprovider.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oauthprovider: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// OperationLogPager provides pagination functionality for OperationLog
+type OperationLogPager struct {
+	Order  operationlog.OrderOption
+	Filter func(*OperationLogQuery) (*OperationLogQuery, error)
+}
+
+// OperationLogPaginateOption enables pagination customization.
+type OperationLogPaginateOption func(*OperationLogPager)
+
+// DefaultOperationLogOrder is the default ordering of OperationLog.
+var DefaultOperationLogOrder = Desc(operationlog.FieldID)
+
+// NewOperationLogPager creates a new pager with the given options
+func NewOperationLogPager(opts ...OperationLogPaginateOption) (*OperationLogPager, error) {
+	pager := &OperationLogPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOperationLogOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for OperationLog pagination
+func WithOperationLogOrder(order operationlog.OrderOption) OperationLogPaginateOption {
+	return func(p *OperationLogPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for OperationLog pagination
+func WithOperationLogFilter(filter func(*OperationLogQuery) (*OperationLogQuery, error)) OperationLogPaginateOption {
+	return func(p *OperationLogPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *OperationLogPager) ApplyFilter(query *OperationLogQuery) (*OperationLogQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OperationLogPageList is OperationLog PageList result.
+type OperationLogPageList struct {
+	List        []*OperationLog `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
+}
+
+// Page performs paginated query for OperationLog
+func (_m *OperationLogQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OperationLogPaginateOption,
+) (*OperationLogPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOperationLogPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogPageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count operationlog: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*OperationLog{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOperationLogOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlog: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for OperationLog
+func (_m *OperationLogQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OperationLogPaginateOption,
+) (*OperationLogPageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOperationLogPager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogPageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*OperationLog{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOperationLogOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlog: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// operationlogCursorFields are the sort keys of OperationLog usable for cursor pagination
+var operationlogCursorFields = map[string]queryKind{
+	operationlog.FieldID:            queryKindUint,
+	operationlog.FieldCreatedAt:     queryKindTime,
+	operationlog.FieldModule:        queryKindString,
+	operationlog.FieldExecutionTime: queryKindInt,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *OperationLog) cursorValue(field string) any {
+	switch field {
+	case operationlog.FieldCreatedAt:
+		return _m.CreatedAt
+	case operationlog.FieldModule:
+		return _m.Module
+	case operationlog.FieldExecutionTime:
+		return _m.ExecutionTime
+	default:
+		return _m.ID
+	}
+}
+
+// OperationLogCursorList is OperationLog cursor page result.
+type OperationLogCursorList struct {
+	List        []*OperationLog    `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for OperationLog, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *OperationLogQuery) CursorPage(ctx context.Context, req *CursorRequest) (*OperationLogCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(operationlogCursorFields, req.Field, operationlog.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(operationlogCursorFields, req.Cursor, field, operationlog.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.OperationLog(cursorPredicate(field, operationlog.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count operationlog: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.OperationLog(cursorPredicate(field, operationlog.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != operationlog.FieldID {
+		_m = _m.Order(sql.OrderByField(operationlog.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlog: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// OperationLogArchivePager provides pagination functionality for OperationLogArchive
+type OperationLogArchivePager struct {
+	Order  operationlogarchive.OrderOption
+	Filter func(*OperationLogArchiveQuery) (*OperationLogArchiveQuery, error)
+}
+
+// OperationLogArchivePaginateOption enables pagination customization.
+type OperationLogArchivePaginateOption func(*OperationLogArchivePager)
+
+// DefaultOperationLogArchiveOrder is the default ordering of OperationLogArchive.
+var DefaultOperationLogArchiveOrder = Desc(operationlogarchive.FieldID)
+
+// NewOperationLogArchivePager creates a new pager with the given options
+func NewOperationLogArchivePager(opts ...OperationLogArchivePaginateOption) (*OperationLogArchivePager, error) {
+	pager := &OperationLogArchivePager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultOperationLogArchiveOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for OperationLogArchive pagination
+func WithOperationLogArchiveOrder(order operationlogarchive.OrderOption) OperationLogArchivePaginateOption {
+	return func(p *OperationLogArchivePager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for OperationLogArchive pagination
+func WithOperationLogArchiveFilter(filter func(*OperationLogArchiveQuery) (*OperationLogArchiveQuery, error)) OperationLogArchivePaginateOption {
+	return func(p *OperationLogArchivePager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *OperationLogArchivePager) ApplyFilter(query *OperationLogArchiveQuery) (*OperationLogArchiveQuery, error) {
+	if p.Filter != nil {
+		return p.Filter(query)
+	}
+	return query, nil
+}
+
+// OperationLogArchivePageList is OperationLogArchive PageList result.
+type OperationLogArchivePageList struct {
+	List        []*OperationLogArchive `json:"list"`
+	PageDetails *PageDetails           `json:"pageDetails"`
+}
+
+// Page performs paginated query for OperationLogArchive
+func (_m *OperationLogArchiveQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OperationLogArchivePaginateOption,
+) (*OperationLogArchivePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOperationLogArchivePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogArchivePageList{}
+	ret.PageDetails = &PageDetails{
+		Page: pageNum,
+		Size: pageSize,
+	}
+
+	// Get total count
+	countQuery := _m.Clone()
+	countQuery.ctx.Fields = nil
+	count, err := countQuery.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count operationlogarchive: %w", err)
+	}
+
+	ret.PageDetails.Total = uint64(count)
+	ret.PageDetails.Pages = CalculatePages(ret.PageDetails.Total, pageSize)
+
+	// If no records, return empty list
+	if count == 0 {
+		ret.List = []*OperationLogArchive{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOperationLogArchiveOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlogarchive: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PageWithCount performs paginated query with pre-calculated count for OperationLogArchive
+func (_m *OperationLogArchiveQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OperationLogArchivePaginateOption,
+) (*OperationLogArchivePageList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(pageNum, pageSize); err != nil {
+		return nil, err
+	}
+
+	// Create pager with options
+	pager, err := NewOperationLogArchivePager(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply filter if set
+	if _m, err = pager.ApplyFilter(_m); err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogArchivePageList{}
+	ret.PageDetails = &PageDetails{
+		Page:  pageNum,
+		Size:  pageSize,
+		Total: totalCount,
+		Pages: CalculatePages(totalCount, pageSize),
+	}
+
+	// If no records, return empty list
+	if totalCount == 0 {
+		ret.List = []*OperationLogArchive{}
+		return ret, nil
+	}
+
+	// Apply ordering
+	if pager.Order != nil {
+		_m = _m.Order(pager.Order)
+	} else {
+		_m = _m.Order(DefaultOperationLogArchiveOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlogarchive: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// operationlogarchiveCursorFields are the sort keys of OperationLogArchive usable for cursor pagination
+var operationlogarchiveCursorFields = map[string]queryKind{
+	operationlogarchive.FieldID:        queryKindUint,
+	operationlogarchive.FieldCreatedAt: queryKindTime,
+	operationlogarchive.FieldModule:    queryKindString,
+	operationlogarchive.FieldStartTime: queryKindTime,
+	operationlogarchive.FieldEndTime:   queryKindTime,
+	operationlogarchive.FieldRowCount:  queryKindInt,
+	operationlogarchive.FieldSize:      queryKindInt,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *OperationLogArchive) cursorValue(field string) any {
+	switch field {
+	case operationlogarchive.FieldCreatedAt:
+		return _m.CreatedAt
+	case operationlogarchive.FieldModule:
+		return _m.Module
+	case operationlogarchive.FieldStartTime:
+		return _m.StartTime
+	case operationlogarchive.FieldEndTime:
+		return _m.EndTime
+	case operationlogarchive.FieldRowCount:
+		return _m.RowCount
+	case operationlogarchive.FieldSize:
+		return _m.Size
+	default:
+		return _m.ID
+	}
+}
+
+// OperationLogArchiveCursorList is OperationLogArchive cursor page result.
+type OperationLogArchiveCursorList struct {
+	List        []*OperationLogArchive `json:"list"`
+	PageDetails *CursorPageDetails     `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for OperationLogArchive, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *OperationLogArchiveQuery) CursorPage(ctx context.Context, req *CursorRequest) (*OperationLogArchiveCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(operationlogarchiveCursorFields, req.Field, operationlogarchive.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &OperationLogArchiveCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(operationlogarchiveCursorFields, req.Cursor, field, operationlogarchive.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.OperationLogArchive(cursorPredicate(field, operationlogarchive.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count operationlogarchive: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.OperationLogArchive(cursorPredicate(field, operationlogarchive.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != operationlogarchive.FieldID {
+		_m = _m.Order(sql.OrderByField(operationlogarchive.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operationlogarchive: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PermissionSnapshotPager provides pagination functionality for PermissionSnapshot
+type PermissionSnapshotPager struct {
+	Order  permissionsnapshot.OrderOption
+	Filter func(*PermissionSnapshotQuery) (*PermissionSnapshotQuery, error)
+}
+
+// PermissionSnapshotPaginateOption enables pagination customization.
+type PermissionSnapshotPaginateOption func(*PermissionSnapshotPager)
+
+// DefaultPermissionSnapshotOrder is the default ordering of PermissionSnapshot.
+var DefaultPermissionSnapshotOrder = Desc(permissionsnapshot.FieldID)
+
+// NewPermissionSnapshotPager creates a new pager with the given options
+func NewPermissionSnapshotPager(opts ...PermissionSnapshotPaginateOption) (*PermissionSnapshotPager, error) {
+	pager := &PermissionSnapshotPager{}
+	for _, opt := range opts {
+		opt(pager)
+	}
+	if pager.Order == nil {
+		pager.Order = DefaultPermissionSnapshotOrder
+	}
+	return pager, nil
+}
+
+// WithOrder sets the order option for PermissionSnapshot pagination
+func WithPermissionSnapshotOrder(order permissionsnapshot.OrderOption) PermissionSnapshotPaginateOption {
+	return func(p *PermissionSnapshotPager) {
+		p.Order = order
+	}
+}
+
+// WithFilter sets the filter function for PermissionSnapshot pagination
+func WithPermissionSnapshotFilter(filter func(*PermissionSnapshotQuery) (*PermissionSnapshotQuery, error)) PermissionSnapshotPaginateOption {
+	return func(p *PermissionSnapshotPager) {
+		p.Filter = filter
+	}
+}
+
+// ApplyFilter applies the filter to the query if set
+func (p *PermissionSnapshotPager) ApplyFilter(query *PermissionSnapshotQuery) (*PermissionSnapshotQuery, error) {
+	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// DictItemPageList is DictItem PageList result.
-type DictItemPageList struct {
-	List        []*DictItem  `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// PermissionSnapshotPageList is PermissionSnapshot PageList result.
+type PermissionSnapshotPageList struct {
+	List        []*PermissionSnapshot `json:"list"`
+	PageDetails *PageDetails          `json:"pageDetails"`
 }
 
-// Page performs paginated query for DictItem
-func (_m *DictItemQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DictItemPaginateOption,
-) (*DictItemPageList, error) {
+// Page performs paginated query for PermissionSnapshot
+func (_m *PermissionSnapshotQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...PermissionSnapshotPaginateOption,
+) (*PermissionSnapshotPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDictItemPager(opts...)
+	pager, err := NewPermissionSnapshotPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -677,7 +4305,7 @@ func (_m *DictItemQuery) Page(
 		return nil, err
 	}
 
-	ret := &DictItemPageList{}
+	ret := &PermissionSnapshotPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -688,7 +4316,7 @@ func (_m *DictItemQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count dictitem: %w", err)
+		return nil, fmt.Errorf("failed to count permissionsnapshot: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -696,7 +4324,7 @@ func (_m *DictItemQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*DictItem{}
+		ret.List = []*PermissionSnapshot{}
 		return ret, nil
 	}
 
@@ -704,7 +4332,7 @@ func (_m *DictItemQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictItemOrder)
+		_m = _m.Order(DefaultPermissionSnapshotOrder)
 	}
 
 	// Apply pagination
@@ -713,24 +4341,24 @@ func (_m *DictItemQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query dictitem: %w", err)
+		return nil, fmt.Errorf("failed to query permissionsnapshot: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for DictItem
-func (_m *DictItemQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DictItemPaginateOption,
-) (*DictItemPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for PermissionSnapshot
+func (_m *PermissionSnapshotQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...PermissionSnapshotPaginateOption,
+) (*PermissionSnapshotPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDictItemPager(opts...)
+	pager, err := NewPermissionSnapshotPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -740,7 +4368,7 @@ func (_m *DictItemQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &DictItemPageList{}
+	ret := &PermissionSnapshotPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -750,7 +4378,7 @@ func (_m *DictItemQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*DictItem{}
+		ret.List = []*PermissionSnapshot{}
 		return ret, nil
 	}
 
@@ -758,7 +4386,7 @@ func (_m *DictItemQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictItemOrder)
+		_m = _m.Order(DefaultPermissionSnapshotOrder)
 	}
 
 	// Apply pagination
@@ -767,76 +4395,168 @@ func (_m *DictItemQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query dictitem: %w", err)
+		return nil, fmt.Errorf("failed to query permissionsnapshot: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// DictTypePager provides pagination functionality for DictType
-type DictTypePager struct {
-	Order  dicttype.OrderOption
-	Filter func(*DictTypeQuery) (*DictTypeQuery, error)
+// permissionsnapshotCursorFields are the sort keys of PermissionSnapshot usable for cursor pagination
+var permissionsnapshotCursorFields = map[string]queryKind{
+	permissionsnapshot.FieldID:        queryKindUint,
+	permissionsnapshot.FieldCreatedAt: queryKindTime,
 }
 
-// DictTypePaginateOption enables pagination customization.
-type DictTypePaginateOption func(*DictTypePager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *PermissionSnapshot) cursorValue(field string) any {
+	switch field {
+	case permissionsnapshot.FieldCreatedAt:
+		return _m.CreatedAt
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultDictTypeOrder is the default ordering of DictType.
-var DefaultDictTypeOrder = Desc(dicttype.FieldID)
+// PermissionSnapshotCursorList is PermissionSnapshot cursor page result.
+type PermissionSnapshotCursorList struct {
+	List        []*PermissionSnapshot `json:"list"`
+	PageDetails *CursorPageDetails    `json:"pageDetails"`
+}
 
-// NewDictTypePager creates a new pager with the given options
-func NewDictTypePager(opts ...DictTypePaginateOption) (*DictTypePager, error) {
-	pager := &DictTypePager{}
+// CursorPage performs keyset pagination for PermissionSnapshot, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *PermissionSnapshotQuery) CursorPage(ctx context.Context, req *CursorRequest) (*PermissionSnapshotCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(permissionsnapshotCursorFields, req.Field, permissionsnapshot.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &PermissionSnapshotCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(permissionsnapshotCursorFields, req.Cursor, field, permissionsnapshot.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.PermissionSnapshot(cursorPredicate(field, permissionsnapshot.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count permissionsnapshot: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.PermissionSnapshot(cursorPredicate(field, permissionsnapshot.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != permissionsnapshot.FieldID {
+		_m = _m.Order(sql.OrderByField(permissionsnapshot.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query permissionsnapshot: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// PositionPager provides pagination functionality for Position
+type PositionPager struct {
+	Order  position.OrderOption
+	Filter func(*PositionQuery) (*PositionQuery, error)
+}
+
+// PositionPaginateOption enables pagination customization.
+type PositionPaginateOption func(*PositionPager)
+
+// DefaultPositionOrder is the default ordering of Position.
+var DefaultPositionOrder = Desc(position.FieldID)
+
+// NewPositionPager creates a new pager with the given options
+func NewPositionPager(opts ...PositionPaginateOption) (*PositionPager, error) {
+	pager := &PositionPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultDictTypeOrder
+		pager.Order = DefaultPositionOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for DictType pagination
-func WithDictTypeOrder(order dicttype.OrderOption) DictTypePaginateOption {
-	return func(p *DictTypePager) {
+// WithOrder sets the order option for Position pagination
+func WithPositionOrder(order position.OrderOption) PositionPaginateOption {
+	return func(p *PositionPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for DictType pagination
-func WithDictTypeFilter(filter func(*DictTypeQuery) (*DictTypeQuery, error)) DictTypePaginateOption {
-	return func(p *DictTypePager) {
+// WithFilter sets the filter function for Position pagination
+func WithPositionFilter(filter func(*PositionQuery) (*PositionQuery, error)) PositionPaginateOption {
+	return func(p *PositionPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *DictTypePager) ApplyFilter(query *DictTypeQuery) (*DictTypeQuery, error) {
+func (p *PositionPager) ApplyFilter(query *PositionQuery) (*PositionQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// DictTypePageList is DictType PageList result.
-type DictTypePageList struct {
-	List        []*DictType  `json:"list"`
+// PositionPageList is Position PageList result.
+type PositionPageList struct {
+	List        []*Position  `json:"list"`
 	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for DictType
-func (_m *DictTypeQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...DictTypePaginateOption,
-) (*DictTypePageList, error) {
+// Page performs paginated query for Position
+func (_m *PositionQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...PositionPaginateOption,
+) (*PositionPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDictTypePager(opts...)
+	pager, err := NewPositionPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -846,7 +4566,7 @@ func (_m *DictTypeQuery) Page(
 		return nil, err
 	}
 
-	ret := &DictTypePageList{}
+	ret := &PositionPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -857,7 +4577,7 @@ func (_m *DictTypeQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count dicttype: %w", err)
+		return nil, fmt.Errorf("failed to count position: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -865,7 +4585,7 @@ func (_m *DictTypeQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*DictType{}
+		ret.List = []*Position{}
 		return ret, nil
 	}
 
@@ -873,7 +4593,7 @@ func (_m *DictTypeQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictTypeOrder)
+		_m = _m.Order(DefaultPositionOrder)
 	}
 
 	// Apply pagination
@@ -882,24 +4602,24 @@ func (_m *DictTypeQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query dicttype: %w", err)
+		return nil, fmt.Errorf("failed to query position: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for DictType
-func (_m *DictTypeQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...DictTypePaginateOption,
-) (*DictTypePageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for Position
+func (_m *PositionQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...PositionPaginateOption,
+) (*PositionPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewDictTypePager(opts...)
+	pager, err := NewPositionPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -909,7 +4629,7 @@ func (_m *DictTypeQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &DictTypePageList{}
+	ret := &PositionPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -919,7 +4639,7 @@ func (_m *DictTypeQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*DictType{}
+		ret.List = []*Position{}
 		return ret, nil
 	}
 
@@ -927,7 +4647,7 @@ func (_m *DictTypeQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultDictTypeOrder)
+		_m = _m.Order(DefaultPositionOrder)
 	}
 
 	// Apply pagination
@@ -936,76 +4656,180 @@ func (_m *DictTypeQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query dicttype: %w", err)
+		return nil, fmt.Errorf("failed to query position: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// MenuPager provides pagination functionality for Menu
-type MenuPager struct {
-	Order  menu.OrderOption
-	Filter func(*MenuQuery) (*MenuQuery, error)
+// positionCursorFields are the sort keys of Position usable for cursor pagination
+var positionCursorFields = map[string]queryKind{
+	position.FieldID:           queryKindUint,
+	position.FieldCreatedAt:    queryKindTime,
+	position.FieldUpdatedAt:    queryKindTime,
+	position.FieldSort:         queryKindInt,
+	position.FieldPositionName: queryKindString,
+	position.FieldPositionCode: queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Position) cursorValue(field string) any {
+	switch field {
+	case position.FieldCreatedAt:
+		return _m.CreatedAt
+	case position.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case position.FieldSort:
+		return _m.Sort
+	case position.FieldPositionName:
+		return _m.PositionName
+	case position.FieldPositionCode:
+		return _m.PositionCode
+	default:
+		return _m.ID
+	}
+}
+
+// PositionCursorList is Position cursor page result.
+type PositionCursorList struct {
+	List        []*Position        `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for Position, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *PositionQuery) CursorPage(ctx context.Context, req *CursorRequest) (*PositionCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(positionCursorFields, req.Field, position.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &PositionCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(positionCursorFields, req.Cursor, field, position.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Position(cursorPredicate(field, position.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count position: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Position(cursorPredicate(field, position.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != position.FieldID {
+		_m = _m.Order(sql.OrderByField(position.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query position: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// RolePager provides pagination functionality for Role
+type RolePager struct {
+	Order  role.OrderOption
+	Filter func(*RoleQuery) (*RoleQuery, error)
 }
 
-// MenuPaginateOption enables pagination customization.
-type MenuPaginateOption func(*MenuPager)
+// RolePaginateOption enables pagination customization.
+type RolePaginateOption func(*RolePager)
 
-// DefaultMenuOrder is the default ordering of Menu.
-var DefaultMenuOrder = Desc(menu.FieldID)
+// DefaultRoleOrder is the default ordering of Role.
+var DefaultRoleOrder = Desc(role.FieldID)
 
-// NewMenuPager creates a new pager with the given options
-func NewMenuPager(opts ...MenuPaginateOption) (*MenuPager, error) {
-	pager := &MenuPager{}
+// NewRolePager creates a new pager with the given options
+func NewRolePager(opts ...RolePaginateOption) (*RolePager, error) {
+	pager := &RolePager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultMenuOrder
+		pager.Order = DefaultRoleOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Menu pagination
-func WithMenuOrder(order menu.OrderOption) MenuPaginateOption {
-	return func(p *MenuPager) {
+// WithOrder sets the order option for Role pagination
+func WithRoleOrder(order role.OrderOption) RolePaginateOption {
+	return func(p *RolePager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Menu pagination
-func WithMenuFilter(filter func(*MenuQuery) (*MenuQuery, error)) MenuPaginateOption {
-	return func(p *MenuPager) {
+// WithFilter sets the filter function for Role pagination
+func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
+	return func(p *RolePager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *MenuPager) ApplyFilter(query *MenuQuery) (*MenuQuery, error) {
+func (p *RolePager) ApplyFilter(query *RoleQuery) (*RoleQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// MenuPageList is Menu PageList result.
-type MenuPageList struct {
-	List        []*Menu      `json:"list"`
+// RolePageList is Role PageList result.
+type RolePageList struct {
+	List        []*Role      `json:"list"`
 	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for Menu
-func (_m *MenuQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...MenuPaginateOption,
-) (*MenuPageList, error) {
+// Page performs paginated query for Role
+func (_m *RoleQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...RolePaginateOption,
+) (*RolePageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewMenuPager(opts...)
+	pager, err := NewRolePager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1015,7 +4839,7 @@ func (_m *MenuQuery) Page(
 		return nil, err
 	}
 
-	ret := &MenuPageList{}
+	ret := &RolePageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1026,7 +4850,7 @@ func (_m *MenuQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count menu: %w", err)
+		return nil, fmt.Errorf("failed to count role: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1034,7 +4858,7 @@ func (_m *MenuQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Menu{}
+		ret.List = []*Role{}
 		return ret, nil
 	}
 
@@ -1042,7 +4866,7 @@ func (_m *MenuQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultMenuOrder)
+		_m = _m.Order(DefaultRoleOrder)
 	}
 
 	// Apply pagination
@@ -1051,24 +4875,24 @@ func (_m *MenuQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query menu: %w", err)
+		return nil, fmt.Errorf("failed to query role: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Menu
-func (_m *MenuQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...MenuPaginateOption,
-) (*MenuPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for Role
+func (_m *RoleQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...RolePaginateOption,
+) (*RolePageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewMenuPager(opts...)
+	pager, err := NewRolePager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1078,7 +4902,7 @@ func (_m *MenuQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &MenuPageList{}
+	ret := &RolePageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1088,7 +4912,7 @@ func (_m *MenuQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Menu{}
+		ret.List = []*Role{}
 		return ret, nil
 	}
 
@@ -1096,7 +4920,7 @@ func (_m *MenuQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultMenuOrder)
+		_m = _m.Order(DefaultRoleOrder)
 	}
 
 	// Apply pagination
@@ -1105,76 +4929,177 @@ func (_m *MenuQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query menu: %w", err)
+		return nil, fmt.Errorf("failed to query role: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// OauthProviderPager provides pagination functionality for OauthProvider
-type OauthProviderPager struct {
-	Order  oauthprovider.OrderOption
-	Filter func(*OauthProviderQuery) (*OauthProviderQuery, error)
+// roleCursorFields are the sort keys of Role usable for cursor pagination
+var roleCursorFields = map[string]queryKind{
+	role.FieldID:        queryKindUint,
+	role.FieldCreatedAt: queryKindTime,
+	role.FieldUpdatedAt: queryKindTime,
+	role.FieldRoleName:  queryKindString,
+	role.FieldRoleCode:  queryKindString,
 }
 
-// OauthProviderPaginateOption enables pagination customization.
-type OauthProviderPaginateOption func(*OauthProviderPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Role) cursorValue(field string) any {
+	switch field {
+	case role.FieldCreatedAt:
+		return _m.CreatedAt
+	case role.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case role.FieldRoleName:
+		return _m.RoleName
+	case role.FieldRoleCode:
+		return _m.RoleCode
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultOauthProviderOrder is the default ordering of OauthProvider.
-var DefaultOauthProviderOrder = Desc(oauthprovider.FieldID)
+// RoleCursorList is Role cursor page result.
+type RoleCursorList struct {
+	List        []*Role            `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewOauthProviderPager creates a new pager with the given options
-func NewOauthProviderPager(opts ...OauthProviderPaginateOption) (*OauthProviderPager, error) {
-	pager := &OauthProviderPager{}
+// CursorPage performs keyset pagination for Role, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *RoleQuery) CursorPage(ctx context.Context, req *CursorRequest) (*RoleCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(roleCursorFields, req.Field, role.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &RoleCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(roleCursorFields, req.Cursor, field, role.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Role(cursorPredicate(field, role.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count role: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Role(cursorPredicate(field, role.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != role.FieldID {
+		_m = _m.Order(sql.OrderByField(role.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query role: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// ScheduledJobPager provides pagination functionality for ScheduledJob
+type ScheduledJobPager struct {
+	Order  scheduledjob.OrderOption
+	Filter func(*ScheduledJobQuery) (*ScheduledJobQuery, error)
+}
+
+// ScheduledJobPaginateOption enables pagination customization.
+type ScheduledJobPaginateOption func(*ScheduledJobPager)
+
+// DefaultScheduledJobOrder is the default ordering of ScheduledJob.
+var DefaultScheduledJobOrder = Desc(scheduledjob.FieldID)
+
+// NewScheduledJobPager creates a new pager with the given options
+func NewScheduledJobPager(opts ...ScheduledJobPaginateOption) (*ScheduledJobPager, error) {
+	pager := &ScheduledJobPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultOauthProviderOrder
+		pager.Order = DefaultScheduledJobOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for OauthProvider pagination
-func WithOauthProviderOrder(order oauthprovider.OrderOption) OauthProviderPaginateOption {
-	return func(p *OauthProviderPager) {
+// WithOrder sets the order option for ScheduledJob pagination
+func WithScheduledJobOrder(order scheduledjob.OrderOption) ScheduledJobPaginateOption {
+	return func(p *ScheduledJobPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for OauthProvider pagination
-func WithOauthProviderFilter(filter func(*OauthProviderQuery) (*OauthProviderQuery, error)) OauthProviderPaginateOption {
-	return func(p *OauthProviderPager) {
+// WithFilter sets the filter function for ScheduledJob pagination
+func WithScheduledJobFilter(filter func(*ScheduledJobQuery) (*ScheduledJobQuery, error)) ScheduledJobPaginateOption {
+	return func(p *ScheduledJobPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *OauthProviderPager) ApplyFilter(query *OauthProviderQuery) (*OauthProviderQuery, error) {
+func (p *ScheduledJobPager) ApplyFilter(query *ScheduledJobQuery) (*ScheduledJobQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// OauthProviderPageList is OauthProvider PageList result.
-type OauthProviderPageList struct {
-	List        []*OauthProvider `json:"list"`
-	PageDetails *PageDetails     `json:"pageDetails"`
+// ScheduledJobPageList is ScheduledJob PageList result.
+type ScheduledJobPageList struct {
+	List        []*ScheduledJob `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
 }
 
-// Page performs paginated query for OauthProvider
-func (_m *OauthProviderQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OauthProviderPaginateOption,
-) (*OauthProviderPageList, error) {
+// Page performs paginated query for ScheduledJob
+func (_m *ScheduledJobQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...ScheduledJobPaginateOption,
+) (*ScheduledJobPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewOauthProviderPager(opts...)
+	pager, err := NewScheduledJobPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1184,7 +5109,7 @@ func (_m *OauthProviderQuery) Page(
 		return nil, err
 	}
 
-	ret := &OauthProviderPageList{}
+	ret := &ScheduledJobPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1195,7 +5120,7 @@ func (_m *OauthProviderQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count oauthprovider: %w", err)
+		return nil, fmt.Errorf("failed to count scheduledjob: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1203,7 +5128,7 @@ func (_m *OauthProviderQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*OauthProvider{}
+		ret.List = []*ScheduledJob{}
 		return ret, nil
 	}
 
@@ -1211,7 +5136,7 @@ func (_m *OauthProviderQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOauthProviderOrder)
+		_m = _m.Order(DefaultScheduledJobOrder)
 	}
 
 	// Apply pagination
@@ -1220,24 +5145,24 @@ func (_m *OauthProviderQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query oauthprovider: %w", err)
+		return nil, fmt.Errorf("failed to query scheduledjob: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for OauthProvider
-func (_m *OauthProviderQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OauthProviderPaginateOption,
-) (*OauthProviderPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for ScheduledJob
+func (_m *ScheduledJobQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...ScheduledJobPaginateOption,
+) (*ScheduledJobPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewOauthProviderPager(opts...)
+	pager, err := NewScheduledJobPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1247,7 +5172,7 @@ func (_m *OauthProviderQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &OauthProviderPageList{}
+	ret := &ScheduledJobPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1257,7 +5182,7 @@ func (_m *OauthProviderQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*OauthProvider{}
+		ret.List = []*ScheduledJob{}
 		return ret, nil
 	}
 
@@ -1265,7 +5190,7 @@ func (_m *OauthProviderQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOauthProviderOrder)
+		_m = _m.Order(DefaultScheduledJobOrder)
 	}
 
 	// Apply pagination
@@ -1274,76 +5199,165 @@ func (_m *OauthProviderQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query oauthprovider: %w", err)
+		return nil, fmt.Errorf("failed to query scheduledjob: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// OperationLogPager provides pagination functionality for OperationLog
-type OperationLogPager struct {
-	Order  operationlog.OrderOption
-	Filter func(*OperationLogQuery) (*OperationLogQuery, error)
+// scheduledjobCursorFields are the sort keys of ScheduledJob usable for cursor pagination
+var scheduledjobCursorFields = map[string]queryKind{
+	scheduledjob.FieldID: queryKindUint,
 }
 
-// OperationLogPaginateOption enables pagination customization.
-type OperationLogPaginateOption func(*OperationLogPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *ScheduledJob) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultOperationLogOrder is the default ordering of OperationLog.
-var DefaultOperationLogOrder = Desc(operationlog.FieldID)
+// ScheduledJobCursorList is ScheduledJob cursor page result.
+type ScheduledJobCursorList struct {
+	List        []*ScheduledJob    `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewOperationLogPager creates a new pager with the given options
-func NewOperationLogPager(opts ...OperationLogPaginateOption) (*OperationLogPager, error) {
-	pager := &OperationLogPager{}
+// CursorPage performs keyset pagination for ScheduledJob, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *ScheduledJobQuery) CursorPage(ctx context.Context, req *CursorRequest) (*ScheduledJobCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(scheduledjobCursorFields, req.Field, scheduledjob.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ScheduledJobCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(scheduledjobCursorFields, req.Cursor, field, scheduledjob.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.ScheduledJob(cursorPredicate(field, scheduledjob.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count scheduledjob: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.ScheduledJob(cursorPredicate(field, scheduledjob.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != scheduledjob.FieldID {
+		_m = _m.Order(sql.OrderByField(scheduledjob.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scheduledjob: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// SecurityEventPager provides pagination functionality for SecurityEvent
+type SecurityEventPager struct {
+	Order  securityevent.OrderOption
+	Filter func(*SecurityEventQuery) (*SecurityEventQuery, error)
+}
+
+// SecurityEventPaginateOption enables pagination customization.
+type SecurityEventPaginateOption func(*SecurityEventPager)
+
+// DefaultSecurityEventOrder is the default ordering of SecurityEvent.
+var DefaultSecurityEventOrder = Desc(securityevent.FieldID)
+
+// NewSecurityEventPager creates a new pager with the given options
+func NewSecurityEventPager(opts ...SecurityEventPaginateOption) (*SecurityEventPager, error) {
+	pager := &SecurityEventPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultOperationLogOrder
+		pager.Order = DefaultSecurityEventOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for OperationLog pagination
-func WithOperationLogOrder(order operationlog.OrderOption) OperationLogPaginateOption {
-	return func(p *OperationLogPager) {
+// WithOrder sets the order option for SecurityEvent pagination
+func WithSecurityEventOrder(order securityevent.OrderOption) SecurityEventPaginateOption {
+	return func(p *SecurityEventPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for OperationLog pagination
-func WithOperationLogFilter(filter func(*OperationLogQuery) (*OperationLogQuery, error)) OperationLogPaginateOption {
-	return func(p *OperationLogPager) {
+// WithFilter sets the filter function for SecurityEvent pagination
+func WithSecurityEventFilter(filter func(*SecurityEventQuery) (*SecurityEventQuery, error)) SecurityEventPaginateOption {
+	return func(p *SecurityEventPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *OperationLogPager) ApplyFilter(query *OperationLogQuery) (*OperationLogQuery, error) {
+func (p *SecurityEventPager) ApplyFilter(query *SecurityEventQuery) (*SecurityEventQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// OperationLogPageList is OperationLog PageList result.
-type OperationLogPageList struct {
-	List        []*OperationLog `json:"list"`
-	PageDetails *PageDetails    `json:"pageDetails"`
+// SecurityEventPageList is SecurityEvent PageList result.
+type SecurityEventPageList struct {
+	List        []*SecurityEvent `json:"list"`
+	PageDetails *PageDetails     `json:"pageDetails"`
 }
 
-// Page performs paginated query for OperationLog
-func (_m *OperationLogQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...OperationLogPaginateOption,
-) (*OperationLogPageList, error) {
+// Page performs paginated query for SecurityEvent
+func (_m *SecurityEventQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...SecurityEventPaginateOption,
+) (*SecurityEventPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewOperationLogPager(opts...)
+	pager, err := NewSecurityEventPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1353,7 +5367,7 @@ func (_m *OperationLogQuery) Page(
 		return nil, err
 	}
 
-	ret := &OperationLogPageList{}
+	ret := &SecurityEventPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1364,7 +5378,7 @@ func (_m *OperationLogQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count operationlog: %w", err)
+		return nil, fmt.Errorf("failed to count securityevent: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1372,7 +5386,7 @@ func (_m *OperationLogQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*OperationLog{}
+		ret.List = []*SecurityEvent{}
 		return ret, nil
 	}
 
@@ -1380,7 +5394,7 @@ func (_m *OperationLogQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOperationLogOrder)
+		_m = _m.Order(DefaultSecurityEventOrder)
 	}
 
 	// Apply pagination
@@ -1389,24 +5403,24 @@ func (_m *OperationLogQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query operationlog: %w", err)
+		return nil, fmt.Errorf("failed to query securityevent: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for OperationLog
-func (_m *OperationLogQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...OperationLogPaginateOption,
-) (*OperationLogPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for SecurityEvent
+func (_m *SecurityEventQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...SecurityEventPaginateOption,
+) (*SecurityEventPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewOperationLogPager(opts...)
+	pager, err := NewSecurityEventPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1416,7 +5430,7 @@ func (_m *OperationLogQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &OperationLogPageList{}
+	ret := &SecurityEventPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1426,7 +5440,7 @@ func (_m *OperationLogQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*OperationLog{}
+		ret.List = []*SecurityEvent{}
 		return ret, nil
 	}
 
@@ -1434,85 +5448,180 @@ func (_m *OperationLogQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultOperationLogOrder)
+		_m = _m.Order(DefaultSecurityEventOrder)
+	}
+
+	// Apply pagination
+	offset := CalculateOffset(pageNum, pageSize)
+	_m = _m.Offset(offset).Limit(int(pageSize))
+
+	list, err := _m.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query securityevent: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// securityeventCursorFields are the sort keys of SecurityEvent usable for cursor pagination
+var securityeventCursorFields = map[string]queryKind{
+	securityevent.FieldID:        queryKindUint,
+	securityevent.FieldCreatedAt: queryKindTime,
+	securityevent.FieldEventType: queryKindString,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *SecurityEvent) cursorValue(field string) any {
+	switch field {
+	case securityevent.FieldCreatedAt:
+		return _m.CreatedAt
+	case securityevent.FieldEventType:
+		return _m.EventType
+	default:
+		return _m.ID
+	}
+}
+
+// SecurityEventCursorList is SecurityEvent cursor page result.
+type SecurityEventCursorList struct {
+	List        []*SecurityEvent   `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for SecurityEvent, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *SecurityEventQuery) CursorPage(ctx context.Context, req *CursorRequest) (*SecurityEventCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(securityeventCursorFields, req.Field, securityevent.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &SecurityEventCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
 	}
 
-	// Apply pagination
-	offset := CalculateOffset(pageNum, pageSize)
-	_m = _m.Offset(offset).Limit(int(pageSize))
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(securityeventCursorFields, req.Cursor, field, securityevent.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
 
-	list, err := _m.All(ctx)
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.SecurityEvent(cursorPredicate(field, securityevent.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count securityevent: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.SecurityEvent(cursorPredicate(field, securityevent.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != securityevent.FieldID {
+		_m = _m.Order(sql.OrderByField(securityevent.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query operationlog: %w", err)
+		return nil, fmt.Errorf("failed to query securityevent: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PositionPager provides pagination functionality for Position
-type PositionPager struct {
-	Order  position.OrderOption
-	Filter func(*PositionQuery) (*PositionQuery, error)
+// TokenPager provides pagination functionality for Token
+type TokenPager struct {
+	Order  token.OrderOption
+	Filter func(*TokenQuery) (*TokenQuery, error)
 }
 
-// PositionPaginateOption enables pagination customization.
-type PositionPaginateOption func(*PositionPager)
+// TokenPaginateOption enables pagination customization.
+type TokenPaginateOption func(*TokenPager)
 
-// DefaultPositionOrder is the default ordering of Position.
-var DefaultPositionOrder = Desc(position.FieldID)
+// DefaultTokenOrder is the default ordering of Token.
+var DefaultTokenOrder = Desc(token.FieldID)
 
-// NewPositionPager creates a new pager with the given options
-func NewPositionPager(opts ...PositionPaginateOption) (*PositionPager, error) {
-	pager := &PositionPager{}
+// NewTokenPager creates a new pager with the given options
+func NewTokenPager(opts ...TokenPaginateOption) (*TokenPager, error) {
+	pager := &TokenPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultPositionOrder
+		pager.Order = DefaultTokenOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Position pagination
-func WithPositionOrder(order position.OrderOption) PositionPaginateOption {
-	return func(p *PositionPager) {
+// WithOrder sets the order option for Token pagination
+func WithTokenOrder(order token.OrderOption) TokenPaginateOption {
+	return func(p *TokenPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Position pagination
-func WithPositionFilter(filter func(*PositionQuery) (*PositionQuery, error)) PositionPaginateOption {
-	return func(p *PositionPager) {
+// WithFilter sets the filter function for Token pagination
+func WithTokenFilter(filter func(*TokenQuery) (*TokenQuery, error)) TokenPaginateOption {
+	return func(p *TokenPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *PositionPager) ApplyFilter(query *PositionQuery) (*PositionQuery, error) {
+func (p *TokenPager) ApplyFilter(query *TokenQuery) (*TokenQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// PositionPageList is Position PageList result.
-type PositionPageList struct {
-	List        []*Position  `json:"list"`
+// TokenPageList is Token PageList result.
+type TokenPageList struct {
+	List        []*Token     `json:"list"`
 	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for Position
-func (_m *PositionQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...PositionPaginateOption,
-) (*PositionPageList, error) {
+// Page performs paginated query for Token
+func (_m *TokenQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...TokenPaginateOption,
+) (*TokenPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewPositionPager(opts...)
+	pager, err := NewTokenPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1522,7 +5631,7 @@ func (_m *PositionQuery) Page(
 		return nil, err
 	}
 
-	ret := &PositionPageList{}
+	ret := &TokenPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1533,7 +5642,7 @@ func (_m *PositionQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count position: %w", err)
+		return nil, fmt.Errorf("failed to count token: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1541,7 +5650,7 @@ func (_m *PositionQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Position{}
+		ret.List = []*Token{}
 		return ret, nil
 	}
 
@@ -1549,7 +5658,7 @@ func (_m *PositionQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultPositionOrder)
+		_m = _m.Order(DefaultTokenOrder)
 	}
 
 	// Apply pagination
@@ -1558,24 +5667,24 @@ func (_m *PositionQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query position: %w", err)
+		return nil, fmt.Errorf("failed to query token: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Position
-func (_m *PositionQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...PositionPaginateOption,
-) (*PositionPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for Token
+func (_m *TokenQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...TokenPaginateOption,
+) (*TokenPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewPositionPager(opts...)
+	pager, err := NewTokenPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1585,7 +5694,7 @@ func (_m *PositionQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &PositionPageList{}
+	ret := &TokenPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1595,7 +5704,7 @@ func (_m *PositionQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Position{}
+		ret.List = []*Token{}
 		return ret, nil
 	}
 
@@ -1603,7 +5712,7 @@ func (_m *PositionQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultPositionOrder)
+		_m = _m.Order(DefaultTokenOrder)
 	}
 
 	// Apply pagination
@@ -1612,76 +5721,171 @@ func (_m *PositionQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query position: %w", err)
+		return nil, fmt.Errorf("failed to query token: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// RolePager provides pagination functionality for Role
-type RolePager struct {
-	Order  role.OrderOption
-	Filter func(*RoleQuery) (*RoleQuery, error)
+// tokenCursorFields are the sort keys of Token usable for cursor pagination
+var tokenCursorFields = map[string]queryKind{
+	token.FieldID:        queryKindUint,
+	token.FieldCreatedAt: queryKindTime,
+	token.FieldExpiresAt: queryKindTime,
 }
 
-// RolePaginateOption enables pagination customization.
-type RolePaginateOption func(*RolePager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *Token) cursorValue(field string) any {
+	switch field {
+	case token.FieldCreatedAt:
+		return _m.CreatedAt
+	case token.FieldExpiresAt:
+		return _m.ExpiresAt
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultRoleOrder is the default ordering of Role.
-var DefaultRoleOrder = Desc(role.FieldID)
+// TokenCursorList is Token cursor page result.
+type TokenCursorList struct {
+	List        []*Token           `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewRolePager creates a new pager with the given options
-func NewRolePager(opts ...RolePaginateOption) (*RolePager, error) {
-	pager := &RolePager{}
+// CursorPage performs keyset pagination for Token, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *TokenQuery) CursorPage(ctx context.Context, req *CursorRequest) (*TokenCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(tokenCursorFields, req.Field, token.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &TokenCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(tokenCursorFields, req.Cursor, field, token.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.Token(cursorPredicate(field, token.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count token: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.Token(cursorPredicate(field, token.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != token.FieldID {
+		_m = _m.Order(sql.OrderByField(token.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// UserPager provides pagination functionality for User
+type UserPager struct {
+	Order  user.OrderOption
+	Filter func(*UserQuery) (*UserQuery, error)
+}
+
+// UserPaginateOption enables pagination customization.
+type UserPaginateOption func(*UserPager)
+
+// DefaultUserOrder is the default ordering of User.
+var DefaultUserOrder = Desc(user.FieldID)
+
+// NewUserPager creates a new pager with the given options
+func NewUserPager(opts ...UserPaginateOption) (*UserPager, error) {
+	pager := &UserPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultRoleOrder
+		pager.Order = DefaultUserOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Role pagination
-func WithRoleOrder(order role.OrderOption) RolePaginateOption {
-	return func(p *RolePager) {
+// WithOrder sets the order option for User pagination
+func WithUserOrder(order user.OrderOption) UserPaginateOption {
+	return func(p *UserPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Role pagination
-func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
-	return func(p *RolePager) {
+// WithFilter sets the filter function for User pagination
+func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
+	return func(p *UserPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *RolePager) ApplyFilter(query *RoleQuery) (*RoleQuery, error) {
+func (p *UserPager) ApplyFilter(query *UserQuery) (*UserQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// RolePageList is Role PageList result.
-type RolePageList struct {
-	List        []*Role      `json:"list"`
+// UserPageList is User PageList result.
+type UserPageList struct {
+	List        []*User      `json:"list"`
 	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for Role
-func (_m *RoleQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...RolePaginateOption,
-) (*RolePageList, error) {
+// Page performs paginated query for User
+func (_m *UserQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserPaginateOption,
+) (*UserPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewRolePager(opts...)
+	pager, err := NewUserPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1691,7 +5895,7 @@ func (_m *RoleQuery) Page(
 		return nil, err
 	}
 
-	ret := &RolePageList{}
+	ret := &UserPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1702,7 +5906,7 @@ func (_m *RoleQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count role: %w", err)
+		return nil, fmt.Errorf("failed to count user: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1710,7 +5914,7 @@ func (_m *RoleQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Role{}
+		ret.List = []*User{}
 		return ret, nil
 	}
 
@@ -1718,7 +5922,7 @@ func (_m *RoleQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultRoleOrder)
+		_m = _m.Order(DefaultUserOrder)
 	}
 
 	// Apply pagination
@@ -1727,24 +5931,24 @@ func (_m *RoleQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query role: %w", err)
+		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Role
-func (_m *RoleQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...RolePaginateOption,
-) (*RolePageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for User
+func (_m *UserQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserPaginateOption,
+) (*UserPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewRolePager(opts...)
+	pager, err := NewUserPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1754,7 +5958,7 @@ func (_m *RoleQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &RolePageList{}
+	ret := &UserPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1764,7 +5968,7 @@ func (_m *RoleQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Role{}
+		ret.List = []*User{}
 		return ret, nil
 	}
 
@@ -1772,7 +5976,7 @@ func (_m *RoleQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultRoleOrder)
+		_m = _m.Order(DefaultUserOrder)
 	}
 
 	// Apply pagination
@@ -1781,76 +5985,174 @@ func (_m *RoleQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query role: %w", err)
+		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// TokenPager provides pagination functionality for Token
-type TokenPager struct {
-	Order  token.OrderOption
-	Filter func(*TokenQuery) (*TokenQuery, error)
+// userCursorFields are the sort keys of User usable for cursor pagination
+var userCursorFields = map[string]queryKind{
+	user.FieldID:        queryKindUUID,
+	user.FieldCreatedAt: queryKindTime,
+	user.FieldUpdatedAt: queryKindTime,
+	user.FieldUsername:  queryKindString,
 }
 
-// TokenPaginateOption enables pagination customization.
-type TokenPaginateOption func(*TokenPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *User) cursorValue(field string) any {
+	switch field {
+	case user.FieldCreatedAt:
+		return _m.CreatedAt
+	case user.FieldUpdatedAt:
+		return _m.UpdatedAt
+	case user.FieldUsername:
+		return _m.Username
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultTokenOrder is the default ordering of Token.
-var DefaultTokenOrder = Desc(token.FieldID)
+// UserCursorList is User cursor page result.
+type UserCursorList struct {
+	List        []*User            `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewTokenPager creates a new pager with the given options
-func NewTokenPager(opts ...TokenPaginateOption) (*TokenPager, error) {
-	pager := &TokenPager{}
+// CursorPage performs keyset pagination for User, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *UserQuery) CursorPage(ctx context.Context, req *CursorRequest) (*UserCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(userCursorFields, req.Field, user.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &UserCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(userCursorFields, req.Cursor, field, user.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.User(cursorPredicate(field, user.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count user: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.User(cursorPredicate(field, user.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != user.FieldID {
+		_m = _m.Order(sql.OrderByField(user.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// UserPasswordHistoryPager provides pagination functionality for UserPasswordHistory
+type UserPasswordHistoryPager struct {
+	Order  userpasswordhistory.OrderOption
+	Filter func(*UserPasswordHistoryQuery) (*UserPasswordHistoryQuery, error)
+}
+
+// UserPasswordHistoryPaginateOption enables pagination customization.
+type UserPasswordHistoryPaginateOption func(*UserPasswordHistoryPager)
+
+// DefaultUserPasswordHistoryOrder is the default ordering of UserPasswordHistory.
+var DefaultUserPasswordHistoryOrder = Desc(userpasswordhistory.FieldID)
+
+// NewUserPasswordHistoryPager creates a new pager with the given options
+func NewUserPasswordHistoryPager(opts ...UserPasswordHistoryPaginateOption) (*UserPasswordHistoryPager, error) {
+	pager := &UserPasswordHistoryPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultTokenOrder
+		pager.Order = DefaultUserPasswordHistoryOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for Token pagination
-func WithTokenOrder(order token.OrderOption) TokenPaginateOption {
-	return func(p *TokenPager) {
+// WithOrder sets the order option for UserPasswordHistory pagination
+func WithUserPasswordHistoryOrder(order userpasswordhistory.OrderOption) UserPasswordHistoryPaginateOption {
+	return func(p *UserPasswordHistoryPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for Token pagination
-func WithTokenFilter(filter func(*TokenQuery) (*TokenQuery, error)) TokenPaginateOption {
-	return func(p *TokenPager) {
+// WithFilter sets the filter function for UserPasswordHistory pagination
+func WithUserPasswordHistoryFilter(filter func(*UserPasswordHistoryQuery) (*UserPasswordHistoryQuery, error)) UserPasswordHistoryPaginateOption {
+	return func(p *UserPasswordHistoryPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *TokenPager) ApplyFilter(query *TokenQuery) (*TokenQuery, error) {
+func (p *UserPasswordHistoryPager) ApplyFilter(query *UserPasswordHistoryQuery) (*UserPasswordHistoryQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// TokenPageList is Token PageList result.
-type TokenPageList struct {
-	List        []*Token     `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// UserPasswordHistoryPageList is UserPasswordHistory PageList result.
+type UserPasswordHistoryPageList struct {
+	List        []*UserPasswordHistory `json:"list"`
+	PageDetails *PageDetails           `json:"pageDetails"`
 }
 
-// Page performs paginated query for Token
-func (_m *TokenQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...TokenPaginateOption,
-) (*TokenPageList, error) {
+// Page performs paginated query for UserPasswordHistory
+func (_m *UserPasswordHistoryQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserPasswordHistoryPaginateOption,
+) (*UserPasswordHistoryPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewTokenPager(opts...)
+	pager, err := NewUserPasswordHistoryPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1860,7 +6162,7 @@ func (_m *TokenQuery) Page(
 		return nil, err
 	}
 
-	ret := &TokenPageList{}
+	ret := &UserPasswordHistoryPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -1871,7 +6173,7 @@ func (_m *TokenQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count token: %w", err)
+		return nil, fmt.Errorf("failed to count userpasswordhistory: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -1879,7 +6181,7 @@ func (_m *TokenQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*Token{}
+		ret.List = []*UserPasswordHistory{}
 		return ret, nil
 	}
 
@@ -1887,7 +6189,7 @@ func (_m *TokenQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultTokenOrder)
+		_m = _m.Order(DefaultUserPasswordHistoryOrder)
 	}
 
 	// Apply pagination
@@ -1896,24 +6198,24 @@ func (_m *TokenQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query token: %w", err)
+		return nil, fmt.Errorf("failed to query userpasswordhistory: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for Token
-func (_m *TokenQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...TokenPaginateOption,
-) (*TokenPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for UserPasswordHistory
+func (_m *UserPasswordHistoryQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserPasswordHistoryPaginateOption,
+) (*UserPasswordHistoryPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewTokenPager(opts...)
+	pager, err := NewUserPasswordHistoryPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1923,7 +6225,7 @@ func (_m *TokenQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &TokenPageList{}
+	ret := &UserPasswordHistoryPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -1933,7 +6235,7 @@ func (_m *TokenQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*Token{}
+		ret.List = []*UserPasswordHistory{}
 		return ret, nil
 	}
 
@@ -1941,7 +6243,7 @@ func (_m *TokenQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultTokenOrder)
+		_m = _m.Order(DefaultUserPasswordHistoryOrder)
 	}
 
 	// Apply pagination
@@ -1950,76 +6252,165 @@ func (_m *TokenQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query token: %w", err)
+		return nil, fmt.Errorf("failed to query userpasswordhistory: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// UserPager provides pagination functionality for User
-type UserPager struct {
-	Order  user.OrderOption
-	Filter func(*UserQuery) (*UserQuery, error)
+// userpasswordhistoryCursorFields are the sort keys of UserPasswordHistory usable for cursor pagination
+var userpasswordhistoryCursorFields = map[string]queryKind{
+	userpasswordhistory.FieldID: queryKindUint,
 }
 
-// UserPaginateOption enables pagination customization.
-type UserPaginateOption func(*UserPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *UserPasswordHistory) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultUserOrder is the default ordering of User.
-var DefaultUserOrder = Desc(user.FieldID)
+// UserPasswordHistoryCursorList is UserPasswordHistory cursor page result.
+type UserPasswordHistoryCursorList struct {
+	List        []*UserPasswordHistory `json:"list"`
+	PageDetails *CursorPageDetails     `json:"pageDetails"`
+}
 
-// NewUserPager creates a new pager with the given options
-func NewUserPager(opts ...UserPaginateOption) (*UserPager, error) {
-	pager := &UserPager{}
+// CursorPage performs keyset pagination for UserPasswordHistory, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *UserPasswordHistoryQuery) CursorPage(ctx context.Context, req *CursorRequest) (*UserPasswordHistoryCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(userpasswordhistoryCursorFields, req.Field, userpasswordhistory.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &UserPasswordHistoryCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(userpasswordhistoryCursorFields, req.Cursor, field, userpasswordhistory.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.UserPasswordHistory(cursorPredicate(field, userpasswordhistory.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count userpasswordhistory: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.UserPasswordHistory(cursorPredicate(field, userpasswordhistory.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != userpasswordhistory.FieldID {
+		_m = _m.Order(sql.OrderByField(userpasswordhistory.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query userpasswordhistory: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// UserTotpPager provides pagination functionality for UserTotp
+type UserTotpPager struct {
+	Order  usertotp.OrderOption
+	Filter func(*UserTotpQuery) (*UserTotpQuery, error)
+}
+
+// UserTotpPaginateOption enables pagination customization.
+type UserTotpPaginateOption func(*UserTotpPager)
+
+// DefaultUserTotpOrder is the default ordering of UserTotp.
+var DefaultUserTotpOrder = Desc(usertotp.FieldID)
+
+// NewUserTotpPager creates a new pager with the given options
+func NewUserTotpPager(opts ...UserTotpPaginateOption) (*UserTotpPager, error) {
+	pager := &UserTotpPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultUserOrder
+		pager.Order = DefaultUserTotpOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for User pagination
-func WithUserOrder(order user.OrderOption) UserPaginateOption {
-	return func(p *UserPager) {
+// WithOrder sets the order option for UserTotp pagination
+func WithUserTotpOrder(order usertotp.OrderOption) UserTotpPaginateOption {
+	return func(p *UserTotpPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for User pagination
-func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
-	return func(p *UserPager) {
+// WithFilter sets the filter function for UserTotp pagination
+func WithUserTotpFilter(filter func(*UserTotpQuery) (*UserTotpQuery, error)) UserTotpPaginateOption {
+	return func(p *UserTotpPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *UserPager) ApplyFilter(query *UserQuery) (*UserQuery, error) {
+func (p *UserTotpPager) ApplyFilter(query *UserTotpQuery) (*UserTotpQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// UserPageList is User PageList result.
-type UserPageList struct {
-	List        []*User      `json:"list"`
+// UserTotpPageList is UserTotp PageList result.
+type UserTotpPageList struct {
+	List        []*UserTotp  `json:"list"`
 	PageDetails *PageDetails `json:"pageDetails"`
 }
 
-// Page performs paginated query for User
-func (_m *UserQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserPaginateOption,
-) (*UserPageList, error) {
+// Page performs paginated query for UserTotp
+func (_m *UserTotpQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserTotpPaginateOption,
+) (*UserTotpPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewUserPager(opts...)
+	pager, err := NewUserTotpPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2029,7 +6420,7 @@ func (_m *UserQuery) Page(
 		return nil, err
 	}
 
-	ret := &UserPageList{}
+	ret := &UserTotpPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -2040,7 +6431,7 @@ func (_m *UserQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count user: %w", err)
+		return nil, fmt.Errorf("failed to count usertotp: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -2048,7 +6439,7 @@ func (_m *UserQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*User{}
+		ret.List = []*UserTotp{}
 		return ret, nil
 	}
 
@@ -2056,7 +6447,7 @@ func (_m *UserQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultUserOrder)
+		_m = _m.Order(DefaultUserTotpOrder)
 	}
 
 	// Apply pagination
@@ -2065,24 +6456,24 @@ func (_m *UserQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user: %w", err)
+		return nil, fmt.Errorf("failed to query usertotp: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for User
-func (_m *UserQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserPaginateOption,
-) (*UserPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for UserTotp
+func (_m *UserTotpQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserTotpPaginateOption,
+) (*UserTotpPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewUserPager(opts...)
+	pager, err := NewUserTotpPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2092,7 +6483,7 @@ func (_m *UserQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &UserPageList{}
+	ret := &UserTotpPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -2102,7 +6493,7 @@ func (_m *UserQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*User{}
+		ret.List = []*UserTotp{}
 		return ret, nil
 	}
 
@@ -2110,7 +6501,7 @@ func (_m *UserQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultUserOrder)
+		_m = _m.Order(DefaultUserTotpOrder)
 	}
 
 	// Apply pagination
@@ -2119,76 +6510,165 @@ func (_m *UserQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user: %w", err)
+		return nil, fmt.Errorf("failed to query usertotp: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// UserTotpPager provides pagination functionality for UserTotp
-type UserTotpPager struct {
-	Order  usertotp.OrderOption
-	Filter func(*UserTotpQuery) (*UserTotpQuery, error)
+// usertotpCursorFields are the sort keys of UserTotp usable for cursor pagination
+var usertotpCursorFields = map[string]queryKind{
+	usertotp.FieldID: queryKindUUID,
 }
 
-// UserTotpPaginateOption enables pagination customization.
-type UserTotpPaginateOption func(*UserTotpPager)
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *UserTotp) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
 
-// DefaultUserTotpOrder is the default ordering of UserTotp.
-var DefaultUserTotpOrder = Desc(usertotp.FieldID)
+// UserTotpCursorList is UserTotp cursor page result.
+type UserTotpCursorList struct {
+	List        []*UserTotp        `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
 
-// NewUserTotpPager creates a new pager with the given options
-func NewUserTotpPager(opts ...UserTotpPaginateOption) (*UserTotpPager, error) {
-	pager := &UserTotpPager{}
+// CursorPage performs keyset pagination for UserTotp, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *UserTotpQuery) CursorPage(ctx context.Context, req *CursorRequest) (*UserTotpCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(usertotpCursorFields, req.Field, usertotp.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &UserTotpCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(usertotpCursorFields, req.Cursor, field, usertotp.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.UserTotp(cursorPredicate(field, usertotp.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count usertotp: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.UserTotp(cursorPredicate(field, usertotp.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != usertotp.FieldID {
+		_m = _m.Order(sql.OrderByField(usertotp.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usertotp: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// UserWebauthnPager provides pagination functionality for UserWebauthn
+type UserWebauthnPager struct {
+	Order  userwebauthn.OrderOption
+	Filter func(*UserWebauthnQuery) (*UserWebauthnQuery, error)
+}
+
+// UserWebauthnPaginateOption enables pagination customization.
+type UserWebauthnPaginateOption func(*UserWebauthnPager)
+
+// DefaultUserWebauthnOrder is the default ordering of UserWebauthn.
+var DefaultUserWebauthnOrder = Desc(userwebauthn.FieldID)
+
+// NewUserWebauthnPager creates a new pager with the given options
+func NewUserWebauthnPager(opts ...UserWebauthnPaginateOption) (*UserWebauthnPager, error) {
+	pager := &UserWebauthnPager{}
 	for _, opt := range opts {
 		opt(pager)
 	}
 	if pager.Order == nil {
-		pager.Order = DefaultUserTotpOrder
+		pager.Order = DefaultUserWebauthnOrder
 	}
 	return pager, nil
 }
 
-// WithOrder sets the order option for UserTotp pagination
-func WithUserTotpOrder(order usertotp.OrderOption) UserTotpPaginateOption {
-	return func(p *UserTotpPager) {
+// WithOrder sets the order option for UserWebauthn pagination
+func WithUserWebauthnOrder(order userwebauthn.OrderOption) UserWebauthnPaginateOption {
+	return func(p *UserWebauthnPager) {
 		p.Order = order
 	}
 }
 
-// WithFilter sets the filter function for UserTotp pagination
-func WithUserTotpFilter(filter func(*UserTotpQuery) (*UserTotpQuery, error)) UserTotpPaginateOption {
-	return func(p *UserTotpPager) {
+// WithFilter sets the filter function for UserWebauthn pagination
+func WithUserWebauthnFilter(filter func(*UserWebauthnQuery) (*UserWebauthnQuery, error)) UserWebauthnPaginateOption {
+	return func(p *UserWebauthnPager) {
 		p.Filter = filter
 	}
 }
 
 // ApplyFilter applies the filter to the query if set
-func (p *UserTotpPager) ApplyFilter(query *UserTotpQuery) (*UserTotpQuery, error) {
+func (p *UserWebauthnPager) ApplyFilter(query *UserWebauthnQuery) (*UserWebauthnQuery, error) {
 	if p.Filter != nil {
 		return p.Filter(query)
 	}
 	return query, nil
 }
 
-// UserTotpPageList is UserTotp PageList result.
-type UserTotpPageList struct {
-	List        []*UserTotp  `json:"list"`
-	PageDetails *PageDetails `json:"pageDetails"`
+// UserWebauthnPageList is UserWebauthn PageList result.
+type UserWebauthnPageList struct {
+	List        []*UserWebauthn `json:"list"`
+	PageDetails *PageDetails    `json:"pageDetails"`
 }
 
-// Page performs paginated query for UserTotp
-func (_m *UserTotpQuery) Page(
-	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserTotpPaginateOption,
-) (*UserTotpPageList, error) {
+// Page performs paginated query for UserWebauthn
+func (_m *UserWebauthnQuery) Page(
+	ctx context.Context, pageNum uint32, pageSize uint32, opts ...UserWebauthnPaginateOption,
+) (*UserWebauthnPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewUserTotpPager(opts...)
+	pager, err := NewUserWebauthnPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2198,7 +6678,7 @@ func (_m *UserTotpQuery) Page(
 		return nil, err
 	}
 
-	ret := &UserTotpPageList{}
+	ret := &UserWebauthnPageList{}
 	ret.PageDetails = &PageDetails{
 		Page: pageNum,
 		Size: pageSize,
@@ -2209,7 +6689,7 @@ func (_m *UserTotpQuery) Page(
 	countQuery.ctx.Fields = nil
 	count, err := countQuery.Count(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count usertotp: %w", err)
+		return nil, fmt.Errorf("failed to count userwebauthn: %w", err)
 	}
 
 	ret.PageDetails.Total = uint64(count)
@@ -2217,7 +6697,7 @@ func (_m *UserTotpQuery) Page(
 
 	// If no records, return empty list
 	if count == 0 {
-		ret.List = []*UserTotp{}
+		ret.List = []*UserWebauthn{}
 		return ret, nil
 	}
 
@@ -2225,7 +6705,7 @@ func (_m *UserTotpQuery) Page(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultUserTotpOrder)
+		_m = _m.Order(DefaultUserWebauthnOrder)
 	}
 
 	// Apply pagination
@@ -2234,24 +6714,24 @@ func (_m *UserTotpQuery) Page(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query usertotp: %w", err)
+		return nil, fmt.Errorf("failed to query userwebauthn: %w", err)
 	}
 
 	ret.List = list
 	return ret, nil
 }
 
-// PageWithCount performs paginated query with pre-calculated count for UserTotp
-func (_m *UserTotpQuery) PageWithCount(
-	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserTotpPaginateOption,
-) (*UserTotpPageList, error) {
+// PageWithCount performs paginated query with pre-calculated count for UserWebauthn
+func (_m *UserWebauthnQuery) PageWithCount(
+	ctx context.Context, pageNum uint32, pageSize uint32, totalCount uint64, opts ...UserWebauthnPaginateOption,
+) (*UserWebauthnPageList, error) {
 	// Validate pagination parameters
 	if err := ValidatePageParams(pageNum, pageSize); err != nil {
 		return nil, err
 	}
 
 	// Create pager with options
-	pager, err := NewUserTotpPager(opts...)
+	pager, err := NewUserWebauthnPager(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2261,7 +6741,7 @@ func (_m *UserTotpQuery) PageWithCount(
 		return nil, err
 	}
 
-	ret := &UserTotpPageList{}
+	ret := &UserWebauthnPageList{}
 	ret.PageDetails = &PageDetails{
 		Page:  pageNum,
 		Size:  pageSize,
@@ -2271,7 +6751,7 @@ func (_m *UserTotpQuery) PageWithCount(
 
 	// If no records, return empty list
 	if totalCount == 0 {
-		ret.List = []*UserTotp{}
+		ret.List = []*UserWebauthn{}
 		return ret, nil
 	}
 
@@ -2279,7 +6759,7 @@ func (_m *UserTotpQuery) PageWithCount(
 	if pager.Order != nil {
 		_m = _m.Order(pager.Order)
 	} else {
-		_m = _m.Order(DefaultUserTotpOrder)
+		_m = _m.Order(DefaultUserWebauthnOrder)
 	}
 
 	// Apply pagination
@@ -2288,7 +6768,96 @@ func (_m *UserTotpQuery) PageWithCount(
 
 	list, err := _m.All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query usertotp: %w", err)
+		return nil, fmt.Errorf("failed to query userwebauthn: %w", err)
+	}
+
+	ret.List = list
+	return ret, nil
+}
+
+// userwebauthnCursorFields are the sort keys of UserWebauthn usable for cursor pagination
+var userwebauthnCursorFields = map[string]queryKind{
+	userwebauthn.FieldID: queryKindUint,
+}
+
+// cursorValue returns the sort key value used to build the next cursor
+func (_m *UserWebauthn) cursorValue(field string) any {
+	switch field {
+	default:
+		return _m.ID
+	}
+}
+
+// UserWebauthnCursorList is UserWebauthn cursor page result.
+type UserWebauthnCursorList struct {
+	List        []*UserWebauthn    `json:"list"`
+	PageDetails *CursorPageDetails `json:"pageDetails"`
+}
+
+// CursorPage performs keyset pagination for UserWebauthn, ordered by the sort key then ID.
+// Orders already set on the query are replaced.
+func (_m *UserWebauthnQuery) CursorPage(ctx context.Context, req *CursorRequest) (*UserWebauthnCursorList, error) {
+	// Validate pagination parameters
+	if err := ValidatePageParams(1, req.Size); err != nil {
+		return nil, err
+	}
+
+	field, err := cursorField(userwebauthnCursorFields, req.Field, userwebauthn.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &UserWebauthnCursorList{}
+	ret.PageDetails = &CursorPageDetails{
+		Size: req.Size,
+	}
+
+	if req.Cursor != "" {
+		value, id, err := decodeCursor(userwebauthnCursorFields, req.Cursor, field, userwebauthn.FieldID, req.Desc, _m.config.location())
+		if err != nil {
+			return nil, err
+		}
+
+		// Count the records before the cursor if requested
+		if req.Skipped {
+			countQuery := _m.Clone()
+			countQuery.ctx.Fields = nil
+			countQuery.order = nil
+			count, err := countQuery.
+				Where(predicate.UserWebauthn(cursorPredicate(field, userwebauthn.FieldID, value, id, req.Desc, true))).
+				Count(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count userwebauthn: %w", err)
+			}
+			ret.PageDetails.Skipped = uint64(count)
+		}
+
+		_m = _m.Where(predicate.UserWebauthn(cursorPredicate(field, userwebauthn.FieldID, value, id, req.Desc, false)))
+	}
+
+	// Apply keyset ordering, fetching one extra row to detect the next page
+	direction := sql.OrderAsc()
+	if req.Desc {
+		direction = sql.OrderDesc()
+	}
+	_m.order = nil
+	_m = _m.Order(sql.OrderByField(field, direction).ToFunc())
+	if field != userwebauthn.FieldID {
+		_m = _m.Order(sql.OrderByField(userwebauthn.FieldID, direction).ToFunc())
+	}
+
+	list, err := _m.Limit(int(req.Size) + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query userwebauthn: %w", err)
+	}
+
+	if len(list) > int(req.Size) {
+		list = list[:req.Size]
+		last := list[len(list)-1]
+		ret.PageDetails.HasNext = true
+		if ret.PageDetails.NextCursor, err = encodeCursor(field, req.Desc, last.cursorValue(field), last.ID); err != nil {
+			return nil, err
+		}
 	}
 
 	ret.List = list
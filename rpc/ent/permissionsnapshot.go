@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema"
+)
+
+// 权限快照表 / Permission snapshot table
+type PermissionSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 备注，如审查周期 / Remark, e.g. review period
+	Remark string `json:"remark,omitempty"`
+	// 创建人ID / Creator user ID
+	CreatorID *uuid.UUID `json:"creator_id,omitempty"`
+	// 授权记录数 / Number of entries
+	EntryCount int64 `json:"entry_count,omitempty"`
+	// 全部角色的授权及持有用户 / Permissions and holders of all roles
+	Entries      []schema.PermissionEntry `json:"entries,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PermissionSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case permissionsnapshot.FieldCreatorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case permissionsnapshot.FieldEntries:
+			values[i] = new([]byte)
+		case permissionsnapshot.FieldID, permissionsnapshot.FieldEntryCount:
+			values[i] = new(sql.NullInt64)
+		case permissionsnapshot.FieldRemark:
+			values[i] = new(sql.NullString)
+		case permissionsnapshot.FieldCreatedAt, permissionsnapshot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PermissionSnapshot fields.
+func (_m *PermissionSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case permissionsnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case permissionsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case permissionsnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case permissionsnapshot.FieldRemark:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remark", values[i])
+			} else if value.Valid {
+				_m.Remark = value.String
+			}
+		case permissionsnapshot.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				_m.CreatorID = new(uuid.UUID)
+				*_m.CreatorID = *value.S.(*uuid.UUID)
+			}
+		case permissionsnapshot.FieldEntryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_count", values[i])
+			} else if value.Valid {
+				_m.EntryCount = value.Int64
+			}
+		case permissionsnapshot.FieldEntries:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field entries", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Entries); err != nil {
+					return fmt.Errorf("unmarshal field entries: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PermissionSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *PermissionSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PermissionSnapshot.
+// Note that you need to call PermissionSnapshot.Unwrap() before calling this method if this PermissionSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PermissionSnapshot) Update() *PermissionSnapshotUpdateOne {
+	return NewPermissionSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PermissionSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PermissionSnapshot) Unwrap() *PermissionSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PermissionSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PermissionSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("PermissionSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("remark=")
+	builder.WriteString(_m.Remark)
+	builder.WriteString(", ")
+	if v := _m.CreatorID; v != nil {
+		builder.WriteString("creator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("entry_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntryCount))
+	builder.WriteString(", ")
+	builder.WriteString("entries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Entries))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PermissionSnapshots is a parsable slice of PermissionSnapshot.
+type PermissionSnapshots []*PermissionSnapshot
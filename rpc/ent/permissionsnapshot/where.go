@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package permissionsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldRemark, v))
+}
+
+// CreatorID applies equality check predicate on the "creator_id" field. It's identical to CreatorIDEQ.
+func CreatorID(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// EntryCount applies equality check predicate on the "entry_count" field. It's identical to EntryCountEQ.
+func EntryCount(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldEntryCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// CreatorIDEQ applies the EQ predicate on the "creator_id" field.
+func CreatorIDEQ(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// CreatorIDNEQ applies the NEQ predicate on the "creator_id" field.
+func CreatorIDNEQ(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldCreatorID, v))
+}
+
+// CreatorIDIn applies the In predicate on the "creator_id" field.
+func CreatorIDIn(vs ...uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDNotIn applies the NotIn predicate on the "creator_id" field.
+func CreatorIDNotIn(vs ...uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDGT applies the GT predicate on the "creator_id" field.
+func CreatorIDGT(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldCreatorID, v))
+}
+
+// CreatorIDGTE applies the GTE predicate on the "creator_id" field.
+func CreatorIDGTE(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldCreatorID, v))
+}
+
+// CreatorIDLT applies the LT predicate on the "creator_id" field.
+func CreatorIDLT(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldCreatorID, v))
+}
+
+// CreatorIDLTE applies the LTE predicate on the "creator_id" field.
+func CreatorIDLTE(v uuid.UUID) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldCreatorID, v))
+}
+
+// CreatorIDIsNil applies the IsNil predicate on the "creator_id" field.
+func CreatorIDIsNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIsNull(FieldCreatorID))
+}
+
+// CreatorIDNotNil applies the NotNil predicate on the "creator_id" field.
+func CreatorIDNotNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotNull(FieldCreatorID))
+}
+
+// EntryCountEQ applies the EQ predicate on the "entry_count" field.
+func EntryCountEQ(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldEQ(FieldEntryCount, v))
+}
+
+// EntryCountNEQ applies the NEQ predicate on the "entry_count" field.
+func EntryCountNEQ(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNEQ(FieldEntryCount, v))
+}
+
+// EntryCountIn applies the In predicate on the "entry_count" field.
+func EntryCountIn(vs ...int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIn(FieldEntryCount, vs...))
+}
+
+// EntryCountNotIn applies the NotIn predicate on the "entry_count" field.
+func EntryCountNotIn(vs ...int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotIn(FieldEntryCount, vs...))
+}
+
+// EntryCountGT applies the GT predicate on the "entry_count" field.
+func EntryCountGT(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGT(FieldEntryCount, v))
+}
+
+// EntryCountGTE applies the GTE predicate on the "entry_count" field.
+func EntryCountGTE(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldGTE(FieldEntryCount, v))
+}
+
+// EntryCountLT applies the LT predicate on the "entry_count" field.
+func EntryCountLT(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLT(FieldEntryCount, v))
+}
+
+// EntryCountLTE applies the LTE predicate on the "entry_count" field.
+func EntryCountLTE(v int64) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldLTE(FieldEntryCount, v))
+}
+
+// EntriesIsNil applies the IsNil predicate on the "entries" field.
+func EntriesIsNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldIsNull(FieldEntries))
+}
+
+// EntriesNotNil applies the NotNil predicate on the "entries" field.
+func EntriesNotNil() predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.FieldNotNull(FieldEntries))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PermissionSnapshot) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PermissionSnapshot) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PermissionSnapshot) predicate.PermissionSnapshot {
+	return predicate.PermissionSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema"
+)
+
+// PermissionSnapshotCreate is the builder for creating a PermissionSnapshot entity.
+type PermissionSnapshotCreate struct {
+	config
+	mutation *PermissionSnapshotMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PermissionSnapshotCreate) SetCreatedAt(v time.Time) *PermissionSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PermissionSnapshotCreate) SetNillableCreatedAt(v *time.Time) *PermissionSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PermissionSnapshotCreate) SetUpdatedAt(v time.Time) *PermissionSnapshotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PermissionSnapshotCreate) SetNillableUpdatedAt(v *time.Time) *PermissionSnapshotCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *PermissionSnapshotCreate) SetRemark(v string) *PermissionSnapshotCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *PermissionSnapshotCreate) SetNillableRemark(v *string) *PermissionSnapshotCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (_c *PermissionSnapshotCreate) SetCreatorID(v uuid.UUID) *PermissionSnapshotCreate {
+	_c.mutation.SetCreatorID(v)
+	return _c
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (_c *PermissionSnapshotCreate) SetNillableCreatorID(v *uuid.UUID) *PermissionSnapshotCreate {
+	if v != nil {
+		_c.SetCreatorID(*v)
+	}
+	return _c
+}
+
+// SetEntryCount sets the "entry_count" field.
+func (_c *PermissionSnapshotCreate) SetEntryCount(v int64) *PermissionSnapshotCreate {
+	_c.mutation.SetEntryCount(v)
+	return _c
+}
+
+// SetNillableEntryCount sets the "entry_count" field if the given value is not nil.
+func (_c *PermissionSnapshotCreate) SetNillableEntryCount(v *int64) *PermissionSnapshotCreate {
+	if v != nil {
+		_c.SetEntryCount(*v)
+	}
+	return _c
+}
+
+// SetEntries sets the "entries" field.
+func (_c *PermissionSnapshotCreate) SetEntries(v []schema.PermissionEntry) *PermissionSnapshotCreate {
+	_c.mutation.SetEntries(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PermissionSnapshotCreate) SetID(v uint32) *PermissionSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PermissionSnapshotMutation object of the builder.
+func (_c *PermissionSnapshotCreate) Mutation() *PermissionSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the PermissionSnapshot in the database.
+func (_c *PermissionSnapshotCreate) Save(ctx context.Context) (*PermissionSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PermissionSnapshotCreate) SaveX(ctx context.Context) *PermissionSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PermissionSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PermissionSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PermissionSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := permissionsnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := permissionsnapshot.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.EntryCount(); !ok {
+		v := permissionsnapshot.DefaultEntryCount
+		_c.mutation.SetEntryCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PermissionSnapshotCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PermissionSnapshot.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PermissionSnapshot.updated_at"`)}
+	}
+	if v, ok := _c.mutation.Remark(); ok {
+		if err := permissionsnapshot.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PermissionSnapshot.remark": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntryCount(); !ok {
+		return &ValidationError{Name: "entry_count", err: errors.New(`ent: missing required field "PermissionSnapshot.entry_count"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := permissionsnapshot.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PermissionSnapshot.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PermissionSnapshotCreate) sqlSave(ctx context.Context) (*PermissionSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PermissionSnapshotCreate) createSpec() (*PermissionSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PermissionSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(permissionsnapshot.Table, sqlgraph.NewFieldSpec(permissionsnapshot.FieldID, field.TypeUint32))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(permissionsnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(permissionsnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(permissionsnapshot.FieldRemark, field.TypeString, value)
+		_node.Remark = value
+	}
+	if value, ok := _c.mutation.CreatorID(); ok {
+		_spec.SetField(permissionsnapshot.FieldCreatorID, field.TypeUUID, value)
+		_node.CreatorID = &value
+	}
+	if value, ok := _c.mutation.EntryCount(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntryCount, field.TypeInt64, value)
+		_node.EntryCount = value
+	}
+	if value, ok := _c.mutation.Entries(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntries, field.TypeJSON, value)
+		_node.Entries = value
+	}
+	return _node, _spec
+}
+
+// PermissionSnapshotCreateBulk is the builder for creating many PermissionSnapshot entities in bulk.
+type PermissionSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*PermissionSnapshotCreate
+}
+
+// Save creates the PermissionSnapshot entities in the database.
+func (_c *PermissionSnapshotCreateBulk) Save(ctx context.Context) ([]*PermissionSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PermissionSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PermissionSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PermissionSnapshotCreateBulk) SaveX(ctx context.Context) []*PermissionSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PermissionSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PermissionSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// PermissionSnapshotDelete is the builder for deleting a PermissionSnapshot entity.
+type PermissionSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *PermissionSnapshotMutation
+}
+
+// Where appends a list predicates to the PermissionSnapshotDelete builder.
+func (_d *PermissionSnapshotDelete) Where(ps ...predicate.PermissionSnapshot) *PermissionSnapshotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PermissionSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PermissionSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PermissionSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(permissionsnapshot.Table, sqlgraph.NewFieldSpec(permissionsnapshot.FieldID, field.TypeUint32))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PermissionSnapshotDeleteOne is the builder for deleting a single PermissionSnapshot entity.
+type PermissionSnapshotDeleteOne struct {
+	_d *PermissionSnapshotDelete
+}
+
+// Where appends a list predicates to the PermissionSnapshotDelete builder.
+func (_d *PermissionSnapshotDeleteOne) Where(ps ...predicate.PermissionSnapshot) *PermissionSnapshotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PermissionSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{permissionsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PermissionSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema"
+)
+
+// PermissionSnapshotUpdate is the builder for updating PermissionSnapshot entities.
+type PermissionSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PermissionSnapshotMutation
+}
+
+// Where appends a list predicates to the PermissionSnapshotUpdate builder.
+func (_u *PermissionSnapshotUpdate) Where(ps ...predicate.PermissionSnapshot) *PermissionSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PermissionSnapshotUpdate) SetUpdatedAt(v time.Time) *PermissionSnapshotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *PermissionSnapshotUpdate) SetRemark(v string) *PermissionSnapshotUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdate) SetNillableRemark(v *string) *PermissionSnapshotUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *PermissionSnapshotUpdate) ClearRemark() *PermissionSnapshotUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (_u *PermissionSnapshotUpdate) SetCreatorID(v uuid.UUID) *PermissionSnapshotUpdate {
+	_u.mutation.SetCreatorID(v)
+	return _u
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdate) SetNillableCreatorID(v *uuid.UUID) *PermissionSnapshotUpdate {
+	if v != nil {
+		_u.SetCreatorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatorID clears the value of the "creator_id" field.
+func (_u *PermissionSnapshotUpdate) ClearCreatorID() *PermissionSnapshotUpdate {
+	_u.mutation.ClearCreatorID()
+	return _u
+}
+
+// SetEntryCount sets the "entry_count" field.
+func (_u *PermissionSnapshotUpdate) SetEntryCount(v int64) *PermissionSnapshotUpdate {
+	_u.mutation.ResetEntryCount()
+	_u.mutation.SetEntryCount(v)
+	return _u
+}
+
+// SetNillableEntryCount sets the "entry_count" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdate) SetNillableEntryCount(v *int64) *PermissionSnapshotUpdate {
+	if v != nil {
+		_u.SetEntryCount(*v)
+	}
+	return _u
+}
+
+// AddEntryCount adds value to the "entry_count" field.
+func (_u *PermissionSnapshotUpdate) AddEntryCount(v int64) *PermissionSnapshotUpdate {
+	_u.mutation.AddEntryCount(v)
+	return _u
+}
+
+// SetEntries sets the "entries" field.
+func (_u *PermissionSnapshotUpdate) SetEntries(v []schema.PermissionEntry) *PermissionSnapshotUpdate {
+	_u.mutation.SetEntries(v)
+	return _u
+}
+
+// AppendEntries appends value to the "entries" field.
+func (_u *PermissionSnapshotUpdate) AppendEntries(v []schema.PermissionEntry) *PermissionSnapshotUpdate {
+	_u.mutation.AppendEntries(v)
+	return _u
+}
+
+// ClearEntries clears the value of the "entries" field.
+func (_u *PermissionSnapshotUpdate) ClearEntries() *PermissionSnapshotUpdate {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// Mutation returns the PermissionSnapshotMutation object of the builder.
+func (_u *PermissionSnapshotUpdate) Mutation() *PermissionSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PermissionSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PermissionSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PermissionSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PermissionSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PermissionSnapshotUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := permissionsnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PermissionSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := permissionsnapshot.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PermissionSnapshot.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PermissionSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(permissionsnapshot.Table, permissionsnapshot.Columns, sqlgraph.NewFieldSpec(permissionsnapshot.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(permissionsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(permissionsnapshot.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(permissionsnapshot.FieldRemark, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatorID(); ok {
+		_spec.SetField(permissionsnapshot.FieldCreatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatorIDCleared() {
+		_spec.ClearField(permissionsnapshot.FieldCreatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.EntryCount(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntryCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEntryCount(); ok {
+		_spec.AddField(permissionsnapshot.FieldEntryCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Entries(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, permissionsnapshot.FieldEntries, value)
+		})
+	}
+	if _u.mutation.EntriesCleared() {
+		_spec.ClearField(permissionsnapshot.FieldEntries, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{permissionsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PermissionSnapshotUpdateOne is the builder for updating a single PermissionSnapshot entity.
+type PermissionSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PermissionSnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PermissionSnapshotUpdateOne) SetUpdatedAt(v time.Time) *PermissionSnapshotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *PermissionSnapshotUpdateOne) SetRemark(v string) *PermissionSnapshotUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdateOne) SetNillableRemark(v *string) *PermissionSnapshotUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *PermissionSnapshotUpdateOne) ClearRemark() *PermissionSnapshotUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (_u *PermissionSnapshotUpdateOne) SetCreatorID(v uuid.UUID) *PermissionSnapshotUpdateOne {
+	_u.mutation.SetCreatorID(v)
+	return _u
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdateOne) SetNillableCreatorID(v *uuid.UUID) *PermissionSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCreatorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatorID clears the value of the "creator_id" field.
+func (_u *PermissionSnapshotUpdateOne) ClearCreatorID() *PermissionSnapshotUpdateOne {
+	_u.mutation.ClearCreatorID()
+	return _u
+}
+
+// SetEntryCount sets the "entry_count" field.
+func (_u *PermissionSnapshotUpdateOne) SetEntryCount(v int64) *PermissionSnapshotUpdateOne {
+	_u.mutation.ResetEntryCount()
+	_u.mutation.SetEntryCount(v)
+	return _u
+}
+
+// SetNillableEntryCount sets the "entry_count" field if the given value is not nil.
+func (_u *PermissionSnapshotUpdateOne) SetNillableEntryCount(v *int64) *PermissionSnapshotUpdateOne {
+	if v != nil {
+		_u.SetEntryCount(*v)
+	}
+	return _u
+}
+
+// AddEntryCount adds value to the "entry_count" field.
+func (_u *PermissionSnapshotUpdateOne) AddEntryCount(v int64) *PermissionSnapshotUpdateOne {
+	_u.mutation.AddEntryCount(v)
+	return _u
+}
+
+// SetEntries sets the "entries" field.
+func (_u *PermissionSnapshotUpdateOne) SetEntries(v []schema.PermissionEntry) *PermissionSnapshotUpdateOne {
+	_u.mutation.SetEntries(v)
+	return _u
+}
+
+// AppendEntries appends value to the "entries" field.
+func (_u *PermissionSnapshotUpdateOne) AppendEntries(v []schema.PermissionEntry) *PermissionSnapshotUpdateOne {
+	_u.mutation.AppendEntries(v)
+	return _u
+}
+
+// ClearEntries clears the value of the "entries" field.
+func (_u *PermissionSnapshotUpdateOne) ClearEntries() *PermissionSnapshotUpdateOne {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// Mutation returns the PermissionSnapshotMutation object of the builder.
+func (_u *PermissionSnapshotUpdateOne) Mutation() *PermissionSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PermissionSnapshotUpdate builder.
+func (_u *PermissionSnapshotUpdateOne) Where(ps ...predicate.PermissionSnapshot) *PermissionSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PermissionSnapshotUpdateOne) Select(field string, fields ...string) *PermissionSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PermissionSnapshot entity.
+func (_u *PermissionSnapshotUpdateOne) Save(ctx context.Context) (*PermissionSnapshot, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PermissionSnapshotUpdateOne) SaveX(ctx context.Context) *PermissionSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PermissionSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PermissionSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PermissionSnapshotUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := permissionsnapshot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PermissionSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.Remark(); ok {
+		if err := permissionsnapshot.RemarkValidator(v); err != nil {
+			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "PermissionSnapshot.remark": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PermissionSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *PermissionSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(permissionsnapshot.Table, permissionsnapshot.Columns, sqlgraph.NewFieldSpec(permissionsnapshot.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PermissionSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, permissionsnapshot.FieldID)
+		for _, f := range fields {
+			if !permissionsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != permissionsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(permissionsnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(permissionsnapshot.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(permissionsnapshot.FieldRemark, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatorID(); ok {
+		_spec.SetField(permissionsnapshot.FieldCreatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatorIDCleared() {
+		_spec.ClearField(permissionsnapshot.FieldCreatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.EntryCount(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntryCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedEntryCount(); ok {
+		_spec.AddField(permissionsnapshot.FieldEntryCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Entries(); ok {
+		_spec.SetField(permissionsnapshot.FieldEntries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEntries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, permissionsnapshot.FieldEntries, value)
+		})
+	}
+	if _u.mutation.EntriesCleared() {
+		_spec.ClearField(permissionsnapshot.FieldEntries, field.TypeJSON)
+	}
+	_node = &PermissionSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{permissionsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
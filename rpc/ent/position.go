@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -29,6 +30,8 @@ type Position struct {
 	Sort int32 `json:"sort,omitempty"`
 	// 职位名称 / Position name
 	PositionName string `json:"position_name,omitempty"`
+	// 职位名称多语言，键为语言标签 / Position name translations keyed by locale
+	PositionNameI18n map[string]string `json:"position_name_i18n,omitempty"`
 	// 职位编码 / Position code
 	PositionCode string `json:"position_code,omitempty"`
 	// 职位描述 / Position description
@@ -43,9 +46,11 @@ type Position struct {
 type PositionEdges struct {
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -57,11 +62,22 @@ func (e PositionEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e PositionEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Position) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case position.FieldPositionNameI18n:
+			values[i] = new([]byte)
 		case position.FieldState:
 			values[i] = new(sql.NullBool)
 		case position.FieldID, position.FieldSort:
@@ -128,6 +144,14 @@ func (_m *Position) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PositionName = value.String
 			}
+		case position.FieldPositionNameI18n:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field position_name_i18n", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PositionNameI18n); err != nil {
+					return fmt.Errorf("unmarshal field position_name_i18n: %w", err)
+				}
+			}
 		case position.FieldPositionCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field position_code", values[i])
@@ -159,6 +183,11 @@ func (_m *Position) QueryUsers() *UserQuery {
 	return NewPositionClient(_m.config).QueryUsers(_m)
 }
 
+// QueryRoles queries the "roles" edge of the Position entity.
+func (_m *Position) QueryRoles() *RoleQuery {
+	return NewPositionClient(_m.config).QueryRoles(_m)
+}
+
 // Update returns a builder for updating this Position.
 // Note that you need to call Position.Unwrap() before calling this method if this Position
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,6 +231,9 @@ func (_m *Position) String() string {
 	builder.WriteString("position_name=")
 	builder.WriteString(_m.PositionName)
 	builder.WriteString(", ")
+	builder.WriteString("position_name_i18n=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PositionNameI18n))
+	builder.WriteString(", ")
 	builder.WriteString("position_code=")
 	builder.WriteString(_m.PositionCode)
 	builder.WriteString(", ")
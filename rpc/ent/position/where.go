@@ -350,6 +350,16 @@ func PositionNameContainsFold(v string) predicate.Position {
 	return predicate.Position(sql.FieldContainsFold(FieldPositionName, v))
 }
 
+// PositionNameI18nIsNil applies the IsNil predicate on the "position_name_i18n" field.
+func PositionNameI18nIsNil() predicate.Position {
+	return predicate.Position(sql.FieldIsNull(FieldPositionNameI18n))
+}
+
+// PositionNameI18nNotNil applies the NotNil predicate on the "position_name_i18n" field.
+func PositionNameI18nNotNil() predicate.Position {
+	return predicate.Position(sql.FieldNotNull(FieldPositionNameI18n))
+}
+
 // PositionCodeEQ applies the EQ predicate on the "position_code" field.
 func PositionCodeEQ(v string) predicate.Position {
 	return predicate.Position(sql.FieldEQ(FieldPositionCode, v))
@@ -513,6 +523,29 @@ func HasUsersWith(preds ...predicate.User) predicate.Position {
 	})
 }
 
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, RolesTable, RolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.Position {
+	return predicate.Position(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Position) predicate.Position {
 	return predicate.Position(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 )
 
@@ -98,6 +99,12 @@ func (_c *PositionCreate) SetPositionName(v string) *PositionCreate {
 	return _c
 }
 
+// SetPositionNameI18n sets the "position_name_i18n" field.
+func (_c *PositionCreate) SetPositionNameI18n(v map[string]string) *PositionCreate {
+	_c.mutation.SetPositionNameI18n(v)
+	return _c
+}
+
 // SetPositionCode sets the "position_code" field.
 func (_c *PositionCreate) SetPositionCode(v string) *PositionCreate {
 	_c.mutation.SetPositionCode(v)
@@ -139,6 +146,21 @@ func (_c *PositionCreate) AddUsers(v ...*User) *PositionCreate {
 	return _c.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_c *PositionCreate) AddRoleIDs(ids ...uint32) *PositionCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_c *PositionCreate) AddRoles(v ...*Role) *PositionCreate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (_c *PositionCreate) Mutation() *PositionMutation {
 	return _c.mutation
@@ -280,6 +302,10 @@ func (_c *PositionCreate) createSpec() (*Position, *sqlgraph.CreateSpec) {
 		_spec.SetField(position.FieldPositionName, field.TypeString, value)
 		_node.PositionName = value
 	}
+	if value, ok := _c.mutation.PositionNameI18n(); ok {
+		_spec.SetField(position.FieldPositionNameI18n, field.TypeJSON, value)
+		_node.PositionNameI18n = value
+	}
 	if value, ok := _c.mutation.PositionCode(); ok {
 		_spec.SetField(position.FieldPositionCode, field.TypeString, value)
 		_node.PositionCode = value
@@ -304,6 +330,22 @@ func (_c *PositionCreate) createSpec() (*Position, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
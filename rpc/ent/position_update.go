@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 )
 
@@ -111,6 +112,18 @@ func (_u *PositionUpdate) SetNillablePositionName(v *string) *PositionUpdate {
 	return _u
 }
 
+// SetPositionNameI18n sets the "position_name_i18n" field.
+func (_u *PositionUpdate) SetPositionNameI18n(v map[string]string) *PositionUpdate {
+	_u.mutation.SetPositionNameI18n(v)
+	return _u
+}
+
+// ClearPositionNameI18n clears the value of the "position_name_i18n" field.
+func (_u *PositionUpdate) ClearPositionNameI18n() *PositionUpdate {
+	_u.mutation.ClearPositionNameI18n()
+	return _u
+}
+
 // SetPositionCode sets the "position_code" field.
 func (_u *PositionUpdate) SetPositionCode(v string) *PositionUpdate {
 	_u.mutation.SetPositionCode(v)
@@ -160,6 +173,21 @@ func (_u *PositionUpdate) AddUsers(v ...*User) *PositionUpdate {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *PositionUpdate) AddRoleIDs(ids ...uint32) *PositionUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *PositionUpdate) AddRoles(v ...*Role) *PositionUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (_u *PositionUpdate) Mutation() *PositionMutation {
 	return _u.mutation
@@ -186,6 +214,27 @@ func (_u *PositionUpdate) RemoveUsers(v ...*User) *PositionUpdate {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *PositionUpdate) ClearRoles() *PositionUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *PositionUpdate) RemoveRoleIDs(ids ...uint32) *PositionUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *PositionUpdate) RemoveRoles(v ...*Role) *PositionUpdate {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PositionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -273,6 +322,12 @@ func (_u *PositionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PositionName(); ok {
 		_spec.SetField(position.FieldPositionName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PositionNameI18n(); ok {
+		_spec.SetField(position.FieldPositionNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.PositionNameI18nCleared() {
+		_spec.ClearField(position.FieldPositionNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.PositionCode(); ok {
 		_spec.SetField(position.FieldPositionCode, field.TypeString, value)
 	}
@@ -327,6 +382,51 @@ func (_u *PositionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{position.Label}
@@ -428,6 +528,18 @@ func (_u *PositionUpdateOne) SetNillablePositionName(v *string) *PositionUpdateO
 	return _u
 }
 
+// SetPositionNameI18n sets the "position_name_i18n" field.
+func (_u *PositionUpdateOne) SetPositionNameI18n(v map[string]string) *PositionUpdateOne {
+	_u.mutation.SetPositionNameI18n(v)
+	return _u
+}
+
+// ClearPositionNameI18n clears the value of the "position_name_i18n" field.
+func (_u *PositionUpdateOne) ClearPositionNameI18n() *PositionUpdateOne {
+	_u.mutation.ClearPositionNameI18n()
+	return _u
+}
+
 // SetPositionCode sets the "position_code" field.
 func (_u *PositionUpdateOne) SetPositionCode(v string) *PositionUpdateOne {
 	_u.mutation.SetPositionCode(v)
@@ -477,6 +589,21 @@ func (_u *PositionUpdateOne) AddUsers(v ...*User) *PositionUpdateOne {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *PositionUpdateOne) AddRoleIDs(ids ...uint32) *PositionUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *PositionUpdateOne) AddRoles(v ...*Role) *PositionUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
 // Mutation returns the PositionMutation object of the builder.
 func (_u *PositionUpdateOne) Mutation() *PositionMutation {
 	return _u.mutation
@@ -503,6 +630,27 @@ func (_u *PositionUpdateOne) RemoveUsers(v ...*User) *PositionUpdateOne {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *PositionUpdateOne) ClearRoles() *PositionUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *PositionUpdateOne) RemoveRoleIDs(ids ...uint32) *PositionUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *PositionUpdateOne) RemoveRoles(v ...*Role) *PositionUpdateOne {
+	ids := make([]uint32, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
 // Where appends a list predicates to the PositionUpdate builder.
 func (_u *PositionUpdateOne) Where(ps ...predicate.Position) *PositionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -620,6 +768,12 @@ func (_u *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err
 	if value, ok := _u.mutation.PositionName(); ok {
 		_spec.SetField(position.FieldPositionName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PositionNameI18n(); ok {
+		_spec.SetField(position.FieldPositionNameI18n, field.TypeJSON, value)
+	}
+	if _u.mutation.PositionNameI18nCleared() {
+		_spec.ClearField(position.FieldPositionNameI18n, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.PositionCode(); ok {
 		_spec.SetField(position.FieldPositionCode, field.TypeString, value)
 	}
@@ -674,6 +828,51 @@ func (_u *PositionUpdateOne) sqlSave(ctx context.Context) (_node *Position, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   position.RolesTable,
+			Columns: position.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Position{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
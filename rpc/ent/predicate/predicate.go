@@ -9,6 +9,9 @@ import (
 // API is the predicate function for api builders.
 type API func(*sql.Selector)
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
 // Configuration is the predicate function for configuration builders.
 type Configuration func(*sql.Selector)
 
@@ -21,26 +24,59 @@ type DictItem func(*sql.Selector)
 // DictType is the predicate function for dicttype builders.
 type DictType func(*sql.Selector)
 
+// File is the predicate function for file builders.
+type File func(*sql.Selector)
+
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationRecipient is the predicate function for notificationrecipient builders.
+type NotificationRecipient func(*sql.Selector)
+
+// OauthClient is the predicate function for oauthclient builders.
+type OauthClient func(*sql.Selector)
+
+// OauthConsent is the predicate function for oauthconsent builders.
+type OauthConsent func(*sql.Selector)
+
 // OauthProvider is the predicate function for oauthprovider builders.
 type OauthProvider func(*sql.Selector)
 
 // OperationLog is the predicate function for operationlog builders.
 type OperationLog func(*sql.Selector)
 
+// OperationLogArchive is the predicate function for operationlogarchive builders.
+type OperationLogArchive func(*sql.Selector)
+
+// PermissionSnapshot is the predicate function for permissionsnapshot builders.
+type PermissionSnapshot func(*sql.Selector)
+
 // Position is the predicate function for position builders.
 type Position func(*sql.Selector)
 
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// ScheduledJob is the predicate function for scheduledjob builders.
+type ScheduledJob func(*sql.Selector)
+
+// SecurityEvent is the predicate function for securityevent builders.
+type SecurityEvent func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserPasswordHistory is the predicate function for userpasswordhistory builders.
+type UserPasswordHistory func(*sql.Selector)
+
 // UserTotp is the predicate function for usertotp builders.
 type UserTotp func(*sql.Selector)
+
+// UserWebauthn is the predicate function for userwebauthn builders.
+type UserWebauthn func(*sql.Selector)
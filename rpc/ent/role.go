@@ -33,8 +33,9 @@ type Role struct {
 	Description *string `json:"description,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
-	Edges        RoleEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges              RoleEdges `json:"edges"`
+	notification_roles *uint32
+	selectValues       sql.SelectValues
 }
 
 // RoleEdges holds the relations/edges for other nodes in the graph.
@@ -43,9 +44,15 @@ type RoleEdges struct {
 	Menus []*Menu `json:"menus,omitempty"`
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Positions holds the value of the positions edge.
+	Positions []*Position `json:"positions,omitempty"`
+	// Departments holds the value of the departments edge.
+	Departments []*Department `json:"departments,omitempty"`
+	// Apis holds the value of the apis edge.
+	Apis []*API `json:"apis,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // MenusOrErr returns the Menus value or an error if the edge
@@ -66,6 +73,33 @@ func (e RoleEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// PositionsOrErr returns the Positions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) PositionsOrErr() ([]*Position, error) {
+	if e.loadedTypes[2] {
+		return e.Positions, nil
+	}
+	return nil, &NotLoadedError{edge: "positions"}
+}
+
+// DepartmentsOrErr returns the Departments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) DepartmentsOrErr() ([]*Department, error) {
+	if e.loadedTypes[3] {
+		return e.Departments, nil
+	}
+	return nil, &NotLoadedError{edge: "departments"}
+}
+
+// ApisOrErr returns the Apis value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) ApisOrErr() ([]*API, error) {
+	if e.loadedTypes[4] {
+		return e.Apis, nil
+	}
+	return nil, &NotLoadedError{edge: "apis"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -79,6 +113,8 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case role.FieldCreatedAt, role.FieldUpdatedAt, role.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
+		case role.ForeignKeys[0]: // notification_roles
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -144,6 +180,13 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 				_m.Description = new(string)
 				*_m.Description = value.String
 			}
+		case role.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field notification_roles", value)
+			} else if value.Valid {
+				_m.notification_roles = new(uint32)
+				*_m.notification_roles = uint32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -167,6 +210,21 @@ func (_m *Role) QueryUsers() *UserQuery {
 	return NewRoleClient(_m.config).QueryUsers(_m)
 }
 
+// QueryPositions queries the "positions" edge of the Role entity.
+func (_m *Role) QueryPositions() *PositionQuery {
+	return NewRoleClient(_m.config).QueryPositions(_m)
+}
+
+// QueryDepartments queries the "departments" edge of the Role entity.
+func (_m *Role) QueryDepartments() *DepartmentQuery {
+	return NewRoleClient(_m.config).QueryDepartments(_m)
+}
+
+// QueryApis queries the "apis" edge of the Role entity.
+func (_m *Role) QueryApis() *APIQuery {
+	return NewRoleClient(_m.config).QueryApis(_m)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -7,19 +7,31 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
 	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dictitem"
 	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/oauthprovider"
 	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
 	"github.com/wenpiner/last-admin-core/rpc/ent/schema"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/token"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userpasswordhistory"
 	"github.com/wenpiner/last-admin-core/rpc/ent/usertotp"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -127,6 +139,95 @@ func init() {
 	apiDescID := apiMixinFields0[0].Descriptor()
 	// api.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	api.IDValidator = apiDescID.Validators[0].(func(uint32) error)
+	apikeyMixin := schema.ApiKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyMixinFields1 := apikeyMixin[1].Fields()
+	_ = apikeyMixinFields1
+	apikeyMixinFields2 := apikeyMixin[2].Fields()
+	_ = apikeyMixinFields2
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields1[0].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields1[1].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescState is the schema descriptor for state field.
+	apikeyDescState := apikeyMixinFields2[0].Descriptor()
+	// apikey.DefaultState holds the default value on creation for the state field.
+	apikey.DefaultState = apikeyDescState.Default.(bool)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescKeyPrefix is the schema descriptor for key_prefix field.
+	apikeyDescKeyPrefix := apikeyFields[2].Descriptor()
+	// apikey.KeyPrefixValidator is a validator for the "key_prefix" field. It is called by the builders before save.
+	apikey.KeyPrefixValidator = func() func(string) error {
+		validators := apikeyDescKeyPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_prefix string) error {
+			for _, fn := range fns {
+				if err := fn(key_prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[3].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = func() func(string) error {
+		validators := apikeyDescKeyHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_hash string) error {
+			for _, fn := range fns {
+				if err := fn(key_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apikeyDescLastUsedIP := apikeyFields[8].Descriptor()
+	// apikey.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	apikey.LastUsedIPValidator = apikeyDescLastUsedIP.Validators[0].(func(string) error)
+	// apikeyDescDescription is the schema descriptor for description field.
+	apikeyDescDescription := apikeyFields[9].Descriptor()
+	// apikey.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	apikey.DescriptionValidator = apikeyDescDescription.Validators[0].(func(string) error)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyMixinFields0[0].Descriptor()
+	// apikey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apikey.IDValidator = apikeyDescID.Validators[0].(func(uint32) error)
 	configurationMixin := schema.Configuration{}.Mixin()
 	configurationMixinFields0 := configurationMixin[0].Fields()
 	_ = configurationMixinFields0
@@ -206,7 +307,7 @@ func init() {
 		}
 	}()
 	// departmentDescDeptCode is the schema descriptor for dept_code field.
-	departmentDescDeptCode := departmentFields[1].Descriptor()
+	departmentDescDeptCode := departmentFields[2].Descriptor()
 	// department.DeptCodeValidator is a validator for the "dept_code" field. It is called by the builders before save.
 	department.DeptCodeValidator = func() func(string) error {
 		validators := departmentDescDeptCode.Validators
@@ -223,6 +324,10 @@ func init() {
 			return nil
 		}
 	}()
+	// departmentDescInheritRoles is the schema descriptor for inherit_roles field.
+	departmentDescInheritRoles := departmentFields[6].Descriptor()
+	// department.DefaultInheritRoles holds the default value on creation for the inherit_roles field.
+	department.DefaultInheritRoles = departmentDescInheritRoles.Default.(bool)
 	// departmentDescID is the schema descriptor for id field.
 	departmentDescID := departmentMixinFields0[0].Descriptor()
 	// department.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -269,7 +374,7 @@ func init() {
 		}
 	}()
 	// dictitemDescItemValue is the schema descriptor for item_value field.
-	dictitemDescItemValue := dictitemFields[1].Descriptor()
+	dictitemDescItemValue := dictitemFields[2].Descriptor()
 	// dictitem.ItemValueValidator is a validator for the "item_value" field. It is called by the builders before save.
 	dictitem.ItemValueValidator = func() func(string) error {
 		validators := dictitemDescItemValue.Validators
@@ -287,15 +392,15 @@ func init() {
 		}
 	}()
 	// dictitemDescItemColor is the schema descriptor for item_color field.
-	dictitemDescItemColor := dictitemFields[2].Descriptor()
+	dictitemDescItemColor := dictitemFields[3].Descriptor()
 	// dictitem.ItemColorValidator is a validator for the "item_color" field. It is called by the builders before save.
 	dictitem.ItemColorValidator = dictitemDescItemColor.Validators[0].(func(string) error)
 	// dictitemDescItemCSS is the schema descriptor for item_css field.
-	dictitemDescItemCSS := dictitemFields[3].Descriptor()
+	dictitemDescItemCSS := dictitemFields[4].Descriptor()
 	// dictitem.ItemCSSValidator is a validator for the "item_css" field. It is called by the builders before save.
 	dictitem.ItemCSSValidator = dictitemDescItemCSS.Validators[0].(func(string) error)
 	// dictitemDescSortOrder is the schema descriptor for sort_order field.
-	dictitemDescSortOrder := dictitemFields[4].Descriptor()
+	dictitemDescSortOrder := dictitemFields[5].Descriptor()
 	// dictitem.DefaultSortOrder holds the default value on creation for the sort_order field.
 	dictitem.DefaultSortOrder = dictitemDescSortOrder.Default.(int)
 	// dictitemDescID is the schema descriptor for id field.
@@ -367,10 +472,73 @@ func init() {
 	dicttype.DefaultDescription = dicttypeDescDescription.Default.(string)
 	// dicttype.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	dicttype.DescriptionValidator = dicttypeDescDescription.Validators[0].(func(string) error)
+	// dicttypeDescIsPublic is the schema descriptor for is_public field.
+	dicttypeDescIsPublic := dicttypeFields[3].Descriptor()
+	// dicttype.DefaultIsPublic holds the default value on creation for the is_public field.
+	dicttype.DefaultIsPublic = dicttypeDescIsPublic.Default.(bool)
 	// dicttypeDescID is the schema descriptor for id field.
 	dicttypeDescID := dicttypeMixinFields0[0].Descriptor()
 	// dicttype.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	dicttype.IDValidator = dicttypeDescID.Validators[0].(func(uint32) error)
+	fileMixin := schema.File{}.Mixin()
+	fileMixinFields0 := fileMixin[0].Fields()
+	_ = fileMixinFields0
+	fileFields := schema.File{}.Fields()
+	_ = fileFields
+	// fileDescCreatedAt is the schema descriptor for created_at field.
+	fileDescCreatedAt := fileMixinFields0[0].Descriptor()
+	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
+	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
+	// fileDescUpdatedAt is the schema descriptor for updated_at field.
+	fileDescUpdatedAt := fileMixinFields0[1].Descriptor()
+	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
+	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fileDescName is the schema descriptor for name field.
+	fileDescName := fileFields[2].Descriptor()
+	// file.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	file.NameValidator = func() func(string) error {
+		validators := fileDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescCategory is the schema descriptor for category field.
+	fileDescCategory := fileFields[3].Descriptor()
+	// file.DefaultCategory holds the default value on creation for the category field.
+	file.DefaultCategory = fileDescCategory.Default.(string)
+	// file.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	file.CategoryValidator = fileDescCategory.Validators[0].(func(string) error)
+	// fileDescSize is the schema descriptor for size field.
+	fileDescSize := fileFields[4].Descriptor()
+	// file.DefaultSize holds the default value on creation for the size field.
+	file.DefaultSize = fileDescSize.Default.(int64)
+	// fileDescMimeType is the schema descriptor for mime_type field.
+	fileDescMimeType := fileFields[5].Descriptor()
+	// file.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	file.MimeTypeValidator = fileDescMimeType.Validators[0].(func(string) error)
+	// fileDescChecksum is the schema descriptor for checksum field.
+	fileDescChecksum := fileFields[6].Descriptor()
+	// file.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	file.ChecksumValidator = fileDescChecksum.Validators[0].(func(string) error)
+	// fileDescStorageKey is the schema descriptor for storage_key field.
+	fileDescStorageKey := fileFields[7].Descriptor()
+	// file.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	file.StorageKeyValidator = fileDescStorageKey.Validators[0].(func(string) error)
+	// fileDescID is the schema descriptor for id field.
+	fileDescID := fileFields[0].Descriptor()
+	// file.DefaultID holds the default value on creation for the id field.
+	file.DefaultID = fileDescID.Default.(func() uuid.UUID)
 	menuMixin := schema.Menu{}.Mixin()
 	menuMixinFields0 := menuMixin[0].Fields()
 	_ = menuMixinFields0
@@ -437,35 +605,35 @@ func init() {
 		}
 	}()
 	// menuDescMenuPath is the schema descriptor for menu_path field.
-	menuDescMenuPath := menuFields[3].Descriptor()
+	menuDescMenuPath := menuFields[4].Descriptor()
 	// menu.MenuPathValidator is a validator for the "menu_path" field. It is called by the builders before save.
 	menu.MenuPathValidator = menuDescMenuPath.Validators[0].(func(string) error)
 	// menuDescComponent is the schema descriptor for component field.
-	menuDescComponent := menuFields[4].Descriptor()
+	menuDescComponent := menuFields[5].Descriptor()
 	// menu.ComponentValidator is a validator for the "component" field. It is called by the builders before save.
 	menu.ComponentValidator = menuDescComponent.Validators[0].(func(string) error)
 	// menuDescRedirect is the schema descriptor for redirect field.
-	menuDescRedirect := menuFields[5].Descriptor()
+	menuDescRedirect := menuFields[6].Descriptor()
 	// menu.RedirectValidator is a validator for the "redirect" field. It is called by the builders before save.
 	menu.RedirectValidator = menuDescRedirect.Validators[0].(func(string) error)
 	// menuDescMenuLevel is the schema descriptor for menu_level field.
-	menuDescMenuLevel := menuFields[6].Descriptor()
+	menuDescMenuLevel := menuFields[7].Descriptor()
 	// menu.DefaultMenuLevel holds the default value on creation for the menu_level field.
 	menu.DefaultMenuLevel = menuDescMenuLevel.Default.(uint16)
 	// menuDescIcon is the schema descriptor for icon field.
-	menuDescIcon := menuFields[7].Descriptor()
+	menuDescIcon := menuFields[8].Descriptor()
 	// menu.IconValidator is a validator for the "icon" field. It is called by the builders before save.
 	menu.IconValidator = menuDescIcon.Validators[0].(func(string) error)
 	// menuDescPermission is the schema descriptor for permission field.
-	menuDescPermission := menuFields[8].Descriptor()
+	menuDescPermission := menuFields[9].Descriptor()
 	// menu.PermissionValidator is a validator for the "permission" field. It is called by the builders before save.
 	menu.PermissionValidator = menuDescPermission.Validators[0].(func(string) error)
 	// menuDescServiceName is the schema descriptor for service_name field.
-	menuDescServiceName := menuFields[9].Descriptor()
+	menuDescServiceName := menuFields[10].Descriptor()
 	// menu.ServiceNameValidator is a validator for the "service_name" field. It is called by the builders before save.
 	menu.ServiceNameValidator = menuDescServiceName.Validators[0].(func(string) error)
 	// menuDescMenuType is the schema descriptor for menu_type field.
-	menuDescMenuType := menuFields[10].Descriptor()
+	menuDescMenuType := menuFields[11].Descriptor()
 	// menu.MenuTypeValidator is a validator for the "menu_type" field. It is called by the builders before save.
 	menu.MenuTypeValidator = func() func(string) error {
 		validators := menuDescMenuType.Validators
@@ -483,17 +651,227 @@ func init() {
 		}
 	}()
 	// menuDescFrameSrc is the schema descriptor for frame_src field.
-	menuDescFrameSrc := menuFields[16].Descriptor()
+	menuDescFrameSrc := menuFields[17].Descriptor()
 	// menu.FrameSrcValidator is a validator for the "frame_src" field. It is called by the builders before save.
 	menu.FrameSrcValidator = menuDescFrameSrc.Validators[0].(func(string) error)
 	// menuDescLink is the schema descriptor for link field.
-	menuDescLink := menuFields[18].Descriptor()
+	menuDescLink := menuFields[19].Descriptor()
 	// menu.LinkValidator is a validator for the "link" field. It is called by the builders before save.
 	menu.LinkValidator = menuDescLink.Validators[0].(func(string) error)
 	// menuDescID is the schema descriptor for id field.
 	menuDescID := menuMixinFields0[0].Descriptor()
 	// menu.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	menu.IDValidator = menuDescID.Validators[0].(func(uint32) error)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationMixinFields1 := notificationMixin[1].Fields()
+	_ = notificationMixinFields1
+	notificationMixinFields2 := notificationMixin[2].Fields()
+	_ = notificationMixinFields2
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields1[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields1[1].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationDescState is the schema descriptor for state field.
+	notificationDescState := notificationMixinFields2[0].Descriptor()
+	// notification.DefaultState holds the default value on creation for the state field.
+	notification.DefaultState = notificationDescState.Default.(bool)
+	// notificationDescTitle is the schema descriptor for title field.
+	notificationDescTitle := notificationFields[0].Descriptor()
+	// notification.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	notification.TitleValidator = func() func(string) error {
+		validators := notificationDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescCategory is the schema descriptor for category field.
+	notificationDescCategory := notificationFields[2].Descriptor()
+	// notification.DefaultCategory holds the default value on creation for the category field.
+	notification.DefaultCategory = notificationDescCategory.Default.(string)
+	// notification.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	notification.CategoryValidator = notificationDescCategory.Validators[0].(func(string) error)
+	// notificationDescLevel is the schema descriptor for level field.
+	notificationDescLevel := notificationFields[3].Descriptor()
+	// notification.DefaultLevel holds the default value on creation for the level field.
+	notification.DefaultLevel = notificationDescLevel.Default.(string)
+	// notification.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	notification.LevelValidator = notificationDescLevel.Validators[0].(func(string) error)
+	// notificationDescTargetAll is the schema descriptor for target_all field.
+	notificationDescTargetAll := notificationFields[4].Descriptor()
+	// notification.DefaultTargetAll holds the default value on creation for the target_all field.
+	notification.DefaultTargetAll = notificationDescTargetAll.Default.(bool)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationMixinFields0[0].Descriptor()
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = notificationDescID.Validators[0].(func(uint32) error)
+	notificationrecipientMixin := schema.NotificationRecipient{}.Mixin()
+	notificationrecipientMixinFields0 := notificationrecipientMixin[0].Fields()
+	_ = notificationrecipientMixinFields0
+	notificationrecipientMixinFields1 := notificationrecipientMixin[1].Fields()
+	_ = notificationrecipientMixinFields1
+	notificationrecipientFields := schema.NotificationRecipient{}.Fields()
+	_ = notificationrecipientFields
+	// notificationrecipientDescCreatedAt is the schema descriptor for created_at field.
+	notificationrecipientDescCreatedAt := notificationrecipientMixinFields1[0].Descriptor()
+	// notificationrecipient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationrecipient.DefaultCreatedAt = notificationrecipientDescCreatedAt.Default.(func() time.Time)
+	// notificationrecipientDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationrecipientDescUpdatedAt := notificationrecipientMixinFields1[1].Descriptor()
+	// notificationrecipient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationrecipient.DefaultUpdatedAt = notificationrecipientDescUpdatedAt.Default.(func() time.Time)
+	// notificationrecipient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationrecipient.UpdateDefaultUpdatedAt = notificationrecipientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationrecipientDescStatus is the schema descriptor for status field.
+	notificationrecipientDescStatus := notificationrecipientFields[2].Descriptor()
+	// notificationrecipient.DefaultStatus holds the default value on creation for the status field.
+	notificationrecipient.DefaultStatus = notificationrecipientDescStatus.Default.(string)
+	// notificationrecipient.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	notificationrecipient.StatusValidator = notificationrecipientDescStatus.Validators[0].(func(string) error)
+	// notificationrecipientDescID is the schema descriptor for id field.
+	notificationrecipientDescID := notificationrecipientMixinFields0[0].Descriptor()
+	// notificationrecipient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationrecipient.IDValidator = notificationrecipientDescID.Validators[0].(func(uint32) error)
+	oauthclientMixin := schema.OauthClient{}.Mixin()
+	oauthclientMixinFields0 := oauthclientMixin[0].Fields()
+	_ = oauthclientMixinFields0
+	oauthclientMixinFields1 := oauthclientMixin[1].Fields()
+	_ = oauthclientMixinFields1
+	oauthclientMixinFields2 := oauthclientMixin[2].Fields()
+	_ = oauthclientMixinFields2
+	oauthclientFields := schema.OauthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientMixinFields1[0].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientMixinFields1[1].Descriptor()
+	// oauthclient.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthclient.DefaultUpdatedAt = oauthclientDescUpdatedAt.Default.(func() time.Time)
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthclientDescState is the schema descriptor for state field.
+	oauthclientDescState := oauthclientMixinFields2[0].Descriptor()
+	// oauthclient.DefaultState holds the default value on creation for the state field.
+	oauthclient.DefaultState = oauthclientDescState.Default.(bool)
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[0].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = func() func(string) error {
+		validators := oauthclientDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthclientDescClientSecretHash is the schema descriptor for client_secret_hash field.
+	oauthclientDescClientSecretHash := oauthclientFields[1].Descriptor()
+	// oauthclient.ClientSecretHashValidator is a validator for the "client_secret_hash" field. It is called by the builders before save.
+	oauthclient.ClientSecretHashValidator = oauthclientDescClientSecretHash.Validators[0].(func(string) error)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[2].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = func() func(string) error {
+		validators := oauthclientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthclientDescPublic is the schema descriptor for public field.
+	oauthclientDescPublic := oauthclientFields[6].Descriptor()
+	// oauthclient.DefaultPublic holds the default value on creation for the public field.
+	oauthclient.DefaultPublic = oauthclientDescPublic.Default.(bool)
+	// oauthclientDescSkipConsent is the schema descriptor for skip_consent field.
+	oauthclientDescSkipConsent := oauthclientFields[7].Descriptor()
+	// oauthclient.DefaultSkipConsent holds the default value on creation for the skip_consent field.
+	oauthclient.DefaultSkipConsent = oauthclientDescSkipConsent.Default.(bool)
+	// oauthclientDescLogo is the schema descriptor for logo field.
+	oauthclientDescLogo := oauthclientFields[8].Descriptor()
+	// oauthclient.LogoValidator is a validator for the "logo" field. It is called by the builders before save.
+	oauthclient.LogoValidator = oauthclientDescLogo.Validators[0].(func(string) error)
+	// oauthclientDescDescription is the schema descriptor for description field.
+	oauthclientDescDescription := oauthclientFields[9].Descriptor()
+	// oauthclient.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	oauthclient.DescriptionValidator = oauthclientDescDescription.Validators[0].(func(string) error)
+	// oauthclientDescID is the schema descriptor for id field.
+	oauthclientDescID := oauthclientMixinFields0[0].Descriptor()
+	// oauthclient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthclient.IDValidator = oauthclientDescID.Validators[0].(func(uint32) error)
+	oauthconsentMixin := schema.OauthConsent{}.Mixin()
+	oauthconsentMixinFields0 := oauthconsentMixin[0].Fields()
+	_ = oauthconsentMixinFields0
+	oauthconsentMixinFields1 := oauthconsentMixin[1].Fields()
+	_ = oauthconsentMixinFields1
+	oauthconsentFields := schema.OauthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescCreatedAt is the schema descriptor for created_at field.
+	oauthconsentDescCreatedAt := oauthconsentMixinFields1[0].Descriptor()
+	// oauthconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthconsent.DefaultCreatedAt = oauthconsentDescCreatedAt.Default.(func() time.Time)
+	// oauthconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthconsentDescUpdatedAt := oauthconsentMixinFields1[1].Descriptor()
+	// oauthconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthconsent.DefaultUpdatedAt = oauthconsentDescUpdatedAt.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthconsent.UpdateDefaultUpdatedAt = oauthconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthconsentDescClientID is the schema descriptor for client_id field.
+	oauthconsentDescClientID := oauthconsentFields[1].Descriptor()
+	// oauthconsent.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthconsent.ClientIDValidator = func() func(string) error {
+		validators := oauthconsentDescClientID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthconsentDescID is the schema descriptor for id field.
+	oauthconsentDescID := oauthconsentMixinFields0[0].Descriptor()
+	// oauthconsent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oauthconsent.IDValidator = oauthconsentDescID.Validators[0].(func(uint32) error)
 	oauthproviderMixin := schema.OauthProvider{}.Mixin()
 	oauthproviderMixinFields0 := oauthproviderMixin[0].Fields()
 	_ = oauthproviderMixinFields0
@@ -760,6 +1138,76 @@ func init() {
 	operationlogDescID := operationlogMixinFields0[0].Descriptor()
 	// operationlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	operationlog.IDValidator = operationlogDescID.Validators[0].(func(uint32) error)
+	operationlogarchiveMixin := schema.OperationLogArchive{}.Mixin()
+	operationlogarchiveMixinFields0 := operationlogarchiveMixin[0].Fields()
+	_ = operationlogarchiveMixinFields0
+	operationlogarchiveMixinFields1 := operationlogarchiveMixin[1].Fields()
+	_ = operationlogarchiveMixinFields1
+	operationlogarchiveFields := schema.OperationLogArchive{}.Fields()
+	_ = operationlogarchiveFields
+	// operationlogarchiveDescCreatedAt is the schema descriptor for created_at field.
+	operationlogarchiveDescCreatedAt := operationlogarchiveMixinFields1[0].Descriptor()
+	// operationlogarchive.DefaultCreatedAt holds the default value on creation for the created_at field.
+	operationlogarchive.DefaultCreatedAt = operationlogarchiveDescCreatedAt.Default.(func() time.Time)
+	// operationlogarchiveDescUpdatedAt is the schema descriptor for updated_at field.
+	operationlogarchiveDescUpdatedAt := operationlogarchiveMixinFields1[1].Descriptor()
+	// operationlogarchive.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	operationlogarchive.DefaultUpdatedAt = operationlogarchiveDescUpdatedAt.Default.(func() time.Time)
+	// operationlogarchive.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	operationlogarchive.UpdateDefaultUpdatedAt = operationlogarchiveDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// operationlogarchiveDescModule is the schema descriptor for module field.
+	operationlogarchiveDescModule := operationlogarchiveFields[0].Descriptor()
+	// operationlogarchive.ModuleValidator is a validator for the "module" field. It is called by the builders before save.
+	operationlogarchive.ModuleValidator = operationlogarchiveDescModule.Validators[0].(func(string) error)
+	// operationlogarchiveDescRowCount is the schema descriptor for row_count field.
+	operationlogarchiveDescRowCount := operationlogarchiveFields[5].Descriptor()
+	// operationlogarchive.DefaultRowCount holds the default value on creation for the row_count field.
+	operationlogarchive.DefaultRowCount = operationlogarchiveDescRowCount.Default.(int64)
+	// operationlogarchiveDescSize is the schema descriptor for size field.
+	operationlogarchiveDescSize := operationlogarchiveFields[6].Descriptor()
+	// operationlogarchive.DefaultSize holds the default value on creation for the size field.
+	operationlogarchive.DefaultSize = operationlogarchiveDescSize.Default.(int64)
+	// operationlogarchiveDescChecksum is the schema descriptor for checksum field.
+	operationlogarchiveDescChecksum := operationlogarchiveFields[7].Descriptor()
+	// operationlogarchive.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	operationlogarchive.ChecksumValidator = operationlogarchiveDescChecksum.Validators[0].(func(string) error)
+	// operationlogarchiveDescStorageKey is the schema descriptor for storage_key field.
+	operationlogarchiveDescStorageKey := operationlogarchiveFields[8].Descriptor()
+	// operationlogarchive.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	operationlogarchive.StorageKeyValidator = operationlogarchiveDescStorageKey.Validators[0].(func(string) error)
+	// operationlogarchiveDescID is the schema descriptor for id field.
+	operationlogarchiveDescID := operationlogarchiveMixinFields0[0].Descriptor()
+	// operationlogarchive.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	operationlogarchive.IDValidator = operationlogarchiveDescID.Validators[0].(func(uint32) error)
+	permissionsnapshotMixin := schema.PermissionSnapshot{}.Mixin()
+	permissionsnapshotMixinFields0 := permissionsnapshotMixin[0].Fields()
+	_ = permissionsnapshotMixinFields0
+	permissionsnapshotMixinFields1 := permissionsnapshotMixin[1].Fields()
+	_ = permissionsnapshotMixinFields1
+	permissionsnapshotFields := schema.PermissionSnapshot{}.Fields()
+	_ = permissionsnapshotFields
+	// permissionsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	permissionsnapshotDescCreatedAt := permissionsnapshotMixinFields1[0].Descriptor()
+	// permissionsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	permissionsnapshot.DefaultCreatedAt = permissionsnapshotDescCreatedAt.Default.(func() time.Time)
+	// permissionsnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	permissionsnapshotDescUpdatedAt := permissionsnapshotMixinFields1[1].Descriptor()
+	// permissionsnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	permissionsnapshot.DefaultUpdatedAt = permissionsnapshotDescUpdatedAt.Default.(func() time.Time)
+	// permissionsnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	permissionsnapshot.UpdateDefaultUpdatedAt = permissionsnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// permissionsnapshotDescRemark is the schema descriptor for remark field.
+	permissionsnapshotDescRemark := permissionsnapshotFields[0].Descriptor()
+	// permissionsnapshot.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
+	permissionsnapshot.RemarkValidator = permissionsnapshotDescRemark.Validators[0].(func(string) error)
+	// permissionsnapshotDescEntryCount is the schema descriptor for entry_count field.
+	permissionsnapshotDescEntryCount := permissionsnapshotFields[2].Descriptor()
+	// permissionsnapshot.DefaultEntryCount holds the default value on creation for the entry_count field.
+	permissionsnapshot.DefaultEntryCount = permissionsnapshotDescEntryCount.Default.(int64)
+	// permissionsnapshotDescID is the schema descriptor for id field.
+	permissionsnapshotDescID := permissionsnapshotMixinFields0[0].Descriptor()
+	// permissionsnapshot.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	permissionsnapshot.IDValidator = permissionsnapshotDescID.Validators[0].(func(uint32) error)
 	positionMixin := schema.Position{}.Mixin()
 	positionMixinFields0 := positionMixin[0].Fields()
 	_ = positionMixinFields0
@@ -808,7 +1256,7 @@ func init() {
 		}
 	}()
 	// positionDescPositionCode is the schema descriptor for position_code field.
-	positionDescPositionCode := positionFields[1].Descriptor()
+	positionDescPositionCode := positionFields[2].Descriptor()
 	// position.PositionCodeValidator is a validator for the "position_code" field. It is called by the builders before save.
 	position.PositionCodeValidator = func() func(string) error {
 		validators := positionDescPositionCode.Validators
@@ -892,6 +1340,128 @@ func init() {
 	roleDescID := roleMixinFields2[0].Descriptor()
 	// role.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	role.IDValidator = roleDescID.Validators[0].(func(uint32) error)
+	scheduledjobMixin := schema.ScheduledJob{}.Mixin()
+	scheduledjobMixinFields0 := scheduledjobMixin[0].Fields()
+	_ = scheduledjobMixinFields0
+	scheduledjobMixinFields1 := scheduledjobMixin[1].Fields()
+	_ = scheduledjobMixinFields1
+	scheduledjobFields := schema.ScheduledJob{}.Fields()
+	_ = scheduledjobFields
+	// scheduledjobDescCreatedAt is the schema descriptor for created_at field.
+	scheduledjobDescCreatedAt := scheduledjobMixinFields1[0].Descriptor()
+	// scheduledjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledjob.DefaultCreatedAt = scheduledjobDescCreatedAt.Default.(func() time.Time)
+	// scheduledjobDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledjobDescUpdatedAt := scheduledjobMixinFields1[1].Descriptor()
+	// scheduledjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledjob.DefaultUpdatedAt = scheduledjobDescUpdatedAt.Default.(func() time.Time)
+	// scheduledjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledjob.UpdateDefaultUpdatedAt = scheduledjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledjobDescName is the schema descriptor for name field.
+	scheduledjobDescName := scheduledjobFields[0].Descriptor()
+	// scheduledjob.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scheduledjob.NameValidator = func() func(string) error {
+		validators := scheduledjobDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledjobDescPaused is the schema descriptor for paused field.
+	scheduledjobDescPaused := scheduledjobFields[1].Descriptor()
+	// scheduledjob.DefaultPaused holds the default value on creation for the paused field.
+	scheduledjob.DefaultPaused = scheduledjobDescPaused.Default.(bool)
+	// scheduledjobDescLastStatus is the schema descriptor for last_status field.
+	scheduledjobDescLastStatus := scheduledjobFields[3].Descriptor()
+	// scheduledjob.LastStatusValidator is a validator for the "last_status" field. It is called by the builders before save.
+	scheduledjob.LastStatusValidator = scheduledjobDescLastStatus.Validators[0].(func(string) error)
+	// scheduledjobDescLastTrigger is the schema descriptor for last_trigger field.
+	scheduledjobDescLastTrigger := scheduledjobFields[4].Descriptor()
+	// scheduledjob.LastTriggerValidator is a validator for the "last_trigger" field. It is called by the builders before save.
+	scheduledjob.LastTriggerValidator = scheduledjobDescLastTrigger.Validators[0].(func(string) error)
+	// scheduledjobDescLastDuration is the schema descriptor for last_duration field.
+	scheduledjobDescLastDuration := scheduledjobFields[5].Descriptor()
+	// scheduledjob.DefaultLastDuration holds the default value on creation for the last_duration field.
+	scheduledjob.DefaultLastDuration = scheduledjobDescLastDuration.Default.(int64)
+	// scheduledjobDescLastMessage is the schema descriptor for last_message field.
+	scheduledjobDescLastMessage := scheduledjobFields[6].Descriptor()
+	// scheduledjob.LastMessageValidator is a validator for the "last_message" field. It is called by the builders before save.
+	scheduledjob.LastMessageValidator = scheduledjobDescLastMessage.Validators[0].(func(string) error)
+	// scheduledjobDescID is the schema descriptor for id field.
+	scheduledjobDescID := scheduledjobMixinFields0[0].Descriptor()
+	// scheduledjob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scheduledjob.IDValidator = scheduledjobDescID.Validators[0].(func(uint32) error)
+	securityeventMixin := schema.SecurityEvent{}.Mixin()
+	securityeventMixinFields0 := securityeventMixin[0].Fields()
+	_ = securityeventMixinFields0
+	securityeventMixinFields1 := securityeventMixin[1].Fields()
+	_ = securityeventMixinFields1
+	securityeventFields := schema.SecurityEvent{}.Fields()
+	_ = securityeventFields
+	// securityeventDescCreatedAt is the schema descriptor for created_at field.
+	securityeventDescCreatedAt := securityeventMixinFields1[0].Descriptor()
+	// securityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityevent.DefaultCreatedAt = securityeventDescCreatedAt.Default.(func() time.Time)
+	// securityeventDescUpdatedAt is the schema descriptor for updated_at field.
+	securityeventDescUpdatedAt := securityeventMixinFields1[1].Descriptor()
+	// securityevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	securityevent.DefaultUpdatedAt = securityeventDescUpdatedAt.Default.(func() time.Time)
+	// securityevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	securityevent.UpdateDefaultUpdatedAt = securityeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// securityeventDescUsername is the schema descriptor for username field.
+	securityeventDescUsername := securityeventFields[1].Descriptor()
+	// securityevent.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	securityevent.UsernameValidator = securityeventDescUsername.Validators[0].(func(string) error)
+	// securityeventDescEventType is the schema descriptor for event_type field.
+	securityeventDescEventType := securityeventFields[2].Descriptor()
+	// securityevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	securityevent.EventTypeValidator = func() func(string) error {
+		validators := securityeventDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// securityeventDescSuccess is the schema descriptor for success field.
+	securityeventDescSuccess := securityeventFields[3].Descriptor()
+	// securityevent.DefaultSuccess holds the default value on creation for the success field.
+	securityevent.DefaultSuccess = securityeventDescSuccess.Default.(bool)
+	// securityeventDescProvider is the schema descriptor for provider field.
+	securityeventDescProvider := securityeventFields[4].Descriptor()
+	// securityevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	securityevent.ProviderValidator = securityeventDescProvider.Validators[0].(func(string) error)
+	// securityeventDescReason is the schema descriptor for reason field.
+	securityeventDescReason := securityeventFields[5].Descriptor()
+	// securityevent.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	securityevent.ReasonValidator = securityeventDescReason.Validators[0].(func(string) error)
+	// securityeventDescIPAddress is the schema descriptor for ip_address field.
+	securityeventDescIPAddress := securityeventFields[6].Descriptor()
+	// securityevent.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	securityevent.IPAddressValidator = securityeventDescIPAddress.Validators[0].(func(string) error)
+	// securityeventDescUserAgent is the schema descriptor for user_agent field.
+	securityeventDescUserAgent := securityeventFields[7].Descriptor()
+	// securityevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	securityevent.UserAgentValidator = securityeventDescUserAgent.Validators[0].(func(string) error)
+	// securityeventDescID is the schema descriptor for id field.
+	securityeventDescID := securityeventMixinFields0[0].Descriptor()
+	// securityevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	securityevent.IDValidator = securityeventDescID.Validators[0].(func(uint32) error)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinFields0 := tokenMixin[0].Fields()
 	_ = tokenMixinFields0
@@ -1048,10 +1618,57 @@ func init() {
 	userDescHomePath := userFields[11].Descriptor()
 	// user.HomePathValidator is a validator for the "home_path" field. It is called by the builders before save.
 	user.HomePathValidator = userDescHomePath.Validators[0].(func(string) error)
+	// userDescMustChangePassword is the schema descriptor for must_change_password field.
+	userDescMustChangePassword := userFields[13].Descriptor()
+	// user.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	user.DefaultMustChangePassword = userDescMustChangePassword.Default.(bool)
+	// userDescLanguage is the schema descriptor for language field.
+	userDescLanguage := userFields[14].Descriptor()
+	// user.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	user.LanguageValidator = userDescLanguage.Validators[0].(func(string) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userpasswordhistoryMixin := schema.UserPasswordHistory{}.Mixin()
+	userpasswordhistoryMixinFields0 := userpasswordhistoryMixin[0].Fields()
+	_ = userpasswordhistoryMixinFields0
+	userpasswordhistoryMixinFields1 := userpasswordhistoryMixin[1].Fields()
+	_ = userpasswordhistoryMixinFields1
+	userpasswordhistoryFields := schema.UserPasswordHistory{}.Fields()
+	_ = userpasswordhistoryFields
+	// userpasswordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	userpasswordhistoryDescCreatedAt := userpasswordhistoryMixinFields1[0].Descriptor()
+	// userpasswordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userpasswordhistory.DefaultCreatedAt = userpasswordhistoryDescCreatedAt.Default.(func() time.Time)
+	// userpasswordhistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	userpasswordhistoryDescUpdatedAt := userpasswordhistoryMixinFields1[1].Descriptor()
+	// userpasswordhistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userpasswordhistory.DefaultUpdatedAt = userpasswordhistoryDescUpdatedAt.Default.(func() time.Time)
+	// userpasswordhistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userpasswordhistory.UpdateDefaultUpdatedAt = userpasswordhistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userpasswordhistoryDescPasswordHash is the schema descriptor for password_hash field.
+	userpasswordhistoryDescPasswordHash := userpasswordhistoryFields[1].Descriptor()
+	// userpasswordhistory.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	userpasswordhistory.PasswordHashValidator = func() func(string) error {
+		validators := userpasswordhistoryDescPasswordHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(password_hash string) error {
+			for _, fn := range fns {
+				if err := fn(password_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userpasswordhistoryDescID is the schema descriptor for id field.
+	userpasswordhistoryDescID := userpasswordhistoryMixinFields0[0].Descriptor()
+	// userpasswordhistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userpasswordhistory.IDValidator = userpasswordhistoryDescID.Validators[0].(func(uint32) error)
 	usertotpMixin := schema.UserTotp{}.Mixin()
 	usertotpMixinFields0 := usertotpMixin[0].Fields()
 	_ = usertotpMixinFields0
@@ -1115,4 +1732,85 @@ func init() {
 	usertotpDescID := usertotpFields[0].Descriptor()
 	// usertotp.DefaultID holds the default value on creation for the id field.
 	usertotp.DefaultID = usertotpDescID.Default.(func() uuid.UUID)
+	userwebauthnMixin := schema.UserWebauthn{}.Mixin()
+	userwebauthnMixinFields0 := userwebauthnMixin[0].Fields()
+	_ = userwebauthnMixinFields0
+	userwebauthnMixinFields1 := userwebauthnMixin[1].Fields()
+	_ = userwebauthnMixinFields1
+	userwebauthnMixinFields2 := userwebauthnMixin[2].Fields()
+	_ = userwebauthnMixinFields2
+	userwebauthnFields := schema.UserWebauthn{}.Fields()
+	_ = userwebauthnFields
+	// userwebauthnDescCreatedAt is the schema descriptor for created_at field.
+	userwebauthnDescCreatedAt := userwebauthnMixinFields1[0].Descriptor()
+	// userwebauthn.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userwebauthn.DefaultCreatedAt = userwebauthnDescCreatedAt.Default.(func() time.Time)
+	// userwebauthnDescUpdatedAt is the schema descriptor for updated_at field.
+	userwebauthnDescUpdatedAt := userwebauthnMixinFields1[1].Descriptor()
+	// userwebauthn.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userwebauthn.DefaultUpdatedAt = userwebauthnDescUpdatedAt.Default.(func() time.Time)
+	// userwebauthn.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userwebauthn.UpdateDefaultUpdatedAt = userwebauthnDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userwebauthnDescState is the schema descriptor for state field.
+	userwebauthnDescState := userwebauthnMixinFields2[0].Descriptor()
+	// userwebauthn.DefaultState holds the default value on creation for the state field.
+	userwebauthn.DefaultState = userwebauthnDescState.Default.(bool)
+	// userwebauthnDescCredentialID is the schema descriptor for credential_id field.
+	userwebauthnDescCredentialID := userwebauthnFields[1].Descriptor()
+	// userwebauthn.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	userwebauthn.CredentialIDValidator = func() func(string) error {
+		validators := userwebauthnDescCredentialID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(credential_id string) error {
+			for _, fn := range fns {
+				if err := fn(credential_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userwebauthnDescAttestationType is the schema descriptor for attestation_type field.
+	userwebauthnDescAttestationType := userwebauthnFields[3].Descriptor()
+	// userwebauthn.AttestationTypeValidator is a validator for the "attestation_type" field. It is called by the builders before save.
+	userwebauthn.AttestationTypeValidator = userwebauthnDescAttestationType.Validators[0].(func(string) error)
+	// userwebauthnDescAaguid is the schema descriptor for aaguid field.
+	userwebauthnDescAaguid := userwebauthnFields[4].Descriptor()
+	// userwebauthn.AaguidValidator is a validator for the "aaguid" field. It is called by the builders before save.
+	userwebauthn.AaguidValidator = userwebauthnDescAaguid.Validators[0].(func(string) error)
+	// userwebauthnDescSignCount is the schema descriptor for sign_count field.
+	userwebauthnDescSignCount := userwebauthnFields[5].Descriptor()
+	// userwebauthn.DefaultSignCount holds the default value on creation for the sign_count field.
+	userwebauthn.DefaultSignCount = userwebauthnDescSignCount.Default.(uint32)
+	// userwebauthnDescCloneWarning is the schema descriptor for clone_warning field.
+	userwebauthnDescCloneWarning := userwebauthnFields[6].Descriptor()
+	// userwebauthn.DefaultCloneWarning holds the default value on creation for the clone_warning field.
+	userwebauthn.DefaultCloneWarning = userwebauthnDescCloneWarning.Default.(bool)
+	// userwebauthnDescAttachment is the schema descriptor for attachment field.
+	userwebauthnDescAttachment := userwebauthnFields[8].Descriptor()
+	// userwebauthn.AttachmentValidator is a validator for the "attachment" field. It is called by the builders before save.
+	userwebauthn.AttachmentValidator = userwebauthnDescAttachment.Validators[0].(func(string) error)
+	// userwebauthnDescUserVerified is the schema descriptor for user_verified field.
+	userwebauthnDescUserVerified := userwebauthnFields[9].Descriptor()
+	// userwebauthn.DefaultUserVerified holds the default value on creation for the user_verified field.
+	userwebauthn.DefaultUserVerified = userwebauthnDescUserVerified.Default.(bool)
+	// userwebauthnDescBackupEligible is the schema descriptor for backup_eligible field.
+	userwebauthnDescBackupEligible := userwebauthnFields[10].Descriptor()
+	// userwebauthn.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	userwebauthn.DefaultBackupEligible = userwebauthnDescBackupEligible.Default.(bool)
+	// userwebauthnDescBackupState is the schema descriptor for backup_state field.
+	userwebauthnDescBackupState := userwebauthnFields[11].Descriptor()
+	// userwebauthn.DefaultBackupState holds the default value on creation for the backup_state field.
+	userwebauthn.DefaultBackupState = userwebauthnDescBackupState.Default.(bool)
+	// userwebauthnDescFriendlyName is the schema descriptor for friendly_name field.
+	userwebauthnDescFriendlyName := userwebauthnFields[12].Descriptor()
+	// userwebauthn.FriendlyNameValidator is a validator for the "friendly_name" field. It is called by the builders before save.
+	userwebauthn.FriendlyNameValidator = userwebauthnDescFriendlyName.Validators[0].(func(string) error)
+	// userwebauthnDescID is the schema descriptor for id field.
+	userwebauthnDescID := userwebauthnMixinFields0[0].Descriptor()
+	// userwebauthn.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userwebauthn.IDValidator = userwebauthnDescID.Validators[0].(func(uint32) error)
 }
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+)
+
+// 定时任务表 / Scheduled job table
+type ScheduledJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 任务名称 / Job name
+	Name string `json:"name,omitempty"`
+	// 是否暂停定时执行 / Whether scheduled runs are paused
+	Paused bool `json:"paused,omitempty"`
+	// 最后执行时间 / Last run time
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// 最后执行状态 / Last run status (running, success, failed)
+	LastStatus string `json:"last_status,omitempty"`
+	// 最后触发方式 / Last trigger (schedule, manual)
+	LastTrigger string `json:"last_trigger,omitempty"`
+	// 最后执行耗时(毫秒) / Last run duration (milliseconds)
+	LastDuration int64 `json:"last_duration,omitempty"`
+	// 最后执行结果或错误信息 / Last run result or error message
+	LastMessage  string `json:"last_message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledjob.FieldPaused:
+			values[i] = new(sql.NullBool)
+		case scheduledjob.FieldID, scheduledjob.FieldLastDuration:
+			values[i] = new(sql.NullInt64)
+		case scheduledjob.FieldName, scheduledjob.FieldLastStatus, scheduledjob.FieldLastTrigger, scheduledjob.FieldLastMessage:
+			values[i] = new(sql.NullString)
+		case scheduledjob.FieldCreatedAt, scheduledjob.FieldUpdatedAt, scheduledjob.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledJob fields.
+func (_m *ScheduledJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case scheduledjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case scheduledjob.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case scheduledjob.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				_m.Paused = value.Bool
+			}
+		case scheduledjob.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case scheduledjob.FieldLastStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_status", values[i])
+			} else if value.Valid {
+				_m.LastStatus = value.String
+			}
+		case scheduledjob.FieldLastTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_trigger", values[i])
+			} else if value.Valid {
+				_m.LastTrigger = value.String
+			}
+		case scheduledjob.FieldLastDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_duration", values[i])
+			} else if value.Valid {
+				_m.LastDuration = value.Int64
+			}
+		case scheduledjob.FieldLastMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message", values[i])
+			} else if value.Valid {
+				_m.LastMessage = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ScheduledJob.
+// Note that you need to call ScheduledJob.Unwrap() before calling this method if this ScheduledJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledJob) Update() *ScheduledJobUpdateOne {
+	return NewScheduledJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledJob) Unwrap() *ScheduledJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paused))
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_status=")
+	builder.WriteString(_m.LastStatus)
+	builder.WriteString(", ")
+	builder.WriteString("last_trigger=")
+	builder.WriteString(_m.LastTrigger)
+	builder.WriteString(", ")
+	builder.WriteString("last_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastDuration))
+	builder.WriteString(", ")
+	builder.WriteString("last_message=")
+	builder.WriteString(_m.LastMessage)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledJobs is a parsable slice of ScheduledJob.
+type ScheduledJobs []*ScheduledJob
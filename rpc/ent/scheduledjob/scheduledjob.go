@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the scheduledjob type in the database.
+	Label = "scheduled_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastStatus holds the string denoting the last_status field in the database.
+	FieldLastStatus = "last_status"
+	// FieldLastTrigger holds the string denoting the last_trigger field in the database.
+	FieldLastTrigger = "last_trigger"
+	// FieldLastDuration holds the string denoting the last_duration field in the database.
+	FieldLastDuration = "last_duration"
+	// FieldLastMessage holds the string denoting the last_message field in the database.
+	FieldLastMessage = "last_message"
+	// Table holds the table name of the scheduledjob in the database.
+	Table = "sys_scheduled_jobs"
+)
+
+// Columns holds all SQL columns for scheduledjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldPaused,
+	FieldLastRunAt,
+	FieldLastStatus,
+	FieldLastTrigger,
+	FieldLastDuration,
+	FieldLastMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
+	// LastStatusValidator is a validator for the "last_status" field. It is called by the builders before save.
+	LastStatusValidator func(string) error
+	// LastTriggerValidator is a validator for the "last_trigger" field. It is called by the builders before save.
+	LastTriggerValidator func(string) error
+	// DefaultLastDuration holds the default value on creation for the "last_duration" field.
+	DefaultLastDuration int64
+	// LastMessageValidator is a validator for the "last_message" field. It is called by the builders before save.
+	LastMessageValidator func(string) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the ScheduledJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastStatus orders the results by the last_status field.
+func ByLastStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastStatus, opts...).ToFunc()
+}
+
+// ByLastTrigger orders the results by the last_trigger field.
+func ByLastTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTrigger, opts...).ToFunc()
+}
+
+// ByLastDuration orders the results by the last_duration field.
+func ByLastDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDuration, opts...).ToFunc()
+}
+
+// ByLastMessage orders the results by the last_message field.
+func ByLastMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastMessage, opts...).ToFunc()
+}
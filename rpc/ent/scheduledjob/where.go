@@ -0,0 +1,585 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldName, v))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldPaused, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastStatus applies equality check predicate on the "last_status" field. It's identical to LastStatusEQ.
+func LastStatus(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastTrigger applies equality check predicate on the "last_trigger" field. It's identical to LastTriggerEQ.
+func LastTrigger(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastTrigger, v))
+}
+
+// LastDuration applies equality check predicate on the "last_duration" field. It's identical to LastDurationEQ.
+func LastDuration(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastDuration, v))
+}
+
+// LastMessage applies equality check predicate on the "last_message" field. It's identical to LastMessageEQ.
+func LastMessage(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContainsFold(FieldName, v))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldPaused, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastStatusEQ applies the EQ predicate on the "last_status" field.
+func LastStatusEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastStatusNEQ applies the NEQ predicate on the "last_status" field.
+func LastStatusNEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldLastStatus, v))
+}
+
+// LastStatusIn applies the In predicate on the "last_status" field.
+func LastStatusIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldLastStatus, vs...))
+}
+
+// LastStatusNotIn applies the NotIn predicate on the "last_status" field.
+func LastStatusNotIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldLastStatus, vs...))
+}
+
+// LastStatusGT applies the GT predicate on the "last_status" field.
+func LastStatusGT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldLastStatus, v))
+}
+
+// LastStatusGTE applies the GTE predicate on the "last_status" field.
+func LastStatusGTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldLastStatus, v))
+}
+
+// LastStatusLT applies the LT predicate on the "last_status" field.
+func LastStatusLT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldLastStatus, v))
+}
+
+// LastStatusLTE applies the LTE predicate on the "last_status" field.
+func LastStatusLTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldLastStatus, v))
+}
+
+// LastStatusContains applies the Contains predicate on the "last_status" field.
+func LastStatusContains(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContains(FieldLastStatus, v))
+}
+
+// LastStatusHasPrefix applies the HasPrefix predicate on the "last_status" field.
+func LastStatusHasPrefix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasPrefix(FieldLastStatus, v))
+}
+
+// LastStatusHasSuffix applies the HasSuffix predicate on the "last_status" field.
+func LastStatusHasSuffix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasSuffix(FieldLastStatus, v))
+}
+
+// LastStatusIsNil applies the IsNil predicate on the "last_status" field.
+func LastStatusIsNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIsNull(FieldLastStatus))
+}
+
+// LastStatusNotNil applies the NotNil predicate on the "last_status" field.
+func LastStatusNotNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotNull(FieldLastStatus))
+}
+
+// LastStatusEqualFold applies the EqualFold predicate on the "last_status" field.
+func LastStatusEqualFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEqualFold(FieldLastStatus, v))
+}
+
+// LastStatusContainsFold applies the ContainsFold predicate on the "last_status" field.
+func LastStatusContainsFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContainsFold(FieldLastStatus, v))
+}
+
+// LastTriggerEQ applies the EQ predicate on the "last_trigger" field.
+func LastTriggerEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastTrigger, v))
+}
+
+// LastTriggerNEQ applies the NEQ predicate on the "last_trigger" field.
+func LastTriggerNEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldLastTrigger, v))
+}
+
+// LastTriggerIn applies the In predicate on the "last_trigger" field.
+func LastTriggerIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldLastTrigger, vs...))
+}
+
+// LastTriggerNotIn applies the NotIn predicate on the "last_trigger" field.
+func LastTriggerNotIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldLastTrigger, vs...))
+}
+
+// LastTriggerGT applies the GT predicate on the "last_trigger" field.
+func LastTriggerGT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldLastTrigger, v))
+}
+
+// LastTriggerGTE applies the GTE predicate on the "last_trigger" field.
+func LastTriggerGTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldLastTrigger, v))
+}
+
+// LastTriggerLT applies the LT predicate on the "last_trigger" field.
+func LastTriggerLT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldLastTrigger, v))
+}
+
+// LastTriggerLTE applies the LTE predicate on the "last_trigger" field.
+func LastTriggerLTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldLastTrigger, v))
+}
+
+// LastTriggerContains applies the Contains predicate on the "last_trigger" field.
+func LastTriggerContains(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContains(FieldLastTrigger, v))
+}
+
+// LastTriggerHasPrefix applies the HasPrefix predicate on the "last_trigger" field.
+func LastTriggerHasPrefix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasPrefix(FieldLastTrigger, v))
+}
+
+// LastTriggerHasSuffix applies the HasSuffix predicate on the "last_trigger" field.
+func LastTriggerHasSuffix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasSuffix(FieldLastTrigger, v))
+}
+
+// LastTriggerIsNil applies the IsNil predicate on the "last_trigger" field.
+func LastTriggerIsNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIsNull(FieldLastTrigger))
+}
+
+// LastTriggerNotNil applies the NotNil predicate on the "last_trigger" field.
+func LastTriggerNotNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotNull(FieldLastTrigger))
+}
+
+// LastTriggerEqualFold applies the EqualFold predicate on the "last_trigger" field.
+func LastTriggerEqualFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEqualFold(FieldLastTrigger, v))
+}
+
+// LastTriggerContainsFold applies the ContainsFold predicate on the "last_trigger" field.
+func LastTriggerContainsFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContainsFold(FieldLastTrigger, v))
+}
+
+// LastDurationEQ applies the EQ predicate on the "last_duration" field.
+func LastDurationEQ(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastDuration, v))
+}
+
+// LastDurationNEQ applies the NEQ predicate on the "last_duration" field.
+func LastDurationNEQ(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldLastDuration, v))
+}
+
+// LastDurationIn applies the In predicate on the "last_duration" field.
+func LastDurationIn(vs ...int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldLastDuration, vs...))
+}
+
+// LastDurationNotIn applies the NotIn predicate on the "last_duration" field.
+func LastDurationNotIn(vs ...int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldLastDuration, vs...))
+}
+
+// LastDurationGT applies the GT predicate on the "last_duration" field.
+func LastDurationGT(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldLastDuration, v))
+}
+
+// LastDurationGTE applies the GTE predicate on the "last_duration" field.
+func LastDurationGTE(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldLastDuration, v))
+}
+
+// LastDurationLT applies the LT predicate on the "last_duration" field.
+func LastDurationLT(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldLastDuration, v))
+}
+
+// LastDurationLTE applies the LTE predicate on the "last_duration" field.
+func LastDurationLTE(v int64) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldLastDuration, v))
+}
+
+// LastMessageEQ applies the EQ predicate on the "last_message" field.
+func LastMessageEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEQ(FieldLastMessage, v))
+}
+
+// LastMessageNEQ applies the NEQ predicate on the "last_message" field.
+func LastMessageNEQ(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNEQ(FieldLastMessage, v))
+}
+
+// LastMessageIn applies the In predicate on the "last_message" field.
+func LastMessageIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIn(FieldLastMessage, vs...))
+}
+
+// LastMessageNotIn applies the NotIn predicate on the "last_message" field.
+func LastMessageNotIn(vs ...string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotIn(FieldLastMessage, vs...))
+}
+
+// LastMessageGT applies the GT predicate on the "last_message" field.
+func LastMessageGT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGT(FieldLastMessage, v))
+}
+
+// LastMessageGTE applies the GTE predicate on the "last_message" field.
+func LastMessageGTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldGTE(FieldLastMessage, v))
+}
+
+// LastMessageLT applies the LT predicate on the "last_message" field.
+func LastMessageLT(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLT(FieldLastMessage, v))
+}
+
+// LastMessageLTE applies the LTE predicate on the "last_message" field.
+func LastMessageLTE(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldLTE(FieldLastMessage, v))
+}
+
+// LastMessageContains applies the Contains predicate on the "last_message" field.
+func LastMessageContains(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContains(FieldLastMessage, v))
+}
+
+// LastMessageHasPrefix applies the HasPrefix predicate on the "last_message" field.
+func LastMessageHasPrefix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasPrefix(FieldLastMessage, v))
+}
+
+// LastMessageHasSuffix applies the HasSuffix predicate on the "last_message" field.
+func LastMessageHasSuffix(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldHasSuffix(FieldLastMessage, v))
+}
+
+// LastMessageIsNil applies the IsNil predicate on the "last_message" field.
+func LastMessageIsNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldIsNull(FieldLastMessage))
+}
+
+// LastMessageNotNil applies the NotNil predicate on the "last_message" field.
+func LastMessageNotNil() predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldNotNull(FieldLastMessage))
+}
+
+// LastMessageEqualFold applies the EqualFold predicate on the "last_message" field.
+func LastMessageEqualFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldEqualFold(FieldLastMessage, v))
+}
+
+// LastMessageContainsFold applies the ContainsFold predicate on the "last_message" field.
+func LastMessageContainsFold(v string) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.FieldContainsFold(FieldLastMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledJob) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledJob) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledJob) predicate.ScheduledJob {
+	return predicate.ScheduledJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+)
+
+// ScheduledJobCreate is the builder for creating a ScheduledJob entity.
+type ScheduledJobCreate struct {
+	config
+	mutation *ScheduledJobMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledJobCreate) SetCreatedAt(v time.Time) *ScheduledJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableCreatedAt(v *time.Time) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledJobCreate) SetUpdatedAt(v time.Time) *ScheduledJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScheduledJobCreate) SetName(v string) *ScheduledJobCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPaused sets the "paused" field.
+func (_c *ScheduledJobCreate) SetPaused(v bool) *ScheduledJobCreate {
+	_c.mutation.SetPaused(v)
+	return _c
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillablePaused(v *bool) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetPaused(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScheduledJobCreate) SetLastRunAt(v time.Time) *ScheduledJobCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableLastRunAt(v *time.Time) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_c *ScheduledJobCreate) SetLastStatus(v string) *ScheduledJobCreate {
+	_c.mutation.SetLastStatus(v)
+	return _c
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableLastStatus(v *string) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetLastStatus(*v)
+	}
+	return _c
+}
+
+// SetLastTrigger sets the "last_trigger" field.
+func (_c *ScheduledJobCreate) SetLastTrigger(v string) *ScheduledJobCreate {
+	_c.mutation.SetLastTrigger(v)
+	return _c
+}
+
+// SetNillableLastTrigger sets the "last_trigger" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableLastTrigger(v *string) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetLastTrigger(*v)
+	}
+	return _c
+}
+
+// SetLastDuration sets the "last_duration" field.
+func (_c *ScheduledJobCreate) SetLastDuration(v int64) *ScheduledJobCreate {
+	_c.mutation.SetLastDuration(v)
+	return _c
+}
+
+// SetNillableLastDuration sets the "last_duration" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableLastDuration(v *int64) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetLastDuration(*v)
+	}
+	return _c
+}
+
+// SetLastMessage sets the "last_message" field.
+func (_c *ScheduledJobCreate) SetLastMessage(v string) *ScheduledJobCreate {
+	_c.mutation.SetLastMessage(v)
+	return _c
+}
+
+// SetNillableLastMessage sets the "last_message" field if the given value is not nil.
+func (_c *ScheduledJobCreate) SetNillableLastMessage(v *string) *ScheduledJobCreate {
+	if v != nil {
+		_c.SetLastMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledJobCreate) SetID(v uint32) *ScheduledJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ScheduledJobMutation object of the builder.
+func (_c *ScheduledJobCreate) Mutation() *ScheduledJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledJob in the database.
+func (_c *ScheduledJobCreate) Save(ctx context.Context) (*ScheduledJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledJobCreate) SaveX(ctx context.Context) *ScheduledJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledJobCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		v := scheduledjob.DefaultPaused
+		_c.mutation.SetPaused(v)
+	}
+	if _, ok := _c.mutation.LastDuration(); !ok {
+		v := scheduledjob.DefaultLastDuration
+		_c.mutation.SetLastDuration(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledJob.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScheduledJob.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := scheduledjob.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "ScheduledJob.paused"`)}
+	}
+	if v, ok := _c.mutation.LastStatus(); ok {
+		if err := scheduledjob.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastTrigger(); ok {
+		if err := scheduledjob.LastTriggerValidator(v); err != nil {
+			return &ValidationError{Name: "last_trigger", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_trigger": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastDuration(); !ok {
+		return &ValidationError{Name: "last_duration", err: errors.New(`ent: missing required field "ScheduledJob.last_duration"`)}
+	}
+	if v, ok := _c.mutation.LastMessage(); ok {
+		if err := scheduledjob.LastMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_message", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_message": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := scheduledjob.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ScheduledJobCreate) sqlSave(ctx context.Context) (*ScheduledJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledJobCreate) createSpec() (*ScheduledJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledjob.Table, sqlgraph.NewFieldSpec(scheduledjob.FieldID, field.TypeUint32))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scheduledjob.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Paused(); ok {
+		_spec.SetField(scheduledjob.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledjob.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledjob.FieldLastStatus, field.TypeString, value)
+		_node.LastStatus = value
+	}
+	if value, ok := _c.mutation.LastTrigger(); ok {
+		_spec.SetField(scheduledjob.FieldLastTrigger, field.TypeString, value)
+		_node.LastTrigger = value
+	}
+	if value, ok := _c.mutation.LastDuration(); ok {
+		_spec.SetField(scheduledjob.FieldLastDuration, field.TypeInt64, value)
+		_node.LastDuration = value
+	}
+	if value, ok := _c.mutation.LastMessage(); ok {
+		_spec.SetField(scheduledjob.FieldLastMessage, field.TypeString, value)
+		_node.LastMessage = value
+	}
+	return _node, _spec
+}
+
+// ScheduledJobCreateBulk is the builder for creating many ScheduledJob entities in bulk.
+type ScheduledJobCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledJobCreate
+}
+
+// Save creates the ScheduledJob entities in the database.
+func (_c *ScheduledJobCreateBulk) Save(ctx context.Context) ([]*ScheduledJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledJobCreateBulk) SaveX(ctx context.Context) []*ScheduledJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
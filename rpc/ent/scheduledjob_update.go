@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+)
+
+// ScheduledJobUpdate is the builder for updating ScheduledJob entities.
+type ScheduledJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledJobMutation
+}
+
+// Where appends a list predicates to the ScheduledJobUpdate builder.
+func (_u *ScheduledJobUpdate) Where(ps ...predicate.ScheduledJob) *ScheduledJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledJobUpdate) SetUpdatedAt(v time.Time) *ScheduledJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPaused sets the "paused" field.
+func (_u *ScheduledJobUpdate) SetPaused(v bool) *ScheduledJobUpdate {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillablePaused(v *bool) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledJobUpdate) SetLastRunAt(v time.Time) *ScheduledJobUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillableLastRunAt(v *time.Time) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledJobUpdate) ClearLastRunAt() *ScheduledJobUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledJobUpdate) SetLastStatus(v string) *ScheduledJobUpdate {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillableLastStatus(v *string) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *ScheduledJobUpdate) ClearLastStatus() *ScheduledJobUpdate {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastTrigger sets the "last_trigger" field.
+func (_u *ScheduledJobUpdate) SetLastTrigger(v string) *ScheduledJobUpdate {
+	_u.mutation.SetLastTrigger(v)
+	return _u
+}
+
+// SetNillableLastTrigger sets the "last_trigger" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillableLastTrigger(v *string) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetLastTrigger(*v)
+	}
+	return _u
+}
+
+// ClearLastTrigger clears the value of the "last_trigger" field.
+func (_u *ScheduledJobUpdate) ClearLastTrigger() *ScheduledJobUpdate {
+	_u.mutation.ClearLastTrigger()
+	return _u
+}
+
+// SetLastDuration sets the "last_duration" field.
+func (_u *ScheduledJobUpdate) SetLastDuration(v int64) *ScheduledJobUpdate {
+	_u.mutation.ResetLastDuration()
+	_u.mutation.SetLastDuration(v)
+	return _u
+}
+
+// SetNillableLastDuration sets the "last_duration" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillableLastDuration(v *int64) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetLastDuration(*v)
+	}
+	return _u
+}
+
+// AddLastDuration adds value to the "last_duration" field.
+func (_u *ScheduledJobUpdate) AddLastDuration(v int64) *ScheduledJobUpdate {
+	_u.mutation.AddLastDuration(v)
+	return _u
+}
+
+// SetLastMessage sets the "last_message" field.
+func (_u *ScheduledJobUpdate) SetLastMessage(v string) *ScheduledJobUpdate {
+	_u.mutation.SetLastMessage(v)
+	return _u
+}
+
+// SetNillableLastMessage sets the "last_message" field if the given value is not nil.
+func (_u *ScheduledJobUpdate) SetNillableLastMessage(v *string) *ScheduledJobUpdate {
+	if v != nil {
+		_u.SetLastMessage(*v)
+	}
+	return _u
+}
+
+// ClearLastMessage clears the value of the "last_message" field.
+func (_u *ScheduledJobUpdate) ClearLastMessage() *ScheduledJobUpdate {
+	_u.mutation.ClearLastMessage()
+	return _u
+}
+
+// Mutation returns the ScheduledJobMutation object of the builder.
+func (_u *ScheduledJobUpdate) Mutation() *ScheduledJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledJobUpdate) check() error {
+	if v, ok := _u.mutation.LastStatus(); ok {
+		if err := scheduledjob.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastTrigger(); ok {
+		if err := scheduledjob.LastTriggerValidator(v); err != nil {
+			return &ValidationError{Name: "last_trigger", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastMessage(); ok {
+		if err := scheduledjob.LastMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_message", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ScheduledJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledjob.Table, scheduledjob.Columns, sqlgraph.NewFieldSpec(scheduledjob.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(scheduledjob.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledjob.FieldLastStatus, field.TypeString, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(scheduledjob.FieldLastStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastTrigger(); ok {
+		_spec.SetField(scheduledjob.FieldLastTrigger, field.TypeString, value)
+	}
+	if _u.mutation.LastTriggerCleared() {
+		_spec.ClearField(scheduledjob.FieldLastTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastDuration(); ok {
+		_spec.SetField(scheduledjob.FieldLastDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastDuration(); ok {
+		_spec.AddField(scheduledjob.FieldLastDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastMessage(); ok {
+		_spec.SetField(scheduledjob.FieldLastMessage, field.TypeString, value)
+	}
+	if _u.mutation.LastMessageCleared() {
+		_spec.ClearField(scheduledjob.FieldLastMessage, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledJobUpdateOne is the builder for updating a single ScheduledJob entity.
+type ScheduledJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledJobMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledJobUpdateOne) SetUpdatedAt(v time.Time) *ScheduledJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPaused sets the "paused" field.
+func (_u *ScheduledJobUpdateOne) SetPaused(v bool) *ScheduledJobUpdateOne {
+	_u.mutation.SetPaused(v)
+	return _u
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillablePaused(v *bool) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetPaused(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledJobUpdateOne) SetLastRunAt(v time.Time) *ScheduledJobUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillableLastRunAt(v *time.Time) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledJobUpdateOne) ClearLastRunAt() *ScheduledJobUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledJobUpdateOne) SetLastStatus(v string) *ScheduledJobUpdateOne {
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillableLastStatus(v *string) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (_u *ScheduledJobUpdateOne) ClearLastStatus() *ScheduledJobUpdateOne {
+	_u.mutation.ClearLastStatus()
+	return _u
+}
+
+// SetLastTrigger sets the "last_trigger" field.
+func (_u *ScheduledJobUpdateOne) SetLastTrigger(v string) *ScheduledJobUpdateOne {
+	_u.mutation.SetLastTrigger(v)
+	return _u
+}
+
+// SetNillableLastTrigger sets the "last_trigger" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillableLastTrigger(v *string) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetLastTrigger(*v)
+	}
+	return _u
+}
+
+// ClearLastTrigger clears the value of the "last_trigger" field.
+func (_u *ScheduledJobUpdateOne) ClearLastTrigger() *ScheduledJobUpdateOne {
+	_u.mutation.ClearLastTrigger()
+	return _u
+}
+
+// SetLastDuration sets the "last_duration" field.
+func (_u *ScheduledJobUpdateOne) SetLastDuration(v int64) *ScheduledJobUpdateOne {
+	_u.mutation.ResetLastDuration()
+	_u.mutation.SetLastDuration(v)
+	return _u
+}
+
+// SetNillableLastDuration sets the "last_duration" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillableLastDuration(v *int64) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetLastDuration(*v)
+	}
+	return _u
+}
+
+// AddLastDuration adds value to the "last_duration" field.
+func (_u *ScheduledJobUpdateOne) AddLastDuration(v int64) *ScheduledJobUpdateOne {
+	_u.mutation.AddLastDuration(v)
+	return _u
+}
+
+// SetLastMessage sets the "last_message" field.
+func (_u *ScheduledJobUpdateOne) SetLastMessage(v string) *ScheduledJobUpdateOne {
+	_u.mutation.SetLastMessage(v)
+	return _u
+}
+
+// SetNillableLastMessage sets the "last_message" field if the given value is not nil.
+func (_u *ScheduledJobUpdateOne) SetNillableLastMessage(v *string) *ScheduledJobUpdateOne {
+	if v != nil {
+		_u.SetLastMessage(*v)
+	}
+	return _u
+}
+
+// ClearLastMessage clears the value of the "last_message" field.
+func (_u *ScheduledJobUpdateOne) ClearLastMessage() *ScheduledJobUpdateOne {
+	_u.mutation.ClearLastMessage()
+	return _u
+}
+
+// Mutation returns the ScheduledJobMutation object of the builder.
+func (_u *ScheduledJobUpdateOne) Mutation() *ScheduledJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScheduledJobUpdate builder.
+func (_u *ScheduledJobUpdateOne) Where(ps ...predicate.ScheduledJob) *ScheduledJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledJobUpdateOne) Select(field string, fields ...string) *ScheduledJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledJob entity.
+func (_u *ScheduledJobUpdateOne) Save(ctx context.Context) (*ScheduledJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledJobUpdateOne) SaveX(ctx context.Context) *ScheduledJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledJobUpdateOne) check() error {
+	if v, ok := _u.mutation.LastStatus(); ok {
+		if err := scheduledjob.LastStatusValidator(v); err != nil {
+			return &ValidationError{Name: "last_status", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastTrigger(); ok {
+		if err := scheduledjob.LastTriggerValidator(v); err != nil {
+			return &ValidationError{Name: "last_trigger", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastMessage(); ok {
+		if err := scheduledjob.LastMessageValidator(v); err != nil {
+			return &ValidationError{Name: "last_message", err: fmt.Errorf(`ent: validator failed for field "ScheduledJob.last_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ScheduledJobUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledjob.Table, scheduledjob.Columns, sqlgraph.NewFieldSpec(scheduledjob.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledjob.FieldID)
+		for _, f := range fields {
+			if !scheduledjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Paused(); ok {
+		_spec.SetField(scheduledjob.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledjob.FieldLastStatus, field.TypeString, value)
+	}
+	if _u.mutation.LastStatusCleared() {
+		_spec.ClearField(scheduledjob.FieldLastStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastTrigger(); ok {
+		_spec.SetField(scheduledjob.FieldLastTrigger, field.TypeString, value)
+	}
+	if _u.mutation.LastTriggerCleared() {
+		_spec.ClearField(scheduledjob.FieldLastTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastDuration(); ok {
+		_spec.SetField(scheduledjob.FieldLastDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastDuration(); ok {
+		_spec.AddField(scheduledjob.FieldLastDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastMessage(); ok {
+		_spec.SetField(scheduledjob.FieldLastMessage, field.TypeString, value)
+	}
+	if _u.mutation.LastMessageCleared() {
+		_spec.ClearField(scheduledjob.FieldLastMessage, field.TypeString)
+	}
+	_node = &ScheduledJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
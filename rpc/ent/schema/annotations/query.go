@@ -0,0 +1,15 @@
+package annotations
+
+// QueryFields 列表通用筛选及排序的字段白名单，字段名为数据库列名。
+// 生成的 ApplyOptions 只接受白名单内的字段
+type QueryFields struct {
+	// Filters 允许筛选的字段
+	Filters []string
+	// Sorts 允许排序的字段
+	Sorts []string
+}
+
+// Name 实现 schema.Annotation 接口
+func (QueryFields) Name() string {
+	return "QueryFields"
+}
@@ -4,9 +4,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 type API struct {
@@ -46,6 +48,14 @@ func (API) Fields() []ent.Field {
 	}
 }
 
+// Edges of the API.
+func (API) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("menus", Menu.Type).Ref("apis"),
+		edge.From("roles", Role.Type).Ref("apis"),
+	}
+}
+
 func (API) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixins.ID32Mixin{},
@@ -65,5 +75,9 @@ func (API) Annotations() []schema.Annotation {
 		entsql.WithComments(true),
 		entsql.Annotation{Table: "sys_apis"},
 		schema.Comment("API表 / API table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "name", "method", "path", "is_required", "service_name", "api_group"},
+			Sorts:   []string{"id", "created_at", "name", "method", "path", "service_name", "api_group"},
+		},
 	}
 }
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Mixin of the ApiKey.
+func (ApiKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+		mixins.StateMixin{},
+	}
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("所属用户ID / Owner user ID"),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Comment("名称 / Name"),
+		field.String("key_prefix").
+			MaxLen(20).
+			NotEmpty().
+			Comment("密钥前缀，用于展示识别 / Key prefix for display"),
+		field.String("key_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive().
+			Comment("密钥哈希(SHA-256) / Key hash (SHA-256)"),
+		field.Strings("roles").
+			Optional().
+			Comment("授权的角色编码，为所属用户角色的子集 / Granted role codes, a subset of the owner's roles"),
+		field.Strings("allowed_ips").
+			Optional().
+			Comment("允许访问的IP或CIDR，为空不限制 / Allowed IPs or CIDRs, empty means unrestricted"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空永不过期 / Expiration time, never expires if empty"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最后使用时间 / Last used time"),
+		field.String("last_used_ip").
+			MaxLen(45).
+			Optional().
+			Comment("最后使用IP / Last used IP"),
+		field.String("description").
+			MaxLen(255).
+			Optional().
+			Comment("描述 / Description"),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_keys").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ApiKey.
+func (ApiKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：密钥哈希
+		index.Fields("key_hash").
+			Unique().
+			StorageKey("sys_api_keys_key_hash_unique"),
+		// 普通索引：用户ID
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the ApiKey.
+func (ApiKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_api_keys"},
+		entsql.WithComments(true),
+		schema.Comment("API密钥表 / API key table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "user_id", "name", "key_prefix", "expires_at", "last_used_at"},
+			Sorts:   []string{"id", "created_at", "name", "expires_at", "last_used_at"},
+		},
+	}
+}
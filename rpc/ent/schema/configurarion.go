@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 type Configuration struct {
@@ -40,5 +41,9 @@ func (Configuration) Annotations() []schema.Annotation {
 		entsql.WithComments(true),
 		entsql.Annotation{Table: "sys_configuration"},
 		schema.Comment("配置表 / Configuration table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "state", "name", "group", "key"},
+			Sorts:   []string{"id", "name", "group", "key"},
+		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 	mixins2 "github.com/wenpiner/last-admin-core/rpc/ent/schema/mixins"
 )
 
@@ -35,6 +36,9 @@ func (Department) Fields() []ent.Field {
 			MaxLen(100).
 			NotEmpty().
 			Comment("部门名称 / Department name"),
+		field.JSON("dept_name_i18n", map[string]string{}).
+			Optional().
+			Comment("部门名称多语言，键为语言标签 / Department name translations keyed by locale"),
 		field.String("dept_code").
 			MaxLen(50).
 			NotEmpty().
@@ -51,6 +55,9 @@ func (Department) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("部门描述 / Department description"),
+		field.Bool("inherit_roles").
+			Default(false).
+			Comment("下级部门是否继承本部门角色 / Whether sub-departments inherit roles of this department"),
 	}
 }
 
@@ -62,6 +69,7 @@ func (Department) Edges() []ent.Edge {
 			Field("parent_id").
 			Unique(),
 		edge.From("users", User.Type).Ref("department"),
+		edge.From("roles", Role.Type).Ref("departments"),
 		edge.To("leader", User.Type).
 			Field("leader_user_id").
 			Unique(),
@@ -86,5 +94,9 @@ func (Department) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_departments"},
 		entsql.WithComments(true),
 		schema.Comment("部门表 / Department table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "sort", "dept_name", "dept_code", "parent_id", "leader_user_id"},
+			Sorts:   []string{"id", "created_at", "updated_at", "sort", "dept_name", "dept_code"},
+		},
 	}
 }
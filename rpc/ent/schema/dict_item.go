@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 	mixins2 "github.com/wenpiner/last-admin-core/rpc/ent/schema/mixins"
 )
 
@@ -33,6 +34,9 @@ func (DictItem) Fields() []ent.Field {
 			MaxLen(100).
 			NotEmpty().
 			Comment("字典项标签 / Dictionary item label"),
+		field.JSON("item_label_i18n", map[string]string{}).
+			Optional().
+			Comment("字典项标签多语言，键为语言标签 / Dictionary item label translations keyed by locale"),
 		field.String("item_value").
 			MaxLen(100).
 			NotEmpty().
@@ -87,5 +91,9 @@ func (DictItem) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_dict_items"},
 		entsql.WithComments(true),
 		schema.Comment("字典项表 / Dictionary item table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "item_label", "item_value", "sort_order", "dict_type_id"},
+			Sorts:   []string{"id", "created_at", "updated_at", "sort_order", "item_label", "item_value"},
+		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 	mixins2 "github.com/wenpiner/last-admin-core/rpc/ent/schema/mixins"
 )
 
@@ -41,6 +42,9 @@ func (DictType) Fields() []ent.Field {
 			NotEmpty().
 			Default("").
 			Comment("字典类型描述 / Dictionary type description"),
+		field.Bool("is_public").
+			Default(false).
+			Comment("是否公开，公开字典允许匿名读取 / Whether anonymous users can read the dictionary"),
 	}
 }
 
@@ -67,5 +71,9 @@ func (DictType) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_dict_types"},
 		entsql.WithComments(true),
 		schema.Comment("字典类型表 / Dictionary type table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "dict_type_code", "dict_type_name", "is_public"},
+			Sorts:   []string{"id", "created_at", "updated_at", "dict_type_code", "dict_type_name"},
+		},
 	}
 }
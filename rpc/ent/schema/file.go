@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// File holds the schema definition for the File entity.
+type File struct {
+	ent.Schema
+}
+
+// Mixin of the File.
+func (File) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the File.
+func (File) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Comment("文件ID / File ID"),
+		field.UUID("owner_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("上传用户ID / Owner user ID"),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty().
+			Comment("原始文件名 / Original file name"),
+		field.String("category").
+			MaxLen(50).
+			Default("attachment").
+			Comment("文件分类 / File category (attachment, avatar)"),
+		field.Int64("size").
+			Default(0).
+			Comment("文件大小(字节) / File size (bytes)"),
+		field.String("mime_type").
+			MaxLen(127).
+			Comment("文件类型 / MIME type"),
+		field.String("checksum").
+			MaxLen(64).
+			Comment("文件 SHA-256 / File SHA-256"),
+		field.String("storage_key").
+			MaxLen(255).
+			Unique().
+			Comment("存储键 / Storage key"),
+	}
+}
+
+// Edges of the File.
+func (File) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("files").
+			Field("owner_id").
+			Unique(),
+	}
+}
+
+// Indexes of the File.
+func (File) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+		index.Fields("category"),
+	}
+}
+
+// Annotations of the File.
+func (File) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_files"},
+		entsql.WithComments(true),
+		schema.Comment("文件表 / File table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "owner_id", "name", "category", "size", "mime_type"},
+			Sorts:   []string{"id", "created_at", "name", "size"},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 type Menu struct {
@@ -29,6 +30,7 @@ func (Menu) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("menu_code").MaxLen(100).NotEmpty().Comment("菜单编码 / Menu code"),
 		field.String("menu_name").MaxLen(100).NotEmpty().Comment("菜单名称 / Menu name"),
+		field.JSON("menu_name_i18n", map[string]string{}).Optional().Comment("菜单名称多语言，键为语言标签 / Menu name translations keyed by locale"),
 		field.Uint32("parent_id").Optional().Nillable().Comment("父菜单ID / Parent menu ID"),
 		field.String("menu_path").MaxLen(255).Optional().Nillable().Comment("菜单路径 / Menu path"),
 		field.String("component").MaxLen(100).Optional().Nillable().Comment("前端组件 / Frontend component"),
@@ -57,6 +59,8 @@ func (Menu) Edges() []ent.Edge {
 			Field("parent_id").
 			Unique(),
 		edge.From("roles", Role.Type).Ref("menus"),
+		// 菜单或按钮依赖的API，分配菜单时自动授予
+		edge.To("apis", API.Type),
 	}
 }
 
@@ -75,5 +79,9 @@ func (Menu) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_menus"},
 		entsql.WithComments(true),
 		schema.Comment("菜单资源表 / Menu resource table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "sort", "menu_code", "menu_name", "parent_id", "menu_path", "menu_level", "menu_type", "service_name", "permission", "is_hidden"},
+			Sorts:   []string{"id", "created_at", "sort", "menu_level", "menu_code", "menu_name"},
+		},
 	}
 }
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// Notification holds the schema definition for the Notification entity.
+type Notification struct {
+	ent.Schema
+}
+
+// Mixin of the Notification.
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+		mixins.StateMixin{},
+	}
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			MaxLen(200).
+			NotEmpty().
+			Comment("标题 / Title"),
+		field.Text("content").
+			Optional().
+			Comment("内容 / Content"),
+		field.String("category").
+			MaxLen(20).
+			Default("announcement").
+			Comment("分类 / Category (announcement, notice, security)"),
+		field.String("level").
+			MaxLen(20).
+			Default("info").
+			Comment("级别 / Level (info, warning, critical)"),
+		field.Bool("target_all").
+			Default(false).
+			Comment("是否发送给所有用户 / Whether targeted at all users"),
+		field.Time("publish_at").
+			Optional().
+			Nillable().
+			Comment("发布时间，为空立即发布 / Publish time, published immediately if empty"),
+		field.Time("expire_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空永不过期 / Expiration time, never expires if empty"),
+		field.UUID("sender_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("发送人ID，系统通知为空 / Sender ID, empty for system notifications"),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 接收对象，按用户、角色或部门指定
+		edge.To("users", User.Type),
+		edge.To("roles", Role.Type),
+		edge.To("departments", Department.Type),
+		edge.To("recipients", NotificationRecipient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		// 普通索引：发布时间
+		index.Fields("publish_at"),
+		// 普通索引：分类
+		index.Fields("category"),
+	}
+}
+
+// Annotations of the Notification.
+func (Notification) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_notifications"},
+		entsql.WithComments(true),
+		schema.Comment("通知公告表 / Notification table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "title", "category", "level", "target_all", "publish_at", "expire_at", "sender_id"},
+			Sorts:   []string{"id", "created_at", "publish_at", "expire_at", "level", "title"},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+)
+
+// NotificationRecipient holds the schema definition for the NotificationRecipient entity.
+type NotificationRecipient struct {
+	ent.Schema
+}
+
+// Mixin of the NotificationRecipient.
+func (NotificationRecipient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the NotificationRecipient.
+func (NotificationRecipient) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("notification_id").
+			Comment("通知ID / Notification ID"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("用户ID / User ID"),
+		field.String("status").
+			MaxLen(20).
+			Default("unread").
+			Comment("阅读状态 / Status (unread, read, archived)"),
+		field.Time("read_at").
+			Optional().
+			Nillable().
+			Comment("阅读时间 / Read time"),
+	}
+}
+
+// Edges of the NotificationRecipient.
+func (NotificationRecipient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("notification", Notification.Type).
+			Ref("recipients").
+			Field("notification_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("notification_recipients").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the NotificationRecipient.
+func (NotificationRecipient) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：每个用户对同一通知只有一条状态记录
+		index.Fields("notification_id", "user_id").Unique(),
+		// 普通索引：用户ID + 状态
+		index.Fields("user_id", "status"),
+	}
+}
+
+// Annotations of the NotificationRecipient.
+func (NotificationRecipient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_notification_recipients"},
+		entsql.WithComments(true),
+		schema.Comment("通知接收状态表，未读且未操作过的通知不产生记录 / Notification recipient status table"),
+	}
+}
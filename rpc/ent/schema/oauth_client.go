@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// OauthClient holds the schema definition for the OauthClient entity.
+type OauthClient struct {
+	ent.Schema
+}
+
+// Mixin of the OauthClient.
+func (OauthClient) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+		mixins.StateMixin{},
+	}
+}
+
+// Fields of the OauthClient.
+func (OauthClient) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_id").
+			MaxLen(64).
+			NotEmpty().
+			Immutable().
+			Comment("客户端ID / Client ID"),
+		field.String("client_secret_hash").
+			MaxLen(255).
+			Optional().
+			Sensitive().
+			Comment("客户端密钥哈希，公共客户端为空 / Client secret hash, empty for public clients"),
+		field.String("name").
+			MaxLen(100).
+			NotEmpty().
+			Comment("应用名称 / Application name"),
+		field.Strings("redirect_uris").
+			Optional().
+			Comment("回调地址 / Redirect URIs"),
+		field.Strings("grant_types").
+			Optional().
+			Comment("授权类型 / Grant types"),
+		field.Strings("scopes").
+			Optional().
+			Comment("允许的权限范围 / Allowed scopes"),
+		field.Bool("public").
+			Default(false).
+			Comment("是否公共客户端，公共客户端必须使用PKCE / Public client, PKCE required"),
+		field.Bool("skip_consent").
+			Default(false).
+			Comment("是否跳过授权确认，仅用于受信任的内部应用 / Skip consent for trusted internal apps"),
+		field.String("logo").
+			MaxLen(500).
+			Optional().
+			Comment("应用图标 / Application logo"),
+		field.String("description").
+			MaxLen(255).
+			Optional().
+			Comment("描述 / Description"),
+	}
+}
+
+// Indexes of the OauthClient.
+func (OauthClient) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：客户端ID
+		index.Fields("client_id").
+			Unique().
+			StorageKey("sys_oauth_clients_client_id_unique"),
+	}
+}
+
+// Annotations of the OauthClient.
+func (OauthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_oauth_clients"},
+		entsql.WithComments(true),
+		schema.Comment("OAuth客户端应用表 / OAuth client application table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "client_id", "name", "public", "skip_consent"},
+			Sorts:   []string{"id", "created_at", "name", "client_id"},
+		},
+	}
+}
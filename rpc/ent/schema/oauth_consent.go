@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+)
+
+// OauthConsent holds the schema definition for the OauthConsent entity.
+type OauthConsent struct {
+	ent.Schema
+}
+
+// Mixin of the OauthConsent.
+func (OauthConsent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the OauthConsent.
+func (OauthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("用户ID / User ID"),
+		field.String("client_id").
+			MaxLen(64).
+			NotEmpty().
+			Comment("客户端ID / Client ID"),
+		field.Strings("scopes").
+			Optional().
+			Comment("已授权的权限范围 / Granted scopes"),
+	}
+}
+
+// Edges of the OauthConsent.
+func (OauthConsent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_consents").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OauthConsent.
+func (OauthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：用户ID + 客户端ID
+		index.Fields("user_id", "client_id").
+			Unique().
+			StorageKey("sys_oauth_consents_user_client_unique"),
+	}
+}
+
+// Annotations of the OauthConsent.
+func (OauthConsent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_oauth_consents"},
+		entsql.WithComments(true),
+		schema.Comment("OAuth授权记录表 / OAuth consent table"),
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 // OauthProvider holds the schema definition for the OauthProvider entity.
@@ -99,5 +100,9 @@ func (OauthProvider) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_oauth_providers"},
 		entsql.WithComments(true),
 		schema.Comment("OAuth提供商表 / OAuth provider table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "provider_name", "provider_code"},
+			Sorts:   []string{"id", "created_at", "provider_name", "provider_code"},
+		},
 	}
 }
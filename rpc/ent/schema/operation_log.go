@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 // OperationLog holds the schema definition for the OperationLog entity.
@@ -129,5 +130,9 @@ func (OperationLog) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_operation_logs"},
 		entsql.WithComments(true),
 		schema.Comment("操作记录表 / Operation log table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "user_id", "username", "operation_type", "module", "business_type", "method", "request_url", "ip_address", "is_success", "execution_time"},
+			Sorts:   []string{"id", "created_at", "username", "module", "execution_time"},
+		},
 	}
 }
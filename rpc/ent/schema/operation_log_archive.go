@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// OperationLogArchive holds the schema definition for the OperationLogArchive entity.
+type OperationLogArchive struct {
+	ent.Schema
+}
+
+// Mixin of the OperationLogArchive.
+func (OperationLogArchive) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the OperationLogArchive.
+func (OperationLogArchive) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("module").
+			MaxLen(50).
+			Comment("操作模块 / Operation module"),
+		field.Time("start_time").
+			Comment("归档记录的最早创建时间 / Earliest created time of archived rows"),
+		field.Time("end_time").
+			Comment("归档记录的最晚创建时间 / Latest created time of archived rows"),
+		field.Uint32("start_id").
+			Comment("归档记录的最小ID / Smallest archived row ID"),
+		field.Uint32("end_id").
+			Comment("归档记录的最大ID / Largest archived row ID"),
+		field.Int64("row_count").
+			Default(0).
+			Comment("归档记录数 / Number of archived rows"),
+		field.Int64("size").
+			Default(0).
+			Comment("归档文件大小(字节) / Archive file size (bytes)"),
+		field.String("checksum").
+			MaxLen(64).
+			Comment("归档文件 SHA-256 / Archive file SHA-256"),
+		field.String("storage_key").
+			MaxLen(255).
+			Unique().
+			Comment("存储键 / Storage key"),
+		field.Time("restored_at").
+			Optional().
+			Nillable().
+			Comment("恢复时间，下次执行保留策略时恢复的记录将再次移除 / Restored time, restored rows are removed again on the next retention run"),
+	}
+}
+
+// Indexes of the OperationLogArchive.
+func (OperationLogArchive) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("module", "start_time"),
+	}
+}
+
+// Annotations of the OperationLogArchive.
+func (OperationLogArchive) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_operation_log_archives"},
+		entsql.WithComments(true),
+		schema.Comment("操作记录归档表 / Operation log archive table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "module", "start_time", "end_time", "row_count", "size", "restored_at"},
+			Sorts:   []string{"id", "created_at", "module", "start_time", "end_time", "row_count", "size"},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// PermissionEntry 权限快照中的一条授权记录
+type PermissionEntry struct {
+	Kind      string `json:"kind"`
+	RoleValue string `json:"roleValue"`
+	Object    string `json:"object"`
+	Action    string `json:"action,omitempty"`
+	Label     string `json:"label,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+// PermissionSnapshot holds the schema definition for the PermissionSnapshot entity.
+type PermissionSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the PermissionSnapshot.
+func (PermissionSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the PermissionSnapshot.
+func (PermissionSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("remark").
+			MaxLen(255).
+			Optional().
+			Comment("备注，如审查周期 / Remark, e.g. review period"),
+		field.UUID("creator_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("创建人ID / Creator user ID"),
+		field.Int64("entry_count").
+			Default(0).
+			Comment("授权记录数 / Number of entries"),
+		field.JSON("entries", []PermissionEntry{}).
+			Optional().
+			Comment("全部角色的授权及持有用户 / Permissions and holders of all roles"),
+	}
+}
+
+// Indexes of the PermissionSnapshot.
+func (PermissionSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the PermissionSnapshot.
+func (PermissionSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_permission_snapshots"},
+		entsql.WithComments(true),
+		schema.Comment("权限快照表 / Permission snapshot table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "remark", "creator_id"},
+			Sorts:   []string{"id", "created_at"},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 	mixins2 "github.com/wenpiner/last-admin-core/rpc/ent/schema/mixins"
 )
 
@@ -34,6 +35,9 @@ func (Position) Fields() []ent.Field {
 			MaxLen(100).
 			NotEmpty().
 			Comment("职位名称 / Position name"),
+		field.JSON("position_name_i18n", map[string]string{}).
+			Optional().
+			Comment("职位名称多语言，键为语言标签 / Position name translations keyed by locale"),
 		field.String("position_code").
 			MaxLen(50).
 			NotEmpty().
@@ -49,6 +53,7 @@ func (Position) Fields() []ent.Field {
 func (Position) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("users", User.Type),
+		edge.From("roles", Role.Type).Ref("positions"),
 	}
 }
 
@@ -68,5 +73,9 @@ func (Position) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_positions"},
 		entsql.WithComments(true),
 		schema.Comment("职位表 / Position table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "sort", "position_name", "position_code"},
+			Sorts:   []string{"id", "created_at", "updated_at", "sort", "position_name", "position_code"},
+		},
 	}
 }
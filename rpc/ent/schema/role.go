@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 	mixins2 "github.com/wenpiner/last-admin-core/rpc/ent/schema/mixins"
 )
 
@@ -39,6 +40,10 @@ func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("menus", Menu.Type),
 		edge.To("users", User.Type),
+		edge.To("positions", Position.Type),
+		edge.To("departments", Department.Type),
+		// 手动分配的API，不包含由菜单派生的API
+		edge.To("apis", API.Type),
 	}
 }
 
@@ -67,5 +72,9 @@ func (Role) Annotations() []schema.Annotation {
 		entsql.Annotation{
 			Table: "sys_roles",
 		},
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "role_name", "role_code"},
+			Sorts:   []string{"id", "created_at", "updated_at", "role_name", "role_code"},
+		},
 	}
 }
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+)
+
+// ScheduledJob holds the schema definition for the ScheduledJob entity.
+type ScheduledJob struct {
+	ent.Schema
+}
+
+// Mixin of the ScheduledJob.
+func (ScheduledJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the ScheduledJob.
+func (ScheduledJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(50).
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("任务名称 / Job name"),
+		field.Bool("paused").
+			Default(false).
+			Comment("是否暂停定时执行 / Whether scheduled runs are paused"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("最后执行时间 / Last run time"),
+		field.String("last_status").
+			MaxLen(20).
+			Optional().
+			Comment("最后执行状态 / Last run status (running, success, failed)"),
+		field.String("last_trigger").
+			MaxLen(20).
+			Optional().
+			Comment("最后触发方式 / Last trigger (schedule, manual)"),
+		field.Int64("last_duration").
+			Default(0).
+			Comment("最后执行耗时(毫秒) / Last run duration (milliseconds)"),
+		field.String("last_message").
+			MaxLen(1000).
+			Optional().
+			Comment("最后执行结果或错误信息 / Last run result or error message"),
+	}
+}
+
+// Annotations of the ScheduledJob.
+func (ScheduledJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_scheduled_jobs"},
+		entsql.WithComments(true),
+		schema.Comment("定时任务表 / Scheduled job table"),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
+)
+
+// SecurityEvent holds the schema definition for the SecurityEvent entity.
+type SecurityEvent struct {
+	ent.Schema
+}
+
+// Mixin of the SecurityEvent.
+func (SecurityEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the SecurityEvent.
+func (SecurityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("用户ID，登录失败且用户不存在或用户已删除时为空 / User ID"),
+		field.String("username").
+			MaxLen(50).
+			Optional().
+			Comment("用户名，登录失败时为尝试的用户名，用户删除后仍保留 / Username"),
+		field.String("event_type").
+			MaxLen(32).
+			NotEmpty().
+			Comment("事件类型 / Event type (LOGIN, MFA_CHALLENGE, PASSWORD_CHANGE, MFA_ENABLE, MFA_DISABLE, TOKEN_REVOKE, OAUTH_BIND, OAUTH_UNBIND)"),
+		field.Bool("success").
+			Default(true).
+			Comment("是否成功 / Whether successful"),
+		field.String("provider").
+			MaxLen(50).
+			Optional().
+			Comment("认证方式或第三方提供商 / Authentication method or provider"),
+		field.String("reason").
+			MaxLen(255).
+			Optional().
+			Comment("原因，失败时为错误信息 / Reason"),
+		field.String("ip_address").
+			MaxLen(45).
+			Optional().
+			Comment("IP地址 / IP address"),
+		field.String("user_agent").
+			MaxLen(500).
+			Optional().
+			Comment("用户代理 / User agent"),
+	}
+}
+
+// Edges of the SecurityEvent.
+func (SecurityEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("security_events").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the SecurityEvent.
+func (SecurityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// 联合索引：用户ID + 创建时间，用于查询个人安全记录
+		index.Fields("user_id", "created_at"),
+		// 普通索引：事件类型
+		index.Fields("event_type"),
+		// 普通索引：创建时间
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the SecurityEvent.
+func (SecurityEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_security_events"},
+		entsql.WithComments(true),
+		schema.Comment("安全事件表 / Security event table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "user_id", "username", "event_type", "success", "provider", "ip_address"},
+			Sorts:   []string{"id", "created_at", "username", "event_type"},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 // Token holds the schema definition for the Token entity.
@@ -108,5 +109,9 @@ func (Token) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_tokens"},
 		entsql.WithComments(true),
 		schema.Comment("令牌表 / Token table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "state", "token_type", "user_id", "expires_at", "ip_address", "last_used_at", "provider_id"},
+			Sorts:   []string{"id", "created_at", "expires_at", "last_used_at"},
+		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/wenpiner/last-admin-common/ent/mixins"
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema/annotations"
 )
 
 // User holds the schema definition for the User entity.
@@ -72,6 +73,18 @@ func (User) Fields() []ent.Field {
 			MaxLen(255).
 			Optional().
 			Comment("首页路径 / Home path"),
+		field.Time("password_changed_at").
+			Optional().
+			Nillable().
+			Comment("密码修改时间 / Password changed time"),
+		field.Bool("must_change_password").
+			Default(false).
+			Comment("是否必须修改密码 / Whether password change is required"),
+		field.String("language").
+			MaxLen(20).
+			Optional().
+			Nillable().
+			Comment("偏好语言 / Preferred language"),
 	}
 }
 
@@ -87,6 +100,21 @@ func (User) Edges() []ent.Edge {
 
 		edge.To("totp", UserTotp.Type).
 			Unique(),
+		edge.To("webauthn_credentials", UserWebauthn.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("password_histories", UserPasswordHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("api_keys", ApiKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("oauth_consents", OauthConsent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 用户删除后保留其安全事件，事件中记录的用户名作为审计依据
+		edge.To("security_events", SecurityEvent.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("notification_recipients", NotificationRecipient.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 用户删除后保留其上传的文件，文件可能被业务数据引用
+		edge.To("files", File.Type),
 	}
 }
 
@@ -108,5 +136,9 @@ func (User) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "sys_users"},
 		entsql.WithComments(true),
 		schema.Comment("用户表 / User table"),
+		annotations.QueryFields{
+			Filters: []string{"id", "created_at", "updated_at", "state", "username", "email", "full_name", "mobile", "department_id", "last_login_at", "password_changed_at", "must_change_password", "language"},
+			Sorts:   []string{"id", "created_at", "updated_at", "state", "username", "full_name", "last_login_at"},
+		},
 	}
 }
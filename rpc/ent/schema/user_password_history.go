@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+)
+
+// UserPasswordHistory holds the schema definition for the UserPasswordHistory entity.
+type UserPasswordHistory struct {
+	ent.Schema
+}
+
+// Mixin of the UserPasswordHistory.
+func (UserPasswordHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+	}
+}
+
+// Fields of the UserPasswordHistory.
+func (UserPasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("用户ID / User ID"),
+		field.String("password_hash").
+			MaxLen(255).
+			NotEmpty().
+			Sensitive().
+			Comment("密码哈希 / Password hash"),
+	}
+}
+
+// Edges of the UserPasswordHistory.
+func (UserPasswordHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("password_histories").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the UserPasswordHistory.
+func (UserPasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		// 普通索引：用户ID
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the UserPasswordHistory.
+func (UserPasswordHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_user_password_history"},
+		entsql.WithComments(true),
+		schema.Comment("用户密码历史表 / User password history table"),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/ent/mixins"
+)
+
+// UserWebauthn holds the schema definition for the UserWebauthn entity.
+type UserWebauthn struct {
+	ent.Schema
+}
+
+// Mixin of the UserWebauthn.
+func (UserWebauthn) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.ID32Mixin{},
+		mixins.TimestampMixin{},
+		mixins.StateMixin{},
+	}
+}
+
+// Fields of the UserWebauthn.
+func (UserWebauthn) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("用户ID / User ID"),
+		field.String("credential_id").
+			MaxLen(1024).
+			NotEmpty().
+			Comment("凭证ID(Base64URL) / Credential ID (Base64URL)"),
+		field.Bytes("public_key").
+			Comment("凭证公钥(COSE) / Credential public key (COSE)"),
+		field.String("attestation_type").
+			MaxLen(50).
+			Optional().
+			Comment("证明格式 / Attestation format"),
+		field.String("aaguid").
+			MaxLen(64).
+			Optional().
+			Comment("认证器型号标识 / Authenticator AAGUID"),
+		field.Uint32("sign_count").
+			Default(0).
+			Comment("签名计数器 / Signature counter"),
+		field.Bool("clone_warning").
+			Default(false).
+			Comment("克隆告警 / Clone warning"),
+		field.Strings("transports").
+			Optional().
+			Comment("传输方式 / Transports"),
+		field.String("attachment").
+			MaxLen(50).
+			Optional().
+			Comment("认证器连接方式 / Authenticator attachment"),
+		field.Bool("user_verified").
+			Default(false).
+			Comment("是否经过用户验证 / Whether user verified"),
+		field.Bool("backup_eligible").
+			Default(false).
+			Comment("是否可备份 / Whether backup eligible"),
+		field.Bool("backup_state").
+			Default(false).
+			Comment("是否已备份 / Whether backed up"),
+		field.String("friendly_name").
+			MaxLen(100).
+			Optional().
+			Comment("凭证名称 / Friendly name"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最后使用时间 / Last used time"),
+	}
+}
+
+// Edges of the UserWebauthn.
+func (UserWebauthn) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webauthn_credentials").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the UserWebauthn.
+func (UserWebauthn) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：凭证ID
+		index.Fields("credential_id").
+			Unique().
+			StorageKey("sys_user_webauthn_credential_id_unique"),
+		// 普通索引：用户ID
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the UserWebauthn.
+func (UserWebauthn) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sys_user_webauthn"},
+		entsql.WithComments(true),
+		schema.Comment("用户WebAuthn凭证表 / User WebAuthn credential table"),
+	}
+}
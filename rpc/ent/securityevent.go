@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+)
+
+// 安全事件表 / Security event table
+type SecurityEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 用户ID，登录失败且用户不存在或用户已删除时为空 / User ID
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// 用户名，登录失败时为尝试的用户名，用户删除后仍保留 / Username
+	Username string `json:"username,omitempty"`
+	// 事件类型 / Event type (LOGIN, MFA_CHALLENGE, PASSWORD_CHANGE, MFA_ENABLE, MFA_DISABLE, TOKEN_REVOKE, OAUTH_BIND, OAUTH_UNBIND)
+	EventType string `json:"event_type,omitempty"`
+	// 是否成功 / Whether successful
+	Success bool `json:"success,omitempty"`
+	// 认证方式或第三方提供商 / Authentication method or provider
+	Provider string `json:"provider,omitempty"`
+	// 原因，失败时为错误信息 / Reason
+	Reason string `json:"reason,omitempty"`
+	// IP地址 / IP address
+	IPAddress string `json:"ip_address,omitempty"`
+	// 用户代理 / User agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityEventQuery when eager-loading is set.
+	Edges        SecurityEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityEventEdges holds the relations/edges for other nodes in the graph.
+type SecurityEventEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SecurityEventEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityevent.FieldUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case securityevent.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case securityevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case securityevent.FieldUsername, securityevent.FieldEventType, securityevent.FieldProvider, securityevent.FieldReason, securityevent.FieldIPAddress, securityevent.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case securityevent.FieldCreatedAt, securityevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityEvent fields.
+func (_m *SecurityEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case securityevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case securityevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case securityevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case securityevent.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case securityevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case securityevent.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case securityevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case securityevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case securityevent.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				_m.IPAddress = value.String
+			}
+		case securityevent.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *SecurityEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SecurityEvent entity.
+func (_m *SecurityEvent) QueryUser() *UserQuery {
+	return NewSecurityEventClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this SecurityEvent.
+// Note that you need to call SecurityEvent.Unwrap() before calling this method if this SecurityEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SecurityEvent) Update() *SecurityEventUpdateOne {
+	return NewSecurityEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SecurityEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SecurityEvent) Unwrap() *SecurityEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SecurityEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SecurityEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(_m.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityEvents is a parsable slice of SecurityEvent.
+type SecurityEvents []*SecurityEvent
@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// API is the client for interacting with the API builders.
 	API *APIClient
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
 	// Configuration is the client for interacting with the Configuration builders.
 	Configuration *ConfigurationClient
 	// Department is the client for interacting with the Department builders.
@@ -22,22 +24,44 @@ type Tx struct {
 	DictItem *DictItemClient
 	// DictType is the client for interacting with the DictType builders.
 	DictType *DictTypeClient
+	// File is the client for interacting with the File builders.
+	File *FileClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationRecipient is the client for interacting with the NotificationRecipient builders.
+	NotificationRecipient *NotificationRecipientClient
+	// OauthClient is the client for interacting with the OauthClient builders.
+	OauthClient *OauthClientClient
+	// OauthConsent is the client for interacting with the OauthConsent builders.
+	OauthConsent *OauthConsentClient
 	// OauthProvider is the client for interacting with the OauthProvider builders.
 	OauthProvider *OauthProviderClient
 	// OperationLog is the client for interacting with the OperationLog builders.
 	OperationLog *OperationLogClient
+	// OperationLogArchive is the client for interacting with the OperationLogArchive builders.
+	OperationLogArchive *OperationLogArchiveClient
+	// PermissionSnapshot is the client for interacting with the PermissionSnapshot builders.
+	PermissionSnapshot *PermissionSnapshotClient
 	// Position is the client for interacting with the Position builders.
 	Position *PositionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ScheduledJob is the client for interacting with the ScheduledJob builders.
+	ScheduledJob *ScheduledJobClient
+	// SecurityEvent is the client for interacting with the SecurityEvent builders.
+	SecurityEvent *SecurityEventClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserPasswordHistory is the client for interacting with the UserPasswordHistory builders.
+	UserPasswordHistory *UserPasswordHistoryClient
 	// UserTotp is the client for interacting with the UserTotp builders.
 	UserTotp *UserTotpClient
+	// UserWebauthn is the client for interacting with the UserWebauthn builders.
+	UserWebauthn *UserWebauthnClient
 
 	// lazily loaded.
 	client     *Client
@@ -170,18 +194,30 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.API = NewAPIClient(tx.config)
+	tx.ApiKey = NewApiKeyClient(tx.config)
 	tx.Configuration = NewConfigurationClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.DictItem = NewDictItemClient(tx.config)
 	tx.DictType = NewDictTypeClient(tx.config)
+	tx.File = NewFileClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationRecipient = NewNotificationRecipientClient(tx.config)
+	tx.OauthClient = NewOauthClientClient(tx.config)
+	tx.OauthConsent = NewOauthConsentClient(tx.config)
 	tx.OauthProvider = NewOauthProviderClient(tx.config)
 	tx.OperationLog = NewOperationLogClient(tx.config)
+	tx.OperationLogArchive = NewOperationLogArchiveClient(tx.config)
+	tx.PermissionSnapshot = NewPermissionSnapshotClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.ScheduledJob = NewScheduledJobClient(tx.config)
+	tx.SecurityEvent = NewSecurityEventClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserPasswordHistory = NewUserPasswordHistoryClient(tx.config)
 	tx.UserTotp = NewUserTotpClient(tx.config)
+	tx.UserWebauthn = NewUserWebauthnClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -49,10 +49,17 @@ type User struct {
 	DepartmentID uint32 `json:"department_id,omitempty"`
 	// 首页路径 / Home path
 	HomePath string `json:"home_path,omitempty"`
+	// 密码修改时间 / Password changed time
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+	// 是否必须修改密码 / Whether password change is required
+	MustChangePassword bool `json:"must_change_password,omitempty"`
+	// 偏好语言 / Preferred language
+	Language *string `json:"language,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges        UserEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges              UserEdges `json:"edges"`
+	notification_users *uint32
+	selectValues       sql.SelectValues
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -67,9 +74,23 @@ type UserEdges struct {
 	LeaderDepartment []*Department `json:"leader_department,omitempty"`
 	// Totp holds the value of the totp edge.
 	Totp *UserTotp `json:"totp,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*UserWebauthn `json:"webauthn_credentials,omitempty"`
+	// PasswordHistories holds the value of the password_histories edge.
+	PasswordHistories []*UserPasswordHistory `json:"password_histories,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*ApiKey `json:"api_keys,omitempty"`
+	// OauthConsents holds the value of the oauth_consents edge.
+	OauthConsents []*OauthConsent `json:"oauth_consents,omitempty"`
+	// SecurityEvents holds the value of the security_events edge.
+	SecurityEvents []*SecurityEvent `json:"security_events,omitempty"`
+	// NotificationRecipients holds the value of the notification_recipients edge.
+	NotificationRecipients []*NotificationRecipient `json:"notification_recipients,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [12]bool
 }
 
 // RolesOrErr returns the Roles value or an error if the edge
@@ -121,21 +142,86 @@ func (e UserEdges) TotpOrErr() (*UserTotp, error) {
 	return nil, &NotLoadedError{edge: "totp"}
 }
 
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*UserWebauthn, error) {
+	if e.loadedTypes[5] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// PasswordHistoriesOrErr returns the PasswordHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordHistoriesOrErr() ([]*UserPasswordHistory, error) {
+	if e.loadedTypes[6] {
+		return e.PasswordHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "password_histories"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*ApiKey, error) {
+	if e.loadedTypes[7] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// OauthConsentsOrErr returns the OauthConsents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthConsentsOrErr() ([]*OauthConsent, error) {
+	if e.loadedTypes[8] {
+		return e.OauthConsents, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_consents"}
+}
+
+// SecurityEventsOrErr returns the SecurityEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SecurityEventsOrErr() ([]*SecurityEvent, error) {
+	if e.loadedTypes[9] {
+		return e.SecurityEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "security_events"}
+}
+
+// NotificationRecipientsOrErr returns the NotificationRecipients value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationRecipientsOrErr() ([]*NotificationRecipient, error) {
+	if e.loadedTypes[10] {
+		return e.NotificationRecipients, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_recipients"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[11] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldState:
+		case user.FieldState, user.FieldMustChangePassword:
 			values[i] = new(sql.NullBool)
 		case user.FieldDepartmentID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail, user.FieldFullName, user.FieldMobile, user.FieldAvatar, user.FieldUserDescription, user.FieldLastLoginIP, user.FieldHomePath:
+		case user.FieldUsername, user.FieldPasswordHash, user.FieldEmail, user.FieldFullName, user.FieldMobile, user.FieldAvatar, user.FieldUserDescription, user.FieldLastLoginIP, user.FieldHomePath, user.FieldLanguage:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLoginAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLoginAt, user.FieldPasswordChangedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
+		case user.ForeignKeys[0]: // notification_users
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -242,6 +328,33 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.HomePath = value.String
 			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				_m.PasswordChangedAt = new(time.Time)
+				*_m.PasswordChangedAt = value.Time
+			}
+		case user.FieldMustChangePassword:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field must_change_password", values[i])
+			} else if value.Valid {
+				_m.MustChangePassword = value.Bool
+			}
+		case user.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = new(string)
+				*_m.Language = value.String
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field notification_users", value)
+			} else if value.Valid {
+				_m.notification_users = new(uint32)
+				*_m.notification_users = uint32(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -280,6 +393,41 @@ func (_m *User) QueryTotp() *UserTotpQuery {
 	return NewUserClient(_m.config).QueryTotp(_m)
 }
 
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (_m *User) QueryWebauthnCredentials() *UserWebauthnQuery {
+	return NewUserClient(_m.config).QueryWebauthnCredentials(_m)
+}
+
+// QueryPasswordHistories queries the "password_histories" edge of the User entity.
+func (_m *User) QueryPasswordHistories() *UserPasswordHistoryQuery {
+	return NewUserClient(_m.config).QueryPasswordHistories(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *ApiKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryOauthConsents queries the "oauth_consents" edge of the User entity.
+func (_m *User) QueryOauthConsents() *OauthConsentQuery {
+	return NewUserClient(_m.config).QueryOauthConsents(_m)
+}
+
+// QuerySecurityEvents queries the "security_events" edge of the User entity.
+func (_m *User) QuerySecurityEvents() *SecurityEventQuery {
+	return NewUserClient(_m.config).QuerySecurityEvents(_m)
+}
+
+// QueryNotificationRecipients queries the "notification_recipients" edge of the User entity.
+func (_m *User) QueryNotificationRecipients() *NotificationRecipientQuery {
+	return NewUserClient(_m.config).QueryNotificationRecipients(_m)
+}
+
+// QueryFiles queries the "files" edge of the User entity.
+func (_m *User) QueryFiles() *FileQuery {
+	return NewUserClient(_m.config).QueryFiles(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -346,6 +494,19 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("home_path=")
 	builder.WriteString(_m.HomePath)
+	builder.WriteString(", ")
+	if v := _m.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("must_change_password=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MustChangePassword))
+	builder.WriteString(", ")
+	if v := _m.Language; v != nil {
+		builder.WriteString("language=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+)
+
+// 用户WebAuthn凭证表 / User WebAuthn credential table
+type UserWebauthn struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间 / Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间 / Update time
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 状态 / State
+	State bool `json:"state,omitempty"`
+	// 用户ID / User ID
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// 凭证ID(Base64URL) / Credential ID (Base64URL)
+	CredentialID string `json:"credential_id,omitempty"`
+	// 凭证公钥(COSE) / Credential public key (COSE)
+	PublicKey []byte `json:"public_key,omitempty"`
+	// 证明格式 / Attestation format
+	AttestationType string `json:"attestation_type,omitempty"`
+	// 认证器型号标识 / Authenticator AAGUID
+	Aaguid string `json:"aaguid,omitempty"`
+	// 签名计数器 / Signature counter
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// 克隆告警 / Clone warning
+	CloneWarning bool `json:"clone_warning,omitempty"`
+	// 传输方式 / Transports
+	Transports []string `json:"transports,omitempty"`
+	// 认证器连接方式 / Authenticator attachment
+	Attachment string `json:"attachment,omitempty"`
+	// 是否经过用户验证 / Whether user verified
+	UserVerified bool `json:"user_verified,omitempty"`
+	// 是否可备份 / Whether backup eligible
+	BackupEligible bool `json:"backup_eligible,omitempty"`
+	// 是否已备份 / Whether backed up
+	BackupState bool `json:"backup_state,omitempty"`
+	// 凭证名称 / Friendly name
+	FriendlyName string `json:"friendly_name,omitempty"`
+	// 最后使用时间 / Last used time
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserWebauthnQuery when eager-loading is set.
+	Edges        UserWebauthnEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserWebauthnEdges holds the relations/edges for other nodes in the graph.
+type UserWebauthnEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserWebauthnEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserWebauthn) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userwebauthn.FieldPublicKey, userwebauthn.FieldTransports:
+			values[i] = new([]byte)
+		case userwebauthn.FieldState, userwebauthn.FieldCloneWarning, userwebauthn.FieldUserVerified, userwebauthn.FieldBackupEligible, userwebauthn.FieldBackupState:
+			values[i] = new(sql.NullBool)
+		case userwebauthn.FieldID, userwebauthn.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case userwebauthn.FieldCredentialID, userwebauthn.FieldAttestationType, userwebauthn.FieldAaguid, userwebauthn.FieldAttachment, userwebauthn.FieldFriendlyName:
+			values[i] = new(sql.NullString)
+		case userwebauthn.FieldCreatedAt, userwebauthn.FieldUpdatedAt, userwebauthn.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		case userwebauthn.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserWebauthn fields.
+func (_m *UserWebauthn) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userwebauthn.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case userwebauthn.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userwebauthn.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userwebauthn.FieldState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.Bool
+			}
+		case userwebauthn.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case userwebauthn.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				_m.CredentialID = value.String
+			}
+		case userwebauthn.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				_m.PublicKey = *value
+			}
+		case userwebauthn.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				_m.AttestationType = value.String
+			}
+		case userwebauthn.FieldAaguid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value.Valid {
+				_m.Aaguid = value.String
+			}
+		case userwebauthn.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				_m.SignCount = uint32(value.Int64)
+			}
+		case userwebauthn.FieldCloneWarning:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field clone_warning", values[i])
+			} else if value.Valid {
+				_m.CloneWarning = value.Bool
+			}
+		case userwebauthn.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case userwebauthn.FieldAttachment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment", values[i])
+			} else if value.Valid {
+				_m.Attachment = value.String
+			}
+		case userwebauthn.FieldUserVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user_verified", values[i])
+			} else if value.Valid {
+				_m.UserVerified = value.Bool
+			}
+		case userwebauthn.FieldBackupEligible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_eligible", values[i])
+			} else if value.Valid {
+				_m.BackupEligible = value.Bool
+			}
+		case userwebauthn.FieldBackupState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_state", values[i])
+			} else if value.Valid {
+				_m.BackupState = value.Bool
+			}
+		case userwebauthn.FieldFriendlyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field friendly_name", values[i])
+			} else if value.Valid {
+				_m.FriendlyName = value.String
+			}
+		case userwebauthn.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				_m.LastUsedAt = new(time.Time)
+				*_m.LastUsedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserWebauthn.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserWebauthn) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserWebauthn entity.
+func (_m *UserWebauthn) QueryUser() *UserQuery {
+	return NewUserWebauthnClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UserWebauthn.
+// Note that you need to call UserWebauthn.Unwrap() before calling this method if this UserWebauthn
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserWebauthn) Update() *UserWebauthnUpdateOne {
+	return NewUserWebauthnClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserWebauthn entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserWebauthn) Unwrap() *UserWebauthn {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserWebauthn is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserWebauthn) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserWebauthn(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.State))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(_m.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_type=")
+	builder.WriteString(_m.AttestationType)
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(_m.Aaguid)
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("clone_warning=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CloneWarning))
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("attachment=")
+	builder.WriteString(_m.Attachment)
+	builder.WriteString(", ")
+	builder.WriteString("user_verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserVerified))
+	builder.WriteString(", ")
+	builder.WriteString("backup_eligible=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BackupEligible))
+	builder.WriteString(", ")
+	builder.WriteString("backup_state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BackupState))
+	builder.WriteString(", ")
+	builder.WriteString("friendly_name=")
+	builder.WriteString(_m.FriendlyName)
+	builder.WriteString(", ")
+	if v := _m.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserWebauthns is a parsable slice of UserWebauthn.
+type UserWebauthns []*UserWebauthn
@@ -0,0 +1,840 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userwebauthn
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldState, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUserID, v))
+}
+
+// CredentialID applies equality check predicate on the "credential_id" field. It's identical to CredentialIDEQ.
+func CredentialID(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// AttestationType applies equality check predicate on the "attestation_type" field. It's identical to AttestationTypeEQ.
+func AttestationType(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// Aaguid applies equality check predicate on the "aaguid" field. It's identical to AaguidEQ.
+func Aaguid(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAaguid, v))
+}
+
+// SignCount applies equality check predicate on the "sign_count" field. It's identical to SignCountEQ.
+func SignCount(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldSignCount, v))
+}
+
+// CloneWarning applies equality check predicate on the "clone_warning" field. It's identical to CloneWarningEQ.
+func CloneWarning(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCloneWarning, v))
+}
+
+// Attachment applies equality check predicate on the "attachment" field. It's identical to AttachmentEQ.
+func Attachment(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAttachment, v))
+}
+
+// UserVerified applies equality check predicate on the "user_verified" field. It's identical to UserVerifiedEQ.
+func UserVerified(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUserVerified, v))
+}
+
+// BackupEligible applies equality check predicate on the "backup_eligible" field. It's identical to BackupEligibleEQ.
+func BackupEligible(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldBackupEligible, v))
+}
+
+// BackupState applies equality check predicate on the "backup_state" field. It's identical to BackupStateEQ.
+func BackupState(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldBackupState, v))
+}
+
+// FriendlyName applies equality check predicate on the "friendly_name" field. It's identical to FriendlyNameEQ.
+func FriendlyName(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldFriendlyName, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldState))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// CredentialIDEQ applies the EQ predicate on the "credential_id" field.
+func CredentialIDEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCredentialID, v))
+}
+
+// CredentialIDNEQ applies the NEQ predicate on the "credential_id" field.
+func CredentialIDNEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldCredentialID, v))
+}
+
+// CredentialIDIn applies the In predicate on the "credential_id" field.
+func CredentialIDIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDNotIn applies the NotIn predicate on the "credential_id" field.
+func CredentialIDNotIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldCredentialID, vs...))
+}
+
+// CredentialIDGT applies the GT predicate on the "credential_id" field.
+func CredentialIDGT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldCredentialID, v))
+}
+
+// CredentialIDGTE applies the GTE predicate on the "credential_id" field.
+func CredentialIDGTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldCredentialID, v))
+}
+
+// CredentialIDLT applies the LT predicate on the "credential_id" field.
+func CredentialIDLT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldCredentialID, v))
+}
+
+// CredentialIDLTE applies the LTE predicate on the "credential_id" field.
+func CredentialIDLTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldCredentialID, v))
+}
+
+// CredentialIDContains applies the Contains predicate on the "credential_id" field.
+func CredentialIDContains(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContains(FieldCredentialID, v))
+}
+
+// CredentialIDHasPrefix applies the HasPrefix predicate on the "credential_id" field.
+func CredentialIDHasPrefix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasPrefix(FieldCredentialID, v))
+}
+
+// CredentialIDHasSuffix applies the HasSuffix predicate on the "credential_id" field.
+func CredentialIDHasSuffix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasSuffix(FieldCredentialID, v))
+}
+
+// CredentialIDEqualFold applies the EqualFold predicate on the "credential_id" field.
+func CredentialIDEqualFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEqualFold(FieldCredentialID, v))
+}
+
+// CredentialIDContainsFold applies the ContainsFold predicate on the "credential_id" field.
+func CredentialIDContainsFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContainsFold(FieldCredentialID, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...[]byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...[]byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v []byte) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// AttestationTypeEQ applies the EQ predicate on the "attestation_type" field.
+func AttestationTypeEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeNEQ applies the NEQ predicate on the "attestation_type" field.
+func AttestationTypeNEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldAttestationType, v))
+}
+
+// AttestationTypeIn applies the In predicate on the "attestation_type" field.
+func AttestationTypeIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeNotIn applies the NotIn predicate on the "attestation_type" field.
+func AttestationTypeNotIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldAttestationType, vs...))
+}
+
+// AttestationTypeGT applies the GT predicate on the "attestation_type" field.
+func AttestationTypeGT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldAttestationType, v))
+}
+
+// AttestationTypeGTE applies the GTE predicate on the "attestation_type" field.
+func AttestationTypeGTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldAttestationType, v))
+}
+
+// AttestationTypeLT applies the LT predicate on the "attestation_type" field.
+func AttestationTypeLT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldAttestationType, v))
+}
+
+// AttestationTypeLTE applies the LTE predicate on the "attestation_type" field.
+func AttestationTypeLTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldAttestationType, v))
+}
+
+// AttestationTypeContains applies the Contains predicate on the "attestation_type" field.
+func AttestationTypeContains(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContains(FieldAttestationType, v))
+}
+
+// AttestationTypeHasPrefix applies the HasPrefix predicate on the "attestation_type" field.
+func AttestationTypeHasPrefix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasPrefix(FieldAttestationType, v))
+}
+
+// AttestationTypeHasSuffix applies the HasSuffix predicate on the "attestation_type" field.
+func AttestationTypeHasSuffix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasSuffix(FieldAttestationType, v))
+}
+
+// AttestationTypeIsNil applies the IsNil predicate on the "attestation_type" field.
+func AttestationTypeIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldAttestationType))
+}
+
+// AttestationTypeNotNil applies the NotNil predicate on the "attestation_type" field.
+func AttestationTypeNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldAttestationType))
+}
+
+// AttestationTypeEqualFold applies the EqualFold predicate on the "attestation_type" field.
+func AttestationTypeEqualFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEqualFold(FieldAttestationType, v))
+}
+
+// AttestationTypeContainsFold applies the ContainsFold predicate on the "attestation_type" field.
+func AttestationTypeContainsFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContainsFold(FieldAttestationType, v))
+}
+
+// AaguidEQ applies the EQ predicate on the "aaguid" field.
+func AaguidEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAaguid, v))
+}
+
+// AaguidNEQ applies the NEQ predicate on the "aaguid" field.
+func AaguidNEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldAaguid, v))
+}
+
+// AaguidIn applies the In predicate on the "aaguid" field.
+func AaguidIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldAaguid, vs...))
+}
+
+// AaguidNotIn applies the NotIn predicate on the "aaguid" field.
+func AaguidNotIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldAaguid, vs...))
+}
+
+// AaguidGT applies the GT predicate on the "aaguid" field.
+func AaguidGT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldAaguid, v))
+}
+
+// AaguidGTE applies the GTE predicate on the "aaguid" field.
+func AaguidGTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldAaguid, v))
+}
+
+// AaguidLT applies the LT predicate on the "aaguid" field.
+func AaguidLT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldAaguid, v))
+}
+
+// AaguidLTE applies the LTE predicate on the "aaguid" field.
+func AaguidLTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldAaguid, v))
+}
+
+// AaguidContains applies the Contains predicate on the "aaguid" field.
+func AaguidContains(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContains(FieldAaguid, v))
+}
+
+// AaguidHasPrefix applies the HasPrefix predicate on the "aaguid" field.
+func AaguidHasPrefix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasPrefix(FieldAaguid, v))
+}
+
+// AaguidHasSuffix applies the HasSuffix predicate on the "aaguid" field.
+func AaguidHasSuffix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasSuffix(FieldAaguid, v))
+}
+
+// AaguidIsNil applies the IsNil predicate on the "aaguid" field.
+func AaguidIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldAaguid))
+}
+
+// AaguidNotNil applies the NotNil predicate on the "aaguid" field.
+func AaguidNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldAaguid))
+}
+
+// AaguidEqualFold applies the EqualFold predicate on the "aaguid" field.
+func AaguidEqualFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEqualFold(FieldAaguid, v))
+}
+
+// AaguidContainsFold applies the ContainsFold predicate on the "aaguid" field.
+func AaguidContainsFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContainsFold(FieldAaguid, v))
+}
+
+// SignCountEQ applies the EQ predicate on the "sign_count" field.
+func SignCountEQ(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldSignCount, v))
+}
+
+// SignCountNEQ applies the NEQ predicate on the "sign_count" field.
+func SignCountNEQ(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldSignCount, v))
+}
+
+// SignCountIn applies the In predicate on the "sign_count" field.
+func SignCountIn(vs ...uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldSignCount, vs...))
+}
+
+// SignCountNotIn applies the NotIn predicate on the "sign_count" field.
+func SignCountNotIn(vs ...uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldSignCount, vs...))
+}
+
+// SignCountGT applies the GT predicate on the "sign_count" field.
+func SignCountGT(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldSignCount, v))
+}
+
+// SignCountGTE applies the GTE predicate on the "sign_count" field.
+func SignCountGTE(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldSignCount, v))
+}
+
+// SignCountLT applies the LT predicate on the "sign_count" field.
+func SignCountLT(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldSignCount, v))
+}
+
+// SignCountLTE applies the LTE predicate on the "sign_count" field.
+func SignCountLTE(v uint32) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldSignCount, v))
+}
+
+// CloneWarningEQ applies the EQ predicate on the "clone_warning" field.
+func CloneWarningEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldCloneWarning, v))
+}
+
+// CloneWarningNEQ applies the NEQ predicate on the "clone_warning" field.
+func CloneWarningNEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldCloneWarning, v))
+}
+
+// TransportsIsNil applies the IsNil predicate on the "transports" field.
+func TransportsIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldTransports))
+}
+
+// TransportsNotNil applies the NotNil predicate on the "transports" field.
+func TransportsNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldTransports))
+}
+
+// AttachmentEQ applies the EQ predicate on the "attachment" field.
+func AttachmentEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldAttachment, v))
+}
+
+// AttachmentNEQ applies the NEQ predicate on the "attachment" field.
+func AttachmentNEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldAttachment, v))
+}
+
+// AttachmentIn applies the In predicate on the "attachment" field.
+func AttachmentIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldAttachment, vs...))
+}
+
+// AttachmentNotIn applies the NotIn predicate on the "attachment" field.
+func AttachmentNotIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldAttachment, vs...))
+}
+
+// AttachmentGT applies the GT predicate on the "attachment" field.
+func AttachmentGT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldAttachment, v))
+}
+
+// AttachmentGTE applies the GTE predicate on the "attachment" field.
+func AttachmentGTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldAttachment, v))
+}
+
+// AttachmentLT applies the LT predicate on the "attachment" field.
+func AttachmentLT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldAttachment, v))
+}
+
+// AttachmentLTE applies the LTE predicate on the "attachment" field.
+func AttachmentLTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldAttachment, v))
+}
+
+// AttachmentContains applies the Contains predicate on the "attachment" field.
+func AttachmentContains(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContains(FieldAttachment, v))
+}
+
+// AttachmentHasPrefix applies the HasPrefix predicate on the "attachment" field.
+func AttachmentHasPrefix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasPrefix(FieldAttachment, v))
+}
+
+// AttachmentHasSuffix applies the HasSuffix predicate on the "attachment" field.
+func AttachmentHasSuffix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasSuffix(FieldAttachment, v))
+}
+
+// AttachmentIsNil applies the IsNil predicate on the "attachment" field.
+func AttachmentIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldAttachment))
+}
+
+// AttachmentNotNil applies the NotNil predicate on the "attachment" field.
+func AttachmentNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldAttachment))
+}
+
+// AttachmentEqualFold applies the EqualFold predicate on the "attachment" field.
+func AttachmentEqualFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEqualFold(FieldAttachment, v))
+}
+
+// AttachmentContainsFold applies the ContainsFold predicate on the "attachment" field.
+func AttachmentContainsFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContainsFold(FieldAttachment, v))
+}
+
+// UserVerifiedEQ applies the EQ predicate on the "user_verified" field.
+func UserVerifiedEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldUserVerified, v))
+}
+
+// UserVerifiedNEQ applies the NEQ predicate on the "user_verified" field.
+func UserVerifiedNEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldUserVerified, v))
+}
+
+// BackupEligibleEQ applies the EQ predicate on the "backup_eligible" field.
+func BackupEligibleEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldBackupEligible, v))
+}
+
+// BackupEligibleNEQ applies the NEQ predicate on the "backup_eligible" field.
+func BackupEligibleNEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldBackupEligible, v))
+}
+
+// BackupStateEQ applies the EQ predicate on the "backup_state" field.
+func BackupStateEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldBackupState, v))
+}
+
+// BackupStateNEQ applies the NEQ predicate on the "backup_state" field.
+func BackupStateNEQ(v bool) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldBackupState, v))
+}
+
+// FriendlyNameEQ applies the EQ predicate on the "friendly_name" field.
+func FriendlyNameEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldFriendlyName, v))
+}
+
+// FriendlyNameNEQ applies the NEQ predicate on the "friendly_name" field.
+func FriendlyNameNEQ(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldFriendlyName, v))
+}
+
+// FriendlyNameIn applies the In predicate on the "friendly_name" field.
+func FriendlyNameIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldFriendlyName, vs...))
+}
+
+// FriendlyNameNotIn applies the NotIn predicate on the "friendly_name" field.
+func FriendlyNameNotIn(vs ...string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldFriendlyName, vs...))
+}
+
+// FriendlyNameGT applies the GT predicate on the "friendly_name" field.
+func FriendlyNameGT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldFriendlyName, v))
+}
+
+// FriendlyNameGTE applies the GTE predicate on the "friendly_name" field.
+func FriendlyNameGTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldFriendlyName, v))
+}
+
+// FriendlyNameLT applies the LT predicate on the "friendly_name" field.
+func FriendlyNameLT(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldFriendlyName, v))
+}
+
+// FriendlyNameLTE applies the LTE predicate on the "friendly_name" field.
+func FriendlyNameLTE(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldFriendlyName, v))
+}
+
+// FriendlyNameContains applies the Contains predicate on the "friendly_name" field.
+func FriendlyNameContains(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContains(FieldFriendlyName, v))
+}
+
+// FriendlyNameHasPrefix applies the HasPrefix predicate on the "friendly_name" field.
+func FriendlyNameHasPrefix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasPrefix(FieldFriendlyName, v))
+}
+
+// FriendlyNameHasSuffix applies the HasSuffix predicate on the "friendly_name" field.
+func FriendlyNameHasSuffix(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldHasSuffix(FieldFriendlyName, v))
+}
+
+// FriendlyNameIsNil applies the IsNil predicate on the "friendly_name" field.
+func FriendlyNameIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldFriendlyName))
+}
+
+// FriendlyNameNotNil applies the NotNil predicate on the "friendly_name" field.
+func FriendlyNameNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldFriendlyName))
+}
+
+// FriendlyNameEqualFold applies the EqualFold predicate on the "friendly_name" field.
+func FriendlyNameEqualFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEqualFold(FieldFriendlyName, v))
+}
+
+// FriendlyNameContainsFold applies the ContainsFold predicate on the "friendly_name" field.
+func FriendlyNameContainsFold(v string) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldContainsFold(FieldFriendlyName, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserWebauthn {
+	return predicate.UserWebauthn(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserWebauthn {
+	return predicate.UserWebauthn(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserWebauthn) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserWebauthn) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserWebauthn) predicate.UserWebauthn {
+	return predicate.UserWebauthn(sql.NotPredicates(p))
+}
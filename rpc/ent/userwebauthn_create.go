@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+)
+
+// UserWebauthnCreate is the builder for creating a UserWebauthn entity.
+type UserWebauthnCreate struct {
+	config
+	mutation *UserWebauthnMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserWebauthnCreate) SetCreatedAt(v time.Time) *UserWebauthnCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableCreatedAt(v *time.Time) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserWebauthnCreate) SetUpdatedAt(v time.Time) *UserWebauthnCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableUpdatedAt(v *time.Time) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *UserWebauthnCreate) SetState(v bool) *UserWebauthnCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableState(v *bool) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserWebauthnCreate) SetUserID(v uuid.UUID) *UserWebauthnCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_c *UserWebauthnCreate) SetCredentialID(v string) *UserWebauthnCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *UserWebauthnCreate) SetPublicKey(v []byte) *UserWebauthnCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_c *UserWebauthnCreate) SetAttestationType(v string) *UserWebauthnCreate {
+	_c.mutation.SetAttestationType(v)
+	return _c
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableAttestationType(v *string) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetAttestationType(*v)
+	}
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *UserWebauthnCreate) SetAaguid(v string) *UserWebauthnCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableAaguid(v *string) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetAaguid(*v)
+	}
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *UserWebauthnCreate) SetSignCount(v uint32) *UserWebauthnCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableSignCount(v *uint32) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetCloneWarning sets the "clone_warning" field.
+func (_c *UserWebauthnCreate) SetCloneWarning(v bool) *UserWebauthnCreate {
+	_c.mutation.SetCloneWarning(v)
+	return _c
+}
+
+// SetNillableCloneWarning sets the "clone_warning" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableCloneWarning(v *bool) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetCloneWarning(*v)
+	}
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *UserWebauthnCreate) SetTransports(v []string) *UserWebauthnCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetAttachment sets the "attachment" field.
+func (_c *UserWebauthnCreate) SetAttachment(v string) *UserWebauthnCreate {
+	_c.mutation.SetAttachment(v)
+	return _c
+}
+
+// SetNillableAttachment sets the "attachment" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableAttachment(v *string) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetAttachment(*v)
+	}
+	return _c
+}
+
+// SetUserVerified sets the "user_verified" field.
+func (_c *UserWebauthnCreate) SetUserVerified(v bool) *UserWebauthnCreate {
+	_c.mutation.SetUserVerified(v)
+	return _c
+}
+
+// SetNillableUserVerified sets the "user_verified" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableUserVerified(v *bool) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetUserVerified(*v)
+	}
+	return _c
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_c *UserWebauthnCreate) SetBackupEligible(v bool) *UserWebauthnCreate {
+	_c.mutation.SetBackupEligible(v)
+	return _c
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableBackupEligible(v *bool) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetBackupEligible(*v)
+	}
+	return _c
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_c *UserWebauthnCreate) SetBackupState(v bool) *UserWebauthnCreate {
+	_c.mutation.SetBackupState(v)
+	return _c
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableBackupState(v *bool) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetBackupState(*v)
+	}
+	return _c
+}
+
+// SetFriendlyName sets the "friendly_name" field.
+func (_c *UserWebauthnCreate) SetFriendlyName(v string) *UserWebauthnCreate {
+	_c.mutation.SetFriendlyName(v)
+	return _c
+}
+
+// SetNillableFriendlyName sets the "friendly_name" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableFriendlyName(v *string) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetFriendlyName(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *UserWebauthnCreate) SetLastUsedAt(v time.Time) *UserWebauthnCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *UserWebauthnCreate) SetNillableLastUsedAt(v *time.Time) *UserWebauthnCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserWebauthnCreate) SetID(v uint32) *UserWebauthnCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserWebauthnCreate) SetUser(v *User) *UserWebauthnCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UserWebauthnMutation object of the builder.
+func (_c *UserWebauthnCreate) Mutation() *UserWebauthnMutation {
+	return _c.mutation
+}
+
+// Save creates the UserWebauthn in the database.
+func (_c *UserWebauthnCreate) Save(ctx context.Context) (*UserWebauthn, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserWebauthnCreate) SaveX(ctx context.Context) *UserWebauthn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserWebauthnCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserWebauthnCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserWebauthnCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userwebauthn.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userwebauthn.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := userwebauthn.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := userwebauthn.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+	if _, ok := _c.mutation.CloneWarning(); !ok {
+		v := userwebauthn.DefaultCloneWarning
+		_c.mutation.SetCloneWarning(v)
+	}
+	if _, ok := _c.mutation.UserVerified(); !ok {
+		v := userwebauthn.DefaultUserVerified
+		_c.mutation.SetUserVerified(v)
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		v := userwebauthn.DefaultBackupEligible
+		_c.mutation.SetBackupEligible(v)
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		v := userwebauthn.DefaultBackupState
+		_c.mutation.SetBackupState(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserWebauthnCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserWebauthn.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserWebauthn.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserWebauthn.user_id"`)}
+	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "UserWebauthn.credential_id"`)}
+	}
+	if v, ok := _c.mutation.CredentialID(); ok {
+		if err := userwebauthn.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "UserWebauthn.public_key"`)}
+	}
+	if v, ok := _c.mutation.AttestationType(); ok {
+		if err := userwebauthn.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Aaguid(); ok {
+		if err := userwebauthn.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.aaguid": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "UserWebauthn.sign_count"`)}
+	}
+	if _, ok := _c.mutation.CloneWarning(); !ok {
+		return &ValidationError{Name: "clone_warning", err: errors.New(`ent: missing required field "UserWebauthn.clone_warning"`)}
+	}
+	if v, ok := _c.mutation.Attachment(); ok {
+		if err := userwebauthn.AttachmentValidator(v); err != nil {
+			return &ValidationError{Name: "attachment", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attachment": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserVerified(); !ok {
+		return &ValidationError{Name: "user_verified", err: errors.New(`ent: missing required field "UserWebauthn.user_verified"`)}
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		return &ValidationError{Name: "backup_eligible", err: errors.New(`ent: missing required field "UserWebauthn.backup_eligible"`)}
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		return &ValidationError{Name: "backup_state", err: errors.New(`ent: missing required field "UserWebauthn.backup_state"`)}
+	}
+	if v, ok := _c.mutation.FriendlyName(); ok {
+		if err := userwebauthn.FriendlyNameValidator(v); err != nil {
+			return &ValidationError{Name: "friendly_name", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.friendly_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := userwebauthn.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserWebauthn.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserWebauthnCreate) sqlSave(ctx context.Context) (*UserWebauthn, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserWebauthnCreate) createSpec() (*UserWebauthn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserWebauthn{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userwebauthn.Table, sqlgraph.NewFieldSpec(userwebauthn.FieldID, field.TypeUint32))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userwebauthn.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userwebauthn.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(userwebauthn.FieldState, field.TypeBool, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(userwebauthn.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(userwebauthn.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.AttestationType(); ok {
+		_spec.SetField(userwebauthn.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(userwebauthn.FieldAaguid, field.TypeString, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(userwebauthn.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.CloneWarning(); ok {
+		_spec.SetField(userwebauthn.FieldCloneWarning, field.TypeBool, value)
+		_node.CloneWarning = value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(userwebauthn.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := _c.mutation.Attachment(); ok {
+		_spec.SetField(userwebauthn.FieldAttachment, field.TypeString, value)
+		_node.Attachment = value
+	}
+	if value, ok := _c.mutation.UserVerified(); ok {
+		_spec.SetField(userwebauthn.FieldUserVerified, field.TypeBool, value)
+		_node.UserVerified = value
+	}
+	if value, ok := _c.mutation.BackupEligible(); ok {
+		_spec.SetField(userwebauthn.FieldBackupEligible, field.TypeBool, value)
+		_node.BackupEligible = value
+	}
+	if value, ok := _c.mutation.BackupState(); ok {
+		_spec.SetField(userwebauthn.FieldBackupState, field.TypeBool, value)
+		_node.BackupState = value
+	}
+	if value, ok := _c.mutation.FriendlyName(); ok {
+		_spec.SetField(userwebauthn.FieldFriendlyName, field.TypeString, value)
+		_node.FriendlyName = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(userwebauthn.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userwebauthn.UserTable,
+			Columns: []string{userwebauthn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserWebauthnCreateBulk is the builder for creating many UserWebauthn entities in bulk.
+type UserWebauthnCreateBulk struct {
+	config
+	err      error
+	builders []*UserWebauthnCreate
+}
+
+// Save creates the UserWebauthn entities in the database.
+func (_c *UserWebauthnCreateBulk) Save(ctx context.Context) ([]*UserWebauthn, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserWebauthn, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserWebauthnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserWebauthnCreateBulk) SaveX(ctx context.Context) []*UserWebauthn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserWebauthnCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserWebauthnCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
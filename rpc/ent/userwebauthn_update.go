@@ -0,0 +1,1007 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+)
+
+// UserWebauthnUpdate is the builder for updating UserWebauthn entities.
+type UserWebauthnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserWebauthnMutation
+}
+
+// Where appends a list predicates to the UserWebauthnUpdate builder.
+func (_u *UserWebauthnUpdate) Where(ps ...predicate.UserWebauthn) *UserWebauthnUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserWebauthnUpdate) SetUpdatedAt(v time.Time) *UserWebauthnUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *UserWebauthnUpdate) SetState(v bool) *UserWebauthnUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableState(v *bool) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *UserWebauthnUpdate) ClearState() *UserWebauthnUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserWebauthnUpdate) SetUserID(v uuid.UUID) *UserWebauthnUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableUserID(v *uuid.UUID) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *UserWebauthnUpdate) SetCredentialID(v string) *UserWebauthnUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableCredentialID(v *string) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *UserWebauthnUpdate) SetPublicKey(v []byte) *UserWebauthnUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *UserWebauthnUpdate) SetAttestationType(v string) *UserWebauthnUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableAttestationType(v *string) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *UserWebauthnUpdate) ClearAttestationType() *UserWebauthnUpdate {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *UserWebauthnUpdate) SetAaguid(v string) *UserWebauthnUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableAaguid(v *string) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetAaguid(*v)
+	}
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *UserWebauthnUpdate) ClearAaguid() *UserWebauthnUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *UserWebauthnUpdate) SetSignCount(v uint32) *UserWebauthnUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableSignCount(v *uint32) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *UserWebauthnUpdate) AddSignCount(v int32) *UserWebauthnUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetCloneWarning sets the "clone_warning" field.
+func (_u *UserWebauthnUpdate) SetCloneWarning(v bool) *UserWebauthnUpdate {
+	_u.mutation.SetCloneWarning(v)
+	return _u
+}
+
+// SetNillableCloneWarning sets the "clone_warning" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableCloneWarning(v *bool) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetCloneWarning(*v)
+	}
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *UserWebauthnUpdate) SetTransports(v []string) *UserWebauthnUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *UserWebauthnUpdate) AppendTransports(v []string) *UserWebauthnUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *UserWebauthnUpdate) ClearTransports() *UserWebauthnUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetAttachment sets the "attachment" field.
+func (_u *UserWebauthnUpdate) SetAttachment(v string) *UserWebauthnUpdate {
+	_u.mutation.SetAttachment(v)
+	return _u
+}
+
+// SetNillableAttachment sets the "attachment" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableAttachment(v *string) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetAttachment(*v)
+	}
+	return _u
+}
+
+// ClearAttachment clears the value of the "attachment" field.
+func (_u *UserWebauthnUpdate) ClearAttachment() *UserWebauthnUpdate {
+	_u.mutation.ClearAttachment()
+	return _u
+}
+
+// SetUserVerified sets the "user_verified" field.
+func (_u *UserWebauthnUpdate) SetUserVerified(v bool) *UserWebauthnUpdate {
+	_u.mutation.SetUserVerified(v)
+	return _u
+}
+
+// SetNillableUserVerified sets the "user_verified" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableUserVerified(v *bool) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetUserVerified(*v)
+	}
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *UserWebauthnUpdate) SetBackupEligible(v bool) *UserWebauthnUpdate {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableBackupEligible(v *bool) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *UserWebauthnUpdate) SetBackupState(v bool) *UserWebauthnUpdate {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableBackupState(v *bool) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetFriendlyName sets the "friendly_name" field.
+func (_u *UserWebauthnUpdate) SetFriendlyName(v string) *UserWebauthnUpdate {
+	_u.mutation.SetFriendlyName(v)
+	return _u
+}
+
+// SetNillableFriendlyName sets the "friendly_name" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableFriendlyName(v *string) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetFriendlyName(*v)
+	}
+	return _u
+}
+
+// ClearFriendlyName clears the value of the "friendly_name" field.
+func (_u *UserWebauthnUpdate) ClearFriendlyName() *UserWebauthnUpdate {
+	_u.mutation.ClearFriendlyName()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *UserWebauthnUpdate) SetLastUsedAt(v time.Time) *UserWebauthnUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *UserWebauthnUpdate) SetNillableLastUsedAt(v *time.Time) *UserWebauthnUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *UserWebauthnUpdate) ClearLastUsedAt() *UserWebauthnUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserWebauthnUpdate) SetUser(v *User) *UserWebauthnUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserWebauthnMutation object of the builder.
+func (_u *UserWebauthnUpdate) Mutation() *UserWebauthnMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserWebauthnUpdate) ClearUser() *UserWebauthnUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserWebauthnUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserWebauthnUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserWebauthnUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserWebauthnUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserWebauthnUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userwebauthn.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserWebauthnUpdate) check() error {
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := userwebauthn.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := userwebauthn.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Aaguid(); ok {
+		if err := userwebauthn.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.aaguid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attachment(); ok {
+		if err := userwebauthn.AttachmentValidator(v); err != nil {
+			return &ValidationError{Name: "attachment", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attachment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FriendlyName(); ok {
+		if err := userwebauthn.FriendlyNameValidator(v); err != nil {
+			return &ValidationError{Name: "friendly_name", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.friendly_name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserWebauthn.user"`)
+	}
+	return nil
+}
+
+func (_u *UserWebauthnUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userwebauthn.Table, userwebauthn.Columns, sqlgraph.NewFieldSpec(userwebauthn.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userwebauthn.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(userwebauthn.FieldState, field.TypeBool, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(userwebauthn.FieldState, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(userwebauthn.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(userwebauthn.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(userwebauthn.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(userwebauthn.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(userwebauthn.FieldAaguid, field.TypeString, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(userwebauthn.FieldAaguid, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(userwebauthn.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(userwebauthn.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.CloneWarning(); ok {
+		_spec.SetField(userwebauthn.FieldCloneWarning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(userwebauthn.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userwebauthn.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(userwebauthn.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Attachment(); ok {
+		_spec.SetField(userwebauthn.FieldAttachment, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentCleared() {
+		_spec.ClearField(userwebauthn.FieldAttachment, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserVerified(); ok {
+		_spec.SetField(userwebauthn.FieldUserVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(userwebauthn.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(userwebauthn.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FriendlyName(); ok {
+		_spec.SetField(userwebauthn.FieldFriendlyName, field.TypeString, value)
+	}
+	if _u.mutation.FriendlyNameCleared() {
+		_spec.ClearField(userwebauthn.FieldFriendlyName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(userwebauthn.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(userwebauthn.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userwebauthn.UserTable,
+			Columns: []string{userwebauthn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userwebauthn.UserTable,
+			Columns: []string{userwebauthn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userwebauthn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserWebauthnUpdateOne is the builder for updating a single UserWebauthn entity.
+type UserWebauthnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserWebauthnMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserWebauthnUpdateOne) SetUpdatedAt(v time.Time) *UserWebauthnUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *UserWebauthnUpdateOne) SetState(v bool) *UserWebauthnUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableState(v *bool) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *UserWebauthnUpdateOne) ClearState() *UserWebauthnUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserWebauthnUpdateOne) SetUserID(v uuid.UUID) *UserWebauthnUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableUserID(v *uuid.UUID) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *UserWebauthnUpdateOne) SetCredentialID(v string) *UserWebauthnUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetNillableCredentialID sets the "credential_id" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableCredentialID(v *string) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetCredentialID(*v)
+	}
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *UserWebauthnUpdateOne) SetPublicKey(v []byte) *UserWebauthnUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *UserWebauthnUpdateOne) SetAttestationType(v string) *UserWebauthnUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableAttestationType(v *string) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (_u *UserWebauthnUpdateOne) ClearAttestationType() *UserWebauthnUpdateOne {
+	_u.mutation.ClearAttestationType()
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *UserWebauthnUpdateOne) SetAaguid(v string) *UserWebauthnUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableAaguid(v *string) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetAaguid(*v)
+	}
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *UserWebauthnUpdateOne) ClearAaguid() *UserWebauthnUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *UserWebauthnUpdateOne) SetSignCount(v uint32) *UserWebauthnUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableSignCount(v *uint32) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *UserWebauthnUpdateOne) AddSignCount(v int32) *UserWebauthnUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetCloneWarning sets the "clone_warning" field.
+func (_u *UserWebauthnUpdateOne) SetCloneWarning(v bool) *UserWebauthnUpdateOne {
+	_u.mutation.SetCloneWarning(v)
+	return _u
+}
+
+// SetNillableCloneWarning sets the "clone_warning" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableCloneWarning(v *bool) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetCloneWarning(*v)
+	}
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *UserWebauthnUpdateOne) SetTransports(v []string) *UserWebauthnUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *UserWebauthnUpdateOne) AppendTransports(v []string) *UserWebauthnUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *UserWebauthnUpdateOne) ClearTransports() *UserWebauthnUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetAttachment sets the "attachment" field.
+func (_u *UserWebauthnUpdateOne) SetAttachment(v string) *UserWebauthnUpdateOne {
+	_u.mutation.SetAttachment(v)
+	return _u
+}
+
+// SetNillableAttachment sets the "attachment" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableAttachment(v *string) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetAttachment(*v)
+	}
+	return _u
+}
+
+// ClearAttachment clears the value of the "attachment" field.
+func (_u *UserWebauthnUpdateOne) ClearAttachment() *UserWebauthnUpdateOne {
+	_u.mutation.ClearAttachment()
+	return _u
+}
+
+// SetUserVerified sets the "user_verified" field.
+func (_u *UserWebauthnUpdateOne) SetUserVerified(v bool) *UserWebauthnUpdateOne {
+	_u.mutation.SetUserVerified(v)
+	return _u
+}
+
+// SetNillableUserVerified sets the "user_verified" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableUserVerified(v *bool) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetUserVerified(*v)
+	}
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *UserWebauthnUpdateOne) SetBackupEligible(v bool) *UserWebauthnUpdateOne {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableBackupEligible(v *bool) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *UserWebauthnUpdateOne) SetBackupState(v bool) *UserWebauthnUpdateOne {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableBackupState(v *bool) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetFriendlyName sets the "friendly_name" field.
+func (_u *UserWebauthnUpdateOne) SetFriendlyName(v string) *UserWebauthnUpdateOne {
+	_u.mutation.SetFriendlyName(v)
+	return _u
+}
+
+// SetNillableFriendlyName sets the "friendly_name" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableFriendlyName(v *string) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetFriendlyName(*v)
+	}
+	return _u
+}
+
+// ClearFriendlyName clears the value of the "friendly_name" field.
+func (_u *UserWebauthnUpdateOne) ClearFriendlyName() *UserWebauthnUpdateOne {
+	_u.mutation.ClearFriendlyName()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *UserWebauthnUpdateOne) SetLastUsedAt(v time.Time) *UserWebauthnUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *UserWebauthnUpdateOne) SetNillableLastUsedAt(v *time.Time) *UserWebauthnUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *UserWebauthnUpdateOne) ClearLastUsedAt() *UserWebauthnUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserWebauthnUpdateOne) SetUser(v *User) *UserWebauthnUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserWebauthnMutation object of the builder.
+func (_u *UserWebauthnUpdateOne) Mutation() *UserWebauthnMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserWebauthnUpdateOne) ClearUser() *UserWebauthnUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UserWebauthnUpdate builder.
+func (_u *UserWebauthnUpdateOne) Where(ps ...predicate.UserWebauthn) *UserWebauthnUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserWebauthnUpdateOne) Select(field string, fields ...string) *UserWebauthnUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserWebauthn entity.
+func (_u *UserWebauthnUpdateOne) Save(ctx context.Context) (*UserWebauthn, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserWebauthnUpdateOne) SaveX(ctx context.Context) *UserWebauthn {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserWebauthnUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserWebauthnUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserWebauthnUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userwebauthn.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserWebauthnUpdateOne) check() error {
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := userwebauthn.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := userwebauthn.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attestation_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Aaguid(); ok {
+		if err := userwebauthn.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.aaguid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attachment(); ok {
+		if err := userwebauthn.AttachmentValidator(v); err != nil {
+			return &ValidationError{Name: "attachment", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.attachment": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FriendlyName(); ok {
+		if err := userwebauthn.FriendlyNameValidator(v); err != nil {
+			return &ValidationError{Name: "friendly_name", err: fmt.Errorf(`ent: validator failed for field "UserWebauthn.friendly_name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserWebauthn.user"`)
+	}
+	return nil
+}
+
+func (_u *UserWebauthnUpdateOne) sqlSave(ctx context.Context) (_node *UserWebauthn, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userwebauthn.Table, userwebauthn.Columns, sqlgraph.NewFieldSpec(userwebauthn.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserWebauthn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userwebauthn.FieldID)
+		for _, f := range fields {
+			if !userwebauthn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userwebauthn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userwebauthn.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(userwebauthn.FieldState, field.TypeBool, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(userwebauthn.FieldState, field.TypeBool)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(userwebauthn.FieldCredentialID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(userwebauthn.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(userwebauthn.FieldAttestationType, field.TypeString, value)
+	}
+	if _u.mutation.AttestationTypeCleared() {
+		_spec.ClearField(userwebauthn.FieldAttestationType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(userwebauthn.FieldAaguid, field.TypeString, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(userwebauthn.FieldAaguid, field.TypeString)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(userwebauthn.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(userwebauthn.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.CloneWarning(); ok {
+		_spec.SetField(userwebauthn.FieldCloneWarning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(userwebauthn.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, userwebauthn.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(userwebauthn.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Attachment(); ok {
+		_spec.SetField(userwebauthn.FieldAttachment, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentCleared() {
+		_spec.ClearField(userwebauthn.FieldAttachment, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserVerified(); ok {
+		_spec.SetField(userwebauthn.FieldUserVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(userwebauthn.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(userwebauthn.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FriendlyName(); ok {
+		_spec.SetField(userwebauthn.FieldFriendlyName, field.TypeString, value)
+	}
+	if _u.mutation.FriendlyNameCleared() {
+		_spec.ClearField(userwebauthn.FieldFriendlyName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(userwebauthn.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(userwebauthn.FieldLastUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userwebauthn.UserTable,
+			Columns: []string{userwebauthn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userwebauthn.UserTable,
+			Columns: []string{userwebauthn.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserWebauthn{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userwebauthn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,11 +10,11 @@ func TestConfigValidator(t *testing.T) {
 
 	// 初始化测试数据
 	testConfigs := map[string]string{
-		"enable_feature_x": "true",
-		"min_user_level":   "50",
-		"admin_email":      "admin@example.com",
-		"allowed_user_ids": `[100, 201, 305]`,
-		"feature_flags":    `{"beta": true, "max_items": 25, "theme": "dark"}`,
+		"enable_feature_x": "system<>true",
+		"min_user_level":   "system<>50",
+		"admin_email":      "system<>admin@example.com",
+		"allowed_user_ids": `system<>[100, 201, 305]`,
+		"feature_flags":    `system<>{"beta": true, "max_items": 25, "theme": "dark"}`,
 	}
 	cache.SetAll(testConfigs)
 
@@ -140,9 +140,9 @@ func TestConfigValidatorEvaluate(t *testing.T) {
 
 	// 初始化测试数据
 	testConfigs := map[string]string{
-		"min_user_level": "50",
-		"feature_flags":  `{"beta": true, "max_items": 25, "theme": "dark"}`,
-		"allowed_user_ids": `[100, 201, 305]`,
+		"min_user_level": "system<>50",
+		"feature_flags":  `system<>{"beta": true, "max_items": 25, "theme": "dark"}`,
+		"allowed_user_ids": `system<>[100, 201, 305]`,
 	}
 	cache.SetAll(testConfigs)
 
@@ -205,7 +205,7 @@ func TestProgramCache(t *testing.T) {
 	// 创建配置缓存
 	cache := NewConfigurationCache()
 	cache.SetAll(map[string]string{
-		"test_key": "test_value",
+		"test_key": "system<>test_value",
 	})
 
 	// 创建验证器
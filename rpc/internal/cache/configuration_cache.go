@@ -26,6 +26,19 @@ func (c *ConfigurationCache) Get(key string) (string, bool) {
 	return val, ok
 }
 
+// GetValue 获取去除分组前缀后的配置值
+func (c *ConfigurationCache) GetValue(key string) (string, bool) {
+	val, ok := c.Get(key)
+	if !ok {
+		return "", false
+	}
+	_, value, err := splitGroupAndValue(val)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
 // Set 设置配置值
 func (c *ConfigurationCache) Set(key, value string) {
 	c.mu.Lock()
@@ -18,6 +18,30 @@ type Config struct {
 	OAuthStateSecret string                `json:",env=OAUTH_STATE_SECRET"` // OAuth 状态密钥
 	RedisConf        config.RedisConfig
 	CasbinConf       casbin.CasbinConf   `json:",optional"`
+	WebauthnConf     WebauthnConf        // WebAuthn 配置
+	DefaultLocale    string              `json:",default=zh"` // 默认语言，多语言内容的原字段保存该语言的值
+	ArchiveStorage   StorageConf         // 操作记录归档存储
+	FileStorage      StorageConf         // 文件存储
+}
+
+// StorageConf 对象存储配置，不同用途的对象使用不同的 key 前缀，可共用同一存储
+type StorageConf struct {
+	Driver    string `json:",default=local,options=local|s3"` // 存储驱动
+	Path      string `json:",default=./data/storage"`         // 本地存储根目录
+	Endpoint  string `json:",optional"`                       // S3 服务地址，如 127.0.0.1:9000
+	Region    string `json:",optional"`                       // S3 区域
+	Bucket    string `json:",optional"`                       // S3 存储桶
+	AccessKey string `json:",optional"`                       // S3 访问密钥ID
+	SecretKey string `json:",optional"`                       // S3 访问密钥
+	UseSSL    bool   `json:",optional"`                       // 是否使用 HTTPS 访问 S3
+}
+
+// WebauthnConf WebAuthn 依赖方配置
+type WebauthnConf struct {
+	RPID          string   `json:",default=localhost"`              // 依赖方ID，通常为前端域名
+	RPDisplayName string   `json:",optional"`                       // 依赖方显示名称，默认使用服务名
+	RPOrigins     []string `json:",default=[http://localhost:5666]"` // 允许的前端来源
+	Timeout       int64    `json:",default=300"`                    // 仪式超时时间(秒)
 }
 
 // NewNoCacheDriver returns a new driver with no cache.
@@ -25,7 +49,8 @@ func NewNoCacheDriver(c config.DatabaseConfig) *esql.Driver {
 	db, err := sql.Open(c.DBType, c.GetDSN())
 	logx.Must(err)
 
-	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 	err = db.PingContext(ctx)
 	logx.Must(err)
 
@@ -0,0 +1,96 @@
+package apikeyservicelogic
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/apikeyutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// apiKeyFields 创建和更新API密钥时校验后的字段
+type apiKeyFields struct {
+	userID     uuid.UUID
+	roles      []string
+	allowedIPs []string
+	expiresAt  *time.Time
+}
+
+// validateApiKey 校验API密钥的所属用户、角色子集、IP限制及过期时间
+func validateApiKey(ctx context.Context, db *ent.Client, logger logx.Logger, in *core.ApiKeyInfo) (*apiKeyFields, error) {
+	if in.UserId == nil || in.Name == nil || *in.Name == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	userID, err := uuid.Parse(*in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	if len(in.Roles) == 0 {
+		return nil, errorx.NewInvalidArgumentError("apiKey.rolesRequired")
+	}
+	userRoles, err := userutils.EffectiveRoleValues(ctx, db, userID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(logger, err, in)
+	}
+	roles := make([]string, 0, len(in.Roles))
+	for _, code := range in.Roles {
+		if !slices.Contains(userRoles, code) {
+			return nil, errorx.NewInvalidArgumentError("apiKey.roleNotOwned")
+		}
+		if !slices.Contains(roles, code) {
+			roles = append(roles, code)
+		}
+	}
+
+	allowedIPs, ok := apikeyutils.NormalizeIPs(in.AllowedIps)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError("apiKey.invalidIp")
+	}
+
+	fields := &apiKeyFields{userID: userID, roles: roles, allowedIPs: allowedIPs}
+	if pointer.GetInt64(in.ExpiresAt) > 0 {
+		expiresAt := time.UnixMilli(*in.ExpiresAt)
+		if !expiresAt.After(time.Now()) {
+			return nil, errorx.NewInvalidArgumentError("apiKey.invalidExpiry")
+		}
+		fields.expiresAt = &expiresAt
+	}
+	return fields, nil
+}
+
+// ConvertApiKeyToApiKeyInfo 将 ApiKey 实体转换为 ApiKeyInfo，不包含密钥哈希
+func ConvertApiKeyToApiKeyInfo(apiKey *ent.ApiKey) *core.ApiKeyInfo {
+	info := &core.ApiKeyInfo{
+		Id:          pointer.ToUint32Ptr(apiKey.ID),
+		CreatedAt:   pointer.ToInt64Ptr(apiKey.CreatedAt.UnixMilli()),
+		UpdatedAt:   pointer.ToInt64Ptr(apiKey.UpdatedAt.UnixMilli()),
+		State:       pointer.ToBoolPtr(apiKey.State),
+		UserId:      pointer.ToStringPtr(apiKey.UserID.String()),
+		Name:        pointer.ToStringPtr(apiKey.Name),
+		KeyPrefix:   pointer.ToStringPtr(apiKey.KeyPrefix),
+		Roles:       apiKey.Roles,
+		AllowedIps:  apiKey.AllowedIps,
+		LastUsedIp:  pointer.ToStringPtrIfNotEmpty(apiKey.LastUsedIP),
+		Description: pointer.ToStringPtrIfNotEmpty(apiKey.Description),
+	}
+	if apiKey.ExpiresAt != nil {
+		info.ExpiresAt = pointer.ToInt64Ptr(apiKey.ExpiresAt.UnixMilli())
+	}
+	if apiKey.LastUsedAt != nil {
+		info.LastUsedAt = pointer.ToInt64Ptr(apiKey.LastUsedAt.UnixMilli())
+	}
+	if apiKey.Edges.User != nil {
+		info.Username = pointer.ToStringPtr(apiKey.Edges.User.Username)
+	}
+	return info
+}
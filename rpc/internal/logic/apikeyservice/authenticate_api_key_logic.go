@@ -0,0 +1,96 @@
+package apikeyservicelogic
+
+import (
+	"context"
+
+	"slices"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/apikeyutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthenticateApiKeyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthenticateApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthenticateApiKeyLogic {
+	return &AuthenticateApiKeyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// lastUsedInterval 最后使用时间的更新间隔，避免每次请求都写库
+const lastUsedInterval = time.Minute
+
+// 校验API密钥并记录使用情况
+func (l *AuthenticateApiKeyLogic) AuthenticateApiKey(in *core.ApiKeyAuthRequest) (*core.ApiKeyAuthResponse, error) {
+	apiKey, err := l.svcCtx.DBEnt.ApiKey.Query().
+		Where(apikey.KeyHashEQ(apikeyutils.HashKey(in.Key))).
+		WithUser().
+		Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorx.NewUnauthenticatedError("apiKey.invalid")
+		}
+		return nil, errorhandler.DBEntError(l.Logger, err, nil)
+	}
+
+	now := time.Now()
+	switch {
+	case !apiKey.State, apiKey.Edges.User == nil, !apiKey.Edges.User.State:
+		return nil, errorx.NewUnauthenticatedError("apiKey.invalid")
+	case apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(now):
+		return nil, errorx.NewUnauthenticatedError("apiKey.expired")
+	case !apikeyutils.IPAllowed(in.IpAddress, apiKey.AllowedIps):
+		return nil, errorx.NewPermissionDeniedError("apiKey.ipNotAllowed")
+	}
+
+	// 生效角色为密钥授权角色与用户当前角色的交集，用户失去的角色密钥同样失去
+	userRoles, err := userutils.EffectiveRoleValues(l.ctx, l.svcCtx.DBEnt, apiKey.UserID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, nil)
+	}
+	roles := make([]string, 0, len(apiKey.Roles))
+	for _, code := range apiKey.Roles {
+		if slices.Contains(userRoles, code) {
+			roles = append(roles, code)
+		}
+	}
+	if len(roles) == 0 {
+		return nil, errorx.NewPermissionDeniedError("apiKey.noRoles")
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedInterval || apiKey.LastUsedIP != in.IpAddress {
+		err = l.svcCtx.DBEnt.ApiKey.UpdateOneID(apiKey.ID).
+			SetLastUsedAt(now).
+			SetLastUsedIP(in.IpAddress).
+			Exec(l.ctx)
+		if err != nil {
+			l.Errorw("更新API密钥使用时间失败", logx.Field("detail", err.Error()))
+		}
+	}
+
+	resp := &core.ApiKeyAuthResponse{
+		Id:     apiKey.ID,
+		UserId: apiKey.UserID.String(),
+		Roles:  roles,
+	}
+	if apiKey.Edges.User.DepartmentID != 0 {
+		resp.DepartmentId = &apiKey.Edges.User.DepartmentID
+	}
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package apikeyservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/apikeyutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateApiKeyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateApiKeyLogic {
+	return &CreateApiKeyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建API密钥
+func (l *CreateApiKeyLogic) CreateApiKey(in *core.ApiKeyInfo) (*core.CreateApiKeyResponse, error) {
+	fields, err := validateApiKey(l.ctx, l.svcCtx.DBEnt, l.Logger, in)
+	if err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := apikeyutils.GenerateKey()
+	if err != nil {
+		l.Errorw("生成API密钥失败", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("apiKey.generateFailed")
+	}
+
+	apiKey, err := l.svcCtx.DBEnt.ApiKey.Create().
+		SetUserID(fields.userID).
+		SetName(*in.Name).
+		SetKeyPrefix(prefix).
+		SetKeyHash(hash).
+		SetRoles(fields.roles).
+		SetAllowedIps(fields.allowedIPs).
+		SetNillableExpiresAt(fields.expiresAt).
+		SetNillableDescription(in.Description).
+		SetState(in.State == nil || *in.State).
+		Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.CreateApiKeyResponse{
+		Info: ConvertApiKeyToApiKeyInfo(apiKey),
+		Key:  key,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package apikeyservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteApiKeyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteApiKeyLogic {
+	return &DeleteApiKeyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除API密钥
+func (l *DeleteApiKeyLogic) DeleteApiKey(in *core.ApiKeyDeleteRequest) (*core.BaseResponse, error) {
+	del := l.svcCtx.DBEnt.ApiKey.Delete().Where(apikey.IDEQ(in.Id))
+	if in.UserId != nil {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		del = del.Where(apikey.UserIDEQ(userID))
+	}
+
+	affected, err := del.Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if affected == 0 {
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	}
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
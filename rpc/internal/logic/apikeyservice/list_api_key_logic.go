@@ -0,0 +1,75 @@
+package apikeyservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListApiKeyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListApiKeyLogic {
+	return &ListApiKeyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取API密钥列表
+func (l *ListApiKeyLogic) ListApiKey(in *core.ApiKeyListRequest) (*core.ApiKeyListResponse, error) {
+	var predicates []predicate.ApiKey
+	if in.UserId != nil && *in.UserId != "" {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		predicates = append(predicates, apikey.UserIDEQ(userID))
+	}
+	if in.Name != nil && *in.Name != "" {
+		predicates = append(predicates, apikey.NameContains(*in.Name))
+	}
+
+	query, err := l.svcCtx.DBEnt.ApiKey.Query().
+		WithUser().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), apikey.ByCreatedAt(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	apiKeys, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.ApiKeyInfo, 0, len(apiKeys.List))
+	for _, apiKey := range apiKeys.List {
+		list = append(list, ConvertApiKeyToApiKeyInfo(apiKey))
+	}
+
+	return &core.ApiKeyListResponse{
+		Page: &core.BasePageResp{
+			Total:      apiKeys.PageDetails.Total,
+			PageNumber: apiKeys.PageDetails.Page,
+			PageSize:   apiKeys.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
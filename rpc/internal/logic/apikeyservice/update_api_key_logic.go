@@ -0,0 +1,78 @@
+package apikeyservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent/apikey"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateApiKeyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateApiKeyLogic {
+	return &UpdateApiKeyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 更新API密钥，指定用户ID时只能更新该用户的密钥
+func (l *UpdateApiKeyLogic) UpdateApiKey(in *core.ApiKeyInfo) (*core.ApiKeyInfo, error) {
+	if in.Id == nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	// 所属用户不可修改，以数据库中的记录为准校验角色子集
+	query := l.svcCtx.DBEnt.ApiKey.Query().Where(apikey.IDEQ(*in.Id))
+	if in.UserId != nil {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		query = query.Where(apikey.UserIDEQ(userID))
+	}
+	current, err := query.Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	in.UserId = pointer.ToStringPtr(current.UserID.String())
+
+	fields, err := validateApiKey(l.ctx, l.svcCtx.DBEnt, l.Logger, in)
+	if err != nil {
+		return nil, err
+	}
+
+	update := l.svcCtx.DBEnt.ApiKey.UpdateOneID(current.ID).
+		SetName(*in.Name).
+		SetRoles(fields.roles).
+		SetAllowedIps(fields.allowedIPs).
+		SetDescription(pointer.GetString(in.Description))
+	if fields.expiresAt != nil {
+		update.SetExpiresAt(*fields.expiresAt)
+	} else {
+		update.ClearExpiresAt()
+	}
+	if in.State != nil {
+		update.SetState(*in.State)
+	}
+
+	apiKey, err := update.Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return ConvertApiKeyToApiKeyInfo(apiKey), nil
+}
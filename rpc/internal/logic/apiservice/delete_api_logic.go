@@ -39,6 +39,6 @@ func (l *DeleteApiLogic) DeleteApi(in *core.ID32SRequest) (*core.BaseResponse, e
 	}
 
 	return &core.BaseResponse{
-		Message: "删除成功",
+		Message: "common.deleteSuccess",
 	}, nil
 }
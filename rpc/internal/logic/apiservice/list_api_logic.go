@@ -9,6 +9,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -58,7 +59,12 @@ func (l *ListApiLogic) ListApi(in *core.ApiListRequest) (*core.ApiListResponse,
 	}
 
 	// 执行分页查询
-	query := l.svcCtx.DBEnt.API.Query().Where(predicates...)
+	query, err := l.svcCtx.DBEnt.API.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
 
 	// 获取总数
 	total, err := query.Count(l.ctx)
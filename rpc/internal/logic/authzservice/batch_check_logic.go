@@ -0,0 +1,50 @@
+package authzservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchCheckLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchCheckLogic {
+	return &BatchCheckLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量检查主体是否有权访问资源
+func (l *BatchCheckLogic) BatchCheck(in *core.AuthzBatchCheckRequest) (*core.AuthzBatchCheckResponse, error) {
+	for _, r := range in.Resources {
+		if !validResource(r) {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+	}
+	roles, err := subjectRoles(l.ctx, l.svcCtx, l.Logger, in.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]bool, len(in.Resources))
+	for i, r := range in.Resources {
+		results[i], _, err = policyutils.Authorize(l.svcCtx.Casbin, roles, r.Domain, r.Object, r.Action)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	return &core.AuthzBatchCheckResponse{Results: results}, nil
+}
@@ -0,0 +1,50 @@
+package authzservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckLogic {
+	return &CheckLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 检查主体是否有权访问资源
+func (l *CheckLogic) Check(in *core.AuthzCheckRequest) (*core.AuthzCheckResponse, error) {
+	if !validResource(in.Resource) {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	roles, err := subjectRoles(l.ctx, l.svcCtx, l.Logger, in.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, grantedBy, err := policyutils.Authorize(l.svcCtx.Casbin, roles, in.Resource.Domain, in.Resource.Object, in.Resource.Action)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	resp := &core.AuthzCheckResponse{Allowed: allowed}
+	if grantedBy != "" {
+		resp.GrantedBy = pointer.ToStringPtr(grantedBy)
+	}
+	return resp, nil
+}
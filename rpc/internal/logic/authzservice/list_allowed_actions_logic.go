@@ -0,0 +1,46 @@
+package authzservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAllowedActionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAllowedActionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAllowedActionsLogic {
+	return &ListAllowedActionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取主体在指定域下允许的资源及操作
+func (l *ListAllowedActionsLogic) ListAllowedActions(in *core.AuthzListActionsRequest) (*core.AuthzListActionsResponse, error) {
+	if in.Domain == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	roles, err := subjectRoles(l.ctx, l.svcCtx, l.Logger, in.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := policyutils.AllowedActions(l.svcCtx.Casbin, roles, in.Domain, pointer.GetString(in.Object))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.AuthzListActionsResponse{List: list}, nil
+}
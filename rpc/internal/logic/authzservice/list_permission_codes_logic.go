@@ -0,0 +1,71 @@
+package authzservicelogic
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPermissionCodesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPermissionCodesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPermissionCodesLogic {
+	return &ListPermissionCodesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户的权限标识
+func (l *ListPermissionCodesLogic) ListPermissionCodes(in *core.UUIDRequest) (*core.AuthzPermissionCodeResponse, error) {
+	userID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	roles, err := userutils.EffectiveRoles(l.ctx, l.svcCtx.DBEnt, userID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	resp := &core.AuthzPermissionCodeResponse{RoleValues: userutils.RoleValues(roles), Codes: []string{}}
+	if len(roles) == 0 {
+		return resp, nil
+	}
+
+	roleIds := make([]uint32, len(roles))
+	for i, r := range roles {
+		roleIds[i] = r.RoleId
+	}
+	menus, err := l.svcCtx.DBEnt.Menu.Query().
+		Where(menu.StateEQ(true), menu.PermissionNotNil(), menu.PermissionNEQ(""), menu.HasRolesWith(role.IDIn(roleIds...))).
+		Select(menu.FieldPermission).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	seen := make(map[string]struct{}, len(menus))
+	for _, m := range menus {
+		if _, ok := seen[*m.Permission]; ok {
+			continue
+		}
+		seen[*m.Permission] = struct{}{}
+		resp.Codes = append(resp.Codes, *m.Permission)
+	}
+	sort.Strings(resp.Codes)
+	return resp, nil
+}
@@ -0,0 +1,53 @@
+package authzservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// subjectRoles 解析鉴权主体的角色，指定用户时使用其当前有效角色，用户不存在时没有角色，否则使用给定的角色值
+func subjectRoles(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, subject *core.AuthzSubject) ([]string, error) {
+	if subject == nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if userId := pointer.GetString(subject.UserId); userId != "" {
+		userID, err := uuid.Parse(userId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		roles, err := userutils.EffectiveRoleValues(ctx, svcCtx.DBEnt, userID)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errorhandler.DBEntError(logger, err, subject)
+		}
+		return roles, nil
+	}
+
+	roles := make([]string, 0, len(subject.RoleValues))
+	seen := make(map[string]struct{}, len(subject.RoleValues))
+	for _, v := range subject.RoleValues {
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		roles = append(roles, v)
+	}
+	return roles, nil
+}
+
+func validResource(r *core.AuthzResource) bool {
+	return r != nil && r.Domain != "" && r.Object != "" && r.Action != ""
+}
@@ -0,0 +1,31 @@
+package authzservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func TestSubjectRolesMissingOrDisabledUser(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	admin := svcCtx.DBEnt.Role.Create().SetRoleName("管理员").SetRoleCode("admin").SaveX(ctx)
+	disabled := svcCtx.DBEnt.User.Create().
+		SetUsername("bob").
+		SetPasswordHash("x").
+		SetState(false).
+		AddRoles(admin).
+		SaveX(ctx)
+
+	for _, id := range []string{uuid.NewString(), disabled.ID.String()} {
+		roles, err := subjectRoles(ctx, svcCtx, logx.WithContext(ctx), &core.AuthzSubject{UserId: pointer.ToStringPtr(id)})
+		if err != nil || len(roles) != 0 {
+			t.Fatalf("user %s must have no roles, got %v %v", id, roles, err)
+		}
+	}
+}
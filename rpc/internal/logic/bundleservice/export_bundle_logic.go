@@ -0,0 +1,41 @@
+package bundleservicelogic
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/bundleutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportBundleLogic {
+	return &ExportBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出角色、菜单、API、配置项及角色策略
+func (l *ExportBundleLogic) ExportBundle(in *core.BundleExportRequest) (*core.BundleData, error) {
+	b, err := bundleutils.Export(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, in.ExcludeSecrets)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.BundleData{Content: content}, nil
+}
@@ -0,0 +1,92 @@
+package bundleservicelogic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/bundleutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportBundleLogic {
+	return &ImportBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 预览或导入配置包
+func (l *ImportBundleLogic) ImportBundle(in *core.BundleImportRequest) (*core.BundleImportResponse, error) {
+	switch in.Strategy {
+	case bundleutils.StrategySkip, bundleutils.StrategyOverwrite, bundleutils.StrategyFail:
+	default:
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	b, err := bundleutils.Parse(in.Content)
+	if err != nil {
+		return nil, l.bundleError(err)
+	}
+	plan, err := bundleutils.NewPlan(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, b, in.Strategy, in.ExcludeSecrets)
+	if err != nil {
+		return nil, l.bundleError(err)
+	}
+
+	resp := &core.BundleImportResponse{
+		Changes:   plan.Changes,
+		Created:   plan.Count(bundleutils.ActionCreate),
+		Updated:   plan.Count(bundleutils.ActionUpdate),
+		Skipped:   plan.Count(bundleutils.ActionSkip),
+		Unchanged: plan.Count(bundleutils.ActionUnchanged),
+		Conflicts: plan.Count(bundleutils.ActionConflict),
+		DryRun:    in.DryRun,
+	}
+	if in.DryRun {
+		return resp, nil
+	}
+	if resp.Conflicts > 0 {
+		return nil, l.bundleError(fmt.Errorf("%w: %d items", bundleutils.ErrConflict, resp.Conflicts))
+	}
+
+	if err = bundleutils.Apply(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, plan); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in.Strategy)
+	}
+
+	for _, c := range b.Configurations {
+		action := plan.Action(bundleutils.KindConfiguration, c.Key)
+		if action == bundleutils.ActionCreate || action == bundleutils.ActionUpdate {
+			l.svcCtx.ConfigurationCache.Set(c.Key, fmt.Sprintf("%s<>%s", c.Group, c.Value))
+		}
+	}
+	return resp, nil
+}
+
+// bundleError 将配置包校验错误转换为对应的 i18n 错误
+func (l *ImportBundleLogic) bundleError(err error) error {
+	switch {
+	case errors.Is(err, bundleutils.ErrConflict):
+		l.Errorw("bundle import aborted", logx.Field("detail", err.Error()))
+		return errorx.NewFailedPreconditionError(bundleutils.ErrConflict.Error())
+	case errors.Is(err, bundleutils.ErrInvalid),
+		errors.Is(err, bundleutils.ErrUnsupportedVersion),
+		errors.Is(err, bundleutils.ErrMissingReference):
+		l.Errorw("invalid bundle", logx.Field("detail", err.Error()))
+		return errorx.NewInvalidArgumentError(errors.Unwrap(err).Error())
+	default:
+		return errorhandler.DBEntError(l.Logger, err, nil)
+	}
+}
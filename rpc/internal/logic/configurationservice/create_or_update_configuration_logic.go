@@ -2,6 +2,7 @@ package configurationservicelogic
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
@@ -31,7 +32,7 @@ func NewCreateOrUpdateConfigurationLogic(ctx context.Context, svcCtx *svc.Servic
 func (l *CreateOrUpdateConfigurationLogic) CreateOrUpdateConfiguration(in *core.ConfigurationInfo) (*core.ConfigurationInfo, error) {
 	// 验证必填字段
 	if in.Key == "" || in.Value == "" || in.Name == "" || in.Group == "" {
-		return nil, errorx.NewInvalidArgumentError("key、value、name、group 不能为空")
+		return nil, errorx.NewInvalidArgumentError("common.configuration.requiredFields")
 	}
 
 	// 检查写权限
@@ -65,7 +66,7 @@ func (l *CreateOrUpdateConfigurationLogic) CreateOrUpdateConfiguration(in *core.
 		}
 
 		// 更新缓存
-		l.svcCtx.ConfigurationCache.Set(updatedConfig.Key, updatedConfig.Value)
+		l.svcCtx.ConfigurationCache.Set(updatedConfig.Key, fmt.Sprintf("%s<>%s", updatedConfig.Group, updatedConfig.Value))
 
 		result = ConvertConfigurationToConfigurationInfo(updatedConfig)
 	} else {
@@ -82,7 +83,7 @@ func (l *CreateOrUpdateConfigurationLogic) CreateOrUpdateConfiguration(in *core.
 		}
 
 		// 添加到缓存
-		l.svcCtx.ConfigurationCache.Set(newConfig.Key, newConfig.Value)
+		l.svcCtx.ConfigurationCache.Set(newConfig.Key, fmt.Sprintf("%s<>%s", newConfig.Group, newConfig.Value))
 
 		result = ConvertConfigurationToConfigurationInfo(newConfig)
 	}
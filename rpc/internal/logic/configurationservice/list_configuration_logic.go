@@ -8,6 +8,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -91,10 +92,14 @@ func (l *ListConfigurationLogic) ListConfiguration(in *core.ConfigurationListReq
 	}
 
 	// 执行分页查询
-	page, err := l.svcCtx.DBEnt.Configuration.Query().
+	query, err := l.svcCtx.DBEnt.Configuration.Query().
 		Where(predicates...).
-		Order(configuration.ByID(sql.OrderDesc())).
-		Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+		ApplyOptions(pageutils.QueryOptions(in.Page), configuration.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
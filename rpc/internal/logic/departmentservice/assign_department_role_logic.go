@@ -0,0 +1,36 @@
+package departmentservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignDepartmentRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignDepartmentRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignDepartmentRoleLogic {
+	return &AssignDepartmentRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 为部门分配角色，部门成员自动获得这些角色
+func (l *AssignDepartmentRoleLogic) AssignDepartmentRole(in *core.DepartmentRoleRequest) (*core.BaseResponse, error) {
+	err := l.svcCtx.DBEnt.Department.UpdateOneID(in.DepartmentId).ClearRoles().AddRoleIDs(in.RoleIds...).Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.BaseResponse{
+		Message: "success",
+	}, nil
+}
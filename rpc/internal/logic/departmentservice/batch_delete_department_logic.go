@@ -0,0 +1,87 @@
+package departmentservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteDepartmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteDepartmentLogic {
+	return &BatchDeleteDepartmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除部门，存在子部门或成员的部门跳过
+func (l *BatchDeleteDepartmentLogic) BatchDeleteDepartment(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.Department.Query().Where(department.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	// 仍有成员的部门需先调整成员所属部门
+	withUsers, err := tx.Department.Query().
+		Where(department.IDIn(result.Pending()...), department.HasUsers()).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	for _, id := range withUsers {
+		result.Fail(id, "department.hasMembers")
+	}
+
+	// 子部门须在同一批次中一并删除，子部门跳过时其上级部门同样跳过
+	children, err := tx.Department.Query().
+		Where(department.ParentIDIn(result.Pending()...)).
+		Select(department.FieldID, department.FieldParentID).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, child := range children {
+			if parentID := *child.ParentID; result.Passed(parentID) && !result.Passed(child.ID) {
+				result.Fail(parentID, "department.hasChildren")
+				changed = true
+			}
+		}
+	}
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if _, err = tx.Department.Delete().Where(department.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
@@ -0,0 +1,58 @@
+package departmentservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateDepartmentStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchUpdateDepartmentStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchUpdateDepartmentStateLogic {
+	return &BatchUpdateDepartmentStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量启用或禁用部门
+func (l *BatchUpdateDepartmentStateLogic) BatchUpdateDepartmentState(in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.Department.Query().Where(department.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if err = tx.Department.Update().Where(department.IDIn(pending...)).SetState(in.State).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
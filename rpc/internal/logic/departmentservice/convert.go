@@ -1,23 +1,28 @@
 package departmentservicelogic
 
 import (
+	"context"
+
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 )
 
 // ConvertDepartmentToDepartmentInfo 将 Department 实体转换为 DepartmentInfo
-func ConvertDepartmentToDepartmentInfo(dept *ent.Department) *core.DepartmentInfo {
+func ConvertDepartmentToDepartmentInfo(dept *ent.Department, defaultLocale string) *core.DepartmentInfo {
 	info := core.DepartmentInfo{
-		Id:          &dept.ID,
-		CreatedAt:   pointer.ToInt64Ptr(dept.CreatedAt.UnixMilli()),
-		UpdatedAt:   pointer.ToInt64Ptr(dept.UpdatedAt.UnixMilli()),
-		DeptName:    &dept.DeptName,
-		DeptCode:    &dept.DeptCode,
-		ParentId:    dept.ParentID,
-		SortOrder:   &dept.Sort,
-		State:       &dept.State,
-		Description: dept.Description,
+		Id:           &dept.ID,
+		CreatedAt:    pointer.ToInt64Ptr(dept.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointer.ToInt64Ptr(dept.UpdatedAt.UnixMilli()),
+		DeptName:     &dept.DeptName,
+		DeptCode:     &dept.DeptCode,
+		ParentId:     dept.ParentID,
+		SortOrder:    &dept.Sort,
+		State:        &dept.State,
+		Description:  dept.Description,
+		DeptNameI18N: i18nutils.Expand(defaultLocale, dept.DeptName, dept.DeptNameI18n),
+		InheritRoles: pointer.ToBoolPtr(dept.InheritRoles),
 	}
 
 	if dept.LeaderUserID != nil {
@@ -30,5 +35,15 @@ func ConvertDepartmentToDepartmentInfo(dept *ent.Department) *core.DepartmentInf
 		info.LaderEmail = pointer.ToStringPtrIfNotEmpty(dept.Edges.Leader.Email)
 	}
 
+	for _, r := range dept.Edges.Roles {
+		info.RoleIds = append(info.RoleIds, r.ID)
+	}
+
 	return &info
 }
+
+// LocalizeDepartmentInfo 按上下文语言本地化部门名称，用于部门列表
+func LocalizeDepartmentInfo(ctx context.Context, info *core.DepartmentInfo) *core.DepartmentInfo {
+	info.DeptName = pointer.ToStringPtr(i18nutils.Localize(ctx, info.GetDeptName(), info.DeptNameI18N))
+	return info
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -48,6 +49,13 @@ func (l *CreateOrUpdateDepartmentLogic) CreateOrUpdateDepartment(in *core.Depart
 
 	defer tx.Rollback()
 
+	// 拆分多语言部门名称，默认语言的值写回部门名称
+	deptName, deptNameI18n, ok := i18nutils.Prepare(l.svcCtx.Config.DefaultLocale, in.DeptName, in.DeptNameI18N)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	in.DeptName = deptName
+
 	var department *ent.Department
 
 	if in.Id != nil && *in.Id != 0 {
@@ -62,6 +70,9 @@ func (l *CreateOrUpdateDepartmentLogic) CreateOrUpdateDepartment(in *core.Depart
 		if in.DeptName != nil {
 			updateQuery.SetDeptName(*in.DeptName)
 		}
+		if in.DeptNameI18N != nil {
+			updateQuery.SetDeptNameI18n(deptNameI18n)
+		}
 		if in.DeptCode != nil {
 			updateQuery.SetDeptCode(*in.DeptCode)
 		}
@@ -80,6 +91,9 @@ func (l *CreateOrUpdateDepartmentLogic) CreateOrUpdateDepartment(in *core.Depart
 		if in.Description != nil {
 			updateQuery.SetNillableDescription(in.Description)
 		}
+		if in.InheritRoles != nil {
+			updateQuery.SetNillableInheritRoles(in.InheritRoles)
+		}
 
 		department, err = updateQuery.Save(l.ctx)
 	} else {
@@ -90,12 +104,14 @@ func (l *CreateOrUpdateDepartmentLogic) CreateOrUpdateDepartment(in *core.Depart
 
 		createQuery := tx.Department.Create().
 			SetDeptName(*in.DeptName).
+			SetDeptNameI18n(deptNameI18n).
 			SetDeptCode(*in.DeptCode).
 			SetNillableParentID(in.ParentId).
 			SetNillableSort(in.SortOrder).
 			SetNillableLeaderUserID(&leaderUserID).
 			SetState(pointer.GetBool(in.State)).
-			SetNillableDescription(in.Description)
+			SetNillableDescription(in.Description).
+			SetNillableInheritRoles(in.InheritRoles)
 
 		department, err = createQuery.Save(l.ctx)
 	}
@@ -141,6 +157,8 @@ func (l *CreateOrUpdateDepartmentLogic) convertDepartmentToDepartmentInfo(dept *
 		LeaderUserId: pointer.ToStringPtrIfNotEmpty(dept.LeaderUserID.String()),
 		State:        pointer.ToBoolPtr(dept.State),
 		Description:  dept.Description,
+		DeptNameI18N: i18nutils.Expand(l.svcCtx.Config.DefaultLocale, dept.DeptName, dept.DeptNameI18n),
+		InheritRoles: pointer.ToBoolPtr(dept.InheritRoles),
 	}
 }
 
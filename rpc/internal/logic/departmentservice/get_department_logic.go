@@ -28,10 +28,10 @@ func NewGetDepartmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Get
 
 // 获取部门
 func (l *GetDepartmentLogic) GetDepartment(in *core.ID32Request) (*core.DepartmentInfo, error) {
-	dept, err := l.svcCtx.DBEnt.Department.Query().WithLeader().Where(department.ID(in.Id)).Only(l.ctx)
+	dept, err := l.svcCtx.DBEnt.Department.Query().WithLeader().WithRoles().Where(department.ID(in.Id)).Only(l.ctx)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	return ConvertDepartmentToDepartmentInfo(dept), nil
+	return ConvertDepartmentToDepartmentInfo(dept, l.svcCtx.Config.DefaultLocale), nil
 }
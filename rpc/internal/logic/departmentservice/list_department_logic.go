@@ -7,6 +7,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/department"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -43,7 +44,13 @@ func (l *ListDepartmentLogic) ListDepartment(in *core.DepartmentListRequest) (*c
 		predicates = append(predicates, department.DeptCodeContains(*in.DeptCode))
 	}
 
-	page, err := l.svcCtx.DBEnt.Department.Query().WithLeader().Where(predicates...).Order(department.BySort(sql.OrderDesc())).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.Department.Query().WithLeader().WithRoles().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), department.BySort(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -56,7 +63,7 @@ func (l *ListDepartmentLogic) ListDepartment(in *core.DepartmentListRequest) (*c
 	}
 
 	for _, dept := range page.List {
-		resp.List = append(resp.List, ConvertDepartmentToDepartmentInfo(dept))
+		resp.List = append(resp.List, LocalizeDepartmentInfo(l.ctx, ConvertDepartmentToDepartmentInfo(dept, l.svcCtx.Config.DefaultLocale)))
 	}
 
 	return resp, nil
@@ -0,0 +1,70 @@
+package dictservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteDictLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteDictLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteDictLogic {
+	return &BatchDeleteDictLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除字典，存在字典项的字典跳过
+func (l *BatchDeleteDictLogic) BatchDeleteDict(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.DictType.Query().Where(dicttype.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	// 存在字典项的字典需先删除字典项
+	withItems, err := tx.DictType.Query().
+		Where(dicttype.IDIn(result.Pending()...), dicttype.HasDictItems()).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	for _, id := range withItems {
+		result.Fail(id, "dict.hasItems")
+	}
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if _, err = tx.DictType.Delete().Where(dicttype.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
+
+	return result.Response(), nil
+}
@@ -0,0 +1,61 @@
+package dictservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxBatchDictCodes 批量获取字典的最大编码数量
+const maxBatchDictCodes = 50
+
+type BatchGetDictByCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchGetDictByCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchGetDictByCodeLogic {
+	return &BatchGetDictByCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 根据编码批量获取字典及启用的子项
+func (l *BatchGetDictByCodeLogic) BatchGetDictByCode(in *core.DictCodesRequest) (*core.DictDataListResponse, error) {
+	// 去重并保持请求顺序
+	codes := make([]string, 0, len(in.Codes))
+	seen := make(map[string]bool, len(in.Codes))
+	for _, code := range in.Codes {
+		if code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 || len(codes) > maxBatchDictCodes {
+		return nil, errorx.NewInvalidArgumentError("dict.codesLimit")
+	}
+
+	dicts, err := loadDictData(l.ctx, l.svcCtx, l.Logger, codes)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	resp := &core.DictDataListResponse{List: make([]*core.DictData, 0, len(codes))}
+	for _, code := range codes {
+		data, ok := dicts[code]
+		if !ok || (in.PublicOnly && !data.IsPublic) {
+			continue
+		}
+		resp.List = append(resp.List, data)
+	}
+	return resp, nil
+}
@@ -0,0 +1,59 @@
+package dictservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateDictStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchUpdateDictStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchUpdateDictStateLogic {
+	return &BatchUpdateDictStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量启用或禁用字典
+func (l *BatchUpdateDictStateLogic) BatchUpdateDictState(in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.DictType.Query().Where(dicttype.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if err = tx.DictType.Update().Where(dicttype.IDIn(pending...)).SetState(in.State).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
+
+	return result.Response(), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -31,6 +32,13 @@ func NewCreateOrUpdateDictItemLogic(ctx context.Context, svcCtx *svc.ServiceCont
 
 // 创建或更新字典子项
 func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(in *core.DictItemInfo) (*core.DictItemInfo, error) {
+	// 拆分多语言标签，默认语言的值写回标签
+	label, labelI18n, ok := i18nutils.Prepare(l.svcCtx.Config.DefaultLocale, in.Label, in.LabelI18N)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	in.Label = label
+
 	// 开启事务，并先进行检查是否存在，如果存在则进行更新否则进行创建
 	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
 	if err != nil {
@@ -50,6 +58,7 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(in *core.DictItemIn
 		}
 		dictItem, err = tx.DictItem.Create().
 			SetItemLabel(pointer.GetString(in.Label)).
+			SetItemLabelI18n(labelI18n).
 			SetItemValue(pointer.GetString(in.Value)).
 			SetNillableItemColor(in.Color).
 			SetNillableItemCSS(in.Css).
@@ -68,15 +77,18 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(in *core.DictItemIn
 			sortOrder := int(*in.SortOrder)
 			sortOrderPtr = &sortOrder
 		}
-		dictItem, err = tx.DictItem.UpdateOneID(pointer.GetUint32(in.Id)).
+		updateQuery := tx.DictItem.UpdateOneID(pointer.GetUint32(in.Id)).
 			SetNillableItemLabel(in.Label).
 			SetNillableItemValue(in.Value).
 			SetNillableItemColor(in.Color).
 			SetNillableItemCSS(in.Css).
 			SetNillableSortOrder(sortOrderPtr).
 			SetNillableDescription(in.Description).
-			SetNillableState(in.State).
-			Save(l.ctx)
+			SetNillableState(in.State)
+		if in.LabelI18N != nil {
+			updateQuery.SetItemLabelI18n(labelI18n)
+		}
+		dictItem, err = updateQuery.Save(l.ctx)
 	}
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
@@ -85,6 +97,7 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(in *core.DictItemIn
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
 
 	sortOrder := int32(dictItem.SortOrder)
 	return &core.DictItemInfo{
@@ -98,6 +111,7 @@ func (l *CreateOrUpdateDictItemLogic) CreateOrUpdateDictItem(in *core.DictItemIn
 		SortOrder:   &sortOrder,
 		Description: dictItem.Description,
 		State:       &dictItem.State,
+		LabelI18N:   i18nutils.Expand(l.svcCtx.Config.DefaultLocale, dictItem.ItemLabel, dictItem.ItemLabelI18n),
 	}, nil
 }
 
@@ -48,6 +48,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(in *core.DictInfo) (*core.D
 			SetDictTypeCode(pointer.GetString(in.Code)).
 			SetDescription(pointer.GetString(in.Description)).
 			SetState(pointer.GetBool(in.State)).
+			SetIsPublic(pointer.GetBool(in.IsPublic)).
 			Save(l.ctx)
 	} else {
 		// 更新
@@ -59,6 +60,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(in *core.DictInfo) (*core.D
 			SetNillableDictTypeCode(in.Code).
 			SetNillableDescription(in.Description).
 			SetNillableState(in.State).
+			SetNillableIsPublic(in.IsPublic).
 			Save(l.ctx)
 	}
 	if err != nil {
@@ -68,6 +70,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(in *core.DictInfo) (*core.D
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
 
 	return &core.DictInfo{
 		Id:          &dictType.ID,
@@ -77,6 +80,7 @@ func (l *CreateOrUpdateDictLogic) CreateOrUpdateDict(in *core.DictInfo) (*core.D
 		Code:        &dictType.DictTypeCode,
 		Description: &dictType.Description,
 		State:       &dictType.State,
+		IsPublic:    &dictType.IsPublic,
 	}, nil
 }
 
@@ -31,6 +31,7 @@ func (l *DeleteDictItemLogic) DeleteDictItem(in *core.ID32Request) (*core.BaseRe
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
 
 	return &core.BaseResponse{
 		Message: "common.deleteSuccess",
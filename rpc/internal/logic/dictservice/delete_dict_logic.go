@@ -31,6 +31,7 @@ func (l *DeleteDictLogic) DeleteDict(in *core.ID32Request) (*core.BaseResponse,
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
+	invalidateDictCache(l.ctx, l.svcCtx, l.Logger)
 
 	return &core.BaseResponse{
 		Message: "common.deleteSuccess",
@@ -0,0 +1,139 @@
+package dictservicelogic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/dictitem"
+	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// dictCacheVersionKey 字典缓存版本号，字典写入时自增使旧缓存全部失效
+	dictCacheVersionKey = "dict:cache:version"
+	// dictCacheTTL 字典缓存有效期，旧版本的缓存依赖过期清理
+	dictCacheTTL = time.Hour
+)
+
+func dictCacheKey(version int64, code string) string {
+	return fmt.Sprintf("dict:cache:%d:%s", version, code)
+}
+
+// invalidateDictCache 使字典缓存失效，失败只记录日志，缓存最多延迟 dictCacheTTL 过期
+func invalidateDictCache(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	if err := svcCtx.Redis.Incr(ctx, dictCacheVersionKey).Err(); err != nil {
+		logger.Errorw("字典缓存失效失败", logx.Field("detail", err.Error()))
+	}
+}
+
+// loadDictData 按编码获取启用的字典及其启用的子项，优先读取缓存，不存在的编码不返回
+func loadDictData(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, codes []string) (map[string]*core.DictData, error) {
+	result := make(map[string]*core.DictData, len(codes))
+	if len(codes) == 0 {
+		return result, nil
+	}
+
+	// 缓存不可用时直接查询数据库
+	version, err := svcCtx.Redis.Get(ctx, dictCacheVersionKey).Int64()
+	cacheable := err == nil || errors.Is(err, redis.Nil)
+	if !cacheable {
+		logger.Errorw("读取字典缓存版本失败", logx.Field("detail", err.Error()))
+	}
+
+	misses := codes
+	if cacheable {
+		keys := make([]string, len(codes))
+		for i, code := range codes {
+			keys[i] = dictCacheKey(version, code)
+		}
+		values, err := svcCtx.Redis.MGet(ctx, keys...).Result()
+		if err != nil {
+			logger.Errorw("读取字典缓存失败", logx.Field("detail", err.Error()))
+			values = make([]interface{}, len(codes))
+		}
+		misses = nil
+		for i, value := range values {
+			raw, ok := value.(string)
+			data := &core.DictData{}
+			if !ok || proto.Unmarshal([]byte(raw), data) != nil {
+				misses = append(misses, codes[i])
+				continue
+			}
+			result[codes[i]] = data
+		}
+	}
+	if len(misses) == 0 {
+		localizeDictData(ctx, result)
+		return result, nil
+	}
+
+	dictTypes, err := svcCtx.DBEnt.DictType.Query().
+		Where(dicttype.DictTypeCodeIn(misses...), dicttype.State(true)).
+		WithDictItems(func(q *ent.DictItemQuery) {
+			q.Where(dictitem.State(true)).
+				Order(ent.Asc(dictitem.FieldSortOrder), ent.Asc(dictitem.FieldID))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := svcCtx.Redis.Pipeline()
+	for _, dictType := range dictTypes {
+		data := convertDictData(dictType)
+		result[dictType.DictTypeCode] = data
+		if raw, err := proto.Marshal(data); cacheable && err == nil {
+			pipe.Set(ctx, dictCacheKey(version, dictType.DictTypeCode), raw, dictCacheTTL)
+		}
+	}
+	if pipe.Len() > 0 {
+		if _, err = pipe.Exec(ctx); err != nil {
+			logger.Errorw("写入字典缓存失败", logx.Field("detail", err.Error()))
+		}
+	}
+
+	localizeDictData(ctx, result)
+	return result, nil
+}
+
+// localizeDictData 按上下文语言本地化字典子项标签，缓存中保留全部翻译
+func localizeDictData(ctx context.Context, dicts map[string]*core.DictData) {
+	for _, data := range dicts {
+		for _, item := range data.Items {
+			item.Label = pointer.ToStringPtr(i18nutils.Localize(ctx, item.GetLabel(), item.LabelI18N))
+		}
+	}
+}
+
+func convertDictData(dictType *ent.DictType) *core.DictData {
+	data := &core.DictData{
+		Code:     dictType.DictTypeCode,
+		Name:     dictType.DictTypeName,
+		IsPublic: dictType.IsPublic,
+		Items:    make([]*core.DictItemInfo, 0, len(dictType.Edges.DictItems)),
+	}
+	for _, item := range dictType.Edges.DictItems {
+		data.Items = append(data.Items, &core.DictItemInfo{
+			Id:          pointer.ToUint32Ptr(item.ID),
+			Label:       pointer.ToStringPtr(item.ItemLabel),
+			Value:       pointer.ToStringPtr(item.ItemValue),
+			Color:       item.ItemColor,
+			Css:         item.ItemCSS,
+			SortOrder:   pointer.ToInt32Ptr(int32(item.SortOrder)),
+			Description: item.Description,
+			DictTypeId:  pointer.ToUint32Ptr(item.DictTypeID),
+			LabelI18N:   item.ItemLabelI18n,
+		})
+	}
+	return data
+}
@@ -0,0 +1,46 @@
+package dictservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDictByCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDictByCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDictByCodeLogic {
+	return &GetDictByCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 根据编码获取字典及启用的子项
+func (l *GetDictByCodeLogic) GetDictByCode(in *core.DictCodeRequest) (*core.DictData, error) {
+	if in.Code == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	dicts, err := loadDictData(l.ctx, l.svcCtx, l.Logger, []string{in.Code})
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	data, ok := dicts[in.Code]
+	if !ok || (in.PublicOnly && !data.IsPublic) {
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	}
+	return data, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -45,5 +46,6 @@ func (l *GetDictItemLogic) GetDictItem(in *core.ID32Request) (*core.DictItemInfo
 		SortOrder:   &sortOrder,
 		Description: dictItem.Description,
 		State:       &dictItem.State,
+		LabelI18N:   i18nutils.Expand(l.svcCtx.Config.DefaultLocale, dictItem.ItemLabel, dictItem.ItemLabelI18n),
 	}, nil
 }
@@ -41,5 +41,6 @@ func (l *GetDictLogic) GetDict(in *core.ID32Request) (*core.DictInfo, error) {
 		Code:        &dictType.DictTypeCode,
 		Description: &dictType.Description,
 		State:       &dictType.State,
+		IsPublic:    &dictType.IsPublic,
 	}, nil
 }
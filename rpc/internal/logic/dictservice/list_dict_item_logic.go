@@ -8,8 +8,10 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/dictitem"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -42,7 +44,13 @@ func (l *ListDictItemLogic) ListDictItem(in *core.DictItemListRequest) (*core.Di
 		predicates = append(predicates, dictitem.ItemValueContains(*in.Value))
 	}
 
-	page, err := l.svcCtx.DBEnt.DictItem.Query().Where(predicates...).Order(dictitem.BySortOrder(sql.OrderDesc())).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.DictItem.Query().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), dictitem.BySortOrder(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -59,13 +67,14 @@ func (l *ListDictItemLogic) ListDictItem(in *core.DictItemListRequest) (*core.Di
 			Id:          &v.ID,
 			CreatedAt:   pointer.ToInt64Ptr(v.CreatedAt.UnixMilli()),
 			UpdatedAt:   pointer.ToInt64Ptr(v.UpdatedAt.UnixMilli()),
-			Label:       &v.ItemLabel,
+			Label:       pointer.ToStringPtr(i18nutils.Localize(l.ctx, v.ItemLabel, v.ItemLabelI18n)),
 			Value:       &v.ItemValue,
 			Color:       v.ItemColor,
 			Css:         v.ItemCSS,
 			SortOrder:   &sortOrder,
 			Description: v.Description,
 			State:       &v.State,
+			LabelI18N:   i18nutils.Expand(l.svcCtx.Config.DefaultLocale, v.ItemLabel, v.ItemLabelI18n),
 		})
 	}
 	return resp, nil
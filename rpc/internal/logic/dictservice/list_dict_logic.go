@@ -8,6 +8,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/dicttype"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -42,7 +43,13 @@ func (l *ListDictLogic) ListDict(in *core.DictListRequest) (*core.DictListRespon
 		predicates = append(predicates, dicttype.DescriptionContains(*in.Description))
 	}
 
-	page, err := l.svcCtx.DBEnt.DictType.Query().Where(predicates...).Order(dicttype.ByCreatedAt(sql.OrderDesc())).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.DictType.Query().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), dicttype.ByCreatedAt(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -62,6 +69,7 @@ func (l *ListDictLogic) ListDict(in *core.DictListRequest) (*core.DictListRespon
 			Code:        &v.DictTypeCode,
 			Description: &v.Description,
 			State:       &v.State,
+			IsPublic:    &v.IsPublic,
 		})
 	}
 	return resp, nil
@@ -0,0 +1,65 @@
+package fileservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteFileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteFileLogic {
+	return &DeleteFileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除文件及存储的内容
+func (l *DeleteFileLogic) DeleteFile(in *core.UUIDSRequest) (*core.BaseResponse, error) {
+	ids := make([]uuid.UUID, 0, len(in.Ids))
+	for _, v := range in.Ids {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		ids = append(ids, id)
+	}
+
+	files, err := l.svcCtx.DBEnt.File.Query().
+		Where(file.IDIn(ids...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	_, err = l.svcCtx.DBEnt.File.Delete().
+		Where(file.IDIn(ids...)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 记录删除后再清理对象，清理失败只会残留无引用的对象
+	for _, f := range files {
+		if err := l.svcCtx.FileStorage.Delete(l.ctx, f.StorageKey); err != nil {
+			l.Errorf("delete file object %s failed: %v", f.StorageKey, err)
+		}
+	}
+
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
@@ -0,0 +1,74 @@
+package fileservicelogic
+
+import (
+	"context"
+
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/storage"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadFileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDownloadFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadFileLogic {
+	return &DownloadFileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 下载文件内容
+func (l *DownloadFileLogic) DownloadFile(in *core.UUIDRequest, stream core.FileService_DownloadFileServer) error {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	f, err := l.svcCtx.DBEnt.File.Get(l.ctx, id)
+	if err != nil {
+		return errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	r, err := l.svcCtx.FileStorage.Get(l.ctx, f.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		l.Errorf("file object %s is missing", f.StorageKey)
+		return errorx.NewNotFoundError("file.contentMissing")
+	}
+	if err != nil {
+		l.Errorf("get file object %s failed: %v", f.StorageKey, err)
+		return errorx.NewInternalError("file.downloadFailed")
+	}
+	defer r.Close()
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&core.FileChunk{Data: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			l.Errorf("read file object %s failed: %v", f.StorageKey, err)
+			return errorx.NewInternalError("file.downloadFailed")
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package fileservicelogic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+const (
+	CategoryAttachment = "attachment"
+	CategoryAvatar     = "avatar"
+
+	// chunkSize 文件内容分块传输的大小
+	chunkSize = 64 << 10
+)
+
+// storageKey 文件的存储键，按上传月份分目录
+func storageKey(id uuid.UUID, now time.Time) string {
+	return fmt.Sprintf("files/%s/%s", now.Format("200601"), id.String())
+}
+
+// ConvertFileToFileInfo 转换文件实体，加载了上传用户时返回用户名
+func ConvertFileToFileInfo(file *ent.File) *core.FileInfo {
+	info := &core.FileInfo{
+		Id:        pointer.ToStringPtr(file.ID.String()),
+		CreatedAt: pointer.ToInt64Ptr(file.CreatedAt.UnixMilli()),
+		Name:      &file.Name,
+		Category:  &file.Category,
+		Size:      &file.Size,
+		MimeType:  &file.MimeType,
+		Checksum:  &file.Checksum,
+	}
+	if file.OwnerID != nil {
+		info.OwnerId = pointer.ToStringPtr(file.OwnerID.String())
+	}
+	if file.Edges.Owner != nil {
+		info.OwnerName = pointer.ToStringPtr(file.Edges.Owner.Username)
+	}
+	return info
+}
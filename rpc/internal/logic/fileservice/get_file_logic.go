@@ -0,0 +1,48 @@
+package fileservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFileLogic {
+	return &GetFileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取文件信息
+func (l *GetFileLogic) GetFile(in *core.UUIDRequest) (*core.FileInfo, error) {
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	f, err := l.svcCtx.DBEnt.File.Query().
+		Where(file.IDEQ(id)).
+		WithOwner().
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return ConvertFileToFileInfo(f), nil
+}
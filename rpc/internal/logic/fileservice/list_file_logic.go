@@ -0,0 +1,82 @@
+package fileservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/file"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFileLogic {
+	return &ListFileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取文件列表
+func (l *ListFileLogic) ListFile(in *core.FileListRequest) (*core.FileListResponse, error) {
+	var predicates []predicate.File
+	if in.OwnerId != nil && *in.OwnerId != "" {
+		ownerID, err := uuid.Parse(*in.OwnerId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		predicates = append(predicates, file.OwnerIDEQ(ownerID))
+	}
+	if in.Name != nil && *in.Name != "" {
+		predicates = append(predicates, file.NameContains(*in.Name))
+	}
+	if in.Category != nil && *in.Category != "" {
+		predicates = append(predicates, file.CategoryEQ(*in.Category))
+	}
+	// 支持按类型前缀过滤，如 image/
+	if in.MimeType != nil && *in.MimeType != "" {
+		predicates = append(predicates, file.MimeTypeHasPrefix(*in.MimeType))
+	}
+
+	query, err := l.svcCtx.DBEnt.File.Query().
+		Where(predicates...).
+		WithOwner().
+		ApplyOptions(pageutils.QueryOptions(in.Page), file.ByCreatedAt(sql.OrderDesc()), file.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	files, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.FileInfo, 0, len(files.List))
+	for _, f := range files.List {
+		list = append(list, ConvertFileToFileInfo(f))
+	}
+
+	return &core.FileListResponse{
+		Page: &core.BasePageResp{
+			Total:      files.PageDetails.Total,
+			PageNumber: files.PageDetails.Page,
+			PageSize:   files.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
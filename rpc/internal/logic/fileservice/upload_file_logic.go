@@ -0,0 +1,146 @@
+package fileservicelogic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// errTooLarge 上传内容超过限制
+var errTooLarge = errors.New("file too large")
+
+type UploadFileLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUploadFileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadFileLogic {
+	return &UploadFileLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 上传文件
+func (l *UploadFileLogic) UploadFile(stream core.FileService_UploadFileServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.Info
+	if info == nil || info.Name == nil || *info.Name == "" || info.MimeType == nil || *info.MimeType == "" {
+		return errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	var ownerID *uuid.UUID
+	if info.OwnerId != nil && *info.OwnerId != "" {
+		id, err := uuid.Parse(*info.OwnerId)
+		if err != nil {
+			return errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		ownerID = &id
+	}
+	category := CategoryAttachment
+	if info.Category != nil && *info.Category != "" {
+		category = *info.Category
+	}
+
+	// 边接收边写入存储，同时计算大小和校验和
+	var (
+		size   int64
+		hash   = sha256.New()
+		pr, pw = io.Pipe()
+		done   = make(chan error, 1)
+	)
+	go func() {
+		err := l.receive(stream, first.Chunk, first.MaxSize, pw, hash, &size)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	id := uuid.New()
+	key := storageKey(id, time.Now())
+	putErr := l.svcCtx.FileStorage.Put(l.ctx, key, pr, -1)
+	// 存储提前失败时解除接收协程的阻塞
+	pr.CloseWithError(putErr)
+	recvErr := <-done
+
+	switch {
+	case errors.Is(recvErr, errTooLarge):
+		l.deleteObject(key)
+		return errorx.NewInvalidArgumentError("file.tooLarge")
+	case recvErr != nil && (putErr == nil || !errors.Is(recvErr, putErr)):
+		// 客户端流异常
+		l.deleteObject(key)
+		return recvErr
+	case putErr != nil:
+		l.Errorf("put file object %s failed: %v", key, putErr)
+		l.deleteObject(key)
+		return errorx.NewInternalError("file.uploadFailed")
+	}
+
+	file, err := l.svcCtx.DBEnt.File.Create().
+		SetID(id).
+		SetNillableOwnerID(ownerID).
+		SetName(*info.Name).
+		SetCategory(category).
+		SetSize(size).
+		SetMimeType(*info.MimeType).
+		SetChecksum(hex.EncodeToString(hash.Sum(nil))).
+		SetStorageKey(key).
+		Save(l.ctx)
+	if err != nil {
+		l.deleteObject(key)
+		return errorhandler.DBEntError(l.Logger, err, info)
+	}
+
+	return stream.SendAndClose(ConvertFileToFileInfo(file))
+}
+
+// receive 将首条消息及后续消息中的内容写入 w，超出 maxSize 时返回 errTooLarge
+func (l *UploadFileLogic) receive(stream core.FileService_UploadFileServer, chunk []byte, maxSize int64,
+	w io.Writer, hash io.Writer, size *int64) error {
+	for {
+		if len(chunk) > 0 {
+			*size += int64(len(chunk))
+			if maxSize > 0 && *size > maxSize {
+				return errTooLarge
+			}
+			hash.Write(chunk)
+			if _, err := w.Write(chunk); err != nil {
+				return err
+			}
+		}
+
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		chunk = req.Chunk
+	}
+}
+
+// deleteObject 清理上传失败残留的对象
+func (l *UploadFileLogic) deleteObject(key string) {
+	if err := l.svcCtx.FileStorage.Delete(context.Background(), key); err != nil {
+		l.Errorf("delete file object %s failed: %v", key, err)
+	}
+}
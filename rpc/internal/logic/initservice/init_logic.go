@@ -11,7 +11,11 @@ import (
 	"github.com/wenpiner/last-admin-common/utils/encrypt"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
+	jobservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/jobservice"
+	operationlogservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/operationlogservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/scheduler"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -59,6 +63,7 @@ func (l *InitLogic) Init(in *core.EmptyRequest) (*core.BaseResponse, error) {
 	if err := l.initConfiguration(); err != nil {
 		return nil, err
 	}
+	l.svcCtx.CacheRefreshService.RefreshNow(l.ctx)
 
 	// 初始化角色信息
 	if err := l.initRole(); err != nil {
@@ -156,11 +161,11 @@ func (l *InitLogic) initCasbin() error {
 		removeResult, err := l.svcCtx.Casbin.RemoveFilteredPolicy(0, enums.DefaultRoleValue)
 		if err != nil {
 			logx.Errorw("删除 Casbin 策略失败", logx.Field("detail", err.Error()))
-			return errorx.NewInternalError(err.Error())
+			return errorx.NewInternalError("init.failed")
 		}
 		if !removeResult {
 			logx.Errorw("删除 Casbin 策略失败", logx.Field("detail", "删除失败"))
-			return errorx.NewInternalError("删除 Casbin 策略失败")
+			return errorx.NewInternalError("init.failed")
 		}
 	}
 
@@ -212,6 +217,26 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/auth/codes").
 		SetServiceName("core").
 		SetName("获取用户权限码(菜单按钮级别)").SetIsRequired(true))
+	// /auth/webauthn/login/begin
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Auth").
+		SetMethod("POST").
+		SetPath("/auth/webauthn/login/begin").
+		SetServiceName("core").
+		SetName("开始WebAuthn登录").SetIsRequired(true))
+	// /auth/webauthn/login/finish
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Auth").
+		SetMethod("POST").
+		SetPath("/auth/webauthn/login/finish").
+		SetServiceName("core").
+		SetName("WebAuthn登录(Passkey无密码登录)").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Auth").
+		SetMethod("POST").
+		SetPath("/auth/password/change").
+		SetServiceName("core").
+		SetName("修改过期密码(登录时要求修改密码)").SetIsRequired(true))
 
 	// Captcha
 	// /captcha/email
@@ -245,6 +270,60 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/user/info").
 		SetServiceName("core").
 		SetName("获取用户信息").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/profile").
+		SetServiceName("core").
+		SetName("修改个人资料").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/avatar").
+		SetServiceName("core").
+		SetName("上传头像").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/password").
+		SetServiceName("core").
+		SetName("修改密码").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/totp/enable").
+		SetServiceName("core").
+		SetName("创建/重置TOTP").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/totp/verify").
+		SetServiceName("core").
+		SetName("验证TOTP").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/totp/disable").
+		SetServiceName("core").
+		SetName("禁用TOTP").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/session/list").
+		SetServiceName("core").
+		SetName("获取我的登录会话").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/session/revoke").
+		SetServiceName("core").
+		SetName("退出指定会话").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/session/revokeOthers").
+		SetServiceName("core").
+		SetName("退出其他设备").SetIsRequired(true))
 
 	// Menu
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
@@ -276,6 +355,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/menu/update").
 		SetServiceName("core").
 		SetName("更新菜单").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Menu").
+		SetMethod("POST").
+		SetPath("/menu/assign/api").
+		SetServiceName("core").
+		SetName("为菜单绑定API").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Menu").
+		SetMethod("POST").
+		SetPath("/menu/get/api").
+		SetServiceName("core").
+		SetName("获取菜单绑定的API").SetIsRequired(false))
 
 	// Api
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
@@ -322,6 +413,24 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/department/delete").
 		SetServiceName("core").
 		SetName("删除部门").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Department").
+		SetMethod("POST").
+		SetPath("/department/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除部门").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Department").
+		SetMethod("POST").
+		SetPath("/department/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用部门").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Department").
+		SetMethod("POST").
+		SetPath("/department/assign/role").
+		SetServiceName("core").
+		SetName("为部门分配角色").SetIsRequired(false))
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Department").
 		SetMethod("POST").
@@ -348,6 +457,58 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/user/delete").
 		SetServiceName("core").
 		SetName("删除用户").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除用户").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用用户").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/totp/reset").
+		SetServiceName("core").
+		SetName("重置用户TOTP(用户丢失设备时由管理员关闭)").SetIsRequired(false))
+
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/import").
+		SetServiceName("core").
+		SetName("批量导入用户").SetIsRequired(false))
+
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/export").
+		SetServiceName("core").
+		SetName("导出用户").SetIsRequired(false))
+
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/impersonate").
+		SetServiceName("core").
+		SetName("模拟用户登录").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/effectiveRoles").
+		SetServiceName("core").
+		SetName("获取用户有效角色及来源").SetIsRequired(false))
+
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("User").
+		SetMethod("POST").
+		SetPath("/user/impersonate/stop").
+		SetServiceName("core").
+		SetName("结束模拟登录").SetIsRequired(true))
 
 	// role
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
@@ -362,6 +523,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/role/delete").
 		SetServiceName("core").
 		SetName("删除角色").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Role").
+		SetMethod("POST").
+		SetPath("/role/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除角色").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Role").
+		SetMethod("POST").
+		SetPath("/role/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用角色").SetIsRequired(false))
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Role").
 		SetMethod("POST").
@@ -392,6 +565,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/role/get/api").
 		SetServiceName("core").
 		SetName("获取角色API").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Role").
+		SetMethod("POST").
+		SetPath("/role/api/consistency").
+		SetServiceName("core").
+		SetName("检查角色接口授权与菜单的一致性").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Role").
+		SetMethod("POST").
+		SetPath("/role/permission/explain").
+		SetServiceName("core").
+		SetName("解释或模拟接口、配置项的访问决策").SetIsRequired(false))
 	//role/get/configurationGroup
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Role").
@@ -427,6 +612,24 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/position/delete").
 		SetServiceName("core").
 		SetName("删除岗位").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Position").
+		SetMethod("POST").
+		SetPath("/position/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除岗位").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Position").
+		SetMethod("POST").
+		SetPath("/position/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用岗位").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Position").
+		SetMethod("POST").
+		SetPath("/position/assign/role").
+		SetServiceName("core").
+		SetName("为岗位分配角色").SetIsRequired(false))
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Position").
 		SetMethod("POST").
@@ -447,6 +650,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/dict/delete").
 		SetServiceName("core").
 		SetName("删除字典").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Dict").
+		SetMethod("POST").
+		SetPath("/dict/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除字典").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Dict").
+		SetMethod("POST").
+		SetPath("/dict/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用字典").SetIsRequired(false))
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Dict").
 		SetMethod("POST").
@@ -483,6 +698,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/dict/item/get").
 		SetServiceName("core").
 		SetName("获取字典子项").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Dict").
+		SetMethod("GET").
+		SetPath("/dict/code").
+		SetServiceName("core").
+		SetName("根据编码获取字典").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Dict").
+		SetMethod("GET").
+		SetPath("/dict/codes").
+		SetServiceName("core").
+		SetName("根据编码批量获取字典").SetIsRequired(true))
 
 	// Oauth
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
@@ -505,6 +732,18 @@ func (l *InitLogic) initBaseApi() error {
 		SetPath("/oauth/delete").
 		SetServiceName("core").
 		SetName("删除Oauth").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Oauth").
+		SetMethod("POST").
+		SetPath("/oauth/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除Oauth").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Oauth").
+		SetMethod("POST").
+		SetPath("/oauth/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用Oauth").SetIsRequired(false))
 
 	// Token
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
@@ -535,6 +774,358 @@ func (l *InitLogic) initBaseApi() error {
 		SetServiceName("core").
 		SetName("解封用户Token").SetIsRequired(false))
 
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Token").
+		SetMethod("POST").
+		SetPath("/token/batch/delete").
+		SetServiceName("core").
+		SetName("批量删除Token").SetIsRequired(false))
+
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Token").
+		SetMethod("POST").
+		SetPath("/token/batch/state").
+		SetServiceName("core").
+		SetName("批量启用或禁用Token").SetIsRequired(false))
+
+	// ApiKey
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/user/apikey/list").
+		SetServiceName("core").
+		SetName("获取我的API密钥列表").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/user/apikey/create").
+		SetServiceName("core").
+		SetName("创建API密钥").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/user/apikey/update").
+		SetServiceName("core").
+		SetName("更新我的API密钥").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/user/apikey/delete").
+		SetServiceName("core").
+		SetName("删除我的API密钥").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/apikey/list").
+		SetServiceName("core").
+		SetName("获取API密钥列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/apikey/update").
+		SetServiceName("core").
+		SetName("更新API密钥").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("ApiKey").
+		SetMethod("POST").
+		SetPath("/apikey/delete").
+		SetServiceName("core").
+		SetName("删除API密钥").SetIsRequired(false))
+
+	// SecurityEvent
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("SecurityEvent").
+		SetMethod("POST").
+		SetPath("/user/securityEvent/list").
+		SetServiceName("core").
+		SetName("获取我的登录记录及安全事件").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("SecurityEvent").
+		SetMethod("POST").
+		SetPath("/securityEvent/list").
+		SetServiceName("core").
+		SetName("获取安全事件列表").SetIsRequired(false))
+
+	// Notification
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/user/notification/unreadCount").
+		SetServiceName("core").
+		SetName("获取未读通知数量").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/user/notification/list").
+		SetServiceName("core").
+		SetName("获取我的通知列表").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/user/notification/read").
+		SetServiceName("core").
+		SetName("标记通知为已读").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/user/notification/archive").
+		SetServiceName("core").
+		SetName("归档通知").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/notification/list").
+		SetServiceName("core").
+		SetName("获取通知公告列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/notification/create").
+		SetServiceName("core").
+		SetName("发布通知公告").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/notification/update").
+		SetServiceName("core").
+		SetName("更新通知公告").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Notification").
+		SetMethod("POST").
+		SetPath("/notification/delete").
+		SetServiceName("core").
+		SetName("删除通知公告").SetIsRequired(false))
+
+	// OperationLog
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OperationLog").
+		SetMethod("POST").
+		SetPath("/operationLog/list").
+		SetServiceName("core").
+		SetName("获取操作记录列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OperationLog").
+		SetMethod("POST").
+		SetPath("/operationLog/export").
+		SetServiceName("core").
+		SetName("导出操作记录").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OperationLog").
+		SetMethod("POST").
+		SetPath("/operationLog/archive/list").
+		SetServiceName("core").
+		SetName("获取操作记录归档列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OperationLog").
+		SetMethod("POST").
+		SetPath("/operationLog/archive/restore").
+		SetServiceName("core").
+		SetName("恢复操作记录归档").SetIsRequired(false))
+
+	// Job
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Job").
+		SetMethod("POST").
+		SetPath("/job/list").
+		SetServiceName("core").
+		SetName("获取定时任务列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Job").
+		SetMethod("POST").
+		SetPath("/job/trigger").
+		SetServiceName("core").
+		SetName("立即执行定时任务").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Job").
+		SetMethod("POST").
+		SetPath("/job/pause").
+		SetServiceName("core").
+		SetName("暂停或恢复定时任务").SetIsRequired(false))
+
+	// File
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("File").
+		SetMethod("POST").
+		SetPath("/file/upload").
+		SetServiceName("core").
+		SetName("上传文件").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("File").
+		SetMethod("GET").
+		SetPath("/file/download/:id").
+		SetServiceName("core").
+		SetName("下载文件").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("File").
+		SetMethod("POST").
+		SetPath("/file/url").
+		SetServiceName("core").
+		SetName("生成临时下载链接").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("File").
+		SetMethod("POST").
+		SetPath("/file/list").
+		SetServiceName("core").
+		SetName("获取文件列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("File").
+		SetMethod("POST").
+		SetPath("/file/delete").
+		SetServiceName("core").
+		SetName("删除文件").SetIsRequired(false))
+
+	// PermissionReport
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/role").
+		SetServiceName("core").
+		SetName("获取角色的全部权限及持有用户").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/role/export").
+		SetServiceName("core").
+		SetName("导出角色权限报表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/holder").
+		SetServiceName("core").
+		SetName("获取持有接口、菜单或配置项权限的角色及用户").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/holder/export").
+		SetServiceName("core").
+		SetName("导出权限持有人").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/snapshot/create").
+		SetServiceName("core").
+		SetName("创建当前权限的快照").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/snapshot/list").
+		SetServiceName("core").
+		SetName("获取权限快照列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/snapshot/delete").
+		SetServiceName("core").
+		SetName("删除权限快照").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/snapshot/diff").
+		SetServiceName("core").
+		SetName("对比权限快照的差异").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("PermissionReport").
+		SetMethod("POST").
+		SetPath("/permissionReport/snapshot/diff/export").
+		SetServiceName("core").
+		SetName("导出权限快照差异").SetIsRequired(false))
+
+	// Bundle
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Bundle").
+		SetMethod("POST").
+		SetPath("/bundle/export").
+		SetServiceName("core").
+		SetName("导出配置包").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("Bundle").
+		SetMethod("POST").
+		SetPath("/bundle/import").
+		SetServiceName("core").
+		SetName("预览或导入配置包").SetIsRequired(false))
+
+	// OAuth2
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/authorize/info").
+		SetServiceName("core").
+		SetName("获取OAuth2授权请求信息").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/authorize/consent").
+		SetServiceName("core").
+		SetName("确认或拒绝OAuth2授权").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/consent/list").
+		SetServiceName("core").
+		SetName("获取我授权的应用").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/consent/delete").
+		SetServiceName("core").
+		SetName("撤销对应用的授权").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/client/list").
+		SetServiceName("core").
+		SetName("获取OAuth2客户端应用列表").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/client/create").
+		SetServiceName("core").
+		SetName("创建OAuth2客户端应用").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/client/update").
+		SetServiceName("core").
+		SetName("更新OAuth2客户端应用").SetIsRequired(false))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("OAuth2").
+		SetMethod("POST").
+		SetPath("/oauth2/client/delete").
+		SetServiceName("core").
+		SetName("删除OAuth2客户端应用").SetIsRequired(false))
+
+	// WebAuthn
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("WebAuthn").
+		SetMethod("POST").
+		SetPath("/user/webauthn/register/begin").
+		SetServiceName("core").
+		SetName("开始注册WebAuthn凭证").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("WebAuthn").
+		SetMethod("POST").
+		SetPath("/user/webauthn/register/finish").
+		SetServiceName("core").
+		SetName("完成注册WebAuthn凭证").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("WebAuthn").
+		SetMethod("GET").
+		SetPath("/user/webauthn/list").
+		SetServiceName("core").
+		SetName("获取当前用户WebAuthn凭证列表").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("WebAuthn").
+		SetMethod("POST").
+		SetPath("/user/webauthn/update").
+		SetServiceName("core").
+		SetName("修改WebAuthn凭证名称").SetIsRequired(true))
+	apis = append(apis, l.svcCtx.DBEnt.API.Create().
+		SetAPIGroup("WebAuthn").
+		SetMethod("POST").
+		SetPath("/user/webauthn/delete").
+		SetServiceName("core").
+		SetName("删除WebAuthn凭证").SetIsRequired(true))
+
 	// Configuration
 	apis = append(apis, l.svcCtx.DBEnt.API.Create().
 		SetAPIGroup("Configuration").
@@ -825,6 +1416,7 @@ func (l *InitLogic) initUser() error {
 	users = append(users, l.svcCtx.DBEnt.User.Create().
 		SetUsername("admin").
 		SetPasswordHash(encrypt.BcryptEncrypt("last-admin123")).
+		SetPasswordChangedAt(time.Now()).
 		SetEmail("wenpiner@gmail.com").
 		SetFullName("管理员").
 		SetState(true).
@@ -868,7 +1460,78 @@ func (l *InitLogic) initConfiguration() error {
 		SetKey(enums.ConfigurationKeyInit).
 		SetValue("true").
 		SetDescription("是否开启初始化"))
-	
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("密码最小长度").
+		SetGroup(passwordutils.ConfigurationGroup).
+		SetKey(passwordutils.KeyMinLength).
+		SetValue("8").
+		SetDescription("密码最少字符数"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("密码字符类别").
+		SetGroup(passwordutils.ConfigurationGroup).
+		SetKey(passwordutils.KeyMinCharClasses).
+		SetValue("2").
+		SetDescription("密码至少包含的字符类别数（大写字母、小写字母、数字、符号）"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("禁用密码").
+		SetGroup(passwordutils.ConfigurationGroup).
+		SetKey(passwordutils.KeyBanned).
+		SetValue("12345678,123456789,password,password123,admin123,qwerty123").
+		SetDescription("禁止使用的密码，逗号或换行分隔，不区分大小写"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("密码历史").
+		SetGroup(passwordutils.ConfigurationGroup).
+		SetKey(passwordutils.KeyHistoryDepth).
+		SetValue("3").
+		SetDescription("新密码不能与最近 N 次使用的密码相同，0 表示不限制"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("密码有效期").
+		SetGroup(passwordutils.ConfigurationGroup).
+		SetKey(passwordutils.KeyMaxAgeDays).
+		SetValue("0").
+		SetDescription("密码有效天数，过期后登录需修改密码，0 表示永不过期"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("Token清理周期").
+		SetGroup(scheduler.ConfigurationGroup).
+		SetKey(scheduler.SpecKey(jobservicelogic.JobTokenCleanup)).
+		SetValue("0 3 * * *").
+		SetDescription("清理过期Token及黑名单的 cron 表达式，为空表示不定时执行"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("日志清理周期").
+		SetGroup(scheduler.ConfigurationGroup).
+		SetKey(scheduler.SpecKey(jobservicelogic.JobLogRetention)).
+		SetValue("30 3 * * *").
+		SetDescription("归档清理操作记录及清理过期安全事件的 cron 表达式，为空表示不定时执行"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("安全事件保留天数").
+		SetGroup(scheduler.ConfigurationGroup).
+		SetKey(jobservicelogic.KeyLogRetentionDays).
+		SetValue("180").
+		SetDescription("安全事件的保留天数，0 表示不清理"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("操作记录保留天数").
+		SetGroup(operationlogservicelogic.ConfigurationGroup).
+		SetKey(operationlogservicelogic.KeyRetentionDays).
+		SetValue("90").
+		SetDescription("操作记录在数据库中的默认保留天数，0 表示不清理"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("模块保留天数").
+		SetGroup(operationlogservicelogic.ConfigurationGroup).
+		SetKey(operationlogservicelogic.KeyModuleRetentionDays).
+		SetValue("").
+		SetDescription("按模块覆盖默认保留天数，格式为 模块=天数，逗号或换行分隔，如 user=180,auth=30"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("操作记录归档").
+		SetGroup(operationlogservicelogic.ConfigurationGroup).
+		SetKey(operationlogservicelogic.KeyArchiveEnabled).
+		SetValue("true").
+		SetDescription("清理前是否将操作记录归档到归档存储，归档可查询和恢复"))
+	configurations = append(configurations, l.svcCtx.DBEnt.Configuration.Create().
+		SetName("TOTP清理周期").
+		SetGroup(scheduler.ConfigurationGroup).
+		SetKey(scheduler.SpecKey(jobservicelogic.JobTotpCleanup)).
+		SetValue("0 * * * *").
+		SetDescription("清理未完成验证的 TOTP 绑定的 cron 表达式，为空表示不定时执行"))
 
 
 	err := l.svcCtx.DBEnt.Configuration.CreateBulk(configurations...).Exec(l.ctx)
@@ -0,0 +1,166 @@
+package jobservicelogic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/ent/usertotp"
+	operationlogservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/operationlogservice"
+	tokenservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/scheduler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// 内置任务名称
+const (
+	JobTokenCleanup = "token_cleanup"
+	JobLogRetention = "log_retention"
+	JobTotpCleanup  = "totp_cleanup"
+)
+
+const (
+	// KeyLogRetentionDays 安全事件的保留天数，0 表示不清理；操作记录的保留策略见 operationlogservicelogic
+	KeyLogRetentionDays = "job_log_retention_days"
+
+	defaultLogRetentionDays = 180
+	// staleTotpAge 未完成验证的 TOTP 绑定超过该时长后清理
+	staleTotpAge = 24 * time.Hour
+	// blacklistScanCount 每批扫描的黑名单数量
+	blacklistScanCount = 500
+)
+
+// RegisterJobs 向调度器注册内置维护任务
+func RegisterJobs(svcCtx *svc.ServiceContext) {
+	s := svcCtx.Scheduler
+	s.Register(scheduler.Job{
+		Name:        JobTokenCleanup,
+		DefaultSpec: "0 3 * * *",
+		Timeout:     30 * time.Minute,
+		Run: func(ctx context.Context) (string, error) {
+			return cleanTokens(ctx, svcCtx)
+		},
+	})
+	s.Register(scheduler.Job{
+		Name:        JobLogRetention,
+		DefaultSpec: "30 3 * * *",
+		Timeout:     time.Hour,
+		Run: func(ctx context.Context) (string, error) {
+			return cleanLogs(ctx, svcCtx)
+		},
+	})
+	s.Register(scheduler.Job{
+		Name:        JobTotpCleanup,
+		DefaultSpec: "0 * * * *",
+		Timeout:     10 * time.Minute,
+		Run: func(ctx context.Context) (string, error) {
+			return cleanStaleTotp(ctx, svcCtx)
+		},
+	})
+}
+
+// cleanTokens 删除过期Token，并移除黑名单中已失效的Token
+func cleanTokens(ctx context.Context, svcCtx *svc.ServiceContext) (string, error) {
+	resp, err := tokenservicelogic.NewCleanExpiredTokensLogic(ctx, svcCtx).
+		CleanExpiredTokens(&core.CleanExpiredTokensRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	removed, err := pruneBlacklist(ctx, svcCtx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("tokens: %d, blacklist: %d", resp.CleanedCount, removed), nil
+}
+
+// pruneBlacklist 移除黑名单中不再对应有效Token的条目，Token 过期后黑名单已无意义
+func pruneBlacklist(ctx context.Context, svcCtx *svc.ServiceContext) (int64, error) {
+	key := string(last_redis.BlacklistToken)
+	var (
+		cursor  uint64
+		removed int64
+	)
+	for {
+		members, next, err := svcCtx.Redis.SScan(ctx, key, cursor, "", blacklistScanCount).Result()
+		if err != nil {
+			return removed, err
+		}
+
+		if len(members) > 0 {
+			alive, err := svcCtx.DBEnt.Token.Query().
+				Where(token.TokenValueIn(members...), token.ExpiresAtGT(time.Now())).
+				Select(token.FieldTokenValue).
+				Strings(ctx)
+			if err != nil {
+				return removed, err
+			}
+			aliveSet := make(map[string]struct{}, len(alive))
+			for _, v := range alive {
+				aliveSet[v] = struct{}{}
+			}
+
+			var stale []any
+			for _, m := range members {
+				if _, ok := aliveSet[m]; !ok {
+					stale = append(stale, m)
+				}
+			}
+			if len(stale) > 0 {
+				n, err := svcCtx.Redis.SRem(ctx, key, stale...).Result()
+				if err != nil {
+					return removed, err
+				}
+				removed += n
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return removed, nil
+		}
+	}
+}
+
+// cleanLogs 按保留策略归档并清理操作记录，删除超过保留天数的安全事件
+func cleanLogs(ctx context.Context, svcCtx *svc.ServiceContext) (string, error) {
+	message, err := operationlogservicelogic.ApplyRetention(ctx, svcCtx)
+	if err != nil {
+		return "", err
+	}
+
+	days := defaultLogRetentionDays
+	if v, ok := svcCtx.ConfigurationCache.GetValue(KeyLogRetentionDays); ok {
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil && n >= 0 {
+			days = n
+		}
+	}
+	if days == 0 {
+		return fmt.Sprintf("operation logs: %s, security events: retention disabled", message), nil
+	}
+
+	events, err := svcCtx.DBEnt.SecurityEvent.Delete().
+		Where(securityevent.CreatedAtLT(time.Now().AddDate(0, 0, -days))).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("operation logs: %s, security events: %d", message, events), nil
+}
+
+// cleanStaleTotp 删除长时间未完成验证的 TOTP 绑定
+func cleanStaleTotp(ctx context.Context, svcCtx *svc.ServiceContext) (string, error) {
+	affected, err := svcCtx.DBEnt.UserTotp.Delete().
+		Where(usertotp.IsVerified(false), usertotp.UpdatedAtLT(time.Now().Add(-staleTotpAge))).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("totp: %d", affected), nil
+}
@@ -0,0 +1,98 @@
+package jobservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	tokenservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func createToken(t *testing.T, svcCtx *svc.ServiceContext, value string, expiresAt time.Time) uint32 {
+	t.Helper()
+	tk, err := svcCtx.DBEnt.Token.Create().
+		SetTokenValue(value).
+		SetTokenType("access_token").
+		SetExpiresAt(expiresAt).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tk.ID
+}
+
+func TestDeletedTokensStayBlacklistedAfterCleanup(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, mr := svctest.New(t)
+	key := string(last_redis.BlacklistToken)
+
+	batch := createToken(t, svcCtx, "batch", time.Now().Add(time.Hour))
+	single := createToken(t, svcCtx, "single", time.Now().Add(time.Hour))
+	createToken(t, svcCtx, "expired", time.Now().Add(-time.Hour))
+	if _, err := mr.SAdd(key, "expired", "unknown"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tokenservicelogic.NewBatchDeleteTokenLogic(ctx, svcCtx).
+		BatchDeleteToken(&core.ID32SRequest{Ids: []uint32{batch}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokenservicelogic.NewDeleteTokenLogic(ctx, svcCtx).
+		DeleteToken(&core.ID32Request{Id: single}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cleanTokens(ctx, svcCtx); err != nil {
+		t.Fatal(err)
+	}
+
+	for value, want := range map[string]bool{"batch": true, "single": true, "expired": false, "unknown": false} {
+		ok, err := svcCtx.Redis.SIsMember(ctx, key, value).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != want {
+			t.Errorf("blacklisted(%q) = %v, want %v", value, ok, want)
+		}
+	}
+
+	n, err := svcCtx.DBEnt.Token.Query().Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("revoked tokens must be kept until they expire, got %d rows", n)
+	}
+}
+
+func TestPruneBlacklist(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, mr := svctest.New(t)
+	key := string(last_redis.BlacklistToken)
+
+	createToken(t, svcCtx, "alive", time.Now().Add(time.Hour))
+	createToken(t, svcCtx, "expired", time.Now().Add(-time.Hour))
+	members := []string{"alive", "expired", "unknown"}
+	if _, err := mr.SAdd(key, members...); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := pruneBlacklist(ctx, svcCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int64(len(members) - 1); removed != want {
+		t.Fatalf("removed %d, want %d", removed, want)
+	}
+	left, err := mr.Members(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 || left[0] != "alive" {
+		t.Fatalf("blacklist = %v, want [alive]", left)
+	}
+}
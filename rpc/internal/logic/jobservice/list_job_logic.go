@@ -0,0 +1,68 @@
+package jobservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListJobLogic {
+	return &ListJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取定时任务列表
+func (l *ListJobLogic) ListJob(in *core.EmptyRequest) (*core.ScheduledJobListResponse, error) {
+	states := l.svcCtx.Scheduler.States()
+	names := make([]string, 0, len(states))
+	for _, state := range states {
+		names = append(names, state.Name)
+	}
+
+	// 执行记录在任务首次执行或暂停时创建，可能不存在
+	rows, err := l.svcCtx.DBEnt.ScheduledJob.Query().Where(scheduledjob.NameIn(names...)).All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	rowMap := make(map[string]int, len(rows))
+	for i, row := range rows {
+		rowMap[row.Name] = i
+	}
+
+	resp := &core.ScheduledJobListResponse{List: make([]*core.ScheduledJobInfo, 0, len(states))}
+	for _, state := range states {
+		info := &core.ScheduledJobInfo{Name: state.Name, Spec: state.Spec}
+		if !state.NextRun.IsZero() {
+			info.NextRunAt = pointer.ToInt64Ptr(state.NextRun.UnixMilli())
+		}
+		if i, ok := rowMap[state.Name]; ok {
+			row := rows[i]
+			info.Paused = row.Paused
+			if row.LastRunAt != nil {
+				info.LastRunAt = pointer.ToInt64Ptr(row.LastRunAt.UnixMilli())
+				info.LastStatus = pointer.ToStringPtr(row.LastStatus)
+				info.LastTrigger = pointer.ToStringPtr(row.LastTrigger)
+				info.LastDuration = pointer.ToInt64Ptr(row.LastDuration)
+				info.LastMessage = pointer.ToStringPtr(row.LastMessage)
+			}
+		}
+		resp.List = append(resp.List, info)
+	}
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package jobservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/scheduler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PauseJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPauseJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PauseJobLogic {
+	return &PauseJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 暂停或恢复定时任务
+func (l *PauseJobLogic) PauseJob(in *core.ScheduledJobPauseRequest) (*core.BaseResponse, error) {
+	if !l.svcCtx.Scheduler.Has(in.Name) {
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	}
+
+	id, err := scheduler.EnsureJob(l.ctx, l.svcCtx.DBEnt, in.Name)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if err = l.svcCtx.DBEnt.ScheduledJob.UpdateOneID(id).SetPaused(in.Paused).Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.updateSuccess"}, nil
+}
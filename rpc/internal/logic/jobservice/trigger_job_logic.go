@@ -0,0 +1,45 @@
+package jobservicelogic
+
+import (
+	"context"
+	"errors"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/scheduler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TriggerJobLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTriggerJobLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TriggerJobLogic {
+	return &TriggerJobLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 立即执行定时任务
+func (l *TriggerJobLogic) TriggerJob(in *core.StringRequest) (*core.BaseResponse, error) {
+	err := l.svcCtx.Scheduler.Trigger(l.ctx, in.Value)
+	switch {
+	case errors.Is(err, scheduler.ErrJobNotFound):
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	case errors.Is(err, scheduler.ErrJobRunning):
+		return nil, errorx.NewFailedPreconditionError("job.running")
+	case err != nil:
+		l.Errorw("触发定时任务失败", logx.Field("job", in.Value), logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(err.Error())
+	}
+
+	return &core.BaseResponse{Message: "job.triggered"}, nil
+}
@@ -0,0 +1,48 @@
+package menuservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignMenuApiLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignMenuApiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignMenuApiLogic {
+	return &AssignMenuApiLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 为菜单绑定API，已分配该菜单的角色同步获得这些API
+func (l *AssignMenuApiLogic) AssignMenuApi(in *core.MenuApiRequest) (*core.BaseResponse, error) {
+	// 已分配该菜单的角色先保留手动分配的API，绑定变更后重建接口策略
+	roles, err := l.svcCtx.DBEnt.Role.Query().Where(role.HasMenusWith(menu.IDEQ(in.MenuId))).All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	err = policyutils.Update(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, roles, func(tx *ent.Tx) error {
+		return tx.Menu.UpdateOneID(in.MenuId).ClearApis().AddAPIIDs(in.ApiIds...).Exec(l.ctx)
+	})
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{
+		Message: "success",
+	}, nil
+}
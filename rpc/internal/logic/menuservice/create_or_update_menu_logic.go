@@ -9,6 +9,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -31,6 +32,13 @@ func NewCreateOrUpdateMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 
 // 创建或更新菜单
 func (l *CreateOrUpdateMenuLogic) CreateOrUpdateMenu(in *core.MenuInfo) (*core.MenuInfo, error) {
+	// 拆分多语言菜单名称，默认语言的值写回菜单名称
+	menuName, menuNameI18n, ok := i18nutils.Prepare(l.svcCtx.Config.DefaultLocale, in.MenuName, in.MenuNameI18N)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	in.MenuName = menuName
+
 	// 开启事务，并先进行检查是否存在，如果存在则进行更新否则进行创建
 	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
 	if err != nil {
@@ -54,6 +62,7 @@ func (l *CreateOrUpdateMenuLogic) CreateOrUpdateMenu(in *core.MenuInfo) (*core.M
 		menu, err = tx.Menu.Create().
 			SetMenuCode(pointer.GetString(in.MenuCode)).
 			SetMenuName(pointer.GetString(in.MenuName)).
+			SetMenuNameI18n(menuNameI18n).
 			SetNillableParentID(in.ParentId).
 			SetMenuLevel(menuLevel).
 			SetNillableMenuPath(in.MenuPath).
@@ -91,6 +100,10 @@ func (l *CreateOrUpdateMenuLogic) CreateOrUpdateMenu(in *core.MenuInfo) (*core.M
 			SetNillableSort(in.Sort).
 			SetNillableIcon(l.getIconFromMeta(in.Meta))
 
+		if in.MenuNameI18N != nil {
+			updateQuery.SetMenuNameI18n(menuNameI18n)
+		}
+
 		// 进行Clear判断，如果某个值是nil则代表需清空
 		if in.MenuPath == nil {
 			updateQuery.ClearMenuPath()
@@ -171,7 +184,7 @@ func (l *CreateOrUpdateMenuLogic) CreateOrUpdateMenu(in *core.MenuInfo) (*core.M
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	return ConvertMenuToMenuInfo(menu), nil
+	return ConvertMenuToMenuInfo(menu, l.svcCtx.Config.DefaultLocale), nil
 }
 
 // 验证新增参数可用性
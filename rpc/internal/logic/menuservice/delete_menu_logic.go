@@ -3,8 +3,11 @@ package menuservicelogic
 import (
 	"context"
 
+	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -38,7 +41,14 @@ func (l *DeleteMenuLogic) DeleteMenu(in *core.ID32Request) (*core.BaseResponse,
 		return nil, errorx.NewInvalidArgumentError("menu.hasChildren")
 	}
 
-	err = l.svcCtx.DBEnt.Menu.DeleteOneID(in.Id).Exec(l.ctx)
+	// 已分配该菜单的角色先保留手动分配的API，删除后移除菜单派生的接口策略
+	roles, err := l.svcCtx.DBEnt.Role.Query().Where(role.HasMenusWith(menu.IDEQ(in.Id))).All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	err = policyutils.Update(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, roles, func(tx *ent.Tx) error {
+		return tx.Menu.DeleteOneID(in.Id).Exec(l.ctx)
+	})
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
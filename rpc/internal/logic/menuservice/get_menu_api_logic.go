@@ -0,0 +1,35 @@
+package menuservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMenuApiLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetMenuApiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMenuApiLogic {
+	return &GetMenuApiLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取菜单绑定的API
+func (l *GetMenuApiLogic) GetMenuApi(in *core.ID32Request) (*core.MenuApiListResponse, error) {
+	ids, err := l.svcCtx.DBEnt.Menu.Query().Where(menu.IDEQ(in.Id)).QueryApis().IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.MenuApiListResponse{List: ids}, nil
+}
@@ -32,5 +32,5 @@ func (l *GetMenuLogic) GetMenu(in *core.ID32Request) (*core.MenuInfo, error) {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	return ConvertMenuToMenuInfo(menu), nil
+	return ConvertMenuToMenuInfo(menu, l.svcCtx.Config.DefaultLocale), nil
 }
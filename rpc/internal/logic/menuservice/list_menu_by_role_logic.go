@@ -97,7 +97,7 @@ func (l *ListMenuByRoleLogic) ListMenuByRole(in *core.StringRequest) (*core.Menu
 			for _, menu := range r.Edges.Menus {
 				if !menuSet[menu.ID] {
 					menuSet[menu.ID] = true
-					resp.List = append(resp.List, ConvertMenuToMenuInfo(menu))
+					resp.List = append(resp.List, LocalizeMenuInfo(l.ctx, ConvertMenuToMenuInfo(menu, l.svcCtx.Config.DefaultLocale)))
 				}
 			}
 		}
@@ -7,6 +7,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -38,7 +39,13 @@ func (l *ListMenuLogic) ListMenu(in *core.MenuListRequest) (*core.MenuListRespon
 		predicates = append(predicates, menu.MenuCodeContains(*in.MenuCode))
 	}
 
-	page, err := l.svcCtx.DBEnt.Menu.Query().Where(predicates...).Order(menu.ByMenuLevel(), menu.BySort(sql.OrderDesc())).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.Menu.Query().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), menu.ByMenuLevel(), menu.BySort(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -50,7 +57,7 @@ func (l *ListMenuLogic) ListMenu(in *core.MenuListRequest) (*core.MenuListRespon
 		},
 	}
 	for _, v := range page.List {
-		resp.List = append(resp.List, ConvertMenuToMenuInfo(v))
+		resp.List = append(resp.List, LocalizeMenuInfo(l.ctx, ConvertMenuToMenuInfo(v, l.svcCtx.Config.DefaultLocale)))
 	}
 	return resp, nil
 }
@@ -1,30 +1,34 @@
 package menuservicelogic
 
 import (
+	"context"
+
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 )
 
 // ConvertMenuToMenuInfo 将 Menu 实体转换为 MenuInfo
-func ConvertMenuToMenuInfo(menu *ent.Menu) *core.MenuInfo {
+func ConvertMenuToMenuInfo(menu *ent.Menu, defaultLocale string) *core.MenuInfo {
 	return &core.MenuInfo{
-		Id:          &menu.ID,
-		CreatedAt:   pointer.ToInt64Ptr(menu.CreatedAt.UnixMilli()),
-		UpdatedAt:   pointer.ToInt64Ptr(menu.UpdatedAt.UnixMilli()),
-		MenuCode:    &menu.MenuCode,
-		MenuName:    &menu.MenuName,
-		ParentId:    menu.ParentID,
-		MenuPath:    menu.MenuPath,
-		State:       &menu.State,
-		Sort:        &menu.Sort,
-		MenuType:    &menu.MenuType,
-		Description: menu.Description,
-		Component:   menu.Component,
-		Redirect:    menu.Redirect,
-		ServiceName: menu.ServiceName,
-		MenuLevel:   pointer.ToUint32Ptr(uint32(menu.MenuLevel)),
-		Permission:  menu.Permission,
+		Id:           &menu.ID,
+		CreatedAt:    pointer.ToInt64Ptr(menu.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointer.ToInt64Ptr(menu.UpdatedAt.UnixMilli()),
+		MenuCode:     &menu.MenuCode,
+		MenuName:     &menu.MenuName,
+		ParentId:     menu.ParentID,
+		MenuPath:     menu.MenuPath,
+		State:        &menu.State,
+		Sort:         &menu.Sort,
+		MenuType:     &menu.MenuType,
+		Description:  menu.Description,
+		Component:    menu.Component,
+		Redirect:     menu.Redirect,
+		ServiceName:  menu.ServiceName,
+		MenuLevel:    pointer.ToUint32Ptr(uint32(menu.MenuLevel)),
+		Permission:   menu.Permission,
+		MenuNameI18N: i18nutils.Expand(defaultLocale, menu.MenuName, menu.MenuNameI18n),
 		Meta: &core.MenuMeta{
 			Title:        &menu.MenuName, // menu_name 对应 meta.title
 			Icon:         menu.Icon,
@@ -38,3 +42,13 @@ func ConvertMenuToMenuInfo(menu *ent.Menu) *core.MenuInfo {
 		},
 	}
 }
+
+// LocalizeMenuInfo 按上下文语言本地化菜单名称，用于菜单列表及菜单树
+func LocalizeMenuInfo(ctx context.Context, info *core.MenuInfo) *core.MenuInfo {
+	name := i18nutils.Localize(ctx, info.GetMenuName(), info.MenuNameI18N)
+	info.MenuName = &name
+	if info.Meta != nil {
+		info.Meta.Title = &name
+	}
+	return info
+}
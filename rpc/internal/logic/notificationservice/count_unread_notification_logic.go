@@ -0,0 +1,46 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CountUnreadNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCountUnreadNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CountUnreadNotificationLogic {
+	return &CountUnreadNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户未读通知数量
+func (l *CountUnreadNotificationLogic) CountUnreadNotification(in *core.UUIDRequest) (*core.NotificationCountResponse, error) {
+	userID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	count, err := l.svcCtx.DBEnt.Notification.Query().
+		Where(visibleTo(userID), withStatus(userID, StatusUnread)).
+		Count(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.NotificationCountResponse{Count: int64(count)}, nil
+}
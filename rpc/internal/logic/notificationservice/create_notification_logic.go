@@ -0,0 +1,54 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateNotificationLogic {
+	return &CreateNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建通知
+func (l *CreateNotificationLogic) CreateNotification(in *core.NotificationInfo) (*core.BaseResponse, error) {
+	fields, err := validateNotification(in)
+	if err != nil {
+		return nil, err
+	}
+
+	create := l.svcCtx.DBEnt.Notification.Create().
+		SetTitle(*in.Title).
+		SetContent(pointer.GetString(in.Content)).
+		SetNillableCategory(in.Category).
+		SetNillableLevel(in.Level).
+		SetTargetAll(pointer.GetBool(in.TargetAll)).
+		SetPublishAt(fields.publishAt).
+		SetNillableExpireAt(fields.expireAt).
+		SetNillableSenderID(fields.senderID).
+		SetNillableState(in.State).
+		AddUserIDs(fields.userIDs...).
+		AddRoleIDs(in.RoleIds...).
+		AddDepartmentIDs(in.DepartmentIds...)
+	if err = create.Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.createSuccess"}, nil
+}
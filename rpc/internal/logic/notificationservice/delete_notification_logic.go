@@ -0,0 +1,39 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteNotificationLogic {
+	return &DeleteNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除通知
+func (l *DeleteNotificationLogic) DeleteNotification(in *core.ID32SRequest) (*core.BaseResponse, error) {
+	_, err := l.svcCtx.DBEnt.Notification.Delete().
+		Where(notification.IDIn(in.Ids...)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
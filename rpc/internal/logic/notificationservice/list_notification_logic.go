@@ -0,0 +1,77 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationLogic {
+	return &ListNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取通知列表
+func (l *ListNotificationLogic) ListNotification(in *core.NotificationListRequest) (*core.NotificationListResponse, error) {
+	var predicates []predicate.Notification
+	if in.Title != nil && *in.Title != "" {
+		predicates = append(predicates, notification.TitleContains(*in.Title))
+	}
+	if in.Category != nil && *in.Category != "" {
+		predicates = append(predicates, notification.CategoryEQ(*in.Category))
+	}
+	if in.State != nil {
+		predicates = append(predicates, notification.State(*in.State))
+	}
+
+	query, err := l.svcCtx.DBEnt.Notification.Query().
+		Where(predicates...).
+		WithUsers(func(q *ent.UserQuery) { q.Select(user.FieldID) }).
+		WithRoles(func(q *ent.RoleQuery) { q.Select(role.FieldID) }).
+		WithDepartments(func(q *ent.DepartmentQuery) { q.Select(department.FieldID) }).
+		ApplyOptions(pageutils.QueryOptions(in.Page), notification.ByPublishAt(sql.OrderDesc()), notification.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	notifications, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.NotificationInfo, 0, len(notifications.List))
+	for _, n := range notifications.List {
+		list = append(list, ConvertNotificationToNotificationInfo(n))
+	}
+
+	return &core.NotificationListResponse{
+		Page: &core.BasePageResp{
+			Total:      notifications.PageDetails.Total,
+			PageNumber: notifications.PageDetails.Page,
+			PageSize:   notifications.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
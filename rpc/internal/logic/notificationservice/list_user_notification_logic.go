@@ -0,0 +1,100 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUserNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserNotificationLogic {
+	return &ListUserNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户可见的通知列表
+func (l *ListUserNotificationLogic) ListUserNotification(in *core.UserNotificationListRequest) (*core.NotificationListResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	query := l.svcCtx.DBEnt.Notification.Query().
+		Where(visibleTo(userID), withStatus(userID, pointer.GetString(in.Status)))
+	if in.Category != nil && *in.Category != "" {
+		query.Where(notification.CategoryEQ(*in.Category))
+	}
+	query, err = query.
+		ApplyOptions(pageutils.QueryOptions(in.Page), notification.ByPublishAt(sql.OrderDesc()), notification.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	notifications, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 补充当前页通知的阅读状态
+	ids := make([]uint32, 0, len(notifications.List))
+	for _, n := range notifications.List {
+		ids = append(ids, n.ID)
+	}
+	recipients, err := l.svcCtx.DBEnt.NotificationRecipient.Query().
+		Where(
+			notificationrecipient.UserID(userID),
+			notificationrecipient.NotificationIDIn(ids...),
+		).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.NotificationInfo, 0, len(notifications.List))
+	for _, n := range notifications.List {
+		info := ConvertNotificationToNotificationInfo(n)
+		// 接收对象不向普通用户返回
+		info.TargetAll = nil
+		info.Status = pointer.ToStringPtr(StatusUnread)
+		for _, r := range recipients {
+			if r.NotificationID == n.ID {
+				info.Status = pointer.ToStringPtr(r.Status)
+				if r.ReadAt != nil {
+					info.ReadAt = pointer.ToInt64Ptr(r.ReadAt.UnixMilli())
+				}
+				break
+			}
+		}
+		list = append(list, info)
+	}
+
+	return &core.NotificationListResponse{
+		Page: &core.BasePageResp{
+			Total:      notifications.PageDetails.Total,
+			PageNumber: notifications.PageDetails.Page,
+			PageSize:   notifications.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
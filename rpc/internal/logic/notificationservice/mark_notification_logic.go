@@ -0,0 +1,122 @@
+package notificationservicelogic
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MarkNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMarkNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MarkNotificationLogic {
+	return &MarkNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 标记用户通知的阅读状态
+func (l *MarkNotificationLogic) MarkNotification(in *core.MarkNotificationRequest) (*core.BaseResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil || !slices.Contains(statuses, in.Status) || (!in.All && len(in.Ids) == 0) {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	// 只能标记自己可见的通知，全部标记为已读时不改变已归档的通知
+	query := tx.Notification.Query().Where(visibleTo(userID))
+	if in.All {
+		if in.Status == StatusRead {
+			query.Where(withStatus(userID, StatusUnread))
+		}
+	} else {
+		query.Where(notification.IDIn(in.Ids...))
+	}
+	ids, err := query.IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	existing, err := tx.NotificationRecipient.Query().
+		Where(notificationrecipient.UserID(userID), notificationrecipient.NotificationIDIn(ids...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	existingIDs := make([]uint32, 0, len(existing))
+	for _, r := range existing {
+		existingIDs = append(existingIDs, r.NotificationID)
+	}
+
+	now := time.Now()
+	if len(existingIDs) > 0 {
+		update := tx.NotificationRecipient.Update().
+			Where(notificationrecipient.UserID(userID), notificationrecipient.NotificationIDIn(existingIDs...)).
+			SetStatus(in.Status)
+		if err = update.Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		if in.Status != StatusUnread {
+			err = tx.NotificationRecipient.Update().
+				Where(
+					notificationrecipient.UserID(userID),
+					notificationrecipient.NotificationIDIn(existingIDs...),
+					notificationrecipient.ReadAtIsNil(),
+				).
+				SetReadAt(now).
+				Exec(l.ctx)
+			if err != nil {
+				return nil, errorhandler.DBEntError(l.Logger, err, in)
+			}
+		}
+	}
+
+	// 未读的通知没有状态记录，标记为未读时无需创建
+	if in.Status != StatusUnread {
+		creates := make([]*ent.NotificationRecipientCreate, 0, len(ids)-len(existingIDs))
+		for _, id := range ids {
+			if slices.Contains(existingIDs, id) {
+				continue
+			}
+			creates = append(creates, tx.NotificationRecipient.Create().
+				SetNotificationID(id).
+				SetUserID(userID).
+				SetStatus(in.Status).
+				SetReadAt(now))
+		}
+		if len(creates) > 0 {
+			if err = tx.NotificationRecipient.CreateBulk(creates...).Exec(l.ctx); err != nil {
+				return nil, errorhandler.DBEntError(l.Logger, err, in)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.updateSuccess"}, nil
+}
@@ -0,0 +1,155 @@
+package notificationservicelogic
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notification"
+	"github.com/wenpiner/last-admin-core/rpc/ent/notificationrecipient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+const (
+	StatusUnread   = "unread"
+	StatusRead     = "read"
+	StatusArchived = "archived"
+)
+
+var (
+	categories = []string{"announcement", "notice", "security"}
+	levels     = []string{"info", "warning", "critical"}
+	statuses   = []string{StatusUnread, StatusRead, StatusArchived}
+)
+
+// notificationFields 创建和更新通知时校验后的字段
+type notificationFields struct {
+	userIDs   []uuid.UUID
+	publishAt time.Time
+	expireAt  *time.Time
+	senderID  *uuid.UUID
+}
+
+// validateNotification 校验通知的分类、级别、接收对象及发布时间窗口
+func validateNotification(in *core.NotificationInfo) (*notificationFields, error) {
+	if pointer.GetString(in.Title) == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if in.Category != nil && !slices.Contains(categories, *in.Category) {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if in.Level != nil && !slices.Contains(levels, *in.Level) {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if !pointer.GetBool(in.TargetAll) && len(in.UserIds) == 0 && len(in.RoleIds) == 0 && len(in.DepartmentIds) == 0 {
+		return nil, errorx.NewInvalidArgumentError("notification.targetRequired")
+	}
+
+	fields := &notificationFields{publishAt: time.Now()}
+	for _, id := range in.UserIds {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		fields.userIDs = append(fields.userIDs, userID)
+	}
+	if in.SenderId != nil && *in.SenderId != "" {
+		senderID, err := uuid.Parse(*in.SenderId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		fields.senderID = &senderID
+	}
+	// 未指定发布时间时立即发布
+	if in.PublishAt != nil && *in.PublishAt > 0 {
+		fields.publishAt = time.UnixMilli(*in.PublishAt)
+	}
+	if in.ExpireAt != nil && *in.ExpireAt > 0 {
+		expireAt := time.UnixMilli(*in.ExpireAt)
+		if !expireAt.After(fields.publishAt) {
+			return nil, errorx.NewInvalidArgumentError("notification.invalidExpireAt")
+		}
+		fields.expireAt = &expireAt
+	}
+	return fields, nil
+}
+
+// visibleTo 用户当前可见的通知：已启用、在发布窗口内，且发送给所有人或该用户本人、所属角色、所属部门
+func visibleTo(userID uuid.UUID) predicate.Notification {
+	now := time.Now()
+	return notification.And(
+		notification.State(true),
+		notification.Or(notification.PublishAtIsNil(), notification.PublishAtLTE(now)),
+		notification.Or(notification.ExpireAtIsNil(), notification.ExpireAtGT(now)),
+		notification.Or(
+			notification.TargetAll(true),
+			notification.HasUsersWith(user.ID(userID)),
+			notification.HasRolesWith(role.State(true), role.HasUsersWith(user.ID(userID))),
+			notification.HasDepartmentsWith(department.HasUsersWith(user.ID(userID))),
+		),
+	)
+}
+
+// withStatus 按用户的阅读状态过滤，未读的通知可能尚无状态记录
+func withStatus(userID uuid.UUID, status string) predicate.Notification {
+	switch status {
+	case StatusUnread:
+		return notification.Not(notification.HasRecipientsWith(
+			notificationrecipient.UserID(userID),
+			notificationrecipient.StatusIn(StatusRead, StatusArchived),
+		))
+	case StatusRead, StatusArchived:
+		return notification.HasRecipientsWith(
+			notificationrecipient.UserID(userID),
+			notificationrecipient.StatusEQ(status),
+		)
+	default:
+		// 默认不返回已归档的通知
+		return notification.Not(notification.HasRecipientsWith(
+			notificationrecipient.UserID(userID),
+			notificationrecipient.StatusEQ(StatusArchived),
+		))
+	}
+}
+
+// ConvertNotificationToNotificationInfo 转换通知实体，需预先加载接收对象
+func ConvertNotificationToNotificationInfo(n *ent.Notification) *core.NotificationInfo {
+	info := &core.NotificationInfo{
+		Id:        &n.ID,
+		CreatedAt: pointer.ToInt64Ptr(n.CreatedAt.UnixMilli()),
+		UpdatedAt: pointer.ToInt64Ptr(n.UpdatedAt.UnixMilli()),
+		State:     &n.State,
+		Title:     &n.Title,
+		Content:   pointer.ToStringPtrIfNotEmpty(n.Content),
+		Category:  &n.Category,
+		Level:     &n.Level,
+		TargetAll: &n.TargetAll,
+	}
+	if n.PublishAt != nil {
+		info.PublishAt = pointer.ToInt64Ptr(n.PublishAt.UnixMilli())
+	}
+	if n.ExpireAt != nil {
+		info.ExpireAt = pointer.ToInt64Ptr(n.ExpireAt.UnixMilli())
+	}
+	if n.SenderID != nil {
+		info.SenderId = pointer.ToStringPtr(n.SenderID.String())
+	}
+	for _, u := range n.Edges.Users {
+		info.UserIds = append(info.UserIds, u.ID.String())
+	}
+	for _, r := range n.Edges.Roles {
+		info.RoleIds = append(info.RoleIds, r.ID)
+	}
+	for _, d := range n.Edges.Departments {
+		info.DepartmentIds = append(info.DepartmentIds, d.ID)
+	}
+	return info
+}
@@ -0,0 +1,172 @@
+package notificationservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func createUser(t *testing.T, db *ent.Client, username string) *ent.User {
+	t.Helper()
+	u, err := db.User.Create().SetUsername(username).SetPasswordHash("-").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func notify(t *testing.T, svcCtx *svc.ServiceContext, in *core.NotificationInfo) {
+	t.Helper()
+	if _, err := NewCreateNotificationLogic(context.Background(), svcCtx).CreateNotification(in); err != nil {
+		t.Fatalf("create %s: %v", pointer.GetString(in.Title), err)
+	}
+}
+
+func titles(t *testing.T, svcCtx *svc.ServiceContext, userID, status string) []string {
+	t.Helper()
+	resp, err := NewListUserNotificationLogic(context.Background(), svcCtx).ListUserNotification(&core.UserNotificationListRequest{
+		Page:   &core.BasePageRequest{PageNumber: 1, PageSize: 100},
+		UserId: userID,
+		Status: pointer.ToStringPtrIfNotEmpty(status),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var list []string
+	for _, n := range resp.List {
+		list = append(list, pointer.GetString(n.Title))
+	}
+	slices.Sort(list)
+	return list
+}
+
+func TestNotificationTargetingAndWindow(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	db := svcCtx.DBEnt
+
+	alice := createUser(t, db, "alice")
+	bob := createUser(t, db, "bob")
+	ops := db.Role.Create().SetRoleCode("ops").SetRoleName("ops").AddUsers(alice).SaveX(ctx)
+	dept := db.Department.Create().SetDeptCode("rd").SetDeptName("rd").SaveX(ctx)
+	db.User.UpdateOne(bob).SetDepartmentID(dept.ID).ExecX(ctx)
+
+	now := time.Now()
+	notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr("all"), TargetAll: pointer.ToBoolPtr(true)})
+	notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr("alice"), UserIds: []string{alice.ID.String()}})
+	notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr("role"), RoleIds: []uint32{ops.ID}})
+	notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr("department"), DepartmentIds: []uint32{dept.ID}})
+	notify(t, svcCtx, &core.NotificationInfo{
+		Title:     pointer.ToStringPtr("scheduled"),
+		TargetAll: pointer.ToBoolPtr(true),
+		PublishAt: pointer.ToInt64Ptr(now.Add(time.Hour).UnixMilli()),
+	})
+	notify(t, svcCtx, &core.NotificationInfo{
+		Title:     pointer.ToStringPtr("expired"),
+		TargetAll: pointer.ToBoolPtr(true),
+		PublishAt: pointer.ToInt64Ptr(now.Add(-2 * time.Hour).UnixMilli()),
+		ExpireAt:  pointer.ToInt64Ptr(now.Add(-time.Hour).UnixMilli()),
+	})
+	notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr("disabled"), TargetAll: pointer.ToBoolPtr(true), State: pointer.ToBoolPtr(false)})
+
+	if got, want := titles(t, svcCtx, alice.ID.String(), ""), []string{"alice", "all", "role"}; !slices.Equal(got, want) {
+		t.Fatalf("alice sees %v, want %v", got, want)
+	}
+	if got, want := titles(t, svcCtx, bob.ID.String(), ""), []string{"all", "department"}; !slices.Equal(got, want) {
+		t.Fatalf("bob sees %v, want %v", got, want)
+	}
+
+	// 禁用角色后不再向角色成员展示
+	db.Role.UpdateOne(ops).SetState(false).ExecX(ctx)
+	if got, want := titles(t, svcCtx, alice.ID.String(), ""), []string{"alice", "all"}; !slices.Equal(got, want) {
+		t.Fatalf("alice sees %v after role disabled, want %v", got, want)
+	}
+}
+
+func TestNotificationReadStatus(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	alice := createUser(t, svcCtx.DBEnt, "alice").ID.String()
+	for _, title := range []string{"a", "b", "c"} {
+		notify(t, svcCtx, &core.NotificationInfo{Title: pointer.ToStringPtr(title), TargetAll: pointer.ToBoolPtr(true)})
+	}
+	ids := svcCtx.DBEnt.Notification.Query().Order(ent.Asc("id")).IDsX(ctx)
+
+	unread := func() int64 {
+		t.Helper()
+		resp, err := NewCountUnreadNotificationLogic(ctx, svcCtx).CountUnreadNotification(&core.UUIDRequest{Id: alice})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Count
+	}
+	mark := func(status string, all bool, ids ...uint32) {
+		t.Helper()
+		_, err := NewMarkNotificationLogic(ctx, svcCtx).MarkNotification(&core.MarkNotificationRequest{
+			UserId: alice, Ids: ids, All: all, Status: status,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := unread(); n != 3 {
+		t.Fatalf("unread = %d, want 3", n)
+	}
+	mark(StatusRead, false, ids[0])
+	mark(StatusArchived, false, ids[1])
+	if n := unread(); n != 1 {
+		t.Fatalf("unread = %d, want 1", n)
+	}
+	// 默认列表不包含已归档的通知
+	if got, want := titles(t, svcCtx, alice, ""), []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("list = %v, want %v", got, want)
+	}
+	if got, want := titles(t, svcCtx, alice, StatusArchived), []string{"b"}; !slices.Equal(got, want) {
+		t.Fatalf("archived = %v, want %v", got, want)
+	}
+
+	// 全部已读不改变已归档的通知
+	mark(StatusRead, true)
+	if n := unread(); n != 0 {
+		t.Fatalf("unread = %d, want 0", n)
+	}
+	if got, want := titles(t, svcCtx, alice, StatusArchived), []string{"b"}; !slices.Equal(got, want) {
+		t.Fatalf("archived after mark all = %v, want %v", got, want)
+	}
+	mark(StatusUnread, false, ids[0])
+	if got, want := titles(t, svcCtx, alice, StatusUnread), []string{"a"}; !slices.Equal(got, want) {
+		t.Fatalf("unread list = %v, want %v", got, want)
+	}
+}
+
+func TestValidateNotification(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		in   *core.NotificationInfo
+		ok   bool
+	}{
+		{"noTarget", &core.NotificationInfo{Title: pointer.ToStringPtr("t")}, false},
+		{"badCategory", &core.NotificationInfo{Title: pointer.ToStringPtr("t"), TargetAll: pointer.ToBoolPtr(true), Category: pointer.ToStringPtr("x")}, false},
+		{"expireBeforePublish", &core.NotificationInfo{
+			Title:     pointer.ToStringPtr("t"),
+			TargetAll: pointer.ToBoolPtr(true),
+			PublishAt: pointer.ToInt64Ptr(now.Add(time.Hour).UnixMilli()),
+			ExpireAt:  pointer.ToInt64Ptr(now.UnixMilli()),
+		}, false},
+		{"valid", &core.NotificationInfo{Title: pointer.ToStringPtr("t"), RoleIds: []uint32{1}}, true},
+	}
+	for _, tt := range tests {
+		if _, err := validateNotification(tt.in); (err == nil) != tt.ok {
+			t.Errorf("%s: err = %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package notificationservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateNotificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateNotificationLogic {
+	return &UpdateNotificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 更新通知
+func (l *UpdateNotificationLogic) UpdateNotification(in *core.NotificationInfo) (*core.BaseResponse, error) {
+	if in.Id == nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	fields, err := validateNotification(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 接收对象整体替换，已产生的阅读状态保留
+	update := l.svcCtx.DBEnt.Notification.UpdateOneID(*in.Id).
+		SetTitle(*in.Title).
+		SetContent(pointer.GetString(in.Content)).
+		SetNillableCategory(in.Category).
+		SetNillableLevel(in.Level).
+		SetTargetAll(pointer.GetBool(in.TargetAll)).
+		SetPublishAt(fields.publishAt).
+		ClearExpireAt().
+		SetNillableExpireAt(fields.expireAt).
+		SetNillableState(in.State).
+		ClearUsers().
+		AddUserIDs(fields.userIDs...).
+		ClearRoles().
+		AddRoleIDs(in.RoleIds...).
+		ClearDepartments().
+		AddDepartmentIDs(in.DepartmentIds...)
+	if err = update.Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.updateSuccess"}, nil
+}
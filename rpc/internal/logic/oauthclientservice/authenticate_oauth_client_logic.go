@@ -0,0 +1,52 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthenticateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAuthenticateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthenticateOauthClientLogic {
+	return &AuthenticateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 认证OAuth客户端
+func (l *AuthenticateOauthClientLogic) AuthenticateOauthClient(in *core.OauthClientAuthRequest) (*core.OauthClientInfo, error) {
+	client, err := l.svcCtx.DBEnt.OauthClient.Query().
+		Where(oauthclient.ClientIDEQ(in.ClientId), oauthclient.State(true)).
+		Only(l.ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errorx.NewUnauthenticatedError("oauthClient.invalidClient")
+		}
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 提供了密钥时必须为机密客户端且密钥匹配
+	if in.ClientSecret != nil {
+		if client.Public || client.ClientSecretHash == "" || !encrypt.BcryptCheck(*in.ClientSecret, client.ClientSecretHash) {
+			return nil, errorx.NewUnauthenticatedError("oauthClient.invalidClient")
+		}
+	}
+
+	return ConvertOauthClientToOauthClientInfo(client), nil
+}
@@ -0,0 +1,78 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOauthClientLogic {
+	return &CreateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建OAuth客户端
+func (l *CreateOauthClientLogic) CreateOauthClient(in *core.OauthClientInfo) (*core.OauthClientSecretResponse, error) {
+	public := pointer.GetBool(in.Public)
+	fields, err := validateOauthClient(in, public)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := pointer.GetString(in.ClientId)
+	if clientID == "" {
+		if clientID, err = generateClientID(); err != nil {
+			l.Errorw("生成客户端ID失败", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("oauthClient.generateFailed")
+		}
+	}
+
+	create := l.svcCtx.DBEnt.OauthClient.Create().
+		SetClientID(clientID).
+		SetName(*in.Name).
+		SetRedirectUris(fields.redirectURIs).
+		SetGrantTypes(fields.grantTypes).
+		SetScopes(fields.scopes).
+		SetPublic(public).
+		SetSkipConsent(pointer.GetBool(in.SkipConsent)).
+		SetNillableLogo(in.Logo).
+		SetNillableDescription(in.Description).
+		SetState(in.State == nil || *in.State)
+
+	// 公共客户端不签发密钥
+	var secret string
+	if !public {
+		var hash string
+		if secret, hash, err = generateClientSecret(); err != nil {
+			l.Errorw("生成客户端密钥失败", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("oauthClient.generateFailed")
+		}
+		create.SetClientSecretHash(hash)
+	}
+
+	client, err := create.Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.OauthClientSecretResponse{
+		Info:         ConvertOauthClientToOauthClientInfo(client),
+		ClientSecret: pointer.ToStringPtrIfNotEmpty(secret),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthClientLogic {
+	return &DeleteOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除OAuth客户端
+func (l *DeleteOauthClientLogic) DeleteOauthClient(in *core.ID32Request) (*core.BaseResponse, error) {
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	client, err := tx.OauthClient.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 删除客户端时一并清理用户授权记录
+	if _, err = tx.OauthConsent.Delete().Where(oauthconsent.ClientIDEQ(client.ClientID)).Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if err = tx.OauthClient.DeleteOneID(client.ID).Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
@@ -0,0 +1,49 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOauthConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOauthConsentLogic {
+	return &DeleteOauthConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 撤销用户对客户端的授权
+func (l *DeleteOauthConsentLogic) DeleteOauthConsent(in *core.OauthConsentRequest) (*core.BaseResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	affected, err := l.svcCtx.DBEnt.OauthConsent.Delete().
+		Where(oauthconsent.UserIDEQ(userID), oauthconsent.ClientIDEQ(in.ClientId)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if affected == 0 {
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	}
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
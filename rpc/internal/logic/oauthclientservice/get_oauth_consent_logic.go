@@ -0,0 +1,47 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOauthConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOauthConsentLogic {
+	return &GetOauthConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户对客户端的授权记录
+func (l *GetOauthConsentLogic) GetOauthConsent(in *core.OauthConsentRequest) (*core.OauthConsentInfo, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	consent, err := l.svcCtx.DBEnt.OauthConsent.Query().
+		Where(oauthconsent.UserIDEQ(userID), oauthconsent.ClientIDEQ(in.ClientId)).
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return ConvertOauthConsentToOauthConsentInfo(consent), nil
+}
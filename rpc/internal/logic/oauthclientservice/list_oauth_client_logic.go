@@ -0,0 +1,67 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOauthClientLogic {
+	return &ListOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取OAuth客户端列表
+func (l *ListOauthClientLogic) ListOauthClient(in *core.OauthClientListRequest) (*core.OauthClientListResponse, error) {
+	var predicates []predicate.OauthClient
+	if in.Name != nil && *in.Name != "" {
+		predicates = append(predicates, oauthclient.NameContains(*in.Name))
+	}
+	if in.ClientId != nil && *in.ClientId != "" {
+		predicates = append(predicates, oauthclient.ClientIDEQ(*in.ClientId))
+	}
+
+	query, err := l.svcCtx.DBEnt.OauthClient.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), oauthclient.ByCreatedAt(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	clients, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.OauthClientInfo, 0, len(clients.List))
+	for _, client := range clients.List {
+		list = append(list, ConvertOauthClientToOauthClientInfo(client))
+	}
+
+	return &core.OauthClientListResponse{
+		Page: &core.BasePageResp{
+			Total:      clients.PageDetails.Total,
+			PageNumber: clients.PageDetails.Page,
+			PageSize:   clients.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthclient"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOauthConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOauthConsentLogic {
+	return &ListOauthConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户的授权记录列表
+func (l *ListOauthConsentLogic) ListOauthConsent(in *core.UUIDRequest) (*core.OauthConsentListResponse, error) {
+	userID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	consents, err := l.svcCtx.DBEnt.OauthConsent.Query().
+		Where(oauthconsent.UserIDEQ(userID)).
+		Order(oauthconsent.ByUpdatedAt(sql.OrderDesc())).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 补充客户端名称及图标
+	clientIDs := make([]string, 0, len(consents))
+	for _, consent := range consents {
+		clientIDs = append(clientIDs, consent.ClientID)
+	}
+	clients, err := l.svcCtx.DBEnt.OauthClient.Query().
+		Where(oauthclient.ClientIDIn(clientIDs...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	clientMap := make(map[string]*core.OauthClientInfo, len(clients))
+	for _, client := range clients {
+		clientMap[client.ClientID] = ConvertOauthClientToOauthClientInfo(client)
+	}
+
+	list := make([]*core.OauthConsentInfo, 0, len(consents))
+	for _, consent := range consents {
+		info := ConvertOauthConsentToOauthConsentInfo(consent)
+		if client, ok := clientMap[consent.ClientID]; ok {
+			info.ClientName = client.Name
+			info.ClientLogo = client.Logo
+		}
+		list = append(list, info)
+	}
+
+	return &core.OauthConsentListResponse{List: list}, nil
+}
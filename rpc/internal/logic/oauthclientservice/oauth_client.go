@@ -0,0 +1,130 @@
+package oauthclientservicelogic
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strings"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// supportedGrantTypes 支持的授权类型
+var supportedGrantTypes = []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials}
+
+// oauthClientFields 创建和更新客户端时校验后的字段
+type oauthClientFields struct {
+	redirectURIs []string
+	grantTypes   []string
+	scopes       []string
+}
+
+// validateOauthClient 校验客户端名称、回调地址、授权类型及权限范围
+func validateOauthClient(in *core.OauthClientInfo, public bool) (*oauthClientFields, error) {
+	if in.Name == nil || *in.Name == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	fields := &oauthClientFields{}
+	for _, grantType := range in.GrantTypes {
+		if !slices.Contains(supportedGrantTypes, grantType) {
+			return nil, errorx.NewInvalidArgumentError("oauthClient.invalidGrantType")
+		}
+		if !slices.Contains(fields.grantTypes, grantType) {
+			fields.grantTypes = append(fields.grantTypes, grantType)
+		}
+	}
+	if len(fields.grantTypes) == 0 {
+		return nil, errorx.NewInvalidArgumentError("oauthClient.invalidGrantType")
+	}
+	// 公共客户端无法保管密钥，不能使用客户端凭证模式
+	if public && slices.Contains(fields.grantTypes, GrantTypeClientCredentials) {
+		return nil, errorx.NewInvalidArgumentError("oauthClient.publicClientCredentials")
+	}
+
+	for _, uri := range in.RedirectUris {
+		u, err := url.Parse(uri)
+		if err != nil || u.Scheme == "" || u.Host == "" || u.Fragment != "" {
+			return nil, errorx.NewInvalidArgumentError("oauthClient.invalidRedirectUri")
+		}
+		if !slices.Contains(fields.redirectURIs, uri) {
+			fields.redirectURIs = append(fields.redirectURIs, uri)
+		}
+	}
+	if slices.Contains(fields.grantTypes, GrantTypeAuthorizationCode) && len(fields.redirectURIs) == 0 {
+		return nil, errorx.NewInvalidArgumentError("oauthClient.invalidRedirectUri")
+	}
+
+	for _, scope := range in.Scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t\"\\") {
+			return nil, errorx.NewInvalidArgumentError("oauthClient.invalidScope")
+		}
+		if !slices.Contains(fields.scopes, scope) {
+			fields.scopes = append(fields.scopes, scope)
+		}
+	}
+	return fields, nil
+}
+
+// generateClientID 生成随机客户端ID
+func generateClientID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// generateClientSecret 生成随机客户端密钥，返回明文及哈希
+func generateClientSecret() (secret, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret = base64.RawURLEncoding.EncodeToString(buf)
+	return secret, encrypt.BcryptEncrypt(secret), nil
+}
+
+// ConvertOauthClientToOauthClientInfo 将 OauthClient 实体转换为 OauthClientInfo，不包含密钥哈希
+func ConvertOauthClientToOauthClientInfo(client *ent.OauthClient) *core.OauthClientInfo {
+	return &core.OauthClientInfo{
+		Id:           pointer.ToUint32Ptr(client.ID),
+		CreatedAt:    pointer.ToInt64Ptr(client.CreatedAt.UnixMilli()),
+		UpdatedAt:    pointer.ToInt64Ptr(client.UpdatedAt.UnixMilli()),
+		State:        pointer.ToBoolPtr(client.State),
+		ClientId:     pointer.ToStringPtr(client.ClientID),
+		Name:         pointer.ToStringPtr(client.Name),
+		RedirectUris: client.RedirectUris,
+		GrantTypes:   client.GrantTypes,
+		Scopes:       client.Scopes,
+		Public:       pointer.ToBoolPtr(client.Public),
+		SkipConsent:  pointer.ToBoolPtr(client.SkipConsent),
+		Logo:         pointer.ToStringPtrIfNotEmpty(client.Logo),
+		Description:  pointer.ToStringPtrIfNotEmpty(client.Description),
+	}
+}
+
+// ConvertOauthConsentToOauthConsentInfo 将 OauthConsent 实体转换为 OauthConsentInfo
+func ConvertOauthConsentToOauthConsentInfo(consent *ent.OauthConsent) *core.OauthConsentInfo {
+	return &core.OauthConsentInfo{
+		Id:        pointer.ToUint32Ptr(consent.ID),
+		CreatedAt: pointer.ToInt64Ptr(consent.CreatedAt.UnixMilli()),
+		UpdatedAt: pointer.ToInt64Ptr(consent.UpdatedAt.UnixMilli()),
+		UserId:    consent.UserID.String(),
+		ClientId:  consent.ClientID,
+		Scopes:    consent.Scopes,
+	}
+}
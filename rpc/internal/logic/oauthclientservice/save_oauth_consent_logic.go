@@ -0,0 +1,70 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	"slices"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthconsent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SaveOauthConsentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSaveOauthConsentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SaveOauthConsentLogic {
+	return &SaveOauthConsentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 保存用户对客户端的授权记录
+func (l *SaveOauthConsentLogic) SaveOauthConsent(in *core.OauthConsentInfo) (*core.BaseResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil || in.ClientId == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	existing, err := l.svcCtx.DBEnt.OauthConsent.Query().
+		Where(oauthconsent.UserIDEQ(userID), oauthconsent.ClientIDEQ(in.ClientId)).
+		Only(l.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	if existing == nil {
+		err = l.svcCtx.DBEnt.OauthConsent.Create().
+			SetUserID(userID).
+			SetClientID(in.ClientId).
+			SetScopes(in.Scopes).
+			Exec(l.ctx)
+	} else {
+		// 合并已授权的权限范围
+		scopes := slices.Clone(existing.Scopes)
+		for _, scope := range in.Scopes {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+		err = existing.Update().SetScopes(scopes).Exec(l.ctx)
+	}
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.updateSuccess"}, nil
+}
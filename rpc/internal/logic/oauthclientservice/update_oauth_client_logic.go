@@ -0,0 +1,78 @@
+package oauthclientservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateOauthClientLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateOauthClientLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateOauthClientLogic {
+	return &UpdateOauthClientLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 更新OAuth客户端
+func (l *UpdateOauthClientLogic) UpdateOauthClient(in *core.OauthClientInfo) (*core.OauthClientSecretResponse, error) {
+	if in.Id == nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	// 客户端ID及客户端类型不可修改
+	current, err := l.svcCtx.DBEnt.OauthClient.Get(l.ctx, *in.Id)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	fields, err := validateOauthClient(in, current.Public)
+	if err != nil {
+		return nil, err
+	}
+
+	update := l.svcCtx.DBEnt.OauthClient.UpdateOneID(current.ID).
+		SetName(*in.Name).
+		SetRedirectUris(fields.redirectURIs).
+		SetGrantTypes(fields.grantTypes).
+		SetScopes(fields.scopes).
+		SetSkipConsent(pointer.GetBool(in.SkipConsent)).
+		SetLogo(pointer.GetString(in.Logo)).
+		SetDescription(pointer.GetString(in.Description))
+	if in.State != nil {
+		update.SetState(*in.State)
+	}
+
+	var secret string
+	if pointer.GetBool(in.ResetSecret) && !current.Public {
+		var hash string
+		if secret, hash, err = generateClientSecret(); err != nil {
+			l.Errorw("生成客户端密钥失败", logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("oauthClient.generateFailed")
+		}
+		update.SetClientSecretHash(hash)
+	}
+
+	client, err := update.Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.OauthClientSecretResponse{
+		Info:         ConvertOauthClientToOauthClientInfo(client),
+		ClientSecret: pointer.ToStringPtrIfNotEmpty(secret),
+	}, nil
+}
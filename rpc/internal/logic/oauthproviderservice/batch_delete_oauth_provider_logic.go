@@ -0,0 +1,58 @@
+package oauthproviderservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/oauthprovider"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteOauthProviderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteOauthProviderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteOauthProviderLogic {
+	return &BatchDeleteOauthProviderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除第三方登录提供商
+func (l *BatchDeleteOauthProviderLogic) BatchDeleteOauthProvider(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.OauthProvider.Query().Where(oauthprovider.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if _, err = tx.OauthProvider.Delete().Where(oauthprovider.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
@@ -37,6 +37,6 @@ func (l *DeleteOauthProviderLogic) DeleteOauthProvider(in *core.ID32Request) (*c
 	}
 
 	return &core.BaseResponse{
-		Message: "删除成功",
+		Message: "common.deleteSuccess",
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -49,7 +50,12 @@ func (l *ListOauthProviderLogic) ListOauthProvider(in *core.OauthProviderListReq
 	}
 
 	// 执行分页查询
-	query := l.svcCtx.DBEnt.OauthProvider.Query().Where(predicates...)
+	query, err := l.svcCtx.DBEnt.OauthProvider.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
 
 	// 分页查询
 	providers, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
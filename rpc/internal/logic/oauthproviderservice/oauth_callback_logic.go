@@ -15,6 +15,8 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 	"golang.org/x/oauth2"
 
@@ -89,6 +91,11 @@ func (l *OauthCallbackLogic) OauthCallback(in *core.OauthCallbackRequest) (*core
 	// 7. 返回本地用户信息
 	u := l.convertUserToUserInfo(localUser)
 	u.ProviderId = &provider.ID
+	// 与其他登录方式一致，返回密码是否过期，由调用方决定是否签发令牌
+	userutils.FillPasswordExpired(u, localUser, passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache))
+	if u.EffectiveRoleValues, err = userutils.EffectiveRoleValues(l.ctx, l.svcCtx.DBEnt, localUser.ID); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
 	return u, nil
 }
 
@@ -129,14 +136,15 @@ func (l *OauthCallbackLogic) findLocalUser(oauthUserInfo *OAuthUserInfo) (*ent.U
 // convertUserToUserInfo 将用户实体转换为 UserInfo
 func (l *OauthCallbackLogic) convertUserToUserInfo(userEntity *ent.User) *core.UserInfo {
 	return &core.UserInfo{
-		Id:           pointer.ToStringPtrIfNotEmpty(userEntity.ID.String()),
-		CreatedAt:    pointer.ToInt64Ptr(userEntity.CreatedAt.UnixMilli()),
-		UpdatedAt:    pointer.ToInt64Ptr(userEntity.UpdatedAt.UnixMilli()),
-		Username:     &userEntity.Username,
-		Email:        pointer.ToStringPtrIfNotEmpty(userEntity.Email),
-		Mobile:       pointer.ToStringPtrIfNotEmpty(userEntity.Mobile),
-		Avatar:       pointer.ToStringPtrIfNotEmpty(userEntity.Avatar),
-		DepartmentId: &userEntity.DepartmentID,
+		Id:                 pointer.ToStringPtrIfNotEmpty(userEntity.ID.String()),
+		CreatedAt:          pointer.ToInt64Ptr(userEntity.CreatedAt.UnixMilli()),
+		UpdatedAt:          pointer.ToInt64Ptr(userEntity.UpdatedAt.UnixMilli()),
+		Username:           &userEntity.Username,
+		Email:              pointer.ToStringPtrIfNotEmpty(userEntity.Email),
+		Mobile:             pointer.ToStringPtrIfNotEmpty(userEntity.Mobile),
+		Avatar:             pointer.ToStringPtrIfNotEmpty(userEntity.Avatar),
+		DepartmentId:       &userEntity.DepartmentID,
+		MustChangePassword: pointer.ToBoolPtr(userEntity.MustChangePassword),
 	}
 }
 
@@ -0,0 +1,67 @@
+package operationlogservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOperationLogLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateOperationLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOperationLogLogic {
+	return &CreateOperationLogLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateOperationLogLogic) CreateOperationLog(in *core.OperationLogInfo) (*core.BaseResponse, error) {
+	if in.Module == nil || *in.Module == "" || in.OperationType == nil || *in.OperationType == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	create := l.svcCtx.DBEnt.OperationLog.Create().
+		SetModule(*in.Module).
+		SetOperationType(*in.OperationType).
+		SetNillableUsername(in.Username).
+		SetNillableBusinessType(in.BusinessType).
+		SetNillableMethod(in.Method).
+		SetNillableRequestURL(in.RequestUrl).
+		SetNillableRequestParams(in.RequestParams).
+		SetNillableResponseData(in.ResponseData).
+		SetNillableIPAddress(in.IpAddress).
+		SetNillableUserAgent(in.UserAgent).
+		SetNillableLocation(in.Location).
+		SetNillableIsSuccess(in.IsSuccess).
+		SetNillableErrorMessage(in.ErrorMessage).
+		SetNillableDescription(in.Description)
+	if in.UserId != nil && *in.UserId != "" {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		create.SetUserID(userID)
+	}
+	if in.ExecutionTime != nil {
+		create.SetExecutionTime(int(*in.ExecutionTime))
+	}
+
+	if err := create.Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.createSuccess"}, nil
+}
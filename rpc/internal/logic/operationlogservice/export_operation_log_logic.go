@@ -0,0 +1,61 @@
+package operationlogservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// exportBatchSize 导出时每批读取的记录数
+const exportBatchSize = 500
+
+type ExportOperationLogLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportOperationLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportOperationLogLogic {
+	return &ExportOperationLogLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 按列表条件导出操作记录，忽略分页参数
+func (l *ExportOperationLogLogic) ExportOperationLog(in *core.OperationLogListRequest, stream core.OperationLogService_ExportOperationLogServer) error {
+	predicates, err := operationLogPredicates(in)
+	if err != nil {
+		return err
+	}
+
+	// 按ID分批读取，避免一次加载全部记录
+	var lastID uint32
+	for {
+		logs, err := l.svcCtx.DBEnt.OperationLog.Query().
+			Where(predicates...).
+			Where(operationlog.IDGT(lastID)).
+			Order(operationlog.ByID()).
+			Limit(exportBatchSize).
+			All(l.ctx)
+		if err != nil {
+			return errorhandler.DBEntError(l.Logger, err, in)
+		}
+		for _, log := range logs {
+			if err = stream.Send(ConvertOperationLogToOperationLogInfo(log)); err != nil {
+				return err
+			}
+		}
+		if len(logs) < exportBatchSize {
+			return nil
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+}
@@ -0,0 +1,72 @@
+package operationlogservicelogic
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOperationLogArchiveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOperationLogArchiveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOperationLogArchiveLogic {
+	return &ListOperationLogArchiveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取归档列表
+func (l *ListOperationLogArchiveLogic) ListOperationLogArchive(in *core.OperationLogArchiveListRequest) (*core.OperationLogArchiveListResponse, error) {
+	var predicates []predicate.OperationLogArchive
+	if in.Module != nil && *in.Module != "" {
+		predicates = append(predicates, operationlogarchive.ModuleEQ(*in.Module))
+	}
+	// 返回与查询时间范围有交集的归档
+	if in.StartTime != nil && *in.StartTime > 0 {
+		predicates = append(predicates, operationlogarchive.EndTimeGTE(time.UnixMilli(*in.StartTime)))
+	}
+	if in.EndTime != nil && *in.EndTime > 0 {
+		predicates = append(predicates, operationlogarchive.StartTimeLTE(time.UnixMilli(*in.EndTime)))
+	}
+
+	query, err := l.svcCtx.DBEnt.OperationLogArchive.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), operationlogarchive.ByStartTime(sql.OrderDesc()), operationlogarchive.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	archives, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.OperationLogArchiveInfo, 0, len(archives.List))
+	for _, archive := range archives.List {
+		list = append(list, ConvertArchiveToArchiveInfo(archive))
+	}
+
+	return &core.OperationLogArchiveListResponse{
+		Page: &core.BasePageResp{
+			Total:      archives.PageDetails.Total,
+			PageNumber: archives.PageDetails.Page,
+			PageSize:   archives.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
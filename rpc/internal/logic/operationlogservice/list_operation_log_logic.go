@@ -0,0 +1,82 @@
+package operationlogservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOperationLogLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOperationLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOperationLogLogic {
+	return &ListOperationLogLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListOperationLogLogic) ListOperationLog(in *core.OperationLogListRequest) (*core.OperationLogListResponse, error) {
+	predicates, err := operationLogPredicates(in)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := l.svcCtx.DBEnt.OperationLog.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), operationlog.ByCreatedAt(sql.OrderDesc()), operationlog.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	var (
+		logs []*ent.OperationLog
+		page *core.BasePageResp
+	)
+	if pageutils.IsCursor(in.Page) {
+		// 游标分页不统计总数，适合数据量大的表连续翻页
+		req, err := pageutils.CursorRequest(in.Page, operationlog.FieldCreatedAt, true)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		result, err := query.CursorPage(l.ctx, req)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		logs, page = result.List, pageutils.CursorResp(result.PageDetails)
+	} else {
+		result, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		logs = result.List
+		page = &core.BasePageResp{
+			Total:      result.PageDetails.Total,
+			PageNumber: result.PageDetails.Page,
+			PageSize:   result.PageDetails.Pages,
+		}
+	}
+
+	list := make([]*core.OperationLogInfo, 0, len(logs))
+	for _, log := range logs {
+		list = append(list, ConvertOperationLogToOperationLogInfo(log))
+	}
+
+	return &core.OperationLogListResponse{
+		Page: page,
+		List: list,
+	}, nil
+}
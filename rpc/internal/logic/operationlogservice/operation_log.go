@@ -0,0 +1,136 @@
+package operationlogservicelogic
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// operationLogPredicates 列表与导出共用的筛选条件
+func operationLogPredicates(in *core.OperationLogListRequest) ([]predicate.OperationLog, error) {
+	var predicates []predicate.OperationLog
+	if in.UserId != nil && *in.UserId != "" {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		predicates = append(predicates, operationlog.UserIDEQ(userID))
+	}
+	if in.Username != nil && *in.Username != "" {
+		predicates = append(predicates, operationlog.UsernameContains(*in.Username))
+	}
+	if in.OperationType != nil && *in.OperationType != "" {
+		predicates = append(predicates, operationlog.OperationTypeEQ(*in.OperationType))
+	}
+	if in.Module != nil && *in.Module != "" {
+		predicates = append(predicates, operationlog.ModuleEQ(*in.Module))
+	}
+	if in.BusinessType != nil && *in.BusinessType != "" {
+		predicates = append(predicates, operationlog.BusinessTypeEQ(*in.BusinessType))
+	}
+	if in.Method != nil && *in.Method != "" {
+		predicates = append(predicates, operationlog.MethodEQ(strings.ToUpper(*in.Method)))
+	}
+	if in.RequestUrl != nil && *in.RequestUrl != "" {
+		predicates = append(predicates, operationlog.RequestURLContains(*in.RequestUrl))
+	}
+	if in.RequestParams != nil && *in.RequestParams != "" {
+		predicates = append(predicates, operationlog.RequestParamsContains(*in.RequestParams))
+	}
+	if in.ResponseData != nil && *in.ResponseData != "" {
+		predicates = append(predicates, operationlog.ResponseDataContains(*in.ResponseData))
+	}
+	if in.IpAddress != nil && *in.IpAddress != "" {
+		predicates = append(predicates, operationlog.IPAddressContains(*in.IpAddress))
+	}
+	if in.UserAgent != nil && *in.UserAgent != "" {
+		predicates = append(predicates, operationlog.UserAgentContains(*in.UserAgent))
+	}
+	if in.Location != nil && *in.Location != "" {
+		predicates = append(predicates, operationlog.LocationContains(*in.Location))
+	}
+	if in.IsSuccess != nil {
+		predicates = append(predicates, operationlog.IsSuccessEQ(*in.IsSuccess))
+	}
+	if r := in.ExecutionTime; r != nil {
+		if r.StartTime != nil && *r.StartTime != "" {
+			start, err := parseMilli(*r.StartTime)
+			if err != nil {
+				return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+			}
+			predicates = append(predicates, operationlog.CreatedAtGTE(start))
+		}
+		if r.EndTime != nil && *r.EndTime != "" {
+			end, err := parseMilli(*r.EndTime)
+			if err != nil {
+				return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+			}
+			predicates = append(predicates, operationlog.CreatedAtLTE(end))
+		}
+	}
+	return predicates, nil
+}
+
+// parseMilli 解析毫秒时间戳字符串
+func parseMilli(s string) (time.Time, error) {
+	ms, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// ConvertOperationLogToOperationLogInfo 转换操作记录实体
+func ConvertOperationLogToOperationLogInfo(log *ent.OperationLog) *core.OperationLogInfo {
+	info := &core.OperationLogInfo{
+		Id:            &log.ID,
+		CreatedAt:     pointer.ToInt64Ptr(log.CreatedAt.UnixMilli()),
+		UpdatedAt:     pointer.ToInt64Ptr(log.UpdatedAt.UnixMilli()),
+		Username:      log.Username,
+		OperationType: &log.OperationType,
+		Module:        &log.Module,
+		BusinessType:  log.BusinessType,
+		Method:        log.Method,
+		RequestUrl:    log.RequestURL,
+		RequestParams: log.RequestParams,
+		ResponseData:  log.ResponseData,
+		IpAddress:     log.IPAddress,
+		UserAgent:     log.UserAgent,
+		Location:      log.Location,
+		IsSuccess:     &log.IsSuccess,
+		ErrorMessage:  log.ErrorMessage,
+		ExecutionTime: pointer.ToInt32Ptr(int32(log.ExecutionTime)),
+		Description:   log.Description,
+	}
+	if log.UserID != nil {
+		info.UserId = pointer.ToStringPtr(log.UserID.String())
+	}
+	return info
+}
+
+// ConvertArchiveToArchiveInfo 转换归档实体
+func ConvertArchiveToArchiveInfo(archive *ent.OperationLogArchive) *core.OperationLogArchiveInfo {
+	info := &core.OperationLogArchiveInfo{
+		Id:        &archive.ID,
+		CreatedAt: pointer.ToInt64Ptr(archive.CreatedAt.UnixMilli()),
+		Module:    &archive.Module,
+		StartTime: pointer.ToInt64Ptr(archive.StartTime.UnixMilli()),
+		EndTime:   pointer.ToInt64Ptr(archive.EndTime.UnixMilli()),
+		RowCount:  &archive.RowCount,
+		Size:      &archive.Size,
+		Checksum:  &archive.Checksum,
+	}
+	if archive.RestoredAt != nil {
+		info.RestoredAt = pointer.ToInt64Ptr(archive.RestoredAt.UnixMilli())
+	}
+	return info
+}
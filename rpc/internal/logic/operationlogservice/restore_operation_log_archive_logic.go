@@ -0,0 +1,186 @@
+package operationlogservicelogic
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/storage"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// restoreBatchSize 恢复时每批写入的记录数
+const restoreBatchSize = 500
+
+type RestoreOperationLogArchiveLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreOperationLogArchiveLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreOperationLogArchiveLogic {
+	return &RestoreOperationLogArchiveLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 将归档记录恢复到操作记录表
+func (l *RestoreOperationLogArchiveLogic) RestoreOperationLogArchive(in *core.ID32Request) (*core.BaseResponse, error) {
+	archive, err := l.svcCtx.DBEnt.OperationLogArchive.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if archive.RestoredAt != nil {
+		return nil, errorx.NewFailedPreconditionError("operationLog.alreadyRestored")
+	}
+
+	r, err := l.svcCtx.ArchiveStorage.Get(l.ctx, archive.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, errorx.NewNotFoundError("operationLog.archiveMissing")
+	}
+	if err != nil {
+		l.Errorw("读取操作记录归档失败", logx.Field("key", archive.StorageKey), logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError(err.Error())
+	}
+	defer r.Close()
+
+	hash := sha256.New()
+	tee := io.TeeReader(r, hash)
+	gz, err := gzip.NewReader(tee)
+	if err != nil {
+		return nil, errorx.NewInternalError("operationLog.archiveCorrupted")
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	dec := json.NewDecoder(gz)
+	batch := make([]*ent.OperationLog, 0, restoreBatchSize)
+	for {
+		var log ent.OperationLog
+		err = dec.Decode(&log)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			l.Errorw("解析操作记录归档失败", logx.Field("key", archive.StorageKey), logx.Field("detail", err.Error()))
+			return nil, errorx.NewInternalError("operationLog.archiveCorrupted")
+		}
+		batch = append(batch, &log)
+		if len(batch) == restoreBatchSize {
+			if err = l.restoreBatch(tx, batch); err != nil {
+				return nil, errorhandler.DBEntError(l.Logger, err, in)
+			}
+			batch = batch[:0]
+		}
+	}
+	if err = l.restoreBatch(tx, batch); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 读取完成后校验文件完整性
+	if _, err = io.Copy(io.Discard, tee); err != nil {
+		return nil, errorx.NewInternalError(err.Error())
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != archive.Checksum {
+		return nil, errorx.NewInternalError("operationLog.archiveCorrupted")
+	}
+
+	if err = tx.OperationLogArchive.UpdateOne(archive).SetRestoredAt(time.Now()).Exec(l.ctx); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "operationLog.restored"}, nil
+}
+
+// restoreBatch 写入一批归档记录，跳过仍存在的记录，已删除用户的ID置空
+func (l *RestoreOperationLogArchiveLogic) restoreBatch(tx *ent.Tx, logs []*ent.OperationLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	ids := make([]uint32, 0, len(logs))
+	var userIDs []uuid.UUID
+	for _, log := range logs {
+		ids = append(ids, log.ID)
+		if log.UserID != nil {
+			userIDs = append(userIDs, *log.UserID)
+		}
+	}
+	existing, err := tx.OperationLog.Query().Where(operationlog.IDIn(ids...)).IDs(l.ctx)
+	if err != nil {
+		return err
+	}
+	users, err := tx.User.Query().Where(user.IDIn(userIDs...)).IDs(l.ctx)
+	if err != nil {
+		return err
+	}
+	existingSet := make(map[uint32]struct{}, len(existing))
+	for _, id := range existing {
+		existingSet[id] = struct{}{}
+	}
+	userSet := make(map[uuid.UUID]struct{}, len(users))
+	for _, id := range users {
+		userSet[id] = struct{}{}
+	}
+
+	creates := make([]*ent.OperationLogCreate, 0, len(logs))
+	for _, log := range logs {
+		if _, ok := existingSet[log.ID]; ok {
+			continue
+		}
+		userID := log.UserID
+		if userID != nil {
+			if _, ok := userSet[*userID]; !ok {
+				userID = nil
+			}
+		}
+		creates = append(creates, tx.OperationLog.Create().
+			SetID(log.ID).
+			SetCreatedAt(log.CreatedAt).
+			SetUpdatedAt(log.UpdatedAt).
+			SetNillableUserID(userID).
+			SetNillableUsername(log.Username).
+			SetOperationType(log.OperationType).
+			SetModule(log.Module).
+			SetNillableBusinessType(log.BusinessType).
+			SetNillableMethod(log.Method).
+			SetNillableRequestURL(log.RequestURL).
+			SetNillableRequestParams(log.RequestParams).
+			SetNillableResponseData(log.ResponseData).
+			SetNillableIPAddress(log.IPAddress).
+			SetNillableUserAgent(log.UserAgent).
+			SetNillableLocation(log.Location).
+			SetIsSuccess(log.IsSuccess).
+			SetNillableErrorMessage(log.ErrorMessage).
+			SetExecutionTime(log.ExecutionTime).
+			SetNillableDescription(log.Description))
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+	return tx.OperationLog.CreateBulk(creates...).Exec(l.ctx)
+}
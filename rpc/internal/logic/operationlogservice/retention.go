@@ -0,0 +1,251 @@
+package operationlogservicelogic
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlog"
+	"github.com/wenpiner/last-admin-core/rpc/ent/operationlogarchive"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+)
+
+const (
+	ConfigurationGroup = "/operationLog"
+
+	KeyRetentionDays       = "oplog_retention_days"        // 默认保留天数，0 表示不清理
+	KeyModuleRetentionDays = "oplog_module_retention_days" // 按模块设置保留天数，格式为 module=days，逗号或换行分隔
+	KeyArchiveEnabled      = "oplog_archive_enabled"       // 清理前是否归档
+
+	defaultRetentionDays = 90
+
+	// archiveBatchSize 归档时每批读取的记录数
+	archiveBatchSize = 1000
+)
+
+// RetentionPolicy 操作记录保留策略
+type RetentionPolicy struct {
+	Days    int
+	Modules map[string]int
+	Archive bool
+}
+
+// LoadRetentionPolicy 从配置缓存读取保留策略，缺失或无效的配置使用默认值
+func LoadRetentionPolicy(c *cache.ConfigurationCache) RetentionPolicy {
+	p := RetentionPolicy{Days: defaultRetentionDays, Modules: map[string]int{}, Archive: true}
+	if v, ok := c.GetValue(KeyRetentionDays); ok {
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil && n >= 0 {
+			p.Days = n
+		}
+	}
+	if v, ok := c.GetValue(KeyModuleRetentionDays); ok {
+		for _, item := range strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == '\n' }) {
+			module, days, found := strings.Cut(item, "=")
+			if !found {
+				continue
+			}
+			if n, err := strconv.Atoi(strings.TrimSpace(days)); err == nil && n >= 0 {
+				p.Modules[strings.TrimSpace(module)] = n
+			}
+		}
+	}
+	if v, ok := c.GetValue(KeyArchiveEnabled); ok {
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			p.Archive = b
+		}
+	}
+	return p
+}
+
+// DaysFor 模块的保留天数
+func (p RetentionPolicy) DaysFor(module string) int {
+	if n, ok := p.Modules[module]; ok {
+		return n
+	}
+	return p.Days
+}
+
+// ApplyRetention 按模块清理超过保留天数的操作记录，开启归档时先写入归档存储
+func ApplyRetention(ctx context.Context, svcCtx *svc.ServiceContext) (string, error) {
+	policy := LoadRetentionPolicy(svcCtx.ConfigurationCache)
+	modules, err := svcCtx.DBEnt.OperationLog.Query().
+		Unique(true).
+		Select(operationlog.FieldModule).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var archived, deleted int
+	now := time.Now()
+	for _, module := range modules {
+		days := policy.DaysFor(module)
+		if days == 0 {
+			continue
+		}
+		a, d, err := retainModule(ctx, svcCtx, module, now.AddDate(0, 0, -days), policy.Archive)
+		archived += a
+		deleted += d
+		if err != nil {
+			return fmt.Sprintf("archived: %d, deleted: %d", archived, deleted), fmt.Errorf("module %s: %w", module, err)
+		}
+	}
+	return fmt.Sprintf("archived: %d, deleted: %d", archived, deleted), nil
+}
+
+// retainModule 清理单个模块早于 cutoff 的记录，返回归档及删除的记录数
+func retainModule(ctx context.Context, svcCtx *svc.ServiceContext, module string, cutoff time.Time, archive bool) (int, int, error) {
+	// 已恢复的记录在归档中已有备份，直接删除
+	restored, err := svcCtx.DBEnt.OperationLogArchive.Query().
+		Where(operationlogarchive.Module(module), operationlogarchive.RestoredAtNotNil()).
+		All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	var deleted int
+	for _, a := range restored {
+		n, err := svcCtx.DBEnt.OperationLog.Delete().
+			Where(
+				operationlog.Module(module),
+				operationlog.IDGTE(a.StartID),
+				operationlog.IDLTE(a.EndID),
+				operationlog.CreatedAtLT(cutoff),
+			).
+			Exec(ctx)
+		if err != nil {
+			return 0, deleted, err
+		}
+		deleted += n
+		if err = svcCtx.DBEnt.OperationLogArchive.UpdateOne(a).ClearRestoredAt().Exec(ctx); err != nil {
+			return 0, deleted, err
+		}
+	}
+
+	maxID, err := svcCtx.DBEnt.OperationLog.Query().
+		Where(operationlog.Module(module), operationlog.CreatedAtLT(cutoff)).
+		Order(ent.Desc(operationlog.FieldID)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, deleted, nil
+	}
+	if err != nil {
+		return 0, deleted, err
+	}
+
+	var archived int
+	if archive {
+		if archived, err = archiveModule(ctx, svcCtx, module, cutoff, maxID); err != nil {
+			return 0, deleted, err
+		}
+	}
+
+	n, err := svcCtx.DBEnt.OperationLog.Delete().
+		Where(operationlog.Module(module), operationlog.CreatedAtLT(cutoff), operationlog.IDLTE(maxID)).
+		Exec(ctx)
+	return archived, deleted + n, err
+}
+
+// archiveModule 将模块中早于 cutoff 且ID不超过 maxID 的记录写入压缩的 JSON Lines 归档
+func archiveModule(ctx context.Context, svcCtx *svc.ServiceContext, module string, cutoff time.Time, maxID uint32) (int, error) {
+	// 先写入临时文件，得到完整的大小和校验值后再上传
+	tmp, err := os.CreateTemp("", "oplog-archive-*.jsonl.gz")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(tmp, hash))
+	enc := json.NewEncoder(gz)
+
+	var (
+		count          int
+		lastID         uint32
+		startID, endID uint32
+		start, end     time.Time
+	)
+	for {
+		logs, err := svcCtx.DBEnt.OperationLog.Query().
+			Where(
+				operationlog.Module(module),
+				operationlog.CreatedAtLT(cutoff),
+				operationlog.IDGT(lastID),
+				operationlog.IDLTE(maxID),
+			).
+			Order(ent.Asc(operationlog.FieldID)).
+			Limit(archiveBatchSize).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, log := range logs {
+			if err = enc.Encode(log); err != nil {
+				return 0, err
+			}
+			if count == 0 {
+				startID, start, end = log.ID, log.CreatedAt, log.CreatedAt
+			}
+			if log.CreatedAt.Before(start) {
+				start = log.CreatedAt
+			}
+			if log.CreatedAt.After(end) {
+				end = log.CreatedAt
+			}
+			endID = log.ID
+			count++
+		}
+		if len(logs) < archiveBatchSize {
+			break
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	if err = gz.Close(); err != nil {
+		return 0, err
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	key := fmt.Sprintf("operation-logs/%s/%s-%d-%d.jsonl.gz",
+		url.PathEscape(module), time.Now().Format("20060102150405"), startID, endID)
+	if err = svcCtx.ArchiveStorage.Put(ctx, key, tmp, size); err != nil {
+		return 0, err
+	}
+
+	err = svcCtx.DBEnt.OperationLogArchive.Create().
+		SetModule(module).
+		SetStartTime(start).
+		SetEndTime(end).
+		SetStartID(startID).
+		SetEndID(endID).
+		SetRowCount(int64(count)).
+		SetSize(size).
+		SetChecksum(hex.EncodeToString(hash.Sum(nil))).
+		SetStorageKey(key).
+		Exec(ctx)
+	if err != nil {
+		// 归档记录保存失败时删除已上传的文件，原记录保留到下次执行
+		_ = svcCtx.ArchiveStorage.Delete(context.WithoutCancel(ctx), key)
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,21 @@
+package permissionreportservicelogic
+
+import (
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// convertPermissionSnapshot 转换权限快照，不包含授权记录
+func convertPermissionSnapshot(s *ent.PermissionSnapshot) *core.PermissionSnapshotInfo {
+	info := &core.PermissionSnapshotInfo{
+		Id:         pointer.ToUint32Ptr(s.ID),
+		CreatedAt:  pointer.ToInt64Ptr(s.CreatedAt.UnixMilli()),
+		Remark:     pointer.ToStringPtr(s.Remark),
+		EntryCount: pointer.ToInt64Ptr(s.EntryCount),
+	}
+	if s.CreatorID != nil {
+		info.CreatorId = pointer.ToStringPtr(s.CreatorID.String())
+	}
+	return info
+}
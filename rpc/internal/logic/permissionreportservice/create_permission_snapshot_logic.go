@@ -0,0 +1,55 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/reportutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePermissionSnapshotLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreatePermissionSnapshotLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePermissionSnapshotLogic {
+	return &CreatePermissionSnapshotLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 创建当前权限的快照
+func (l *CreatePermissionSnapshotLogic) CreatePermissionSnapshot(in *core.PermissionSnapshotInfo) (*core.PermissionSnapshotInfo, error) {
+	entries, err := reportutils.Snapshot(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	create := l.svcCtx.DBEnt.PermissionSnapshot.Create().
+		SetRemark(pointer.GetString(in.Remark)).
+		SetEntryCount(int64(len(entries))).
+		SetEntries(reportutils.ToSchema(entries))
+	if creatorId := pointer.GetString(in.CreatorId); creatorId != "" {
+		creatorID, err := uuid.Parse(creatorId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		create.SetCreatorID(creatorID)
+	}
+	snapshot, err := create.Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return convertPermissionSnapshot(snapshot), nil
+}
@@ -0,0 +1,35 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePermissionSnapshotLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeletePermissionSnapshotLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePermissionSnapshotLogic {
+	return &DeletePermissionSnapshotLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除权限快照
+func (l *DeletePermissionSnapshotLogic) DeletePermissionSnapshot(in *core.ID32SRequest) (*core.BaseResponse, error) {
+	_, err := l.svcCtx.DBEnt.PermissionSnapshot.Delete().Where(permissionsnapshot.IDIn(in.Ids...)).Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.BaseResponse{Message: "common.deleteSuccess"}, nil
+}
@@ -0,0 +1,48 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/reportutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffPermissionSnapshotLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDiffPermissionSnapshotLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiffPermissionSnapshotLogic {
+	return &DiffPermissionSnapshotLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 对比两个权限快照，或快照与当前权限的差异
+func (l *DiffPermissionSnapshotLogic) DiffPermissionSnapshot(in *core.PermissionSnapshotDiffRequest) (*core.PermissionSnapshotDiffResponse, error) {
+	from, err := l.svcCtx.DBEnt.PermissionSnapshot.Get(l.ctx, in.FromId)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	var to []*core.PermissionEntry
+	if in.ToId != nil {
+		snapshot, err := l.svcCtx.DBEnt.PermissionSnapshot.Get(l.ctx, *in.ToId)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		to = reportutils.FromSchema(snapshot.Entries)
+	} else if to, err = reportutils.Snapshot(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	added, removed := reportutils.Diff(reportutils.FromSchema(from.Entries), to)
+	return &core.PermissionSnapshotDiffResponse{Added: added, Removed: removed}, nil
+}
@@ -0,0 +1,50 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/reportutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionHoldersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPermissionHoldersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionHoldersLogic {
+	return &GetPermissionHoldersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取持有接口、菜单或配置项权限的角色及用户
+func (l *GetPermissionHoldersLogic) GetPermissionHolders(in *core.PermissionHolderRequest) (*core.PermissionHolderResponse, error) {
+	switch in.Kind {
+	case reportutils.EntryMenu:
+		if in.Object == "" {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+	case reportutils.EntryApi, reportutils.EntryConfiguration:
+		if in.Object == "" || in.Action == "" {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+	default:
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	list, err := reportutils.Holders(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, in.Kind, in.Object, in.Action)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.PermissionHolderResponse{List: list}, nil
+}
@@ -0,0 +1,56 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/wenpiner/last-admin-core/rpc/ent/permissionsnapshot"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPermissionSnapshotListLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPermissionSnapshotListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPermissionSnapshotListLogic {
+	return &GetPermissionSnapshotListLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取权限快照列表
+func (l *GetPermissionSnapshotListLogic) GetPermissionSnapshotList(in *core.PermissionSnapshotListRequest) (*core.PermissionSnapshotListResponse, error) {
+	query, err := l.svcCtx.DBEnt.PermissionSnapshot.Query().
+		Select(permissionsnapshot.FieldID, permissionsnapshot.FieldCreatedAt, permissionsnapshot.FieldRemark, permissionsnapshot.FieldCreatorID, permissionsnapshot.FieldEntryCount).
+		ApplyOptions(pageutils.QueryOptions(in.Page), permissionsnapshot.ByCreatedAt(sql.OrderDesc()), permissionsnapshot.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	snapshots, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	list := make([]*core.PermissionSnapshotInfo, 0, len(snapshots.List))
+	for _, s := range snapshots.List {
+		list = append(list, convertPermissionSnapshot(s))
+	}
+	return &core.PermissionSnapshotListResponse{
+		Page: &core.BasePageResp{
+			Total:      snapshots.PageDetails.Total,
+			PageNumber: snapshots.PageDetails.Page,
+			PageSize:   snapshots.PageDetails.Pages,
+		},
+		List: list,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package permissionreportservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/reportutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRolePermissionReportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRolePermissionReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRolePermissionReportLogic {
+	return &GetRolePermissionReportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取角色的全部权限及持有用户
+func (l *GetRolePermissionReportLogic) GetRolePermissionReport(in *core.ID32Request) (*core.RolePermissionReport, error) {
+	r, err := l.svcCtx.DBEnt.Role.Get(l.ctx, in.Id)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	entries, err := reportutils.RoleEntries(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, r)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	users, err := userutils.AllEffectiveRoles(l.ctx, l.svcCtx.DBEnt)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	entries = append(entries, reportutils.UserEntries(users, map[string]struct{}{r.RoleCode: {}})...)
+
+	return &core.RolePermissionReport{
+		RoleId:    r.ID,
+		RoleValue: r.RoleCode,
+		RoleName:  r.RoleName,
+		State:     r.State,
+		Entries:   entries,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package positionservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AssignPositionRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAssignPositionRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignPositionRoleLogic {
+	return &AssignPositionRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 为岗位分配角色，任职用户自动获得这些角色
+func (l *AssignPositionRoleLogic) AssignPositionRole(in *core.PositionRoleRequest) (*core.BaseResponse, error) {
+	err := l.svcCtx.DBEnt.Position.UpdateOneID(in.PositionId).ClearRoles().AddRoleIDs(in.RoleIds...).Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.BaseResponse{
+		Message: "success",
+	}, nil
+}
@@ -0,0 +1,69 @@
+package positionservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/position"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeletePositionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeletePositionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeletePositionLogic {
+	return &BatchDeletePositionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除岗位，仍有成员的岗位跳过
+func (l *BatchDeletePositionLogic) BatchDeletePosition(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	found, err := tx.Position.Query().Where(position.IDIn(result.IDs()...)).IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	result.FailMissing(found)
+
+	// 仍有成员的岗位需先调整成员岗位
+	withUsers, err := tx.Position.Query().
+		Where(position.IDIn(result.Pending()...), position.HasUsers()).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	for _, id := range withUsers {
+		result.Fail(id, "position.hasMembers")
+	}
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if _, err = tx.Position.Delete().Where(position.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
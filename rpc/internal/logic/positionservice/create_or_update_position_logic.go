@@ -9,6 +9,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/errorx"
@@ -38,6 +39,13 @@ func (l *CreateOrUpdatePositionLogic) CreateOrUpdatePosition(in *core.PositionIn
 	}
 	defer tx.Rollback()
 
+	// 拆分多语言岗位名称，默认语言的值写回岗位名称
+	positionName, positionNameI18n, ok := i18nutils.Prepare(l.svcCtx.Config.DefaultLocale, in.PositionName, in.PositionNameI18N)
+	if !ok {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	in.PositionName = positionName
+
 	var position *ent.Position
 
 	if in.Id != nil && *in.Id != 0 {
@@ -52,6 +60,9 @@ func (l *CreateOrUpdatePositionLogic) CreateOrUpdatePosition(in *core.PositionIn
 		if in.PositionName != nil {
 			updateQuery.SetPositionName(*in.PositionName)
 		}
+		if in.PositionNameI18N != nil {
+			updateQuery.SetPositionNameI18n(positionNameI18n)
+		}
 		if in.PositionCode != nil {
 			updateQuery.SetPositionCode(*in.PositionCode)
 		}
@@ -74,6 +85,7 @@ func (l *CreateOrUpdatePositionLogic) CreateOrUpdatePosition(in *core.PositionIn
 
 		createQuery := tx.Position.Create().
 			SetPositionName(*in.PositionName).
+			SetPositionNameI18n(positionNameI18n).
 			SetPositionCode(*in.PositionCode).
 			SetSort(l.getSortOrderValue(in.SortOrder)).
 			SetState(l.getStateValue(in.State)).
@@ -129,13 +141,14 @@ func (l *CreateOrUpdatePositionLogic) getStateValue(state *bool) bool {
 // 将 Position 实体转换为 PositionInfo
 func (l *CreateOrUpdatePositionLogic) convertPositionToPositionInfo(pos *ent.Position) *core.PositionInfo {
 	return &core.PositionInfo{
-		Id:           &pos.ID,
-		CreatedAt:    pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
-		UpdatedAt:    pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
-		PositionName: &pos.PositionName,
-		PositionCode: &pos.PositionCode,
-		SortOrder:    &pos.Sort,
-		State:        &pos.State,
-		Description:  pos.Description,
+		Id:               &pos.ID,
+		CreatedAt:        pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
+		UpdatedAt:        pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
+		PositionName:     &pos.PositionName,
+		PositionCode:     &pos.PositionCode,
+		SortOrder:        &pos.Sort,
+		State:            &pos.State,
+		Description:      pos.Description,
+		PositionNameI18N: i18nutils.Expand(l.svcCtx.Config.DefaultLocale, pos.PositionName, pos.PositionNameI18n),
 	}
 }
@@ -5,9 +5,11 @@ import (
 
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/position"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -29,7 +31,7 @@ func NewGetPositionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPo
 
 // 获取岗位
 func (l *GetPositionLogic) GetPosition(in *core.ID32Request) (*core.PositionInfo, error) {
-	pos, err := l.svcCtx.DBEnt.Position.Get(l.ctx, in.Id)
+	pos, err := l.svcCtx.DBEnt.Position.Query().WithRoles().Where(position.ID(in.Id)).Only(l.ctx)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -40,13 +42,24 @@ func (l *GetPositionLogic) GetPosition(in *core.ID32Request) (*core.PositionInfo
 // 将 Position 实体转换为 PositionInfo
 func (l *GetPositionLogic) convertPositionToPositionInfo(pos *ent.Position) *core.PositionInfo {
 	return &core.PositionInfo{
-		Id:           &pos.ID,
-		CreatedAt:    pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
-		UpdatedAt:    pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
-		PositionName: &pos.PositionName,
-		PositionCode: &pos.PositionCode,
-		SortOrder:    &pos.Sort,
-		State:        &pos.State,
-		Description:  pos.Description,
+		Id:               &pos.ID,
+		CreatedAt:        pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
+		UpdatedAt:        pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
+		PositionName:     &pos.PositionName,
+		PositionCode:     &pos.PositionCode,
+		SortOrder:        &pos.Sort,
+		State:            &pos.State,
+		Description:      pos.Description,
+		PositionNameI18N: i18nutils.Expand(l.svcCtx.Config.DefaultLocale, pos.PositionName, pos.PositionNameI18n),
+		RoleIds:          roleIDs(pos.Edges.Roles),
 	}
 }
+
+// roleIDs 提取已加载的角色ID
+func roleIDs(roles []*ent.Role) []uint32 {
+	ids := make([]uint32, len(roles))
+	for i, r := range roles {
+		ids[i] = r.ID
+	}
+	return ids
+}
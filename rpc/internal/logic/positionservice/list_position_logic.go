@@ -2,6 +2,7 @@ package positionservicelogic
 
 import (
 	"context"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/i18nutils"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/wenpiner/last-admin-common/utils/pointer"
@@ -9,6 +10,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/position"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -45,7 +47,13 @@ func (l *ListPositionLogic) ListPosition(in *core.PositionListRequest) (*core.Po
 		predicates = append(predicates, position.PositionCodeContains(*in.PositionCode))
 	}
 
-	page, err := l.svcCtx.DBEnt.Position.Query().Where(predicates...).Order(position.BySort(sql.OrderDesc())).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.Position.Query().WithRoles().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), position.BySort(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -58,7 +66,9 @@ func (l *ListPositionLogic) ListPosition(in *core.PositionListRequest) (*core.Po
 	}
 
 	for _, pos := range page.List {
-		resp.List = append(resp.List, l.convertPositionToPositionInfo(pos))
+		info := l.convertPositionToPositionInfo(pos)
+		info.PositionName = pointer.ToStringPtr(i18nutils.Localize(l.ctx, pos.PositionName, pos.PositionNameI18n))
+		resp.List = append(resp.List, info)
 	}
 
 	return resp, nil
@@ -67,13 +77,15 @@ func (l *ListPositionLogic) ListPosition(in *core.PositionListRequest) (*core.Po
 // 将 Position 实体转换为 PositionInfo
 func (l *ListPositionLogic) convertPositionToPositionInfo(pos *ent.Position) *core.PositionInfo {
 	return &core.PositionInfo{
-		Id:           &pos.ID,
-		CreatedAt:    pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
-		UpdatedAt:    pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
-		PositionName: &pos.PositionName,
-		PositionCode: &pos.PositionCode,
-		SortOrder:    &pos.Sort,
-		State:        &pos.State,
-		Description:  pos.Description,
+		Id:               &pos.ID,
+		CreatedAt:        pointer.ToInt64Ptr(pos.CreatedAt.UnixMilli()),
+		UpdatedAt:        pointer.ToInt64Ptr(pos.UpdatedAt.UnixMilli()),
+		PositionName:     &pos.PositionName,
+		PositionCode:     &pos.PositionCode,
+		SortOrder:        &pos.Sort,
+		State:            &pos.State,
+		Description:      pos.Description,
+		PositionNameI18N: i18nutils.Expand(l.svcCtx.Config.DefaultLocale, pos.PositionName, pos.PositionNameI18n),
+		RoleIds:          roleIDs(pos.Edges.Roles),
 	}
 }
@@ -3,9 +3,12 @@ package roleservicelogic
 import (
 	"context"
 
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/api"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -25,45 +28,40 @@ func NewAssignApiLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AssignA
 	}
 }
 
-// 为角色分配API
+// 为角色分配API，已分配菜单依赖的API始终保留
 func (l *AssignApiLogic) AssignApi(in *core.RoleApiRequest) (*core.BaseResponse, error) {
 	// 查询角色
-	role, err := l.svcCtx.DBEnt.Role.Get(l.ctx, *in.RoleId)
+	role, err := l.svcCtx.DBEnt.Role.Get(l.ctx, pointer.GetUint32(in.RoleId))
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	// 查询API
-	apis, err := l.svcCtx.DBEnt.API.Query().Where(api.IDIn(in.ApiIds...)).All(l.ctx)
+	// 菜单派生的API由菜单维护，其余记为手动分配
+	derived, err := policyutils.DerivedApis(l.ctx, l.svcCtx.DBEnt, role.ID)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
-
-	// 为角色分配API
-	var policies [][]string
-	for _, api := range apis {
-		policies = append(policies, []string{role.RoleCode, api.Path, api.Method})
+	skip := make(map[uint32]struct{}, len(derived))
+	for _, a := range derived {
+		skip[a.ID] = struct{}{}
 	}
-
-	// 查询旧策略
-	oldPolicies, err := l.svcCtx.Casbin.GetFilteredPolicy(0, role.RoleCode)
+	apiIDs, err := l.svcCtx.DBEnt.API.Query().Where(api.IDIn(in.ApiIds...)).IDs(l.ctx)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
-
-	if len(oldPolicies) != 0 {
-		removeResult, err := l.svcCtx.Casbin.RemoveFilteredPolicy(0, role.RoleCode)
-		if err != nil {
-			return nil, errorhandler.DBEntError(l.Logger, err, in)
-		}
-		if !removeResult {
-			return nil, errorhandler.DBEntError(l.Logger, err, in)
+	var manual []uint32
+	for _, id := range apiIDs {
+		if _, ok := skip[id]; !ok {
+			manual = append(manual, id)
 		}
 	}
-	// 添加新策略
-	if result, err := l.svcCtx.Casbin.AddPolicies(policies); err != nil || !result {
+
+	err = policyutils.Update(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, []*ent.Role{role}, func(tx *ent.Tx) error {
+		return tx.Role.UpdateOneID(role.ID).ClearApis().AddAPIIDs(manual...).Exec(l.ctx)
+	})
+	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
-	
+
 	return &core.BaseResponse{}, nil
 }
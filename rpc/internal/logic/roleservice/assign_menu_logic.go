@@ -3,8 +3,12 @@ package roleservicelogic
 import (
 	"context"
 
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -26,7 +30,15 @@ func NewAssignMenuLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Assign
 
 // 为角色分配菜单
 func (l *AssignMenuLogic) AssignMenu(in *core.RoleMenuRequest) (*core.BaseResponse, error) {
-	err := l.svcCtx.DBEnt.Role.UpdateOneID(*in.RoleId).ClearMenus().AddMenuIDs(in.MenuIds...).Exec(l.ctx)
+	role, err := l.svcCtx.DBEnt.Role.Get(l.ctx, pointer.GetUint32(in.RoleId))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 先保留手动分配的API，再按新菜单重建接口策略
+	err = policyutils.Update(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, []*ent.Role{role}, func(tx *ent.Tx) error {
+		return tx.Role.UpdateOneID(role.ID).ClearMenus().AddMenuIDs(in.MenuIds...).Exec(l.ctx)
+	})
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
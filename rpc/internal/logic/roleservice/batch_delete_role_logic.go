@@ -0,0 +1,92 @@
+package roleservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 系统保留的超级管理员角色编码，初始化时创建，不允许删除或禁用
+const superRoleCode = "super"
+
+type BatchDeleteRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteRoleLogic {
+	return &BatchDeleteRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除角色，系统保留角色及仍有用户的角色跳过
+func (l *BatchDeleteRoleLogic) BatchDeleteRole(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	roles, err := tx.Role.Query().
+		Where(role.IDIn(result.IDs()...)).
+		Select(role.FieldID, role.FieldRoleCode).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uint32, 0, len(roles))
+	for _, r := range roles {
+		found = append(found, r.ID)
+		if r.RoleCode == superRoleCode {
+			result.Fail(r.ID, "role.reserved")
+		}
+	}
+	result.FailMissing(found)
+
+	// 仍分配给用户的角色需先解除分配
+	withUsers, err := tx.Role.Query().
+		Where(role.IDIn(result.Pending()...), role.HasUsers()).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	for _, id := range withUsers {
+		result.Fail(id, "role.hasMembers")
+	}
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if _, err = tx.Role.Delete().Where(role.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 清理已删除角色的接口权限策略
+	for _, r := range roles {
+		if result.Failed(r.ID) {
+			continue
+		}
+		if _, err := l.svcCtx.Casbin.RemoveFilteredPolicy(0, r.RoleCode); err != nil {
+			l.Errorw("清理角色权限策略失败", logx.Field("roleCode", r.RoleCode), logx.Field("detail", err.Error()))
+		}
+	}
+
+	return result.Response(), nil
+}
@@ -0,0 +1,68 @@
+package roleservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateRoleStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchUpdateRoleStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchUpdateRoleStateLogic {
+	return &BatchUpdateRoleStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量启用或禁用角色，系统保留角色跳过
+func (l *BatchUpdateRoleStateLogic) BatchUpdateRoleState(in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	roles, err := tx.Role.Query().
+		Where(role.IDIn(result.IDs()...)).
+		Select(role.FieldID, role.FieldRoleCode).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uint32, 0, len(roles))
+	for _, r := range roles {
+		found = append(found, r.ID)
+		if r.RoleCode == superRoleCode {
+			result.Fail(r.ID, "role.reserved")
+		}
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if err = tx.Role.Update().Where(role.IDIn(pending...)).SetState(in.State).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
@@ -0,0 +1,62 @@
+package roleservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckRoleApiConsistencyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckRoleApiConsistencyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckRoleApiConsistencyLogic {
+	return &CheckRoleApiConsistencyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 检查角色接口授权与菜单的一致性
+func (l *CheckRoleApiConsistencyLogic) CheckRoleApiConsistency(in *core.RoleApiConsistencyRequest) (*core.RoleApiConsistencyResponse, error) {
+	query := l.svcCtx.DBEnt.Role.Query().Order(role.ByID())
+	if in.RoleId != nil {
+		query.Where(role.IDEQ(*in.RoleId))
+	}
+	roles, err := query.All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	apis, err := l.svcCtx.DBEnt.API.Query().WithMenus().All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	resp := &core.RoleApiConsistencyResponse{}
+	for _, r := range roles {
+		mismatches, err := policyutils.Check(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, r, apis)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		if len(mismatches) == 0 {
+			continue
+		}
+		resp.List = append(resp.List, &core.RoleApiConsistency{
+			RoleId:     r.ID,
+			RoleValue:  r.RoleCode,
+			RoleName:   r.RoleName,
+			Mismatches: mismatches,
+		})
+	}
+	return resp, nil
+}
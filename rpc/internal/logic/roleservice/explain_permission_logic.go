@@ -0,0 +1,133 @@
+package roleservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExplainPermissionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExplainPermissionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExplainPermissionLogic {
+	return &ExplainPermissionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 解释用户或角色对接口、配置项的访问决策，支持模拟角色变更
+func (l *ExplainPermissionLogic) ExplainPermission(in *core.PermissionExplainRequest) (*core.PermissionExplainResponse, error) {
+	if (in.Domain != policyutils.ApiDomain && in.Domain != policyutils.ConfigurationDomain) || in.Object == "" || in.Action == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	decisions, err := l.baseDecisions(in)
+	if err != nil {
+		return nil, err
+	}
+	decisions = simulate(decisions, in.AddRoleValues, in.RemoveRoleValues)
+
+	resp := &core.PermissionExplainResponse{
+		Simulated: len(in.AddRoleValues) > 0 || len(in.RemoveRoleValues) > 0,
+		Public:    policyutils.IsPublic(in.Domain, in.Object),
+		Roles:     decisions,
+	}
+	if resp.Public {
+		resp.Allowed = true
+		return resp, nil
+	}
+
+	evaluated := make(map[string]struct{}, len(decisions))
+	for _, d := range decisions {
+		evaluated[d.RoleValue] = struct{}{}
+		d.Allowed, d.MatchedPolicies, err = policyutils.Decide(l.svcCtx.Casbin, d.RoleValue, in.Domain, in.Object, in.Action)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		if d.Allowed && !resp.Allowed {
+			resp.Allowed = true
+			resp.GrantedBy = pointer.ToStringPtr(d.RoleValue)
+		}
+	}
+
+	if !resp.Allowed {
+		resp.Suggestions, err = policyutils.Suggest(l.ctx, l.svcCtx.DBEnt, l.svcCtx.Casbin, in.Domain, in.Object, in.Action, evaluated)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	return resp, nil
+}
+
+// baseDecisions 确定评估的角色集合，指定用户时使用其有效角色及来源，否则使用请求中的角色
+func (l *ExplainPermissionLogic) baseDecisions(in *core.PermissionExplainRequest) ([]*core.PermissionRoleDecision, error) {
+	var decisions []*core.PermissionRoleDecision
+	if userId := pointer.GetString(in.UserId); userId != "" {
+		userID, err := uuid.Parse(userId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		roles, err := userutils.EffectiveRoles(l.ctx, l.svcCtx.DBEnt, userID)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		for _, r := range roles {
+			decisions = append(decisions, &core.PermissionRoleDecision{RoleValue: r.RoleValue, Sources: r.Sources})
+		}
+		return decisions, nil
+	}
+
+	if len(in.RoleValues) == 0 && len(in.AddRoleValues) == 0 {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	seen := make(map[string]struct{}, len(in.RoleValues))
+	for _, v := range in.RoleValues {
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		decisions = append(decisions, &core.PermissionRoleDecision{RoleValue: v})
+	}
+	return decisions, nil
+}
+
+// simulate 在评估的角色集合上应用模拟的角色变更
+func simulate(decisions []*core.PermissionRoleDecision, add, remove []string) []*core.PermissionRoleDecision {
+	removed := make(map[string]struct{}, len(remove))
+	for _, v := range remove {
+		removed[v] = struct{}{}
+	}
+
+	result := make([]*core.PermissionRoleDecision, 0, len(decisions)+len(add))
+	present := make(map[string]struct{}, len(decisions)+len(add))
+	for _, d := range decisions {
+		if _, ok := removed[d.RoleValue]; ok {
+			continue
+		}
+		present[d.RoleValue] = struct{}{}
+		result = append(result, d)
+	}
+	for _, v := range add {
+		if _, ok := present[v]; ok || v == "" {
+			continue
+		}
+		present[v] = struct{}{}
+		result = append(result, &core.PermissionRoleDecision{RoleValue: v, Simulated: true})
+	}
+	return result
+}
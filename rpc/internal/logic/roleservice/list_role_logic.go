@@ -6,6 +6,7 @@ import (
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -42,7 +43,13 @@ func (l *ListRoleLogic) ListRole(in *core.RoleListRequest) (*core.RoleListRespon
 		predicates = append(predicates, role.RoleCodeContains(*in.RoleCode))
 	}
 
-	page, err := l.svcCtx.DBEnt.Role.Query().Where(predicates...).Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+	query, err := l.svcCtx.DBEnt.Role.Query().Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	page, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
@@ -0,0 +1,94 @@
+package securityeventservicelogic
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateSecurityEventLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateSecurityEventLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateSecurityEventLogic {
+	return &CreateSecurityEventLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 记录安全事件，登录成功时同时更新用户最后登录信息
+func (l *CreateSecurityEventLogic) CreateSecurityEvent(in *core.SecurityEventInfo) (*core.BaseResponse, error) {
+	eventType := pointer.GetString(in.EventType)
+	if !isEventType(eventType) {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	var userID *uuid.UUID
+	if in.UserId != nil && *in.UserId != "" {
+		id, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		userID = &id
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	username := pointer.GetString(in.Username)
+	success := in.Success == nil || *in.Success
+	ipAddress := truncate(pointer.GetString(in.IpAddress), 45)
+
+	// 登录成功时更新用户最后登录信息，并以用户当前用户名为准
+	if userID != nil && eventType == EventLogin && success {
+		u, err := tx.User.UpdateOneID(*userID).
+			SetLastLoginAt(time.Now()).
+			SetLastLoginIP(ipAddress).
+			Save(l.ctx)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		username = u.Username
+	} else if userID != nil && username == "" {
+		u, err := tx.User.Get(l.ctx, *userID)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		username = u.Username
+	}
+
+	err = tx.SecurityEvent.Create().
+		SetNillableUserID(userID).
+		SetUsername(truncate(username, 50)).
+		SetEventType(eventType).
+		SetSuccess(success).
+		SetProvider(truncate(pointer.GetString(in.Provider), 50)).
+		SetReason(truncate(pointer.GetString(in.Reason), 255)).
+		SetIPAddress(ipAddress).
+		SetUserAgent(truncate(pointer.GetString(in.UserAgent), 500)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{Message: "common.success"}, nil
+}
@@ -0,0 +1,110 @@
+package securityeventservicelogic
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/securityevent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSecurityEventLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListSecurityEventLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSecurityEventLogic {
+	return &ListSecurityEventLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取安全事件列表
+func (l *ListSecurityEventLogic) ListSecurityEvent(in *core.SecurityEventListRequest) (*core.SecurityEventListResponse, error) {
+	var predicates []predicate.SecurityEvent
+	if in.UserId != nil && *in.UserId != "" {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		predicates = append(predicates, securityevent.UserIDEQ(userID))
+	}
+	if in.Username != nil && *in.Username != "" {
+		predicates = append(predicates, securityevent.UsernameContains(*in.Username))
+	}
+	if in.EventType != nil && *in.EventType != "" {
+		predicates = append(predicates, securityevent.EventTypeEQ(*in.EventType))
+	}
+	if in.Success != nil {
+		predicates = append(predicates, securityevent.SuccessEQ(*in.Success))
+	}
+	if in.IpAddress != nil && *in.IpAddress != "" {
+		predicates = append(predicates, securityevent.IPAddressEQ(*in.IpAddress))
+	}
+	if in.StartTime != nil && *in.StartTime > 0 {
+		predicates = append(predicates, securityevent.CreatedAtGTE(time.UnixMilli(*in.StartTime)))
+	}
+	if in.EndTime != nil && *in.EndTime > 0 {
+		predicates = append(predicates, securityevent.CreatedAtLTE(time.UnixMilli(*in.EndTime)))
+	}
+
+	query, err := l.svcCtx.DBEnt.SecurityEvent.Query().
+		Where(predicates...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), securityevent.ByCreatedAt(sql.OrderDesc()), securityevent.ByID(sql.OrderDesc()))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	var (
+		events []*ent.SecurityEvent
+		page   *core.BasePageResp
+	)
+	if pageutils.IsCursor(in.Page) {
+		// 游标分页不统计总数，适合数据量大的表连续翻页
+		req, err := pageutils.CursorRequest(in.Page, securityevent.FieldCreatedAt, true)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		result, err := query.CursorPage(l.ctx, req)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		events, page = result.List, pageutils.CursorResp(result.PageDetails)
+	} else {
+		result, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		events = result.List
+		page = &core.BasePageResp{
+			Total:      result.PageDetails.Total,
+			PageNumber: result.PageDetails.Page,
+			PageSize:   result.PageDetails.Pages,
+		}
+	}
+
+	list := make([]*core.SecurityEventInfo, 0, len(events))
+	for _, event := range events {
+		list = append(list, ConvertSecurityEventToSecurityEventInfo(event))
+	}
+
+	return &core.SecurityEventListResponse{
+		Page: page,
+		List: list,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package securityeventservicelogic
+
+import (
+	"slices"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+const (
+	EventLogin          = "LOGIN"
+	EventMfaChallenge   = "MFA_CHALLENGE"
+	EventPasswordChange = "PASSWORD_CHANGE"
+	EventMfaEnable      = "MFA_ENABLE"
+	EventMfaDisable     = "MFA_DISABLE"
+	EventTokenRevoke    = "TOKEN_REVOKE"
+	EventOauthBind      = "OAUTH_BIND"
+	EventOauthUnbind    = "OAUTH_UNBIND"
+)
+
+// eventTypes 支持记录的安全事件类型
+var eventTypes = []string{
+	EventLogin, EventMfaChallenge, EventPasswordChange, EventMfaEnable,
+	EventMfaDisable, EventTokenRevoke, EventOauthBind, EventOauthUnbind,
+}
+
+// isEventType 是否为支持的事件类型
+func isEventType(eventType string) bool {
+	return slices.Contains(eventTypes, eventType)
+}
+
+// truncate 按字符截断超出字段长度的内容，避免客户端传入的长字符串导致记录失败
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+// ConvertSecurityEventToSecurityEventInfo 转换安全事件实体
+func ConvertSecurityEventToSecurityEventInfo(event *ent.SecurityEvent) *core.SecurityEventInfo {
+	info := &core.SecurityEventInfo{
+		Id:        &event.ID,
+		CreatedAt: pointer.ToInt64Ptr(event.CreatedAt.UnixMilli()),
+		Username:  pointer.ToStringPtrIfNotEmpty(event.Username),
+		EventType: &event.EventType,
+		Success:   &event.Success,
+		Provider:  pointer.ToStringPtrIfNotEmpty(event.Provider),
+		Reason:    pointer.ToStringPtrIfNotEmpty(event.Reason),
+		IpAddress: pointer.ToStringPtrIfNotEmpty(event.IPAddress),
+		UserAgent: pointer.ToStringPtrIfNotEmpty(event.UserAgent),
+	}
+	if event.UserID != nil {
+		info.UserId = pointer.ToStringPtr(event.UserID.String())
+	}
+	return info
+}
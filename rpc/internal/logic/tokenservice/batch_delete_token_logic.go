@@ -0,0 +1,55 @@
+package tokenservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchDeleteTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteTokenLogic {
+	return &BatchDeleteTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除令牌，令牌加入黑名单并停用，记录保留至过期后由清理任务删除
+func (l *BatchDeleteTokenLogic) BatchDeleteToken(in *core.ID32SRequest) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := l.svcCtx.DBEnt.Token.Query().
+		Where(token.IDIn(result.IDs()...)).
+		Select(token.FieldID, token.FieldTokenValue).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uint32, 0, len(tokens))
+	values := make([]any, 0, len(tokens))
+	for _, t := range tokens {
+		found = append(found, t.ID)
+		values = append(values, t.TokenValue)
+	}
+	result.FailMissing(found)
+
+	if _, err = revokeTokens(l.ctx, l.svcCtx, l.Logger, result.Pending(), values); err != nil {
+		return nil, err
+	}
+	return result.Response(), nil
+}
@@ -0,0 +1,81 @@
+package tokenservicelogic
+
+import (
+	"context"
+
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateTokenStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchUpdateTokenStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchUpdateTokenStateLogic {
+	return &BatchUpdateTokenStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量启用或禁用令牌，同步维护黑名单
+func (l *BatchUpdateTokenStateLogic) BatchUpdateTokenState(in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	result, err := batchutils.NewResult(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	tokens, err := tx.Token.Query().
+		Where(token.IDIn(result.IDs()...)).
+		Select(token.FieldID, token.FieldTokenValue).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uint32, 0, len(tokens))
+	values := make([]any, 0, len(tokens))
+	for _, t := range tokens {
+		found = append(found, t.ID)
+		values = append(values, t.TokenValue)
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if err = tx.Token.Update().Where(token.IDIn(pending...)).SetState(in.State).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 按状态维护黑名单
+	if len(values) > 0 {
+		key := string(last_redis.BlacklistToken)
+		if in.State {
+			err = l.svcCtx.Redis.SRem(l.ctx, key, values...).Err()
+		} else {
+			err = l.svcCtx.Redis.SAdd(l.ctx, key, values...).Err()
+		}
+		if err != nil {
+			l.Errorw("更新令牌黑名单失败", logx.Field("detail", err.Error()))
+		}
+	}
+
+	return result.Response(), nil
+}
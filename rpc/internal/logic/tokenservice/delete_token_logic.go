@@ -3,7 +3,7 @@ package tokenservicelogic
 import (
 	"context"
 
-	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
@@ -25,24 +25,23 @@ func NewDeleteTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delet
 	}
 }
 
-// 删除Token
+// 删除Token，与批量删除一致，令牌加入黑名单并停用，记录保留至过期后由清理任务删除
 func (l *DeleteTokenLogic) DeleteToken(in *core.ID32Request) (*core.BaseResponse, error) {
-	// 删除Token
-	affected, err := l.svcCtx.DBEnt.Token.Delete().
-		Where(token.IDEQ(in.Id)).
-		Exec(l.ctx)
-
+	t, err := l.svcCtx.DBEnt.Token.Get(l.ctx, in.Id)
+	if ent.IsNotFound(err) {
+		return &core.BaseResponse{
+			Message: "token.notFound",
+		}, nil
+	}
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	if affected == 0 {
-		return &core.BaseResponse{
-			Message: "Token not found",
-		}, nil
+	if _, err = revokeTokens(l.ctx, l.svcCtx, l.Logger, []uint32{t.ID}, []any{t.TokenValue}); err != nil {
+		return nil, err
 	}
 
 	return &core.BaseResponse{
-		Message: "Token deleted successfully",
+		Message: "token.deleteSuccess",
 	}, nil
 }
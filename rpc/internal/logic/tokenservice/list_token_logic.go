@@ -2,13 +2,16 @@ package tokenservicelogic
 
 import (
 	"context"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
 	"github.com/wenpiner/last-admin-core/rpc/ent/token"
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -61,30 +64,57 @@ func (l *ListTokenLogic) ListToken(in *core.TokenListRequest) (*core.TokenListRe
 		predicates = append(predicates, token.DeviceInfoEQ(*in.DeviceInfo))
 	}
 
+	// 仅查询有效的Token
+	if in.Active != nil && *in.Active {
+		predicates = append(predicates, token.State(true), token.ExpiresAtGT(time.Now()))
+	}
+
 	// 按创建时间倒序排序，获取分页数据
-	tokenEntities, err := l.svcCtx.DBEnt.Token.Query().
+	query, err := l.svcCtx.DBEnt.Token.Query().
 		WithUser().
 		WithProvider().
 		Where(predicates...).
-		Order(token.ByCreatedAt(sql.OrderDesc())).
-		Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
-
+		ApplyOptions(pageutils.QueryOptions(in.Page), token.ByCreatedAt(sql.OrderDesc()))
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
+	var (
+		tokenEntities []*ent.Token
+		page          *core.BasePageResp
+	)
+	if pageutils.IsCursor(in.Page) {
+		// 游标分页不统计总数，适合数据量大的表连续翻页
+		req, err := pageutils.CursorRequest(in.Page, token.FieldCreatedAt, true)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		result, err := query.CursorPage(l.ctx, req)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		tokenEntities, page = result.List, pageutils.CursorResp(result.PageDetails)
+	} else {
+		result, err := query.Page(l.ctx, in.Page.PageNumber, in.Page.PageSize)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		tokenEntities = result.List
+		page = &core.BasePageResp{
+			Total:      result.PageDetails.Total,
+			PageNumber: result.PageDetails.Page,
+			PageSize:   result.PageDetails.Pages,
+		}
+	}
+
 	// 转换为TokenInfo列表
 	var tokenInfos []*core.TokenInfo
-	for _, tokenEntity := range tokenEntities.List {
+	for _, tokenEntity := range tokenEntities {
 		tokenInfos = append(tokenInfos, ConvertTokenToTokenInfo(tokenEntity))
 	}
 
 	return &core.TokenListResponse{
-		Page: &core.BasePageResp{
-			Total:      tokenEntities.PageDetails.Total,
-			PageNumber: tokenEntities.PageDetails.Page,
-			PageSize:   tokenEntities.PageDetails.Pages,
-		},
+		Page: page,
 		List: tokenInfos,
 	}, nil
 }
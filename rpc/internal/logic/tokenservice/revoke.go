@@ -0,0 +1,32 @@
+package tokenservicelogic
+
+import (
+	"context"
+
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// revokeTokens 将令牌写入黑名单使其立即失效，再停用数据库记录
+// 记录保留至过期后由清理任务删除，清理黑名单时据此判断条目是否仍需保留，因此不能直接删除记录
+func revokeTokens(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, ids []uint32, values []any) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if err := svcCtx.Redis.SAdd(ctx, string(last_redis.BlacklistToken), values...).Err(); err != nil {
+		logger.Errorw("写入令牌黑名单失败", logx.Field("detail", err.Error()))
+		return 0, errorx.NewInternalError("token.revokeFailed")
+	}
+	affected, err := svcCtx.DBEnt.Token.Update().
+		Where(token.IDIn(ids...)).
+		SetState(false).
+		Save(ctx)
+	if err != nil {
+		return 0, errorhandler.DBEntError(logger, err, ids)
+	}
+	return affected, nil
+}
@@ -0,0 +1,84 @@
+package tokenservicelogic
+
+import (
+	"context"
+
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserTokensLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeUserTokensLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserTokensLogic {
+	return &RevokeUserTokensLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 撤销用户的Token，同时写入黑名单
+func (l *RevokeUserTokensLogic) RevokeUserTokens(in *core.RevokeUserTokensRequest) (*core.RevokeUserTokensResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	predicates := []predicate.Token{
+		token.UserIDEQ(userID),
+		token.State(true),
+		token.ExpiresAtGT(time.Now()),
+	}
+	if in.Id != nil {
+		predicates = append(predicates, token.IDEQ(*in.Id))
+	}
+	if in.ExceptTokenValue != nil && *in.ExceptTokenValue != "" {
+		predicates = append(predicates, token.TokenValueNEQ(*in.ExceptTokenValue))
+	}
+	if len(in.TokenTypes) > 0 {
+		predicates = append(predicates, token.TokenTypeIn(in.TokenTypes...))
+	}
+
+	tokens, err := l.svcCtx.DBEnt.Token.Query().
+		Where(predicates...).
+		Select(token.FieldID, token.FieldTokenValue).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if len(tokens) == 0 {
+		if in.Id != nil {
+			return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+		}
+		return &core.RevokeUserTokensResponse{}, nil
+	}
+
+	ids := make([]uint32, 0, len(tokens))
+	values := make([]any, 0, len(tokens))
+	for _, t := range tokens {
+		ids = append(ids, t.ID)
+		values = append(values, t.TokenValue)
+	}
+
+	affected, err := revokeTokens(l.ctx, l.svcCtx, l.Logger, ids, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.RevokeUserTokensResponse{RevokedCount: int64(affected)}, nil
+}
@@ -0,0 +1,96 @@
+package tokenservicelogic
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/token"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUserSessions(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	db := svcCtx.DBEnt
+	alice := db.User.Create().SetUsername("alice").SetPasswordHash("-").SaveX(ctx)
+	bob := db.User.Create().SetUsername("bob").SetPasswordHash("-").SaveX(ctx)
+
+	create := func(u *ent.User, value, tokenType string, expiresAt time.Time, state bool) *ent.Token {
+		return db.Token.Create().SetUser(u).SetTokenValue(value).SetTokenType(tokenType).
+			SetExpiresAt(expiresAt).SetState(state).SaveX(ctx)
+	}
+	future, past := time.Now().Add(time.Hour), time.Now().Add(-time.Hour)
+	create(alice, "current", "access_token", future, true)
+	other := create(alice, "other", "access_token", future, true)
+	create(alice, "refresh", "refresh_token", future, true)
+	create(alice, "expired", "access_token", past, true)
+	create(alice, "revoked", "access_token", future, false)
+	create(bob, "bob", "access_token", future, true)
+
+	sessions := func() []string {
+		t.Helper()
+		resp, err := NewListTokenLogic(ctx, svcCtx).ListToken(&core.TokenListRequest{
+			Page:      &core.BasePageRequest{PageNumber: 1, PageSize: 100},
+			UserId:    pointer.ToStringPtr(alice.ID.String()),
+			TokenType: pointer.ToStringPtr("access_token"),
+			Active:    pointer.ToBoolPtr(true),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var values []string
+		for _, tk := range resp.List {
+			values = append(values, pointer.GetString(tk.TokenValue))
+		}
+		slices.Sort(values)
+		return values
+	}
+	if got, want := sessions(), []string{"current", "other"}; !slices.Equal(got, want) {
+		t.Fatalf("sessions = %v, want %v", got, want)
+	}
+
+	resp, err := NewRevokeUserTokensLogic(ctx, svcCtx).RevokeUserTokens(&core.RevokeUserTokensRequest{
+		UserId:           alice.ID.String(),
+		ExceptTokenValue: pointer.ToStringPtr("current"),
+		TokenTypes:       []string{"access_token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.RevokedCount != 1 {
+		t.Fatalf("revoked %d, want 1", resp.RevokedCount)
+	}
+	if got, want := sessions(), []string{"current"}; !slices.Equal(got, want) {
+		t.Fatalf("sessions after revoke = %v, want %v", got, want)
+	}
+	blacklist, err := svcCtx.Redis.SMembers(ctx, string(last_redis.BlacklistToken)).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(blacklist, []string{"other"}) {
+		t.Fatalf("blacklist = %v, want [other]", blacklist)
+	}
+	// 其他类型的令牌及其他用户的会话不受影响
+	for _, value := range []string{"refresh", "bob"} {
+		if !db.Token.Query().Where(token.TokenValue(value)).OnlyX(ctx).State {
+			t.Fatalf("%s must stay active", value)
+		}
+	}
+
+	// 已撤销的会话无法再次撤销
+	_, err = NewRevokeUserTokensLogic(ctx, svcCtx).RevokeUserTokens(&core.RevokeUserTokensRequest{
+		UserId: alice.ID.String(),
+		Id:     &other.ID,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("revoke revoked session: %v, want not found", err)
+	}
+}
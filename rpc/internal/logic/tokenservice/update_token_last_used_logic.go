@@ -46,11 +46,11 @@ func (l *UpdateTokenLastUsedLogic) UpdateTokenLastUsed(in *core.UpdateTokenLastU
 
 	if affected == 0 {
 		return &core.BaseResponse{
-			Message: "Token not found",
+			Message: "token.notFound",
 		}, nil
 	}
 
 	return &core.BaseResponse{
-		Message: "Token last used time updated successfully",
+		Message: "token.lastUsedUpdated",
 	}, nil
 }
@@ -69,16 +69,6 @@ func (l *UpdateTokenLogic) UpdateToken(in *core.TokenInfo) (*core.TokenInfo, err
 		return &core.TokenInfo{}, nil
 	}
 
-	if in.State != nil {
-		if *in.State {
-			// 从黑名单中移除
-			l.svcCtx.Redis.SRem(l.ctx, string(last_redis.BlacklistToken), in.TokenValue).Result()
-		} else {
-			// 加入黑名单
-			l.svcCtx.Redis.SAdd(l.ctx, string(last_redis.BlacklistToken), in.TokenValue).Result()
-		}
-	}
-
 	// 查询更新后的Token
 	tokenEntity, err := l.svcCtx.DBEnt.Token.Query().
 		Where(token.IDEQ(*in.Id)).
@@ -88,6 +78,17 @@ func (l *UpdateTokenLogic) UpdateToken(in *core.TokenInfo) (*core.TokenInfo, err
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
+	// 按令牌值维护黑名单，请求中未携带令牌值时以数据库为准
+	if in.State != nil {
+		if *in.State {
+			// 从黑名单中移除
+			l.svcCtx.Redis.SRem(l.ctx, string(last_redis.BlacklistToken), tokenEntity.TokenValue).Result()
+		} else {
+			// 加入黑名单
+			l.svcCtx.Redis.SAdd(l.ctx, string(last_redis.BlacklistToken), tokenEntity.TokenValue).Result()
+		}
+	}
+
 	
 	return ConvertTokenToTokenInfo(tokenEntity), nil
 }
@@ -0,0 +1,79 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 系统保留的管理员用户名，初始化时创建，不允许删除或禁用
+const adminUsername = "admin"
+
+type BatchDeleteUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchDeleteUserLogic {
+	return &BatchDeleteUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量删除用户，系统保留用户跳过，删除的用户令牌同时撤销
+func (l *BatchDeleteUserLogic) BatchDeleteUser(in *core.UUIDSRequest) (*core.BatchResponse, error) {
+	ids, err := batchutils.ParseUUIDs(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+	result, err := batchutils.NewResult(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	users, err := tx.User.Query().
+		Where(user.IDIn(result.IDs()...)).
+		Select(user.FieldID, user.FieldUsername).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		found = append(found, u.ID)
+		if u.Username == adminUsername {
+			result.Fail(u.ID, "user.reserved")
+		}
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if err = revokeUsersTokens(l.ctx, l.svcCtx, pending); err != nil {
+			return nil, err
+		}
+		if _, err = tx.User.Delete().Where(user.IDIn(pending...)).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
@@ -0,0 +1,34 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestBatchDeleteUserKeepsSecurityEvents(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	u := svcCtx.DBEnt.User.Create().SetUsername("alice").SetPasswordHash("x").SaveX(ctx)
+	event := svcCtx.DBEnt.SecurityEvent.Create().
+		SetUserID(u.ID).
+		SetUsername("alice").
+		SetEventType("LOGIN").
+		SaveX(ctx)
+
+	if _, err := NewBatchDeleteUserLogic(ctx, svcCtx).BatchDeleteUser(&core.UUIDSRequest{
+		Ids: []string{u.ID.String()},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := svcCtx.DBEnt.SecurityEvent.Get(ctx, event.ID)
+	if err != nil {
+		t.Fatalf("security event removed with user: %v", err)
+	}
+	if got.UserID != nil || got.Username != "alice" {
+		t.Fatalf("user id = %v, username = %q", got.UserID, got.Username)
+	}
+}
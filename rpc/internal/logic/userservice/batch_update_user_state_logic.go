@@ -0,0 +1,78 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/batchutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BatchUpdateUserStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBatchUpdateUserStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BatchUpdateUserStateLogic {
+	return &BatchUpdateUserStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 批量启用或禁用用户，系统保留用户跳过，禁用的用户令牌同时撤销
+func (l *BatchUpdateUserStateLogic) BatchUpdateUserState(in *core.BatchStateUUIDRequest) (*core.BatchResponse, error) {
+	ids, err := batchutils.ParseUUIDs(in.Ids)
+	if err != nil {
+		return nil, err
+	}
+	result, err := batchutils.NewResult(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	users, err := tx.User.Query().
+		Where(user.IDIn(result.IDs()...)).
+		Select(user.FieldID, user.FieldUsername).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	found := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		found = append(found, u.ID)
+		if u.Username == adminUsername {
+			result.Fail(u.ID, "user.reserved")
+		}
+	}
+	result.FailMissing(found)
+
+	if pending := result.Pending(); len(pending) > 0 {
+		if !in.State {
+			if err = revokeUsersTokens(l.ctx, l.svcCtx, pending); err != nil {
+				return nil, err
+			}
+		}
+		if err = tx.User.Update().Where(user.IDIn(pending...)).SetState(in.State).Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return result.Response(), nil
+}
@@ -0,0 +1,63 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	last_redis "github.com/wenpiner/last-admin-common/last-redis"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func createUserWithToken(t *testing.T, svcCtx *svc.ServiceContext, username string) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+	u := svcCtx.DBEnt.User.Create().SetUsername(username).SetPasswordHash("x").SaveX(ctx)
+	svcCtx.DBEnt.Token.Create().
+		SetTokenValue(username + "-token").
+		SetTokenType("access_token").
+		SetUserID(u.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+	return u.ID
+}
+
+func TestBatchDisableAndDeleteRevokeTokens(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	disabled := createUserWithToken(t, svcCtx, "alice")
+	deleted := createUserWithToken(t, svcCtx, "bob")
+	kept := createUserWithToken(t, svcCtx, "carol")
+
+	if _, err := NewBatchUpdateUserStateLogic(ctx, svcCtx).BatchUpdateUserState(&core.BatchStateUUIDRequest{
+		Ids:   []string{disabled.String()},
+		State: false,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewBatchDeleteUserLogic(ctx, svcCtx).BatchDeleteUser(&core.UUIDSRequest{
+		Ids: []string{deleted.String()},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// 启用用户不撤销令牌
+	if _, err := NewBatchUpdateUserStateLogic(ctx, svcCtx).BatchUpdateUserState(&core.BatchStateUUIDRequest{
+		Ids:   []string{kept.String()},
+		State: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for value, want := range map[string]bool{"alice-token": true, "bob-token": true, "carol-token": false} {
+		blacklisted, err := svcCtx.Redis.SIsMember(ctx, string(last_redis.BlacklistToken), value).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if blacklisted != want {
+			t.Errorf("blacklisted(%q) = %v, want %v", value, blacklisted, want)
+		}
+	}
+}
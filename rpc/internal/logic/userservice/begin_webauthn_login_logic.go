@@ -0,0 +1,70 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BeginWebauthnLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBeginWebauthnLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BeginWebauthnLoginLogic {
+	return &BeginWebauthnLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 开始WebAuthn登录
+func (l *BeginWebauthnLoginLogic) BeginWebauthnLogin(in *core.WebauthnBeginLoginRequest) (*core.WebauthnBeginResponse, error) {
+	var wu *webauthnutils.User
+
+	// 指定用户时仅允许该用户已启用的凭证
+	if in.UserId != nil {
+		userID, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+
+		u, err := l.svcCtx.DBEnt.User.Query().
+			Where(user.IDEQ(userID), user.StateEQ(true)).
+			WithWebauthnCredentials(func(q *ent.UserWebauthnQuery) {
+				q.Where(userwebauthn.StateEQ(true))
+			}).
+			Only(l.ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		// 用户不存在与未注册凭证返回同样的错误，避免枚举用户
+		if err != nil || len(u.Edges.WebauthnCredentials) == 0 {
+			return nil, errorx.NewInvalidArgumentError("webauthn.notRegistered")
+		}
+		wu = webauthnutils.NewUser(u, u.Edges.WebauthnCredentials)
+	}
+
+	sessionID, options, err := l.svcCtx.Webauthn.BeginLogin(l.ctx, wu)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.WebauthnBeginResponse{
+		SessionId: sessionID,
+		Options:   options,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BeginWebauthnRegistrationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBeginWebauthnRegistrationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BeginWebauthnRegistrationLogic {
+	return &BeginWebauthnRegistrationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// WebAuthn相关接口
+func (l *BeginWebauthnRegistrationLogic) BeginWebauthnRegistration(in *core.WebauthnBeginRegistrationRequest) (*core.WebauthnBeginResponse, error) {
+	// 解析用户ID
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	// 查询用户及已有凭证，已有凭证将被排除避免重复注册
+	u, err := l.svcCtx.DBEnt.User.Query().
+		Where(user.IDEQ(userID)).
+		WithWebauthnCredentials().
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	sessionID, options, err := l.svcCtx.Webauthn.BeginRegistration(l.ctx, webauthnutils.NewUser(u, u.Edges.WebauthnCredentials))
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.WebauthnBeginResponse{
+		SessionId: sessionID,
+		Options:   options,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package userservicelogic
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ChangePasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangePasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangePasswordLogic {
+	return &ChangePasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改密码
+func (l *ChangePasswordLogic) ChangePassword(in *core.ChangePasswordRequest) (*core.BaseResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil || in.NewPassword == "" {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Get(l.ctx, userID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 校验原密码
+	if in.OldPassword != nil && !encrypt.BcryptCheck(*in.OldPassword, u.PasswordHash) {
+		return nil, errorx.NewInvalidArgumentError("password.oldPasswordError")
+	}
+
+	// 按密码策略校验新密码
+	policy := passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache)
+	passwordHash, err := preparePassword(l.ctx, tx, policy, u, u.Username, in.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.User.UpdateOne(u).
+		SetPasswordHash(passwordHash).
+		SetPasswordChangedAt(time.Now()).
+		SetMustChangePassword(false).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	if err = recordPasswordHistory(l.ctx, tx, policy, userID, passwordHash); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return &core.BaseResponse{
+		Message: "password.changeSuccess",
+	}, nil
+}
@@ -0,0 +1,45 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangePasswordRequiresCurrentPassword(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	u := svcCtx.DBEnt.User.Create().
+		SetUsername("alice").
+		SetPasswordHash(encrypt.BcryptEncrypt("Current#2024")).
+		SetMustChangePassword(true).
+		SaveX(ctx)
+
+	wrong := "Wrong#2024"
+	_, err := NewChangePasswordLogic(ctx, svcCtx).ChangePassword(&core.ChangePasswordRequest{
+		UserId:      u.ID.String(),
+		OldPassword: &wrong,
+		NewPassword: "Changed#2024",
+	})
+	if s, _ := status.FromError(err); s.Code() != codes.InvalidArgument || s.Message() != "password.oldPasswordError" {
+		t.Fatalf("wrong current password: %v", err)
+	}
+
+	current := "Current#2024"
+	if _, err = NewChangePasswordLogic(ctx, svcCtx).ChangePassword(&core.ChangePasswordRequest{
+		UserId:      u.ID.String(),
+		OldPassword: &current,
+		NewPassword: "Changed#2024",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	u = svcCtx.DBEnt.User.GetX(ctx, u.ID)
+	if !encrypt.BcryptCheck("Changed#2024", u.PasswordHash) || u.MustChangePassword || u.PasswordChangedAt == nil {
+		t.Fatalf("password not changed: %+v", u)
+	}
+}
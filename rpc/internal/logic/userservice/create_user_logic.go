@@ -2,13 +2,15 @@ package userservicelogic
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
-	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -46,6 +48,13 @@ func (l *CreateUserLogic) CreateUser(in *core.UserInfo) (*core.UserInfo, error)
 	}
 	defer tx.Rollback()
 
+	// 按密码策略校验密码
+	policy := passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache)
+	passwordHash, err := preparePassword(l.ctx, tx, policy, nil, *in.Username, *in.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+
 	// 生成用户ID
 	userID := uuid.New()
 
@@ -53,7 +62,9 @@ func (l *CreateUserLogic) CreateUser(in *core.UserInfo) (*core.UserInfo, error)
 	createQuery := tx.User.Create().
 		SetID(userID).
 		SetUsername(*in.Username).
-		SetPasswordHash(l.hashPassword(*in.PasswordHash)).
+		SetPasswordHash(passwordHash).
+		SetPasswordChangedAt(time.Now()).
+		SetMustChangePassword(pointer.GetBool(in.MustChangePassword)).
 		SetState(l.getStateValue(in.State))
 
 	// 设置可选字段
@@ -72,10 +83,16 @@ func (l *CreateUserLogic) CreateUser(in *core.UserInfo) (*core.UserInfo, error)
 	if in.UserDescription != nil {
 		createQuery.SetUserDescription(*in.UserDescription)
 	}
+	if in.HomePath != nil {
+		createQuery.SetHomePath(*in.HomePath)
+	}
 
 	if in.DepartmentId != nil {
 		createQuery.SetDepartmentID(*in.DepartmentId)
 	}
+	if in.Language != nil && *in.Language != "" {
+		createQuery.SetLanguage(*in.Language)
+	}
 
 	// 创建用户
 	_, err = createQuery.Save(l.ctx)
@@ -83,6 +100,11 @@ func (l *CreateUserLogic) CreateUser(in *core.UserInfo) (*core.UserInfo, error)
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
+	// 记录密码历史
+	if err = recordPasswordHistory(l.ctx, tx, policy, userID, passwordHash); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
 	var roleIds []uint32
 	// 处理角色关联
 	if len(in.RoleIds) > 0 {
@@ -152,11 +174,6 @@ func (l *CreateUserLogic) validateCreate(in *core.UserInfo) error {
 	return nil
 }
 
-// 密码哈希处理
-func (l *CreateUserLogic) hashPassword(password string) string {
-	return encrypt.BcryptEncrypt(password)
-}
-
 // 获取状态值，默认为 true (启用)
 func (l *CreateUserLogic) getStateValue(state *bool) bool {
 	if state != nil {
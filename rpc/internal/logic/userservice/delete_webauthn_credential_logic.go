@@ -0,0 +1,52 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWebauthnCredentialLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteWebauthnCredentialLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWebauthnCredentialLogic {
+	return &DeleteWebauthnCredentialLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 删除WebAuthn凭证
+func (l *DeleteWebauthnCredentialLogic) DeleteWebauthnCredential(in *core.WebauthnDeleteCredentialRequest) (*core.BaseResponse, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	// 只能删除属于该用户的凭证
+	affected, err := l.svcCtx.DBEnt.UserWebauthn.Delete().
+		Where(userwebauthn.IDEQ(in.Id), userwebauthn.UserIDEQ(userID)).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if affected == 0 {
+		return nil, errorx.NewNotFoundError(last_i18n.TargetNotExist)
+	}
+
+	return &core.BaseResponse{
+		Message: "common.deleteSuccess",
+	}, nil
+}
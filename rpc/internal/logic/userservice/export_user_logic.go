@@ -0,0 +1,83 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxExportRows 单次导出的最大行数
+const maxExportRows = 10000
+
+type ExportUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportUserLogic {
+	return &ExportUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出用户，筛选条件与 ListUser 一致，忽略分页
+func (l *ExportUserLogic) ExportUser(in *core.UserListRequest) (*core.UserExportResponse, error) {
+	query, err := l.svcCtx.DBEnt.User.Query().
+		Where(userListPredicates(in)...).
+		ApplyOptions(pageutils.QueryOptions(in.Page), ent.Asc(user.FieldCreatedAt))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	total, err := query.Clone().Count(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if total > maxExportRows {
+		return nil, errorx.NewInvalidArgumentError("user.exportTooMany")
+	}
+
+	users, err := query.
+		WithDepartment().
+		WithPositions().
+		WithRoles().
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	resp := &core.UserExportResponse{Rows: make([]*core.UserImportRow, 0, len(users))}
+	for i, u := range users {
+		row := &core.UserImportRow{
+			RowNumber: uint32(i + 2), // 第一行为表头
+			Username:  u.Username,
+			FullName:  pointer.ToStringPtrIfNotEmpty(u.FullName),
+			Email:     pointer.ToStringPtrIfNotEmpty(u.Email),
+			Mobile:    pointer.ToStringPtrIfNotEmpty(u.Mobile),
+		}
+		if u.Edges.Department != nil {
+			row.DepartmentCode = u.Edges.Department.DeptCode
+		}
+		for _, p := range u.Edges.Positions {
+			row.PositionCodes = append(row.PositionCodes, p.PositionCode)
+		}
+		for _, r := range u.Edges.Roles {
+			row.RoleCodes = append(row.RoleCodes, r.RoleCode)
+		}
+		resp.Rows = append(resp.Rows, row)
+	}
+
+	return resp, nil
+}
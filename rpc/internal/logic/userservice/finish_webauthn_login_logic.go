@@ -0,0 +1,125 @@
+package userservicelogic
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FinishWebauthnLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFinishWebauthnLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FinishWebauthnLoginLogic {
+	return &FinishWebauthnLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 完成WebAuthn登录
+func (l *FinishWebauthnLoginLogic) FinishWebauthnLogin(in *core.WebauthnFinishLoginRequest) (*core.UserInfo, error) {
+	// 作为第二因素时需要校验会话用户
+	var expectedUserID uuid.UUID
+	if in.UserId != nil {
+		id, err := uuid.Parse(*in.UserId)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		expectedUserID = id
+	}
+
+	// 仅加载已启用的凭证参与校验
+	loader := func(userID uuid.UUID) (*webauthnutils.User, error) {
+		u, err := l.svcCtx.DBEnt.User.Query().
+			Where(user.IDEQ(userID)).
+			WithWebauthnCredentials(func(q *ent.UserWebauthnQuery) {
+				q.Where(userwebauthn.StateEQ(true))
+			}).
+			Only(l.ctx)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+		}
+		return webauthnutils.NewUser(u, u.Edges.WebauthnCredentials), nil
+	}
+
+	wu, credential, err := l.svcCtx.Webauthn.FinishLogin(l.ctx, in.SessionId, []byte(in.Credential), loader)
+	if err != nil {
+		return nil, err
+	}
+	if in.UserId != nil && wu.ID != expectedUserID {
+		return nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+	}
+
+	record, err := l.svcCtx.DBEnt.UserWebauthn.Query().
+		Where(
+			userwebauthn.UserIDEQ(wu.ID),
+			userwebauthn.CredentialIDEQ(webauthnutils.EncodeCredentialID(credential.ID)),
+		).
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+	}
+
+	// 签名计数器回退说明凭证可能被克隆，标记并拒绝登录
+	if credential.Authenticator.CloneWarning {
+		l.Errorw("WebAuthn凭证疑似被克隆",
+			logx.Field("userId", wu.ID.String()),
+			logx.Field("credentialId", record.ID))
+		if err = l.svcCtx.DBEnt.UserWebauthn.UpdateOneID(record.ID).
+			SetCloneWarning(true).
+			Exec(l.ctx); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+		}
+		return nil, errorx.NewInvalidArgumentError("webauthn.cloneWarning")
+	}
+
+	// 更新签名计数器及使用记录
+	err = l.svcCtx.DBEnt.UserWebauthn.UpdateOneID(record.ID).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetUserVerified(credential.Flags.UserVerified).
+		SetBackupState(credential.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Exec(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+	}
+
+	// 返回完整的用户信息用于签发令牌
+	userWithEdges, err := l.svcCtx.DBEnt.User.Query().
+		Where(user.IDEQ(wu.ID)).
+		WithRoles().
+		WithPositions().
+		WithDepartment().
+		WithTotp().
+		WithWebauthnCredentials().
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+	}
+
+	info := userutils.ConvertUserToUserInfo(userWithEdges)
+	if info.EffectiveRoleValues, err = userutils.EffectiveRoleValues(l.ctx, l.svcCtx.DBEnt, userWithEdges.ID); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in.SessionId)
+	}
+	// 无密码登录同样需要按密码有效期要求修改密码
+	userutils.FillPasswordExpired(info, userWithEdges, passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache))
+	return info, nil
+}
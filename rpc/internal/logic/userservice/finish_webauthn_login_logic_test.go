@@ -0,0 +1,68 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils/webauthntest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestFinishWebauthnLoginReportsPasswordExpired(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	ceremony, err := webauthnutils.NewCeremony(&webauthn.Config{
+		RPID:          webauthntest.RPID,
+		RPDisplayName: "Last Admin",
+		RPOrigins:     []string{webauthntest.Origin},
+	}, webauthnutils.NewMemorySessionStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svcCtx.Webauthn = ceremony
+	svcCtx.ConfigurationCache.Set(passwordutils.KeyMaxAgeDays, "password<>30")
+
+	u := svcCtx.DBEnt.User.Create().
+		SetUsername("alice").
+		SetPasswordHash(encrypt.BcryptEncrypt("Current#2024")).
+		SetPasswordChangedAt(time.Now().AddDate(0, 0, -31)).
+		SaveX(ctx)
+	userID := u.ID.String()
+
+	a := webauthntest.NewAuthenticator(t)
+	begin, err := NewBeginWebauthnRegistrationLogic(ctx, svcCtx).
+		BeginWebauthnRegistration(&core.WebauthnBeginRegistrationRequest{UserId: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewFinishWebauthnRegistrationLogic(ctx, svcCtx).FinishWebauthnRegistration(&core.WebauthnFinishRegistrationRequest{
+		UserId:     userID,
+		SessionId:  begin.SessionId,
+		Credential: string(a.Create(t, begin.Options)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 无用户名(Passkey)登录
+	a.Counter++
+	begin, err = NewBeginWebauthnLoginLogic(ctx, svcCtx).BeginWebauthnLogin(&core.WebauthnBeginLoginRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := NewFinishWebauthnLoginLogic(ctx, svcCtx).FinishWebauthnLogin(&core.WebauthnFinishLoginRequest{
+		SessionId:  begin.SessionId,
+		Credential: string(a.Get(t, begin.Options)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetId() != userID || !info.GetPasswordExpired() {
+		t.Fatalf("expected expired password for %s, got %+v", userID, info)
+	}
+}
@@ -0,0 +1,81 @@
+package userservicelogic
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FinishWebauthnRegistrationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFinishWebauthnRegistrationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FinishWebauthnRegistrationLogic {
+	return &FinishWebauthnRegistrationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 完成注册WebAuthn凭证
+func (l *FinishWebauthnRegistrationLogic) FinishWebauthnRegistration(in *core.WebauthnFinishRegistrationRequest) (*core.WebauthnCredentialInfo, error) {
+	// 解析用户ID
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	u, err := l.svcCtx.DBEnt.User.Query().
+		Where(user.IDEQ(userID)).
+		WithWebauthnCredentials().
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	// 校验注册响应，会话与用户不一致时校验失败
+	credential, err := l.svcCtx.Webauthn.FinishRegistration(l.ctx, webauthnutils.NewUser(u, u.Edges.WebauthnCredentials), in.SessionId, []byte(in.Credential))
+	if err != nil {
+		return nil, err
+	}
+
+	// 未指定名称时使用默认名称
+	friendlyName := strings.TrimSpace(in.GetFriendlyName())
+	if friendlyName == "" {
+		friendlyName = "Passkey"
+	}
+
+	created, err := l.svcCtx.DBEnt.UserWebauthn.Create().
+		SetUserID(userID).
+		SetCredentialID(webauthnutils.EncodeCredentialID(credential.ID)).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetAaguid(webauthnutils.FormatAAGUID(credential.Authenticator.AAGUID)).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetTransports(webauthnutils.Transports(credential)).
+		SetAttachment(string(credential.Authenticator.Attachment)).
+		SetUserVerified(credential.Flags.UserVerified).
+		SetBackupEligible(credential.Flags.BackupEligible).
+		SetBackupState(credential.Flags.BackupState).
+		SetFriendlyName(friendlyName).
+		Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return userutils.ConvertWebauthnToCredentialInfo(created), nil
+}
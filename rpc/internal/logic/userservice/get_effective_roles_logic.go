@@ -0,0 +1,46 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEffectiveRolesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEffectiveRolesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEffectiveRolesLogic {
+	return &GetEffectiveRolesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户有效角色及来源
+func (l *GetEffectiveRolesLogic) GetEffectiveRoles(in *core.UUIDRequest) (*core.EffectiveRoleResponse, error) {
+	userID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	roles, err := userutils.EffectiveRoles(l.ctx, l.svcCtx.DBEnt, userID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.EffectiveRoleResponse{
+		List:       roles,
+		RoleValues: userutils.RoleValues(roles),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -35,11 +36,17 @@ func (l *GetUserByUsernameLogic) GetUserByUsername(in *core.StringRequest) (*cor
 		WithPositions().
 		WithDepartment().
 		WithTotp().
+		WithWebauthnCredentials().
 		Where(user.UsernameEQ(in.Value)).
 		Only(l.ctx)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	return userutils.ConvertUserToUserInfo(userWithEdges), nil
+	info := userutils.ConvertUserToUserInfo(userWithEdges)
+	if info.EffectiveRoleValues, err = userutils.EffectiveRoleValues(l.ctx, l.svcCtx.DBEnt, userWithEdges.ID); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	userutils.FillPasswordExpired(info, userWithEdges, passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache))
+	return info, nil
 }
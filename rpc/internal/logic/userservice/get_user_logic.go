@@ -7,6 +7,7 @@ import (
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -45,10 +46,16 @@ func (l *GetUserLogic) GetUser(in *core.UUIDRequest) (*core.UserInfo, error) {
 		WithPositions().
 		WithDepartment().
 		WithTotp().
+		WithWebauthnCredentials().
 		Only(l.ctx)
 	if err != nil {
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
-	return userutils.ConvertUserToUserInfo(userWithEdges), nil
+	info := userutils.ConvertUserToUserInfo(userWithEdges)
+	if info.EffectiveRoleValues, err = userutils.EffectiveRoleValues(l.ctx, l.svcCtx.DBEnt, userWithEdges.ID); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	userutils.FillPasswordExpired(info, userWithEdges, passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache))
+	return info, nil
 }
\ No newline at end of file
@@ -0,0 +1,87 @@
+package userservicelogic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+const (
+	// 模拟登录审计日志的操作类型
+	operationImpersonateStart = "IMPERSONATE_START"
+	operationImpersonateStop  = "IMPERSONATE_STOP"
+)
+
+// parseImpersonation 解析模拟登录请求中的管理员及目标用户ID
+func parseImpersonation(in *core.ImpersonationRequest) (impersonatorID, targetID uuid.UUID, err error) {
+	if impersonatorID, err = uuid.Parse(in.ImpersonatorId); err != nil {
+		return impersonatorID, targetID, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if targetID, err = uuid.Parse(in.UserId); err != nil {
+		return impersonatorID, targetID, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+	if impersonatorID == targetID {
+		return impersonatorID, targetID, errorx.NewInvalidArgumentError("impersonation.self")
+	}
+	return impersonatorID, targetID, nil
+}
+
+// canImpersonate 判断目标用户的有效角色是否均为管理员所拥有，防止模拟更高权限的用户
+func canImpersonate(ctx context.Context, db *ent.Client, impersonatorID, targetID uuid.UUID) (bool, error) {
+	owned, err := userutils.EffectiveRoleValues(ctx, db, impersonatorID)
+	if err != nil {
+		return false, err
+	}
+	roles, err := userutils.EffectiveRoleValues(ctx, db, targetID)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range roles {
+		if !slices.Contains(owned, r) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// recordImpersonation 写入模拟登录审计日志，操作人为发起模拟的管理员
+func recordImpersonation(ctx context.Context, db *ent.Client, impersonatorID, targetID uuid.UUID,
+	in *core.ImpersonationRequest, operationType string) error {
+	impersonator, err := db.User.Get(ctx, impersonatorID)
+	if err != nil {
+		return err
+	}
+	target, err := db.User.Get(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	params, err := json.Marshal(map[string]string{
+		"userId":   target.ID.String(),
+		"username": target.Username,
+		"reason":   in.GetReason(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return db.OperationLog.Create().
+		SetUserID(impersonatorID).
+		SetUsername(impersonator.Username).
+		SetOperationType(operationType).
+		SetModule("user").
+		SetBusinessType("impersonation").
+		SetRequestParams(string(params)).
+		SetNillableIPAddress(in.IpAddress).
+		SetNillableUserAgent(in.UserAgent).
+		SetDescription(fmt.Sprintf("%s 模拟用户 %s", impersonator.Username, target.Username)).
+		Exec(ctx)
+}
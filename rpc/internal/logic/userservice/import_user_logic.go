@@ -0,0 +1,302 @@
+package userservicelogic
+
+import (
+	"context"
+	"net/mail"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/ent/position"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// maxImportRows 单次导入的最大行数
+	maxImportRows = 5000
+	// defaultImportBatchSize 默认每批事务写入的行数
+	defaultImportBatchSize = 100
+)
+
+type ImportUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportUserLogic {
+	return &ImportUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// importRefs 导入时用到的编码到ID的映射
+type importRefs struct {
+	departments map[string]uint32
+	positions   map[string]uint32
+	roles       map[string]uint32
+	usernames   map[string]bool // 数据库中已存在的用户名
+}
+
+// 批量导入用户
+func (l *ImportUserLogic) ImportUser(in *core.UserImportRequest) (*core.UserImportResponse, error) {
+	if len(in.Rows) == 0 || len(in.Rows) > maxImportRows {
+		return nil, errorx.NewInvalidArgumentError("user.importRowsLimit")
+	}
+
+	refs, err := l.loadRefs(in.Rows)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, nil)
+	}
+
+	// 逐行校验，文件内用户名重复也视为错误
+	results := make([]*core.UserImportRowResult, len(in.Rows))
+	seen := make(map[string]bool, len(in.Rows))
+	var valid []int
+	for i, row := range in.Rows {
+		result := &core.UserImportRowResult{
+			RowNumber: row.RowNumber,
+			Username:  row.Username,
+			Errors:    validateImportRow(row, refs),
+		}
+		if seen[row.Username] && row.Username != "" {
+			result.Errors = append(result.Errors, importError("username", "user.importUsernameDuplicated", row.Username))
+		}
+		seen[row.Username] = true
+		result.Success = len(result.Errors) == 0
+		if result.Success {
+			valid = append(valid, i)
+		}
+		results[i] = result
+	}
+
+	if !in.DryRun {
+		batchSize := int(pointer.GetUint32(in.BatchSize))
+		if batchSize <= 0 {
+			batchSize = defaultImportBatchSize
+		}
+		policy := passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache)
+		for start := 0; start < len(valid); start += batchSize {
+			end := min(start+batchSize, len(valid))
+			l.importBatch(in.Rows, results, valid[start:end], refs, policy)
+		}
+	}
+
+	resp := &core.UserImportResponse{
+		Total:   uint32(len(in.Rows)),
+		DryRun:  in.DryRun,
+		Results: results,
+	}
+	for _, result := range results {
+		if result.Success {
+			resp.Success++
+		} else {
+			resp.Failed++
+		}
+	}
+	return resp, nil
+}
+
+// importBatch 在一个事务中写入一批用户，任一行失败则整批回滚并标记失败
+func (l *ImportUserLogic) importBatch(rows []*core.UserImportRow, results []*core.UserImportRowResult, batch []int, refs *importRefs, policy passwordutils.Policy) {
+	// 事务外生成初始密码及哈希，缩短事务时长
+	passwords := make([]string, len(batch))
+	hashes := make([]string, len(batch))
+	for i := range batch {
+		password, err := policy.Generate()
+		if err != nil {
+			l.Errorw("生成初始密码失败", logx.Field("detail", err.Error()))
+			markBatchFailed(results, batch, "user.importFailed")
+			return
+		}
+		passwords[i] = password
+		hashes[i] = encrypt.BcryptEncrypt(password)
+	}
+
+	err := l.insertBatch(rows, batch, hashes, refs, policy)
+	if err != nil {
+		rowNumbers := make([]uint32, len(batch))
+		for i, idx := range batch {
+			rowNumbers[i] = rows[idx].RowNumber
+		}
+		l.Errorw("导入用户批次失败", logx.Field("rows", rowNumbers), logx.Field("detail", err.Error()))
+		markBatchFailed(results, batch, "user.importFailed")
+		return
+	}
+
+	for i, idx := range batch {
+		results[idx].InitialPassword = &passwords[i]
+	}
+}
+
+func (l *ImportUserLogic) insertBatch(rows []*core.UserImportRow, batch []int, hashes []string, refs *importRefs, policy passwordutils.Policy) error {
+	tx, err := l.svcCtx.DBEnt.Tx(l.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	for i, idx := range batch {
+		row := rows[idx]
+		userID := uuid.New()
+		create := tx.User.Create().
+			SetID(userID).
+			SetUsername(row.Username).
+			SetPasswordHash(hashes[i]).
+			SetPasswordChangedAt(now).
+			SetMustChangePassword(true).
+			SetState(true).
+			SetDepartmentID(refs.departments[row.DepartmentCode]).
+			SetNillableFullName(row.FullName).
+			SetNillableEmail(row.Email).
+			SetNillableMobile(row.Mobile)
+		for _, code := range row.PositionCodes {
+			create.AddPositionIDs(refs.positions[code])
+		}
+		for _, code := range row.RoleCodes {
+			create.AddRoleIDs(refs.roles[code])
+		}
+		if err = create.Exec(l.ctx); err != nil {
+			return err
+		}
+		if err = recordPasswordHistory(l.ctx, tx, policy, userID, hashes[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// loadRefs 一次性加载导入行引用的部门、岗位、角色及已存在的用户名
+func (l *ImportUserLogic) loadRefs(rows []*core.UserImportRow) (*importRefs, error) {
+	var deptCodes, positionCodes, roleCodes, usernames []string
+	for _, row := range rows {
+		deptCodes = append(deptCodes, row.DepartmentCode)
+		positionCodes = append(positionCodes, row.PositionCodes...)
+		roleCodes = append(roleCodes, row.RoleCodes...)
+		usernames = append(usernames, row.Username)
+	}
+
+	refs := &importRefs{
+		departments: make(map[string]uint32),
+		positions:   make(map[string]uint32),
+		roles:       make(map[string]uint32),
+		usernames:   make(map[string]bool),
+	}
+
+	departments, err := l.svcCtx.DBEnt.Department.Query().
+		Where(department.DeptCodeIn(deptCodes...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range departments {
+		refs.departments[d.DeptCode] = d.ID
+	}
+
+	positions, err := l.svcCtx.DBEnt.Position.Query().
+		Where(position.PositionCodeIn(positionCodes...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range positions {
+		refs.positions[p.PositionCode] = p.ID
+	}
+
+	roles, err := l.svcCtx.DBEnt.Role.Query().
+		Where(role.RoleCodeIn(roleCodes...)).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		refs.roles[r.RoleCode] = r.ID
+	}
+
+	existing, err := l.svcCtx.DBEnt.User.Query().
+		Where(user.UsernameIn(usernames...)).
+		Select(user.FieldUsername).
+		Strings(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range existing {
+		refs.usernames[name] = true
+	}
+
+	return refs, nil
+}
+
+// validateImportRow 校验单行数据，返回该行的全部错误
+func validateImportRow(row *core.UserImportRow, refs *importRefs) []*core.UserImportRowError {
+	var errs []*core.UserImportRowError
+
+	switch n := utf8.RuneCountInString(row.Username); {
+	case n == 0:
+		errs = append(errs, importError("username", "user.importUsernameRequired", ""))
+	case n < 4 || n > 16:
+		errs = append(errs, importError("username", "user.importUsernameInvalid", row.Username))
+	case refs.usernames[row.Username]:
+		errs = append(errs, importError("username", "user.importUsernameExists", row.Username))
+	}
+
+	if row.FullName != nil && utf8.RuneCountInString(*row.FullName) > 100 {
+		errs = append(errs, importError("fullName", "user.importFullNameInvalid", *row.FullName))
+	}
+	if row.Email != nil {
+		if addr, err := mail.ParseAddress(*row.Email); err != nil || addr.Address != *row.Email || len(*row.Email) > 100 {
+			errs = append(errs, importError("email", "user.importEmailInvalid", *row.Email))
+		}
+	}
+	if row.Mobile != nil && len(*row.Mobile) > 20 {
+		errs = append(errs, importError("mobile", "user.importMobileInvalid", *row.Mobile))
+	}
+
+	if row.DepartmentCode == "" {
+		errs = append(errs, importError("departmentCode", "user.importDepartmentRequired", ""))
+	} else if _, ok := refs.departments[row.DepartmentCode]; !ok {
+		errs = append(errs, importError("departmentCode", "user.importDepartmentNotFound", row.DepartmentCode))
+	}
+	for _, code := range row.PositionCodes {
+		if _, ok := refs.positions[code]; !ok {
+			errs = append(errs, importError("positionCodes", "user.importPositionNotFound", code))
+		}
+	}
+	for _, code := range row.RoleCodes {
+		if _, ok := refs.roles[code]; !ok {
+			errs = append(errs, importError("roleCodes", "user.importRoleNotFound", code))
+		}
+	}
+
+	return errs
+}
+
+func importError(field, message, value string) *core.UserImportRowError {
+	return &core.UserImportRowError{
+		Field:   field,
+		Message: message,
+		Value:   pointer.ToStringPtrIfNotEmpty(value),
+	}
+}
+
+func markBatchFailed(results []*core.UserImportRowResult, batch []int, message string) {
+	for _, idx := range batch {
+		results[idx].Success = false
+		results[idx].Errors = append(results[idx].Errors, importError("", message, ""))
+	}
+}
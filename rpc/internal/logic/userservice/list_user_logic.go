@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/wenpiner/last-admin-core/rpc/ent/predicate"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/pageutils"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -30,27 +32,15 @@ func NewListUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUser
 
 // 获取用户列表
 func (l *ListUserLogic) ListUser(in *core.UserListRequest) (*core.UserListResponse, error) {
-	// 构建查询条件
-	var predicates []predicate.User
-
-	// 根据用户名模糊搜索
-	if in.Username != nil && *in.Username != "" {
-		predicates = append(predicates, user.UsernameContains(*in.Username))
-	}
-
-	// 根据邮箱模糊搜索
-	if in.Email != nil && *in.Email != "" {
-		predicates = append(predicates, user.EmailContains(*in.Email))
-	}
-
-	// 根据手机号模糊搜索
-	if in.Mobile != nil && *in.Mobile != "" {
-		predicates = append(predicates, user.MobileContains(*in.Mobile))
+	query, err := l.svcCtx.DBEnt.User.Query().
+		Where(userListPredicates(in)...).
+		ApplyOptions(pageutils.QueryOptions(in.Page))
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
 	// 执行分页查询（包含关联数据）
-	page, err := l.svcCtx.DBEnt.User.Query().
-		Where(predicates...).
+	page, err := query.
 		WithRoles().
 		WithPositions().
 		WithTotp().
@@ -74,3 +64,29 @@ func (l *ListUserLogic) ListUser(in *core.UserListRequest) (*core.UserListRespon
 	return resp, nil
 }
 
+// userListPredicates 构建用户列表的查询条件，列表与导出共用
+func userListPredicates(in *core.UserListRequest) []predicate.User {
+	var predicates []predicate.User
+
+	// 根据用户名模糊搜索
+	if in.Username != nil && *in.Username != "" {
+		predicates = append(predicates, user.UsernameContains(*in.Username))
+	}
+
+	// 根据邮箱模糊搜索
+	if in.Email != nil && *in.Email != "" {
+		predicates = append(predicates, user.EmailContains(*in.Email))
+	}
+
+	// 根据手机号模糊搜索
+	if in.Mobile != nil && *in.Mobile != "" {
+		predicates = append(predicates, user.MobileContains(*in.Mobile))
+	}
+
+	// 拥有任一指定角色
+	if len(in.RoleIds) > 0 {
+		predicates = append(predicates, user.HasRolesWith(role.IDIn(in.RoleIds...)))
+	}
+
+	return predicates
+}
@@ -0,0 +1,53 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWebauthnCredentialLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListWebauthnCredentialLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWebauthnCredentialLogic {
+	return &ListWebauthnCredentialLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 获取用户WebAuthn凭证列表
+func (l *ListWebauthnCredentialLogic) ListWebauthnCredential(in *core.UUIDRequest) (*core.WebauthnCredentialListResponse, error) {
+	userID, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	credentials, err := l.svcCtx.DBEnt.UserWebauthn.Query().
+		Where(userwebauthn.UserIDEQ(userID)).
+		Order(ent.Desc(userwebauthn.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	resp := &core.WebauthnCredentialListResponse{}
+	for _, credential := range credentials {
+		resp.List = append(resp.List, userutils.ConvertWebauthnToCredentialInfo(credential))
+	}
+	return resp, nil
+}
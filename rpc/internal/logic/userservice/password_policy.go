@@ -0,0 +1,68 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userpasswordhistory"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// preparePassword 按密码策略校验新密码并返回哈希，u 为空表示新建用户，不做历史校验
+func preparePassword(ctx context.Context, tx *ent.Tx, policy passwordutils.Policy, u *ent.User, username, password string) (string, error) {
+	if err := policy.Validate(password, username); err != nil {
+		return "", err
+	}
+
+	if u != nil && policy.HistoryDepth > 0 {
+		histories, err := tx.UserPasswordHistory.Query().
+			Where(userpasswordhistory.UserIDEQ(u.ID)).
+			Order(ent.Desc(userpasswordhistory.FieldID)).
+			Limit(policy.HistoryDepth).
+			All(ctx)
+		if err != nil {
+			return "", err
+		}
+		// 当前密码始终参与比较，兼容启用策略前没有历史记录的用户
+		hashes := []string{u.PasswordHash}
+		for _, history := range histories {
+			hashes = append(hashes, history.PasswordHash)
+		}
+		if policy.Reused(password, hashes) {
+			return "", errorx.NewInvalidArgumentError("password.reused")
+		}
+	}
+
+	return encrypt.BcryptEncrypt(password), nil
+}
+
+// recordPasswordHistory 记录密码历史，并清理超出保留深度的记录
+func recordPasswordHistory(ctx context.Context, tx *ent.Tx, policy passwordutils.Policy, userID uuid.UUID, hash string) error {
+	if policy.HistoryDepth <= 0 {
+		return nil
+	}
+
+	err := tx.UserPasswordHistory.Create().
+		SetUserID(userID).
+		SetPasswordHash(hash).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	stale, err := tx.UserPasswordHistory.Query().
+		Where(userpasswordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(userpasswordhistory.FieldID)).
+		Offset(policy.HistoryDepth).
+		IDs(ctx)
+	if err != nil || len(stale) == 0 {
+		return err
+	}
+	_, err = tx.UserPasswordHistory.Delete().
+		Where(userpasswordhistory.IDIn(stale...)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,23 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	tokenservicelogic "github.com/wenpiner/last-admin-core/rpc/internal/logic/tokenservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// revokeUsersTokens 撤销用户的全部令牌并写入黑名单，禁用或删除用户后其已登录会话立即失效
+// 删除用户会清空令牌记录的用户ID，因此需在删除前撤销
+func revokeUsersTokens(ctx context.Context, svcCtx *svc.ServiceContext, ids []uuid.UUID) error {
+	for _, id := range ids {
+		if _, err := tokenservicelogic.NewRevokeUserTokensLogic(ctx, svcCtx).RevokeUserTokens(&core.RevokeUserTokensRequest{
+			UserId: id.String(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/zeromicro/go-zero/core/errorx"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStartImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartImpersonationLogic {
+	return &StartImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 开始模拟登录，校验目标用户并记录审计日志
+func (l *StartImpersonationLogic) StartImpersonation(in *core.ImpersonationRequest) (*core.UserInfo, error) {
+	impersonatorID, targetID, err := parseImpersonation(in)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := NewGetUserLogic(l.ctx, l.svcCtx).GetUser(&core.UUIDRequest{Id: in.UserId})
+	if err != nil {
+		return nil, err
+	}
+	if !pointer.GetBool(info.State) {
+		return nil, errorx.NewInvalidArgumentError("user.disabled")
+	}
+	ok, err := canImpersonate(l.ctx, l.svcCtx.DBEnt, impersonatorID, targetID)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	if !ok {
+		return nil, errorx.NewPermissionDeniedError("impersonation.privilegeExceeded")
+	}
+
+	if err = recordImpersonation(l.ctx, l.svcCtx.DBEnt, impersonatorID, targetID, in, operationImpersonateStart); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return info, nil
+}
@@ -0,0 +1,41 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartImpersonationRoles(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	admin := svcCtx.DBEnt.Role.Create().SetRoleName("管理员").SetRoleCode("admin").SaveX(ctx)
+	support := svcCtx.DBEnt.Role.Create().SetRoleName("客服").SetRoleCode("support").SaveX(ctx)
+	operator := svcCtx.DBEnt.User.Create().SetUsername("operator").SetPasswordHash("x").AddRoles(support).SaveX(ctx)
+	member := svcCtx.DBEnt.User.Create().SetUsername("member").SetPasswordHash("x").AddRoles(support).SaveX(ctx)
+	root := svcCtx.DBEnt.User.Create().SetUsername("root").SetPasswordHash("x").AddRoles(admin, support).SaveX(ctx)
+
+	logic := NewStartImpersonationLogic(ctx, svcCtx)
+	_, err := logic.StartImpersonation(&core.ImpersonationRequest{
+		ImpersonatorId: operator.ID.String(),
+		UserId:         root.ID.String(),
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("impersonate admin: err = %v, want PermissionDenied", err)
+	}
+
+	info, err := logic.StartImpersonation(&core.ImpersonationRequest{
+		ImpersonatorId: operator.ID.String(),
+		UserId:         member.ID.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetUsername() != "member" {
+		t.Fatalf("username = %q", info.GetUsername())
+	}
+}
@@ -0,0 +1,39 @@
+package userservicelogic
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StopImpersonationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStopImpersonationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StopImpersonationLogic {
+	return &StopImpersonationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 结束模拟登录，记录审计日志
+func (l *StopImpersonationLogic) StopImpersonation(in *core.ImpersonationRequest) (*core.BaseResponse, error) {
+	impersonatorID, targetID, err := parseImpersonation(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = recordImpersonation(l.ctx, l.svcCtx.DBEnt, impersonatorID, targetID, in, operationImpersonateStop); err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+	return &core.BaseResponse{Message: "impersonation.stopped"}, nil
+}
@@ -2,14 +2,13 @@ package userservicelogic
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
+	"time"
 
 	"github.com/google/uuid"
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
 	"github.com/wenpiner/last-admin-core/rpc/ent/user"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
 
 	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
@@ -75,6 +74,9 @@ func (l *UpdateUserLogic) UpdateUser(in *core.UserInfo) (*core.UserInfo, error)
 	if in.UserDescription != nil {
 		updateQuery.SetUserDescription(*in.UserDescription)
 	}
+	if in.HomePath != nil {
+		updateQuery.SetHomePath(*in.HomePath)
+	}
 	if in.State != nil {
 		updateQuery.SetState(*in.State)
 	}
@@ -82,9 +84,35 @@ func (l *UpdateUserLogic) UpdateUser(in *core.UserInfo) (*core.UserInfo, error)
 		updateQuery.SetDepartmentID(*in.DepartmentId)
 	}
 
-	// 处理密码更新（如果提供了密码）
+	if in.MustChangePassword != nil {
+		updateQuery.SetMustChangePassword(*in.MustChangePassword)
+	}
+	// 偏好语言为空时清除，恢复按请求头协商
+	if in.Language != nil {
+		if *in.Language == "" {
+			updateQuery.ClearLanguage()
+		} else {
+			updateQuery.SetLanguage(*in.Language)
+		}
+	}
+
+	// 处理密码更新（如果提供了密码），按密码策略校验
+	policy := passwordutils.LoadPolicy(l.svcCtx.ConfigurationCache)
+	var passwordHash string
 	if in.PasswordHash != nil && *in.PasswordHash != "" {
-		updateQuery.SetPasswordHash(l.hashPassword(*in.PasswordHash))
+		u, err := tx.User.Get(l.ctx, userID)
+		if err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+		username := u.Username
+		if in.Username != nil {
+			username = *in.Username
+		}
+		passwordHash, err = preparePassword(l.ctx, tx, policy, u, username, *in.PasswordHash)
+		if err != nil {
+			return nil, err
+		}
+		updateQuery.SetPasswordHash(passwordHash).SetPasswordChangedAt(time.Now())
 	}
 
 	// 执行基本信息更新
@@ -93,6 +121,13 @@ func (l *UpdateUserLogic) UpdateUser(in *core.UserInfo) (*core.UserInfo, error)
 		return nil, errorhandler.DBEntError(l.Logger, err, in)
 	}
 
+	// 记录密码历史
+	if passwordHash != "" {
+		if err = recordPasswordHistory(l.ctx, tx, policy, userID, passwordHash); err != nil {
+			return nil, errorhandler.DBEntError(l.Logger, err, in)
+		}
+	}
+
 	// 处理角色关联更新
 	if in.RoleIds != nil {
 		// 先清除现有角色关联
@@ -157,18 +192,3 @@ func (l *UpdateUserLogic) validateUpdate(in *core.UserInfo) error {
 	return nil
 }
 
-// 密码哈希处理
-func (l *UpdateUserLogic) hashPassword(password string) string {
-	// 生成盐值
-	salt := make([]byte, 16)
-	rand.Read(salt)
-
-	// 使用SHA256进行哈希
-	hasher := sha256.New()
-	hasher.Write([]byte(password))
-	hasher.Write(salt)
-
-	// 返回十六进制编码的哈希值
-	return hex.EncodeToString(hasher.Sum(nil)) + ":" + hex.EncodeToString(salt)
-}
-
@@ -0,0 +1,27 @@
+package userservicelogic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc/svctest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestUpdateUserHomePath(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := svctest.New(t)
+	u := svcCtx.DBEnt.User.Create().SetUsername("alice").SetPasswordHash("x").SaveX(ctx)
+
+	info, err := NewUpdateUserLogic(ctx, svcCtx).UpdateUser(&core.UserInfo{
+		Id:       pointer.ToStringPtr(u.ID.String()),
+		HomePath: pointer.ToStringPtr("/dashboard/workspace"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored := svcCtx.DBEnt.User.GetX(ctx, u.ID).HomePath; stored != "/dashboard/workspace" || info.GetHomePath() != stored {
+		t.Fatalf("home path = %q, response %q", stored, info.GetHomePath())
+	}
+}
@@ -0,0 +1,58 @@
+package userservicelogic
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/ent/userwebauthn"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/errorhandler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateWebauthnCredentialLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateWebauthnCredentialLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateWebauthnCredentialLogic {
+	return &UpdateWebauthnCredentialLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 修改WebAuthn凭证
+func (l *UpdateWebauthnCredentialLogic) UpdateWebauthnCredential(in *core.WebauthnUpdateCredentialRequest) (*core.WebauthnCredentialInfo, error) {
+	userID, err := uuid.Parse(in.UserId)
+	if err != nil {
+		return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	}
+
+	// 只能修改属于该用户的凭证
+	credential, err := l.svcCtx.DBEnt.UserWebauthn.Query().
+		Where(userwebauthn.IDEQ(in.Id), userwebauthn.UserIDEQ(userID)).
+		Only(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	update := credential.Update()
+	if in.FriendlyName != nil {
+		update.SetFriendlyName(strings.TrimSpace(*in.FriendlyName))
+	}
+	credential, err = update.Save(l.ctx)
+	if err != nil {
+		return nil, errorhandler.DBEntError(l.Logger, err, in)
+	}
+
+	return userutils.ConvertWebauthnToCredentialInfo(credential), nil
+}
@@ -0,0 +1,302 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bsm/redislock"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// ConfigurationGroup 定时任务配置分组，任务的 cron 表达式存储于 sys_configuration
+const ConfigurationGroup = "/job"
+
+const (
+	StatusRunning = "running"
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+// syncInterval 重新读取 cron 表达式配置的间隔
+const syncInterval = time.Minute
+
+var (
+	// ErrJobNotFound 任务不存在
+	ErrJobNotFound = errors.New("scheduler: job not found")
+	// ErrJobRunning 任务正在其他实例或本实例中执行
+	ErrJobRunning = errors.New("scheduler: job is running")
+)
+
+// Job 定时任务，Run 返回的字符串作为执行结果记录
+type Job struct {
+	Name        string
+	DefaultSpec string        // 默认 cron 表达式，配置缺失时使用，为空表示不定时执行
+	Timeout     time.Duration // 单次执行超时时间，同时作为分布式锁的有效期
+	Run         func(ctx context.Context) (string, error)
+}
+
+// SpecKey 任务 cron 表达式的配置键
+func SpecKey(name string) string {
+	return "job_" + name + "_cron"
+}
+
+// entry 已注册的任务及其当前调度
+type entry struct {
+	job     Job
+	spec    string
+	entryID cron.EntryID
+}
+
+// JobState 任务的调度信息
+type JobState struct {
+	Name    string
+	Spec    string
+	NextRun time.Time
+}
+
+// Scheduler 定时任务调度器，多实例部署时通过 Redis 锁保证同一任务只在一个实例执行
+type Scheduler struct {
+	db      *ent.Client
+	configs *cache.ConfigurationCache
+	locker  *redislock.Client
+	cron    *cron.Cron
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	names   []string
+	done    chan struct{}
+}
+
+// New 创建调度器
+func New(db *ent.Client, rds redis.UniversalClient, configs *cache.ConfigurationCache) *Scheduler {
+	return &Scheduler{
+		db:      db,
+		configs: configs,
+		locker:  redislock.New(rds),
+		cron:    cron.New(),
+		entries: make(map[string]*entry),
+		done:    make(chan struct{}),
+	}
+}
+
+// Register 注册任务，需在 Start 之前调用
+func (s *Scheduler) Register(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[job.Name] = &entry{job: job}
+	s.names = append(s.names, job.Name)
+}
+
+// Start 按配置调度全部任务，并定期同步 cron 表达式的变更
+func (s *Scheduler) Start() {
+	s.sync()
+	s.cron.Start()
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(syncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sync()
+			case <-s.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop 停止调度，等待正在执行的任务结束
+func (s *Scheduler) Stop() {
+	close(s.done)
+	<-s.cron.Stop().Done()
+}
+
+// Has 判断任务是否已注册
+func (s *Scheduler) Has(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.entries[name]
+	return ok
+}
+
+// States 返回全部任务的调度信息，按注册顺序排列
+func (s *Scheduler) States() []JobState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	states := make([]JobState, 0, len(s.names))
+	for _, name := range s.names {
+		e := s.entries[name]
+		state := JobState{Name: name, Spec: e.spec}
+		if e.entryID != 0 {
+			state.NextRun = s.cron.Entry(e.entryID).Next
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// Trigger 立即异步执行任务，不受暂停状态影响
+func (s *Scheduler) Trigger(ctx context.Context, name string) error {
+	s.mu.Lock()
+	e, ok := s.entries[name]
+	s.mu.Unlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+
+	// 在返回前获取锁，便于告知调用方任务正在执行
+	lock, err := s.obtain(ctx, e.job)
+	if err != nil {
+		return err
+	}
+	threading.GoSafe(func() {
+		s.execute(e.job, lock, TriggerManual)
+	})
+	return nil
+}
+
+// sync 读取任务的 cron 表达式，表达式变化时重新调度
+func (s *Scheduler) sync() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range s.names {
+		e := s.entries[name]
+		spec := e.job.DefaultSpec
+		if v, ok := s.configs.GetValue(SpecKey(name)); ok {
+			spec = v
+		}
+		if spec == e.spec {
+			continue
+		}
+
+		var entryID cron.EntryID
+		if spec != "" {
+			job := e.job
+			id, err := s.cron.AddFunc(spec, func() { s.runScheduled(job) })
+			if err != nil {
+				// 表达式无效时保留原有调度
+				logx.Errorw("定时任务 cron 表达式无效", logx.Field("job", name), logx.Field("spec", spec),
+					logx.Field("detail", err.Error()))
+				continue
+			}
+			entryID = id
+		}
+		if e.entryID != 0 {
+			s.cron.Remove(e.entryID)
+		}
+		e.spec, e.entryID = spec, entryID
+	}
+}
+
+// runScheduled 定时触发的执行，任务暂停或已在其他实例执行时跳过
+func (s *Scheduler) runScheduled(job Job) {
+	ctx := context.Background()
+	paused, err := s.db.ScheduledJob.Query().
+		Where(scheduledjob.Name(job.Name), scheduledjob.Paused(true)).
+		Exist(ctx)
+	if err != nil {
+		logx.Errorw("查询定时任务状态失败", logx.Field("job", job.Name), logx.Field("detail", err.Error()))
+		return
+	}
+	if paused {
+		return
+	}
+
+	lock, err := s.obtain(ctx, job)
+	if err != nil {
+		if !errors.Is(err, ErrJobRunning) {
+			logx.Errorw("获取定时任务锁失败", logx.Field("job", job.Name), logx.Field("detail", err.Error()))
+		}
+		return
+	}
+	s.execute(job, lock, TriggerSchedule)
+}
+
+// obtain 获取任务的分布式锁，不重试
+func (s *Scheduler) obtain(ctx context.Context, job Job) (*redislock.Lock, error) {
+	lock, err := s.locker.Obtain(ctx, "last-admin-core:job:"+job.Name, job.Timeout, nil)
+	if errors.Is(err, redislock.ErrNotObtained) {
+		return nil, ErrJobRunning
+	}
+	return lock, err
+}
+
+// execute 执行任务并记录执行状态及耗时，执行结束后释放锁
+func (s *Scheduler) execute(job Job, lock *redislock.Lock, trigger string) {
+	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	defer cancel()
+	defer lock.Release(context.Background())
+
+	start := time.Now()
+	s.record(job.Name, func(u *ent.ScheduledJobUpdateOne) {
+		u.SetLastRunAt(start).
+			SetLastStatus(StatusRunning).
+			SetLastTrigger(trigger).
+			SetLastDuration(0).
+			SetLastMessage("")
+	})
+
+	message, err := runSafely(ctx, job)
+	status := StatusSuccess
+	if err != nil {
+		status, message = StatusFailed, err.Error()
+		logx.Errorw("定时任务执行失败", logx.Field("job", job.Name), logx.Field("detail", message))
+	}
+	if len([]rune(message)) > 1000 {
+		message = string([]rune(message)[:1000])
+	}
+	s.record(job.Name, func(u *ent.ScheduledJobUpdateOne) {
+		u.SetLastStatus(status).
+			SetLastDuration(time.Since(start).Milliseconds()).
+			SetLastMessage(message)
+	})
+}
+
+// runSafely 执行任务并将 panic 转换为错误
+func runSafely(ctx context.Context, job Job) (message string, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+// record 更新任务的执行记录，记录不存在时创建
+func (s *Scheduler) record(name string, set func(u *ent.ScheduledJobUpdateOne)) {
+	ctx := context.Background()
+	id, err := EnsureJob(ctx, s.db, name)
+	if err == nil {
+		update := s.db.ScheduledJob.UpdateOneID(id)
+		set(update)
+		err = update.Exec(ctx)
+	}
+	if err != nil {
+		logx.Errorw("记录定时任务执行状态失败", logx.Field("job", name), logx.Field("detail", err.Error()))
+	}
+}
+
+// EnsureJob 返回任务记录的ID，记录不存在时创建
+func EnsureJob(ctx context.Context, db *ent.Client, name string) (uint32, error) {
+	id, err := db.ScheduledJob.Query().Where(scheduledjob.Name(name)).OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		job, err := db.ScheduledJob.Create().SetName(name).Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return job.ID, nil
+	}
+	return id, err
+}
@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/enttest"
+	"github.com/wenpiner/last-admin-core/rpc/ent/scheduledjob"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+)
+
+func newTestScheduler(t *testing.T) (*Scheduler, *ent.Client, *cache.ConfigurationCache) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_")))
+	t.Cleanup(func() { _ = db.Close() })
+
+	configs := cache.NewConfigurationCache()
+	return New(db, rds, configs), db, configs
+}
+
+// countingJob 返回执行次数计数的任务，release 非空时阻塞至其关闭
+func countingJob(name string, runs *atomic.Int32, release chan struct{}) Job {
+	return Job{
+		Name:    name,
+		Timeout: time.Minute,
+		Run: func(ctx context.Context) (string, error) {
+			runs.Add(1)
+			if release != nil {
+				<-release
+			}
+			return "done", nil
+		},
+	}
+}
+
+// waitIdle 等待任务执行结束，锁在记录执行结果后释放
+func waitIdle(t *testing.T, s *Scheduler, job Job) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		lock, err := s.obtain(context.Background(), job)
+		if err == nil {
+			_ = lock.Release(context.Background())
+			return
+		}
+		if !errors.Is(err, ErrJobRunning) || time.Now().After(deadline) {
+			t.Fatalf("job did not finish: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func lastStatus(t *testing.T, db *ent.Client, name string) string {
+	t.Helper()
+	job, err := db.ScheduledJob.Query().Where(scheduledjob.Name(name)).Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return job.LastStatus
+}
+
+func TestSyncReloadsSpec(t *testing.T) {
+	s, _, configs := newTestScheduler(t)
+	job := Job{Name: "clean", DefaultSpec: "@every 1h", Timeout: time.Minute, Run: func(context.Context) (string, error) { return "", nil }}
+	s.Register(job)
+	s.cron.Start()
+	t.Cleanup(func() { s.cron.Stop() })
+
+	s.sync()
+	if st := s.States()[0]; st.Spec != "@every 1h" || st.NextRun.IsZero() {
+		t.Fatalf("default spec not scheduled: %+v", st)
+	}
+
+	tests := []struct {
+		value, spec string
+		entries     int
+	}{
+		{"*/5 * * * *", "*/5 * * * *", 1},
+		// 无效表达式保留原有调度
+		{"not a spec", "*/5 * * * *", 1},
+		// 配置为空时取消定时执行
+		{"", "", 0},
+		{"@every 2h", "@every 2h", 1},
+	}
+	for _, tt := range tests {
+		configs.Set(SpecKey(job.Name), ConfigurationGroup+"<>"+tt.value)
+		s.sync()
+		st := s.States()[0]
+		if st.Spec != tt.spec || len(s.cron.Entries()) != tt.entries {
+			t.Fatalf("config %q: spec %q with %d entries, want %q with %d", tt.value, st.Spec, len(s.cron.Entries()), tt.spec, tt.entries)
+		}
+		if (tt.entries > 0) == st.NextRun.IsZero() {
+			t.Fatalf("config %q: unexpected next run %v", tt.value, st.NextRun)
+		}
+	}
+}
+
+func TestTriggerLockContention(t *testing.T) {
+	s, db, _ := newTestScheduler(t)
+	var runs atomic.Int32
+	release := make(chan struct{})
+	s.Register(countingJob("clean", &runs, release))
+	ctx := context.Background()
+
+	if err := s.Trigger(ctx, "missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("Trigger(missing) = %v", err)
+	}
+	if err := s.Trigger(ctx, "clean"); err != nil {
+		t.Fatal(err)
+	}
+	// 执行中的任务无法再次触发，定时执行同样跳过
+	if err := s.Trigger(ctx, "clean"); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("Trigger while running = %v, want %v", err, ErrJobRunning)
+	}
+	s.runScheduled(s.entries["clean"].job)
+
+	close(release)
+	waitIdle(t, s, s.entries["clean"].job)
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("job ran %d times, want 1", n)
+	}
+	if status := lastStatus(t, db, "clean"); status != StatusSuccess {
+		t.Fatalf("last status %q", status)
+	}
+
+	// 锁在执行结束后释放
+	if err := s.Trigger(ctx, "clean"); err != nil {
+		t.Fatalf("Trigger after release = %v", err)
+	}
+	waitIdle(t, s, s.entries["clean"].job)
+}
+
+func TestRunScheduledSkipsPausedJob(t *testing.T) {
+	s, db, _ := newTestScheduler(t)
+	ctx := context.Background()
+	var runs atomic.Int32
+	job := countingJob("clean", &runs, nil)
+	s.Register(job)
+	db.ScheduledJob.Create().SetName("clean").SetPaused(true).ExecX(ctx)
+
+	s.runScheduled(job)
+	if n := runs.Load(); n != 0 {
+		t.Fatalf("paused job ran %d times", n)
+	}
+
+	// 恢复后按计划执行
+	db.ScheduledJob.Update().Where(scheduledjob.Name("clean")).SetPaused(false).ExecX(ctx)
+	s.runScheduled(job)
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("resumed job ran %d times, want 1", n)
+	}
+	j := db.ScheduledJob.Query().Where(scheduledjob.Name("clean")).OnlyX(ctx)
+	if j.LastStatus != StatusSuccess || j.LastTrigger != TriggerSchedule || j.LastMessage != "done" {
+		t.Fatalf("unexpected record: %+v", j)
+	}
+}
@@ -0,0 +1,54 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/apikeyservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type ApiKeyServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedApiKeyServiceServer
+}
+
+func NewApiKeyServiceServer(svcCtx *svc.ServiceContext) *ApiKeyServiceServer {
+	return &ApiKeyServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 创建API密钥
+func (s *ApiKeyServiceServer) CreateApiKey(ctx context.Context, in *core.ApiKeyInfo) (*core.CreateApiKeyResponse, error) {
+	l := apikeyservicelogic.NewCreateApiKeyLogic(ctx, s.svcCtx)
+	return l.CreateApiKey(in)
+}
+
+// 更新API密钥，指定用户ID时只能更新该用户的密钥
+func (s *ApiKeyServiceServer) UpdateApiKey(ctx context.Context, in *core.ApiKeyInfo) (*core.ApiKeyInfo, error) {
+	l := apikeyservicelogic.NewUpdateApiKeyLogic(ctx, s.svcCtx)
+	return l.UpdateApiKey(in)
+}
+
+// 删除API密钥
+func (s *ApiKeyServiceServer) DeleteApiKey(ctx context.Context, in *core.ApiKeyDeleteRequest) (*core.BaseResponse, error) {
+	l := apikeyservicelogic.NewDeleteApiKeyLogic(ctx, s.svcCtx)
+	return l.DeleteApiKey(in)
+}
+
+// 获取API密钥列表
+func (s *ApiKeyServiceServer) ListApiKey(ctx context.Context, in *core.ApiKeyListRequest) (*core.ApiKeyListResponse, error) {
+	l := apikeyservicelogic.NewListApiKeyLogic(ctx, s.svcCtx)
+	return l.ListApiKey(in)
+}
+
+// 校验API密钥并记录使用情况
+func (s *ApiKeyServiceServer) AuthenticateApiKey(ctx context.Context, in *core.ApiKeyAuthRequest) (*core.ApiKeyAuthResponse, error) {
+	l := apikeyservicelogic.NewAuthenticateApiKeyLogic(ctx, s.svcCtx)
+	return l.AuthenticateApiKey(in)
+}
@@ -0,0 +1,48 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/authzservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type AuthzServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedAuthzServiceServer
+}
+
+func NewAuthzServiceServer(svcCtx *svc.ServiceContext) *AuthzServiceServer {
+	return &AuthzServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 检查主体是否有权访问资源
+func (s *AuthzServiceServer) Check(ctx context.Context, in *core.AuthzCheckRequest) (*core.AuthzCheckResponse, error) {
+	l := authzservicelogic.NewCheckLogic(ctx, s.svcCtx)
+	return l.Check(in)
+}
+
+// 批量检查主体是否有权访问资源
+func (s *AuthzServiceServer) BatchCheck(ctx context.Context, in *core.AuthzBatchCheckRequest) (*core.AuthzBatchCheckResponse, error) {
+	l := authzservicelogic.NewBatchCheckLogic(ctx, s.svcCtx)
+	return l.BatchCheck(in)
+}
+
+// 获取主体在指定域下允许的资源及操作
+func (s *AuthzServiceServer) ListAllowedActions(ctx context.Context, in *core.AuthzListActionsRequest) (*core.AuthzListActionsResponse, error) {
+	l := authzservicelogic.NewListAllowedActionsLogic(ctx, s.svcCtx)
+	return l.ListAllowedActions(in)
+}
+
+// 获取用户的权限标识
+func (s *AuthzServiceServer) ListPermissionCodes(ctx context.Context, in *core.UUIDRequest) (*core.AuthzPermissionCodeResponse, error) {
+	l := authzservicelogic.NewListPermissionCodesLogic(ctx, s.svcCtx)
+	return l.ListPermissionCodes(in)
+}
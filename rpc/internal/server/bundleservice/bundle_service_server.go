@@ -0,0 +1,36 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/bundleservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type BundleServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedBundleServiceServer
+}
+
+func NewBundleServiceServer(svcCtx *svc.ServiceContext) *BundleServiceServer {
+	return &BundleServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 导出角色、菜单、API、配置项及角色策略
+func (s *BundleServiceServer) ExportBundle(ctx context.Context, in *core.BundleExportRequest) (*core.BundleData, error) {
+	l := bundleservicelogic.NewExportBundleLogic(ctx, s.svcCtx)
+	return l.ExportBundle(in)
+}
+
+// 预览或导入配置包
+func (s *BundleServiceServer) ImportBundle(ctx context.Context, in *core.BundleImportRequest) (*core.BundleImportResponse, error) {
+	l := bundleservicelogic.NewImportBundleLogic(ctx, s.svcCtx)
+	return l.ImportBundle(in)
+}
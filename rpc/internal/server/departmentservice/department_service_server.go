@@ -35,6 +35,18 @@ func (s *DepartmentServiceServer) DeleteDepartment(ctx context.Context, in *core
 	return l.DeleteDepartment(in)
 }
 
+// 批量删除部门，存在子部门或成员的部门跳过
+func (s *DepartmentServiceServer) BatchDeleteDepartment(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := departmentservicelogic.NewBatchDeleteDepartmentLogic(ctx, s.svcCtx)
+	return l.BatchDeleteDepartment(in)
+}
+
+// 批量启用或禁用部门
+func (s *DepartmentServiceServer) BatchUpdateDepartmentState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := departmentservicelogic.NewBatchUpdateDepartmentStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateDepartmentState(in)
+}
+
 // 获取部门
 func (s *DepartmentServiceServer) GetDepartment(ctx context.Context, in *core.ID32Request) (*core.DepartmentInfo, error) {
 	l := departmentservicelogic.NewGetDepartmentLogic(ctx, s.svcCtx)
@@ -46,3 +58,9 @@ func (s *DepartmentServiceServer) ListDepartment(ctx context.Context, in *core.D
 	l := departmentservicelogic.NewListDepartmentLogic(ctx, s.svcCtx)
 	return l.ListDepartment(in)
 }
+
+// 为部门分配角色，部门成员自动获得这些角色
+func (s *DepartmentServiceServer) AssignDepartmentRole(ctx context.Context, in *core.DepartmentRoleRequest) (*core.BaseResponse, error) {
+	l := departmentservicelogic.NewAssignDepartmentRoleLogic(ctx, s.svcCtx)
+	return l.AssignDepartmentRole(in)
+}
@@ -35,6 +35,18 @@ func (s *DictServiceServer) DeleteDict(ctx context.Context, in *core.ID32Request
 	return l.DeleteDict(in)
 }
 
+// 批量删除字典，存在字典项的字典跳过
+func (s *DictServiceServer) BatchDeleteDict(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := dictservicelogic.NewBatchDeleteDictLogic(ctx, s.svcCtx)
+	return l.BatchDeleteDict(in)
+}
+
+// 批量启用或禁用字典
+func (s *DictServiceServer) BatchUpdateDictState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := dictservicelogic.NewBatchUpdateDictStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateDictState(in)
+}
+
 // 获取字典
 func (s *DictServiceServer) GetDict(ctx context.Context, in *core.ID32Request) (*core.DictInfo, error) {
 	l := dictservicelogic.NewGetDictLogic(ctx, s.svcCtx)
@@ -70,3 +82,15 @@ func (s *DictServiceServer) ListDictItem(ctx context.Context, in *core.DictItemL
 	l := dictservicelogic.NewListDictItemLogic(ctx, s.svcCtx)
 	return l.ListDictItem(in)
 }
+
+// 根据编码获取字典及启用的子项
+func (s *DictServiceServer) GetDictByCode(ctx context.Context, in *core.DictCodeRequest) (*core.DictData, error) {
+	l := dictservicelogic.NewGetDictByCodeLogic(ctx, s.svcCtx)
+	return l.GetDictByCode(in)
+}
+
+// 根据编码批量获取字典及启用的子项
+func (s *DictServiceServer) BatchGetDictByCode(ctx context.Context, in *core.DictCodesRequest) (*core.DictDataListResponse, error) {
+	l := dictservicelogic.NewBatchGetDictByCodeLogic(ctx, s.svcCtx)
+	return l.BatchGetDictByCode(in)
+}
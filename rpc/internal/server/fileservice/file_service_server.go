@@ -0,0 +1,54 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/fileservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type FileServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedFileServiceServer
+}
+
+func NewFileServiceServer(svcCtx *svc.ServiceContext) *FileServiceServer {
+	return &FileServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 上传文件
+func (s *FileServiceServer) UploadFile(stream core.FileService_UploadFileServer) error {
+	l := fileservicelogic.NewUploadFileLogic(stream.Context(), s.svcCtx)
+	return l.UploadFile(stream)
+}
+
+// 获取文件信息
+func (s *FileServiceServer) GetFile(ctx context.Context, in *core.UUIDRequest) (*core.FileInfo, error) {
+	l := fileservicelogic.NewGetFileLogic(ctx, s.svcCtx)
+	return l.GetFile(in)
+}
+
+// 下载文件内容
+func (s *FileServiceServer) DownloadFile(in *core.UUIDRequest, stream core.FileService_DownloadFileServer) error {
+	l := fileservicelogic.NewDownloadFileLogic(stream.Context(), s.svcCtx)
+	return l.DownloadFile(in, stream)
+}
+
+// 获取文件列表
+func (s *FileServiceServer) ListFile(ctx context.Context, in *core.FileListRequest) (*core.FileListResponse, error) {
+	l := fileservicelogic.NewListFileLogic(ctx, s.svcCtx)
+	return l.ListFile(in)
+}
+
+// 删除文件及存储的内容
+func (s *FileServiceServer) DeleteFile(ctx context.Context, in *core.UUIDSRequest) (*core.BaseResponse, error) {
+	l := fileservicelogic.NewDeleteFileLogic(ctx, s.svcCtx)
+	return l.DeleteFile(in)
+}
@@ -0,0 +1,42 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/jobservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type JobServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedJobServiceServer
+}
+
+func NewJobServiceServer(svcCtx *svc.ServiceContext) *JobServiceServer {
+	return &JobServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 获取定时任务列表
+func (s *JobServiceServer) ListJob(ctx context.Context, in *core.EmptyRequest) (*core.ScheduledJobListResponse, error) {
+	l := jobservicelogic.NewListJobLogic(ctx, s.svcCtx)
+	return l.ListJob(in)
+}
+
+// 立即执行定时任务
+func (s *JobServiceServer) TriggerJob(ctx context.Context, in *core.StringRequest) (*core.BaseResponse, error) {
+	l := jobservicelogic.NewTriggerJobLogic(ctx, s.svcCtx)
+	return l.TriggerJob(in)
+}
+
+// 暂停或恢复定时任务
+func (s *JobServiceServer) PauseJob(ctx context.Context, in *core.ScheduledJobPauseRequest) (*core.BaseResponse, error) {
+	l := jobservicelogic.NewPauseJobLogic(ctx, s.svcCtx)
+	return l.PauseJob(in)
+}
@@ -58,3 +58,15 @@ func (s *MenuServiceServer) ListPagePermissionByRole(ctx context.Context, in *co
 	l := menuservicelogic.NewListPagePermissionByRoleLogic(ctx, s.svcCtx)
 	return l.ListPagePermissionByRole(in)
 }
+
+// 为菜单绑定API，已分配该菜单的角色同步获得这些API
+func (s *MenuServiceServer) AssignMenuApi(ctx context.Context, in *core.MenuApiRequest) (*core.BaseResponse, error) {
+	l := menuservicelogic.NewAssignMenuApiLogic(ctx, s.svcCtx)
+	return l.AssignMenuApi(in)
+}
+
+// 获取菜单绑定的API
+func (s *MenuServiceServer) GetMenuApi(ctx context.Context, in *core.ID32Request) (*core.MenuApiListResponse, error) {
+	l := menuservicelogic.NewGetMenuApiLogic(ctx, s.svcCtx)
+	return l.GetMenuApi(in)
+}
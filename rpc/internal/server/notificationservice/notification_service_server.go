@@ -0,0 +1,66 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/notificationservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type NotificationServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedNotificationServiceServer
+}
+
+func NewNotificationServiceServer(svcCtx *svc.ServiceContext) *NotificationServiceServer {
+	return &NotificationServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 创建通知
+func (s *NotificationServiceServer) CreateNotification(ctx context.Context, in *core.NotificationInfo) (*core.BaseResponse, error) {
+	l := notificationservicelogic.NewCreateNotificationLogic(ctx, s.svcCtx)
+	return l.CreateNotification(in)
+}
+
+// 更新通知
+func (s *NotificationServiceServer) UpdateNotification(ctx context.Context, in *core.NotificationInfo) (*core.BaseResponse, error) {
+	l := notificationservicelogic.NewUpdateNotificationLogic(ctx, s.svcCtx)
+	return l.UpdateNotification(in)
+}
+
+// 删除通知
+func (s *NotificationServiceServer) DeleteNotification(ctx context.Context, in *core.ID32SRequest) (*core.BaseResponse, error) {
+	l := notificationservicelogic.NewDeleteNotificationLogic(ctx, s.svcCtx)
+	return l.DeleteNotification(in)
+}
+
+// 获取通知列表
+func (s *NotificationServiceServer) ListNotification(ctx context.Context, in *core.NotificationListRequest) (*core.NotificationListResponse, error) {
+	l := notificationservicelogic.NewListNotificationLogic(ctx, s.svcCtx)
+	return l.ListNotification(in)
+}
+
+// 获取用户可见的通知列表
+func (s *NotificationServiceServer) ListUserNotification(ctx context.Context, in *core.UserNotificationListRequest) (*core.NotificationListResponse, error) {
+	l := notificationservicelogic.NewListUserNotificationLogic(ctx, s.svcCtx)
+	return l.ListUserNotification(in)
+}
+
+// 获取用户未读通知数量
+func (s *NotificationServiceServer) CountUnreadNotification(ctx context.Context, in *core.UUIDRequest) (*core.NotificationCountResponse, error) {
+	l := notificationservicelogic.NewCountUnreadNotificationLogic(ctx, s.svcCtx)
+	return l.CountUnreadNotification(in)
+}
+
+// 标记用户通知的阅读状态
+func (s *NotificationServiceServer) MarkNotification(ctx context.Context, in *core.MarkNotificationRequest) (*core.BaseResponse, error) {
+	l := notificationservicelogic.NewMarkNotificationLogic(ctx, s.svcCtx)
+	return l.MarkNotification(in)
+}
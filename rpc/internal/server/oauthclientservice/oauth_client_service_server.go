@@ -0,0 +1,78 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/oauthclientservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type OauthClientServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedOauthClientServiceServer
+}
+
+func NewOauthClientServiceServer(svcCtx *svc.ServiceContext) *OauthClientServiceServer {
+	return &OauthClientServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 创建OAuth客户端
+func (s *OauthClientServiceServer) CreateOauthClient(ctx context.Context, in *core.OauthClientInfo) (*core.OauthClientSecretResponse, error) {
+	l := oauthclientservicelogic.NewCreateOauthClientLogic(ctx, s.svcCtx)
+	return l.CreateOauthClient(in)
+}
+
+// 更新OAuth客户端
+func (s *OauthClientServiceServer) UpdateOauthClient(ctx context.Context, in *core.OauthClientInfo) (*core.OauthClientSecretResponse, error) {
+	l := oauthclientservicelogic.NewUpdateOauthClientLogic(ctx, s.svcCtx)
+	return l.UpdateOauthClient(in)
+}
+
+// 删除OAuth客户端
+func (s *OauthClientServiceServer) DeleteOauthClient(ctx context.Context, in *core.ID32Request) (*core.BaseResponse, error) {
+	l := oauthclientservicelogic.NewDeleteOauthClientLogic(ctx, s.svcCtx)
+	return l.DeleteOauthClient(in)
+}
+
+// 获取OAuth客户端列表
+func (s *OauthClientServiceServer) ListOauthClient(ctx context.Context, in *core.OauthClientListRequest) (*core.OauthClientListResponse, error) {
+	l := oauthclientservicelogic.NewListOauthClientLogic(ctx, s.svcCtx)
+	return l.ListOauthClient(in)
+}
+
+// 认证OAuth客户端
+func (s *OauthClientServiceServer) AuthenticateOauthClient(ctx context.Context, in *core.OauthClientAuthRequest) (*core.OauthClientInfo, error) {
+	l := oauthclientservicelogic.NewAuthenticateOauthClientLogic(ctx, s.svcCtx)
+	return l.AuthenticateOauthClient(in)
+}
+
+// 获取用户对客户端的授权记录
+func (s *OauthClientServiceServer) GetOauthConsent(ctx context.Context, in *core.OauthConsentRequest) (*core.OauthConsentInfo, error) {
+	l := oauthclientservicelogic.NewGetOauthConsentLogic(ctx, s.svcCtx)
+	return l.GetOauthConsent(in)
+}
+
+// 保存用户对客户端的授权记录
+func (s *OauthClientServiceServer) SaveOauthConsent(ctx context.Context, in *core.OauthConsentInfo) (*core.BaseResponse, error) {
+	l := oauthclientservicelogic.NewSaveOauthConsentLogic(ctx, s.svcCtx)
+	return l.SaveOauthConsent(in)
+}
+
+// 获取用户的授权记录列表
+func (s *OauthClientServiceServer) ListOauthConsent(ctx context.Context, in *core.UUIDRequest) (*core.OauthConsentListResponse, error) {
+	l := oauthclientservicelogic.NewListOauthConsentLogic(ctx, s.svcCtx)
+	return l.ListOauthConsent(in)
+}
+
+// 撤销用户对客户端的授权
+func (s *OauthClientServiceServer) DeleteOauthConsent(ctx context.Context, in *core.OauthConsentRequest) (*core.BaseResponse, error) {
+	l := oauthclientservicelogic.NewDeleteOauthConsentLogic(ctx, s.svcCtx)
+	return l.DeleteOauthConsent(in)
+}
@@ -35,6 +35,18 @@ func (s *OauthProviderServiceServer) DeleteOauthProvider(ctx context.Context, in
 	return l.DeleteOauthProvider(in)
 }
 
+// 批量删除第三方登录提供商
+func (s *OauthProviderServiceServer) BatchDeleteOauthProvider(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := oauthproviderservicelogic.NewBatchDeleteOauthProviderLogic(ctx, s.svcCtx)
+	return l.BatchDeleteOauthProvider(in)
+}
+
+// 批量启用或禁用第三方登录提供商
+func (s *OauthProviderServiceServer) BatchUpdateOauthProviderState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := oauthproviderservicelogic.NewBatchUpdateOauthProviderStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateOauthProviderState(in)
+}
+
 // 获取提供商
 func (s *OauthProviderServiceServer) GetOauthProvider(ctx context.Context, in *core.ID32Request) (*core.OauthProviderInfo, error) {
 	l := oauthproviderservicelogic.NewGetOauthProviderLogic(ctx, s.svcCtx)
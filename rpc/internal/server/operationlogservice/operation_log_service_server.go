@@ -0,0 +1,52 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/operationlogservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type OperationLogServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedOperationLogServiceServer
+}
+
+func NewOperationLogServiceServer(svcCtx *svc.ServiceContext) *OperationLogServiceServer {
+	return &OperationLogServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+func (s *OperationLogServiceServer) CreateOperationLog(ctx context.Context, in *core.OperationLogInfo) (*core.BaseResponse, error) {
+	l := operationlogservicelogic.NewCreateOperationLogLogic(ctx, s.svcCtx)
+	return l.CreateOperationLog(in)
+}
+
+func (s *OperationLogServiceServer) ListOperationLog(ctx context.Context, in *core.OperationLogListRequest) (*core.OperationLogListResponse, error) {
+	l := operationlogservicelogic.NewListOperationLogLogic(ctx, s.svcCtx)
+	return l.ListOperationLog(in)
+}
+
+// 按列表条件导出操作记录，忽略分页参数
+func (s *OperationLogServiceServer) ExportOperationLog(in *core.OperationLogListRequest, stream core.OperationLogService_ExportOperationLogServer) error {
+	l := operationlogservicelogic.NewExportOperationLogLogic(stream.Context(), s.svcCtx)
+	return l.ExportOperationLog(in, stream)
+}
+
+// 获取归档列表
+func (s *OperationLogServiceServer) ListOperationLogArchive(ctx context.Context, in *core.OperationLogArchiveListRequest) (*core.OperationLogArchiveListResponse, error) {
+	l := operationlogservicelogic.NewListOperationLogArchiveLogic(ctx, s.svcCtx)
+	return l.ListOperationLogArchive(in)
+}
+
+// 将归档记录恢复到操作记录表
+func (s *OperationLogServiceServer) RestoreOperationLogArchive(ctx context.Context, in *core.ID32Request) (*core.BaseResponse, error) {
+	l := operationlogservicelogic.NewRestoreOperationLogArchiveLogic(ctx, s.svcCtx)
+	return l.RestoreOperationLogArchive(in)
+}
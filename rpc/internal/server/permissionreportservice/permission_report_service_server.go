@@ -0,0 +1,60 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/permissionreportservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type PermissionReportServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedPermissionReportServiceServer
+}
+
+func NewPermissionReportServiceServer(svcCtx *svc.ServiceContext) *PermissionReportServiceServer {
+	return &PermissionReportServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 获取角色的全部权限及持有用户
+func (s *PermissionReportServiceServer) GetRolePermissionReport(ctx context.Context, in *core.ID32Request) (*core.RolePermissionReport, error) {
+	l := permissionreportservicelogic.NewGetRolePermissionReportLogic(ctx, s.svcCtx)
+	return l.GetRolePermissionReport(in)
+}
+
+// 获取持有接口、菜单或配置项权限的角色及用户
+func (s *PermissionReportServiceServer) GetPermissionHolders(ctx context.Context, in *core.PermissionHolderRequest) (*core.PermissionHolderResponse, error) {
+	l := permissionreportservicelogic.NewGetPermissionHoldersLogic(ctx, s.svcCtx)
+	return l.GetPermissionHolders(in)
+}
+
+// 创建当前权限的快照
+func (s *PermissionReportServiceServer) CreatePermissionSnapshot(ctx context.Context, in *core.PermissionSnapshotInfo) (*core.PermissionSnapshotInfo, error) {
+	l := permissionreportservicelogic.NewCreatePermissionSnapshotLogic(ctx, s.svcCtx)
+	return l.CreatePermissionSnapshot(in)
+}
+
+// 获取权限快照列表
+func (s *PermissionReportServiceServer) GetPermissionSnapshotList(ctx context.Context, in *core.PermissionSnapshotListRequest) (*core.PermissionSnapshotListResponse, error) {
+	l := permissionreportservicelogic.NewGetPermissionSnapshotListLogic(ctx, s.svcCtx)
+	return l.GetPermissionSnapshotList(in)
+}
+
+// 删除权限快照
+func (s *PermissionReportServiceServer) DeletePermissionSnapshot(ctx context.Context, in *core.ID32SRequest) (*core.BaseResponse, error) {
+	l := permissionreportservicelogic.NewDeletePermissionSnapshotLogic(ctx, s.svcCtx)
+	return l.DeletePermissionSnapshot(in)
+}
+
+// 对比两个权限快照，或快照与当前权限的差异
+func (s *PermissionReportServiceServer) DiffPermissionSnapshot(ctx context.Context, in *core.PermissionSnapshotDiffRequest) (*core.PermissionSnapshotDiffResponse, error) {
+	l := permissionreportservicelogic.NewDiffPermissionSnapshotLogic(ctx, s.svcCtx)
+	return l.DiffPermissionSnapshot(in)
+}
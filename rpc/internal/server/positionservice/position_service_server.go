@@ -35,6 +35,18 @@ func (s *PositionServiceServer) DeletePosition(ctx context.Context, in *core.ID3
 	return l.DeletePosition(in)
 }
 
+// 批量删除岗位，仍有成员的岗位跳过
+func (s *PositionServiceServer) BatchDeletePosition(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := positionservicelogic.NewBatchDeletePositionLogic(ctx, s.svcCtx)
+	return l.BatchDeletePosition(in)
+}
+
+// 批量启用或禁用岗位
+func (s *PositionServiceServer) BatchUpdatePositionState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := positionservicelogic.NewBatchUpdatePositionStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdatePositionState(in)
+}
+
 // 获取岗位
 func (s *PositionServiceServer) GetPosition(ctx context.Context, in *core.ID32Request) (*core.PositionInfo, error) {
 	l := positionservicelogic.NewGetPositionLogic(ctx, s.svcCtx)
@@ -46,3 +58,9 @@ func (s *PositionServiceServer) ListPosition(ctx context.Context, in *core.Posit
 	l := positionservicelogic.NewListPositionLogic(ctx, s.svcCtx)
 	return l.ListPosition(in)
 }
+
+// 为岗位分配角色，任职用户自动获得这些角色
+func (s *PositionServiceServer) AssignPositionRole(ctx context.Context, in *core.PositionRoleRequest) (*core.BaseResponse, error) {
+	l := positionservicelogic.NewAssignPositionRoleLogic(ctx, s.svcCtx)
+	return l.AssignPositionRole(in)
+}
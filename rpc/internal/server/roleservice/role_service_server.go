@@ -35,6 +35,18 @@ func (s *RoleServiceServer) DeleteRole(ctx context.Context, in *core.ID32Request
 	return l.DeleteRole(in)
 }
 
+// 批量删除角色，系统保留角色及仍有用户的角色跳过
+func (s *RoleServiceServer) BatchDeleteRole(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := roleservicelogic.NewBatchDeleteRoleLogic(ctx, s.svcCtx)
+	return l.BatchDeleteRole(in)
+}
+
+// 批量启用或禁用角色，系统保留角色跳过
+func (s *RoleServiceServer) BatchUpdateRoleState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := roleservicelogic.NewBatchUpdateRoleStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateRoleState(in)
+}
+
 // 获取角色
 func (s *RoleServiceServer) GetRole(ctx context.Context, in *core.ID32Request) (*core.RoleInfo, error) {
 	l := roleservicelogic.NewGetRoleLogic(ctx, s.svcCtx)
@@ -59,7 +71,7 @@ func (s *RoleServiceServer) AssignMenu(ctx context.Context, in *core.RoleMenuReq
 	return l.AssignMenu(in)
 }
 
-// 为角色分配API
+// 为角色分配API，已分配菜单依赖的API始终保留
 func (s *RoleServiceServer) AssignApi(ctx context.Context, in *core.RoleApiRequest) (*core.BaseResponse, error) {
 	l := roleservicelogic.NewAssignApiLogic(ctx, s.svcCtx)
 	return l.AssignApi(in)
@@ -82,3 +94,15 @@ func (s *RoleServiceServer) GetConfigurationGroup(ctx context.Context, in *core.
 	l := roleservicelogic.NewGetConfigurationGroupLogic(ctx, s.svcCtx)
 	return l.GetConfigurationGroup(in)
 }
+
+// 检查角色接口授权与菜单的一致性
+func (s *RoleServiceServer) CheckRoleApiConsistency(ctx context.Context, in *core.RoleApiConsistencyRequest) (*core.RoleApiConsistencyResponse, error) {
+	l := roleservicelogic.NewCheckRoleApiConsistencyLogic(ctx, s.svcCtx)
+	return l.CheckRoleApiConsistency(in)
+}
+
+// 解释用户或角色对接口、配置项的访问决策，支持模拟角色变更
+func (s *RoleServiceServer) ExplainPermission(ctx context.Context, in *core.PermissionExplainRequest) (*core.PermissionExplainResponse, error) {
+	l := roleservicelogic.NewExplainPermissionLogic(ctx, s.svcCtx)
+	return l.ExplainPermission(in)
+}
@@ -0,0 +1,36 @@
+// Code generated by goctl. DO NOT EDIT.
+// goctl 1.9.0
+// Source: core.proto
+
+package server
+
+import (
+	"context"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/logic/securityeventservice"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+type SecurityEventServiceServer struct {
+	svcCtx *svc.ServiceContext
+	core.UnimplementedSecurityEventServiceServer
+}
+
+func NewSecurityEventServiceServer(svcCtx *svc.ServiceContext) *SecurityEventServiceServer {
+	return &SecurityEventServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// 记录安全事件，登录成功时同时更新用户最后登录信息
+func (s *SecurityEventServiceServer) CreateSecurityEvent(ctx context.Context, in *core.SecurityEventInfo) (*core.BaseResponse, error) {
+	l := securityeventservicelogic.NewCreateSecurityEventLogic(ctx, s.svcCtx)
+	return l.CreateSecurityEvent(in)
+}
+
+// 获取安全事件列表
+func (s *SecurityEventServiceServer) ListSecurityEvent(ctx context.Context, in *core.SecurityEventListRequest) (*core.SecurityEventListResponse, error) {
+	l := securityeventservicelogic.NewListSecurityEventLogic(ctx, s.svcCtx)
+	return l.ListSecurityEvent(in)
+}
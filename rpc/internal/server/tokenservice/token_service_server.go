@@ -47,6 +47,18 @@ func (s *TokenServiceServer) DeleteToken(ctx context.Context, in *core.ID32Reque
 	return l.DeleteToken(in)
 }
 
+// 批量删除令牌，删除的令牌同时加入黑名单
+func (s *TokenServiceServer) BatchDeleteToken(ctx context.Context, in *core.ID32SRequest) (*core.BatchResponse, error) {
+	l := tokenservicelogic.NewBatchDeleteTokenLogic(ctx, s.svcCtx)
+	return l.BatchDeleteToken(in)
+}
+
+// 批量启用或禁用令牌，同步维护黑名单
+func (s *TokenServiceServer) BatchUpdateTokenState(ctx context.Context, in *core.BatchStateID32Request) (*core.BatchResponse, error) {
+	l := tokenservicelogic.NewBatchUpdateTokenStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateTokenState(in)
+}
+
 // 获取Token列表
 func (s *TokenServiceServer) ListToken(ctx context.Context, in *core.TokenListRequest) (*core.TokenListResponse, error) {
 	l := tokenservicelogic.NewListTokenLogic(ctx, s.svcCtx)
@@ -64,3 +76,9 @@ func (s *TokenServiceServer) UpdateTokenLastUsed(ctx context.Context, in *core.U
 	l := tokenservicelogic.NewUpdateTokenLastUsedLogic(ctx, s.svcCtx)
 	return l.UpdateTokenLastUsed(in)
 }
+
+// 撤销用户的Token，同时写入黑名单
+func (s *TokenServiceServer) RevokeUserTokens(ctx context.Context, in *core.RevokeUserTokensRequest) (*core.RevokeUserTokensResponse, error) {
+	l := tokenservicelogic.NewRevokeUserTokensLogic(ctx, s.svcCtx)
+	return l.RevokeUserTokens(in)
+}
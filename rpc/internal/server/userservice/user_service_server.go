@@ -41,6 +41,18 @@ func (s *UserServiceServer) DeleteUser(ctx context.Context, in *core.UUIDRequest
 	return l.DeleteUser(in)
 }
 
+// 批量删除用户，系统保留用户跳过
+func (s *UserServiceServer) BatchDeleteUser(ctx context.Context, in *core.UUIDSRequest) (*core.BatchResponse, error) {
+	l := userservicelogic.NewBatchDeleteUserLogic(ctx, s.svcCtx)
+	return l.BatchDeleteUser(in)
+}
+
+// 批量启用或禁用用户，系统保留用户跳过
+func (s *UserServiceServer) BatchUpdateUserState(ctx context.Context, in *core.BatchStateUUIDRequest) (*core.BatchResponse, error) {
+	l := userservicelogic.NewBatchUpdateUserStateLogic(ctx, s.svcCtx)
+	return l.BatchUpdateUserState(in)
+}
+
 // 获取用户
 func (s *UserServiceServer) GetUser(ctx context.Context, in *core.UUIDRequest) (*core.UserInfo, error) {
 	l := userservicelogic.NewGetUserLogic(ctx, s.svcCtx)
@@ -59,6 +71,12 @@ func (s *UserServiceServer) GetUserByUsername(ctx context.Context, in *core.Stri
 	return l.GetUserByUsername(in)
 }
 
+// 获取用户有效角色及来源
+func (s *UserServiceServer) GetEffectiveRoles(ctx context.Context, in *core.UUIDRequest) (*core.EffectiveRoleResponse, error) {
+	l := userservicelogic.NewGetEffectiveRolesLogic(ctx, s.svcCtx)
+	return l.GetEffectiveRoles(in)
+}
+
 // TOTP相关接口
 func (s *UserServiceServer) EnableTotp(ctx context.Context, in *core.EnableTotpRequest) (*core.TotpSetupResponse, error) {
 	l := userservicelogic.NewEnableTotpLogic(ctx, s.svcCtx)
@@ -100,3 +118,75 @@ func (s *UserServiceServer) UseBackupCode(ctx context.Context, in *core.UseBacku
 	l := userservicelogic.NewUseBackupCodeLogic(ctx, s.svcCtx)
 	return l.UseBackupCode(in)
 }
+
+// WebAuthn相关接口
+func (s *UserServiceServer) BeginWebauthnRegistration(ctx context.Context, in *core.WebauthnBeginRegistrationRequest) (*core.WebauthnBeginResponse, error) {
+	l := userservicelogic.NewBeginWebauthnRegistrationLogic(ctx, s.svcCtx)
+	return l.BeginWebauthnRegistration(in)
+}
+
+// 完成注册WebAuthn凭证
+func (s *UserServiceServer) FinishWebauthnRegistration(ctx context.Context, in *core.WebauthnFinishRegistrationRequest) (*core.WebauthnCredentialInfo, error) {
+	l := userservicelogic.NewFinishWebauthnRegistrationLogic(ctx, s.svcCtx)
+	return l.FinishWebauthnRegistration(in)
+}
+
+// 开始WebAuthn登录
+func (s *UserServiceServer) BeginWebauthnLogin(ctx context.Context, in *core.WebauthnBeginLoginRequest) (*core.WebauthnBeginResponse, error) {
+	l := userservicelogic.NewBeginWebauthnLoginLogic(ctx, s.svcCtx)
+	return l.BeginWebauthnLogin(in)
+}
+
+// 完成WebAuthn登录
+func (s *UserServiceServer) FinishWebauthnLogin(ctx context.Context, in *core.WebauthnFinishLoginRequest) (*core.UserInfo, error) {
+	l := userservicelogic.NewFinishWebauthnLoginLogic(ctx, s.svcCtx)
+	return l.FinishWebauthnLogin(in)
+}
+
+// 获取用户WebAuthn凭证列表
+func (s *UserServiceServer) ListWebauthnCredential(ctx context.Context, in *core.UUIDRequest) (*core.WebauthnCredentialListResponse, error) {
+	l := userservicelogic.NewListWebauthnCredentialLogic(ctx, s.svcCtx)
+	return l.ListWebauthnCredential(in)
+}
+
+// 修改WebAuthn凭证
+func (s *UserServiceServer) UpdateWebauthnCredential(ctx context.Context, in *core.WebauthnUpdateCredentialRequest) (*core.WebauthnCredentialInfo, error) {
+	l := userservicelogic.NewUpdateWebauthnCredentialLogic(ctx, s.svcCtx)
+	return l.UpdateWebauthnCredential(in)
+}
+
+// 删除WebAuthn凭证
+func (s *UserServiceServer) DeleteWebauthnCredential(ctx context.Context, in *core.WebauthnDeleteCredentialRequest) (*core.BaseResponse, error) {
+	l := userservicelogic.NewDeleteWebauthnCredentialLogic(ctx, s.svcCtx)
+	return l.DeleteWebauthnCredential(in)
+}
+
+// 修改密码
+func (s *UserServiceServer) ChangePassword(ctx context.Context, in *core.ChangePasswordRequest) (*core.BaseResponse, error) {
+	l := userservicelogic.NewChangePasswordLogic(ctx, s.svcCtx)
+	return l.ChangePassword(in)
+}
+
+// 批量导入用户
+func (s *UserServiceServer) ImportUser(ctx context.Context, in *core.UserImportRequest) (*core.UserImportResponse, error) {
+	l := userservicelogic.NewImportUserLogic(ctx, s.svcCtx)
+	return l.ImportUser(in)
+}
+
+// 导出用户，筛选条件与 ListUser 一致，忽略分页
+func (s *UserServiceServer) ExportUser(ctx context.Context, in *core.UserListRequest) (*core.UserExportResponse, error) {
+	l := userservicelogic.NewExportUserLogic(ctx, s.svcCtx)
+	return l.ExportUser(in)
+}
+
+// 开始模拟登录，校验目标用户并记录审计日志
+func (s *UserServiceServer) StartImpersonation(ctx context.Context, in *core.ImpersonationRequest) (*core.UserInfo, error) {
+	l := userservicelogic.NewStartImpersonationLogic(ctx, s.svcCtx)
+	return l.StartImpersonation(in)
+}
+
+// 结束模拟登录，记录审计日志
+func (s *UserServiceServer) StopImpersonation(ctx context.Context, in *core.ImpersonationRequest) (*core.BaseResponse, error) {
+	l := userservicelogic.NewStopImpersonationLogic(ctx, s.svcCtx)
+	return l.StopImpersonation(in)
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Put(ctx, "a/b.txt", strings.NewReader("hello"), 5); err != nil {
+		t.Fatal(err)
+	}
+	r, err := s.Get(ctx, "a/b.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "hello" {
+		t.Fatalf("got %q", data)
+	}
+
+	if err = s.Delete(ctx, "a/b.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Get(ctx, "a/b.txt"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err = s.Delete(ctx, "a/b.txt"); err != nil {
+		t.Fatalf("delete missing object: %v", err)
+	}
+}
+
+func TestLocalRejectsEscapingKey(t *testing.T) {
+	s, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"../x", "a/../../x", ""} {
+		if err = s.Put(context.Background(), key, strings.NewReader("x"), 1); err == nil {
+			t.Fatalf("expected error for key %q", key)
+		}
+	}
+}
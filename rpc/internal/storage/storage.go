@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/wenpiner/last-admin-core/rpc/internal/config"
+)
+
+// ErrNotFound 对象不存在
+var ErrNotFound = errors.New("storage: object not found")
+
+// Storage 对象存储，key 使用 / 分隔的相对路径
+type Storage interface {
+	// Put 写入对象，已存在时覆盖，size 为 -1 表示大小未知
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get 读取对象，调用方负责关闭
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，对象不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+}
+
+// Factory 根据配置创建存储驱动
+type Factory func(c config.StorageConf) (Storage, error)
+
+var (
+	mu      sync.RWMutex
+	drivers = map[string]Factory{
+		"local": func(c config.StorageConf) (Storage, error) {
+			return NewLocal(c.Path)
+		},
+		"s3": func(c config.StorageConf) (Storage, error) {
+			return NewS3(c)
+		},
+	}
+)
+
+// Register 注册存储驱动，同名驱动将被覆盖
+func Register(driver string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	drivers[driver] = factory
+}
+
+// New 根据配置的驱动创建存储
+func New(c config.StorageConf) (Storage, error) {
+	mu.RLock()
+	factory, ok := drivers[c.Driver]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("storage: unknown driver %q", c.Driver)
+	}
+	return factory(c)
+}
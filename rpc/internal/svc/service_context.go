@@ -2,12 +2,17 @@ package svc
 
 import (
 	"context"
+	"time"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/redis/go-redis/v9"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
 	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
 	"github.com/wenpiner/last-admin-core/rpc/internal/config"
+	"github.com/wenpiner/last-admin-core/rpc/internal/scheduler"
+	"github.com/wenpiner/last-admin-core/rpc/internal/storage"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -19,6 +24,10 @@ type ServiceContext struct {
 	ConfigurationCache  *cache.ConfigurationCache
 	CacheRefreshService *cache.CacheRefreshService
 	ConfigValidator     *cache.ConfigValidator
+	Webauthn            *webauthnutils.Ceremony
+	Scheduler           *scheduler.Scheduler
+	ArchiveStorage      storage.Storage
+	FileStorage         storage.Storage
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -42,13 +51,41 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		logx.Errorw("初始化 ConfigValidator 失败", logx.Field("detail", err.Error()))
 	}
 
+	rds := c.RedisConf.NewMustUniversalRedis()
+
+	// 初始化 WebAuthn
+	timeout := time.Duration(c.WebauthnConf.Timeout) * time.Second
+	displayName := c.WebauthnConf.RPDisplayName
+	if displayName == "" {
+		displayName = c.Name
+	}
+	ceremony, err := webauthnutils.NewCeremony(&webauthn.Config{
+		RPID:          c.WebauthnConf.RPID,
+		RPDisplayName: displayName,
+		RPOrigins:     c.WebauthnConf.RPOrigins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+			Registration: webauthn.TimeoutConfig{Enforce: true, Timeout: timeout, TimeoutUVD: timeout},
+		},
+	}, webauthnutils.NewRedisSessionStore(rds))
+	logx.Must(err)
+
+	archiveStorage, err := storage.New(c.ArchiveStorage)
+	logx.Must(err)
+	fileStorage, err := storage.New(c.FileStorage)
+	logx.Must(err)
+
 	return &ServiceContext{
 		Config:              c,
 		DBEnt:               dbEnt,
-		Redis:               c.RedisConf.NewMustUniversalRedis(),
+		Redis:               rds,
 		Casbin:              casbin,
 		ConfigurationCache:  configCache,
 		CacheRefreshService: cacheRefreshService,
 		ConfigValidator:     configValidator,
+		Webauthn:            ceremony,
+		Scheduler:           scheduler.New(dbEnt, rds, configCache),
+		ArchiveStorage:      archiveStorage,
+		FileStorage:         fileStorage,
 	}
 }
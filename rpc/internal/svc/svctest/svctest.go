@@ -0,0 +1,34 @@
+// Package svctest 为逻辑层测试提供基于内存 SQLite 及 miniredis 的 ServiceContext
+package svctest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/wenpiner/last-admin-core/rpc/ent/enttest"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+	"github.com/wenpiner/last-admin-core/rpc/internal/svc"
+)
+
+// New 创建测试用的 ServiceContext，数据库及 Redis 在测试结束时释放
+func New(t *testing.T) (*svc.ServiceContext, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	rds := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rds.Close() })
+
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", name))
+	t.Cleanup(func() { _ = db.Close() })
+
+	return &svc.ServiceContext{
+		DBEnt:              db,
+		Redis:              rds,
+		ConfigurationCache: cache.NewConfigurationCache(),
+	}, mr
+}
@@ -0,0 +1,83 @@
+package apikeyutils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"strings"
+)
+
+const (
+	// KeyPrefix API密钥统一前缀，便于识别与密钥扫描
+	KeyPrefix = "lak_"
+	// displayPrefixLen 展示用前缀长度
+	displayPrefixLen = len(KeyPrefix) + 8
+)
+
+// GenerateKey 生成随机API密钥，返回明文、展示前缀及哈希
+func GenerateKey() (key, prefix, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	key = KeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return key, key[:displayPrefixLen], HashKey(key), nil
+}
+
+// HashKey 计算密钥哈希，数据库仅保存哈希
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeIPs 校验并规范化IP或CIDR列表，去除空白和重复项
+func NormalizeIPs(ips []string) ([]string, bool) {
+	result := make([]string, 0, len(ips))
+	seen := make(map[string]struct{}, len(ips))
+	for _, ip := range ips {
+		ip = strings.TrimSpace(ip)
+		if ip == "" {
+			continue
+		}
+		if strings.Contains(ip, "/") {
+			_, network, err := net.ParseCIDR(ip)
+			if err != nil {
+				return nil, false
+			}
+			ip = network.String()
+		} else if parsed := net.ParseIP(ip); parsed != nil {
+			ip = parsed.String()
+		} else {
+			return nil, false
+		}
+		if _, ok := seen[ip]; ok {
+			continue
+		}
+		seen[ip] = struct{}{}
+		result = append(result, ip)
+	}
+	return result, true
+}
+
+// IPAllowed 判断IP是否在允许列表中，列表为空时不限制
+func IPAllowed(ip string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return false
+	}
+	for _, item := range allowed {
+		if strings.Contains(item, "/") {
+			if _, network, err := net.ParseCIDR(item); err == nil && network.Contains(parsed) {
+				return true
+			}
+		} else if other := net.ParseIP(item); other != nil && other.Equal(parsed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package apikeyutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateKey(t *testing.T) {
+	key, prefix, hash, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, KeyPrefix) || !strings.HasPrefix(key, prefix) || len(prefix) != displayPrefixLen {
+		t.Fatalf("unexpected key %q prefix %q", key, prefix)
+	}
+	if hash != HashKey(key) || len(hash) != 64 {
+		t.Fatalf("unexpected hash %q", hash)
+	}
+	other, _, _, _ := GenerateKey()
+	if other == key {
+		t.Fatal("expected unique keys")
+	}
+}
+
+func TestNormalizeIPs(t *testing.T) {
+	ips, ok := NormalizeIPs([]string{" 10.0.0.1 ", "10.0.0.0/8", "10.1.2.3/8", "", "10.0.0.1"})
+	if !ok || strings.Join(ips, ",") != "10.0.0.1,10.0.0.0/8" {
+		t.Fatalf("got %v %v", ips, ok)
+	}
+	if _, ok = NormalizeIPs([]string{"example.com"}); ok {
+		t.Fatal("expected invalid ip")
+	}
+}
+
+func TestIPAllowed(t *testing.T) {
+	allowed := []string{"192.168.1.10", "10.0.0.0/8"}
+	cases := map[string]bool{
+		"192.168.1.10": true,
+		"10.20.30.40":  true,
+		"192.168.1.11": false,
+		"bad":          false,
+	}
+	for ip, want := range cases {
+		if got := IPAllowed(ip, allowed); got != want {
+			t.Errorf("IPAllowed(%q) = %v, want %v", ip, got, want)
+		}
+	}
+	if !IPAllowed("1.2.3.4", nil) {
+		t.Error("empty allow list should not restrict")
+	}
+}
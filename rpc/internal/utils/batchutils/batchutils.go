@@ -0,0 +1,120 @@
+package batchutils
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// MaxSize 单次批量操作的最大ID数量
+const MaxSize = 500
+
+// Result 记录批量操作中每个ID的执行结果，未标记失败的ID视为成功
+type Result[K comparable] struct {
+	ids    []K
+	index  map[K]struct{}
+	failed map[K]string
+}
+
+// NewResult 去重后创建结果集，ID数量需在 1 到 MaxSize 之间
+func NewResult[K comparable](ids []K) (*Result[K], error) {
+	r := &Result[K]{
+		index:  make(map[K]struct{}, len(ids)),
+		failed: make(map[K]string),
+	}
+	for _, id := range ids {
+		if _, ok := r.index[id]; ok {
+			continue
+		}
+		r.index[id] = struct{}{}
+		r.ids = append(r.ids, id)
+	}
+	if len(r.ids) == 0 || len(r.ids) > MaxSize {
+		return nil, errorx.NewInvalidArgumentError("common.batchLimit")
+	}
+	return r, nil
+}
+
+// ParseUUIDs 解析批量操作中的 UUID
+func ParseUUIDs(ids []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil {
+			return nil, errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+		}
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// IDs 返回去重后的全部ID
+func (r *Result[K]) IDs() []K {
+	return r.ids
+}
+
+// Fail 标记ID失败，同一ID只保留首个失败原因
+func (r *Result[K]) Fail(id K, message string) {
+	if _, ok := r.failed[id]; !ok {
+		r.failed[id] = message
+	}
+}
+
+// FailMissing 将不在 found 中的ID标记为不存在
+func (r *Result[K]) FailMissing(found []K) {
+	exists := make(map[K]struct{}, len(found))
+	for _, id := range found {
+		exists[id] = struct{}{}
+	}
+	for _, id := range r.ids {
+		if _, ok := exists[id]; !ok {
+			r.Fail(id, last_i18n.TargetNotExist)
+		}
+	}
+}
+
+// Failed 判断ID是否已标记失败
+func (r *Result[K]) Failed(id K) bool {
+	_, ok := r.failed[id]
+	return ok
+}
+
+// Passed 判断ID属于本批次且尚未失败
+func (r *Result[K]) Passed(id K) bool {
+	_, ok := r.index[id]
+	return ok && !r.Failed(id)
+}
+
+// Pending 返回尚未失败、需要执行的ID
+func (r *Result[K]) Pending() []K {
+	pending := make([]K, 0, len(r.ids))
+	for _, id := range r.ids {
+		if !r.Failed(id) {
+			pending = append(pending, id)
+		}
+	}
+	return pending
+}
+
+// Response 转换为 RPC 批量操作结果，顺序与请求一致
+func (r *Result[K]) Response() *core.BatchResponse {
+	resp := &core.BatchResponse{
+		Total:   uint32(len(r.ids)),
+		Results: make([]*core.BatchItemResult, 0, len(r.ids)),
+	}
+	for _, id := range r.ids {
+		item := &core.BatchItemResult{Id: fmt.Sprint(id), Success: true}
+		if message, ok := r.failed[id]; ok {
+			item.Success = false
+			item.Message = &message
+			resp.Failed++
+		} else {
+			resp.Success++
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp
+}
@@ -0,0 +1,58 @@
+package batchutils
+
+import (
+	"testing"
+
+	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
+)
+
+func TestResult(t *testing.T) {
+	if _, err := NewResult([]uint32{}); err == nil {
+		t.Fatal("expected error for empty ids")
+	}
+	if _, err := NewResult(make([]uint32, MaxSize+1)); err != nil {
+		t.Fatalf("duplicated ids should be merged: %v", err)
+	}
+	tooMany := make([]uint32, 0, MaxSize+1)
+	for i := range MaxSize + 1 {
+		tooMany = append(tooMany, uint32(i+1))
+	}
+	if _, err := NewResult(tooMany); err == nil {
+		t.Fatal("expected error for too many ids")
+	}
+
+	r, err := NewResult([]uint32{3, 1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.FailMissing([]uint32{1, 3})
+	r.Fail(3, "role.reserved")
+	r.Fail(3, "role.hasMembers")
+	if !r.Passed(1) || r.Passed(2) || r.Passed(3) || r.Passed(4) {
+		t.Fatal("unexpected passed state")
+	}
+	if pending := r.Pending(); len(pending) != 1 || pending[0] != 1 {
+		t.Fatalf("unexpected pending ids: %v", pending)
+	}
+
+	resp := r.Response()
+	if resp.Total != 3 || resp.Success != 1 || resp.Failed != 2 {
+		t.Fatalf("unexpected counts: %+v", resp)
+	}
+	if resp.Results[0].Id != "3" || resp.Results[0].GetMessage() != "role.reserved" {
+		t.Fatalf("unexpected first result: %+v", resp.Results[0])
+	}
+	if resp.Results[2].Id != "2" || resp.Results[2].GetMessage() != last_i18n.TargetNotExist {
+		t.Fatalf("unexpected missing result: %+v", resp.Results[2])
+	}
+}
+
+func TestParseUUIDs(t *testing.T) {
+	if _, err := ParseUUIDs([]string{"not-a-uuid"}); err == nil {
+		t.Fatal("expected error for invalid uuid")
+	}
+	ids, err := ParseUUIDs([]string{"0b6c1a8e-5d0c-4f5a-9d8e-0b6c1a8e5d0c"})
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("unexpected result: %v %v", ids, err)
+	}
+}
@@ -0,0 +1,320 @@
+package bundleutils
+
+import (
+	"context"
+	"errors"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+)
+
+// Apply 在事务中写入计划中新增及更新的项，并替换这些角色的 Casbin 策略
+// 菜单绑定的API变化时，同步持有该菜单但不在配置包中的角色的接口策略
+// Casbin 无法参与数据库事务，写入失败或事务提交失败时恢复涉及角色原有的策略
+func Apply(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, p *Plan) (err error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	backup := make(policyutils.Backup)
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, backup.Restore(cbn))
+		}
+	}()
+
+	apiIDs, err := p.applyApis(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	// 在菜单绑定的API变化前，将受影响角色中非菜单派生的接口策略记为手动分配
+	affected, err := p.affectedRoles(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, r := range affected {
+		if err = policyutils.AdoptManual(ctx, tx.Client(), cbn, r); err != nil {
+			return err
+		}
+	}
+
+	menuIDs, err := p.applyMenus(ctx, tx, apiIDs)
+	if err != nil {
+		return err
+	}
+	written, err := p.applyRoles(ctx, tx, apiIDs, menuIDs)
+	if err != nil {
+		return err
+	}
+	if err = p.applyConfigurations(ctx, tx); err != nil {
+		return err
+	}
+
+	for _, r := range written {
+		if err = backup.Save(cbn, r.Code); err != nil {
+			return err
+		}
+		if _, err = cbn.RemoveFilteredPolicy(0, r.Code); err != nil {
+			return err
+		}
+		if len(r.Policies) == 0 {
+			continue
+		}
+		rows := make([][]string, 0, len(r.Policies))
+		for _, item := range r.Policies {
+			rows = append(rows, policyRow(r.Code, item))
+		}
+		if _, err = cbn.AddPolicies(rows); err != nil {
+			return err
+		}
+	}
+	for _, r := range affected {
+		if err = backup.Save(cbn, r.RoleCode); err != nil {
+			return err
+		}
+		if err = policyutils.Sync(ctx, tx.Client(), cbn, r); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *Plan) writes(kind, key string) bool {
+	action := p.Action(kind, key)
+	return action == ActionCreate || action == ActionUpdate
+}
+
+func (p *Plan) applyApis(ctx context.Context, tx *ent.Tx) (map[string]uint32, error) {
+	ids := make(map[string]uint32, len(p.apis)+len(p.Bundle.Apis))
+	for key, a := range p.apis {
+		ids[key] = a.ID
+	}
+	for _, a := range p.Bundle.Apis {
+		key := ApiKey(a.Path, a.Method)
+		if !p.writes(KindApi, key) {
+			continue
+		}
+		if old, ok := p.apis[key]; ok {
+			upd := tx.API.UpdateOneID(old.ID)
+			setApi(upd.Mutation(), a)
+			if err := upd.Exec(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		c := tx.API.Create()
+		setApi(c.Mutation(), a)
+		created, err := c.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids[key] = created.ID
+	}
+	return ids, nil
+}
+
+// applyMenus 先写入菜单字段及绑定的API，待全部菜单存在后再按编码设置父菜单
+func (p *Plan) applyMenus(ctx context.Context, tx *ent.Tx, apiIDs map[string]uint32) (map[string]uint32, error) {
+	ids := make(map[string]uint32, len(p.menus)+len(p.Bundle.Menus))
+	for code, m := range p.menus {
+		ids[code] = m.ID
+	}
+	for _, m := range p.Bundle.Menus {
+		if !p.writes(KindMenu, m.Code) {
+			continue
+		}
+		refs := refIDs(apiIDs, m.Apis)
+		if old, ok := p.menus[m.Code]; ok {
+			upd := tx.Menu.UpdateOneID(old.ID).ClearApis().AddAPIIDs(refs...)
+			setMenu(upd.Mutation(), m)
+			if err := upd.Exec(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		c := tx.Menu.Create().AddAPIIDs(refs...)
+		setMenu(c.Mutation(), m)
+		created, err := c.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids[m.Code] = created.ID
+	}
+
+	for _, m := range p.Bundle.Menus {
+		if !p.writes(KindMenu, m.Code) {
+			continue
+		}
+		upd := tx.Menu.UpdateOneID(ids[m.Code])
+		if m.ParentCode == "" {
+			upd.ClearParentID()
+		} else {
+			upd.SetParentID(ids[m.ParentCode])
+		}
+		if err := upd.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// applyRoles 写入角色及其菜单、手动分配的API，返回需要替换策略的角色
+func (p *Plan) applyRoles(ctx context.Context, tx *ent.Tx, apiIDs, menuIDs map[string]uint32) ([]Role, error) {
+	var written []Role
+	for _, r := range p.Bundle.Roles {
+		if !p.writes(KindRole, r.Code) {
+			continue
+		}
+		menus := make([]uint32, 0, len(r.Menus))
+		for _, code := range r.Menus {
+			menus = append(menus, menuIDs[code])
+		}
+		refs := refIDs(apiIDs, r.Apis)
+
+		if old, ok := p.roles[r.Code]; ok {
+			upd := tx.Role.UpdateOneID(old.ID).ClearMenus().AddMenuIDs(menus...).ClearApis().AddAPIIDs(refs...)
+			setRole(upd.Mutation(), r)
+			if err := upd.Exec(ctx); err != nil {
+				return nil, err
+			}
+		} else {
+			c := tx.Role.Create().AddMenuIDs(menus...).AddAPIIDs(refs...)
+			setRole(c.Mutation(), r)
+			if err := c.Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		written = append(written, r)
+	}
+	return written, nil
+}
+
+func (p *Plan) applyConfigurations(ctx context.Context, tx *ent.Tx) error {
+	for _, c := range p.Bundle.Configurations {
+		if !p.writes(KindConfiguration, c.Key) {
+			continue
+		}
+		if old, ok := p.configs[c.Key]; ok {
+			err := tx.Configuration.UpdateOneID(old.ID).
+				SetGroup(c.Group).
+				SetName(c.Name).
+				SetValue(c.Value).
+				SetDescription(c.Description).
+				SetState(c.State).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err := tx.Configuration.Create().
+			SetKey(c.Key).
+			SetGroup(c.Group).
+			SetName(c.Name).
+			SetValue(c.Value).
+			SetDescription(c.Description).
+			SetState(c.State).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// affectedRoles 获取持有绑定API将变化的菜单、且不在本次写入范围内的角色
+func (p *Plan) affectedRoles(ctx context.Context, tx *ent.Tx) ([]*ent.Role, error) {
+	var codes []string
+	for _, c := range p.Changes {
+		if c.Kind != KindMenu || c.Action != ActionUpdate {
+			continue
+		}
+		for _, f := range c.Fields {
+			if f == "apis" {
+				codes = append(codes, c.Key)
+				break
+			}
+		}
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	var written []string
+	for _, r := range p.Bundle.Roles {
+		if p.writes(KindRole, r.Code) {
+			written = append(written, r.Code)
+		}
+	}
+	return tx.Role.Query().
+		Where(role.HasMenusWith(menu.MenuCodeIn(codes...)), role.RoleCodeNotIn(written...)).
+		All(ctx)
+}
+
+func refIDs(ids map[string]uint32, refs []ApiRef) []uint32 {
+	list := make([]uint32, 0, len(refs))
+	for _, ref := range refs {
+		list = append(list, ids[ApiKey(ref.Path, ref.Method)])
+	}
+	return list
+}
+
+func setApi(m *ent.APIMutation, a Api) {
+	m.SetPath(a.Path)
+	m.SetMethod(a.Method)
+	setOrClear(a.Name, m.SetName, m.ClearName)
+	setOrClear(a.Description, m.SetDescription, m.ClearDescription)
+	m.SetAPIGroup(a.Group)
+	m.SetServiceName(a.ServiceName)
+	m.SetIsRequired(a.IsRequired)
+}
+
+func setMenu(m *ent.MenuMutation, item Menu) {
+	m.SetMenuCode(item.Code)
+	m.SetMenuName(item.Name)
+	if len(item.NameI18n) == 0 {
+		m.ClearMenuNameI18n()
+	} else {
+		m.SetMenuNameI18n(item.NameI18n)
+	}
+	m.SetMenuType(item.Type)
+	setOrClear(item.Path, m.SetMenuPath, m.ClearMenuPath)
+	setOrClear(item.Component, m.SetComponent, m.ClearComponent)
+	setOrClear(item.Redirect, m.SetRedirect, m.ClearRedirect)
+	m.SetMenuLevel(item.Level)
+	setOrClear(item.Icon, m.SetIcon, m.ClearIcon)
+	setOrClear(item.Permission, m.SetPermission, m.ClearPermission)
+	setOrClear(item.ServiceName, m.SetServiceName, m.ClearServiceName)
+	setOrClear(item.FrameSrc, m.SetFrameSrc, m.ClearFrameSrc)
+	setOrClear(item.Description, m.SetDescription, m.ClearDescription)
+	setOrClear(item.Link, m.SetLink, m.ClearLink)
+	m.SetSort(item.Sort)
+	m.SetState(item.State)
+	setOrClear(item.IsHidden, m.SetIsHidden, m.ClearIsHidden)
+	setOrClear(item.IsBreadcrumb, m.SetIsBreadcrumb, m.ClearIsBreadcrumb)
+	setOrClear(item.IsCache, m.SetIsCache, m.ClearIsCache)
+	setOrClear(item.IsTab, m.SetIsTab, m.ClearIsTab)
+	setOrClear(item.IsAffix, m.SetIsAffix, m.ClearIsAffix)
+}
+
+func setRole(m *ent.RoleMutation, r Role) {
+	m.SetRoleCode(r.Code)
+	m.SetRoleName(r.Name)
+	setOrClear(r.Description, m.SetDescription, m.ClearDescription)
+	m.SetState(r.State)
+}
+
+func setOrClear[T any](v *T, set func(T), clear func()) {
+	if v == nil {
+		clear()
+		return
+	}
+	set(*v)
+}
@@ -0,0 +1,348 @@
+package bundleutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/configuration"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+)
+
+// Version 当前配置包格式版本，导入时拒绝更高版本的配置包
+const Version = 1
+
+// 配置包校验错误，错误信息为对应的 i18n 键
+var (
+	ErrInvalid            = errors.New("bundle.invalid")
+	ErrUnsupportedVersion = errors.New("bundle.unsupportedVersion")
+	ErrMissingReference   = errors.New("bundle.missingReference")
+	ErrConflict           = errors.New("bundle.conflict")
+)
+
+// secretMarkers 配置键包含以下片段时视为密钥
+var secretMarkers = []string{"secret", "password", "passwd", "token", "private", "credential", "apikey", "api_key", "accesskey", "access_key"}
+
+// Bundle 用于环境间迁移的配置包，各项均以自然键关联，不包含数据库ID
+type Bundle struct {
+	Version        int             `json:"version"`
+	ExportedAt     int64           `json:"exportedAt"`
+	Apis           []Api           `json:"apis"`
+	Menus          []Menu          `json:"menus"`
+	Roles          []Role          `json:"roles"`
+	Configurations []Configuration `json:"configurations"`
+}
+
+// ApiRef 以路径及请求方法引用的API
+type ApiRef struct {
+	Path   string `json:"path"`
+	Method string `json:"method"`
+}
+
+type Api struct {
+	Path        string  `json:"path"`
+	Method      string  `json:"method"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Group       string  `json:"group"`
+	ServiceName string  `json:"serviceName"`
+	IsRequired  bool    `json:"isRequired"`
+}
+
+type Menu struct {
+	Code         string            `json:"code"`
+	ParentCode   string            `json:"parentCode,omitempty"`
+	Name         string            `json:"name"`
+	NameI18n     map[string]string `json:"nameI18n,omitempty"`
+	Type         string            `json:"type"`
+	Path         *string           `json:"path,omitempty"`
+	Component    *string           `json:"component,omitempty"`
+	Redirect     *string           `json:"redirect,omitempty"`
+	Level        uint16            `json:"level"`
+	Icon         *string           `json:"icon,omitempty"`
+	Permission   *string           `json:"permission,omitempty"`
+	ServiceName  *string           `json:"serviceName,omitempty"`
+	FrameSrc     *string           `json:"frameSrc,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	Link         *string           `json:"link,omitempty"`
+	Sort         int32             `json:"sort"`
+	State        bool              `json:"state"`
+	IsHidden     *bool             `json:"isHidden,omitempty"`
+	IsBreadcrumb *bool             `json:"isBreadcrumb,omitempty"`
+	IsCache      *bool             `json:"isCache,omitempty"`
+	IsTab        *bool             `json:"isTab,omitempty"`
+	IsAffix      *bool             `json:"isAffix,omitempty"`
+	// 菜单绑定的API
+	Apis []ApiRef `json:"apis,omitempty"`
+}
+
+// Policy 角色的 Casbin 策略，省略角色编码
+type Policy struct {
+	Domain string `json:"domain"`
+	Object string `json:"object"`
+	Action string `json:"action"`
+}
+
+type Role struct {
+	Code        string  `json:"code"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	State       bool    `json:"state"`
+	// 菜单编码
+	Menus []string `json:"menus,omitempty"`
+	// 手动分配的API，不包含菜单派生的API
+	Apis []ApiRef `json:"apis,omitempty"`
+	// 角色在各域下的全部策略
+	Policies []Policy `json:"policies,omitempty"`
+}
+
+type Configuration struct {
+	Key         string `json:"key"`
+	Group       string `json:"group"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+	State       bool   `json:"state"`
+}
+
+// IsSecretKey 判断配置键是否疑似密钥
+func IsSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, m := range secretMarkers {
+		if strings.Contains(key, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Export 导出全部API、菜单、角色及配置项，excludeSecrets 为 true 时排除疑似密钥的配置项
+func Export(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, excludeSecrets bool) (*Bundle, error) {
+	b := &Bundle{Version: Version, ExportedAt: time.Now().UnixMilli()}
+
+	apis, err := db.API.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range apis {
+		b.Apis = append(b.Apis, Api{
+			Path:        a.Path,
+			Method:      a.Method,
+			Name:        a.Name,
+			Description: a.Description,
+			Group:       a.APIGroup,
+			ServiceName: a.ServiceName,
+			IsRequired:  a.IsRequired,
+		})
+	}
+	sort.SliceStable(b.Apis, func(i, j int) bool {
+		return ApiKey(b.Apis[i].Path, b.Apis[i].Method) < ApiKey(b.Apis[j].Path, b.Apis[j].Method)
+	})
+
+	menus, err := db.Menu.Query().WithApis().Order(menu.ByMenuLevel(), menu.BySort(), menu.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes := make(map[uint32]string, len(menus))
+	for _, m := range menus {
+		codes[m.ID] = m.MenuCode
+	}
+	for _, m := range menus {
+		item := toMenu(m)
+		if m.ParentID != nil {
+			item.ParentCode = codes[*m.ParentID]
+		}
+		b.Menus = append(b.Menus, item)
+	}
+
+	roles, err := db.Role.Query().WithMenus().WithApis().Order(role.ByRoleCode()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		policies, err := cbn.GetFilteredPolicy(0, r.RoleCode)
+		if err != nil {
+			return nil, err
+		}
+		b.Roles = append(b.Roles, toRole(r, policies))
+	}
+
+	configs, err := db.Configuration.Query().Order(configuration.ByGroup(), configuration.ByKey()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range configs {
+		if excludeSecrets && IsSecretKey(c.Key) {
+			continue
+		}
+		b.Configurations = append(b.Configurations, toConfiguration(c))
+	}
+	return b, nil
+}
+
+// Parse 解析 JSON 格式的配置包，校验版本、必填项及自然键是否重复
+func Parse(content []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(content, &b); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if b.Version < 1 || b.Version > Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, b.Version)
+	}
+
+	seen := make(map[string]struct{})
+	unique := func(kind, key string, required ...string) error {
+		for _, v := range required {
+			if v == "" {
+				return fmt.Errorf("%w: %s %q has empty required field", ErrInvalid, kind, key)
+			}
+		}
+		if _, ok := seen[kind+"|"+key]; ok {
+			return fmt.Errorf("%w: duplicate %s %q", ErrInvalid, kind, key)
+		}
+		seen[kind+"|"+key] = struct{}{}
+		return nil
+	}
+	for _, a := range b.Apis {
+		if err := unique(KindApi, ApiKey(a.Path, a.Method), a.Path, a.Method, a.ServiceName, a.Group); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range b.Menus {
+		if err := unique(KindMenu, m.Code, m.Code, m.Name, m.Type); err != nil {
+			return nil, err
+		}
+		if m.ParentCode == m.Code {
+			return nil, fmt.Errorf("%w: menu %q is its own parent", ErrInvalid, m.Code)
+		}
+	}
+	for i, r := range b.Roles {
+		if err := unique(KindRole, r.Code, r.Code, r.Name); err != nil {
+			return nil, err
+		}
+		for _, p := range r.Policies {
+			if p.Domain == "" || p.Object == "" || p.Action == "" {
+				return nil, fmt.Errorf("%w: role %q has incomplete policy", ErrInvalid, r.Code)
+			}
+		}
+		b.Roles[i].Policies = uniquePolicies(r.Policies)
+	}
+	for _, c := range b.Configurations {
+		if err := unique(KindConfiguration, c.Key, c.Key, c.Group, c.Name, c.Value); err != nil {
+			return nil, err
+		}
+	}
+	return &b, nil
+}
+
+// ApiKey API的自然键
+func ApiKey(path, method string) string {
+	return policyutils.Key(path, method)
+}
+
+func toMenu(m *ent.Menu) Menu {
+	item := Menu{
+		Code:         m.MenuCode,
+		Name:         m.MenuName,
+		NameI18n:     m.MenuNameI18n,
+		Type:         m.MenuType,
+		Path:         m.MenuPath,
+		Component:    m.Component,
+		Redirect:     m.Redirect,
+		Level:        m.MenuLevel,
+		Icon:         m.Icon,
+		Permission:   m.Permission,
+		ServiceName:  m.ServiceName,
+		FrameSrc:     m.FrameSrc,
+		Description:  m.Description,
+		Link:         m.Link,
+		Sort:         m.Sort,
+		State:        m.State,
+		IsHidden:     m.IsHidden,
+		IsBreadcrumb: m.IsBreadcrumb,
+		IsCache:      m.IsCache,
+		IsTab:        m.IsTab,
+		IsAffix:      m.IsAffix,
+	}
+	item.Apis = apiRefs(m.Edges.Apis)
+	return item
+}
+
+func toRole(r *ent.Role, policies [][]string) Role {
+	item := Role{Code: r.RoleCode, Name: r.RoleName, Description: r.Description, State: r.State}
+	for _, m := range r.Edges.Menus {
+		item.Menus = append(item.Menus, m.MenuCode)
+	}
+	sort.Strings(item.Menus)
+	item.Apis = apiRefs(r.Edges.Apis)
+	for _, p := range policies {
+		if len(p) >= 4 {
+			item.Policies = append(item.Policies, Policy{Domain: p[1], Object: p[2], Action: p[3]})
+		}
+	}
+	item.Policies = uniquePolicies(item.Policies)
+	return item
+}
+
+func toConfiguration(c *ent.Configuration) Configuration {
+	return Configuration{
+		Key:         c.Key,
+		Group:       c.Group,
+		Name:        c.Name,
+		Value:       c.Value,
+		Description: c.Description,
+		State:       c.State,
+	}
+}
+
+func apiRefs(apis []*ent.API) []ApiRef {
+	refs := make([]ApiRef, 0, len(apis))
+	for _, a := range apis {
+		refs = append(refs, ApiRef{Path: a.Path, Method: a.Method})
+	}
+	return sortRefs(refs)
+}
+
+func sortRefs(refs []ApiRef) []ApiRef {
+	if len(refs) == 0 {
+		return nil
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		return ApiKey(refs[i].Path, refs[i].Method) < ApiKey(refs[j].Path, refs[j].Method)
+	})
+	return refs
+}
+
+// uniquePolicies 去重并排序策略
+func uniquePolicies(policies []Policy) []Policy {
+	seen := make(map[Policy]struct{}, len(policies))
+	list := make([]Policy, 0, len(policies))
+	for _, p := range policies {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		list = append(list, p)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Domain != list[j].Domain {
+			return list[i].Domain < list[j].Domain
+		}
+		if list[i].Object != list[j].Object {
+			return list[i].Object < list[j].Object
+		}
+		return list[i].Action < list[j].Action
+	})
+	return list
+}
@@ -0,0 +1,93 @@
+package bundleutils
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestIsSecretKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"mail.smtp.password":  true,
+		"oss.AccessKey":       true,
+		"jwt_secret":          true,
+		"site.title":          false,
+		"github.clientSecret": true,
+	} {
+		if got := IsSecretKey(key); got != want {
+			t.Errorf("IsSecretKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		content string
+		err     error
+	}{
+		{`{"version":1,"roles":[{"code":"ops","name":"Ops","policies":[{"domain":"api","object":"/a","action":"GET"},{"domain":"api","object":"/a","action":"GET"}]}]}`, nil},
+		{`{"version":2}`, ErrUnsupportedVersion},
+		{`{"apis":[]}`, ErrUnsupportedVersion},
+		{`not json`, ErrInvalid},
+		{`{"version":1,"menus":[{"code":"a","name":"A","type":"menu"},{"code":"a","name":"B","type":"menu"}]}`, ErrInvalid},
+		{`{"version":1,"menus":[{"code":"a","name":"A","type":"menu","parentCode":"a"}]}`, ErrInvalid},
+		{`{"version":1,"configurations":[{"key":"k","group":"g","name":"n"}]}`, ErrInvalid},
+		{`{"version":1,"roles":[{"code":"ops","name":"Ops","policies":[{"domain":"api","object":"/a"}]}]}`, ErrInvalid},
+	}
+	for _, tt := range tests {
+		b, err := Parse([]byte(tt.content))
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("Parse(%s) error = %v, want %v", tt.content, err, tt.err)
+		}
+		if err == nil && len(b.Roles[0].Policies) != 1 {
+			t.Fatalf("duplicate policies must be merged: %v", b.Roles[0].Policies)
+		}
+	}
+}
+
+func TestPolicyChanges(t *testing.T) {
+	current := [][]string{
+		{"ops", "api", "/user/:id", "GET"},
+		{"ops", "configuration", "/system/mail", "read"},
+	}
+	changes := policyChanges("ops", current, []Policy{
+		{Domain: "api", Object: "/user/:id", Action: "GET"},
+		{Domain: "api", Object: "/user/:id", Action: "DELETE"},
+	})
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Action+" "+c.Key)
+	}
+	want := []string{
+		"create p, ops, api, /user/:id, DELETE",
+		"delete p, ops, configuration, /system/mail, read",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestRoleFields(t *testing.T) {
+	old := Role{
+		Code:     "ops",
+		Name:     "Ops",
+		State:    true,
+		Menus:    []string{"a", "b"},
+		Apis:     []ApiRef{{Path: "/a", Method: "GET"}, {Path: "/b", Method: "GET"}},
+		Policies: []Policy{{Domain: "api", Object: "/a", Action: "GET"}},
+	}
+	same := old
+	same.Menus = []string{"b", "a"}
+	same.Apis = []ApiRef{{Path: "/b", Method: "GET"}, {Path: "/a", Method: "GET"}}
+	if fields := roleFields(old, same); len(fields) != 0 {
+		t.Fatalf("order must not matter: %v", fields)
+	}
+
+	changed := same
+	changed.Name = "Operators"
+	changed.Policies = nil
+	if fields := roleFields(old, changed); !slices.Equal(fields, []string{"name", "policies"}) {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+}
@@ -0,0 +1,345 @@
+package bundleutils
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// 导入冲突策略
+const (
+	StrategySkip      = "skip"
+	StrategyOverwrite = "overwrite"
+	StrategyFail      = "fail"
+)
+
+// 变更类型
+const (
+	KindApi           = "api"
+	KindMenu          = "menu"
+	KindRole          = "role"
+	KindConfiguration = "configuration"
+	KindPolicy        = "policy"
+)
+
+// 变更操作
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionSkip      = "skip"
+	ActionConflict  = "conflict"
+	ActionUnchanged = "unchanged"
+)
+
+// Plan 配置包与当前数据的差异，Changes 按API、菜单、角色、配置项的顺序排列，角色的策略变更紧随其后
+type Plan struct {
+	Bundle  *Bundle
+	Changes []*core.BundleChange
+
+	apis    map[string]*ent.API
+	menus   map[string]*ent.Menu
+	roles   map[string]*ent.Role
+	configs map[string]*ent.Configuration
+	actions map[string]string
+}
+
+// Action 获取指定类型及自然键的操作
+func (p *Plan) Action(kind, key string) string {
+	return p.actions[kind+"|"+key]
+}
+
+// Count 统计指定操作的数量，不包含策略行
+func (p *Plan) Count(action string) int64 {
+	var n int64
+	for _, c := range p.Changes {
+		if c.Kind != KindPolicy && c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// NewPlan 对比配置包与当前数据，已存在且有差异的项按冲突策略处理：
+// overwrite 覆盖，skip 跳过，fail 标记为冲突；excludeSecrets 为 true 时跳过疑似密钥的配置项
+func NewPlan(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, b *Bundle, strategy string, excludeSecrets bool) (*Plan, error) {
+	p := &Plan{
+		Bundle:  b,
+		apis:    make(map[string]*ent.API),
+		menus:   make(map[string]*ent.Menu),
+		roles:   make(map[string]*ent.Role),
+		configs: make(map[string]*ent.Configuration),
+		actions: make(map[string]string),
+	}
+	if err := p.load(ctx, db); err != nil {
+		return nil, err
+	}
+	if err := p.checkReferences(); err != nil {
+		return nil, err
+	}
+
+	decide := func(kind, key string, exists bool, fields []string) {
+		action := ActionCreate
+		switch {
+		case !exists:
+		case len(fields) == 0:
+			action = ActionUnchanged
+		case strategy == StrategyOverwrite:
+			action = ActionUpdate
+		case strategy == StrategyFail:
+			action = ActionConflict
+		default:
+			action = ActionSkip
+		}
+		p.actions[kind+"|"+key] = action
+		p.Changes = append(p.Changes, &core.BundleChange{Kind: kind, Key: key, Action: action, Fields: fields})
+	}
+
+	for _, a := range b.Apis {
+		key := ApiKey(a.Path, a.Method)
+		old, ok := p.apis[key]
+		var fields []string
+		if ok {
+			fields = apiFields(old, a)
+		}
+		decide(KindApi, key, ok, fields)
+	}
+
+	menuCodes := make(map[uint32]string, len(p.menus))
+	for _, m := range p.menus {
+		menuCodes[m.ID] = m.MenuCode
+	}
+	for _, m := range b.Menus {
+		old, ok := p.menus[m.Code]
+		var fields []string
+		if ok {
+			item := toMenu(old)
+			if old.ParentID != nil {
+				item.ParentCode = menuCodes[*old.ParentID]
+			}
+			fields = menuFields(item, m)
+		}
+		decide(KindMenu, m.Code, ok, fields)
+	}
+
+	for _, r := range b.Roles {
+		current, err := cbn.GetFilteredPolicy(0, r.Code)
+		if err != nil {
+			return nil, err
+		}
+		old, ok := p.roles[r.Code]
+		var fields []string
+		if ok {
+			fields = roleFields(toRole(old, current), r)
+		}
+		decide(KindRole, r.Code, ok, fields)
+
+		if action := p.Action(KindRole, r.Code); action == ActionCreate || action == ActionUpdate {
+			p.Changes = append(p.Changes, policyChanges(r.Code, current, r.Policies)...)
+		}
+	}
+
+	for _, c := range b.Configurations {
+		if excludeSecrets && IsSecretKey(c.Key) {
+			p.actions[KindConfiguration+"|"+c.Key] = ActionSkip
+			p.Changes = append(p.Changes, &core.BundleChange{Kind: KindConfiguration, Key: c.Key, Action: ActionSkip})
+			continue
+		}
+		old, ok := p.configs[c.Key]
+		var fields []string
+		if ok {
+			fields = configurationFields(toConfiguration(old), c)
+		}
+		decide(KindConfiguration, c.Key, ok, fields)
+	}
+	return p, nil
+}
+
+func (p *Plan) load(ctx context.Context, db *ent.Client) error {
+	apis, err := db.API.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range apis {
+		p.apis[ApiKey(a.Path, a.Method)] = a
+	}
+
+	menus, err := db.Menu.Query().WithApis().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range menus {
+		p.menus[m.MenuCode] = m
+	}
+
+	roles, err := db.Role.Query().WithMenus().WithApis().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		p.roles[r.RoleCode] = r
+	}
+
+	configs, err := db.Configuration.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range configs {
+		p.configs[c.Key] = c
+	}
+	return nil
+}
+
+// checkReferences 校验菜单、角色引用的菜单及API存在于配置包或当前数据中
+func (p *Plan) checkReferences() error {
+	apis := make(map[string]struct{}, len(p.Bundle.Apis))
+	for _, a := range p.Bundle.Apis {
+		apis[ApiKey(a.Path, a.Method)] = struct{}{}
+	}
+	menus := make(map[string]struct{}, len(p.Bundle.Menus))
+	for _, m := range p.Bundle.Menus {
+		menus[m.Code] = struct{}{}
+	}
+	hasApi := func(ref ApiRef) bool {
+		key := ApiKey(ref.Path, ref.Method)
+		_, inBundle := apis[key]
+		_, exists := p.apis[key]
+		return inBundle || exists
+	}
+	hasMenu := func(code string) bool {
+		_, inBundle := menus[code]
+		_, exists := p.menus[code]
+		return inBundle || exists
+	}
+
+	for _, m := range p.Bundle.Menus {
+		if m.ParentCode != "" && !hasMenu(m.ParentCode) {
+			return fmt.Errorf("%w: menu %q parent %q", ErrMissingReference, m.Code, m.ParentCode)
+		}
+		for _, ref := range m.Apis {
+			if !hasApi(ref) {
+				return fmt.Errorf("%w: menu %q api %q", ErrMissingReference, m.Code, ApiKey(ref.Path, ref.Method))
+			}
+		}
+	}
+	for _, r := range p.Bundle.Roles {
+		for _, code := range r.Menus {
+			if !hasMenu(code) {
+				return fmt.Errorf("%w: role %q menu %q", ErrMissingReference, r.Code, code)
+			}
+		}
+		for _, ref := range r.Apis {
+			if !hasApi(ref) {
+				return fmt.Errorf("%w: role %q api %q", ErrMissingReference, r.Code, ApiKey(ref.Path, ref.Method))
+			}
+		}
+	}
+	return nil
+}
+
+// policyChanges 对比角色当前策略与配置包中的策略
+func policyChanges(roleCode string, current [][]string, policies []Policy) []*core.BundleChange {
+	want := make(map[string]struct{}, len(policies))
+	var changes []*core.BundleChange
+	for _, item := range policies {
+		line := policyutils.PolicyLine(policyRow(roleCode, item))
+		want[line] = struct{}{}
+	}
+	have := make(map[string]struct{}, len(current))
+	for _, row := range current {
+		have[policyutils.PolicyLine(row)] = struct{}{}
+	}
+	for _, item := range policies {
+		line := policyutils.PolicyLine(policyRow(roleCode, item))
+		if _, ok := have[line]; !ok {
+			changes = append(changes, &core.BundleChange{Kind: KindPolicy, Key: line, Action: ActionCreate})
+		}
+	}
+	for _, row := range current {
+		line := policyutils.PolicyLine(row)
+		if _, ok := want[line]; !ok {
+			changes = append(changes, &core.BundleChange{Kind: KindPolicy, Key: line, Action: ActionDelete})
+		}
+	}
+	return changes
+}
+
+func policyRow(roleCode string, p Policy) []string {
+	return []string{roleCode, p.Domain, p.Object, p.Action}
+}
+
+// fieldDiff 收集发生变化的字段名
+type fieldDiff []string
+
+func (d *fieldDiff) check(name string, same bool) {
+	if !same {
+		*d = append(*d, name)
+	}
+}
+
+func apiFields(old *ent.API, a Api) []string {
+	var d fieldDiff
+	d.check("name", pointer.GetString(old.Name) == pointer.GetString(a.Name))
+	d.check("description", pointer.GetString(old.Description) == pointer.GetString(a.Description))
+	d.check("group", old.APIGroup == a.Group)
+	d.check("serviceName", old.ServiceName == a.ServiceName)
+	d.check("isRequired", old.IsRequired == a.IsRequired)
+	return d
+}
+
+func menuFields(old, m Menu) []string {
+	var d fieldDiff
+	d.check("parentCode", old.ParentCode == m.ParentCode)
+	d.check("name", old.Name == m.Name)
+	d.check("nameI18n", maps.Equal(old.NameI18n, m.NameI18n))
+	d.check("type", old.Type == m.Type)
+	d.check("path", pointer.GetString(old.Path) == pointer.GetString(m.Path))
+	d.check("component", pointer.GetString(old.Component) == pointer.GetString(m.Component))
+	d.check("redirect", pointer.GetString(old.Redirect) == pointer.GetString(m.Redirect))
+	d.check("level", old.Level == m.Level)
+	d.check("icon", pointer.GetString(old.Icon) == pointer.GetString(m.Icon))
+	d.check("permission", pointer.GetString(old.Permission) == pointer.GetString(m.Permission))
+	d.check("serviceName", pointer.GetString(old.ServiceName) == pointer.GetString(m.ServiceName))
+	d.check("frameSrc", pointer.GetString(old.FrameSrc) == pointer.GetString(m.FrameSrc))
+	d.check("description", pointer.GetString(old.Description) == pointer.GetString(m.Description))
+	d.check("link", pointer.GetString(old.Link) == pointer.GetString(m.Link))
+	d.check("sort", old.Sort == m.Sort)
+	d.check("state", old.State == m.State)
+	d.check("isHidden", pointer.GetBool(old.IsHidden) == pointer.GetBool(m.IsHidden))
+	d.check("isBreadcrumb", pointer.GetBool(old.IsBreadcrumb) == pointer.GetBool(m.IsBreadcrumb))
+	d.check("isCache", pointer.GetBool(old.IsCache) == pointer.GetBool(m.IsCache))
+	d.check("isTab", pointer.GetBool(old.IsTab) == pointer.GetBool(m.IsTab))
+	d.check("isAffix", pointer.GetBool(old.IsAffix) == pointer.GetBool(m.IsAffix))
+	d.check("apis", slices.Equal(old.Apis, sortRefs(slices.Clone(m.Apis))))
+	return d
+}
+
+func roleFields(old, r Role) []string {
+	menus := slices.Clone(r.Menus)
+	slices.Sort(menus)
+	var d fieldDiff
+	d.check("name", old.Name == r.Name)
+	d.check("description", pointer.GetString(old.Description) == pointer.GetString(r.Description))
+	d.check("state", old.State == r.State)
+	d.check("menus", slices.Equal(old.Menus, slices.Compact(menus)))
+	d.check("apis", slices.Equal(old.Apis, sortRefs(slices.Clone(r.Apis))))
+	d.check("policies", slices.Equal(old.Policies, r.Policies))
+	return d
+}
+
+func configurationFields(old, c Configuration) []string {
+	var d fieldDiff
+	d.check("group", old.Group == c.Group)
+	d.check("name", old.Name == c.Name)
+	d.check("value", old.Value == c.Value)
+	d.check("description", old.Description == c.Description)
+	d.check("state", old.State == c.State)
+	return d
+}
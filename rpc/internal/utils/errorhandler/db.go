@@ -1,6 +1,7 @@
 package errorhandler
 
 import (
+	"errors"
 	"strings"
 
 	last_i18n "github.com/wenpiner/last-admin-common/last-i18n"
@@ -29,6 +30,9 @@ func DBEntError(logger logx.Logger, err error, in interface{}) error {
 	case ent.IsValidationError(err):
 		// 验证错误
 		return errorx.NewInvalidArgumentError(last_i18n.ValidationFailed)
+	case errors.Is(err, ent.ErrInvalidQuery):
+		// 筛选或排序条件不合法
+		return errorx.NewInvalidArgumentError("common.invalidQuery")
 	case ent.IsNotSingular(err):
 		// 数据一致性错误
 		return errorx.NewInvalidArgumentError(last_i18n.ConsistencyCheckFailed)
@@ -0,0 +1,85 @@
+package i18nutils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wenpiner/last-admin-common/ctx/langctx"
+	"golang.org/x/text/language"
+)
+
+// Localize 按上下文中的语言返回翻译后的内容，无匹配翻译时返回默认语言的原始值
+func Localize(ctx context.Context, value string, translations map[string]string) string {
+	if len(translations) == 0 {
+		return value
+	}
+	lang, ok := langctx.GetLangFromContext(ctx)
+	if !ok || lang == "" {
+		return value
+	}
+	return Match(lang, value, translations)
+}
+
+// Match 按 Accept-Language 的优先级依次匹配完整语言标签及其基础语言
+func Match(acceptLanguage, value string, translations map[string]string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return value
+	}
+	for _, tag := range tags {
+		if text, ok := translations[tag.String()]; ok {
+			return text
+		}
+		base, _ := tag.Base()
+		if text, ok := translations[base.String()]; ok {
+			return text
+		}
+	}
+	return value
+}
+
+// Prepare 拆分待保存的多语言内容：默认语言的值写回原字段，其余语言作为翻译保存
+// translations 为空时原样返回 value，语言标签无效时返回 false
+func Prepare(defaultLocale string, value *string, translations map[string]string) (*string, map[string]string, bool) {
+	result, ok := Normalize(translations)
+	if !ok {
+		return nil, nil, false
+	}
+	if text, ok := result[normalizeTag(defaultLocale)]; ok {
+		value = &text
+		delete(result, normalizeTag(defaultLocale))
+	}
+	return value, result, true
+}
+
+// Expand 返回包含默认语言原值的完整多语言内容，便于管理端回显编辑
+func Expand(defaultLocale, value string, translations map[string]string) map[string]string {
+	result := make(map[string]string, len(translations)+1)
+	for key, text := range translations {
+		result[key] = text
+	}
+	result[normalizeTag(defaultLocale)] = value
+	return result
+}
+
+func normalizeTag(locale string) string {
+	if tag, err := language.Parse(locale); err == nil {
+		return tag.String()
+	}
+	return locale
+}
+
+// Normalize 规范化翻译的语言标签并去除空值，语言标签无效时返回 false
+func Normalize(translations map[string]string) (map[string]string, bool) {
+	result := make(map[string]string, len(translations))
+	for key, text := range translations {
+		tag, err := language.Parse(strings.TrimSpace(key))
+		if err != nil {
+			return nil, false
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			result[tag.String()] = text
+		}
+	}
+	return result, true
+}
@@ -0,0 +1,60 @@
+package i18nutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/ctx/langctx"
+)
+
+func TestLocalize(t *testing.T) {
+	translations := map[string]string{"en": "User", "zh-TW": "使用者"}
+
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"", "用户"},
+		{"en-US,en;q=0.9", "User"},
+		{"zh-TW", "使用者"},
+		{"fr,en;q=0.5", "User"},
+		{"ja", "用户"},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.lang != "" {
+			ctx = langctx.WithLangToContext(ctx, tt.lang)
+		}
+		if got := Localize(ctx, "用户", translations); got != tt.want {
+			t.Errorf("Localize(%q) = %q, want %q", tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	got, ok := Normalize(map[string]string{"en-us": " User ", "zh-hant-tw": "", "ja": "ユーザー"})
+	if !ok || len(got) != 2 || got["en-US"] != "User" || got["ja"] != "ユーザー" {
+		t.Fatalf("unexpected result: %v %v", got, ok)
+	}
+	if _, ok = Normalize(map[string]string{"not a tag!": "x"}); ok {
+		t.Fatal("expected invalid tag to be rejected")
+	}
+}
+
+func TestPrepareAndExpand(t *testing.T) {
+	name := "研发部"
+	value, translations, ok := Prepare("zh", &name, map[string]string{"zh": "研发中心", "en": "R&D"})
+	if !ok || *value != "研发中心" || len(translations) != 1 || translations["en"] != "R&D" {
+		t.Fatalf("unexpected result: %v %v %v", *value, translations, ok)
+	}
+
+	value, translations, ok = Prepare("zh", &name, nil)
+	if !ok || value != &name || len(translations) != 0 {
+		t.Fatalf("unexpected result without translations: %v %v %v", *value, translations, ok)
+	}
+
+	expanded := Expand("zh", "研发中心", map[string]string{"en": "R&D"})
+	if len(expanded) != 2 || expanded["zh"] != "研发中心" || expanded["en"] != "R&D" {
+		t.Fatalf("unexpected expanded result: %v", expanded)
+	}
+}
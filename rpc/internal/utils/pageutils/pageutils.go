@@ -0,0 +1,59 @@
+package pageutils
+
+import (
+	"fmt"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// QueryOptions 转换分页请求中的通用筛选及排序条件
+func QueryOptions(page *core.BasePageRequest) *ent.QueryOptions {
+	if page == nil {
+		return nil
+	}
+
+	opts := &ent.QueryOptions{
+		Filters: make([]*ent.QueryFilter, 0, len(page.Filters)),
+		Sorts:   make([]*ent.QuerySort, 0, len(page.Sorts)),
+	}
+	for _, f := range page.Filters {
+		opts.Filters = append(opts.Filters, &ent.QueryFilter{Field: f.Field, Op: f.Op, Values: f.Values})
+	}
+	for _, s := range page.Sorts {
+		opts.Sorts = append(opts.Sorts, &ent.QuerySort{Field: s.Field, Desc: s.Desc})
+	}
+	return opts
+}
+
+// IsCursor 判断分页请求是否使用游标分页
+func IsCursor(page *core.BasePageRequest) bool {
+	return page != nil && page.Cursor != nil
+}
+
+// CursorRequest 转换游标分页参数，排序取唯一的排序条件，未指定时按默认字段排序
+func CursorRequest(page *core.BasePageRequest, field string, desc bool) (*ent.CursorRequest, error) {
+	if len(page.Sorts) > 1 {
+		return nil, fmt.Errorf("%w: cursor pagination supports a single sort field", ent.ErrInvalidQuery)
+	}
+	if len(page.Sorts) == 1 {
+		field, desc = page.Sorts[0].Field, page.Sorts[0].Desc
+	}
+	return &ent.CursorRequest{
+		Cursor:  page.GetCursor(),
+		Size:    page.PageSize,
+		Field:   field,
+		Desc:    desc,
+		Skipped: page.WithSkipped,
+	}, nil
+}
+
+// CursorResp 转换游标分页结果
+func CursorResp(details *ent.CursorPageDetails) *core.BasePageResp {
+	return &core.BasePageResp{
+		PageSize:   details.Size,
+		NextCursor: details.NextCursor,
+		HasNext:    details.HasNext,
+		Skipped:    details.Skipped,
+	}
+}
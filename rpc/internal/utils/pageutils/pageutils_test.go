@@ -0,0 +1,121 @@
+package pageutils
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/enttest"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestQueryOptions(t *testing.T) {
+	client := ent.NewClient()
+
+	valid := []*core.BasePageRequest{
+		nil,
+		{},
+		{Filters: []*core.FilterCondition{{Field: "username", Op: ent.QueryOpContains, Values: []string{"adm"}}}},
+		{Filters: []*core.FilterCondition{{Field: "createdAt", Op: ent.QueryOpBetween, Values: []string{"1700000000000", "2024-01-01T00:00:00Z"}}}},
+		{Filters: []*core.FilterCondition{{Field: "last_login_at", Op: ent.QueryOpIsNull}}},
+		{Sorts: []*core.SortOption{{Field: "username", Desc: true}, {Field: "created_at"}}},
+	}
+	for i, page := range valid {
+		if _, err := client.User.Query().ApplyOptions(QueryOptions(page)); err != nil {
+			t.Fatalf("case %d: unexpected error: %v", i, err)
+		}
+	}
+
+	invalid := []*core.BasePageRequest{
+		// 未在白名单内的字段
+		{Filters: []*core.FilterCondition{{Field: "password", Op: ent.QueryOpEQ, Values: []string{"x"}}}},
+		{Sorts: []*core.SortOption{{Field: "email"}}},
+		// 运算符与字段类型或取值数量不匹配
+		{Filters: []*core.FilterCondition{{Field: "username", Op: ent.QueryOpIsNull}}},
+		{Filters: []*core.FilterCondition{{Field: "state", Op: ent.QueryOpGT, Values: []string{"true"}}}},
+		{Filters: []*core.FilterCondition{{Field: "username", Op: ent.QueryOpEQ, Values: []string{"a", "b"}}}},
+		{Filters: []*core.FilterCondition{{Field: "created_at", Op: ent.QueryOpGTE, Values: []string{"yesterday"}}}},
+		{Filters: []*core.FilterCondition{{Field: "username", Op: "like", Values: []string{"a"}}}},
+	}
+	for i, page := range invalid {
+		if _, err := client.User.Query().ApplyOptions(QueryOptions(page)); !errors.Is(err, ent.ErrInvalidQuery) {
+			t.Fatalf("case %d: expected ErrInvalidQuery, got %v", i, err)
+		}
+	}
+}
+
+func TestCursorRequest(t *testing.T) {
+	client := ent.NewClient()
+	cursor := func(v string) *string { return &v }
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"f":"created_at","d":false,"v":"2024-01-01T00:00:00.123456Z","i":"42"}`))
+
+	invalid := []*core.BasePageRequest{
+		// 游标分页只支持一个排序条件
+		{PageSize: 10, Cursor: cursor(""), Sorts: []*core.SortOption{{Field: "created_at"}, {Field: "id"}}},
+		// 可为空的字段不能作为游标排序字段
+		{PageSize: 10, Cursor: cursor(""), Sorts: []*core.SortOption{{Field: "lastUsedAt"}}},
+		{PageSize: 10, Cursor: cursor("not a cursor")},
+		// 游标与排序方向不一致
+		{PageSize: 10, Cursor: cursor(token)},
+	}
+	for i, page := range invalid {
+		req, err := CursorRequest(page, "created_at", true)
+		if err == nil {
+			_, err = client.Token.Query().CursorPage(context.Background(), req)
+		}
+		if !errors.Is(err, ent.ErrInvalidQuery) {
+			t.Fatalf("case %d: expected ErrInvalidQuery, got %v", i, err)
+		}
+	}
+}
+
+func TestCursorPageLocation(t *testing.T) {
+	ctx := context.Background()
+	// SQLite 以带时区偏移的文本保存时间并按文本比较，游标时间需转换为写入时的时区
+	loc := time.FixedZone("CST", 8*3600)
+	client := enttest.Open(t, "sqlite3", "file:cursor_location?mode=memory&cache=shared&_fk=1",
+		enttest.WithOptions(ent.Location(loc)))
+	defer client.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, loc)
+	var want []uint32
+	for i := 0; i < 5; i++ {
+		tk, err := client.Token.Create().
+			SetTokenValue(string(rune('a' + i))).
+			SetTokenType("access_token").
+			SetExpiresAt(start.Add(time.Duration(i) * time.Hour)).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, tk.ID)
+	}
+
+	var got []uint32
+	req := &ent.CursorRequest{Size: 2, Field: "expires_at"}
+	for len(got) <= len(want) {
+		page, err := client.Token.Query().CursorPage(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tk := range page.List {
+			got = append(got, tk.ID)
+		}
+		if !page.PageDetails.HasNext {
+			break
+		}
+		req.Cursor = page.PageDetails.NextCursor
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got ids %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got ids %v, want %v", got, want)
+		}
+	}
+}
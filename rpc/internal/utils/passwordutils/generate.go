@@ -0,0 +1,51 @@
+package passwordutils
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+const (
+	upperChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	lowerChars  = "abcdefghijkmnpqrstuvwxyz"
+	digitChars  = "23456789"
+	symbolChars = "!@#$%^&*-_+="
+)
+
+// Generate 生成包含全部字符类别的随机密码，长度不小于策略最小长度
+func (p Policy) Generate() (string, error) {
+	length := max(16, p.MinLength)
+	sets := []string{upperChars, lowerChars, digitChars, symbolChars}
+	all := upperChars + lowerChars + digitChars + symbolChars
+
+	password := make([]byte, length)
+	for i := range password {
+		set := all
+		if i < len(sets) {
+			set = sets[i]
+		}
+		c, err := randomChar(set)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// 打乱顺序，避免固定位置的字符类别
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(set string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(set))))
+	if err != nil {
+		return 0, err
+	}
+	return set[n.Int64()], nil
+}
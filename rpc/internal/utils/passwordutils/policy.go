@@ -0,0 +1,123 @@
+package passwordutils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+	"github.com/zeromicro/go-zero/core/errorx"
+)
+
+// 密码策略配置分组及配置键，存储于 sys_configuration
+const (
+	ConfigurationGroup = "/password"
+
+	KeyMinLength      = "password_min_length"       // 最小长度
+	KeyMinCharClasses = "password_min_char_classes" // 至少包含的字符类别数(大写/小写/数字/符号)
+	KeyBanned         = "password_banned"           // 禁用密码列表，逗号或换行分隔
+	KeyHistoryDepth   = "password_history_depth"    // 不可与最近 N 次密码重复，0 表示不限制
+	KeyMaxAgeDays     = "password_max_age_days"     // 密码有效天数，0 表示永不过期
+)
+
+// Policy 密码策略
+type Policy struct {
+	MinLength      int
+	MinCharClasses int
+	Banned         []string
+	HistoryDepth   int
+	MaxAgeDays     int
+}
+
+// DefaultPolicy 未配置时使用的默认策略
+func DefaultPolicy() Policy {
+	return Policy{MinLength: 6}
+}
+
+// LoadPolicy 从配置缓存加载密码策略，缺失或格式错误的配置项使用默认值
+func LoadPolicy(c *cache.ConfigurationCache) Policy {
+	p := DefaultPolicy()
+	p.MinLength = getInt(c, KeyMinLength, p.MinLength)
+	p.MinCharClasses = getInt(c, KeyMinCharClasses, p.MinCharClasses)
+	p.HistoryDepth = getInt(c, KeyHistoryDepth, p.HistoryDepth)
+	p.MaxAgeDays = getInt(c, KeyMaxAgeDays, p.MaxAgeDays)
+	if v, ok := c.GetValue(KeyBanned); ok {
+		p.Banned = strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == '\n' || r == '\r'
+		})
+	}
+	return p
+}
+
+// Validate 校验密码是否满足长度、字符类别及禁用列表要求
+func (p Policy) Validate(password, username string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return errorx.NewInvalidArgumentError("password.tooShort")
+	}
+	if charClasses(password) < p.MinCharClasses {
+		return errorx.NewInvalidArgumentError("password.tooWeak")
+	}
+	if username != "" && strings.EqualFold(password, username) {
+		return errorx.NewInvalidArgumentError("password.banned")
+	}
+	for _, banned := range p.Banned {
+		if strings.EqualFold(password, strings.TrimSpace(banned)) {
+			return errorx.NewInvalidArgumentError("password.banned")
+		}
+	}
+	return nil
+}
+
+// Reused 判断密码是否与历史密码哈希中的任意一个相同
+func (p Policy) Reused(password string, hashes []string) bool {
+	if p.HistoryDepth <= 0 {
+		return false
+	}
+	for _, hash := range hashes {
+		if encrypt.BcryptCheck(password, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired 判断密码是否已超过有效期
+func (p Policy) Expired(changedAt time.Time, now time.Time) bool {
+	if p.MaxAgeDays <= 0 {
+		return false
+	}
+	return now.After(changedAt.AddDate(0, 0, p.MaxAgeDays))
+}
+
+// charClasses 统计密码包含的字符类别数
+func charClasses(password string) int {
+	var upper, lower, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return upper + lower + digit + symbol
+}
+
+func getInt(c *cache.ConfigurationCache, key string, def int) int {
+	v, ok := c.GetValue(key)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || n < 0 {
+		return def
+	}
+	return n
+}
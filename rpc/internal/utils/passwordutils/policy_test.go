@@ -0,0 +1,99 @@
+package passwordutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wenpiner/last-admin-common/utils/encrypt"
+	"github.com/wenpiner/last-admin-core/rpc/internal/cache"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	c := cache.NewConfigurationCache()
+	c.SetAll(map[string]string{
+		KeyMinLength:      ConfigurationGroup + "<>10",
+		KeyMinCharClasses: ConfigurationGroup + "<>3",
+		KeyBanned:         ConfigurationGroup + "<>Password1!\nadmin@123",
+		KeyHistoryDepth:   ConfigurationGroup + "<>abc",
+	})
+
+	p := LoadPolicy(c)
+	if p.MinLength != 10 || p.MinCharClasses != 3 {
+		t.Errorf("策略加载错误: %+v", p)
+	}
+	if len(p.Banned) != 2 {
+		t.Errorf("禁用密码解析错误: %v", p.Banned)
+	}
+	if p.HistoryDepth != DefaultPolicy().HistoryDepth || p.MaxAgeDays != DefaultPolicy().MaxAgeDays {
+		t.Errorf("无效或缺失的配置应使用默认值: %+v", p)
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	p := Policy{MinLength: 8, MinCharClasses: 3, Banned: []string{"Password1!"}}
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "符合策略", password: "Abcdef12", wantErr: false},
+		{name: "长度不足", password: "Ab1!", wantErr: true},
+		{name: "字符类别不足", password: "abcdefgh12", wantErr: true},
+		{name: "中文字符按字符计数", password: "密码Ab1密码Ab", wantErr: false},
+		{name: "禁用密码不区分大小写", password: "password1!", wantErr: true},
+		{name: "与用户名相同", password: "Admin1234", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(tt.password, "admin1234")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyReused(t *testing.T) {
+	hashes := []string{encrypt.BcryptEncrypt("Old-pass-1"), encrypt.BcryptEncrypt("Old-pass-2")}
+
+	if (Policy{HistoryDepth: 0}).Reused("Old-pass-1", hashes) {
+		t.Error("未启用历史校验时不应判定重复")
+	}
+	p := Policy{HistoryDepth: 2}
+	if !p.Reused("Old-pass-2", hashes) {
+		t.Error("历史密码应判定为重复")
+	}
+	if p.Reused("New-pass-3", hashes) {
+		t.Error("新密码不应判定为重复")
+	}
+}
+
+func TestPolicyExpired(t *testing.T) {
+	now := time.Now()
+	p := Policy{MaxAgeDays: 90}
+
+	if p.Expired(now.AddDate(0, 0, -30), now) {
+		t.Error("未到期的密码不应过期")
+	}
+	if !p.Expired(now.AddDate(0, 0, -91), now) {
+		t.Error("超过有效期的密码应过期")
+	}
+	if (Policy{}).Expired(now.AddDate(-10, 0, 0), now) {
+		t.Error("有效期为 0 时永不过期")
+	}
+}
+
+func TestPolicyGenerate(t *testing.T) {
+	p := Policy{MinLength: 20, MinCharClasses: 4}
+	password, err := p.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(password) != 20 {
+		t.Errorf("生成的密码长度应为 20, 实际为 %d", len(password))
+	}
+	if err = p.Validate(password, "admin"); err != nil {
+		t.Errorf("生成的密码应满足策略: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package policyutils
+
+import (
+	"sort"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// Authorize 检查角色集合是否有权访问资源，返回授予访问权限的首个角色，公开资源直接允许
+func Authorize(cbn *casbin.Enforcer, roles []string, domain, object, action string) (bool, string, error) {
+	if IsPublic(domain, object) {
+		return true, "", nil
+	}
+	for _, r := range roles {
+		allowed, _, err := Decide(cbn, r, domain, object, action)
+		if err != nil {
+			return false, "", err
+		}
+		if allowed {
+			return true, r, nil
+		}
+	}
+	return false, "", nil
+}
+
+// AllowedActions 获取角色集合在域下允许的资源及操作，object 不为空时仅返回匹配该资源的策略
+func AllowedActions(cbn *casbin.Enforcer, roles []string, domain, object string) ([]*core.AuthzResource, error) {
+	seen := make(map[string]struct{})
+	var list []*core.AuthzResource
+	for _, r := range roles {
+		policies, err := cbn.GetFilteredPolicy(0, r, domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range policies {
+			if len(p) < 4 || !matchObject(domain, object, p[2]) {
+				continue
+			}
+			key := Key(p[2], p[3])
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			list = append(list, &core.AuthzResource{Domain: domain, Object: p[2], Action: p[3]})
+		}
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Object != list[j].Object {
+			return list[i].Object < list[j].Object
+		}
+		return list[i].Action < list[j].Action
+	})
+	return list, nil
+}
+
+// matchObject 按鉴权时的规则匹配资源，配置项精确匹配，其余使用 keyMatch2
+func matchObject(domain, object, pattern string) bool {
+	if object == "" {
+		return true
+	}
+	if domain == ConfigurationDomain {
+		return object == pattern
+	}
+	return util.KeyMatch2(object, pattern)
+}
@@ -0,0 +1,40 @@
+package policyutils
+
+import "testing"
+
+func TestAuthorize(t *testing.T) {
+	cbn := newTestEnforcer(t)
+
+	allowed, grantedBy, err := Authorize(cbn, []string{"guest", "ops"}, ApiDomain, "/user/42", "GET")
+	if err != nil || !allowed || grantedBy != "ops" {
+		t.Fatalf("got %v %q %v", allowed, grantedBy, err)
+	}
+	allowed, _, err = Authorize(cbn, []string{"guest"}, ApiDomain, "/user/42", "GET")
+	if err != nil || allowed {
+		t.Fatalf("got %v %v", allowed, err)
+	}
+	allowed, grantedBy, err = Authorize(cbn, nil, ConfigurationDomain, "/public/site", "read")
+	if err != nil || !allowed || grantedBy != "" {
+		t.Fatalf("got %v %q %v", allowed, grantedBy, err)
+	}
+}
+
+func TestAllowedActions(t *testing.T) {
+	cbn := newTestEnforcer(t)
+
+	list, err := AllowedActions(cbn, []string{"ops"}, ApiDomain, "/user/42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Object != "/user/:id" || list[0].Action != "GET" {
+		t.Fatalf("unexpected actions: %v", list)
+	}
+	list, err = AllowedActions(cbn, []string{"ops"}, ConfigurationDomain, "/system")
+	if err != nil || len(list) != 0 {
+		t.Fatalf("configuration groups must match exactly: %v %v", list, err)
+	}
+	list, err = AllowedActions(cbn, []string{"ops"}, ConfigurationDomain, "")
+	if err != nil || len(list) != 1 {
+		t.Fatalf("unexpected actions: %v %v", list, err)
+	}
+}
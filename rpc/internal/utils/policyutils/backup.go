@@ -0,0 +1,78 @@
+package policyutils
+
+import (
+	"context"
+	"errors"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+)
+
+// Backup 写入前各角色的原有策略，用于数据库事务失败时恢复 Casbin 策略
+type Backup map[string][][]string
+
+// Save 保存角色当前的全部策略，同一角色只保存首次调用时的策略
+func (b Backup) Save(cbn *casbin.Enforcer, roleCode string) error {
+	if _, ok := b[roleCode]; ok {
+		return nil
+	}
+	policies, err := cbn.GetFilteredPolicy(0, roleCode)
+	if err != nil {
+		return err
+	}
+	b[roleCode] = policies
+	return nil
+}
+
+// Restore 将已保存角色的策略恢复为保存时的状态
+func (b Backup) Restore(cbn *casbin.Enforcer) error {
+	var errs []error
+	for code, policies := range b {
+		if _, err := cbn.RemoveFilteredPolicy(0, code); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(policies) == 0 {
+			continue
+		}
+		if _, err := cbn.AddPolicies(policies); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Update 在事务中执行 change 并重建 roles 的接口策略，change 前将角色手动授予的API记为手动分配
+// Casbin 无法参与数据库事务，change、策略同步或事务提交失败时恢复这些角色原有的策略
+func Update(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, roles []*ent.Role, change func(tx *ent.Tx) error) (err error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	backup := make(Backup)
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, backup.Restore(cbn))
+		}
+	}()
+
+	for _, r := range roles {
+		if err = AdoptManual(ctx, tx.Client(), cbn, r); err != nil {
+			return err
+		}
+	}
+	if err = change(tx); err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if err = backup.Save(cbn, r.RoleCode); err != nil {
+			return err
+		}
+		if err = Sync(ctx, tx.Client(), cbn, r); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,108 @@
+package policyutils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// ConfigurationDomain 配置项策略所在的 Casbin 域，策略格式: [roleCode, "configuration", group, operation]
+const ConfigurationDomain = "configuration"
+
+// 授权建议类型
+const (
+	SuggestRegisterApi        = "registerApi"
+	SuggestGrantMenu          = "grantMenu"
+	SuggestGrantApi           = "grantApi"
+	SuggestGrantConfiguration = "grantConfiguration"
+	SuggestAssignRole         = "assignRole"
+)
+
+// IsPublic 判断资源是否无需授权，与配置项权限检查规则一致
+func IsPublic(domain, object string) bool {
+	return domain == ConfigurationDomain && strings.HasPrefix(object, "/public")
+}
+
+// Decide 按鉴权时的规则评估单个角色，返回是否允许及命中的策略
+// 接口使用 Casbin 匹配器，配置项按分组及操作精确匹配
+func Decide(cbn *casbin.Enforcer, roleCode, domain, object, action string) (bool, []string, error) {
+	if domain == ConfigurationDomain {
+		policies, err := cbn.GetFilteredPolicy(0, roleCode, domain, object, action)
+		if err != nil {
+			return false, nil, err
+		}
+		lines := make([]string, 0, len(policies))
+		for _, p := range policies {
+			lines = append(lines, PolicyLine(p))
+		}
+		return len(lines) > 0, lines, nil
+	}
+
+	ok, explain, err := cbn.EnforceEx(roleCode, domain, object, action)
+	if err != nil || !ok {
+		return false, nil, err
+	}
+	return true, []string{PolicyLine(explain)}, nil
+}
+
+// PolicyLine 将策略格式化为 Casbin 策略行
+func PolicyLine(policy []string) string {
+	return "p, " + strings.Join(policy, ", ")
+}
+
+// Suggest 生成缺少权限时的授权建议，包括注册或授权对应API、分配绑定该API的菜单，以及分配已具备该权限的角色
+// exclude 中的角色已参与评估，不作为候选角色
+func Suggest(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, domain, object, action string, exclude map[string]struct{}) ([]*core.PermissionSuggestion, error) {
+	var suggestions []*core.PermissionSuggestion
+	if domain == ApiDomain {
+		apis, err := db.API.Query().Where(api.MethodEQ(action)).WithMenus().All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		matched := false
+		for _, a := range apis {
+			if !util.KeyMatch2(object, a.Path) {
+				continue
+			}
+			matched = true
+			for _, m := range a.Edges.Menus {
+				suggestions = append(suggestions, &core.PermissionSuggestion{
+					Type:     SuggestGrantMenu,
+					ApiId:    &a.ID,
+					MenuId:   &m.ID,
+					MenuName: &m.MenuName,
+				})
+			}
+			suggestions = append(suggestions, &core.PermissionSuggestion{Type: SuggestGrantApi, ApiId: &a.ID})
+		}
+		if !matched {
+			suggestions = append(suggestions, &core.PermissionSuggestion{Type: SuggestRegisterApi})
+		}
+	} else {
+		suggestions = append(suggestions, &core.PermissionSuggestion{Type: SuggestGrantConfiguration})
+	}
+
+	roles, err := db.Role.Query().Where(role.StateEQ(true)).Order(role.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range roles {
+		if _, ok := exclude[r.RoleCode]; ok {
+			continue
+		}
+		allowed, _, err := Decide(cbn, r.RoleCode, domain, object, action)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			suggestions = append(suggestions, &core.PermissionSuggestion{Type: SuggestAssignRole, RoleValue: &r.RoleCode})
+		}
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,80 @@
+package policyutils
+
+import (
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+)
+
+const testModel = `
+[request_definition]
+r = sub, dom, obj, act
+
+[policy_definition]
+p = sub, dom, obj, act
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && r.dom == p.dom && keyMatch2(r.obj, p.obj) && r.act == p.act
+`
+
+func newTestEnforcer(t *testing.T) *casbin.Enforcer {
+	m, err := model.NewModelFromString(testModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cbn, err := casbin.NewEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cbn.AddPolicies([][]string{
+		{"ops", ApiDomain, "/user/:id", "GET"},
+		{"ops", ConfigurationDomain, "/system/mail", "read"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return cbn
+}
+
+func TestDecide(t *testing.T) {
+	cbn := newTestEnforcer(t)
+
+	tests := []struct {
+		role, domain, object, action string
+		allowed                      bool
+		line                         string
+	}{
+		{"ops", ApiDomain, "/user/42", "GET", true, "p, ops, api, /user/:id, GET"},
+		{"ops", ApiDomain, "/user/42", "POST", false, ""},
+		{"guest", ApiDomain, "/user/42", "GET", false, ""},
+		{"ops", ConfigurationDomain, "/system/mail", "read", true, "p, ops, configuration, /system/mail, read"},
+		{"ops", ConfigurationDomain, "/system/mail", "write", false, ""},
+	}
+	for _, tt := range tests {
+		allowed, lines, err := Decide(cbn, tt.role, tt.domain, tt.object, tt.action)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allowed != tt.allowed {
+			t.Fatalf("%v: got allowed %v", tt, allowed)
+		}
+		if tt.allowed && (len(lines) != 1 || lines[0] != tt.line) {
+			t.Fatalf("%v: unexpected policies %v", tt, lines)
+		}
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	if !IsPublic(ConfigurationDomain, "/public/site") {
+		t.Fatal("expected public configuration group")
+	}
+	if IsPublic(ApiDomain, "/public/site") {
+		t.Fatal("api paths are never public")
+	}
+}
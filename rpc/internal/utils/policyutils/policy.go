@@ -0,0 +1,194 @@
+package policyutils
+
+import (
+	"context"
+	"sort"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// ApiDomain 接口策略所在的 Casbin 域，策略格式: [roleCode, "api", path, method]
+const ApiDomain = "api"
+
+// 不一致项类型
+const (
+	MismatchMissingApi  = "missingApi"
+	MismatchMissingMenu = "missingMenu"
+	MismatchUnknownApi  = "unknownApi"
+)
+
+// Key 接口的唯一标识
+func Key(path, method string) string {
+	return method + " " + path
+}
+
+// DerivedApis 获取角色已分配菜单绑定的API
+func DerivedApis(ctx context.Context, db *ent.Client, roleID uint32) ([]*ent.API, error) {
+	return db.API.Query().
+		Where(api.HasMenusWith(menu.HasRolesWith(role.IDEQ(roleID)))).
+		All(ctx)
+}
+
+// AdoptManual 将角色现有接口策略中非菜单派生的API记为手动分配
+// 修改角色菜单或菜单绑定的API前调用，保证手动授予的API在重建策略后仍然保留
+func AdoptManual(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, r *ent.Role) error {
+	policies, err := cbn.GetFilteredPolicy(0, r.RoleCode, ApiDomain)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+
+	derived, err := DerivedApis(ctx, db, r.ID)
+	if err != nil {
+		return err
+	}
+	manual, err := db.Role.QueryApis(r).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	skip := make(map[string]struct{}, len(derived))
+	for _, a := range derived {
+		skip[Key(a.Path, a.Method)] = struct{}{}
+	}
+	known := make(map[uint32]struct{}, len(manual))
+	for _, id := range manual {
+		known[id] = struct{}{}
+	}
+
+	granted := make(map[string]struct{}, len(policies))
+	var paths []string
+	for _, p := range policies {
+		if len(p) < 4 {
+			continue
+		}
+		if _, ok := skip[Key(p[2], p[3])]; ok {
+			continue
+		}
+		granted[Key(p[2], p[3])] = struct{}{}
+		paths = append(paths, p[2])
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	apis, err := db.API.Query().Where(api.PathIn(paths...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	var adopt []uint32
+	for _, a := range apis {
+		if _, ok := granted[Key(a.Path, a.Method)]; !ok {
+			continue
+		}
+		if _, ok := known[a.ID]; !ok {
+			adopt = append(adopt, a.ID)
+		}
+	}
+	if len(adopt) == 0 {
+		return nil
+	}
+	return db.Role.UpdateOneID(r.ID).AddAPIIDs(adopt...).Exec(ctx)
+}
+
+// Sync 按手动分配及菜单派生API的并集重建角色的接口策略，不影响其他域的策略
+func Sync(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, r *ent.Role) error {
+	manual, err := db.Role.QueryApis(r).All(ctx)
+	if err != nil {
+		return err
+	}
+	derived, err := DerivedApis(ctx, db, r.ID)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(manual)+len(derived))
+	var policies [][]string
+	for _, a := range append(manual, derived...) {
+		key := Key(a.Path, a.Method)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		policies = append(policies, []string{r.RoleCode, ApiDomain, a.Path, a.Method})
+	}
+
+	if _, err = cbn.RemoveFilteredPolicy(0, r.RoleCode, ApiDomain); err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	_, err = cbn.AddPolicies(policies)
+	return err
+}
+
+// Check 对比角色的接口策略与菜单，返回不一致项，apis 为已加载菜单关联的全部API
+// 已授权菜单依赖的API缺少策略、已授权API所属菜单均未授权、策略对应的API不存在均视为不一致
+func Check(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, r *ent.Role, apis []*ent.API) ([]*core.RoleApiMismatch, error) {
+	policies, err := cbn.GetFilteredPolicy(0, r.RoleCode, ApiDomain)
+	if err != nil {
+		return nil, err
+	}
+	granted := make(map[string]struct{}, len(policies))
+	for _, p := range policies {
+		if len(p) >= 4 {
+			granted[Key(p[2], p[3])] = struct{}{}
+		}
+	}
+
+	menuIDs, err := db.Role.QueryMenus(r).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[uint32]struct{}, len(menuIDs))
+	for _, id := range menuIDs {
+		owned[id] = struct{}{}
+	}
+
+	var mismatches []*core.RoleApiMismatch
+	known := make(map[string]struct{}, len(apis))
+	for _, a := range apis {
+		key := Key(a.Path, a.Method)
+		known[key] = struct{}{}
+		if len(a.Edges.Menus) == 0 {
+			continue
+		}
+
+		var ownedMenus, allMenus []uint32
+		for _, m := range a.Edges.Menus {
+			allMenus = append(allMenus, m.ID)
+			if _, ok := owned[m.ID]; ok {
+				ownedMenus = append(ownedMenus, m.ID)
+			}
+		}
+		_, ok := granted[key]
+		switch {
+		case len(ownedMenus) > 0 && !ok:
+			mismatches = append(mismatches, mismatch(MismatchMissingApi, a.Path, a.Method, &a.ID, ownedMenus))
+		case len(ownedMenus) == 0 && ok:
+			mismatches = append(mismatches, mismatch(MismatchMissingMenu, a.Path, a.Method, &a.ID, allMenus))
+		}
+	}
+
+	for _, p := range policies {
+		if len(p) < 4 {
+			continue
+		}
+		if _, ok := known[Key(p[2], p[3])]; !ok {
+			mismatches = append(mismatches, mismatch(MismatchUnknownApi, p[2], p[3], nil, nil))
+		}
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool {
+		return Key(mismatches[i].Path, mismatches[i].Method) < Key(mismatches[j].Path, mismatches[j].Method)
+	})
+	return mismatches, nil
+}
+
+func mismatch(kind, path, method string, apiID *uint32, menuIDs []uint32) *core.RoleApiMismatch {
+	return &core.RoleApiMismatch{Type: kind, Path: path, Method: method, ApiId: apiID, MenuIds: menuIDs}
+}
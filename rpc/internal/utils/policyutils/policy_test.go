@@ -0,0 +1,193 @@
+package policyutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/enttest"
+)
+
+// policyFixture 角色 ops 持有菜单 users(绑定 GET /user/list、POST /user/create)，
+// 另有未分配的菜单 audit(绑定 GET /audit)，接口策略包含菜单派生、手动授予及已删除的API
+type policyFixture struct {
+	db   *ent.Client
+	cbn  *casbin.Enforcer
+	role *ent.Role
+	apis map[string]*ent.API
+}
+
+func newPolicyFixture(t *testing.T) *policyFixture {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_")))
+	t.Cleanup(func() { _ = db.Close() })
+
+	f := &policyFixture{db: db, cbn: newTestEnforcer(t), apis: map[string]*ent.API{}}
+	for _, key := range []string{"GET /user/list", "POST /user/create", "GET /report", "GET /audit", "POST /unused"} {
+		method, path, _ := strings.Cut(key, " ")
+		f.apis[key] = db.API.Create().SetMethod(method).SetPath(path).SetServiceName("core").SetAPIGroup("test").SaveX(ctx)
+	}
+	users := db.Menu.Create().SetMenuCode("users").SetMenuName("users").SetMenuType("menu").
+		AddApis(f.apis["GET /user/list"], f.apis["POST /user/create"]).SaveX(ctx)
+	db.Menu.Create().SetMenuCode("audit").SetMenuName("audit").SetMenuType("menu").
+		AddApis(f.apis["GET /audit"]).SaveX(ctx)
+	f.role = db.Role.Create().SetRoleCode("ops").SetRoleName("ops").AddMenus(users).SaveX(ctx)
+
+	if _, err := f.cbn.RemoveFilteredPolicy(0, "ops", ApiDomain); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.cbn.AddPolicies([][]string{
+		{"ops", ApiDomain, "/user/list", "GET"},
+		{"ops", ApiDomain, "/report", "GET"},
+		{"ops", ApiDomain, "/audit", "GET"},
+		{"ops", ApiDomain, "/gone", "DELETE"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func (f *policyFixture) apiPolicies(t *testing.T) []string {
+	t.Helper()
+	policies, err := f.cbn.GetFilteredPolicy(0, "ops", ApiDomain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, p := range policies {
+		keys = append(keys, Key(p[2], p[3]))
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (f *policyFixture) manualApis(t *testing.T) []string {
+	t.Helper()
+	apis := f.db.Role.QueryApis(f.role).AllX(context.Background())
+	var keys []string
+	for _, a := range apis {
+		keys = append(keys, Key(a.Path, a.Method))
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func TestAdoptManual(t *testing.T) {
+	f := newPolicyFixture(t)
+	if err := AdoptManual(context.Background(), f.db, f.cbn, f.role); err != nil {
+		t.Fatal(err)
+	}
+	// 菜单派生的API及已删除的API不记为手动分配
+	if got, want := f.manualApis(t), []string{"GET /audit", "GET /report"}; !slices.Equal(got, want) {
+		t.Fatalf("manual apis = %v, want %v", got, want)
+	}
+}
+
+func TestSync(t *testing.T) {
+	f := newPolicyFixture(t)
+	ctx := context.Background()
+	f.db.Role.UpdateOne(f.role).AddApis(f.apis["GET /report"]).ExecX(ctx)
+
+	if err := Sync(ctx, f.db, f.cbn, f.role); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"GET /report", "GET /user/list", "POST /user/create"}
+	if got := f.apiPolicies(t); !slices.Equal(got, want) {
+		t.Fatalf("api policies = %v, want %v", got, want)
+	}
+	if ok, _ := f.cbn.HasPolicy("ops", ConfigurationDomain, "/system/mail", "read"); !ok {
+		t.Fatal("policies of other domains must be kept")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	f := newPolicyFixture(t)
+	apis := f.db.API.Query().WithMenus().AllX(context.Background())
+
+	mismatches, err := Check(context.Background(), f.db, f.cbn, f.role, apis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range mismatches {
+		got = append(got, m.Type+" "+Key(m.Path, m.Method))
+	}
+	want := []string{
+		MismatchUnknownApi + " DELETE /gone",
+		MismatchMissingMenu + " GET /audit",
+		MismatchMissingApi + " POST /user/create",
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("mismatches = %v, want %v", got, want)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	f := newPolicyFixture(t)
+	ctx := context.Background()
+	before := f.apiPolicies(t)
+
+	// 变更失败时回滚手动分配的记录，策略保持不变
+	fail := errors.New("fail")
+	err := Update(ctx, f.db, f.cbn, []*ent.Role{f.role}, func(tx *ent.Tx) error {
+		if err := tx.Role.UpdateOne(f.role).ClearMenus().Exec(ctx); err != nil {
+			return err
+		}
+		return fail
+	})
+	if !errors.Is(err, fail) {
+		t.Fatalf("Update error = %v, want %v", err, fail)
+	}
+	if got := f.manualApis(t); len(got) != 0 {
+		t.Fatalf("adopted apis must be rolled back, got %v", got)
+	}
+	if got := f.apiPolicies(t); !slices.Equal(got, before) {
+		t.Fatalf("api policies = %v, want %v", got, before)
+	}
+
+	// 移除菜单后仅保留手动授予且存在的API
+	err = Update(ctx, f.db, f.cbn, []*ent.Role{f.role}, func(tx *ent.Tx) error {
+		return tx.Role.UpdateOne(f.role).ClearMenus().Exec(ctx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.apiPolicies(t), []string{"GET /audit", "GET /report"}; !slices.Equal(got, want) {
+		t.Fatalf("api policies = %v, want %v", got, want)
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	cbn := newTestEnforcer(t)
+	backup := make(Backup)
+	if err := backup.Save(cbn, "ops"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cbn.RemoveFilteredPolicy(0, "ops"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cbn.AddPolicy("ops", ApiDomain, "/other", "GET"); err != nil {
+		t.Fatal(err)
+	}
+	// 重复保存不覆盖首次保存的策略
+	if err := backup.Save(cbn, "ops"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := backup.Restore(cbn); err != nil {
+		t.Fatal(err)
+	}
+	policies, _ := cbn.GetFilteredPolicy(0, "ops")
+	if len(policies) != 2 {
+		t.Fatalf("restored policies = %v", policies)
+	}
+	if ok, _ := cbn.HasPolicy("ops", ApiDomain, "/other", "GET"); ok {
+		t.Fatal("policies added after backup must be removed")
+	}
+}
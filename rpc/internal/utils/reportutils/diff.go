@@ -0,0 +1,52 @@
+package reportutils
+
+import (
+	"strings"
+
+	"github.com/wenpiner/last-admin-core/rpc/ent/schema"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// Diff 对比两组授权记录，按类型、角色、资源及操作识别记录，返回新增及移除的记录
+func Diff(from, to []*core.PermissionEntry) (added, removed []*core.PermissionEntry) {
+	before := make(map[string]struct{}, len(from))
+	for _, e := range from {
+		before[entryKey(e)] = struct{}{}
+	}
+	after := make(map[string]struct{}, len(to))
+	for _, e := range to {
+		key := entryKey(e)
+		after[key] = struct{}{}
+		if _, ok := before[key]; !ok {
+			added = append(added, e)
+		}
+	}
+	for _, e := range from {
+		if _, ok := after[entryKey(e)]; !ok {
+			removed = append(removed, e)
+		}
+	}
+	return added, removed
+}
+
+// ToSchema 转换为快照中存储的记录
+func ToSchema(entries []*core.PermissionEntry) []schema.PermissionEntry {
+	result := make([]schema.PermissionEntry, len(entries))
+	for i, e := range entries {
+		result[i] = schema.PermissionEntry{Kind: e.Kind, RoleValue: e.RoleValue, Object: e.Object, Action: e.Action, Label: e.Label, Detail: e.Detail}
+	}
+	return result
+}
+
+// FromSchema 转换快照中存储的记录
+func FromSchema(entries []schema.PermissionEntry) []*core.PermissionEntry {
+	result := make([]*core.PermissionEntry, len(entries))
+	for i, e := range entries {
+		result[i] = &core.PermissionEntry{Kind: e.Kind, RoleValue: e.RoleValue, Object: e.Object, Action: e.Action, Label: e.Label, Detail: e.Detail}
+	}
+	return result
+}
+
+func entryKey(e *core.PermissionEntry) string {
+	return strings.Join([]string{e.Kind, e.RoleValue, e.Object, e.Action}, "\x00")
+}
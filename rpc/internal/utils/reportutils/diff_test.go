@@ -0,0 +1,58 @@
+package reportutils
+
+import (
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestDiff(t *testing.T) {
+	from := []*core.PermissionEntry{
+		{Kind: EntryApi, RoleValue: "ops", Object: "/user/delete", Action: "POST", Detail: ApiSourceManual},
+		{Kind: EntryUser, RoleValue: "ops", Object: "alice"},
+	}
+	to := []*core.PermissionEntry{
+		{Kind: EntryApi, RoleValue: "ops", Object: "/user/delete", Action: "POST", Detail: ApiSourceMenu},
+		{Kind: EntryUser, RoleValue: "ops", Object: "bob"},
+	}
+
+	added, removed := Diff(from, to)
+	if len(added) != 1 || added[0].Object != "bob" {
+		t.Fatalf("unexpected added entries: %v", added)
+	}
+	if len(removed) != 1 || removed[0].Object != "alice" {
+		t.Fatalf("unexpected removed entries: %v", removed)
+	}
+
+	restored := FromSchema(ToSchema(to))
+	if added, removed = Diff(to, restored); len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("stored entries must round trip: %v %v", added, removed)
+	}
+}
+
+func TestUserEntries(t *testing.T) {
+	users := []*userutils.UserRoles{
+		{
+			User: &ent.User{Username: "alice", State: true},
+			Roles: []*core.EffectiveRoleInfo{
+				{RoleValue: "ops", Sources: []*core.RoleSource{{Type: userutils.RoleSourceDirect}, {Type: userutils.RoleSourcePosition, Name: pointer.ToStringPtr("SRE")}}},
+				{RoleValue: "viewer", Sources: []*core.RoleSource{{Type: userutils.RoleSourceDirect}}},
+			},
+		},
+		{
+			User:  &ent.User{Username: "bob"},
+			Roles: []*core.EffectiveRoleInfo{{RoleValue: "ops"}},
+		},
+	}
+
+	entries := UserEntries(users, map[string]struct{}{"ops": {}})
+	if len(entries) != 1 || entries[0].Object != "alice" || entries[0].Detail != "direct; position:SRE" {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+	if entries = UserEntries(users, nil); len(entries) != 2 {
+		t.Fatalf("expected all roles of enabled users: %v", entries)
+	}
+}
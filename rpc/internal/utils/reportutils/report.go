@@ -0,0 +1,229 @@
+package reportutils
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/api"
+	"github.com/wenpiner/last-admin-core/rpc/ent/menu"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/policyutils"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/userutils"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// 授权记录类型
+const (
+	EntryMenu          = "menu"
+	EntryButton        = "button"
+	EntryApi           = "api"
+	EntryConfiguration = "configuration"
+	EntryRole          = "role"
+	EntryUser          = "user"
+)
+
+// 接口的授权来源
+const (
+	ApiSourceMenu   = "menu"
+	ApiSourceManual = "manual"
+	ApiSourcePolicy = "policy"
+)
+
+// RoleEntries 汇总角色的菜单、按钮、接口及配置项权限
+// 接口按 Casbin 策略统计，detail 为授权来源：menu 菜单派生，manual 手动分配，policy 仅存在策略
+func RoleEntries(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, r *ent.Role) ([]*core.PermissionEntry, error) {
+	var entries []*core.PermissionEntry
+
+	menus, err := db.Role.QueryMenus(r).Order(menu.BySort(), menu.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range menus {
+		e := &core.PermissionEntry{Kind: EntryMenu, RoleValue: r.RoleCode, Object: m.MenuCode, Label: m.MenuName}
+		if m.MenuType == EntryButton {
+			e.Kind = EntryButton
+			e.Detail = pointer.GetString(m.Permission)
+		} else {
+			e.Detail = pointer.GetString(m.MenuPath)
+		}
+		entries = append(entries, e)
+	}
+
+	apiEntries, err := apiEntries(ctx, db, cbn, r)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, apiEntries...)
+
+	policies, err := cbn.GetFilteredPolicy(0, r.RoleCode, policyutils.ConfigurationDomain)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if len(p) >= 4 {
+			entries = append(entries, &core.PermissionEntry{Kind: EntryConfiguration, RoleValue: r.RoleCode, Object: p[2], Action: p[3]})
+		}
+	}
+	return entries, nil
+}
+
+func apiEntries(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, r *ent.Role) ([]*core.PermissionEntry, error) {
+	policies, err := cbn.GetFilteredPolicy(0, r.RoleCode, policyutils.ApiDomain)
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+
+	derived, err := policyutils.DerivedApis(ctx, db, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	manual, err := db.Role.QueryApis(r).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sources := make(map[string]string, len(derived)+len(manual))
+	for _, a := range manual {
+		sources[policyutils.Key(a.Path, a.Method)] = ApiSourceManual
+	}
+	for _, a := range derived {
+		sources[policyutils.Key(a.Path, a.Method)] = ApiSourceMenu
+	}
+
+	paths := make([]string, 0, len(policies))
+	for _, p := range policies {
+		if len(p) >= 4 {
+			paths = append(paths, p[2])
+		}
+	}
+	apis, err := db.API.Query().Where(api.PathIn(paths...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(apis))
+	for _, a := range apis {
+		names[policyutils.Key(a.Path, a.Method)] = pointer.GetString(a.Name)
+	}
+
+	entries := make([]*core.PermissionEntry, 0, len(paths))
+	for _, p := range policies {
+		if len(p) < 4 {
+			continue
+		}
+		key := policyutils.Key(p[2], p[3])
+		source, ok := sources[key]
+		if !ok {
+			source = ApiSourcePolicy
+		}
+		entries = append(entries, &core.PermissionEntry{Kind: EntryApi, RoleValue: r.RoleCode, Object: p[2], Action: p[3], Label: names[key], Detail: source})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entryKey(entries[i]) < entryKey(entries[j])
+	})
+	return entries, nil
+}
+
+// UserEntries 生成持有角色的用户记录，include 为空时包含全部角色，已禁用的用户不计入
+func UserEntries(users []*userutils.UserRoles, include map[string]struct{}) []*core.PermissionEntry {
+	var entries []*core.PermissionEntry
+	for _, u := range users {
+		if !u.User.State {
+			continue
+		}
+		for _, r := range u.Roles {
+			if _, ok := include[r.RoleValue]; include != nil && !ok {
+				continue
+			}
+			entries = append(entries, &core.PermissionEntry{
+				Kind:      EntryUser,
+				RoleValue: r.RoleValue,
+				Object:    u.User.Username,
+				Label:     u.User.FullName,
+				Detail:    sourceDetail(r.Sources),
+			})
+		}
+	}
+	return entries
+}
+
+// Holders 获取持有接口、菜单或配置项权限的启用角色及通过这些角色持有权限的用户
+func Holders(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer, kind, object, action string) ([]*core.PermissionEntry, error) {
+	query := db.Role.Query().Where(role.StateEQ(true)).Order(role.ByID())
+	if kind == EntryMenu {
+		query = query.Where(role.HasMenusWith(menu.MenuCodeEQ(object)))
+	}
+	roles, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*core.PermissionEntry
+	include := make(map[string]struct{})
+	for _, r := range roles {
+		var detail string
+		if kind != EntryMenu {
+			allowed, lines, err := policyutils.Decide(cbn, r.RoleCode, kind, object, action)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				continue
+			}
+			detail = strings.Join(lines, "; ")
+		}
+		include[r.RoleCode] = struct{}{}
+		entries = append(entries, &core.PermissionEntry{Kind: EntryRole, RoleValue: r.RoleCode, Object: object, Action: action, Label: r.RoleName, Detail: detail})
+	}
+	if len(include) == 0 {
+		return entries, nil
+	}
+
+	users, err := userutils.AllEffectiveRoles(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return append(entries, UserEntries(users, include)...), nil
+}
+
+// Snapshot 汇总全部启用角色的权限及持有用户，按记录标识排序
+func Snapshot(ctx context.Context, db *ent.Client, cbn *casbin.Enforcer) ([]*core.PermissionEntry, error) {
+	roles, err := db.Role.Query().Where(role.StateEQ(true)).Order(role.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*core.PermissionEntry
+	for _, r := range roles {
+		roleEntries, err := RoleEntries(ctx, db, cbn, r)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, roleEntries...)
+	}
+
+	users, err := userutils.AllEffectiveRoles(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, UserEntries(users, nil)...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entryKey(entries[i]) < entryKey(entries[j])
+	})
+	return entries, nil
+}
+
+// sourceDetail 描述角色来源，如 direct; position:研发岗
+func sourceDetail(sources []*core.RoleSource) string {
+	parts := make([]string, 0, len(sources))
+	for _, s := range sources {
+		if s.Name != nil {
+			parts = append(parts, s.Type+":"+*s.Name)
+		} else {
+			parts = append(parts, s.Type)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
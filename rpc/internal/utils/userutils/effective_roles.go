@@ -0,0 +1,167 @@
+package userutils
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/ent/department"
+	"github.com/wenpiner/last-admin-core/rpc/ent/position"
+	"github.com/wenpiner/last-admin-core/rpc/ent/role"
+	"github.com/wenpiner/last-admin-core/rpc/ent/user"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// 角色来源类型
+const (
+	RoleSourceDirect     = "direct"
+	RoleSourcePosition   = "position"
+	RoleSourceDepartment = "department"
+	RoleSourceInherited  = "inherited"
+)
+
+// maxDepartmentDepth 向上查找部门的最大层级，防止异常数据形成环
+const maxDepartmentDepth = 32
+
+// EffectiveRoles 计算用户的有效角色，为直接分配、所属岗位、所在部门及开启继承的上级部门角色的并集
+// 仅统计启用的角色、岗位及部门，禁用的用户没有有效角色，每个角色附带全部来源，登录、菜单及接口鉴权均以此为准
+func EffectiveRoles(ctx context.Context, db *ent.Client, userID uuid.UUID) ([]*core.EffectiveRoleInfo, error) {
+	u, err := withRoleEdges(db.User.Query().Where(user.IDEQ(userID))).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveRoles(u, func(id uint32) (*ent.Department, error) {
+		dept, err := db.Department.Query().
+			Where(department.IDEQ(id)).
+			WithRoles(enabledRoles).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return dept, err
+	})
+}
+
+// UserRoles 用户及其有效角色
+type UserRoles struct {
+	User  *ent.User
+	Roles []*core.EffectiveRoleInfo
+}
+
+// AllEffectiveRoles 批量计算全部用户的有效角色，部门一次性加载，用于权限报表等全量统计
+func AllEffectiveRoles(ctx context.Context, db *ent.Client) ([]*UserRoles, error) {
+	users, err := withRoleEdges(db.User.Query().Order(user.ByUsername())).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	depts, err := db.Department.Query().WithRoles(enabledRoles).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deptIndex := make(map[uint32]*ent.Department, len(depts))
+	for _, d := range depts {
+		deptIndex[d.ID] = d
+	}
+
+	result := make([]*UserRoles, 0, len(users))
+	for _, u := range users {
+		roles, _ := resolveRoles(u, func(id uint32) (*ent.Department, error) {
+			return deptIndex[id], nil
+		})
+		result = append(result, &UserRoles{User: u, Roles: roles})
+	}
+	return result, nil
+}
+
+func withRoleEdges(q *ent.UserQuery) *ent.UserQuery {
+	return q.WithRoles(enabledRoles).
+		WithPositions(func(q *ent.PositionQuery) {
+			q.Where(position.StateEQ(true)).WithRoles(enabledRoles)
+		})
+}
+
+// resolveRoles 按已加载的直接角色、岗位角色及部门计算有效角色，dept 返回 nil 表示部门不存在
+func resolveRoles(u *ent.User, dept func(id uint32) (*ent.Department, error)) ([]*core.EffectiveRoleInfo, error) {
+	if !u.State {
+		return nil, nil
+	}
+	c := &roleCollector{index: make(map[uint32]*core.EffectiveRoleInfo)}
+	for _, r := range u.Edges.Roles {
+		c.add(r, &core.RoleSource{Type: RoleSourceDirect})
+	}
+	for _, p := range u.Edges.Positions {
+		for _, r := range p.Edges.Roles {
+			c.add(r, &core.RoleSource{Type: RoleSourcePosition, Id: pointer.ToUint32Ptr(p.ID), Name: pointer.ToStringPtr(p.PositionName)})
+		}
+	}
+
+	// 所在部门的角色直接生效，上级部门仅在开启继承时生效
+	deptID := u.DepartmentID
+	visited := make(map[uint32]struct{})
+	for depth := 0; deptID != 0 && depth < maxDepartmentDepth; depth++ {
+		if _, ok := visited[deptID]; ok {
+			break
+		}
+		visited[deptID] = struct{}{}
+
+		d, err := dept(deptID)
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			break
+		}
+
+		source := RoleSourceInherited
+		if depth == 0 {
+			source = RoleSourceDepartment
+		}
+		if d.State && (depth == 0 || d.InheritRoles) {
+			for _, r := range d.Edges.Roles {
+				c.add(r, &core.RoleSource{Type: source, Id: pointer.ToUint32Ptr(d.ID), Name: pointer.ToStringPtr(d.DeptName)})
+			}
+		}
+		deptID = pointer.GetUint32(d.ParentID)
+	}
+	return c.list, nil
+}
+
+// EffectiveRoleValues 获取用户有效角色值
+func EffectiveRoleValues(ctx context.Context, db *ent.Client, userID uuid.UUID) ([]string, error) {
+	roles, err := EffectiveRoles(ctx, db, userID)
+	if err != nil {
+		return nil, err
+	}
+	return RoleValues(roles), nil
+}
+
+// RoleValues 提取有效角色值
+func RoleValues(roles []*core.EffectiveRoleInfo) []string {
+	values := make([]string, len(roles))
+	for i, r := range roles {
+		values[i] = r.RoleValue
+	}
+	return values
+}
+
+func enabledRoles(q *ent.RoleQuery) {
+	q.Where(role.StateEQ(true))
+}
+
+// roleCollector 按首次出现顺序合并角色，同一角色累积来源
+type roleCollector struct {
+	list  []*core.EffectiveRoleInfo
+	index map[uint32]*core.EffectiveRoleInfo
+}
+
+func (c *roleCollector) add(r *ent.Role, source *core.RoleSource) {
+	info, ok := c.index[r.ID]
+	if !ok {
+		info = &core.EffectiveRoleInfo{RoleId: r.ID, RoleValue: r.RoleCode, RoleName: r.RoleName}
+		c.index[r.ID] = info
+		c.list = append(c.list, info)
+	}
+	info.Sources = append(info.Sources, source)
+}
@@ -0,0 +1,64 @@
+package userutils
+
+import (
+	"testing"
+
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+func TestRoleCollector(t *testing.T) {
+	admin := &ent.Role{ID: 1, RoleCode: "admin", RoleName: "管理员"}
+	editor := &ent.Role{ID: 2, RoleCode: "editor", RoleName: "编辑"}
+
+	c := &roleCollector{index: make(map[uint32]*core.EffectiveRoleInfo)}
+	c.add(editor, &core.RoleSource{Type: RoleSourceDirect})
+	c.add(admin, &core.RoleSource{Type: RoleSourcePosition})
+	c.add(editor, &core.RoleSource{Type: RoleSourceInherited})
+
+	values := RoleValues(c.list)
+	if len(values) != 2 || values[0] != "editor" || values[1] != "admin" {
+		t.Fatalf("unexpected role values: %v", values)
+	}
+	sources := c.list[0].Sources
+	if len(sources) != 2 || sources[0].Type != RoleSourceDirect || sources[1].Type != RoleSourceInherited {
+		t.Fatalf("unexpected sources: %v", sources)
+	}
+}
+
+func TestResolveRoles(t *testing.T) {
+	viewer := &ent.Role{ID: 3, RoleCode: "viewer"}
+	auditor := &ent.Role{ID: 4, RoleCode: "auditor"}
+	ops := &ent.Role{ID: 5, RoleCode: "ops"}
+
+	depts := map[uint32]*ent.Department{
+		1: {ID: 1, State: true, InheritRoles: true, Edges: ent.DepartmentEdges{Roles: []*ent.Role{viewer}}},
+		2: {ID: 2, State: true, ParentID: pointer.ToUint32Ptr(1), Edges: ent.DepartmentEdges{Roles: []*ent.Role{auditor}}},
+		3: {ID: 3, State: true, ParentID: pointer.ToUint32Ptr(2), Edges: ent.DepartmentEdges{Roles: []*ent.Role{ops}}},
+	}
+	u := &ent.User{State: true, DepartmentID: 3}
+
+	roles, err := resolveRoles(u, func(id uint32) (*ent.Department, error) {
+		return depts[id], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 上级部门 2 未开启继承，其角色不生效；根部门开启继承，角色传递至下级
+	values := RoleValues(roles)
+	if len(values) != 2 || values[0] != "ops" || values[1] != "viewer" {
+		t.Fatalf("unexpected role values: %v", values)
+	}
+	if roles[0].Sources[0].Type != RoleSourceDepartment || roles[1].Sources[0].Type != RoleSourceInherited {
+		t.Fatalf("unexpected sources: %v", roles)
+	}
+}
+
+func TestResolveRolesDisabledUser(t *testing.T) {
+	u := &ent.User{Edges: ent.UserEdges{Roles: []*ent.Role{{ID: 1, RoleCode: "admin"}}}}
+	roles, err := resolveRoles(u, func(uint32) (*ent.Department, error) { return nil, nil })
+	if err != nil || len(roles) != 0 {
+		t.Fatalf("disabled user must have no roles, got %v %v", roles, err)
+	}
+}
@@ -1,8 +1,11 @@
 package userutils
 
 import (
+	"time"
+
 	"github.com/wenpiner/last-admin-common/utils/pointer"
 	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/passwordutils"
 	"github.com/wenpiner/last-admin-core/rpc/types/core"
 )
 
@@ -11,20 +14,22 @@ import (
 func ConvertUserToUserInfo(user *ent.User) *core.UserInfo {
 
 	userInfo := &core.UserInfo{
-		Id:              pointer.ToStringPtr(user.ID.String()),
-		CreatedAt:       pointer.ToInt64Ptr(user.CreatedAt.UnixMilli()),
-		UpdatedAt:       pointer.ToInt64Ptr(user.UpdatedAt.UnixMilli()),
-		Username:        &user.Username,
-		Email:           pointer.ToStringPtrIfNotEmpty(user.Email),
-		FullName:        pointer.ToStringPtrIfNotEmpty(user.FullName),
-		Mobile:          pointer.ToStringPtrIfNotEmpty(user.Mobile),
-		Avatar:          pointer.ToStringPtrIfNotEmpty(user.Avatar),
-		UserDescription: pointer.ToStringPtrIfNotEmpty(user.UserDescription),
-		LastLoginIp:     pointer.ToStringPtrIfNotEmpty(user.LastLoginIP),
-		State:           &user.State,
-		DepartmentId:    pointer.ToUint32PtrIfNotZero(user.DepartmentID),
-		PasswordHash:    pointer.ToStringPtrIfNotEmpty(user.PasswordHash),
-		HomePath:        pointer.ToStringPtrIfNotEmpty(user.HomePath),
+		Id:                 pointer.ToStringPtr(user.ID.String()),
+		CreatedAt:          pointer.ToInt64Ptr(user.CreatedAt.UnixMilli()),
+		UpdatedAt:          pointer.ToInt64Ptr(user.UpdatedAt.UnixMilli()),
+		Username:           &user.Username,
+		Email:              pointer.ToStringPtrIfNotEmpty(user.Email),
+		FullName:           pointer.ToStringPtrIfNotEmpty(user.FullName),
+		Mobile:             pointer.ToStringPtrIfNotEmpty(user.Mobile),
+		Avatar:             pointer.ToStringPtrIfNotEmpty(user.Avatar),
+		UserDescription:    pointer.ToStringPtrIfNotEmpty(user.UserDescription),
+		LastLoginIp:        pointer.ToStringPtrIfNotEmpty(user.LastLoginIP),
+		State:              &user.State,
+		DepartmentId:       pointer.ToUint32PtrIfNotZero(user.DepartmentID),
+		PasswordHash:       pointer.ToStringPtrIfNotEmpty(user.PasswordHash),
+		HomePath:           pointer.ToStringPtrIfNotEmpty(user.HomePath),
+		MustChangePassword: pointer.ToBoolPtr(user.MustChangePassword),
+		Language:           user.Language,
 	}
 
 	// 设置最后登录时间
@@ -32,6 +37,11 @@ func ConvertUserToUserInfo(user *ent.User) *core.UserInfo {
 		userInfo.LastLoginAt = pointer.ToInt64Ptr(user.LastLoginAt.UnixMilli())
 	}
 
+	// 设置密码修改时间
+	if user.PasswordChangedAt != nil {
+		userInfo.PasswordChangedAt = pointer.ToInt64Ptr(user.PasswordChangedAt.UnixMilli())
+	}
+
 	// 提取角色ID和角色值
 	if user.Edges.Roles != nil {
 		roleIDs := make([]uint32, len(user.Edges.Roles))
@@ -67,13 +77,13 @@ func ConvertUserToUserInfo(user *ent.User) *core.UserInfo {
 	// 设置TOTP信息
 	if user.Edges.Totp != nil {
 		totpInfo := &core.TotpInfo{
-			Id:           pointer.ToStringPtr(user.Edges.Totp.ID.String()),
-			CreatedAt:    pointer.ToInt64Ptr(user.Edges.Totp.CreatedAt.UnixMilli()),
-			UpdatedAt:    pointer.ToInt64Ptr(user.Edges.Totp.UpdatedAt.UnixMilli()),
-			State:        pointer.ToBoolPtr(user.Edges.Totp.State),
-			IsVerified:   pointer.ToBoolPtr(user.Edges.Totp.IsVerified),
-			DeviceName:   user.Edges.Totp.DeviceName,
-			Issuer:       user.Edges.Totp.Issuer,
+			Id:         pointer.ToStringPtr(user.Edges.Totp.ID.String()),
+			CreatedAt:  pointer.ToInt64Ptr(user.Edges.Totp.CreatedAt.UnixMilli()),
+			UpdatedAt:  pointer.ToInt64Ptr(user.Edges.Totp.UpdatedAt.UnixMilli()),
+			State:      pointer.ToBoolPtr(user.Edges.Totp.State),
+			IsVerified: pointer.ToBoolPtr(user.Edges.Totp.IsVerified),
+			DeviceName: user.Edges.Totp.DeviceName,
+			Issuer:     user.Edges.Totp.Issuer,
 		}
 		if user.Edges.Totp.LastUsedAt != nil {
 			totpInfo.LastUsedAt = pointer.ToInt64Ptr(user.Edges.Totp.LastUsedAt.UnixMilli())
@@ -84,5 +94,26 @@ func ConvertUserToUserInfo(user *ent.User) *core.UserInfo {
 		userInfo.TotpInfo = totpInfo
 	}
 
+	// 统计已启用的WebAuthn凭证
+	if user.Edges.WebauthnCredentials != nil {
+		var count uint32
+		for _, credential := range user.Edges.WebauthnCredentials {
+			if credential.State {
+				count++
+			}
+		}
+		userInfo.WebauthnCount = &count
+	}
+
 	return userInfo
 }
+
+// FillPasswordExpired 根据密码策略计算密码是否过期，未修改过密码的用户以创建时间为准
+func FillPasswordExpired(info *core.UserInfo, u *ent.User, policy passwordutils.Policy) {
+	changedAt := u.CreatedAt
+	if u.PasswordChangedAt != nil {
+		changedAt = *u.PasswordChangedAt
+	}
+	expired := policy.Expired(changedAt, time.Now())
+	info.PasswordExpired = &expired
+}
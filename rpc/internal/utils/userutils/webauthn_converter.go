@@ -0,0 +1,31 @@
+package userutils
+
+import (
+	"github.com/wenpiner/last-admin-common/utils/pointer"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+	"github.com/wenpiner/last-admin-core/rpc/types/core"
+)
+
+// ConvertWebauthnToCredentialInfo 将 UserWebauthn 实体转换为 WebauthnCredentialInfo
+func ConvertWebauthnToCredentialInfo(credential *ent.UserWebauthn) *core.WebauthnCredentialInfo {
+	info := &core.WebauthnCredentialInfo{
+		Id:             pointer.ToUint32Ptr(credential.ID),
+		CreatedAt:      pointer.ToInt64Ptr(credential.CreatedAt.UnixMilli()),
+		UpdatedAt:      pointer.ToInt64Ptr(credential.UpdatedAt.UnixMilli()),
+		UserId:         pointer.ToStringPtr(credential.UserID.String()),
+		CredentialId:   pointer.ToStringPtr(credential.CredentialID),
+		FriendlyName:   pointer.ToStringPtrIfNotEmpty(credential.FriendlyName),
+		SignCount:      pointer.ToUint32Ptr(credential.SignCount),
+		Aaguid:         pointer.ToStringPtrIfNotEmpty(credential.Aaguid),
+		Transports:     credential.Transports,
+		Attachment:     pointer.ToStringPtrIfNotEmpty(credential.Attachment),
+		BackupEligible: pointer.ToBoolPtr(credential.BackupEligible),
+		BackupState:    pointer.ToBoolPtr(credential.BackupState),
+		CloneWarning:   pointer.ToBoolPtr(credential.CloneWarning),
+		State:          pointer.ToBoolPtr(credential.State),
+	}
+	if credential.LastUsedAt != nil {
+		info.LastUsedAt = pointer.ToInt64Ptr(credential.LastUsedAt.UnixMilli())
+	}
+	return info
+}
@@ -0,0 +1,167 @@
+package webauthnutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/errorx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// UserLoader 根据用户ID加载 WebAuthn 用户（含凭证）
+type UserLoader func(userID uuid.UUID) (*User, error)
+
+// Ceremony 封装 WebAuthn 注册与认证仪式，会话数据保存在 SessionStore 中
+type Ceremony struct {
+	webauthn *webauthn.WebAuthn
+	sessions SessionStore
+}
+
+func NewCeremony(config *webauthn.Config, sessions SessionStore) (*Ceremony, error) {
+	w, err := webauthn.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Ceremony{webauthn: w, sessions: sessions}, nil
+}
+
+// BeginRegistration 开始注册，返回会话ID及 PublicKeyCredentialCreationOptions
+func (c *Ceremony) BeginRegistration(ctx context.Context, user *User) (string, string, error) {
+	creation, session, err := c.webauthn.BeginRegistration(user,
+		webauthn.WithExclusions(webauthn.Credentials(user.Credentials).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementPreferred),
+	)
+	if err != nil {
+		logx.WithContext(ctx).Errorw("开始WebAuthn注册失败", logx.Field("detail", err.Error()))
+		return "", "", errorx.NewInternalError("webauthn.beginFailed")
+	}
+	return c.saveSession(ctx, session, creation, c.webauthn.Config.Timeouts.Registration.Timeout)
+}
+
+// FinishRegistration 校验注册响应并返回新凭证
+func (c *Ceremony) FinishRegistration(ctx context.Context, user *User, sessionID string, response []byte) (*webauthn.Credential, error) {
+	session, err := c.takeSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		logx.WithContext(ctx).Infow("解析WebAuthn注册响应失败", logx.Field("detail", protocolDetail(err)))
+		return nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+	}
+
+	credential, err := c.webauthn.CreateCredential(user, *session, parsed)
+	if err != nil {
+		logx.WithContext(ctx).Infow("WebAuthn注册校验失败", logx.Field("detail", protocolDetail(err)))
+		return nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+	}
+	return credential, nil
+}
+
+// BeginLogin 开始认证，user 为空时发起无用户名(Passkey)登录
+func (c *Ceremony) BeginLogin(ctx context.Context, user *User) (string, string, error) {
+	var (
+		assertion *protocol.CredentialAssertion
+		session   *webauthn.SessionData
+		err       error
+	)
+	if user == nil {
+		assertion, session, err = c.webauthn.BeginDiscoverableLogin()
+	} else {
+		assertion, session, err = c.webauthn.BeginLogin(user)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorw("开始WebAuthn登录失败", logx.Field("detail", err.Error()))
+		return "", "", errorx.NewInternalError("webauthn.beginFailed")
+	}
+	return c.saveSession(ctx, session, assertion, c.webauthn.Config.Timeouts.Login.Timeout)
+}
+
+// FinishLogin 校验认证响应，返回认证通过的用户及更新后的凭证
+func (c *Ceremony) FinishLogin(ctx context.Context, sessionID string, response []byte, loader UserLoader) (*User, *webauthn.Credential, error) {
+	session, err := c.takeSession(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		logx.WithContext(ctx).Infow("解析WebAuthn认证响应失败", logx.Field("detail", protocolDetail(err)))
+		return nil, nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+	}
+
+	var (
+		user       *User
+		credential *webauthn.Credential
+	)
+	if len(session.UserID) == 0 {
+		// 无用户名登录，通过 userHandle 定位用户
+		var found webauthn.User
+		found, credential, err = c.webauthn.ValidatePasskeyLogin(func(_, userHandle []byte) (webauthn.User, error) {
+			userID, err := uuid.FromBytes(userHandle)
+			if err != nil {
+				return nil, err
+			}
+			return loader(userID)
+		}, *session, parsed)
+		if err == nil {
+			user = found.(*User)
+		}
+	} else {
+		userID, parseErr := uuid.FromBytes(session.UserID)
+		if parseErr != nil {
+			return nil, nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+		}
+		if user, err = loader(userID); err != nil {
+			return nil, nil, err
+		}
+		credential, err = c.webauthn.ValidateLogin(user, *session, parsed)
+	}
+	if err != nil {
+		logx.WithContext(ctx).Infow("WebAuthn认证校验失败", logx.Field("detail", protocolDetail(err)))
+		return nil, nil, errorx.NewInvalidArgumentError("webauthn.verifyFailed")
+	}
+
+	return user, credential, nil
+}
+
+func (c *Ceremony) saveSession(ctx context.Context, session *webauthn.SessionData, options any, ttl time.Duration) (string, string, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "", "", errorx.NewInternalError("webauthn.beginFailed")
+	}
+
+	id := uuid.NewString()
+	if err = c.sessions.Save(ctx, id, session, ttl); err != nil {
+		logx.WithContext(ctx).Errorw("保存WebAuthn会话失败", logx.Field("detail", err.Error()))
+		return "", "", errorx.NewInternalError("webauthn.beginFailed")
+	}
+	return id, string(data), nil
+}
+
+func (c *Ceremony) takeSession(ctx context.Context, id string) (*webauthn.SessionData, error) {
+	session, err := c.sessions.Take(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, errorx.NewInvalidArgumentError("webauthn.sessionExpired")
+		}
+		logx.WithContext(ctx).Errorw("读取WebAuthn会话失败", logx.Field("detail", err.Error()))
+		return nil, errorx.NewInternalError("webauthn.verifyFailed")
+	}
+	return session, nil
+}
+
+// protocolDetail 提取 protocol.Error 的调试信息
+func protocolDetail(err error) string {
+	var pErr *protocol.Error
+	if errors.As(err, &pErr) {
+		return pErr.Details + " " + pErr.DevInfo
+	}
+	return err.Error()
+}
@@ -0,0 +1,151 @@
+package webauthnutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/internal/utils/webauthnutils/webauthntest"
+)
+
+func newTestCeremony(t *testing.T) *Ceremony {
+	c, err := NewCeremony(&webauthn.Config{
+		RPID:          webauthntest.RPID,
+		RPDisplayName: "Last Admin",
+		RPOrigins:     []string{webauthntest.Origin},
+	}, NewMemorySessionStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// register 完成一次注册仪式并将凭证绑定到用户
+func register(t *testing.T, c *Ceremony, user *User, a *webauthntest.Authenticator) {
+	ctx := context.Background()
+	sessionID, options, err := c.BeginRegistration(ctx, user)
+	if err != nil {
+		t.Fatalf("开始注册失败: %v", err)
+	}
+	credential, err := c.FinishRegistration(ctx, user, sessionID, a.Create(t, options))
+	if err != nil {
+		t.Fatalf("完成注册失败: %v", err)
+	}
+	user.Credentials = append(user.Credentials, *credential)
+}
+
+func TestCeremonyRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCeremony(t)
+	user := &User{ID: uuid.New(), Name: "admin"}
+	a := webauthntest.NewAuthenticator(t)
+	register(t, c, user, a)
+
+	if len(user.Credentials) != 1 || EncodeCredentialID(user.Credentials[0].ID) != webauthntest.Encode(a.CredID) {
+		t.Fatalf("注册的凭证不正确: %+v", user.Credentials)
+	}
+
+	loader := func(id uuid.UUID) (*User, error) {
+		return user, nil
+	}
+
+	tests := []struct {
+		name string
+		user *User // 为空时为无用户名登录
+	}{
+		{name: "第二因素登录", user: user},
+		{name: "无用户名登录", user: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a.Counter++
+			sessionID, options, err := c.BeginLogin(ctx, tt.user)
+			if err != nil {
+				t.Fatalf("开始登录失败: %v", err)
+			}
+			found, credential, err := c.FinishLogin(ctx, sessionID, a.Get(t, options), loader)
+			if err != nil {
+				t.Fatalf("完成登录失败: %v", err)
+			}
+			if found.ID != user.ID {
+				t.Errorf("用户不一致: got %s, want %s", found.ID, user.ID)
+			}
+			if credential.Authenticator.SignCount != a.Counter {
+				t.Errorf("签名计数器未更新: got %d, want %d", credential.Authenticator.SignCount, a.Counter)
+			}
+			if credential.Authenticator.CloneWarning {
+				t.Error("不应出现克隆告警")
+			}
+			user.Credentials[0] = *credential
+		})
+	}
+}
+
+func TestCeremonyRejects(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCeremony(t)
+	user := &User{ID: uuid.New(), Name: "admin"}
+	a := webauthntest.NewAuthenticator(t)
+	register(t, c, user, a)
+	loader := func(id uuid.UUID) (*User, error) {
+		return user, nil
+	}
+
+	t.Run("会话只能使用一次", func(t *testing.T) {
+		a.Counter++
+		sessionID, options, err := c.BeginLogin(ctx, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response := a.Get(t, options)
+		if _, _, err = c.FinishLogin(ctx, sessionID, response, loader); err != nil {
+			t.Fatalf("首次登录失败: %v", err)
+		}
+		if _, _, err = c.FinishLogin(ctx, sessionID, response, loader); err == nil {
+			t.Fatal("重放会话应当失败")
+		}
+	})
+
+	t.Run("来源不匹配", func(t *testing.T) {
+		phishing := *a
+		phishing.Origin = "https://admin.example.com.evil.io"
+		phishing.Counter = a.Counter + 1
+		sessionID, options, err := c.BeginLogin(ctx, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = c.FinishLogin(ctx, sessionID, phishing.Get(t, options), loader); err == nil {
+			t.Fatal("钓鱼来源应当失败")
+		}
+	})
+
+	t.Run("未知凭证", func(t *testing.T) {
+		other := webauthntest.NewAuthenticator(t)
+		other.UserHandle = user.WebAuthnID()
+		sessionID, options, err := c.BeginLogin(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = c.FinishLogin(ctx, sessionID, other.Get(t, options), loader); err == nil {
+			t.Fatal("未注册的凭证应当失败")
+		}
+	})
+
+	t.Run("计数器回退触发克隆告警", func(t *testing.T) {
+		user.Credentials[0].Authenticator.SignCount = a.Counter + 10
+		a.Counter++
+		sessionID, options, err := c.BeginLogin(ctx, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, credential, err := c.FinishLogin(ctx, sessionID, a.Get(t, options), loader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !credential.Authenticator.CloneWarning {
+			t.Error("计数器回退应当产生克隆告警")
+		}
+	})
+}
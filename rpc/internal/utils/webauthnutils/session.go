@@ -0,0 +1,89 @@
+package webauthnutils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+)
+
+const sessionKeyPrefix = "webauthn:session:"
+
+// ErrSessionNotFound 会话不存在或已过期
+var ErrSessionNotFound = errors.New("webauthn session not found")
+
+// SessionStore WebAuthn 仪式会话存储，会话只能被取出一次
+type SessionStore interface {
+	Save(ctx context.Context, id string, session *webauthn.SessionData, ttl time.Duration) error
+	Take(ctx context.Context, id string) (*webauthn.SessionData, error)
+}
+
+// RedisSessionStore 基于 Redis 的会话存储
+type RedisSessionStore struct {
+	rds redis.UniversalClient
+}
+
+func NewRedisSessionStore(rds redis.UniversalClient) *RedisSessionStore {
+	return &RedisSessionStore{rds: rds}
+}
+
+func (s *RedisSessionStore) Save(ctx context.Context, id string, session *webauthn.SessionData, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.rds.SetEx(ctx, sessionKeyPrefix+id, data, ttl).Err()
+}
+
+func (s *RedisSessionStore) Take(ctx context.Context, id string) (*webauthn.SessionData, error) {
+	data, err := s.rds.GetDel(ctx, sessionKeyPrefix+id).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	var session webauthn.SessionData
+	if err = json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// MemorySessionStore 基于内存的会话存储，仅用于测试及单机场景
+type MemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]memorySession
+}
+
+type memorySession struct {
+	session   webauthn.SessionData
+	expiresAt time.Time
+}
+
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: make(map[string]memorySession)}
+}
+
+func (s *MemorySessionStore) Save(_ context.Context, id string, session *webauthn.SessionData, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = memorySession{session: *session, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *MemorySessionStore) Take(_ context.Context, id string) (*webauthn.SessionData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.sessions[id]
+	delete(s.sessions, id)
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, ErrSessionNotFound
+	}
+	return &item.session, nil
+}
@@ -0,0 +1,114 @@
+package webauthnutils
+
+import (
+	"encoding/base64"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/wenpiner/last-admin-core/rpc/ent"
+)
+
+// User 适配 webauthn.User 接口
+type User struct {
+	ID          uuid.UUID
+	Name        string
+	DisplayName string
+	Credentials []webauthn.Credential
+}
+
+func (u *User) WebAuthnID() []byte {
+	return u.ID[:]
+}
+
+func (u *User) WebAuthnName() string {
+	return u.Name
+}
+
+func (u *User) WebAuthnDisplayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Name
+}
+
+func (u *User) WebAuthnCredentials() []webauthn.Credential {
+	return u.Credentials
+}
+
+// NewUser 根据用户实体及其凭证构建 WebAuthn 用户
+func NewUser(user *ent.User, credentials []*ent.UserWebauthn) *User {
+	u := &User{
+		ID:          user.ID,
+		Name:        user.Username,
+		DisplayName: user.FullName,
+	}
+	for _, c := range credentials {
+		credential, err := ToCredential(c)
+		if err != nil {
+			continue
+		}
+		u.Credentials = append(u.Credentials, credential)
+	}
+	return u
+}
+
+// ToCredential 将凭证实体转换为 webauthn.Credential
+func ToCredential(c *ent.UserWebauthn) (webauthn.Credential, error) {
+	id, err := DecodeCredentialID(c.CredentialID)
+	if err != nil {
+		return webauthn.Credential{}, err
+	}
+
+	credential := webauthn.Credential{
+		ID:              id,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Flags: webauthn.CredentialFlags{
+			UserPresent:    true,
+			UserVerified:   c.UserVerified,
+			BackupEligible: c.BackupEligible,
+			BackupState:    c.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			SignCount:    c.SignCount,
+			CloneWarning: c.CloneWarning,
+			Attachment:   protocol.AuthenticatorAttachment(c.Attachment),
+		},
+	}
+	for _, t := range c.Transports {
+		credential.Transport = append(credential.Transport, protocol.AuthenticatorTransport(t))
+	}
+	if aaguid, err := uuid.Parse(c.Aaguid); err == nil {
+		credential.Authenticator.AAGUID = aaguid[:]
+	}
+	return credential, nil
+}
+
+// EncodeCredentialID 凭证ID编码为 Base64URL
+func EncodeCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// DecodeCredentialID 解码 Base64URL 凭证ID
+func DecodeCredentialID(id string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(id)
+}
+
+// FormatAAGUID 格式化认证器 AAGUID
+func FormatAAGUID(aaguid []byte) string {
+	id, err := uuid.FromBytes(aaguid)
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Transports 凭证传输方式转换为字符串
+func Transports(credential *webauthn.Credential) []string {
+	transports := make([]string, 0, len(credential.Transport))
+	for _, t := range credential.Transport {
+		transports = append(transports, string(t))
+	}
+	return transports
+}
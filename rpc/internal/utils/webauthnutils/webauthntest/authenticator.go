@@ -0,0 +1,170 @@
+// Package webauthntest 提供模拟浏览器与硬件密钥行为的软件认证器，用于 WebAuthn 仪式测试
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+)
+
+const (
+	// RPID 测试使用的依赖方ID
+	RPID = "admin.example.com"
+	// Origin 测试使用的前端来源
+	Origin = "https://admin.example.com"
+)
+
+// Authenticator 软件认证器，模拟浏览器与硬件密钥的行为
+type Authenticator struct {
+	Key        *ecdsa.PrivateKey
+	CredID     []byte
+	UserHandle []byte
+	Counter    uint32
+	Origin     string
+}
+
+// NewAuthenticator 创建使用随机 P-256 密钥及凭证ID的认证器
+func NewAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credID := make([]byte, 32)
+	_, _ = rand.Read(credID)
+	return &Authenticator{Key: key, CredID: credID, Origin: Origin}
+}
+
+// Create 根据注册选项生成注册响应
+func (a *Authenticator) Create(t *testing.T, options string) []byte {
+	t.Helper()
+	var opts struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+			User      struct {
+				ID string `json:"id"`
+			} `json:"user"`
+		} `json:"publicKey"`
+	}
+	if err := json.Unmarshal([]byte(options), &opts); err != nil {
+		t.Fatal(err)
+	}
+	a.UserHandle = mustDecode(t, opts.PublicKey.User.ID)
+
+	clientData := a.clientData(t, "webauthn.create", opts.PublicKey.Challenge)
+
+	coseKey, err := webauthncbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.Key.PublicKey.X.FillBytes(make([]byte, 32)),
+		-3: a.Key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authData := a.authData(0x01 | 0x04 | 0x40)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredID)))
+	authData = append(authData, a.CredID...)
+	authData = append(authData, coseKey...)
+
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mustJSON(t, map[string]any{
+		"id":    Encode(a.CredID),
+		"rawId": Encode(a.CredID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    Encode(clientData),
+			"attestationObject": Encode(attestation),
+			"transports":        []string{"internal"},
+		},
+	})
+}
+
+// Get 根据认证选项生成断言响应
+func (a *Authenticator) Get(t *testing.T, options string) []byte {
+	t.Helper()
+	var opts struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}
+	if err := json.Unmarshal([]byte(options), &opts); err != nil {
+		t.Fatal(err)
+	}
+
+	clientData := a.clientData(t, "webauthn.get", opts.PublicKey.Challenge)
+	authData := a.authData(0x01 | 0x04)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.Key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mustJSON(t, map[string]any{
+		"id":    Encode(a.CredID),
+		"rawId": Encode(a.CredID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    Encode(clientData),
+			"authenticatorData": Encode(authData),
+			"signature":         Encode(signature),
+			"userHandle":        Encode(a.UserHandle),
+		},
+	})
+}
+
+func (a *Authenticator) clientData(t *testing.T, typ, challenge string) []byte {
+	return mustJSON(t, map[string]any{
+		"type":      typ,
+		"challenge": challenge,
+		"origin":    a.Origin,
+	})
+}
+
+func (a *Authenticator) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(RPID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.Counter)
+}
+
+// Encode 按 WebAuthn 的 base64url 格式编码
+func Encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func mustDecode(t *testing.T, s string) []byte {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -234,12 +234,16 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 const (
 	DictService_CreateOrUpdateDict_FullMethodName     = "/core.DictService/CreateOrUpdateDict"
 	DictService_DeleteDict_FullMethodName             = "/core.DictService/DeleteDict"
+	DictService_BatchDeleteDict_FullMethodName        = "/core.DictService/BatchDeleteDict"
+	DictService_BatchUpdateDictState_FullMethodName   = "/core.DictService/BatchUpdateDictState"
 	DictService_GetDict_FullMethodName                = "/core.DictService/GetDict"
 	DictService_ListDict_FullMethodName               = "/core.DictService/ListDict"
 	DictService_CreateOrUpdateDictItem_FullMethodName = "/core.DictService/CreateOrUpdateDictItem"
 	DictService_DeleteDictItem_FullMethodName         = "/core.DictService/DeleteDictItem"
 	DictService_GetDictItem_FullMethodName            = "/core.DictService/GetDictItem"
 	DictService_ListDictItem_FullMethodName           = "/core.DictService/ListDictItem"
+	DictService_GetDictByCode_FullMethodName          = "/core.DictService/GetDictByCode"
+	DictService_BatchGetDictByCode_FullMethodName     = "/core.DictService/BatchGetDictByCode"
 )
 
 // DictServiceClient is the client API for DictService service.
@@ -250,6 +254,10 @@ type DictServiceClient interface {
 	CreateOrUpdateDict(ctx context.Context, in *DictInfo, opts ...grpc.CallOption) (*DictInfo, error)
 	// 删除字典
 	DeleteDict(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除字典，存在字典项的字典跳过
+	BatchDeleteDict(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用字典
+	BatchUpdateDictState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取字典
 	GetDict(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*DictInfo, error)
 	// 获取字典列表
@@ -262,6 +270,10 @@ type DictServiceClient interface {
 	GetDictItem(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*DictItemInfo, error)
 	// 获取字典子项列表
 	ListDictItem(ctx context.Context, in *DictItemListRequest, opts ...grpc.CallOption) (*DictItemListResponse, error)
+	// 根据编码获取字典及启用的子项
+	GetDictByCode(ctx context.Context, in *DictCodeRequest, opts ...grpc.CallOption) (*DictData, error)
+	// 根据编码批量获取字典及启用的子项
+	BatchGetDictByCode(ctx context.Context, in *DictCodesRequest, opts ...grpc.CallOption) (*DictDataListResponse, error)
 }
 
 type dictServiceClient struct {
@@ -292,6 +304,26 @@ func (c *dictServiceClient) DeleteDict(ctx context.Context, in *ID32Request, opt
 	return out, nil
 }
 
+func (c *dictServiceClient) BatchDeleteDict(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DictService_BatchDeleteDict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dictServiceClient) BatchUpdateDictState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DictService_BatchUpdateDictState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dictServiceClient) GetDict(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*DictInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DictInfo)
@@ -352,6 +384,26 @@ func (c *dictServiceClient) ListDictItem(ctx context.Context, in *DictItemListRe
 	return out, nil
 }
 
+func (c *dictServiceClient) GetDictByCode(ctx context.Context, in *DictCodeRequest, opts ...grpc.CallOption) (*DictData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DictData)
+	err := c.cc.Invoke(ctx, DictService_GetDictByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dictServiceClient) BatchGetDictByCode(ctx context.Context, in *DictCodesRequest, opts ...grpc.CallOption) (*DictDataListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DictDataListResponse)
+	err := c.cc.Invoke(ctx, DictService_BatchGetDictByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DictServiceServer is the server API for DictService service.
 // All implementations must embed UnimplementedDictServiceServer
 // for forward compatibility
@@ -360,6 +412,10 @@ type DictServiceServer interface {
 	CreateOrUpdateDict(context.Context, *DictInfo) (*DictInfo, error)
 	// 删除字典
 	DeleteDict(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除字典，存在字典项的字典跳过
+	BatchDeleteDict(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用字典
+	BatchUpdateDictState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取字典
 	GetDict(context.Context, *ID32Request) (*DictInfo, error)
 	// 获取字典列表
@@ -372,6 +428,10 @@ type DictServiceServer interface {
 	GetDictItem(context.Context, *ID32Request) (*DictItemInfo, error)
 	// 获取字典子项列表
 	ListDictItem(context.Context, *DictItemListRequest) (*DictItemListResponse, error)
+	// 根据编码获取字典及启用的子项
+	GetDictByCode(context.Context, *DictCodeRequest) (*DictData, error)
+	// 根据编码批量获取字典及启用的子项
+	BatchGetDictByCode(context.Context, *DictCodesRequest) (*DictDataListResponse, error)
 	mustEmbedUnimplementedDictServiceServer()
 }
 
@@ -385,6 +445,12 @@ func (UnimplementedDictServiceServer) CreateOrUpdateDict(context.Context, *DictI
 func (UnimplementedDictServiceServer) DeleteDict(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDict not implemented")
 }
+func (UnimplementedDictServiceServer) BatchDeleteDict(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteDict not implemented")
+}
+func (UnimplementedDictServiceServer) BatchUpdateDictState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateDictState not implemented")
+}
 func (UnimplementedDictServiceServer) GetDict(context.Context, *ID32Request) (*DictInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDict not implemented")
 }
@@ -403,6 +469,12 @@ func (UnimplementedDictServiceServer) GetDictItem(context.Context, *ID32Request)
 func (UnimplementedDictServiceServer) ListDictItem(context.Context, *DictItemListRequest) (*DictItemListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDictItem not implemented")
 }
+func (UnimplementedDictServiceServer) GetDictByCode(context.Context, *DictCodeRequest) (*DictData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDictByCode not implemented")
+}
+func (UnimplementedDictServiceServer) BatchGetDictByCode(context.Context, *DictCodesRequest) (*DictDataListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetDictByCode not implemented")
+}
 func (UnimplementedDictServiceServer) mustEmbedUnimplementedDictServiceServer() {}
 
 // UnsafeDictServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -452,6 +524,42 @@ func _DictService_DeleteDict_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DictService_BatchDeleteDict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictServiceServer).BatchDeleteDict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictService_BatchDeleteDict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictServiceServer).BatchDeleteDict(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DictService_BatchUpdateDictState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictServiceServer).BatchUpdateDictState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictService_BatchUpdateDictState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictServiceServer).BatchUpdateDictState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DictService_GetDict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID32Request)
 	if err := dec(in); err != nil {
@@ -560,6 +668,42 @@ func _DictService_ListDictItem_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DictService_GetDictByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictServiceServer).GetDictByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictService_GetDictByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictServiceServer).GetDictByCode(ctx, req.(*DictCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DictService_BatchGetDictByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictServiceServer).BatchGetDictByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictService_BatchGetDictByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictServiceServer).BatchGetDictByCode(ctx, req.(*DictCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DictService_ServiceDesc is the grpc.ServiceDesc for DictService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -575,6 +719,14 @@ var DictService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDict",
 			Handler:    _DictService_DeleteDict_Handler,
 		},
+		{
+			MethodName: "BatchDeleteDict",
+			Handler:    _DictService_BatchDeleteDict_Handler,
+		},
+		{
+			MethodName: "BatchUpdateDictState",
+			Handler:    _DictService_BatchUpdateDictState_Handler,
+		},
 		{
 			MethodName: "GetDict",
 			Handler:    _DictService_GetDict_Handler,
@@ -599,6 +751,14 @@ var DictService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDictItem",
 			Handler:    _DictService_ListDictItem_Handler,
 		},
+		{
+			MethodName: "GetDictByCode",
+			Handler:    _DictService_GetDictByCode_Handler,
+		},
+		{
+			MethodName: "BatchGetDictByCode",
+			Handler:    _DictService_BatchGetDictByCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
@@ -607,6 +767,8 @@ var DictService_ServiceDesc = grpc.ServiceDesc{
 const (
 	RoleService_CreateOrUpdateRole_FullMethodName       = "/core.RoleService/CreateOrUpdateRole"
 	RoleService_DeleteRole_FullMethodName               = "/core.RoleService/DeleteRole"
+	RoleService_BatchDeleteRole_FullMethodName          = "/core.RoleService/BatchDeleteRole"
+	RoleService_BatchUpdateRoleState_FullMethodName     = "/core.RoleService/BatchUpdateRoleState"
 	RoleService_GetRole_FullMethodName                  = "/core.RoleService/GetRole"
 	RoleService_ListRole_FullMethodName                 = "/core.RoleService/ListRole"
 	RoleService_GetRoleByValue_FullMethodName           = "/core.RoleService/GetRoleByValue"
@@ -615,6 +777,8 @@ const (
 	RoleService_GetMenu_FullMethodName                  = "/core.RoleService/GetMenu"
 	RoleService_AssignConfigurationGroup_FullMethodName = "/core.RoleService/AssignConfigurationGroup"
 	RoleService_GetConfigurationGroup_FullMethodName    = "/core.RoleService/GetConfigurationGroup"
+	RoleService_CheckRoleApiConsistency_FullMethodName  = "/core.RoleService/CheckRoleApiConsistency"
+	RoleService_ExplainPermission_FullMethodName        = "/core.RoleService/ExplainPermission"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -625,6 +789,10 @@ type RoleServiceClient interface {
 	CreateOrUpdateRole(ctx context.Context, in *RoleInfo, opts ...grpc.CallOption) (*RoleInfo, error)
 	// 删除角色
 	DeleteRole(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除角色，系统保留角色及仍有用户的角色跳过
+	BatchDeleteRole(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用角色，系统保留角色跳过
+	BatchUpdateRoleState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取角色
 	GetRole(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*RoleInfo, error)
 	// 获取角色列表
@@ -633,7 +801,7 @@ type RoleServiceClient interface {
 	GetRoleByValue(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*RoleInfo, error)
 	// 为角色分配菜单
 	AssignMenu(ctx context.Context, in *RoleMenuRequest, opts ...grpc.CallOption) (*BaseResponse, error)
-	// 为角色分配API
+	// 为角色分配API，已分配菜单依赖的API始终保留
 	AssignApi(ctx context.Context, in *RoleApiRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 	// 获取角色菜单
 	GetMenu(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*RoleMenuListResponse, error)
@@ -641,6 +809,10 @@ type RoleServiceClient interface {
 	AssignConfigurationGroup(ctx context.Context, in *RoleConfigurationGroupRequest, opts ...grpc.CallOption) (*RoleConfigurationGroupListResponse, error)
 	// 获取角色配置项分组权限
 	GetConfigurationGroup(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*RoleConfigurationGroupListResponse, error)
+	// 检查角色接口授权与菜单的一致性
+	CheckRoleApiConsistency(ctx context.Context, in *RoleApiConsistencyRequest, opts ...grpc.CallOption) (*RoleApiConsistencyResponse, error)
+	// 解释用户或角色对接口、配置项的访问决策，支持模拟角色变更
+	ExplainPermission(ctx context.Context, in *PermissionExplainRequest, opts ...grpc.CallOption) (*PermissionExplainResponse, error)
 }
 
 type roleServiceClient struct {
@@ -671,6 +843,26 @@ func (c *roleServiceClient) DeleteRole(ctx context.Context, in *ID32Request, opt
 	return out, nil
 }
 
+func (c *roleServiceClient) BatchDeleteRole(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, RoleService_BatchDeleteRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) BatchUpdateRoleState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, RoleService_BatchUpdateRoleState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleServiceClient) GetRole(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*RoleInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RoleInfo)
@@ -751,6 +943,26 @@ func (c *roleServiceClient) GetConfigurationGroup(ctx context.Context, in *Strin
 	return out, nil
 }
 
+func (c *roleServiceClient) CheckRoleApiConsistency(ctx context.Context, in *RoleApiConsistencyRequest, opts ...grpc.CallOption) (*RoleApiConsistencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleApiConsistencyResponse)
+	err := c.cc.Invoke(ctx, RoleService_CheckRoleApiConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ExplainPermission(ctx context.Context, in *PermissionExplainRequest, opts ...grpc.CallOption) (*PermissionExplainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionExplainResponse)
+	err := c.cc.Invoke(ctx, RoleService_ExplainPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -759,6 +971,10 @@ type RoleServiceServer interface {
 	CreateOrUpdateRole(context.Context, *RoleInfo) (*RoleInfo, error)
 	// 删除角色
 	DeleteRole(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除角色，系统保留角色及仍有用户的角色跳过
+	BatchDeleteRole(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用角色，系统保留角色跳过
+	BatchUpdateRoleState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取角色
 	GetRole(context.Context, *ID32Request) (*RoleInfo, error)
 	// 获取角色列表
@@ -767,7 +983,7 @@ type RoleServiceServer interface {
 	GetRoleByValue(context.Context, *StringRequest) (*RoleInfo, error)
 	// 为角色分配菜单
 	AssignMenu(context.Context, *RoleMenuRequest) (*BaseResponse, error)
-	// 为角色分配API
+	// 为角色分配API，已分配菜单依赖的API始终保留
 	AssignApi(context.Context, *RoleApiRequest) (*BaseResponse, error)
 	// 获取角色菜单
 	GetMenu(context.Context, *ID32Request) (*RoleMenuListResponse, error)
@@ -775,6 +991,10 @@ type RoleServiceServer interface {
 	AssignConfigurationGroup(context.Context, *RoleConfigurationGroupRequest) (*RoleConfigurationGroupListResponse, error)
 	// 获取角色配置项分组权限
 	GetConfigurationGroup(context.Context, *StringRequest) (*RoleConfigurationGroupListResponse, error)
+	// 检查角色接口授权与菜单的一致性
+	CheckRoleApiConsistency(context.Context, *RoleApiConsistencyRequest) (*RoleApiConsistencyResponse, error)
+	// 解释用户或角色对接口、配置项的访问决策，支持模拟角色变更
+	ExplainPermission(context.Context, *PermissionExplainRequest) (*PermissionExplainResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -788,6 +1008,12 @@ func (UnimplementedRoleServiceServer) CreateOrUpdateRole(context.Context, *RoleI
 func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
+func (UnimplementedRoleServiceServer) BatchDeleteRole(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteRole not implemented")
+}
+func (UnimplementedRoleServiceServer) BatchUpdateRoleState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateRoleState not implemented")
+}
 func (UnimplementedRoleServiceServer) GetRole(context.Context, *ID32Request) (*RoleInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
@@ -812,6 +1038,12 @@ func (UnimplementedRoleServiceServer) AssignConfigurationGroup(context.Context,
 func (UnimplementedRoleServiceServer) GetConfigurationGroup(context.Context, *StringRequest) (*RoleConfigurationGroupListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationGroup not implemented")
 }
+func (UnimplementedRoleServiceServer) CheckRoleApiConsistency(context.Context, *RoleApiConsistencyRequest) (*RoleApiConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRoleApiConsistency not implemented")
+}
+func (UnimplementedRoleServiceServer) ExplainPermission(context.Context, *PermissionExplainRequest) (*PermissionExplainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -861,6 +1093,42 @@ func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_BatchDeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).BatchDeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_BatchDeleteRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).BatchDeleteRole(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_BatchUpdateRoleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).BatchUpdateRoleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_BatchUpdateRoleState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).BatchUpdateRoleState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID32Request)
 	if err := dec(in); err != nil {
@@ -1005,6 +1273,42 @@ func _RoleService_GetConfigurationGroup_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_CheckRoleApiConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleApiConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).CheckRoleApiConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_CheckRoleApiConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).CheckRoleApiConsistency(ctx, req.(*RoleApiConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ExplainPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ExplainPermission(ctx, req.(*PermissionExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1020,6 +1324,14 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRole",
 			Handler:    _RoleService_DeleteRole_Handler,
 		},
+		{
+			MethodName: "BatchDeleteRole",
+			Handler:    _RoleService_BatchDeleteRole_Handler,
+		},
+		{
+			MethodName: "BatchUpdateRoleState",
+			Handler:    _RoleService_BatchUpdateRoleState_Handler,
+		},
 		{
 			MethodName: "GetRole",
 			Handler:    _RoleService_GetRole_Handler,
@@ -1052,6 +1364,14 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfigurationGroup",
 			Handler:    _RoleService_GetConfigurationGroup_Handler,
 		},
+		{
+			MethodName: "CheckRoleApiConsistency",
+			Handler:    _RoleService_CheckRoleApiConsistency_Handler,
+		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _RoleService_ExplainPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
@@ -1064,6 +1384,8 @@ const (
 	MenuService_ListMenu_FullMethodName                 = "/core.MenuService/ListMenu"
 	MenuService_ListMenuByRole_FullMethodName           = "/core.MenuService/ListMenuByRole"
 	MenuService_ListPagePermissionByRole_FullMethodName = "/core.MenuService/ListPagePermissionByRole"
+	MenuService_AssignMenuApi_FullMethodName            = "/core.MenuService/AssignMenuApi"
+	MenuService_GetMenuApi_FullMethodName               = "/core.MenuService/GetMenuApi"
 )
 
 // MenuServiceClient is the client API for MenuService service.
@@ -1082,6 +1404,10 @@ type MenuServiceClient interface {
 	ListMenuByRole(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*MenuListResponse, error)
 	// 获取角色对应的所有页面权限
 	ListPagePermissionByRole(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringListResponse, error)
+	// 为菜单绑定API，已分配该菜单的角色同步获得这些API
+	AssignMenuApi(ctx context.Context, in *MenuApiRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取菜单绑定的API
+	GetMenuApi(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*MenuApiListResponse, error)
 }
 
 type menuServiceClient struct {
@@ -1152,6 +1478,26 @@ func (c *menuServiceClient) ListPagePermissionByRole(ctx context.Context, in *St
 	return out, nil
 }
 
+func (c *menuServiceClient) AssignMenuApi(ctx context.Context, in *MenuApiRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, MenuService_AssignMenuApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuApi(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*MenuApiListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuApiListResponse)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MenuServiceServer is the server API for MenuService service.
 // All implementations must embed UnimplementedMenuServiceServer
 // for forward compatibility
@@ -1168,6 +1514,10 @@ type MenuServiceServer interface {
 	ListMenuByRole(context.Context, *StringRequest) (*MenuListResponse, error)
 	// 获取角色对应的所有页面权限
 	ListPagePermissionByRole(context.Context, *StringRequest) (*StringListResponse, error)
+	// 为菜单绑定API，已分配该菜单的角色同步获得这些API
+	AssignMenuApi(context.Context, *MenuApiRequest) (*BaseResponse, error)
+	// 获取菜单绑定的API
+	GetMenuApi(context.Context, *ID32Request) (*MenuApiListResponse, error)
 	mustEmbedUnimplementedMenuServiceServer()
 }
 
@@ -1193,6 +1543,12 @@ func (UnimplementedMenuServiceServer) ListMenuByRole(context.Context, *StringReq
 func (UnimplementedMenuServiceServer) ListPagePermissionByRole(context.Context, *StringRequest) (*StringListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPagePermissionByRole not implemented")
 }
+func (UnimplementedMenuServiceServer) AssignMenuApi(context.Context, *MenuApiRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignMenuApi not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenuApi(context.Context, *ID32Request) (*MenuApiListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuApi not implemented")
+}
 func (UnimplementedMenuServiceServer) mustEmbedUnimplementedMenuServiceServer() {}
 
 // UnsafeMenuServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1314,6 +1670,42 @@ func _MenuService_ListPagePermissionByRole_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MenuService_AssignMenuApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).AssignMenuApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_AssignMenuApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).AssignMenuApi(ctx, req.(*MenuApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuApi(ctx, req.(*ID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MenuService_ServiceDesc is the grpc.ServiceDesc for MenuService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1345,16 +1737,27 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPagePermissionByRole",
 			Handler:    _MenuService_ListPagePermissionByRole_Handler,
 		},
+		{
+			MethodName: "AssignMenuApi",
+			Handler:    _MenuService_AssignMenuApi_Handler,
+		},
+		{
+			MethodName: "GetMenuApi",
+			Handler:    _MenuService_GetMenuApi_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
 }
 
 const (
-	DepartmentService_CreateOrUpdateDepartment_FullMethodName = "/core.DepartmentService/CreateOrUpdateDepartment"
-	DepartmentService_DeleteDepartment_FullMethodName         = "/core.DepartmentService/DeleteDepartment"
-	DepartmentService_GetDepartment_FullMethodName            = "/core.DepartmentService/GetDepartment"
-	DepartmentService_ListDepartment_FullMethodName           = "/core.DepartmentService/ListDepartment"
+	DepartmentService_CreateOrUpdateDepartment_FullMethodName   = "/core.DepartmentService/CreateOrUpdateDepartment"
+	DepartmentService_DeleteDepartment_FullMethodName           = "/core.DepartmentService/DeleteDepartment"
+	DepartmentService_BatchDeleteDepartment_FullMethodName      = "/core.DepartmentService/BatchDeleteDepartment"
+	DepartmentService_BatchUpdateDepartmentState_FullMethodName = "/core.DepartmentService/BatchUpdateDepartmentState"
+	DepartmentService_GetDepartment_FullMethodName              = "/core.DepartmentService/GetDepartment"
+	DepartmentService_ListDepartment_FullMethodName             = "/core.DepartmentService/ListDepartment"
+	DepartmentService_AssignDepartmentRole_FullMethodName       = "/core.DepartmentService/AssignDepartmentRole"
 )
 
 // DepartmentServiceClient is the client API for DepartmentService service.
@@ -1365,10 +1768,16 @@ type DepartmentServiceClient interface {
 	CreateOrUpdateDepartment(ctx context.Context, in *DepartmentInfo, opts ...grpc.CallOption) (*DepartmentInfo, error)
 	// 删除部门
 	DeleteDepartment(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除部门，存在子部门或成员的部门跳过
+	BatchDeleteDepartment(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用部门
+	BatchUpdateDepartmentState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取部门
 	GetDepartment(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*DepartmentInfo, error)
 	// 获取部门列表
 	ListDepartment(ctx context.Context, in *DepartmentListRequest, opts ...grpc.CallOption) (*DepartmentListResponse, error)
+	// 为部门分配角色，部门成员自动获得这些角色
+	AssignDepartmentRole(ctx context.Context, in *DepartmentRoleRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 }
 
 type departmentServiceClient struct {
@@ -1399,6 +1808,26 @@ func (c *departmentServiceClient) DeleteDepartment(ctx context.Context, in *ID32
 	return out, nil
 }
 
+func (c *departmentServiceClient) BatchDeleteDepartment(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_BatchDeleteDepartment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *departmentServiceClient) BatchUpdateDepartmentState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_BatchUpdateDepartmentState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *departmentServiceClient) GetDepartment(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*DepartmentInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DepartmentInfo)
@@ -1419,6 +1848,16 @@ func (c *departmentServiceClient) ListDepartment(ctx context.Context, in *Depart
 	return out, nil
 }
 
+func (c *departmentServiceClient) AssignDepartmentRole(ctx context.Context, in *DepartmentRoleRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, DepartmentService_AssignDepartmentRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepartmentServiceServer is the server API for DepartmentService service.
 // All implementations must embed UnimplementedDepartmentServiceServer
 // for forward compatibility
@@ -1427,10 +1866,16 @@ type DepartmentServiceServer interface {
 	CreateOrUpdateDepartment(context.Context, *DepartmentInfo) (*DepartmentInfo, error)
 	// 删除部门
 	DeleteDepartment(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除部门，存在子部门或成员的部门跳过
+	BatchDeleteDepartment(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用部门
+	BatchUpdateDepartmentState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取部门
 	GetDepartment(context.Context, *ID32Request) (*DepartmentInfo, error)
 	// 获取部门列表
 	ListDepartment(context.Context, *DepartmentListRequest) (*DepartmentListResponse, error)
+	// 为部门分配角色，部门成员自动获得这些角色
+	AssignDepartmentRole(context.Context, *DepartmentRoleRequest) (*BaseResponse, error)
 	mustEmbedUnimplementedDepartmentServiceServer()
 }
 
@@ -1444,12 +1889,21 @@ func (UnimplementedDepartmentServiceServer) CreateOrUpdateDepartment(context.Con
 func (UnimplementedDepartmentServiceServer) DeleteDepartment(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepartment not implemented")
 }
+func (UnimplementedDepartmentServiceServer) BatchDeleteDepartment(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteDepartment not implemented")
+}
+func (UnimplementedDepartmentServiceServer) BatchUpdateDepartmentState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateDepartmentState not implemented")
+}
 func (UnimplementedDepartmentServiceServer) GetDepartment(context.Context, *ID32Request) (*DepartmentInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDepartment not implemented")
 }
 func (UnimplementedDepartmentServiceServer) ListDepartment(context.Context, *DepartmentListRequest) (*DepartmentListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDepartment not implemented")
 }
+func (UnimplementedDepartmentServiceServer) AssignDepartmentRole(context.Context, *DepartmentRoleRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDepartmentRole not implemented")
+}
 func (UnimplementedDepartmentServiceServer) mustEmbedUnimplementedDepartmentServiceServer() {}
 
 // UnsafeDepartmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1499,6 +1953,42 @@ func _DepartmentService_DeleteDepartment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_BatchDeleteDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).BatchDeleteDepartment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_BatchDeleteDepartment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).BatchDeleteDepartment(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepartmentService_BatchUpdateDepartmentState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).BatchUpdateDepartmentState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_BatchUpdateDepartmentState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).BatchUpdateDepartmentState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DepartmentService_GetDepartment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID32Request)
 	if err := dec(in); err != nil {
@@ -1535,6 +2025,24 @@ func _DepartmentService_ListDepartment_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepartmentService_AssignDepartmentRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepartmentRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepartmentServiceServer).AssignDepartmentRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepartmentService_AssignDepartmentRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepartmentServiceServer).AssignDepartmentRole(ctx, req.(*DepartmentRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DepartmentService_ServiceDesc is the grpc.ServiceDesc for DepartmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1550,6 +2058,14 @@ var DepartmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDepartment",
 			Handler:    _DepartmentService_DeleteDepartment_Handler,
 		},
+		{
+			MethodName: "BatchDeleteDepartment",
+			Handler:    _DepartmentService_BatchDeleteDepartment_Handler,
+		},
+		{
+			MethodName: "BatchUpdateDepartmentState",
+			Handler:    _DepartmentService_BatchUpdateDepartmentState_Handler,
+		},
 		{
 			MethodName: "GetDepartment",
 			Handler:    _DepartmentService_GetDepartment_Handler,
@@ -1558,16 +2074,23 @@ var DepartmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDepartment",
 			Handler:    _DepartmentService_ListDepartment_Handler,
 		},
+		{
+			MethodName: "AssignDepartmentRole",
+			Handler:    _DepartmentService_AssignDepartmentRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
 }
 
 const (
-	PositionService_CreateOrUpdatePosition_FullMethodName = "/core.PositionService/CreateOrUpdatePosition"
-	PositionService_DeletePosition_FullMethodName         = "/core.PositionService/DeletePosition"
-	PositionService_GetPosition_FullMethodName            = "/core.PositionService/GetPosition"
-	PositionService_ListPosition_FullMethodName           = "/core.PositionService/ListPosition"
+	PositionService_CreateOrUpdatePosition_FullMethodName   = "/core.PositionService/CreateOrUpdatePosition"
+	PositionService_DeletePosition_FullMethodName           = "/core.PositionService/DeletePosition"
+	PositionService_BatchDeletePosition_FullMethodName      = "/core.PositionService/BatchDeletePosition"
+	PositionService_BatchUpdatePositionState_FullMethodName = "/core.PositionService/BatchUpdatePositionState"
+	PositionService_GetPosition_FullMethodName              = "/core.PositionService/GetPosition"
+	PositionService_ListPosition_FullMethodName             = "/core.PositionService/ListPosition"
+	PositionService_AssignPositionRole_FullMethodName       = "/core.PositionService/AssignPositionRole"
 )
 
 // PositionServiceClient is the client API for PositionService service.
@@ -1578,10 +2101,16 @@ type PositionServiceClient interface {
 	CreateOrUpdatePosition(ctx context.Context, in *PositionInfo, opts ...grpc.CallOption) (*PositionInfo, error)
 	// 删除岗位
 	DeletePosition(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除岗位，仍有成员的岗位跳过
+	BatchDeletePosition(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用岗位
+	BatchUpdatePositionState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取岗位
 	GetPosition(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*PositionInfo, error)
 	// 获取岗位列表
 	ListPosition(ctx context.Context, in *PositionListRequest, opts ...grpc.CallOption) (*PositionListResponse, error)
+	// 为岗位分配角色，任职用户自动获得这些角色
+	AssignPositionRole(ctx context.Context, in *PositionRoleRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 }
 
 type positionServiceClient struct {
@@ -1612,6 +2141,26 @@ func (c *positionServiceClient) DeletePosition(ctx context.Context, in *ID32Requ
 	return out, nil
 }
 
+func (c *positionServiceClient) BatchDeletePosition(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, PositionService_BatchDeletePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *positionServiceClient) BatchUpdatePositionState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, PositionService_BatchUpdatePositionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *positionServiceClient) GetPosition(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*PositionInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PositionInfo)
@@ -1632,6 +2181,16 @@ func (c *positionServiceClient) ListPosition(ctx context.Context, in *PositionLi
 	return out, nil
 }
 
+func (c *positionServiceClient) AssignPositionRole(ctx context.Context, in *PositionRoleRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, PositionService_AssignPositionRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PositionServiceServer is the server API for PositionService service.
 // All implementations must embed UnimplementedPositionServiceServer
 // for forward compatibility
@@ -1640,10 +2199,16 @@ type PositionServiceServer interface {
 	CreateOrUpdatePosition(context.Context, *PositionInfo) (*PositionInfo, error)
 	// 删除岗位
 	DeletePosition(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除岗位，仍有成员的岗位跳过
+	BatchDeletePosition(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用岗位
+	BatchUpdatePositionState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取岗位
 	GetPosition(context.Context, *ID32Request) (*PositionInfo, error)
 	// 获取岗位列表
 	ListPosition(context.Context, *PositionListRequest) (*PositionListResponse, error)
+	// 为岗位分配角色，任职用户自动获得这些角色
+	AssignPositionRole(context.Context, *PositionRoleRequest) (*BaseResponse, error)
 	mustEmbedUnimplementedPositionServiceServer()
 }
 
@@ -1657,12 +2222,21 @@ func (UnimplementedPositionServiceServer) CreateOrUpdatePosition(context.Context
 func (UnimplementedPositionServiceServer) DeletePosition(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePosition not implemented")
 }
+func (UnimplementedPositionServiceServer) BatchDeletePosition(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeletePosition not implemented")
+}
+func (UnimplementedPositionServiceServer) BatchUpdatePositionState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePositionState not implemented")
+}
 func (UnimplementedPositionServiceServer) GetPosition(context.Context, *ID32Request) (*PositionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPosition not implemented")
 }
 func (UnimplementedPositionServiceServer) ListPosition(context.Context, *PositionListRequest) (*PositionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPosition not implemented")
 }
+func (UnimplementedPositionServiceServer) AssignPositionRole(context.Context, *PositionRoleRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPositionRole not implemented")
+}
 func (UnimplementedPositionServiceServer) mustEmbedUnimplementedPositionServiceServer() {}
 
 // UnsafePositionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1712,6 +2286,42 @@ func _PositionService_DeletePosition_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PositionService_BatchDeletePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).BatchDeletePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_BatchDeletePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).BatchDeletePosition(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PositionService_BatchUpdatePositionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).BatchUpdatePositionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_BatchUpdatePositionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).BatchUpdatePositionState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PositionService_GetPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID32Request)
 	if err := dec(in); err != nil {
@@ -1748,6 +2358,24 @@ func _PositionService_ListPosition_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PositionService_AssignPositionRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PositionServiceServer).AssignPositionRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PositionService_AssignPositionRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PositionServiceServer).AssignPositionRole(ctx, req.(*PositionRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PositionService_ServiceDesc is the grpc.ServiceDesc for PositionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1763,6 +2391,14 @@ var PositionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePosition",
 			Handler:    _PositionService_DeletePosition_Handler,
 		},
+		{
+			MethodName: "BatchDeletePosition",
+			Handler:    _PositionService_BatchDeletePosition_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePositionState",
+			Handler:    _PositionService_BatchUpdatePositionState_Handler,
+		},
 		{
 			MethodName: "GetPosition",
 			Handler:    _PositionService_GetPosition_Handler,
@@ -1771,25 +2407,44 @@ var PositionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPosition",
 			Handler:    _PositionService_ListPosition_Handler,
 		},
+		{
+			MethodName: "AssignPositionRole",
+			Handler:    _PositionService_AssignPositionRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
 }
 
 const (
-	UserService_CreateUser_FullMethodName            = "/core.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName            = "/core.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName            = "/core.UserService/DeleteUser"
-	UserService_GetUser_FullMethodName               = "/core.UserService/GetUser"
-	UserService_ListUser_FullMethodName              = "/core.UserService/ListUser"
-	UserService_GetUserByUsername_FullMethodName     = "/core.UserService/GetUserByUsername"
-	UserService_EnableTotp_FullMethodName            = "/core.UserService/EnableTotp"
-	UserService_VerifyTotpSetup_FullMethodName       = "/core.UserService/VerifyTotpSetup"
-	UserService_DisableTotp_FullMethodName           = "/core.UserService/DisableTotp"
-	UserService_VerifyTotpCode_FullMethodName        = "/core.UserService/VerifyTotpCode"
-	UserService_GetTotpStatus_FullMethodName         = "/core.UserService/GetTotpStatus"
-	UserService_RegenerateBackupCodes_FullMethodName = "/core.UserService/RegenerateBackupCodes"
-	UserService_UseBackupCode_FullMethodName         = "/core.UserService/UseBackupCode"
+	UserService_CreateUser_FullMethodName                 = "/core.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                 = "/core.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                 = "/core.UserService/DeleteUser"
+	UserService_BatchDeleteUser_FullMethodName            = "/core.UserService/BatchDeleteUser"
+	UserService_BatchUpdateUserState_FullMethodName       = "/core.UserService/BatchUpdateUserState"
+	UserService_GetUser_FullMethodName                    = "/core.UserService/GetUser"
+	UserService_ListUser_FullMethodName                   = "/core.UserService/ListUser"
+	UserService_GetUserByUsername_FullMethodName          = "/core.UserService/GetUserByUsername"
+	UserService_GetEffectiveRoles_FullMethodName          = "/core.UserService/GetEffectiveRoles"
+	UserService_EnableTotp_FullMethodName                 = "/core.UserService/EnableTotp"
+	UserService_VerifyTotpSetup_FullMethodName            = "/core.UserService/VerifyTotpSetup"
+	UserService_DisableTotp_FullMethodName                = "/core.UserService/DisableTotp"
+	UserService_VerifyTotpCode_FullMethodName             = "/core.UserService/VerifyTotpCode"
+	UserService_GetTotpStatus_FullMethodName              = "/core.UserService/GetTotpStatus"
+	UserService_RegenerateBackupCodes_FullMethodName      = "/core.UserService/RegenerateBackupCodes"
+	UserService_UseBackupCode_FullMethodName              = "/core.UserService/UseBackupCode"
+	UserService_BeginWebauthnRegistration_FullMethodName  = "/core.UserService/BeginWebauthnRegistration"
+	UserService_FinishWebauthnRegistration_FullMethodName = "/core.UserService/FinishWebauthnRegistration"
+	UserService_BeginWebauthnLogin_FullMethodName         = "/core.UserService/BeginWebauthnLogin"
+	UserService_FinishWebauthnLogin_FullMethodName        = "/core.UserService/FinishWebauthnLogin"
+	UserService_ListWebauthnCredential_FullMethodName     = "/core.UserService/ListWebauthnCredential"
+	UserService_UpdateWebauthnCredential_FullMethodName   = "/core.UserService/UpdateWebauthnCredential"
+	UserService_DeleteWebauthnCredential_FullMethodName   = "/core.UserService/DeleteWebauthnCredential"
+	UserService_ChangePassword_FullMethodName             = "/core.UserService/ChangePassword"
+	UserService_ImportUser_FullMethodName                 = "/core.UserService/ImportUser"
+	UserService_ExportUser_FullMethodName                 = "/core.UserService/ExportUser"
+	UserService_StartImpersonation_FullMethodName         = "/core.UserService/StartImpersonation"
+	UserService_StopImpersonation_FullMethodName          = "/core.UserService/StopImpersonation"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -1802,12 +2457,18 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*UserInfo, error)
 	// 删除用户
 	DeleteUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除用户，系统保留用户跳过
+	BatchDeleteUser(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用用户，系统保留用户跳过
+	BatchUpdateUserState(ctx context.Context, in *BatchStateUUIDRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取用户
 	GetUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*UserInfo, error)
 	// 获取用户列表
 	ListUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserListResponse, error)
 	// 获取用户 - 用户用户名
 	GetUserByUsername(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*UserInfo, error)
+	// 获取用户有效角色及来源
+	GetEffectiveRoles(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*EffectiveRoleResponse, error)
 	// TOTP相关接口
 	// 启用TOTP - 生成密钥和二维码
 	EnableTotp(ctx context.Context, in *EnableTotpRequest, opts ...grpc.CallOption) (*TotpSetupResponse, error)
@@ -1823,6 +2484,31 @@ type UserServiceClient interface {
 	RegenerateBackupCodes(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*BackupCodesResponse, error)
 	// 使用备用恢复码
 	UseBackupCode(ctx context.Context, in *UseBackupCodeRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// WebAuthn相关接口
+	// 开始注册WebAuthn凭证
+	BeginWebauthnRegistration(ctx context.Context, in *WebauthnBeginRegistrationRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error)
+	// 完成注册WebAuthn凭证
+	FinishWebauthnRegistration(ctx context.Context, in *WebauthnFinishRegistrationRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error)
+	// 开始WebAuthn登录
+	BeginWebauthnLogin(ctx context.Context, in *WebauthnBeginLoginRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error)
+	// 完成WebAuthn登录
+	FinishWebauthnLogin(ctx context.Context, in *WebauthnFinishLoginRequest, opts ...grpc.CallOption) (*UserInfo, error)
+	// 获取用户WebAuthn凭证列表
+	ListWebauthnCredential(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*WebauthnCredentialListResponse, error)
+	// 修改WebAuthn凭证
+	UpdateWebauthnCredential(ctx context.Context, in *WebauthnUpdateCredentialRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error)
+	// 删除WebAuthn凭证
+	DeleteWebauthnCredential(ctx context.Context, in *WebauthnDeleteCredentialRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 修改密码
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量导入用户
+	ImportUser(ctx context.Context, in *UserImportRequest, opts ...grpc.CallOption) (*UserImportResponse, error)
+	// 导出用户，筛选条件与 ListUser 一致，忽略分页
+	ExportUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserExportResponse, error)
+	// 开始模拟登录，校验目标用户并记录审计日志
+	StartImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*UserInfo, error)
+	// 结束模拟登录，记录审计日志
+	StopImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*BaseResponse, error)
 }
 
 type userServiceClient struct {
@@ -1863,6 +2549,26 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *UUIDRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) BatchDeleteUser(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchDeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BatchUpdateUserState(ctx context.Context, in *BatchStateUUIDRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, UserService_BatchUpdateUserState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUser(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*UserInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UserInfo)
@@ -1893,6 +2599,16 @@ func (c *userServiceClient) GetUserByUsername(ctx context.Context, in *StringReq
 	return out, nil
 }
 
+func (c *userServiceClient) GetEffectiveRoles(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*EffectiveRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectiveRoleResponse)
+	err := c.cc.Invoke(ctx, UserService_GetEffectiveRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) EnableTotp(ctx context.Context, in *EnableTotpRequest, opts ...grpc.CallOption) (*TotpSetupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TotpSetupResponse)
@@ -1963,6 +2679,126 @@ func (c *userServiceClient) UseBackupCode(ctx context.Context, in *UseBackupCode
 	return out, nil
 }
 
+func (c *userServiceClient) BeginWebauthnRegistration(ctx context.Context, in *WebauthnBeginRegistrationRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebauthnBeginResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginWebauthnRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinishWebauthnRegistration(ctx context.Context, in *WebauthnFinishRegistrationRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebauthnCredentialInfo)
+	err := c.cc.Invoke(ctx, UserService_FinishWebauthnRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BeginWebauthnLogin(ctx context.Context, in *WebauthnBeginLoginRequest, opts ...grpc.CallOption) (*WebauthnBeginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebauthnBeginResponse)
+	err := c.cc.Invoke(ctx, UserService_BeginWebauthnLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinishWebauthnLogin(ctx context.Context, in *WebauthnFinishLoginRequest, opts ...grpc.CallOption) (*UserInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, UserService_FinishWebauthnLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListWebauthnCredential(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*WebauthnCredentialListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebauthnCredentialListResponse)
+	err := c.cc.Invoke(ctx, UserService_ListWebauthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateWebauthnCredential(ctx context.Context, in *WebauthnUpdateCredentialRequest, opts ...grpc.CallOption) (*WebauthnCredentialInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebauthnCredentialInfo)
+	err := c.cc.Invoke(ctx, UserService_UpdateWebauthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteWebauthnCredential(ctx context.Context, in *WebauthnDeleteCredentialRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteWebauthnCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportUser(ctx context.Context, in *UserImportRequest, opts ...grpc.CallOption) (*UserImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserImportResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportUser(ctx context.Context, in *UserListRequest, opts ...grpc.CallOption) (*UserExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserExportResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StartImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*UserInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserInfo)
+	err := c.cc.Invoke(ctx, UserService_StartImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) StopImpersonation(ctx context.Context, in *ImpersonationRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, UserService_StopImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -1973,12 +2809,18 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UserInfo) (*UserInfo, error)
 	// 删除用户
 	DeleteUser(context.Context, *UUIDRequest) (*BaseResponse, error)
+	// 批量删除用户，系统保留用户跳过
+	BatchDeleteUser(context.Context, *UUIDSRequest) (*BatchResponse, error)
+	// 批量启用或禁用用户，系统保留用户跳过
+	BatchUpdateUserState(context.Context, *BatchStateUUIDRequest) (*BatchResponse, error)
 	// 获取用户
 	GetUser(context.Context, *UUIDRequest) (*UserInfo, error)
 	// 获取用户列表
 	ListUser(context.Context, *UserListRequest) (*UserListResponse, error)
 	// 获取用户 - 用户用户名
 	GetUserByUsername(context.Context, *StringRequest) (*UserInfo, error)
+	// 获取用户有效角色及来源
+	GetEffectiveRoles(context.Context, *UUIDRequest) (*EffectiveRoleResponse, error)
 	// TOTP相关接口
 	// 启用TOTP - 生成密钥和二维码
 	EnableTotp(context.Context, *EnableTotpRequest) (*TotpSetupResponse, error)
@@ -1994,6 +2836,31 @@ type UserServiceServer interface {
 	RegenerateBackupCodes(context.Context, *UUIDRequest) (*BackupCodesResponse, error)
 	// 使用备用恢复码
 	UseBackupCode(context.Context, *UseBackupCodeRequest) (*BaseResponse, error)
+	// WebAuthn相关接口
+	// 开始注册WebAuthn凭证
+	BeginWebauthnRegistration(context.Context, *WebauthnBeginRegistrationRequest) (*WebauthnBeginResponse, error)
+	// 完成注册WebAuthn凭证
+	FinishWebauthnRegistration(context.Context, *WebauthnFinishRegistrationRequest) (*WebauthnCredentialInfo, error)
+	// 开始WebAuthn登录
+	BeginWebauthnLogin(context.Context, *WebauthnBeginLoginRequest) (*WebauthnBeginResponse, error)
+	// 完成WebAuthn登录
+	FinishWebauthnLogin(context.Context, *WebauthnFinishLoginRequest) (*UserInfo, error)
+	// 获取用户WebAuthn凭证列表
+	ListWebauthnCredential(context.Context, *UUIDRequest) (*WebauthnCredentialListResponse, error)
+	// 修改WebAuthn凭证
+	UpdateWebauthnCredential(context.Context, *WebauthnUpdateCredentialRequest) (*WebauthnCredentialInfo, error)
+	// 删除WebAuthn凭证
+	DeleteWebauthnCredential(context.Context, *WebauthnDeleteCredentialRequest) (*BaseResponse, error)
+	// 修改密码
+	ChangePassword(context.Context, *ChangePasswordRequest) (*BaseResponse, error)
+	// 批量导入用户
+	ImportUser(context.Context, *UserImportRequest) (*UserImportResponse, error)
+	// 导出用户，筛选条件与 ListUser 一致，忽略分页
+	ExportUser(context.Context, *UserListRequest) (*UserExportResponse, error)
+	// 开始模拟登录，校验目标用户并记录审计日志
+	StartImpersonation(context.Context, *ImpersonationRequest) (*UserInfo, error)
+	// 结束模拟登录，记录审计日志
+	StopImpersonation(context.Context, *ImpersonationRequest) (*BaseResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -2010,6 +2877,12 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UserInfo) (*U
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *UUIDRequest) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) BatchDeleteUser(context.Context, *UUIDSRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) BatchUpdateUserState(context.Context, *BatchStateUUIDRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateUserState not implemented")
+}
 func (UnimplementedUserServiceServer) GetUser(context.Context, *UUIDRequest) (*UserInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -2019,6 +2892,9 @@ func (UnimplementedUserServiceServer) ListUser(context.Context, *UserListRequest
 func (UnimplementedUserServiceServer) GetUserByUsername(context.Context, *StringRequest) (*UserInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
 }
+func (UnimplementedUserServiceServer) GetEffectiveRoles(context.Context, *UUIDRequest) (*EffectiveRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveRoles not implemented")
+}
 func (UnimplementedUserServiceServer) EnableTotp(context.Context, *EnableTotpRequest) (*TotpSetupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableTotp not implemented")
 }
@@ -2040,6 +2916,42 @@ func (UnimplementedUserServiceServer) RegenerateBackupCodes(context.Context, *UU
 func (UnimplementedUserServiceServer) UseBackupCode(context.Context, *UseBackupCodeRequest) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseBackupCode not implemented")
 }
+func (UnimplementedUserServiceServer) BeginWebauthnRegistration(context.Context, *WebauthnBeginRegistrationRequest) (*WebauthnBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWebauthnRegistration not implemented")
+}
+func (UnimplementedUserServiceServer) FinishWebauthnRegistration(context.Context, *WebauthnFinishRegistrationRequest) (*WebauthnCredentialInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishWebauthnRegistration not implemented")
+}
+func (UnimplementedUserServiceServer) BeginWebauthnLogin(context.Context, *WebauthnBeginLoginRequest) (*WebauthnBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWebauthnLogin not implemented")
+}
+func (UnimplementedUserServiceServer) FinishWebauthnLogin(context.Context, *WebauthnFinishLoginRequest) (*UserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishWebauthnLogin not implemented")
+}
+func (UnimplementedUserServiceServer) ListWebauthnCredential(context.Context, *UUIDRequest) (*WebauthnCredentialListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebauthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateWebauthnCredential(context.Context, *WebauthnUpdateCredentialRequest) (*WebauthnCredentialInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebauthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteWebauthnCredential(context.Context, *WebauthnDeleteCredentialRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebauthnCredential not implemented")
+}
+func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServiceServer) ImportUser(context.Context, *UserImportRequest) (*UserImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUser not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUser(context.Context, *UserListRequest) (*UserExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUser not implemented")
+}
+func (UnimplementedUserServiceServer) StartImpersonation(context.Context, *ImpersonationRequest) (*UserInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartImpersonation not implemented")
+}
+func (UnimplementedUserServiceServer) StopImpersonation(context.Context, *ImpersonationRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopImpersonation not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2107,43 +3019,79 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UUIDRequest)
+func _UserService_BatchDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDSRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).BatchDeleteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_BatchDeleteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*UUIDRequest))
+		return srv.(UserServiceServer).BatchDeleteUser(ctx, req.(*UUIDSRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserListRequest)
+func _UserService_BatchUpdateUserState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateUUIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUser(ctx, in)
+		return srv.(UserServiceServer).BatchUpdateUserState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUser_FullMethodName,
+		FullMethod: UserService_BatchUpdateUserState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUser(ctx, req.(*UserListRequest))
+		return srv.(UserServiceServer).BatchUpdateUserState(ctx, req.(*BatchStateUUIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUser(ctx, req.(*UserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -2161,6 +3109,24 @@ func _UserService_GetUserByUsername_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetEffectiveRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetEffectiveRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetEffectiveRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetEffectiveRoles(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_EnableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableTotpRequest)
 	if err := dec(in); err != nil {
@@ -2287,6 +3253,222 @@ func _UserService_UseBackupCode_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BeginWebauthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnBeginRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginWebauthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginWebauthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginWebauthnRegistration(ctx, req.(*WebauthnBeginRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FinishWebauthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnFinishRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FinishWebauthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FinishWebauthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FinishWebauthnRegistration(ctx, req.(*WebauthnFinishRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BeginWebauthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnBeginLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BeginWebauthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BeginWebauthnLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BeginWebauthnLogin(ctx, req.(*WebauthnBeginLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FinishWebauthnLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnFinishLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FinishWebauthnLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FinishWebauthnLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FinishWebauthnLogin(ctx, req.(*WebauthnFinishLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListWebauthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListWebauthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListWebauthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListWebauthnCredential(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateWebauthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnUpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateWebauthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateWebauthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateWebauthnCredential(ctx, req.(*WebauthnUpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteWebauthnCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebauthnDeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteWebauthnCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteWebauthnCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteWebauthnCredential(ctx, req.(*WebauthnDeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportUser(ctx, req.(*UserImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUser(ctx, req.(*UserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StartImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StartImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StartImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StartImpersonation(ctx, req.(*ImpersonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_StopImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).StopImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_StopImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).StopImpersonation(ctx, req.(*ImpersonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2306,6 +3488,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "BatchDeleteUser",
+			Handler:    _UserService_BatchDeleteUser_Handler,
+		},
+		{
+			MethodName: "BatchUpdateUserState",
+			Handler:    _UserService_BatchUpdateUserState_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
@@ -2318,6 +3508,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByUsername",
 			Handler:    _UserService_GetUserByUsername_Handler,
 		},
+		{
+			MethodName: "GetEffectiveRoles",
+			Handler:    _UserService_GetEffectiveRoles_Handler,
+		},
 		{
 			MethodName: "EnableTotp",
 			Handler:    _UserService_EnableTotp_Handler,
@@ -2346,18 +3540,68 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UseBackupCode",
 			Handler:    _UserService_UseBackupCode_Handler,
 		},
+		{
+			MethodName: "BeginWebauthnRegistration",
+			Handler:    _UserService_BeginWebauthnRegistration_Handler,
+		},
+		{
+			MethodName: "FinishWebauthnRegistration",
+			Handler:    _UserService_FinishWebauthnRegistration_Handler,
+		},
+		{
+			MethodName: "BeginWebauthnLogin",
+			Handler:    _UserService_BeginWebauthnLogin_Handler,
+		},
+		{
+			MethodName: "FinishWebauthnLogin",
+			Handler:    _UserService_FinishWebauthnLogin_Handler,
+		},
+		{
+			MethodName: "ListWebauthnCredential",
+			Handler:    _UserService_ListWebauthnCredential_Handler,
+		},
+		{
+			MethodName: "UpdateWebauthnCredential",
+			Handler:    _UserService_UpdateWebauthnCredential_Handler,
+		},
+		{
+			MethodName: "DeleteWebauthnCredential",
+			Handler:    _UserService_DeleteWebauthnCredential_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ImportUser",
+			Handler:    _UserService_ImportUser_Handler,
+		},
+		{
+			MethodName: "ExportUser",
+			Handler:    _UserService_ExportUser_Handler,
+		},
+		{
+			MethodName: "StartImpersonation",
+			Handler:    _UserService_StartImpersonation_Handler,
+		},
+		{
+			MethodName: "StopImpersonation",
+			Handler:    _UserService_StopImpersonation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
 }
 
 const (
-	OauthProviderService_CreateOrUpdateOauthProvider_FullMethodName = "/core.OauthProviderService/CreateOrUpdateOauthProvider"
-	OauthProviderService_DeleteOauthProvider_FullMethodName         = "/core.OauthProviderService/DeleteOauthProvider"
-	OauthProviderService_GetOauthProvider_FullMethodName            = "/core.OauthProviderService/GetOauthProvider"
-	OauthProviderService_ListOauthProvider_FullMethodName           = "/core.OauthProviderService/ListOauthProvider"
-	OauthProviderService_OauthLogin_FullMethodName                  = "/core.OauthProviderService/OauthLogin"
-	OauthProviderService_OauthCallback_FullMethodName               = "/core.OauthProviderService/OauthCallback"
+	OauthProviderService_CreateOrUpdateOauthProvider_FullMethodName   = "/core.OauthProviderService/CreateOrUpdateOauthProvider"
+	OauthProviderService_DeleteOauthProvider_FullMethodName           = "/core.OauthProviderService/DeleteOauthProvider"
+	OauthProviderService_BatchDeleteOauthProvider_FullMethodName      = "/core.OauthProviderService/BatchDeleteOauthProvider"
+	OauthProviderService_BatchUpdateOauthProviderState_FullMethodName = "/core.OauthProviderService/BatchUpdateOauthProviderState"
+	OauthProviderService_GetOauthProvider_FullMethodName              = "/core.OauthProviderService/GetOauthProvider"
+	OauthProviderService_ListOauthProvider_FullMethodName             = "/core.OauthProviderService/ListOauthProvider"
+	OauthProviderService_OauthLogin_FullMethodName                    = "/core.OauthProviderService/OauthLogin"
+	OauthProviderService_OauthCallback_FullMethodName                 = "/core.OauthProviderService/OauthCallback"
 )
 
 // OauthProviderServiceClient is the client API for OauthProviderService service.
@@ -2368,6 +3612,10 @@ type OauthProviderServiceClient interface {
 	CreateOrUpdateOauthProvider(ctx context.Context, in *OauthProviderInfo, opts ...grpc.CallOption) (*OauthProviderInfo, error)
 	// 删除提供商
 	DeleteOauthProvider(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除第三方登录提供商
+	BatchDeleteOauthProvider(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用第三方登录提供商
+	BatchUpdateOauthProviderState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取提供商
 	GetOauthProvider(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*OauthProviderInfo, error)
 	// 获取提供商列表
@@ -2406,6 +3654,26 @@ func (c *oauthProviderServiceClient) DeleteOauthProvider(ctx context.Context, in
 	return out, nil
 }
 
+func (c *oauthProviderServiceClient) BatchDeleteOauthProvider(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, OauthProviderService_BatchDeleteOauthProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthProviderServiceClient) BatchUpdateOauthProviderState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, OauthProviderService_BatchUpdateOauthProviderState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oauthProviderServiceClient) GetOauthProvider(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*OauthProviderInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OauthProviderInfo)
@@ -2454,6 +3722,10 @@ type OauthProviderServiceServer interface {
 	CreateOrUpdateOauthProvider(context.Context, *OauthProviderInfo) (*OauthProviderInfo, error)
 	// 删除提供商
 	DeleteOauthProvider(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除第三方登录提供商
+	BatchDeleteOauthProvider(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用第三方登录提供商
+	BatchUpdateOauthProviderState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取提供商
 	GetOauthProvider(context.Context, *ID32Request) (*OauthProviderInfo, error)
 	// 获取提供商列表
@@ -2475,6 +3747,12 @@ func (UnimplementedOauthProviderServiceServer) CreateOrUpdateOauthProvider(conte
 func (UnimplementedOauthProviderServiceServer) DeleteOauthProvider(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthProvider not implemented")
 }
+func (UnimplementedOauthProviderServiceServer) BatchDeleteOauthProvider(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteOauthProvider not implemented")
+}
+func (UnimplementedOauthProviderServiceServer) BatchUpdateOauthProviderState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateOauthProviderState not implemented")
+}
 func (UnimplementedOauthProviderServiceServer) GetOauthProvider(context.Context, *ID32Request) (*OauthProviderInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOauthProvider not implemented")
 }
@@ -2536,7 +3814,43 @@ func _OauthProviderService_DeleteOauthProvider_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OauthProviderService_GetOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _OauthProviderService_BatchDeleteOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthProviderServiceServer).BatchDeleteOauthProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthProviderService_BatchDeleteOauthProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthProviderServiceServer).BatchDeleteOauthProvider(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthProviderService_BatchUpdateOauthProviderState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthProviderServiceServer).BatchUpdateOauthProviderState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthProviderService_BatchUpdateOauthProviderState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthProviderServiceServer).BatchUpdateOauthProviderState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthProviderService_GetOauthProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID32Request)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -2623,6 +3937,14 @@ var OauthProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOauthProvider",
 			Handler:    _OauthProviderService_DeleteOauthProvider_Handler,
 		},
+		{
+			MethodName: "BatchDeleteOauthProvider",
+			Handler:    _OauthProviderService_BatchDeleteOauthProvider_Handler,
+		},
+		{
+			MethodName: "BatchUpdateOauthProviderState",
+			Handler:    _OauthProviderService_BatchUpdateOauthProviderState_Handler,
+		},
 		{
 			MethodName: "GetOauthProvider",
 			Handler:    _OauthProviderService_GetOauthProvider_Handler,
@@ -2645,13 +3967,16 @@ var OauthProviderService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TokenService_CreateToken_FullMethodName         = "/core.TokenService/CreateToken"
-	TokenService_GetTokenByValue_FullMethodName     = "/core.TokenService/GetTokenByValue"
-	TokenService_UpdateToken_FullMethodName         = "/core.TokenService/UpdateToken"
-	TokenService_DeleteToken_FullMethodName         = "/core.TokenService/DeleteToken"
-	TokenService_ListToken_FullMethodName           = "/core.TokenService/ListToken"
-	TokenService_CleanExpiredTokens_FullMethodName  = "/core.TokenService/CleanExpiredTokens"
-	TokenService_UpdateTokenLastUsed_FullMethodName = "/core.TokenService/UpdateTokenLastUsed"
+	TokenService_CreateToken_FullMethodName           = "/core.TokenService/CreateToken"
+	TokenService_GetTokenByValue_FullMethodName       = "/core.TokenService/GetTokenByValue"
+	TokenService_UpdateToken_FullMethodName           = "/core.TokenService/UpdateToken"
+	TokenService_DeleteToken_FullMethodName           = "/core.TokenService/DeleteToken"
+	TokenService_BatchDeleteToken_FullMethodName      = "/core.TokenService/BatchDeleteToken"
+	TokenService_BatchUpdateTokenState_FullMethodName = "/core.TokenService/BatchUpdateTokenState"
+	TokenService_ListToken_FullMethodName             = "/core.TokenService/ListToken"
+	TokenService_CleanExpiredTokens_FullMethodName    = "/core.TokenService/CleanExpiredTokens"
+	TokenService_UpdateTokenLastUsed_FullMethodName   = "/core.TokenService/UpdateTokenLastUsed"
+	TokenService_RevokeUserTokens_FullMethodName      = "/core.TokenService/RevokeUserTokens"
 )
 
 // TokenServiceClient is the client API for TokenService service.
@@ -2668,12 +3993,18 @@ type TokenServiceClient interface {
 	UpdateToken(ctx context.Context, in *TokenInfo, opts ...grpc.CallOption) (*TokenInfo, error)
 	// 删除Token
 	DeleteToken(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 批量删除令牌，删除的令牌同时加入黑名单
+	BatchDeleteToken(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// 批量启用或禁用令牌，同步维护黑名单
+	BatchUpdateTokenState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error)
 	// 获取Token列表
 	ListToken(ctx context.Context, in *TokenListRequest, opts ...grpc.CallOption) (*TokenListResponse, error)
 	// 清理过期Token
 	CleanExpiredTokens(ctx context.Context, in *CleanExpiredTokensRequest, opts ...grpc.CallOption) (*CleanExpiredTokensResponse, error)
 	// 更新Token最后使用时间
 	UpdateTokenLastUsed(ctx context.Context, in *UpdateTokenLastUsedRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 撤销用户的Token，同时写入黑名单
+	RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error)
 }
 
 type tokenServiceClient struct {
@@ -2724,6 +4055,26 @@ func (c *tokenServiceClient) DeleteToken(ctx context.Context, in *ID32Request, o
 	return out, nil
 }
 
+func (c *tokenServiceClient) BatchDeleteToken(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, TokenService_BatchDeleteToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) BatchUpdateTokenState(ctx context.Context, in *BatchStateID32Request, opts ...grpc.CallOption) (*BatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, TokenService_BatchUpdateTokenState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tokenServiceClient) ListToken(ctx context.Context, in *TokenListRequest, opts ...grpc.CallOption) (*TokenListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TokenListResponse)
@@ -2754,6 +4105,16 @@ func (c *tokenServiceClient) UpdateTokenLastUsed(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *tokenServiceClient) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserTokensResponse)
+	err := c.cc.Invoke(ctx, TokenService_RevokeUserTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenServiceServer is the server API for TokenService service.
 // All implementations must embed UnimplementedTokenServiceServer
 // for forward compatibility
@@ -2768,12 +4129,18 @@ type TokenServiceServer interface {
 	UpdateToken(context.Context, *TokenInfo) (*TokenInfo, error)
 	// 删除Token
 	DeleteToken(context.Context, *ID32Request) (*BaseResponse, error)
+	// 批量删除令牌，删除的令牌同时加入黑名单
+	BatchDeleteToken(context.Context, *ID32SRequest) (*BatchResponse, error)
+	// 批量启用或禁用令牌，同步维护黑名单
+	BatchUpdateTokenState(context.Context, *BatchStateID32Request) (*BatchResponse, error)
 	// 获取Token列表
 	ListToken(context.Context, *TokenListRequest) (*TokenListResponse, error)
 	// 清理过期Token
 	CleanExpiredTokens(context.Context, *CleanExpiredTokensRequest) (*CleanExpiredTokensResponse, error)
 	// 更新Token最后使用时间
 	UpdateTokenLastUsed(context.Context, *UpdateTokenLastUsedRequest) (*BaseResponse, error)
+	// 撤销用户的Token，同时写入黑名单
+	RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error)
 	mustEmbedUnimplementedTokenServiceServer()
 }
 
@@ -2793,6 +4160,12 @@ func (UnimplementedTokenServiceServer) UpdateToken(context.Context, *TokenInfo)
 func (UnimplementedTokenServiceServer) DeleteToken(context.Context, *ID32Request) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (UnimplementedTokenServiceServer) BatchDeleteToken(context.Context, *ID32SRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteToken not implemented")
+}
+func (UnimplementedTokenServiceServer) BatchUpdateTokenState(context.Context, *BatchStateID32Request) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTokenState not implemented")
+}
 func (UnimplementedTokenServiceServer) ListToken(context.Context, *TokenListRequest) (*TokenListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListToken not implemented")
 }
@@ -2802,6 +4175,9 @@ func (UnimplementedTokenServiceServer) CleanExpiredTokens(context.Context, *Clea
 func (UnimplementedTokenServiceServer) UpdateTokenLastUsed(context.Context, *UpdateTokenLastUsedRequest) (*BaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenLastUsed not implemented")
 }
+func (UnimplementedTokenServiceServer) RevokeUserTokens(context.Context, *RevokeUserTokensRequest) (*RevokeUserTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserTokens not implemented")
+}
 func (UnimplementedTokenServiceServer) mustEmbedUnimplementedTokenServiceServer() {}
 
 // UnsafeTokenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2887,6 +4263,42 @@ func _TokenService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenService_BatchDeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).BatchDeleteToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokenService_BatchDeleteToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).BatchDeleteToken(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_BatchUpdateTokenState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStateID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).BatchUpdateTokenState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokenService_BatchUpdateTokenState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).BatchUpdateTokenState(ctx, req.(*BatchStateID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TokenService_ListToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TokenListRequest)
 	if err := dec(in); err != nil {
@@ -2941,6 +4353,24 @@ func _TokenService_UpdateTokenLastUsed_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenService_RevokeUserTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).RevokeUserTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TokenService_RevokeUserTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).RevokeUserTokens(ctx, req.(*RevokeUserTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TokenService_ServiceDesc is the grpc.ServiceDesc for TokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2964,6 +4394,14 @@ var TokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _TokenService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "BatchDeleteToken",
+			Handler:    _TokenService_BatchDeleteToken_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTokenState",
+			Handler:    _TokenService_BatchUpdateTokenState_Handler,
+		},
 		{
 			MethodName: "ListToken",
 			Handler:    _TokenService_ListToken_Handler,
@@ -2976,6 +4414,10 @@ var TokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTokenLastUsed",
 			Handler:    _TokenService_UpdateTokenLastUsed_Handler,
 		},
+		{
+			MethodName: "RevokeUserTokens",
+			Handler:    _TokenService_RevokeUserTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
@@ -3324,3 +4766,2540 @@ var ConfigurationService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/core.proto",
 }
+
+const (
+	OperationLogService_CreateOperationLog_FullMethodName         = "/core.OperationLogService/CreateOperationLog"
+	OperationLogService_ListOperationLog_FullMethodName           = "/core.OperationLogService/ListOperationLog"
+	OperationLogService_ExportOperationLog_FullMethodName         = "/core.OperationLogService/ExportOperationLog"
+	OperationLogService_ListOperationLogArchive_FullMethodName    = "/core.OperationLogService/ListOperationLogArchive"
+	OperationLogService_RestoreOperationLogArchive_FullMethodName = "/core.OperationLogService/RestoreOperationLogArchive"
+)
+
+// OperationLogServiceClient is the client API for OperationLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OperationLogServiceClient interface {
+	CreateOperationLog(ctx context.Context, in *OperationLogInfo, opts ...grpc.CallOption) (*BaseResponse, error)
+	ListOperationLog(ctx context.Context, in *OperationLogListRequest, opts ...grpc.CallOption) (*OperationLogListResponse, error)
+	// 按列表条件导出操作记录，忽略分页参数
+	ExportOperationLog(ctx context.Context, in *OperationLogListRequest, opts ...grpc.CallOption) (OperationLogService_ExportOperationLogClient, error)
+	// 获取归档列表
+	ListOperationLogArchive(ctx context.Context, in *OperationLogArchiveListRequest, opts ...grpc.CallOption) (*OperationLogArchiveListResponse, error)
+	// 将归档记录恢复到操作记录表
+	RestoreOperationLogArchive(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type operationLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOperationLogServiceClient(cc grpc.ClientConnInterface) OperationLogServiceClient {
+	return &operationLogServiceClient{cc}
+}
+
+func (c *operationLogServiceClient) CreateOperationLog(ctx context.Context, in *OperationLogInfo, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, OperationLogService_CreateOperationLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationLogServiceClient) ListOperationLog(ctx context.Context, in *OperationLogListRequest, opts ...grpc.CallOption) (*OperationLogListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationLogListResponse)
+	err := c.cc.Invoke(ctx, OperationLogService_ListOperationLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationLogServiceClient) ExportOperationLog(ctx context.Context, in *OperationLogListRequest, opts ...grpc.CallOption) (OperationLogService_ExportOperationLogClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OperationLogService_ServiceDesc.Streams[0], OperationLogService_ExportOperationLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operationLogServiceExportOperationLogClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OperationLogService_ExportOperationLogClient interface {
+	Recv() (*OperationLogInfo, error)
+	grpc.ClientStream
+}
+
+type operationLogServiceExportOperationLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *operationLogServiceExportOperationLogClient) Recv() (*OperationLogInfo, error) {
+	m := new(OperationLogInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operationLogServiceClient) ListOperationLogArchive(ctx context.Context, in *OperationLogArchiveListRequest, opts ...grpc.CallOption) (*OperationLogArchiveListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationLogArchiveListResponse)
+	err := c.cc.Invoke(ctx, OperationLogService_ListOperationLogArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operationLogServiceClient) RestoreOperationLogArchive(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, OperationLogService_RestoreOperationLogArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperationLogServiceServer is the server API for OperationLogService service.
+// All implementations must embed UnimplementedOperationLogServiceServer
+// for forward compatibility
+type OperationLogServiceServer interface {
+	CreateOperationLog(context.Context, *OperationLogInfo) (*BaseResponse, error)
+	ListOperationLog(context.Context, *OperationLogListRequest) (*OperationLogListResponse, error)
+	// 按列表条件导出操作记录，忽略分页参数
+	ExportOperationLog(*OperationLogListRequest, OperationLogService_ExportOperationLogServer) error
+	// 获取归档列表
+	ListOperationLogArchive(context.Context, *OperationLogArchiveListRequest) (*OperationLogArchiveListResponse, error)
+	// 将归档记录恢复到操作记录表
+	RestoreOperationLogArchive(context.Context, *ID32Request) (*BaseResponse, error)
+	mustEmbedUnimplementedOperationLogServiceServer()
+}
+
+// UnimplementedOperationLogServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOperationLogServiceServer struct {
+}
+
+func (UnimplementedOperationLogServiceServer) CreateOperationLog(context.Context, *OperationLogInfo) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOperationLog not implemented")
+}
+func (UnimplementedOperationLogServiceServer) ListOperationLog(context.Context, *OperationLogListRequest) (*OperationLogListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperationLog not implemented")
+}
+func (UnimplementedOperationLogServiceServer) ExportOperationLog(*OperationLogListRequest, OperationLogService_ExportOperationLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOperationLog not implemented")
+}
+func (UnimplementedOperationLogServiceServer) ListOperationLogArchive(context.Context, *OperationLogArchiveListRequest) (*OperationLogArchiveListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperationLogArchive not implemented")
+}
+func (UnimplementedOperationLogServiceServer) RestoreOperationLogArchive(context.Context, *ID32Request) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreOperationLogArchive not implemented")
+}
+func (UnimplementedOperationLogServiceServer) mustEmbedUnimplementedOperationLogServiceServer() {}
+
+// UnsafeOperationLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OperationLogServiceServer will
+// result in compilation errors.
+type UnsafeOperationLogServiceServer interface {
+	mustEmbedUnimplementedOperationLogServiceServer()
+}
+
+func RegisterOperationLogServiceServer(s grpc.ServiceRegistrar, srv OperationLogServiceServer) {
+	s.RegisterService(&OperationLogService_ServiceDesc, srv)
+}
+
+func _OperationLogService_CreateOperationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationLogInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationLogServiceServer).CreateOperationLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationLogService_CreateOperationLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationLogServiceServer).CreateOperationLog(ctx, req.(*OperationLogInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationLogService_ListOperationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationLogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationLogServiceServer).ListOperationLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationLogService_ListOperationLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationLogServiceServer).ListOperationLog(ctx, req.(*OperationLogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationLogService_ExportOperationLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OperationLogListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperationLogServiceServer).ExportOperationLog(m, &operationLogServiceExportOperationLogServer{ServerStream: stream})
+}
+
+type OperationLogService_ExportOperationLogServer interface {
+	Send(*OperationLogInfo) error
+	grpc.ServerStream
+}
+
+type operationLogServiceExportOperationLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *operationLogServiceExportOperationLogServer) Send(m *OperationLogInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OperationLogService_ListOperationLogArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationLogArchiveListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationLogServiceServer).ListOperationLogArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationLogService_ListOperationLogArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationLogServiceServer).ListOperationLogArchive(ctx, req.(*OperationLogArchiveListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperationLogService_RestoreOperationLogArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperationLogServiceServer).RestoreOperationLogArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperationLogService_RestoreOperationLogArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperationLogServiceServer).RestoreOperationLogArchive(ctx, req.(*ID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OperationLogService_ServiceDesc is the grpc.ServiceDesc for OperationLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OperationLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.OperationLogService",
+	HandlerType: (*OperationLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOperationLog",
+			Handler:    _OperationLogService_CreateOperationLog_Handler,
+		},
+		{
+			MethodName: "ListOperationLog",
+			Handler:    _OperationLogService_ListOperationLog_Handler,
+		},
+		{
+			MethodName: "ListOperationLogArchive",
+			Handler:    _OperationLogService_ListOperationLogArchive_Handler,
+		},
+		{
+			MethodName: "RestoreOperationLogArchive",
+			Handler:    _OperationLogService_RestoreOperationLogArchive_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOperationLog",
+			Handler:       _OperationLogService_ExportOperationLog_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	ApiKeyService_CreateApiKey_FullMethodName       = "/core.ApiKeyService/CreateApiKey"
+	ApiKeyService_UpdateApiKey_FullMethodName       = "/core.ApiKeyService/UpdateApiKey"
+	ApiKeyService_DeleteApiKey_FullMethodName       = "/core.ApiKeyService/DeleteApiKey"
+	ApiKeyService_ListApiKey_FullMethodName         = "/core.ApiKeyService/ListApiKey"
+	ApiKeyService_AuthenticateApiKey_FullMethodName = "/core.ApiKeyService/AuthenticateApiKey"
+)
+
+// ApiKeyServiceClient is the client API for ApiKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApiKeyServiceClient interface {
+	// 创建API密钥
+	CreateApiKey(ctx context.Context, in *ApiKeyInfo, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// 更新API密钥，指定用户ID时只能更新该用户的密钥
+	UpdateApiKey(ctx context.Context, in *ApiKeyInfo, opts ...grpc.CallOption) (*ApiKeyInfo, error)
+	// 删除API密钥
+	DeleteApiKey(ctx context.Context, in *ApiKeyDeleteRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取API密钥列表
+	ListApiKey(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error)
+	// 校验API密钥并记录使用情况
+	AuthenticateApiKey(ctx context.Context, in *ApiKeyAuthRequest, opts ...grpc.CallOption) (*ApiKeyAuthResponse, error)
+}
+
+type apiKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiKeyServiceClient(cc grpc.ClientConnInterface) ApiKeyServiceClient {
+	return &apiKeyServiceClient{cc}
+}
+
+func (c *apiKeyServiceClient) CreateApiKey(ctx context.Context, in *ApiKeyInfo, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) UpdateApiKey(ctx context.Context, in *ApiKeyInfo, opts ...grpc.CallOption) (*ApiKeyInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyInfo)
+	err := c.cc.Invoke(ctx, ApiKeyService_UpdateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) DeleteApiKey(ctx context.Context, in *ApiKeyDeleteRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_DeleteApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) ListApiKey(ctx context.Context, in *ApiKeyListRequest, opts ...grpc.CallOption) (*ApiKeyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyListResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_ListApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) AuthenticateApiKey(ctx context.Context, in *ApiKeyAuthRequest, opts ...grpc.CallOption) (*ApiKeyAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeyAuthResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_AuthenticateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiKeyServiceServer is the server API for ApiKeyService service.
+// All implementations must embed UnimplementedApiKeyServiceServer
+// for forward compatibility
+type ApiKeyServiceServer interface {
+	// 创建API密钥
+	CreateApiKey(context.Context, *ApiKeyInfo) (*CreateApiKeyResponse, error)
+	// 更新API密钥，指定用户ID时只能更新该用户的密钥
+	UpdateApiKey(context.Context, *ApiKeyInfo) (*ApiKeyInfo, error)
+	// 删除API密钥
+	DeleteApiKey(context.Context, *ApiKeyDeleteRequest) (*BaseResponse, error)
+	// 获取API密钥列表
+	ListApiKey(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error)
+	// 校验API密钥并记录使用情况
+	AuthenticateApiKey(context.Context, *ApiKeyAuthRequest) (*ApiKeyAuthResponse, error)
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+// UnimplementedApiKeyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedApiKeyServiceServer struct {
+}
+
+func (UnimplementedApiKeyServiceServer) CreateApiKey(context.Context, *ApiKeyInfo) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) UpdateApiKey(context.Context, *ApiKeyInfo) (*ApiKeyInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) DeleteApiKey(context.Context, *ApiKeyDeleteRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) ListApiKey(context.Context, *ApiKeyListRequest) (*ApiKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) AuthenticateApiKey(context.Context, *ApiKeyAuthRequest) (*ApiKeyAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) mustEmbedUnimplementedApiKeyServiceServer() {}
+
+// UnsafeApiKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiKeyServiceServer will
+// result in compilation errors.
+type UnsafeApiKeyServiceServer interface {
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+func RegisterApiKeyServiceServer(s grpc.ServiceRegistrar, srv ApiKeyServiceServer) {
+	s.RegisterService(&ApiKeyService_ServiceDesc, srv)
+}
+
+func _ApiKeyService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, req.(*ApiKeyInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_UpdateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).UpdateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_UpdateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).UpdateApiKey(ctx, req.(*ApiKeyInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_DeleteApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).DeleteApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_DeleteApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).DeleteApiKey(ctx, req.(*ApiKeyDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_ListApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).ListApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_ListApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).ListApiKey(ctx, req.(*ApiKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_AuthenticateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).AuthenticateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_AuthenticateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).AuthenticateApiKey(ctx, req.(*ApiKeyAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiKeyService_ServiceDesc is the grpc.ServiceDesc for ApiKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.ApiKeyService",
+	HandlerType: (*ApiKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _ApiKeyService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "UpdateApiKey",
+			Handler:    _ApiKeyService_UpdateApiKey_Handler,
+		},
+		{
+			MethodName: "DeleteApiKey",
+			Handler:    _ApiKeyService_DeleteApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKey",
+			Handler:    _ApiKeyService_ListApiKey_Handler,
+		},
+		{
+			MethodName: "AuthenticateApiKey",
+			Handler:    _ApiKeyService_AuthenticateApiKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	OauthClientService_CreateOauthClient_FullMethodName       = "/core.OauthClientService/CreateOauthClient"
+	OauthClientService_UpdateOauthClient_FullMethodName       = "/core.OauthClientService/UpdateOauthClient"
+	OauthClientService_DeleteOauthClient_FullMethodName       = "/core.OauthClientService/DeleteOauthClient"
+	OauthClientService_ListOauthClient_FullMethodName         = "/core.OauthClientService/ListOauthClient"
+	OauthClientService_AuthenticateOauthClient_FullMethodName = "/core.OauthClientService/AuthenticateOauthClient"
+	OauthClientService_GetOauthConsent_FullMethodName         = "/core.OauthClientService/GetOauthConsent"
+	OauthClientService_SaveOauthConsent_FullMethodName        = "/core.OauthClientService/SaveOauthConsent"
+	OauthClientService_ListOauthConsent_FullMethodName        = "/core.OauthClientService/ListOauthConsent"
+	OauthClientService_DeleteOauthConsent_FullMethodName      = "/core.OauthClientService/DeleteOauthConsent"
+)
+
+// OauthClientServiceClient is the client API for OauthClientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OauthClientServiceClient interface {
+	// 创建OAuth客户端
+	CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResponse, error)
+	// 更新OAuth客户端
+	UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResponse, error)
+	// 删除OAuth客户端
+	DeleteOauthClient(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取OAuth客户端列表
+	ListOauthClient(ctx context.Context, in *OauthClientListRequest, opts ...grpc.CallOption) (*OauthClientListResponse, error)
+	// 认证OAuth客户端
+	AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthRequest, opts ...grpc.CallOption) (*OauthClientInfo, error)
+	// 获取用户对客户端的授权记录
+	GetOauthConsent(ctx context.Context, in *OauthConsentRequest, opts ...grpc.CallOption) (*OauthConsentInfo, error)
+	// 保存用户对客户端的授权记录
+	SaveOauthConsent(ctx context.Context, in *OauthConsentInfo, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取用户的授权记录列表
+	ListOauthConsent(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*OauthConsentListResponse, error)
+	// 撤销用户对客户端的授权
+	DeleteOauthConsent(ctx context.Context, in *OauthConsentRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type oauthClientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOauthClientServiceClient(cc grpc.ClientConnInterface) OauthClientServiceClient {
+	return &oauthClientServiceClient{cc}
+}
+
+func (c *oauthClientServiceClient) CreateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthClientSecretResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_CreateOauthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) UpdateOauthClient(ctx context.Context, in *OauthClientInfo, opts ...grpc.CallOption) (*OauthClientSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthClientSecretResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_UpdateOauthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) DeleteOauthClient(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_DeleteOauthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) ListOauthClient(ctx context.Context, in *OauthClientListRequest, opts ...grpc.CallOption) (*OauthClientListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthClientListResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_ListOauthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) AuthenticateOauthClient(ctx context.Context, in *OauthClientAuthRequest, opts ...grpc.CallOption) (*OauthClientInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthClientInfo)
+	err := c.cc.Invoke(ctx, OauthClientService_AuthenticateOauthClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) GetOauthConsent(ctx context.Context, in *OauthConsentRequest, opts ...grpc.CallOption) (*OauthConsentInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthConsentInfo)
+	err := c.cc.Invoke(ctx, OauthClientService_GetOauthConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) SaveOauthConsent(ctx context.Context, in *OauthConsentInfo, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_SaveOauthConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) ListOauthConsent(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*OauthConsentListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OauthConsentListResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_ListOauthConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oauthClientServiceClient) DeleteOauthConsent(ctx context.Context, in *OauthConsentRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, OauthClientService_DeleteOauthConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OauthClientServiceServer is the server API for OauthClientService service.
+// All implementations must embed UnimplementedOauthClientServiceServer
+// for forward compatibility
+type OauthClientServiceServer interface {
+	// 创建OAuth客户端
+	CreateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResponse, error)
+	// 更新OAuth客户端
+	UpdateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResponse, error)
+	// 删除OAuth客户端
+	DeleteOauthClient(context.Context, *ID32Request) (*BaseResponse, error)
+	// 获取OAuth客户端列表
+	ListOauthClient(context.Context, *OauthClientListRequest) (*OauthClientListResponse, error)
+	// 认证OAuth客户端
+	AuthenticateOauthClient(context.Context, *OauthClientAuthRequest) (*OauthClientInfo, error)
+	// 获取用户对客户端的授权记录
+	GetOauthConsent(context.Context, *OauthConsentRequest) (*OauthConsentInfo, error)
+	// 保存用户对客户端的授权记录
+	SaveOauthConsent(context.Context, *OauthConsentInfo) (*BaseResponse, error)
+	// 获取用户的授权记录列表
+	ListOauthConsent(context.Context, *UUIDRequest) (*OauthConsentListResponse, error)
+	// 撤销用户对客户端的授权
+	DeleteOauthConsent(context.Context, *OauthConsentRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedOauthClientServiceServer()
+}
+
+// UnimplementedOauthClientServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOauthClientServiceServer struct {
+}
+
+func (UnimplementedOauthClientServiceServer) CreateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOauthClient not implemented")
+}
+func (UnimplementedOauthClientServiceServer) UpdateOauthClient(context.Context, *OauthClientInfo) (*OauthClientSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOauthClient not implemented")
+}
+func (UnimplementedOauthClientServiceServer) DeleteOauthClient(context.Context, *ID32Request) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthClient not implemented")
+}
+func (UnimplementedOauthClientServiceServer) ListOauthClient(context.Context, *OauthClientListRequest) (*OauthClientListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOauthClient not implemented")
+}
+func (UnimplementedOauthClientServiceServer) AuthenticateOauthClient(context.Context, *OauthClientAuthRequest) (*OauthClientInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateOauthClient not implemented")
+}
+func (UnimplementedOauthClientServiceServer) GetOauthConsent(context.Context, *OauthConsentRequest) (*OauthConsentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOauthConsent not implemented")
+}
+func (UnimplementedOauthClientServiceServer) SaveOauthConsent(context.Context, *OauthConsentInfo) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveOauthConsent not implemented")
+}
+func (UnimplementedOauthClientServiceServer) ListOauthConsent(context.Context, *UUIDRequest) (*OauthConsentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOauthConsent not implemented")
+}
+func (UnimplementedOauthClientServiceServer) DeleteOauthConsent(context.Context, *OauthConsentRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOauthConsent not implemented")
+}
+func (UnimplementedOauthClientServiceServer) mustEmbedUnimplementedOauthClientServiceServer() {}
+
+// UnsafeOauthClientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OauthClientServiceServer will
+// result in compilation errors.
+type UnsafeOauthClientServiceServer interface {
+	mustEmbedUnimplementedOauthClientServiceServer()
+}
+
+func RegisterOauthClientServiceServer(s grpc.ServiceRegistrar, srv OauthClientServiceServer) {
+	s.RegisterService(&OauthClientService_ServiceDesc, srv)
+}
+
+func _OauthClientService_CreateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).CreateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_CreateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).CreateOauthClient(ctx, req.(*OauthClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_UpdateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).UpdateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_UpdateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).UpdateOauthClient(ctx, req.(*OauthClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_DeleteOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).DeleteOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_DeleteOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).DeleteOauthClient(ctx, req.(*ID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_ListOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).ListOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_ListOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).ListOauthClient(ctx, req.(*OauthClientListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_AuthenticateOauthClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthClientAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).AuthenticateOauthClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_AuthenticateOauthClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).AuthenticateOauthClient(ctx, req.(*OauthClientAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_GetOauthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).GetOauthConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_GetOauthConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).GetOauthConsent(ctx, req.(*OauthConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_SaveOauthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthConsentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).SaveOauthConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_SaveOauthConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).SaveOauthConsent(ctx, req.(*OauthConsentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_ListOauthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).ListOauthConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_ListOauthConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).ListOauthConsent(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OauthClientService_DeleteOauthConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OauthClientServiceServer).DeleteOauthConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OauthClientService_DeleteOauthConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OauthClientServiceServer).DeleteOauthConsent(ctx, req.(*OauthConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OauthClientService_ServiceDesc is the grpc.ServiceDesc for OauthClientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OauthClientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.OauthClientService",
+	HandlerType: (*OauthClientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOauthClient",
+			Handler:    _OauthClientService_CreateOauthClient_Handler,
+		},
+		{
+			MethodName: "UpdateOauthClient",
+			Handler:    _OauthClientService_UpdateOauthClient_Handler,
+		},
+		{
+			MethodName: "DeleteOauthClient",
+			Handler:    _OauthClientService_DeleteOauthClient_Handler,
+		},
+		{
+			MethodName: "ListOauthClient",
+			Handler:    _OauthClientService_ListOauthClient_Handler,
+		},
+		{
+			MethodName: "AuthenticateOauthClient",
+			Handler:    _OauthClientService_AuthenticateOauthClient_Handler,
+		},
+		{
+			MethodName: "GetOauthConsent",
+			Handler:    _OauthClientService_GetOauthConsent_Handler,
+		},
+		{
+			MethodName: "SaveOauthConsent",
+			Handler:    _OauthClientService_SaveOauthConsent_Handler,
+		},
+		{
+			MethodName: "ListOauthConsent",
+			Handler:    _OauthClientService_ListOauthConsent_Handler,
+		},
+		{
+			MethodName: "DeleteOauthConsent",
+			Handler:    _OauthClientService_DeleteOauthConsent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	SecurityEventService_CreateSecurityEvent_FullMethodName = "/core.SecurityEventService/CreateSecurityEvent"
+	SecurityEventService_ListSecurityEvent_FullMethodName   = "/core.SecurityEventService/ListSecurityEvent"
+)
+
+// SecurityEventServiceClient is the client API for SecurityEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SecurityEventServiceClient interface {
+	// 记录安全事件，登录成功时同时更新用户最后登录信息
+	CreateSecurityEvent(ctx context.Context, in *SecurityEventInfo, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取安全事件列表
+	ListSecurityEvent(ctx context.Context, in *SecurityEventListRequest, opts ...grpc.CallOption) (*SecurityEventListResponse, error)
+}
+
+type securityEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSecurityEventServiceClient(cc grpc.ClientConnInterface) SecurityEventServiceClient {
+	return &securityEventServiceClient{cc}
+}
+
+func (c *securityEventServiceClient) CreateSecurityEvent(ctx context.Context, in *SecurityEventInfo, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, SecurityEventService_CreateSecurityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityEventServiceClient) ListSecurityEvent(ctx context.Context, in *SecurityEventListRequest, opts ...grpc.CallOption) (*SecurityEventListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SecurityEventListResponse)
+	err := c.cc.Invoke(ctx, SecurityEventService_ListSecurityEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecurityEventServiceServer is the server API for SecurityEventService service.
+// All implementations must embed UnimplementedSecurityEventServiceServer
+// for forward compatibility
+type SecurityEventServiceServer interface {
+	// 记录安全事件，登录成功时同时更新用户最后登录信息
+	CreateSecurityEvent(context.Context, *SecurityEventInfo) (*BaseResponse, error)
+	// 获取安全事件列表
+	ListSecurityEvent(context.Context, *SecurityEventListRequest) (*SecurityEventListResponse, error)
+	mustEmbedUnimplementedSecurityEventServiceServer()
+}
+
+// UnimplementedSecurityEventServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSecurityEventServiceServer struct {
+}
+
+func (UnimplementedSecurityEventServiceServer) CreateSecurityEvent(context.Context, *SecurityEventInfo) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecurityEvent not implemented")
+}
+func (UnimplementedSecurityEventServiceServer) ListSecurityEvent(context.Context, *SecurityEventListRequest) (*SecurityEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityEvent not implemented")
+}
+func (UnimplementedSecurityEventServiceServer) mustEmbedUnimplementedSecurityEventServiceServer() {}
+
+// UnsafeSecurityEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecurityEventServiceServer will
+// result in compilation errors.
+type UnsafeSecurityEventServiceServer interface {
+	mustEmbedUnimplementedSecurityEventServiceServer()
+}
+
+func RegisterSecurityEventServiceServer(s grpc.ServiceRegistrar, srv SecurityEventServiceServer) {
+	s.RegisterService(&SecurityEventService_ServiceDesc, srv)
+}
+
+func _SecurityEventService_CreateSecurityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityEventInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityEventServiceServer).CreateSecurityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityEventService_CreateSecurityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityEventServiceServer).CreateSecurityEvent(ctx, req.(*SecurityEventInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityEventService_ListSecurityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecurityEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityEventServiceServer).ListSecurityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityEventService_ListSecurityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityEventServiceServer).ListSecurityEvent(ctx, req.(*SecurityEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SecurityEventService_ServiceDesc is the grpc.ServiceDesc for SecurityEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SecurityEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.SecurityEventService",
+	HandlerType: (*SecurityEventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSecurityEvent",
+			Handler:    _SecurityEventService_CreateSecurityEvent_Handler,
+		},
+		{
+			MethodName: "ListSecurityEvent",
+			Handler:    _SecurityEventService_ListSecurityEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	NotificationService_CreateNotification_FullMethodName      = "/core.NotificationService/CreateNotification"
+	NotificationService_UpdateNotification_FullMethodName      = "/core.NotificationService/UpdateNotification"
+	NotificationService_DeleteNotification_FullMethodName      = "/core.NotificationService/DeleteNotification"
+	NotificationService_ListNotification_FullMethodName        = "/core.NotificationService/ListNotification"
+	NotificationService_ListUserNotification_FullMethodName    = "/core.NotificationService/ListUserNotification"
+	NotificationService_CountUnreadNotification_FullMethodName = "/core.NotificationService/CountUnreadNotification"
+	NotificationService_MarkNotification_FullMethodName        = "/core.NotificationService/MarkNotification"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	// 创建通知
+	CreateNotification(ctx context.Context, in *NotificationInfo, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 更新通知
+	UpdateNotification(ctx context.Context, in *NotificationInfo, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 删除通知
+	DeleteNotification(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 获取通知列表
+	ListNotification(ctx context.Context, in *NotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error)
+	// 获取用户可见的通知列表
+	ListUserNotification(ctx context.Context, in *UserNotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error)
+	// 获取用户未读通知数量
+	CountUnreadNotification(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*NotificationCountResponse, error)
+	// 标记用户通知的阅读状态
+	MarkNotification(ctx context.Context, in *MarkNotificationRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) CreateNotification(ctx context.Context, in *NotificationInfo, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CreateNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotification(ctx context.Context, in *NotificationInfo, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteNotification(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListNotification(ctx context.Context, in *NotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationListResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListUserNotification(ctx context.Context, in *UserNotificationListRequest, opts ...grpc.CallOption) (*NotificationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationListResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListUserNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CountUnreadNotification(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*NotificationCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationCountResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CountUnreadNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkNotification(ctx context.Context, in *MarkNotificationRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility
+type NotificationServiceServer interface {
+	// 创建通知
+	CreateNotification(context.Context, *NotificationInfo) (*BaseResponse, error)
+	// 更新通知
+	UpdateNotification(context.Context, *NotificationInfo) (*BaseResponse, error)
+	// 删除通知
+	DeleteNotification(context.Context, *ID32SRequest) (*BaseResponse, error)
+	// 获取通知列表
+	ListNotification(context.Context, *NotificationListRequest) (*NotificationListResponse, error)
+	// 获取用户可见的通知列表
+	ListUserNotification(context.Context, *UserNotificationListRequest) (*NotificationListResponse, error)
+	// 获取用户未读通知数量
+	CountUnreadNotification(context.Context, *UUIDRequest) (*NotificationCountResponse, error)
+	// 标记用户通知的阅读状态
+	MarkNotification(context.Context, *MarkNotificationRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNotificationServiceServer struct {
+}
+
+func (UnimplementedNotificationServiceServer) CreateNotification(context.Context, *NotificationInfo) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateNotification(context.Context, *NotificationInfo) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteNotification(context.Context, *ID32SRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListNotification(context.Context, *NotificationListRequest) (*NotificationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListUserNotification(context.Context, *UserNotificationListRequest) (*NotificationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) CountUnreadNotification(context.Context, *UUIDRequest) (*NotificationCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUnreadNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkNotification(context.Context, *MarkNotificationRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateNotification(ctx, req.(*NotificationInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotification(ctx, req.(*NotificationInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteNotification(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListNotification(ctx, req.(*NotificationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListUserNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserNotificationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListUserNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListUserNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListUserNotification(ctx, req.(*UserNotificationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CountUnreadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CountUnreadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CountUnreadNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CountUnreadNotification(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkNotification(ctx, req.(*MarkNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNotification",
+			Handler:    _NotificationService_CreateNotification_Handler,
+		},
+		{
+			MethodName: "UpdateNotification",
+			Handler:    _NotificationService_UpdateNotification_Handler,
+		},
+		{
+			MethodName: "DeleteNotification",
+			Handler:    _NotificationService_DeleteNotification_Handler,
+		},
+		{
+			MethodName: "ListNotification",
+			Handler:    _NotificationService_ListNotification_Handler,
+		},
+		{
+			MethodName: "ListUserNotification",
+			Handler:    _NotificationService_ListUserNotification_Handler,
+		},
+		{
+			MethodName: "CountUnreadNotification",
+			Handler:    _NotificationService_CountUnreadNotification_Handler,
+		},
+		{
+			MethodName: "MarkNotification",
+			Handler:    _NotificationService_MarkNotification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	JobService_ListJob_FullMethodName    = "/core.JobService/ListJob"
+	JobService_TriggerJob_FullMethodName = "/core.JobService/TriggerJob"
+	JobService_PauseJob_FullMethodName   = "/core.JobService/PauseJob"
+)
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	// 获取定时任务列表
+	ListJob(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ScheduledJobListResponse, error)
+	// 立即执行定时任务
+	TriggerJob(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 暂停或恢复定时任务
+	PauseJob(ctx context.Context, in *ScheduledJobPauseRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) ListJob(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ScheduledJobListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledJobListResponse)
+	err := c.cc.Invoke(ctx, JobService_ListJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) TriggerJob(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, JobService_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) PauseJob(ctx context.Context, in *ScheduledJobPauseRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, JobService_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility
+type JobServiceServer interface {
+	// 获取定时任务列表
+	ListJob(context.Context, *EmptyRequest) (*ScheduledJobListResponse, error)
+	// 立即执行定时任务
+	TriggerJob(context.Context, *StringRequest) (*BaseResponse, error)
+	// 暂停或恢复定时任务
+	PauseJob(context.Context, *ScheduledJobPauseRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJobServiceServer struct {
+}
+
+func (UnimplementedJobServiceServer) ListJob(context.Context, *EmptyRequest) (*ScheduledJobListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJob not implemented")
+}
+func (UnimplementedJobServiceServer) TriggerJob(context.Context, *StringRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedJobServiceServer) PauseJob(context.Context, *ScheduledJobPauseRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_ListJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).ListJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_ListJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).ListJob(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).TriggerJob(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledJobPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).PauseJob(ctx, req.(*ScheduledJobPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJob",
+			Handler:    _JobService_ListJob_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _JobService_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _JobService_PauseJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	FileService_UploadFile_FullMethodName   = "/core.FileService/UploadFile"
+	FileService_GetFile_FullMethodName      = "/core.FileService/GetFile"
+	FileService_DownloadFile_FullMethodName = "/core.FileService/DownloadFile"
+	FileService_ListFile_FullMethodName     = "/core.FileService/ListFile"
+	FileService_DeleteFile_FullMethodName   = "/core.FileService/DeleteFile"
+)
+
+// FileServiceClient is the client API for FileService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FileServiceClient interface {
+	// 上传文件
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadFileClient, error)
+	// 获取文件信息
+	GetFile(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// 下载文件内容
+	DownloadFile(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (FileService_DownloadFileClient, error)
+	// 获取文件列表
+	ListFile(ctx context.Context, in *FileListRequest, opts ...grpc.CallOption) (*FileListResponse, error)
+	// 删除文件及存储的内容
+	DeleteFile(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type fileServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFileServiceClient(cc grpc.ClientConnInterface) FileServiceClient {
+	return &fileServiceClient{cc}
+}
+
+func (c *fileServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (FileService_UploadFileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FileService_ServiceDesc.Streams[0], FileService_UploadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileServiceUploadFileClient{ClientStream: stream}
+	return x, nil
+}
+
+type FileService_UploadFileClient interface {
+	Send(*FileUploadRequest) error
+	CloseAndRecv() (*FileInfo, error)
+	grpc.ClientStream
+}
+
+type fileServiceUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileServiceUploadFileClient) Send(m *FileUploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *fileServiceUploadFileClient) CloseAndRecv() (*FileInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fileServiceClient) GetFile(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, FileService_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) DownloadFile(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (FileService_DownloadFileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FileService_ServiceDesc.Streams[1], FileService_DownloadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileServiceDownloadFileClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FileService_DownloadFileClient interface {
+	Recv() (*FileChunk, error)
+	grpc.ClientStream
+}
+
+type fileServiceDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileServiceDownloadFileClient) Recv() (*FileChunk, error) {
+	m := new(FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fileServiceClient) ListFile(ctx context.Context, in *FileListRequest, opts ...grpc.CallOption) (*FileListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileListResponse)
+	err := c.cc.Invoke(ctx, FileService_ListFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileServiceClient) DeleteFile(ctx context.Context, in *UUIDSRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, FileService_DeleteFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FileServiceServer is the server API for FileService service.
+// All implementations must embed UnimplementedFileServiceServer
+// for forward compatibility
+type FileServiceServer interface {
+	// 上传文件
+	UploadFile(FileService_UploadFileServer) error
+	// 获取文件信息
+	GetFile(context.Context, *UUIDRequest) (*FileInfo, error)
+	// 下载文件内容
+	DownloadFile(*UUIDRequest, FileService_DownloadFileServer) error
+	// 获取文件列表
+	ListFile(context.Context, *FileListRequest) (*FileListResponse, error)
+	// 删除文件及存储的内容
+	DeleteFile(context.Context, *UUIDSRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedFileServiceServer()
+}
+
+// UnimplementedFileServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFileServiceServer struct {
+}
+
+func (UnimplementedFileServiceServer) UploadFile(FileService_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedFileServiceServer) GetFile(context.Context, *UUIDRequest) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedFileServiceServer) DownloadFile(*UUIDRequest, FileService_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedFileServiceServer) ListFile(context.Context, *FileListRequest) (*FileListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFile not implemented")
+}
+func (UnimplementedFileServiceServer) DeleteFile(context.Context, *UUIDSRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
+
+// UnsafeFileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FileServiceServer will
+// result in compilation errors.
+type UnsafeFileServiceServer interface {
+	mustEmbedUnimplementedFileServiceServer()
+}
+
+func RegisterFileServiceServer(s grpc.ServiceRegistrar, srv FileServiceServer) {
+	s.RegisterService(&FileService_ServiceDesc, srv)
+}
+
+func _FileService_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FileServiceServer).UploadFile(&fileServiceUploadFileServer{ServerStream: stream})
+}
+
+type FileService_UploadFileServer interface {
+	SendAndClose(*FileInfo) error
+	Recv() (*FileUploadRequest, error)
+	grpc.ServerStream
+}
+
+type fileServiceUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileServiceUploadFileServer) SendAndClose(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *fileServiceUploadFileServer) Recv() (*FileUploadRequest, error) {
+	m := new(FileUploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FileService_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).GetFile(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UUIDRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileServiceServer).DownloadFile(m, &fileServiceDownloadFileServer{ServerStream: stream})
+}
+
+type FileService_DownloadFileServer interface {
+	Send(*FileChunk) error
+	grpc.ServerStream
+}
+
+type fileServiceDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileServiceDownloadFileServer) Send(m *FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FileService_ListFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).ListFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_ListFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).ListFile(ctx, req.(*FileListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FileService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_DeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).DeleteFile(ctx, req.(*UUIDSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.FileService",
+	HandlerType: (*FileServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFile",
+			Handler:    _FileService_GetFile_Handler,
+		},
+		{
+			MethodName: "ListFile",
+			Handler:    _FileService_ListFile_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _FileService_DeleteFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _FileService_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _FileService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	AuthzService_Check_FullMethodName               = "/core.AuthzService/Check"
+	AuthzService_BatchCheck_FullMethodName          = "/core.AuthzService/BatchCheck"
+	AuthzService_ListAllowedActions_FullMethodName  = "/core.AuthzService/ListAllowedActions"
+	AuthzService_ListPermissionCodes_FullMethodName = "/core.AuthzService/ListPermissionCodes"
+)
+
+// AuthzServiceClient is the client API for AuthzService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthzServiceClient interface {
+	// 检查主体是否有权访问资源
+	Check(ctx context.Context, in *AuthzCheckRequest, opts ...grpc.CallOption) (*AuthzCheckResponse, error)
+	// 批量检查主体是否有权访问资源
+	BatchCheck(ctx context.Context, in *AuthzBatchCheckRequest, opts ...grpc.CallOption) (*AuthzBatchCheckResponse, error)
+	// 获取主体在指定域下允许的资源及操作
+	ListAllowedActions(ctx context.Context, in *AuthzListActionsRequest, opts ...grpc.CallOption) (*AuthzListActionsResponse, error)
+	// 获取用户的权限标识
+	ListPermissionCodes(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*AuthzPermissionCodeResponse, error)
+}
+
+type authzServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthzServiceClient(cc grpc.ClientConnInterface) AuthzServiceClient {
+	return &authzServiceClient{cc}
+}
+
+func (c *authzServiceClient) Check(ctx context.Context, in *AuthzCheckRequest, opts ...grpc.CallOption) (*AuthzCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthzCheckResponse)
+	err := c.cc.Invoke(ctx, AuthzService_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) BatchCheck(ctx context.Context, in *AuthzBatchCheckRequest, opts ...grpc.CallOption) (*AuthzBatchCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthzBatchCheckResponse)
+	err := c.cc.Invoke(ctx, AuthzService_BatchCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) ListAllowedActions(ctx context.Context, in *AuthzListActionsRequest, opts ...grpc.CallOption) (*AuthzListActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthzListActionsResponse)
+	err := c.cc.Invoke(ctx, AuthzService_ListAllowedActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authzServiceClient) ListPermissionCodes(ctx context.Context, in *UUIDRequest, opts ...grpc.CallOption) (*AuthzPermissionCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthzPermissionCodeResponse)
+	err := c.cc.Invoke(ctx, AuthzService_ListPermissionCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthzServiceServer is the server API for AuthzService service.
+// All implementations must embed UnimplementedAuthzServiceServer
+// for forward compatibility
+type AuthzServiceServer interface {
+	// 检查主体是否有权访问资源
+	Check(context.Context, *AuthzCheckRequest) (*AuthzCheckResponse, error)
+	// 批量检查主体是否有权访问资源
+	BatchCheck(context.Context, *AuthzBatchCheckRequest) (*AuthzBatchCheckResponse, error)
+	// 获取主体在指定域下允许的资源及操作
+	ListAllowedActions(context.Context, *AuthzListActionsRequest) (*AuthzListActionsResponse, error)
+	// 获取用户的权限标识
+	ListPermissionCodes(context.Context, *UUIDRequest) (*AuthzPermissionCodeResponse, error)
+	mustEmbedUnimplementedAuthzServiceServer()
+}
+
+// UnimplementedAuthzServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthzServiceServer struct {
+}
+
+func (UnimplementedAuthzServiceServer) Check(context.Context, *AuthzCheckRequest) (*AuthzCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedAuthzServiceServer) BatchCheck(context.Context, *AuthzBatchCheckRequest) (*AuthzBatchCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCheck not implemented")
+}
+func (UnimplementedAuthzServiceServer) ListAllowedActions(context.Context, *AuthzListActionsRequest) (*AuthzListActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedActions not implemented")
+}
+func (UnimplementedAuthzServiceServer) ListPermissionCodes(context.Context, *UUIDRequest) (*AuthzPermissionCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionCodes not implemented")
+}
+func (UnimplementedAuthzServiceServer) mustEmbedUnimplementedAuthzServiceServer() {}
+
+// UnsafeAuthzServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthzServiceServer will
+// result in compilation errors.
+type UnsafeAuthzServiceServer interface {
+	mustEmbedUnimplementedAuthzServiceServer()
+}
+
+func RegisterAuthzServiceServer(s grpc.ServiceRegistrar, srv AuthzServiceServer) {
+	s.RegisterService(&AuthzService_ServiceDesc, srv)
+}
+
+func _AuthzService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthzCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).Check(ctx, req.(*AuthzCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_BatchCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthzBatchCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).BatchCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_BatchCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).BatchCheck(ctx, req.(*AuthzBatchCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_ListAllowedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthzListActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).ListAllowedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_ListAllowedActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).ListAllowedActions(ctx, req.(*AuthzListActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthzService_ListPermissionCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthzServiceServer).ListPermissionCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthzService_ListPermissionCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthzServiceServer).ListPermissionCodes(ctx, req.(*UUIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthzService_ServiceDesc is the grpc.ServiceDesc for AuthzService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthzService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.AuthzService",
+	HandlerType: (*AuthzServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Check",
+			Handler:    _AuthzService_Check_Handler,
+		},
+		{
+			MethodName: "BatchCheck",
+			Handler:    _AuthzService_BatchCheck_Handler,
+		},
+		{
+			MethodName: "ListAllowedActions",
+			Handler:    _AuthzService_ListAllowedActions_Handler,
+		},
+		{
+			MethodName: "ListPermissionCodes",
+			Handler:    _AuthzService_ListPermissionCodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	PermissionReportService_GetRolePermissionReport_FullMethodName   = "/core.PermissionReportService/GetRolePermissionReport"
+	PermissionReportService_GetPermissionHolders_FullMethodName      = "/core.PermissionReportService/GetPermissionHolders"
+	PermissionReportService_CreatePermissionSnapshot_FullMethodName  = "/core.PermissionReportService/CreatePermissionSnapshot"
+	PermissionReportService_GetPermissionSnapshotList_FullMethodName = "/core.PermissionReportService/GetPermissionSnapshotList"
+	PermissionReportService_DeletePermissionSnapshot_FullMethodName  = "/core.PermissionReportService/DeletePermissionSnapshot"
+	PermissionReportService_DiffPermissionSnapshot_FullMethodName    = "/core.PermissionReportService/DiffPermissionSnapshot"
+)
+
+// PermissionReportServiceClient is the client API for PermissionReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PermissionReportServiceClient interface {
+	// 获取角色的全部权限及持有用户
+	GetRolePermissionReport(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*RolePermissionReport, error)
+	// 获取持有接口、菜单或配置项权限的角色及用户
+	GetPermissionHolders(ctx context.Context, in *PermissionHolderRequest, opts ...grpc.CallOption) (*PermissionHolderResponse, error)
+	// 创建当前权限的快照
+	CreatePermissionSnapshot(ctx context.Context, in *PermissionSnapshotInfo, opts ...grpc.CallOption) (*PermissionSnapshotInfo, error)
+	// 获取权限快照列表
+	GetPermissionSnapshotList(ctx context.Context, in *PermissionSnapshotListRequest, opts ...grpc.CallOption) (*PermissionSnapshotListResponse, error)
+	// 删除权限快照
+	DeletePermissionSnapshot(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// 对比两个权限快照，或快照与当前权限的差异
+	DiffPermissionSnapshot(ctx context.Context, in *PermissionSnapshotDiffRequest, opts ...grpc.CallOption) (*PermissionSnapshotDiffResponse, error)
+}
+
+type permissionReportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPermissionReportServiceClient(cc grpc.ClientConnInterface) PermissionReportServiceClient {
+	return &permissionReportServiceClient{cc}
+}
+
+func (c *permissionReportServiceClient) GetRolePermissionReport(ctx context.Context, in *ID32Request, opts ...grpc.CallOption) (*RolePermissionReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolePermissionReport)
+	err := c.cc.Invoke(ctx, PermissionReportService_GetRolePermissionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionReportServiceClient) GetPermissionHolders(ctx context.Context, in *PermissionHolderRequest, opts ...grpc.CallOption) (*PermissionHolderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionHolderResponse)
+	err := c.cc.Invoke(ctx, PermissionReportService_GetPermissionHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionReportServiceClient) CreatePermissionSnapshot(ctx context.Context, in *PermissionSnapshotInfo, opts ...grpc.CallOption) (*PermissionSnapshotInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionSnapshotInfo)
+	err := c.cc.Invoke(ctx, PermissionReportService_CreatePermissionSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionReportServiceClient) GetPermissionSnapshotList(ctx context.Context, in *PermissionSnapshotListRequest, opts ...grpc.CallOption) (*PermissionSnapshotListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionSnapshotListResponse)
+	err := c.cc.Invoke(ctx, PermissionReportService_GetPermissionSnapshotList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionReportServiceClient) DeletePermissionSnapshot(ctx context.Context, in *ID32SRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, PermissionReportService_DeletePermissionSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionReportServiceClient) DiffPermissionSnapshot(ctx context.Context, in *PermissionSnapshotDiffRequest, opts ...grpc.CallOption) (*PermissionSnapshotDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionSnapshotDiffResponse)
+	err := c.cc.Invoke(ctx, PermissionReportService_DiffPermissionSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PermissionReportServiceServer is the server API for PermissionReportService service.
+// All implementations must embed UnimplementedPermissionReportServiceServer
+// for forward compatibility
+type PermissionReportServiceServer interface {
+	// 获取角色的全部权限及持有用户
+	GetRolePermissionReport(context.Context, *ID32Request) (*RolePermissionReport, error)
+	// 获取持有接口、菜单或配置项权限的角色及用户
+	GetPermissionHolders(context.Context, *PermissionHolderRequest) (*PermissionHolderResponse, error)
+	// 创建当前权限的快照
+	CreatePermissionSnapshot(context.Context, *PermissionSnapshotInfo) (*PermissionSnapshotInfo, error)
+	// 获取权限快照列表
+	GetPermissionSnapshotList(context.Context, *PermissionSnapshotListRequest) (*PermissionSnapshotListResponse, error)
+	// 删除权限快照
+	DeletePermissionSnapshot(context.Context, *ID32SRequest) (*BaseResponse, error)
+	// 对比两个权限快照，或快照与当前权限的差异
+	DiffPermissionSnapshot(context.Context, *PermissionSnapshotDiffRequest) (*PermissionSnapshotDiffResponse, error)
+	mustEmbedUnimplementedPermissionReportServiceServer()
+}
+
+// UnimplementedPermissionReportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPermissionReportServiceServer struct {
+}
+
+func (UnimplementedPermissionReportServiceServer) GetRolePermissionReport(context.Context, *ID32Request) (*RolePermissionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermissionReport not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) GetPermissionHolders(context.Context, *PermissionHolderRequest) (*PermissionHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionHolders not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) CreatePermissionSnapshot(context.Context, *PermissionSnapshotInfo) (*PermissionSnapshotInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermissionSnapshot not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) GetPermissionSnapshotList(context.Context, *PermissionSnapshotListRequest) (*PermissionSnapshotListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionSnapshotList not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) DeletePermissionSnapshot(context.Context, *ID32SRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermissionSnapshot not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) DiffPermissionSnapshot(context.Context, *PermissionSnapshotDiffRequest) (*PermissionSnapshotDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPermissionSnapshot not implemented")
+}
+func (UnimplementedPermissionReportServiceServer) mustEmbedUnimplementedPermissionReportServiceServer() {
+}
+
+// UnsafePermissionReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PermissionReportServiceServer will
+// result in compilation errors.
+type UnsafePermissionReportServiceServer interface {
+	mustEmbedUnimplementedPermissionReportServiceServer()
+}
+
+func RegisterPermissionReportServiceServer(s grpc.ServiceRegistrar, srv PermissionReportServiceServer) {
+	s.RegisterService(&PermissionReportService_ServiceDesc, srv)
+}
+
+func _PermissionReportService_GetRolePermissionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).GetRolePermissionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_GetRolePermissionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).GetRolePermissionReport(ctx, req.(*ID32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionReportService_GetPermissionHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).GetPermissionHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_GetPermissionHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).GetPermissionHolders(ctx, req.(*PermissionHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionReportService_CreatePermissionSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionSnapshotInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).CreatePermissionSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_CreatePermissionSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).CreatePermissionSnapshot(ctx, req.(*PermissionSnapshotInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionReportService_GetPermissionSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionSnapshotListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).GetPermissionSnapshotList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_GetPermissionSnapshotList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).GetPermissionSnapshotList(ctx, req.(*PermissionSnapshotListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionReportService_DeletePermissionSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID32SRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).DeletePermissionSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_DeletePermissionSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).DeletePermissionSnapshot(ctx, req.(*ID32SRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionReportService_DiffPermissionSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionSnapshotDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionReportServiceServer).DiffPermissionSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PermissionReportService_DiffPermissionSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionReportServiceServer).DiffPermissionSnapshot(ctx, req.(*PermissionSnapshotDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PermissionReportService_ServiceDesc is the grpc.ServiceDesc for PermissionReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PermissionReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.PermissionReportService",
+	HandlerType: (*PermissionReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRolePermissionReport",
+			Handler:    _PermissionReportService_GetRolePermissionReport_Handler,
+		},
+		{
+			MethodName: "GetPermissionHolders",
+			Handler:    _PermissionReportService_GetPermissionHolders_Handler,
+		},
+		{
+			MethodName: "CreatePermissionSnapshot",
+			Handler:    _PermissionReportService_CreatePermissionSnapshot_Handler,
+		},
+		{
+			MethodName: "GetPermissionSnapshotList",
+			Handler:    _PermissionReportService_GetPermissionSnapshotList_Handler,
+		},
+		{
+			MethodName: "DeletePermissionSnapshot",
+			Handler:    _PermissionReportService_DeletePermissionSnapshot_Handler,
+		},
+		{
+			MethodName: "DiffPermissionSnapshot",
+			Handler:    _PermissionReportService_DiffPermissionSnapshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
+
+const (
+	BundleService_ExportBundle_FullMethodName = "/core.BundleService/ExportBundle"
+	BundleService_ImportBundle_FullMethodName = "/core.BundleService/ImportBundle"
+)
+
+// BundleServiceClient is the client API for BundleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BundleServiceClient interface {
+	// 导出角色、菜单、API、配置项及角色策略
+	ExportBundle(ctx context.Context, in *BundleExportRequest, opts ...grpc.CallOption) (*BundleData, error)
+	// 预览或导入配置包
+	ImportBundle(ctx context.Context, in *BundleImportRequest, opts ...grpc.CallOption) (*BundleImportResponse, error)
+}
+
+type bundleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBundleServiceClient(cc grpc.ClientConnInterface) BundleServiceClient {
+	return &bundleServiceClient{cc}
+}
+
+func (c *bundleServiceClient) ExportBundle(ctx context.Context, in *BundleExportRequest, opts ...grpc.CallOption) (*BundleData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleData)
+	err := c.cc.Invoke(ctx, BundleService_ExportBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleServiceClient) ImportBundle(ctx context.Context, in *BundleImportRequest, opts ...grpc.CallOption) (*BundleImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleImportResponse)
+	err := c.cc.Invoke(ctx, BundleService_ImportBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BundleServiceServer is the server API for BundleService service.
+// All implementations must embed UnimplementedBundleServiceServer
+// for forward compatibility
+type BundleServiceServer interface {
+	// 导出角色、菜单、API、配置项及角色策略
+	ExportBundle(context.Context, *BundleExportRequest) (*BundleData, error)
+	// 预览或导入配置包
+	ImportBundle(context.Context, *BundleImportRequest) (*BundleImportResponse, error)
+	mustEmbedUnimplementedBundleServiceServer()
+}
+
+// UnimplementedBundleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBundleServiceServer struct {
+}
+
+func (UnimplementedBundleServiceServer) ExportBundle(context.Context, *BundleExportRequest) (*BundleData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) ImportBundle(context.Context, *BundleImportRequest) (*BundleImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBundle not implemented")
+}
+func (UnimplementedBundleServiceServer) mustEmbedUnimplementedBundleServiceServer() {}
+
+// UnsafeBundleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BundleServiceServer will
+// result in compilation errors.
+type UnsafeBundleServiceServer interface {
+	mustEmbedUnimplementedBundleServiceServer()
+}
+
+func RegisterBundleServiceServer(s grpc.ServiceRegistrar, srv BundleServiceServer) {
+	s.RegisterService(&BundleService_ServiceDesc, srv)
+}
+
+func _BundleService_ExportBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BundleExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).ExportBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_ExportBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).ExportBundle(ctx, req.(*BundleExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundleService_ImportBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BundleImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServiceServer).ImportBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BundleService_ImportBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServiceServer).ImportBundle(ctx, req.(*BundleImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BundleService_ServiceDesc is the grpc.ServiceDesc for BundleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BundleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.BundleService",
+	HandlerType: (*BundleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportBundle",
+			Handler:    _BundleService_ExportBundle_Handler,
+		},
+		{
+			MethodName: "ImportBundle",
+			Handler:    _BundleService_ImportBundle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/core.proto",
+}
@@ -80,7 +80,8 @@ func main() {
 
 	fmt.Println("\n========================================")
 	fmt.Println("   数据库迁移工具 - Database Migration")
-	fmt.Println("========================================\n")
+	fmt.Println("========================================")
+	fmt.Println()
 
 	// Step 1: Select database type
 	dbType := selectDatabaseType(reader)
@@ -0,0 +1,103 @@
+// sensitiveroutes 扫描 .api 文件中 @doc 标记 sensitive: "true" 的路由，生成模拟登录及API密钥会话禁止访问的路由表
+//
+// 用法: go run ./scripts/sensitiveroutes [-dir api/desc] [-out api/internal/middleware/delegated_routes_gen.go]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	prefixPattern = regexp.MustCompile(`^prefix\s*:\s*(\S+)`)
+	routePattern  = regexp.MustCompile(`^(get|post|put|patch|delete)\s+(\S+)`)
+	markPattern   = regexp.MustCompile(`^sensitive\s*:\s*"true"`)
+)
+
+func main() {
+	dir := flag.String("dir", "api/desc", ".api 文件目录")
+	out := flag.String("out", "api/internal/middleware/delegated_routes_gen.go", "输出文件")
+	flag.Parse()
+
+	var routes []string
+	err := filepath.WalkDir(*dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".api" {
+			return err
+		}
+		found, err := scan(path)
+		routes = append(routes, found...)
+		return err
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(routes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by scripts/sensitiveroutes. DO NOT EDIT.\n\n")
+	buf.WriteString("package middleware\n\n")
+	buf.WriteString("// delegatedBlockedRoutes 模拟登录及API密钥会话禁止访问的敏感操作，由 .api 文件中 @doc 标记 sensitive: \"true\" 的路由生成\n")
+	buf.WriteString("var delegatedBlockedRoutes = map[string]struct{}{\n")
+	for _, route := range routes {
+		fmt.Fprintf(&buf, "\t%q: {},\n", route)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// scan 按行解析 .api 文件，@server 中的 prefix 作用于其后 service 块内的所有路由
+func scan(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		routes    []string
+		prefix    string
+		inDoc     bool
+		sensitive bool
+	)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "@server"):
+			prefix = ""
+		case strings.HasPrefix(line, "@doc") && strings.HasSuffix(line, "("):
+			inDoc = true
+		case inDoc && line == ")":
+			inDoc = false
+		case inDoc:
+			if markPattern.MatchString(line) {
+				sensitive = true
+			}
+		case prefixPattern.MatchString(line):
+			prefix = prefixPattern.FindStringSubmatch(line)[1]
+		case routePattern.MatchString(line):
+			if sensitive {
+				m := routePattern.FindStringSubmatch(line)
+				routes = append(routes, strings.ToUpper(m[1])+" "+prefix+m[2])
+			}
+			sensitive = false
+		}
+	}
+	return routes, scanner.Err()
+}